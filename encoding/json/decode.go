@@ -113,28 +113,29 @@ func (d *Decoder) Decode() (value cadence.Value, err error) {
 }
 
 const (
-	typeKey         = "type"
-	kindKey         = "kind"
-	valueKey        = "value"
-	keyKey          = "key"
-	nameKey         = "name"
-	fieldsKey       = "fields"
-	initializersKey = "initializers"
-	idKey           = "id"
-	targetPathKey   = "targetPath"
-	borrowTypeKey   = "borrowType"
-	domainKey       = "domain"
-	identifierKey   = "identifier"
-	staticTypeKey   = "staticType"
-	addressKey      = "address"
-	pathKey         = "path"
-	authorizedKey   = "authorized"
-	sizeKey         = "size"
-	typeIDKey       = "typeID"
-	restrictionsKey = "restrictions"
-	labelKey        = "label"
-	parametersKey   = "parameters"
-	returnKey       = "return"
+	typeKey          = "type"
+	kindKey          = "kind"
+	valueKey         = "value"
+	keyKey           = "key"
+	nameKey          = "name"
+	fieldsKey        = "fields"
+	initializersKey  = "initializers"
+	idKey            = "id"
+	targetPathKey    = "targetPath"
+	borrowTypeKey    = "borrowType"
+	domainKey        = "domain"
+	identifierKey    = "identifier"
+	staticTypeKey    = "staticType"
+	addressKey       = "address"
+	pathKey          = "path"
+	authorizedKey    = "authorized"
+	sizeKey          = "size"
+	typeIDKey        = "typeID"
+	restrictionsKey  = "restrictions"
+	typeArgumentsKey = "typeArguments"
+	labelKey         = "label"
+	parametersKey    = "parameters"
+	returnKey        = "return"
 )
 
 var ErrInvalidJSONCadence = errors.NewDefaultUserError("invalid JSON Cadence structure")
@@ -203,14 +204,28 @@ func (d *Decoder) decodeJSON(v any) cadence.Value {
 		return d.decodeWord32(valueJSON)
 	case word64TypeStr:
 		return d.decodeWord64(valueJSON)
+	case word128TypeStr:
+		return d.decodeWord128(valueJSON)
+	case word256TypeStr:
+		return d.decodeWord256(valueJSON)
 	case fix64TypeStr:
 		return d.decodeFix64(valueJSON)
 	case ufix64TypeStr:
 		return d.decodeUFix64(valueJSON)
+	case fix128TypeStr:
+		return d.decodeFix128(valueJSON)
+	case ufix128TypeStr:
+		return d.decodeUFix128(valueJSON)
+	case timestampTypeStr:
+		return d.decodeTimestamp(valueJSON)
+	case durationTypeStr:
+		return d.decodeDuration(valueJSON)
 	case arrayTypeStr:
 		return d.decodeArray(valueJSON)
 	case dictionaryTypeStr:
 		return d.decodeDictionary(valueJSON)
+	case setTypeStr:
+		return d.decodeSet(valueJSON)
 	case resourceTypeStr:
 		return d.decodeResource(valueJSON)
 	case structTypeStr:
@@ -229,6 +244,8 @@ func (d *Decoder) decodeJSON(v any) cadence.Value {
 		return d.decodeCapability(valueJSON)
 	case enumTypeStr:
 		return d.decodeEnum(valueJSON)
+	case newtypeTypeStr:
+		return d.decodeNewtype(valueJSON)
 	}
 
 	panic(ErrInvalidJSONCadence)
@@ -550,6 +567,34 @@ func (d *Decoder) decodeWord64(valueJSON any) cadence.Word64 {
 	return cadence.NewMeteredWord64(d.gauge, i)
 }
 
+func (d *Decoder) decodeWord128(valueJSON any) cadence.Word128 {
+	value, err := cadence.NewMeteredWord128FromBig(
+		d.gauge,
+		func() *big.Int {
+			return d.decodeBigInt(valueJSON)
+		},
+	)
+	if err != nil {
+		// TODO: improve error message
+		panic(ErrInvalidJSONCadence)
+	}
+	return value
+}
+
+func (d *Decoder) decodeWord256(valueJSON any) cadence.Word256 {
+	value, err := cadence.NewMeteredWord256FromBig(
+		d.gauge,
+		func() *big.Int {
+			return d.decodeBigInt(valueJSON)
+		},
+	)
+	if err != nil {
+		// TODO: improve error message
+		panic(ErrInvalidJSONCadence)
+	}
+	return value
+}
+
 func (d *Decoder) decodeFix64(valueJSON any) cadence.Fix64 {
 	v, err := cadence.NewMeteredFix64(d.gauge, func() (string, error) {
 		return toString(valueJSON), nil
@@ -572,6 +617,52 @@ func (d *Decoder) decodeUFix64(valueJSON any) cadence.UFix64 {
 	return v
 }
 
+func (d *Decoder) decodeFix128(valueJSON any) cadence.Fix128 {
+	v, err := cadence.NewMeteredFix128(d.gauge, func() (string, error) {
+		return toString(valueJSON), nil
+	})
+	if err != nil {
+		// TODO: improve error message
+		panic(ErrInvalidJSONCadence)
+	}
+	return v
+}
+
+func (d *Decoder) decodeUFix128(valueJSON any) cadence.UFix128 {
+	v, err := cadence.NewMeteredUFix128(d.gauge, func() (string, error) {
+		return toString(valueJSON), nil
+	})
+	if err != nil {
+		// TODO: improve error message
+		panic(ErrInvalidJSONCadence)
+	}
+	return v
+}
+
+func (d *Decoder) decodeTimestamp(valueJSON any) cadence.Timestamp {
+	v := toString(valueJSON)
+
+	i, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || i < sema.TimestampTypeMinSeconds || i > sema.TimestampTypeMaxSeconds {
+		// TODO: improve error message
+		panic(ErrInvalidJSONCadence)
+	}
+
+	return cadence.NewMeteredTimestamp(d.gauge, i)
+}
+
+func (d *Decoder) decodeDuration(valueJSON any) cadence.Duration {
+	v := toString(valueJSON)
+
+	i, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		// TODO: improve error message
+		panic(ErrInvalidJSONCadence)
+	}
+
+	return cadence.NewMeteredDuration(d.gauge, i)
+}
+
 func (d *Decoder) decodeArray(valueJSON any) cadence.Array {
 	v := toSlice(valueJSON)
 
@@ -594,6 +685,27 @@ func (d *Decoder) decodeArray(valueJSON any) cadence.Array {
 	return value
 }
 
+func (d *Decoder) decodeSet(valueJSON any) cadence.Set {
+	v := toSlice(valueJSON)
+
+	value, err := cadence.NewMeteredSet(
+		d.gauge,
+		func() ([]cadence.Value, error) {
+			elements := make([]cadence.Value, len(v))
+			for i, val := range v {
+				elements[i] = d.decodeJSON(val)
+			}
+			return elements, nil
+		},
+	)
+
+	if err != nil {
+		// TODO: improve error message
+		panic(ErrInvalidJSONCadence)
+	}
+	return value
+}
+
 func (d *Decoder) decodeDictionary(valueJSON any) cadence.Dictionary {
 	v := toSlice(valueJSON)
 
@@ -635,6 +747,7 @@ func (d *Decoder) decodeKeyValuePair(valueJSON any) cadence.KeyValuePair {
 type composite struct {
 	location            common.Location
 	qualifiedIdentifier string
+	typeArguments       []cadence.Type
 	fieldValues         []cadence.Value
 	fieldTypes          []cadence.Field
 }
@@ -673,6 +786,7 @@ func (d *Decoder) decodeComposite(valueJSON any) composite {
 	return composite{
 		location:            location,
 		qualifiedIdentifier: qualifiedIdentifier,
+		typeArguments:       d.decodeTypeArguments(obj, typeDecodingResults{}),
 		fieldValues:         fieldValues,
 		fieldTypes:          fieldTypes,
 	}
@@ -706,13 +820,16 @@ func (d *Decoder) decodeStruct(valueJSON any) cadence.Struct {
 		panic(ErrInvalidJSONCadence)
 	}
 
-	return structure.WithType(cadence.NewMeteredStructType(
+	structType := cadence.NewMeteredStructType(
 		d.gauge,
 		comp.location,
 		comp.qualifiedIdentifier,
 		comp.fieldTypes,
 		nil,
-	))
+	)
+	structType.TypeArguments = comp.typeArguments
+
+	return structure.WithType(structType)
 }
 
 func (d *Decoder) decodeResource(valueJSON any) cadence.Resource {
@@ -729,13 +846,16 @@ func (d *Decoder) decodeResource(valueJSON any) cadence.Resource {
 	if err != nil {
 		panic(ErrInvalidJSONCadence)
 	}
-	return resource.WithType(cadence.NewMeteredResourceType(
+	resourceType := cadence.NewMeteredResourceType(
 		d.gauge,
 		comp.location,
 		comp.qualifiedIdentifier,
 		comp.fieldTypes,
 		nil,
-	))
+	)
+	resourceType.TypeArguments = comp.typeArguments
+
+	return resource.WithType(resourceType)
 }
 
 func (d *Decoder) decodeEvent(valueJSON any) cadence.Event {
@@ -811,6 +931,34 @@ func (d *Decoder) decodeEnum(valueJSON any) cadence.Enum {
 	))
 }
 
+func (d *Decoder) decodeNewtype(valueJSON any) cadence.Newtype {
+	comp := d.decodeComposite(valueJSON)
+
+	obj := toObject(valueJSON)
+	underlyingType := d.decodeType(obj.Get(typeKey), typeDecodingResults{})
+
+	newtype, err := cadence.NewMeteredNewtype(
+		d.gauge,
+		len(comp.fieldValues),
+		func() ([]cadence.Value, error) {
+			return comp.fieldValues, nil
+		},
+	)
+
+	if err != nil {
+		panic(ErrInvalidJSONCadence)
+	}
+
+	return newtype.WithType(cadence.NewMeteredNewtypeType(
+		d.gauge,
+		comp.location,
+		comp.qualifiedIdentifier,
+		underlyingType,
+		comp.fieldTypes,
+		nil,
+	))
+}
+
 func (d *Decoder) decodeLink(valueJSON any) cadence.Link {
 	obj := toObject(valueJSON)
 
@@ -947,22 +1095,26 @@ func (d *Decoder) decodeNominalType(
 
 	switch kind {
 	case "Struct":
-		compositeType = cadence.NewMeteredStructType(
+		structType := cadence.NewMeteredStructType(
 			d.gauge,
 			location,
 			qualifiedIdentifier,
 			nil,
 			inits,
 		)
+		structType.TypeArguments = d.decodeTypeArguments(obj, results)
+		compositeType = structType
 		result = compositeType
 	case "Resource":
-		compositeType = cadence.NewMeteredResourceType(
+		resourceType := cadence.NewMeteredResourceType(
 			d.gauge,
 			location,
 			qualifiedIdentifier,
 			nil,
 			inits,
 		)
+		resourceType.TypeArguments = d.decodeTypeArguments(obj, results)
+		compositeType = resourceType
 		result = compositeType
 	case "Event":
 		compositeType = cadence.NewMeteredEventType(
@@ -1019,11 +1171,23 @@ func (d *Decoder) decodeNominalType(
 			inits,
 		)
 		result = compositeType
+	case "Newtype":
+		compositeType = cadence.NewMeteredNewtypeType(
+			d.gauge,
+			location,
+			qualifiedIdentifier,
+			d.decodeType(obj.Get(typeKey), results),
+			nil,
+			inits,
+		)
+		result = compositeType
 	default:
 		panic(ErrInvalidJSONCadence)
 	}
 
-	results[typeID] = result
+	// NOTE: the ID of an instantiated generic composite type includes the type arguments
+
+	results[result.ID()] = result
 
 	fields := d.decodeFieldTypes(fs, results)
 
@@ -1037,6 +1201,21 @@ func (d *Decoder) decodeNominalType(
 	return result
 }
 
+func (d *Decoder) decodeTypeArguments(obj jsonObject, results typeDecodingResults) []cadence.Type {
+	typeArgumentsValue, ok := obj[typeArgumentsKey]
+	if !ok {
+		return nil
+	}
+
+	typeArgumentsValues := toSlice(typeArgumentsValue)
+
+	typeArguments := make([]cadence.Type, 0, len(typeArgumentsValues))
+	for _, typeArgumentValue := range typeArgumentsValues {
+		typeArguments = append(typeArguments, d.decodeType(typeArgumentValue, results))
+	}
+	return typeArguments
+}
+
 func (d *Decoder) decodeRestrictedType(
 	typeValue any,
 	restrictionsValue []any,
@@ -1110,6 +1289,11 @@ func (d *Decoder) decodeType(valueJSON any, results typeDecodingResults) cadence
 			d.gauge,
 			d.decodeType(obj.Get(typeKey), results),
 		)
+	case "Set":
+		return cadence.NewMeteredSetType(
+			d.gauge,
+			d.decodeType(obj.Get(typeKey), results),
+		)
 	case "Dictionary":
 		return cadence.NewMeteredDictionaryType(
 			d.gauge,
@@ -1200,10 +1384,22 @@ func (d *Decoder) decodeType(valueJSON any, results typeDecodingResults) cadence
 		return cadence.NewMeteredWord32Type(d.gauge)
 	case "Word64":
 		return cadence.NewMeteredWord64Type(d.gauge)
+	case "Word128":
+		return cadence.NewMeteredWord128Type(d.gauge)
+	case "Word256":
+		return cadence.NewMeteredWord256Type(d.gauge)
 	case "Fix64":
 		return cadence.NewMeteredFix64Type(d.gauge)
 	case "UFix64":
 		return cadence.NewMeteredUFix64Type(d.gauge)
+	case "Fix128":
+		return cadence.NewMeteredFix128Type(d.gauge)
+	case "UFix128":
+		return cadence.NewMeteredUFix128Type(d.gauge)
+	case "Timestamp":
+		return cadence.NewMeteredTimestampType(d.gauge)
+	case "Duration":
+		return cadence.NewMeteredDurationType(d.gauge)
 	case "Path":
 		return cadence.NewMeteredPathType(d.gauge)
 	case "CapabilityPath":
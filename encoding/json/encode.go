@@ -117,8 +117,10 @@ type jsonDictionaryItem struct {
 }
 
 type jsonCompositeValue struct {
-	ID     string               `json:"id"`
-	Fields []jsonCompositeField `json:"fields"`
+	ID            string               `json:"id"`
+	Type          jsonValue            `json:"type,omitempty"`
+	TypeArguments []jsonValue          `json:"typeArguments,omitempty"`
+	Fields        []jsonCompositeField `json:"fields"`
 }
 
 type jsonCompositeField struct {
@@ -142,11 +144,12 @@ type jsonFieldType struct {
 }
 
 type jsonNominalType struct {
-	Kind         string                `json:"kind"`
-	TypeID       string                `json:"typeID"`
-	Fields       []jsonFieldType       `json:"fields"`
-	Initializers [][]jsonParameterType `json:"initializers"`
-	Type         jsonValue             `json:"type"`
+	Kind          string                `json:"kind"`
+	TypeID        string                `json:"typeID"`
+	TypeArguments []jsonValue           `json:"typeArguments,omitempty"`
+	Fields        []jsonFieldType       `json:"fields"`
+	Initializers  [][]jsonParameterType `json:"initializers"`
+	Type          jsonValue             `json:"type"`
 }
 
 type jsonSimpleType struct {
@@ -231,10 +234,17 @@ const (
 	word16TypeStr     = "Word16"
 	word32TypeStr     = "Word32"
 	word64TypeStr     = "Word64"
+	word128TypeStr    = "Word128"
+	word256TypeStr    = "Word256"
 	fix64TypeStr      = "Fix64"
 	ufix64TypeStr     = "UFix64"
+	fix128TypeStr     = "Fix128"
+	ufix128TypeStr    = "UFix128"
+	timestampTypeStr  = "Timestamp"
+	durationTypeStr   = "Duration"
 	arrayTypeStr      = "Array"
 	dictionaryTypeStr = "Dictionary"
+	setTypeStr        = "Set"
 	structTypeStr     = "Struct"
 	resourceTypeStr   = "Resource"
 	eventTypeStr      = "Event"
@@ -244,6 +254,7 @@ const (
 	typeTypeStr       = "Type"
 	capabilityTypeStr = "Capability"
 	enumTypeStr       = "Enum"
+	newtypeTypeStr    = "Newtype"
 )
 
 // Prepare traverses the object graph of the provided value and constructs
@@ -298,14 +309,28 @@ func Prepare(v cadence.Value) jsonValue {
 		return prepareWord32(x)
 	case cadence.Word64:
 		return prepareWord64(x)
+	case cadence.Word128:
+		return prepareWord128(x)
+	case cadence.Word256:
+		return prepareWord256(x)
 	case cadence.Fix64:
 		return prepareFix64(x)
 	case cadence.UFix64:
 		return prepareUFix64(x)
+	case cadence.Fix128:
+		return prepareFix128(x)
+	case cadence.UFix128:
+		return prepareUFix128(x)
+	case cadence.Timestamp:
+		return prepareTimestamp(x)
+	case cadence.Duration:
+		return prepareDuration(x)
 	case cadence.Array:
 		return prepareArray(x)
 	case cadence.Dictionary:
 		return prepareDictionary(x)
+	case cadence.Set:
+		return prepareSet(x)
 	case cadence.Struct:
 		return prepareStruct(x)
 	case cadence.Resource:
@@ -324,6 +349,8 @@ func Prepare(v cadence.Value) jsonValue {
 		return prepareCapability(x)
 	case cadence.Enum:
 		return prepareEnum(x)
+	case cadence.Newtype:
+		return prepareNewtype(x)
 	default:
 		panic(fmt.Errorf("unsupported value: %T, %v", v, v))
 	}
@@ -500,6 +527,20 @@ func prepareWord64(v cadence.Word64) jsonValue {
 	}
 }
 
+func prepareWord128(v cadence.Word128) jsonValue {
+	return jsonValueObject{
+		Type:  word128TypeStr,
+		Value: encodeBig(v.Big()),
+	}
+}
+
+func prepareWord256(v cadence.Word256) jsonValue {
+	return jsonValueObject{
+		Type:  word256TypeStr,
+		Value: encodeBig(v.Big()),
+	}
+}
+
 func prepareFix64(v cadence.Fix64) jsonValue {
 	return jsonValueObject{
 		Type:  fix64TypeStr,
@@ -514,6 +555,34 @@ func prepareUFix64(v cadence.UFix64) jsonValue {
 	}
 }
 
+func prepareFix128(v cadence.Fix128) jsonValue {
+	return jsonValueObject{
+		Type:  fix128TypeStr,
+		Value: v.String(),
+	}
+}
+
+func prepareUFix128(v cadence.UFix128) jsonValue {
+	return jsonValueObject{
+		Type:  ufix128TypeStr,
+		Value: v.String(),
+	}
+}
+
+func prepareTimestamp(v cadence.Timestamp) jsonValue {
+	return jsonValueObject{
+		Type:  timestampTypeStr,
+		Value: encodeInt(int64(v)),
+	}
+}
+
+func prepareDuration(v cadence.Duration) jsonValue {
+	return jsonValueObject{
+		Type:  durationTypeStr,
+		Value: encodeInt(int64(v)),
+	}
+}
+
 func prepareArray(v cadence.Array) jsonValue {
 	values := make([]jsonValue, len(v.Values))
 
@@ -543,12 +612,39 @@ func prepareDictionary(v cadence.Dictionary) jsonValue {
 	}
 }
 
+func prepareSet(v cadence.Set) jsonValue {
+	elements := make([]jsonValue, len(v.Elements))
+
+	for i, element := range v.Elements {
+		elements[i] = Prepare(element)
+	}
+
+	return jsonValueObject{
+		Type:  setTypeStr,
+		Value: elements,
+	}
+}
+
 func prepareStruct(v cadence.Struct) jsonValue {
-	return prepareComposite(structTypeStr, v.StructType.ID(), v.StructType.Fields, v.Fields)
+	return prepareGenericComposite(
+		structTypeStr,
+		v.StructType.Location,
+		v.StructType.QualifiedIdentifier,
+		v.StructType.TypeArguments,
+		v.StructType.Fields,
+		v.Fields,
+	)
 }
 
 func prepareResource(v cadence.Resource) jsonValue {
-	return prepareComposite(resourceTypeStr, v.ResourceType.ID(), v.ResourceType.Fields, v.Fields)
+	return prepareGenericComposite(
+		resourceTypeStr,
+		v.ResourceType.Location,
+		v.ResourceType.QualifiedIdentifier,
+		v.ResourceType.TypeArguments,
+		v.ResourceType.Fields,
+		v.Fields,
+	)
 }
 
 func prepareEvent(v cadence.Event) jsonValue {
@@ -563,7 +659,46 @@ func prepareEnum(v cadence.Enum) jsonValue {
 	return prepareComposite(enumTypeStr, v.EnumType.ID(), v.EnumType.Fields, v.Fields)
 }
 
-func prepareComposite(kind, id string, fieldTypes []cadence.Field, fields []cadence.Value) jsonValue {
+func prepareNewtype(v cadence.Newtype) jsonValue {
+	newtype := prepareComposite(newtypeTypeStr, v.NewtypeType.ID(), v.NewtypeType.Fields, v.Fields)
+
+	// The underlying type of a newtype is not necessarily the dynamic type of its value,
+	// so it is encoded explicitly
+
+	compositeValue, ok := newtype.Value.(jsonCompositeValue)
+	if !ok {
+		panic(fmt.Errorf("invalid newtype value: %s", v))
+	}
+
+	compositeValue.Type = prepareType(v.NewtypeType.UnderlyingType, typePreparationResults{})
+	newtype.Value = compositeValue
+
+	return newtype
+}
+
+// prepareGenericComposite prepares a value of a possibly instantiated generic composite type.
+// Like for types, the ID is the ID of the generic type, and the type arguments are encoded separately
+//
+func prepareGenericComposite(
+	kind string,
+	location common.Location,
+	qualifiedIdentifier string,
+	typeArguments []cadence.Type,
+	fieldTypes []cadence.Field,
+	fields []cadence.Value,
+) jsonValue {
+	composite := prepareComposite(kind, typeId(location, qualifiedIdentifier), fieldTypes, fields)
+
+	if len(typeArguments) > 0 {
+		compositeValue := composite.Value.(jsonCompositeValue)
+		compositeValue.TypeArguments = prepareTypeArguments(typeArguments, typePreparationResults{})
+		composite.Value = compositeValue
+	}
+
+	return composite
+}
+
+func prepareComposite(kind, id string, fieldTypes []cadence.Field, fields []cadence.Value) jsonValueObject {
 	nonFunctionFieldTypes := make([]cadence.Field, 0)
 
 	for _, field := range fieldTypes {
@@ -652,6 +787,18 @@ func prepareParameters(parameterTypes []cadence.Parameter, results typePreparati
 	return parameters
 }
 
+func prepareTypeArguments(typeArguments []cadence.Type, results typePreparationResults) []jsonValue {
+	if len(typeArguments) == 0 {
+		return nil
+	}
+
+	preparedTypeArguments := make([]jsonValue, 0, len(typeArguments))
+	for _, typeArgument := range typeArguments {
+		preparedTypeArguments = append(preparedTypeArguments, prepareType(typeArgument, results))
+	}
+	return preparedTypeArguments
+}
+
 func prepareInitializers(initializerTypes [][]cadence.Parameter, results typePreparationResults) [][]jsonParameterType {
 	initializers := make([][]jsonParameterType, 0)
 	for _, params := range initializerTypes {
@@ -716,8 +863,14 @@ func prepareType(typ cadence.Type, results typePreparationResults) jsonValue {
 		cadence.Word16Type,
 		cadence.Word32Type,
 		cadence.Word64Type,
+		cadence.Word128Type,
+		cadence.Word256Type,
 		cadence.Fix64Type,
 		cadence.UFix64Type,
+		cadence.Fix128Type,
+		cadence.UFix128Type,
+		cadence.TimestampType,
+		cadence.DurationType,
 		cadence.BlockType,
 		cadence.PathType,
 		cadence.CapabilityPathType,
@@ -757,21 +910,28 @@ func prepareType(typ cadence.Type, results typePreparationResults) jsonValue {
 			KeyType:   prepareType(typ.KeyType, results),
 			ValueType: prepareType(typ.ElementType, results),
 		}
+	case cadence.SetType:
+		return jsonUnaryType{
+			Kind: "Set",
+			Type: prepareType(typ.ElementType, results),
+		}
 	case *cadence.StructType:
 		return jsonNominalType{
-			Kind:         "Struct",
-			Type:         "",
-			TypeID:       typeId(typ.Location, typ.QualifiedIdentifier),
-			Fields:       prepareFields(typ.Fields, results),
-			Initializers: prepareInitializers(typ.Initializers, results),
+			Kind:          "Struct",
+			Type:          "",
+			TypeID:        typeId(typ.Location, typ.QualifiedIdentifier),
+			TypeArguments: prepareTypeArguments(typ.TypeArguments, results),
+			Fields:        prepareFields(typ.Fields, results),
+			Initializers:  prepareInitializers(typ.Initializers, results),
 		}
 	case *cadence.ResourceType:
 		return jsonNominalType{
-			Kind:         "Resource",
-			Type:         "",
-			TypeID:       typeId(typ.Location, typ.QualifiedIdentifier),
-			Fields:       prepareFields(typ.Fields, results),
-			Initializers: prepareInitializers(typ.Initializers, results),
+			Kind:          "Resource",
+			Type:          "",
+			TypeID:        typeId(typ.Location, typ.QualifiedIdentifier),
+			TypeArguments: prepareTypeArguments(typ.TypeArguments, results),
+			Fields:        prepareFields(typ.Fields, results),
+			Initializers:  prepareInitializers(typ.Initializers, results),
 		}
 	case *cadence.EventType:
 		return jsonNominalType{
@@ -850,6 +1010,14 @@ func prepareType(typ cadence.Type, results typePreparationResults) jsonValue {
 			Initializers: prepareInitializers(typ.Initializers, results),
 			Type:         prepareType(typ.RawType, results),
 		}
+	case *cadence.NewtypeType:
+		return jsonNominalType{
+			Kind:         "Newtype",
+			TypeID:       typeId(typ.Location, typ.QualifiedIdentifier),
+			Fields:       prepareFields(typ.Fields, results),
+			Initializers: prepareInitializers(typ.Initializers, results),
+			Type:         prepareType(typ.UnderlyingType, results),
+		}
 	case nil:
 		return ""
 	default:
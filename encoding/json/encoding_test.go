@@ -544,6 +544,104 @@ func TestEncodeWord64(t *testing.T) {
 	}...)
 }
 
+func TestEncodeWord128(t *testing.T) {
+
+	t.Parallel()
+
+	testAllEncodeAndDecode(t, []encodeTest{
+		{
+			"Zero",
+			cadence.NewWord128(0),
+			`{"type":"Word128","value":"0"}`,
+		},
+		{
+			"Max",
+			cadence.Word128{Value: sema.Word128TypeMaxIntBig},
+			`{"type":"Word128","value":"340282366920938463463374607431768211455"}`,
+		},
+	}...)
+}
+
+func TestEncodeWord256(t *testing.T) {
+
+	t.Parallel()
+
+	testAllEncodeAndDecode(t, []encodeTest{
+		{
+			"Zero",
+			cadence.NewWord256(0),
+			`{"type":"Word256","value":"0"}`,
+		},
+		{
+			"Max",
+			cadence.Word256{Value: sema.Word256TypeMaxIntBig},
+			`{"type":"Word256","value":"115792089237316195423570985008687907853269984665640564039457584007913129639935"}`,
+		},
+	}...)
+}
+
+func TestEncodeTimestamp(t *testing.T) {
+
+	t.Parallel()
+
+	testAllEncodeAndDecode(t, []encodeTest{
+		{
+			"Epoch",
+			cadence.NewTimestamp(0),
+			`{"type":"Timestamp","value":"0"}`,
+		},
+		{
+			"Positive",
+			cadence.NewTimestamp(1584129163),
+			`{"type":"Timestamp","value":"1584129163"}`,
+		},
+		{
+			"Negative",
+			cadence.NewTimestamp(-42),
+			`{"type":"Timestamp","value":"-42"}`,
+		},
+		{
+			"Min",
+			cadence.NewTimestamp(sema.TimestampTypeMinSeconds),
+			`{"type":"Timestamp","value":"-62167219200"}`,
+		},
+		{
+			"Max",
+			cadence.NewTimestamp(sema.TimestampTypeMaxSeconds),
+			`{"type":"Timestamp","value":"253402300799"}`,
+		},
+	}...)
+}
+
+func TestDecodeTimestampOutOfRange(t *testing.T) {
+
+	t.Parallel()
+
+	for _, value := range []string{"-62167219201", "253402300800"} {
+
+		_, err := json.Decode(nil, []byte(fmt.Sprintf(`{"type":"Timestamp","value":"%s"}`, value)))
+		require.Error(t, err)
+	}
+}
+
+func TestEncodeDuration(t *testing.T) {
+
+	t.Parallel()
+
+	testAllEncodeAndDecode(t, []encodeTest{
+		{
+			"Zero",
+			cadence.NewDuration(0),
+			`{"type":"Duration","value":"0"}`,
+		},
+		{
+			"Day",
+			cadence.NewDuration(86400),
+			`{"type":"Duration","value":"86400"}`,
+		},
+	}...)
+}
+
 func TestEncodeFix64(t *testing.T) {
 
 	t.Parallel()
@@ -595,6 +693,57 @@ func TestEncodeUFix64(t *testing.T) {
 	}...)
 }
 
+func TestEncodeFix128(t *testing.T) {
+
+	t.Parallel()
+
+	testAllEncodeAndDecode(t, []encodeTest{
+		{
+			"Zero",
+			cadence.Fix128{Value: big.NewInt(0)},
+			`{"type":"Fix128","value":"0.000000000000000000000000"}`,
+		},
+		{
+			"-12345.006789",
+			cadence.Fix128{Value: new(big.Int).Mul(big.NewInt(-12345_006789), big.NewInt(1e18))},
+			`{"type":"Fix128","value":"-12345.006789000000000000000000"}`,
+		},
+		{
+			"Min",
+			cadence.Fix128{Value: sema.Fix128TypeMinBig},
+			`{"type":"Fix128","value":"-170141183460469.231731687303715884105728"}`,
+		},
+		{
+			"Max",
+			cadence.Fix128{Value: sema.Fix128TypeMaxBig},
+			`{"type":"Fix128","value":"170141183460469.231731687303715884105727"}`,
+		},
+	}...)
+}
+
+func TestEncodeUFix128(t *testing.T) {
+
+	t.Parallel()
+
+	testAllEncodeAndDecode(t, []encodeTest{
+		{
+			"Zero",
+			cadence.UFix128{Value: big.NewInt(0)},
+			`{"type":"UFix128","value":"0.000000000000000000000000"}`,
+		},
+		{
+			"Smallest",
+			cadence.UFix128{Value: big.NewInt(1)},
+			`{"type":"UFix128","value":"0.000000000000000000000001"}`,
+		},
+		{
+			"Max",
+			cadence.UFix128{Value: sema.UFix128TypeMaxBig},
+			`{"type":"UFix128","value":"340282366920938.463463374607431768211455"}`,
+		},
+	}...)
+}
+
 func TestEncodeArray(t *testing.T) {
 
 	t.Parallel()
@@ -727,6 +876,32 @@ func TestEncodeDictionary(t *testing.T) {
 	)
 }
 
+func TestEncodeSet(t *testing.T) {
+
+	t.Parallel()
+
+	emptySet := encodeTest{
+		"Empty",
+		cadence.NewSet([]cadence.Value{}),
+		`{"type":"Set","value":[]}`,
+	}
+
+	stringSet := encodeTest{
+		"Strings",
+		cadence.NewSet([]cadence.Value{
+			cadence.String("a"),
+			cadence.String("b"),
+			cadence.String("c"),
+		}),
+		`{"type":"Set","value":[{"type":"String","value":"a"},{"type":"String","value":"b"},{"type":"String","value":"c"}]}`,
+	}
+
+	testAllEncodeAndDecode(t,
+		emptySet,
+		stringSet,
+	)
+}
+
 func exportFromScript(t *testing.T, code string) cadence.Value {
 	checker, err := checker.ParseAndCheck(t, code)
 	require.NoError(t, err)
@@ -913,7 +1088,31 @@ func TestEncodeStruct(t *testing.T) {
 		`{"type":"Struct","value":{"id":"S.test.FooStruct","fields":[{"name":"a","value":{"type":"String","value":"foo"}},{"name":"b","value":{"type":"Resource","value":{"id":"S.test.Foo","fields":[{"name":"bar","value":{"type":"Int","value":"42"}}]}}}]}}`,
 	}
 
-	testAllEncodeAndDecode(t, simpleStruct, resourceStruct)
+	genericStructType := &cadence.StructType{
+		Location:            utils.TestLocation,
+		QualifiedIdentifier: "Box",
+		TypeArguments: []cadence.Type{
+			cadence.IntType{},
+		},
+		Fields: []cadence.Field{
+			{
+				Identifier: "value",
+				Type:       cadence.IntType{},
+			},
+		},
+	}
+
+	genericStruct := encodeTest{
+		"Generic",
+		cadence.NewStruct(
+			[]cadence.Value{
+				cadence.NewInt(1),
+			},
+		).WithType(genericStructType),
+		`{"type":"Struct","value":{"id":"S.test.Box","typeArguments":[{"kind":"Int"}],"fields":[{"name":"value","value":{"type":"Int","value":"1"}}]}}`,
+	}
+
+	testAllEncodeAndDecode(t, simpleStruct, resourceStruct, genericStruct)
 }
 
 func TestEncodeEvent(t *testing.T) {
@@ -979,6 +1178,123 @@ func TestEncodeEvent(t *testing.T) {
 	testAllEncodeAndDecode(t, simpleEvent, resourceEvent)
 }
 
+func TestEncodeNewtype(t *testing.T) {
+
+	t.Parallel()
+
+	newtypeType := &cadence.NewtypeType{
+		Location:            utils.TestLocation,
+		QualifiedIdentifier: "PersonID",
+		UnderlyingType:      cadence.StringType{},
+		Fields: []cadence.Field{
+			{
+				Identifier: "value",
+				Type:       cadence.StringType{},
+			},
+		},
+	}
+
+	newtype := cadence.NewNewtype(
+		[]cadence.Value{
+			cadence.String("alice"),
+		},
+	).WithType(newtypeType)
+
+	actualJSON := testEncode(
+		t,
+		newtype,
+		`{"type":"Newtype","value":{"id":"S.test.PersonID","type":{"kind":"String"},"fields":[{"name":"value","value":{"type":"String","value":"alice"}}]}}`,
+	)
+
+	decoded, err := json.Decode(nil, []byte(actualJSON))
+	require.NoError(t, err)
+
+	assert.Equal(t, newtype, decoded)
+	assert.Equal(t, newtypeType, decoded.Type())
+}
+
+func TestEncodeNewtypePrimitive(t *testing.T) {
+
+	t.Parallel()
+
+	newtype := func(underlyingType cadence.Type, value cadence.Value) cadence.Newtype {
+		return cadence.NewNewtype(
+			[]cadence.Value{value},
+		).WithType(&cadence.NewtypeType{
+			Location:            utils.TestLocation,
+			QualifiedIdentifier: "N",
+			UnderlyingType:      underlyingType,
+			Fields: []cadence.Field{
+				{
+					Identifier: "value",
+					Type:       underlyingType,
+				},
+			},
+		})
+	}
+
+	testAllEncodeAndDecode(t,
+		encodeTest{
+			"Int",
+			newtype(cadence.IntType{}, cadence.NewInt(-42)),
+			`{"type":"Newtype","value":{"id":"S.test.N","type":{"kind":"Int"},"fields":[{"name":"value","value":{"type":"Int","value":"-42"}}]}}`,
+		},
+		encodeTest{
+			"UInt8",
+			newtype(cadence.UInt8Type{}, cadence.NewUInt8(8)),
+			`{"type":"Newtype","value":{"id":"S.test.N","type":{"kind":"UInt8"},"fields":[{"name":"value","value":{"type":"UInt8","value":"8"}}]}}`,
+		},
+		encodeTest{
+			"UFix64",
+			newtype(cadence.UFix64Type{}, cadence.UFix64(150000000)),
+			`{"type":"Newtype","value":{"id":"S.test.N","type":{"kind":"UFix64"},"fields":[{"name":"value","value":{"type":"UFix64","value":"1.50000000"}}]}}`,
+		},
+		encodeTest{
+			"Bool",
+			newtype(cadence.BoolType{}, cadence.NewBool(true)),
+			`{"type":"Newtype","value":{"id":"S.test.N","type":{"kind":"Bool"},"fields":[{"name":"value","value":{"type":"Bool","value":true}}]}}`,
+		},
+		encodeTest{
+			"Address",
+			newtype(cadence.AddressType{}, cadence.NewAddress([8]byte{0, 0, 0, 0, 0, 0, 0, 1})),
+			`{"type":"Newtype","value":{"id":"S.test.N","type":{"kind":"Address"},"fields":[{"name":"value","value":{"type":"Address","value":"0x0000000000000001"}}]}}`,
+		},
+		encodeTest{
+			"Optional",
+			newtype(
+				cadence.OptionalType{Type: cadence.StringType{}},
+				cadence.NewOptional(cadence.String("a")),
+			),
+			`{"type":"Newtype","value":{"id":"S.test.N","type":{"kind":"Optional","type":{"kind":"String"}},"fields":[{"name":"value","value":{"type":"Optional","value":{"type":"String","value":"a"}}}]}}`,
+		},
+	)
+}
+
+func TestEncodeNewtypeInvalidFields(t *testing.T) {
+
+	t.Parallel()
+
+	newtype := cadence.NewNewtype(
+		[]cadence.Value{
+			cadence.NewInt(1),
+			cadence.NewInt(2),
+		},
+	).WithType(&cadence.NewtypeType{
+		Location:            utils.TestLocation,
+		QualifiedIdentifier: "N",
+		UnderlyingType:      cadence.IntType{},
+		Fields: []cadence.Field{
+			{
+				Identifier: "value",
+				Type:       cadence.IntType{},
+			},
+		},
+	})
+
+	_, err := json.Encode(newtype)
+	require.Error(t, err)
+}
+
 func TestEncodeContract(t *testing.T) {
 
 	t.Parallel()
@@ -1096,8 +1412,14 @@ func TestEncodeSimpleTypes(t *testing.T) {
 		cadence.Word16Type{},
 		cadence.Word32Type{},
 		cadence.Word64Type{},
+		cadence.Word128Type{},
+		cadence.Word256Type{},
 		cadence.Fix64Type{},
 		cadence.UFix64Type{},
+		cadence.Fix128Type{},
+		cadence.UFix128Type{},
+		cadence.TimestampType{},
+		cadence.DurationType{},
 		cadence.BlockType{},
 		cadence.PathType{},
 		cadence.CapabilityPathType{},
@@ -1185,6 +1507,20 @@ func TestEncodeType(t *testing.T) {
 
 	})
 
+	t.Run("with static {int}", func(t *testing.T) {
+
+		testEncodeAndDecode(
+			t,
+			cadence.TypeValue{
+				StaticType: cadence.SetType{
+					ElementType: cadence.IntType{},
+				},
+			},
+			`{"type":"Type","value":{"staticType":{"kind":"Set", "type" : {"kind" : "Int"}}}}`,
+		)
+
+	})
+
 	t.Run("with static struct", func(t *testing.T) {
 
 		testEncodeAndDecode(
@@ -1219,6 +1555,40 @@ func TestEncodeType(t *testing.T) {
 		)
 	})
 
+	t.Run("with static struct, type arguments", func(t *testing.T) {
+
+		testEncodeAndDecode(
+			t,
+			cadence.TypeValue{
+				StaticType: &cadence.StructType{
+					Location:            utils.TestLocation,
+					QualifiedIdentifier: "Box",
+					TypeArguments: []cadence.Type{
+						cadence.IntType{},
+					},
+					Fields: []cadence.Field{
+						{Identifier: "value", Type: cadence.IntType{}},
+					},
+					Initializers: [][]cadence.Parameter{},
+				},
+			},
+			`{"type":"Type", "value": {"staticType":
+					{"kind": "Struct",
+					 "type" : "",
+					 "typeID" : "S.test.Box",
+					 "typeArguments" : [
+						  {"kind" : "Int"}
+					    ],
+					 "fields" : [
+						  {"id" : "value", "type": {"kind" : "Int"} }
+					    ],
+					 "initializers" : []
+					}
+				}
+			}`,
+		)
+	})
+
 	t.Run("with static resource", func(t *testing.T) {
 
 		testEncodeAndDecode(
@@ -1457,6 +1827,39 @@ func TestEncodeType(t *testing.T) {
 		)
 	})
 
+	t.Run("with static newtype", func(t *testing.T) {
+
+		testEncodeAndDecode(
+			t,
+			cadence.TypeValue{
+				StaticType: &cadence.NewtypeType{
+					Location:            utils.TestLocation,
+					QualifiedIdentifier: "PersonID",
+					UnderlyingType:      cadence.StringType{},
+					Fields: []cadence.Field{
+						{Identifier: "value", Type: cadence.StringType{}},
+					},
+					Initializers: [][]cadence.Parameter{
+						{{Label: "_", Identifier: "value", Type: cadence.StringType{}}},
+					},
+				},
+			},
+			`{"type":"Type", "value": {"staticType":
+					{"kind": "Newtype",
+					 "type" : {"kind" : "String"},
+					 "typeID" : "S.test.PersonID",
+					 "fields" : [
+						  {"id" : "value", "type": {"kind" : "String"} }
+					    ],
+					 "initializers" : [
+						  [{"label" : "_", "id" : "value", "type": {"kind" : "String"}}]
+						]
+					}
+				}
+			}`,
+		)
+	})
+
 	t.Run("with static &int", func(t *testing.T) {
 
 		testEncodeAndDecode(
@@ -2121,6 +2524,13 @@ func TestEncodeBuiltinComposites(t *testing.T) {
 			},
 			kind: "Event",
 		},
+		{
+			typ: &cadence.NewtypeType{
+				Location:            nil,
+				QualifiedIdentifier: "Foo",
+			},
+			kind: "Newtype",
+		},
 	}
 
 	const compositeJson = `{"type":"Type","value":{"staticType":{"kind":"%s","typeID":"Foo","fields":[],"initializers":[],"type":""}}}`
@@ -22,6 +22,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/onflow/cadence/runtime/sema"
 )
 
 func TestDocument_Offset(t *testing.T) {
@@ -59,3 +61,25 @@ func TestDocument_HasAnyPrecedingStringsAtPosition(t *testing.T) {
 		assert.False(t, doc.HasAnyPrecedingStringsAtPosition([]string{"access(self)"}, 2, 2))
 	})
 }
+
+func TestDocument_TextInRange(t *testing.T) {
+
+	t.Parallel()
+
+	doc := Document{Text: "typealias ID = UInt64\nlet id: ID = 1"}
+
+	assert.Equal(t,
+		"ID",
+		doc.TextInRange(
+			sema.Position{Line: 2, Column: 8},
+			sema.Position{Line: 2, Column: 9},
+		),
+	)
+	assert.Equal(t,
+		"",
+		doc.TextInRange(
+			sema.Position{Line: 2, Column: 8},
+			sema.Position{Line: 2, Column: 20},
+		),
+	)
+}
@@ -145,6 +145,18 @@ func (d Document) HasAnyPrecedingStringsAtPosition(options []string, line, colum
 	return false
 }
 
+// TextInRange returns the text of the document between the given positions (inclusive),
+// or the empty string if the positions are not in the document
+//
+func (d Document) TextInRange(startPos, endPos sema.Position) string {
+	startOffset := d.Offset(startPos.Line, startPos.Column)
+	endOffset := d.Offset(endPos.Line, endPos.Column)
+	if startOffset < 0 || endOffset >= len(d.Text) || startOffset > endOffset {
+		return ""
+	}
+	return d.Text[startOffset : endOffset+1]
+}
+
 // CommandHandler represents the form of functions that handle commands
 // submitted from the client using workspace/executeCommand.
 type CommandHandler func(conn protocol.Conn, args ...json2.RawMessage) (interface{}, error)
@@ -611,11 +623,27 @@ func (s *Server) Hover(
 
 	var markup strings.Builder
 
-	_, _ = fmt.Fprintf(
-		&markup,
-		"**Type**\n\n```cadence\n%s\n```\n",
-		documentType(occurrence.Origin.Type),
-	)
+	// Type aliases are transparent, so the origin's type is the aliased type.
+	// Show the alias itself, so it is clear that the type is an alias.
+	//
+	// NOTE: The declaration kind is compared by keyword,
+	// as the language server may be built against a version of Cadence
+	// which does not support type aliases yet
+
+	if occurrence.Origin.DeclarationKind.Keywords() == "typealias" {
+		_, _ = fmt.Fprintf(
+			&markup,
+			"**Type Alias**\n\n```cadence\ntypealias %s = %s\n```\n",
+			s.documents[uri].TextInRange(occurrence.StartPos, occurrence.EndPos),
+			documentType(occurrence.Origin.Type),
+		)
+	} else {
+		_, _ = fmt.Fprintf(
+			&markup,
+			"**Type**\n\n```cadence\n%s\n```\n",
+			documentType(occurrence.Origin.Type),
+		)
+	}
 
 	docString := occurrence.Origin.DocString
 	if docString != "" {
@@ -747,6 +775,16 @@ func (s *Server) SignatureHelp(
 			)
 		}
 
+		// Show the default argument, if any, as the argument may be omitted
+
+		if defaultArgument := parameterDefaultArgument(parameter); defaultArgument != "" {
+			signatureLabelPart = fmt.Sprintf(
+				"%s = %s",
+				signatureLabelPart,
+				defaultArgument,
+			)
+		}
+
 		signatureLabelParts = append(signatureLabelParts, signatureLabelPart)
 	}
 
@@ -783,6 +821,23 @@ func (s *Server) SignatureHelp(
 	}, nil
 }
 
+// parameterDefaultArgument returns the source representation of the default argument
+// of the given parameter, or the empty string if the parameter has no default argument.
+//
+// NOTE: The default argument is accessed through an interface,
+// as the language server may be built against a version of Cadence
+// which does not support default arguments yet
+//
+func parameterDefaultArgument(parameter *sema.Parameter) string {
+	defaultArgumentParameter, ok := any(parameter).(interface {
+		DefaultArgumentString() string
+	})
+	if !ok {
+		return ""
+	}
+	return defaultArgumentParameter.DefaultArgumentString()
+}
+
 func (s *Server) DocumentHighlight(
 	_ protocol.Conn,
 	params *protocol.TextDocumentPositionParams,
@@ -29,15 +29,22 @@ import (
 
 // CompositeDeclaration
 
-// NOTE: For events, only an empty initializer is declared
+// NOTE: For events and newtypes, only an empty initializer is declared.
+// For newtypes, the initializer has a single parameter `_ value`,
+// which has the underlying type of the newtype
+
+// NewtypeValueFieldName is the name of the field of a newtype value
+// which holds the wrapped value of the underlying type
+const NewtypeValueFieldName = "value"
 
 type CompositeDeclaration struct {
-	Access        Access
-	CompositeKind common.CompositeKind
-	Identifier    Identifier
-	Conformances  []*NominalType
-	Members       *Members
-	DocString     string
+	Access            Access
+	CompositeKind     common.CompositeKind
+	Identifier        Identifier
+	TypeParameterList *TypeParameterList `json:",omitempty"`
+	Conformances      []*NominalType
+	Members           *Members
+	DocString         string
 	Range
 }
 
@@ -50,6 +57,7 @@ func NewCompositeDeclaration(
 	access Access,
 	compositeKind common.CompositeKind,
 	identifier Identifier,
+	typeParameterList *TypeParameterList,
 	conformances []*NominalType,
 	members *Members,
 	docString string,
@@ -58,13 +66,14 @@ func NewCompositeDeclaration(
 	common.UseMemory(memoryGauge, common.CompositeDeclarationMemoryUsage)
 
 	return &CompositeDeclaration{
-		Access:        access,
-		CompositeKind: compositeKind,
-		Identifier:    identifier,
-		Conformances:  conformances,
-		Members:       members,
-		DocString:     docString,
-		Range:         declarationRange,
+		Access:            access,
+		CompositeKind:     compositeKind,
+		Identifier:        identifier,
+		TypeParameterList: typeParameterList,
+		Conformances:      conformances,
+		Members:           members,
+		DocString:         docString,
+		Range:             declarationRange,
 	}
 }
 
@@ -120,8 +129,11 @@ func (d *CompositeDeclaration) MarshalJSON() ([]byte, error) {
 
 func (d *CompositeDeclaration) Doc() prettier.Doc {
 
-	if d.CompositeKind == common.CompositeKindEvent {
+	switch d.CompositeKind {
+	case common.CompositeKindEvent:
 		return d.EventDoc()
+	case common.CompositeKindNewtype:
+		return d.NewtypeDoc()
 	}
 
 	return CompositeDocument(
@@ -129,6 +141,7 @@ func (d *CompositeDeclaration) Doc() prettier.Doc {
 		d.CompositeKind,
 		false,
 		d.Identifier.Identifier,
+		d.TypeParameterList,
 		d.Conformances,
 		d.Members,
 	)
@@ -163,6 +176,54 @@ func (d *CompositeDeclaration) EventDoc() prettier.Doc {
 	return append(doc, paramsDoc)
 }
 
+// NewtypeUnderlyingTypeAnnotation returns the type annotation of the underlying type
+// of a newtype declaration, i.e. the type annotation of the parameter of the synthesized initializer.
+// It returns nil if the declaration is not a well-formed newtype declaration.
+//
+func (d *CompositeDeclaration) NewtypeUnderlyingTypeAnnotation() *TypeAnnotation {
+	if d.CompositeKind != common.CompositeKindNewtype {
+		return nil
+	}
+
+	initializers := d.Members.Initializers()
+	if len(initializers) != 1 {
+		return nil
+	}
+
+	parameters := initializers[0].FunctionDeclaration.ParameterList.Parameters
+	if len(parameters) != 1 {
+		return nil
+	}
+
+	return parameters[0].TypeAnnotation
+}
+
+func (d *CompositeDeclaration) NewtypeDoc() prettier.Doc {
+	var doc prettier.Concat
+
+	if d.Access != AccessNotSpecified {
+		doc = append(
+			doc,
+			prettier.Text(d.Access.Keyword()),
+			prettier.Space,
+		)
+	}
+
+	underlyingTypeAnnotation := d.NewtypeUnderlyingTypeAnnotation()
+	if underlyingTypeAnnotation == nil {
+		return nil
+	}
+
+	return append(
+		doc,
+		prettier.Text(d.CompositeKind.Keyword()),
+		prettier.Space,
+		prettier.Text(d.Identifier.Identifier),
+		typeSeparatorSpaceDoc,
+		underlyingTypeAnnotation.Doc(),
+	)
+}
+
 func (d *CompositeDeclaration) String() string {
 	return Prettier(d)
 }
@@ -179,6 +240,7 @@ func CompositeDocument(
 	kind common.CompositeKind,
 	isInterface bool,
 	identifier string,
+	typeParameterList *TypeParameterList,
 	conformances []*NominalType,
 	members *Members,
 ) prettier.Doc {
@@ -211,6 +273,13 @@ func CompositeDocument(
 		prettier.Text(identifier),
 	)
 
+	if !typeParameterList.IsEmpty() {
+		doc = append(
+			doc,
+			typeParameterList.Doc(),
+		)
+	}
+
 	if len(conformances) > 0 {
 
 		conformancesDoc := prettier.Concat{
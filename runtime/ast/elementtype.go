@@ -40,6 +40,7 @@ const (
 	ElementTypePragmaDeclaration
 	ElementTypeImportDeclaration
 	ElementTypeTransactionDeclaration
+	ElementTypeTypeAliasDeclaration
 
 	// Statements
 
@@ -55,6 +56,7 @@ const (
 	ElementTypeAssignmentStatement
 	ElementTypeSwapStatement
 	ElementTypeExpressionStatement
+	ElementTypeTryStatement
 
 	// Expressions
 
@@ -21,44 +21,46 @@ func _() {
 	_ = x[ElementTypePragmaDeclaration-10]
 	_ = x[ElementTypeImportDeclaration-11]
 	_ = x[ElementTypeTransactionDeclaration-12]
-	_ = x[ElementTypeReturnStatement-13]
-	_ = x[ElementTypeBreakStatement-14]
-	_ = x[ElementTypeContinueStatement-15]
-	_ = x[ElementTypeIfStatement-16]
-	_ = x[ElementTypeSwitchStatement-17]
-	_ = x[ElementTypeWhileStatement-18]
-	_ = x[ElementTypeForStatement-19]
-	_ = x[ElementTypeEmitStatement-20]
-	_ = x[ElementTypeVariableDeclaration-21]
-	_ = x[ElementTypeAssignmentStatement-22]
-	_ = x[ElementTypeSwapStatement-23]
-	_ = x[ElementTypeExpressionStatement-24]
-	_ = x[ElementTypeBoolExpression-25]
-	_ = x[ElementTypeNilExpression-26]
-	_ = x[ElementTypeIntegerExpression-27]
-	_ = x[ElementTypeFixedPointExpression-28]
-	_ = x[ElementTypeArrayExpression-29]
-	_ = x[ElementTypeDictionaryExpression-30]
-	_ = x[ElementTypeIdentifierExpression-31]
-	_ = x[ElementTypeInvocationExpression-32]
-	_ = x[ElementTypeMemberExpression-33]
-	_ = x[ElementTypeIndexExpression-34]
-	_ = x[ElementTypeConditionalExpression-35]
-	_ = x[ElementTypeUnaryExpression-36]
-	_ = x[ElementTypeBinaryExpression-37]
-	_ = x[ElementTypeFunctionExpression-38]
-	_ = x[ElementTypeStringExpression-39]
-	_ = x[ElementTypeCastingExpression-40]
-	_ = x[ElementTypeCreateExpression-41]
-	_ = x[ElementTypeDestroyExpression-42]
-	_ = x[ElementTypeReferenceExpression-43]
-	_ = x[ElementTypeForceExpression-44]
-	_ = x[ElementTypePathExpression-45]
+	_ = x[ElementTypeTypeAliasDeclaration-13]
+	_ = x[ElementTypeReturnStatement-14]
+	_ = x[ElementTypeBreakStatement-15]
+	_ = x[ElementTypeContinueStatement-16]
+	_ = x[ElementTypeIfStatement-17]
+	_ = x[ElementTypeSwitchStatement-18]
+	_ = x[ElementTypeWhileStatement-19]
+	_ = x[ElementTypeForStatement-20]
+	_ = x[ElementTypeEmitStatement-21]
+	_ = x[ElementTypeVariableDeclaration-22]
+	_ = x[ElementTypeAssignmentStatement-23]
+	_ = x[ElementTypeSwapStatement-24]
+	_ = x[ElementTypeExpressionStatement-25]
+	_ = x[ElementTypeTryStatement-26]
+	_ = x[ElementTypeBoolExpression-27]
+	_ = x[ElementTypeNilExpression-28]
+	_ = x[ElementTypeIntegerExpression-29]
+	_ = x[ElementTypeFixedPointExpression-30]
+	_ = x[ElementTypeArrayExpression-31]
+	_ = x[ElementTypeDictionaryExpression-32]
+	_ = x[ElementTypeIdentifierExpression-33]
+	_ = x[ElementTypeInvocationExpression-34]
+	_ = x[ElementTypeMemberExpression-35]
+	_ = x[ElementTypeIndexExpression-36]
+	_ = x[ElementTypeConditionalExpression-37]
+	_ = x[ElementTypeUnaryExpression-38]
+	_ = x[ElementTypeBinaryExpression-39]
+	_ = x[ElementTypeFunctionExpression-40]
+	_ = x[ElementTypeStringExpression-41]
+	_ = x[ElementTypeCastingExpression-42]
+	_ = x[ElementTypeCreateExpression-43]
+	_ = x[ElementTypeDestroyExpression-44]
+	_ = x[ElementTypeReferenceExpression-45]
+	_ = x[ElementTypeForceExpression-46]
+	_ = x[ElementTypePathExpression-47]
 }
 
-const _ElementType_name = "ElementTypeUnknownElementTypeProgramElementTypeBlockElementTypeFunctionBlockElementTypeFunctionDeclarationElementTypeSpecialFunctionDeclarationElementTypeCompositeDeclarationElementTypeInterfaceDeclarationElementTypeFieldDeclarationElementTypeEnumCaseDeclarationElementTypePragmaDeclarationElementTypeImportDeclarationElementTypeTransactionDeclarationElementTypeReturnStatementElementTypeBreakStatementElementTypeContinueStatementElementTypeIfStatementElementTypeSwitchStatementElementTypeWhileStatementElementTypeForStatementElementTypeEmitStatementElementTypeVariableDeclarationElementTypeAssignmentStatementElementTypeSwapStatementElementTypeExpressionStatementElementTypeBoolExpressionElementTypeNilExpressionElementTypeIntegerExpressionElementTypeFixedPointExpressionElementTypeArrayExpressionElementTypeDictionaryExpressionElementTypeIdentifierExpressionElementTypeInvocationExpressionElementTypeMemberExpressionElementTypeIndexExpressionElementTypeConditionalExpressionElementTypeUnaryExpressionElementTypeBinaryExpressionElementTypeFunctionExpressionElementTypeStringExpressionElementTypeCastingExpressionElementTypeCreateExpressionElementTypeDestroyExpressionElementTypeReferenceExpressionElementTypeForceExpressionElementTypePathExpression"
+const _ElementType_name = "ElementTypeUnknownElementTypeProgramElementTypeBlockElementTypeFunctionBlockElementTypeFunctionDeclarationElementTypeSpecialFunctionDeclarationElementTypeCompositeDeclarationElementTypeInterfaceDeclarationElementTypeFieldDeclarationElementTypeEnumCaseDeclarationElementTypePragmaDeclarationElementTypeImportDeclarationElementTypeTransactionDeclarationElementTypeTypeAliasDeclarationElementTypeReturnStatementElementTypeBreakStatementElementTypeContinueStatementElementTypeIfStatementElementTypeSwitchStatementElementTypeWhileStatementElementTypeForStatementElementTypeEmitStatementElementTypeVariableDeclarationElementTypeAssignmentStatementElementTypeSwapStatementElementTypeExpressionStatementElementTypeTryStatementElementTypeBoolExpressionElementTypeNilExpressionElementTypeIntegerExpressionElementTypeFixedPointExpressionElementTypeArrayExpressionElementTypeDictionaryExpressionElementTypeIdentifierExpressionElementTypeInvocationExpressionElementTypeMemberExpressionElementTypeIndexExpressionElementTypeConditionalExpressionElementTypeUnaryExpressionElementTypeBinaryExpressionElementTypeFunctionExpressionElementTypeStringExpressionElementTypeCastingExpressionElementTypeCreateExpressionElementTypeDestroyExpressionElementTypeReferenceExpressionElementTypeForceExpressionElementTypePathExpression"
 
-var _ElementType_index = [...]uint16{0, 18, 36, 52, 76, 106, 143, 174, 205, 232, 262, 290, 318, 351, 377, 402, 430, 452, 478, 503, 526, 550, 580, 610, 634, 664, 689, 713, 741, 772, 798, 829, 860, 891, 918, 944, 976, 1002, 1029, 1058, 1085, 1113, 1140, 1168, 1198, 1224, 1249}
+var _ElementType_index = [...]uint16{0, 18, 36, 52, 76, 106, 143, 174, 205, 232, 262, 290, 318, 351, 382, 408, 433, 461, 483, 509, 534, 557, 581, 611, 641, 665, 695, 718, 743, 767, 795, 826, 852, 883, 914, 945, 972, 998, 1030, 1056, 1083, 1112, 1139, 1167, 1194, 1222, 1252, 1278, 1303}
 
 func (i ElementType) String() string {
 	if i >= ElementType(len(_ElementType_index)-1) {
@@ -1507,6 +1507,7 @@ func FunctionDocument(
 	access Access,
 	includeKeyword bool,
 	identifier string,
+	typeParameterList *TypeParameterList,
 	parameterList *ParameterList,
 	returnTypeAnnotation *TypeAnnotation,
 	block *FunctionBlock,
@@ -1554,6 +1555,13 @@ func FunctionDocument(
 		)
 	}
 
+	if !typeParameterList.IsEmpty() {
+		doc = append(
+			doc,
+			typeParameterList.Doc(),
+		)
+	}
+
 	if signatureDoc != nil {
 		doc = append(
 			doc,
@@ -1581,6 +1589,7 @@ func (e *FunctionExpression) Doc() prettier.Doc {
 		AccessNotSpecified,
 		true,
 		"",
+		nil,
 		e.ParameterList,
 		e.ReturnTypeAnnotation,
 		e.FunctionBlock,
@@ -29,6 +29,7 @@ import (
 type FunctionDeclaration struct {
 	Access               Access
 	Identifier           Identifier
+	TypeParameterList    *TypeParameterList `json:",omitempty"`
 	ParameterList        *ParameterList
 	ReturnTypeAnnotation *TypeAnnotation
 	FunctionBlock        *FunctionBlock
@@ -44,6 +45,7 @@ func NewFunctionDeclaration(
 	gauge common.MemoryGauge,
 	access Access,
 	identifier Identifier,
+	typeParameterList *TypeParameterList,
 	parameterList *ParameterList,
 	returnTypeAnnotation *TypeAnnotation,
 	functionBlock *FunctionBlock,
@@ -55,6 +57,7 @@ func NewFunctionDeclaration(
 	return &FunctionDeclaration{
 		Access:               access,
 		Identifier:           identifier,
+		TypeParameterList:    typeParameterList,
 		ParameterList:        parameterList,
 		ReturnTypeAnnotation: returnTypeAnnotation,
 		FunctionBlock:        functionBlock,
@@ -132,6 +135,7 @@ func (d *FunctionDeclaration) Doc() prettier.Doc {
 		d.Access,
 		true,
 		d.Identifier.Identifier,
+		d.TypeParameterList,
 		d.ParameterList,
 		d.ReturnTypeAnnotation,
 		d.FunctionBlock,
@@ -228,6 +232,7 @@ func (d *SpecialFunctionDeclaration) Doc() prettier.Doc {
 		d.FunctionDeclaration.Access,
 		false,
 		d.Kind.Keywords(),
+		nil,
 		d.FunctionDeclaration.ParameterList,
 		d.FunctionDeclaration.ReturnTypeAnnotation,
 		d.FunctionDeclaration.FunctionBlock,
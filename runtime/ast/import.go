@@ -19,6 +19,7 @@
 package ast
 
 import (
+	"encoding/hex"
 	"encoding/json"
 
 	"github.com/turbolent/prettier"
@@ -32,6 +33,7 @@ type ImportDeclaration struct {
 	Identifiers []Identifier
 	Location    common.Location
 	LocationPos Position
+	Hash        *ImportHash
 	Range
 }
 
@@ -44,6 +46,7 @@ func NewImportDeclaration(
 	location common.Location,
 	declRange Range,
 	locationPos Position,
+	hash *ImportHash,
 ) *ImportDeclaration {
 	common.UseMemory(gauge, common.ImportDeclarationMemoryUsage)
 
@@ -52,6 +55,7 @@ func NewImportDeclaration(
 		Location:    location,
 		Range:       declRange,
 		LocationPos: locationPos,
+		Hash:        hash,
 	}
 }
 
@@ -104,6 +108,7 @@ func (d *ImportDeclaration) MarshalJSON() ([]byte, error) {
 
 const importDeclarationImportKeywordDoc = prettier.Text("import")
 const importDeclarationFromKeywordDoc = prettier.Text("from ")
+const importDeclarationWithHashKeywordsDoc = prettier.Text(" with hash ")
 
 var importDeclarationSeparatorDoc prettier.Doc = prettier.Concat{
 	prettier.Text(","),
@@ -156,16 +161,67 @@ func (d *ImportDeclaration) Doc() prettier.Doc {
 		)
 	}
 
-	return append(
+	doc = append(
 		doc,
 		LocationDoc(d.Location),
 	)
+
+	if d.Hash != nil {
+		doc = append(
+			doc,
+			importDeclarationWithHashKeywordsDoc,
+			prettier.Text(QuoteString(d.Hash.String())),
+		)
+	}
+
+	return doc
 }
 
 func (d *ImportDeclaration) String() string {
 	return Prettier(d)
 }
 
+// ImportHash is the expected hash of the code of an imported program,
+// e.g. `with hash "sha3-256:..."`
+//
+type ImportHash struct {
+	Algorithm string
+	Digest    []byte
+	Range
+}
+
+const ImportHashAlgorithmSHA3_256 = "sha3-256"
+
+func NewImportHash(
+	gauge common.MemoryGauge,
+	algorithm string,
+	digest []byte,
+	hashRange Range,
+) *ImportHash {
+	common.UseMemory(gauge, common.ImportHashMemoryUsage)
+
+	return &ImportHash{
+		Algorithm: algorithm,
+		Digest:    digest,
+		Range:     hashRange,
+	}
+}
+
+func (h *ImportHash) String() string {
+	return h.Algorithm + ":" + hex.EncodeToString(h.Digest)
+}
+
+func (h *ImportHash) MarshalJSON() ([]byte, error) {
+	type Alias ImportHash
+	return json.Marshal(&struct {
+		Digest string
+		*Alias
+	}{
+		Digest: hex.EncodeToString(h.Digest),
+		Alias:  (*Alias)(h),
+	})
+}
+
 func LocationDoc(location common.Location) prettier.Doc {
 	switch location := location.(type) {
 	case common.AddressLocation:
@@ -42,6 +42,14 @@ func TestImportDeclaration_MarshalJSON(t *testing.T) {
 		},
 		Location:    common.StringLocation("test"),
 		LocationPos: Position{Offset: 4, Line: 5, Column: 6},
+		Hash: &ImportHash{
+			Algorithm: ImportHashAlgorithmSHA3_256,
+			Digest:    []byte{0xca, 0xfe},
+			Range: Range{
+				StartPos: Position{Offset: 13, Line: 14, Column: 15},
+				EndPos:   Position{Offset: 16, Line: 17, Column: 18},
+			},
+		},
 		Range: Range{
 			StartPos: Position{Offset: 7, Line: 8, Column: 9},
 			EndPos:   Position{Offset: 10, Line: 11, Column: 12},
@@ -67,6 +75,12 @@ func TestImportDeclaration_MarshalJSON(t *testing.T) {
                 "String": "test"
             },
             "LocationPos": {"Offset": 4, "Line": 5, "Column": 6},
+            "Hash": {
+                "Algorithm": "sha3-256",
+                "Digest": "cafe",
+                "StartPos": {"Offset": 13, "Line": 14, "Column": 15},
+                "EndPos": {"Offset": 16, "Line": 17, "Column": 18}
+            },
             "StartPos": {"Offset": 7, "Line": 8, "Column": 9},
             "EndPos": {"Offset": 10, "Line": 11, "Column": 12}
         }
@@ -173,6 +187,31 @@ func TestImportDeclaration_Doc(t *testing.T) {
 			decl.Doc(),
 		)
 	})
+
+	t.Run("hash", func(t *testing.T) {
+
+		t.Parallel()
+
+		decl := &ImportDeclaration{
+			Location: common.StringLocation("test"),
+			Hash: &ImportHash{
+				Algorithm: ImportHashAlgorithmSHA3_256,
+				Digest:    []byte{0xca, 0xfe},
+			},
+		}
+
+		require.Equal(
+			t,
+			prettier.Concat{
+				prettier.Text("import"),
+				prettier.Text(" "),
+				prettier.Text("\"test\""),
+				prettier.Text(" with hash "),
+				prettier.Text("\"sha3-256:cafe\""),
+			},
+			decl.Doc(),
+		)
+	})
 }
 
 func TestImportDeclaration_String(t *testing.T) {
@@ -238,4 +277,30 @@ func TestImportDeclaration_String(t *testing.T) {
 			decl.String(),
 		)
 	})
+
+	t.Run("hash", func(t *testing.T) {
+
+		t.Parallel()
+
+		decl := &ImportDeclaration{
+			Identifiers: []Identifier{
+				{
+					Identifier: "foo",
+				},
+			},
+			Location: common.AddressLocation{
+				Address: common.MustBytesToAddress([]byte{0x1}),
+			},
+			Hash: &ImportHash{
+				Algorithm: ImportHashAlgorithmSHA3_256,
+				Digest:    []byte{0xca, 0xfe},
+			},
+		}
+
+		require.Equal(
+			t,
+			`import foo from 0x1 with hash "sha3-256:cafe"`,
+			decl.String(),
+		)
+	})
 }
@@ -40,6 +40,14 @@ func TestInterfaceDeclaration_MarshalJSON(t *testing.T) {
 			Identifier: "AB",
 			Pos:        Position{Offset: 1, Line: 2, Column: 3},
 		},
+		Conformances: []*NominalType{
+			{
+				Identifier: Identifier{
+					Identifier: "CD",
+					Pos:        Position{Offset: 4, Line: 5, Column: 6},
+				},
+			},
+		},
 		Members:   NewUnmeteredMembers([]Declaration{}),
 		DocString: "test",
 		Range: Range{
@@ -62,6 +70,18 @@ func TestInterfaceDeclaration_MarshalJSON(t *testing.T) {
 				"StartPos": {"Offset": 1, "Line": 2, "Column": 3},
 				"EndPos": {"Offset": 2, "Line": 2, "Column": 4}
             },
+            "Conformances": [
+                {
+                    "Type": "NominalType",
+                    "Identifier": {
+                        "Identifier": "CD",
+                        "StartPos": {"Offset": 4, "Line": 5, "Column": 6},
+                        "EndPos": {"Offset": 5, "Line": 5, "Column": 7}
+                    },
+                    "StartPos": {"Offset": 4, "Line": 5, "Column": 6},
+                    "EndPos": {"Offset": 5, "Line": 5, "Column": 7}
+                }
+            ],
             "Members": {
                 "Declarations": []
             },
@@ -228,4 +248,36 @@ func TestInterfaceDeclaration_String(t *testing.T) {
 		)
 
 	})
+
+	t.Run("conformances", func(t *testing.T) {
+
+		t.Parallel()
+
+		decl := &InterfaceDeclaration{
+			Access:        AccessPublic,
+			CompositeKind: common.CompositeKindResource,
+			Identifier: Identifier{
+				Identifier: "AB",
+			},
+			Conformances: []*NominalType{
+				{
+					Identifier: Identifier{
+						Identifier: "CD",
+					},
+				},
+				{
+					Identifier: Identifier{
+						Identifier: "EF",
+					},
+				},
+			},
+			Members: NewMembers(nil, []Declaration{}),
+		}
+
+		require.Equal(
+			t,
+			"pub resource interface AB: CD, EF {}",
+			decl.String(),
+		)
+	})
 }
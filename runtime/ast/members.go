@@ -72,6 +72,10 @@ func (m *Members) EnumCases() []*EnumCaseDeclaration {
 	return m.indices.EnumCases(m.declarations)
 }
 
+func (m *Members) TypeAliases() []*TypeAliasDeclaration {
+	return m.indices.TypeAliases(m.declarations)
+}
+
 func (m *Members) FieldsByIdentifier() map[string]*FieldDeclaration {
 	return m.indices.FieldsByIdentifier(m.declarations)
 }
@@ -106,11 +110,12 @@ func (m *Members) Destructor() *SpecialFunctionDeclaration {
 }
 
 func (m *Members) FieldPosition(name string, compositeKind common.CompositeKind) Position {
-	if compositeKind == common.CompositeKindEvent {
+	switch compositeKind {
+	case common.CompositeKindEvent, common.CompositeKindNewtype:
 		parameters := m.Initializers()[0].FunctionDeclaration.ParameterList.ParametersByIdentifier()
 		parameter := parameters[name]
 		return parameter.Identifier.Pos
-	} else {
+	default:
 		fields := m.FieldsByIdentifier()
 		field := fields[name]
 		return field.Identifier.Pos
@@ -24,6 +24,9 @@ type Parameter struct {
 	Label          string
 	Identifier     Identifier
 	TypeAnnotation *TypeAnnotation
+	// DefaultArgument is the value of the parameter
+	// if an invocation provides no argument for it, if any
+	DefaultArgument Expression `json:",omitempty"`
 	Range
 }
 
@@ -110,6 +110,14 @@ func (l *ParameterList) Doc() prettier.Doc {
 			parameter.TypeAnnotation.Doc(),
 		)
 
+		if parameter.DefaultArgument != nil {
+			parameterDoc = append(
+				parameterDoc,
+				prettier.Text(" = "),
+				parameter.DefaultArgument.Doc(),
+			)
+		}
+
 		parameterDocs = append(parameterDocs, parameterDoc)
 	}
 
@@ -206,3 +206,38 @@ func TestParameterList_String(t *testing.T) {
 		params.String(),
 	)
 }
+
+func TestParameterList_String_DefaultArgument(t *testing.T) {
+
+	t.Parallel()
+
+	params := &ParameterList{
+		Parameters: []*Parameter{
+			{
+				Identifier: Identifier{Identifier: "a"},
+				TypeAnnotation: &TypeAnnotation{
+					Type: &NominalType{
+						Identifier: Identifier{Identifier: "A"},
+					},
+				},
+			},
+			{
+				Label:      "b",
+				Identifier: Identifier{Identifier: "c"},
+				TypeAnnotation: &TypeAnnotation{
+					Type: &NominalType{
+						Identifier: Identifier{Identifier: "Bool"},
+					},
+				},
+				DefaultArgument: &BoolExpression{
+					Value: true,
+				},
+			},
+		},
+	}
+
+	require.Equal(t,
+		"(a: A, b c: Bool = true)",
+		params.String(),
+	)
+}
@@ -0,0 +1,447 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ast
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/turbolent/prettier"
+
+	"github.com/onflow/cadence/runtime/common"
+)
+
+// BlankIdentifier is the identifier of an identifier pattern
+// which matches any value, but does not bind it
+const BlankIdentifier = "_"
+
+// Pattern is the destructuring target of a variable declaration,
+// e.g. `[a, b]` in `let [a, b] = array`,
+// or the pattern of a switch case, e.g. `x?` in `case let x?:`
+
+type Pattern interface {
+	HasPosition
+	fmt.Stringer
+	isPattern()
+	Doc() prettier.Doc
+	// Walk calls the given function for each expression contained in the pattern
+	Walk(walkChild func(Element))
+}
+
+// IdentifierPattern
+
+type IdentifierPattern struct {
+	Identifier Identifier
+}
+
+var _ Pattern = &IdentifierPattern{}
+
+func NewIdentifierPattern(
+	memoryGauge common.MemoryGauge,
+	identifier Identifier,
+) *IdentifierPattern {
+	common.UseMemory(memoryGauge, common.IdentifierPatternMemoryUsage)
+	return &IdentifierPattern{
+		Identifier: identifier,
+	}
+}
+
+func (*IdentifierPattern) isPattern() {}
+
+// IsBlank returns true if the pattern is the blank identifier `_`,
+// i.e. it does not bind the matched value
+func (p *IdentifierPattern) IsBlank() bool {
+	return p.Identifier.Identifier == BlankIdentifier
+}
+
+func (p *IdentifierPattern) StartPosition() Position {
+	return p.Identifier.StartPosition()
+}
+
+func (p *IdentifierPattern) EndPosition(memoryGauge common.MemoryGauge) Position {
+	return p.Identifier.EndPosition(memoryGauge)
+}
+
+func (*IdentifierPattern) Walk(_ func(Element)) {
+	// NO-OP
+}
+
+func (p *IdentifierPattern) String() string {
+	return Prettier(p)
+}
+
+func (p *IdentifierPattern) Doc() prettier.Doc {
+	return prettier.Text(p.Identifier.Identifier)
+}
+
+func (p *IdentifierPattern) MarshalJSON() ([]byte, error) {
+	type Alias IdentifierPattern
+	return json.Marshal(&struct {
+		Type string
+		Range
+		*Alias
+	}{
+		Type:  "IdentifierPattern",
+		Range: NewUnmeteredRangeFromPositioned(p),
+		Alias: (*Alias)(p),
+	})
+}
+
+// ArrayPattern
+
+type ArrayPattern struct {
+	Elements []Pattern
+	Range
+}
+
+var _ Pattern = &ArrayPattern{}
+
+func NewArrayPattern(
+	memoryGauge common.MemoryGauge,
+	elements []Pattern,
+	patternRange Range,
+) *ArrayPattern {
+	common.UseMemory(memoryGauge, common.ArrayPatternMemoryUsage)
+	return &ArrayPattern{
+		Elements: elements,
+		Range:    patternRange,
+	}
+}
+
+func (*ArrayPattern) isPattern() {}
+
+func (p *ArrayPattern) Walk(walkChild func(Element)) {
+	for _, element := range p.Elements {
+		element.Walk(walkChild)
+	}
+}
+
+func (p *ArrayPattern) String() string {
+	return Prettier(p)
+}
+
+func (p *ArrayPattern) Doc() prettier.Doc {
+	if len(p.Elements) == 0 {
+		return prettier.Text("[]")
+	}
+
+	elementDocs := make([]prettier.Doc, len(p.Elements))
+	for i, element := range p.Elements {
+		elementDocs[i] = element.Doc()
+	}
+	return prettier.WrapBrackets(
+		prettier.Join(arrayExpressionSeparatorDoc, elementDocs...),
+		prettier.SoftLine{},
+	)
+}
+
+func (p *ArrayPattern) MarshalJSON() ([]byte, error) {
+	type Alias ArrayPattern
+	return json.Marshal(&struct {
+		Type string
+		*Alias
+	}{
+		Type:  "ArrayPattern",
+		Alias: (*Alias)(p),
+	})
+}
+
+// DictionaryPattern
+
+type DictionaryPattern struct {
+	Entries []DictionaryPatternEntry
+	Range
+}
+
+var _ Pattern = &DictionaryPattern{}
+
+func NewDictionaryPattern(
+	memoryGauge common.MemoryGauge,
+	entries []DictionaryPatternEntry,
+	patternRange Range,
+) *DictionaryPattern {
+	common.UseMemory(memoryGauge, common.DictionaryPatternMemoryUsage)
+	return &DictionaryPattern{
+		Entries: entries,
+		Range:   patternRange,
+	}
+}
+
+func (*DictionaryPattern) isPattern() {}
+
+func (p *DictionaryPattern) Walk(walkChild func(Element)) {
+	for _, entry := range p.Entries {
+		walkChild(entry.Key)
+		entry.Value.Walk(walkChild)
+	}
+}
+
+func (p *DictionaryPattern) String() string {
+	return Prettier(p)
+}
+
+func (p *DictionaryPattern) Doc() prettier.Doc {
+	if len(p.Entries) == 0 {
+		return prettier.Text("{}")
+	}
+
+	entryDocs := make([]prettier.Doc, len(p.Entries))
+	for i, entry := range p.Entries {
+		entryDocs[i] = prettier.Group{
+			Doc: prettier.Concat{
+				entry.Key.Doc(),
+				dictionaryKeyValueSeparatorDoc,
+				entry.Value.Doc(),
+			},
+		}
+	}
+
+	return prettier.WrapBraces(
+		prettier.Join(dictionaryExpressionSeparatorDoc, entryDocs...),
+		prettier.SoftLine{},
+	)
+}
+
+func (p *DictionaryPattern) MarshalJSON() ([]byte, error) {
+	type Alias DictionaryPattern
+	return json.Marshal(&struct {
+		Type string
+		*Alias
+	}{
+		Type:  "DictionaryPattern",
+		Alias: (*Alias)(p),
+	})
+}
+
+// DictionaryPatternEntry matches the value for the key in a dictionary
+
+type DictionaryPatternEntry struct {
+	Key   Expression
+	Value Pattern
+}
+
+// CompositePattern
+
+type CompositePattern struct {
+	Elements []CompositePatternElement
+	Range
+}
+
+var _ Pattern = &CompositePattern{}
+
+func NewCompositePattern(
+	memoryGauge common.MemoryGauge,
+	elements []CompositePatternElement,
+	patternRange Range,
+) *CompositePattern {
+	common.UseMemory(memoryGauge, common.CompositePatternMemoryUsage)
+	return &CompositePattern{
+		Elements: elements,
+		Range:    patternRange,
+	}
+}
+
+func (*CompositePattern) isPattern() {}
+
+// IsLabeled returns true if the elements of the pattern
+// refer to the fields of the composite by name,
+// instead of by the order in which the fields are declared
+func (p *CompositePattern) IsLabeled() bool {
+	return len(p.Elements) > 0 && p.Elements[0].Label != nil
+}
+
+func (p *CompositePattern) Walk(walkChild func(Element)) {
+	for _, element := range p.Elements {
+		element.Pattern.Walk(walkChild)
+	}
+}
+
+func (p *CompositePattern) String() string {
+	return Prettier(p)
+}
+
+func (p *CompositePattern) Doc() prettier.Doc {
+	if len(p.Elements) == 0 {
+		return prettier.Text("()")
+	}
+
+	elementDocs := make([]prettier.Doc, len(p.Elements))
+	for i, element := range p.Elements {
+		elementDoc := element.Pattern.Doc()
+		if element.Label != nil {
+			elementDoc = prettier.Concat{
+				prettier.Text(element.Label.Identifier),
+				typeSeparatorSpaceDoc,
+				elementDoc,
+			}
+		}
+		elementDocs[i] = elementDoc
+	}
+	return prettier.WrapParentheses(
+		prettier.Join(arrayExpressionSeparatorDoc, elementDocs...),
+		prettier.SoftLine{},
+	)
+}
+
+func (p *CompositePattern) MarshalJSON() ([]byte, error) {
+	type Alias CompositePattern
+	return json.Marshal(&struct {
+		Type string
+		*Alias
+	}{
+		Type:  "CompositePattern",
+		Alias: (*Alias)(p),
+	})
+}
+
+// CompositePatternElement matches a field of a composite.
+// If the element has no label, it matches the field at the same position
+// in the composite's declaration
+
+type CompositePatternElement struct {
+	Label   *Identifier `json:",omitempty"`
+	Pattern Pattern
+}
+
+// OptionalPattern matches a non-nil optional,
+// e.g. `x?` in `case let x?:`.
+// It is only allowed in switch cases
+
+type OptionalPattern struct {
+	Pattern Pattern
+	EndPos  Position `json:"-"`
+}
+
+var _ Pattern = &OptionalPattern{}
+
+func NewOptionalPattern(
+	memoryGauge common.MemoryGauge,
+	pattern Pattern,
+	endPos Position,
+) *OptionalPattern {
+	common.UseMemory(memoryGauge, common.OptionalPatternMemoryUsage)
+	return &OptionalPattern{
+		Pattern: pattern,
+		EndPos:  endPos,
+	}
+}
+
+func (*OptionalPattern) isPattern() {}
+
+func (p *OptionalPattern) StartPosition() Position {
+	return p.Pattern.StartPosition()
+}
+
+func (p *OptionalPattern) EndPosition(_ common.MemoryGauge) Position {
+	return p.EndPos
+}
+
+func (p *OptionalPattern) Walk(walkChild func(Element)) {
+	p.Pattern.Walk(walkChild)
+}
+
+func (p *OptionalPattern) String() string {
+	return Prettier(p)
+}
+
+const optionalPatternSymbolDoc = prettier.Text("?")
+
+func (p *OptionalPattern) Doc() prettier.Doc {
+	return prettier.Concat{
+		p.Pattern.Doc(),
+		optionalPatternSymbolDoc,
+	}
+}
+
+func (p *OptionalPattern) MarshalJSON() ([]byte, error) {
+	type Alias OptionalPattern
+	return json.Marshal(&struct {
+		Type string
+		Range
+		*Alias
+	}{
+		Type:  "OptionalPattern",
+		Range: NewUnmeteredRangeFromPositioned(p),
+		Alias: (*Alias)(p),
+	})
+}
+
+// TypePattern matches a value which has the given type at run-time,
+// e.g. `v as @NFT` in `case let v as @NFT:`.
+// It is only allowed in switch cases
+
+type TypePattern struct {
+	Pattern        Pattern
+	TypeAnnotation *TypeAnnotation
+}
+
+var _ Pattern = &TypePattern{}
+
+func NewTypePattern(
+	memoryGauge common.MemoryGauge,
+	pattern Pattern,
+	typeAnnotation *TypeAnnotation,
+) *TypePattern {
+	common.UseMemory(memoryGauge, common.TypePatternMemoryUsage)
+	return &TypePattern{
+		Pattern:        pattern,
+		TypeAnnotation: typeAnnotation,
+	}
+}
+
+func (*TypePattern) isPattern() {}
+
+func (p *TypePattern) StartPosition() Position {
+	return p.Pattern.StartPosition()
+}
+
+func (p *TypePattern) EndPosition(memoryGauge common.MemoryGauge) Position {
+	return p.TypeAnnotation.EndPosition(memoryGauge)
+}
+
+func (p *TypePattern) Walk(walkChild func(Element)) {
+	p.Pattern.Walk(walkChild)
+}
+
+func (p *TypePattern) String() string {
+	return Prettier(p)
+}
+
+const typePatternAsKeywordDoc = prettier.Text(" as ")
+
+func (p *TypePattern) Doc() prettier.Doc {
+	return prettier.Concat{
+		p.Pattern.Doc(),
+		typePatternAsKeywordDoc,
+		p.TypeAnnotation.Doc(),
+	}
+}
+
+func (p *TypePattern) MarshalJSON() ([]byte, error) {
+	type Alias TypePattern
+	return json.Marshal(&struct {
+		Type string
+		Range
+		*Alias
+	}{
+		Type:  "TypePattern",
+		Range: NewUnmeteredRangeFromPositioned(p),
+		Alias: (*Alias)(p),
+	})
+}
@@ -105,6 +105,10 @@ func (p *Program) VariableDeclarations() []*VariableDeclaration {
 // SoleContractDeclaration returns the sole contract declaration, if any,
 // and if there are no other actionable declarations.
 //
+func (p *Program) TypeAliasDeclarations() []*TypeAliasDeclaration {
+	return p.indices.typeAliasDeclarations(p.declarations)
+}
+
 func (p *Program) SoleContractDeclaration() *CompositeDeclaration {
 
 	compositeDeclarations := p.CompositeDeclarations()
@@ -499,6 +499,105 @@ func (s *ForStatement) MarshalJSON() ([]byte, error) {
 	})
 }
 
+// TryStatement
+
+// A try statement executes its block, and if the block fails,
+// reverts all state changes of the block and executes the catch block instead.
+// The catch block may bind the error message to an identifier, e.g. `catch (error)`.
+//
+type TryStatement struct {
+	Block           *Block
+	ErrorIdentifier *Identifier
+	CatchBlock      *Block
+	StartPos        Position `json:"-"`
+}
+
+var _ Element = &TryStatement{}
+var _ Statement = &TryStatement{}
+
+func NewTryStatement(
+	gauge common.MemoryGauge,
+	block *Block,
+	errorIdentifier *Identifier,
+	catchBlock *Block,
+	startPos Position,
+) *TryStatement {
+	common.UseMemory(gauge, common.TryStatementMemoryUsage)
+
+	return &TryStatement{
+		Block:           block,
+		ErrorIdentifier: errorIdentifier,
+		CatchBlock:      catchBlock,
+		StartPos:        startPos,
+	}
+}
+
+func (*TryStatement) ElementType() ElementType {
+	return ElementTypeTryStatement
+}
+
+func (*TryStatement) isStatement() {}
+
+func (s *TryStatement) Accept(visitor Visitor) Repr {
+	return visitor.VisitTryStatement(s)
+}
+
+func (s *TryStatement) Walk(walkChild func(Element)) {
+	walkChild(s.Block)
+	walkChild(s.CatchBlock)
+}
+
+func (s *TryStatement) StartPosition() Position {
+	return s.StartPos
+}
+
+func (s *TryStatement) EndPosition(memoryGauge common.MemoryGauge) Position {
+	return s.CatchBlock.EndPosition(memoryGauge)
+}
+
+const tryStatementTryKeywordSpaceDoc = prettier.Text("try ")
+const tryStatementSpaceCatchKeywordSpaceDoc = prettier.Text(" catch ")
+
+func (s *TryStatement) Doc() prettier.Doc {
+	doc := prettier.Concat{
+		tryStatementTryKeywordSpaceDoc,
+		s.Block.Doc(),
+		tryStatementSpaceCatchKeywordSpaceDoc,
+	}
+
+	if s.ErrorIdentifier != nil {
+		doc = append(
+			doc,
+			prettier.Text("("),
+			prettier.Text(s.ErrorIdentifier.Identifier),
+			prettier.Text(") "),
+		)
+	}
+
+	doc = append(doc, s.CatchBlock.Doc())
+
+	return prettier.Group{
+		Doc: doc,
+	}
+}
+
+func (s *TryStatement) String() string {
+	return Prettier(s)
+}
+
+func (s *TryStatement) MarshalJSON() ([]byte, error) {
+	type Alias TryStatement
+	return json.Marshal(&struct {
+		Type string
+		Range
+		*Alias
+	}{
+		Type:  "TryStatement",
+		Range: NewUnmeteredRangeFromPositioned(s),
+		Alias: (*Alias)(s),
+	})
+}
+
 // EmitStatement
 
 type EmitStatement struct {
@@ -823,6 +922,12 @@ func (s *SwitchStatement) Walk(walkChild func(Element)) {
 		if expression != nil {
 			walkChild(expression)
 		}
+		if switchCase.Pattern != nil {
+			switchCase.Pattern.Walk(walkChild)
+		}
+		if switchCase.Guard != nil {
+			walkChild(switchCase.Guard)
+		}
 		walkStatements(walkChild, switchCase.Statements)
 	}
 }
@@ -879,13 +984,28 @@ func (s *SwitchStatement) MarshalJSON() ([]byte, error) {
 }
 
 // SwitchCase
+//
+// A case either compares the tested value with an expression,
+// e.g. `case 1:`, or matches it against a pattern, e.g. `case let x?:`.
+// The default case has neither an expression nor a pattern.
 
 type SwitchCase struct {
 	Expression Expression
+	// Pattern is the pattern which the tested value is matched against,
+	// and which binds constants, e.g. `x?` in `case let x?:`
+	Pattern Pattern `json:",omitempty"`
+	// Guard is the condition which must hold for the case to be taken,
+	// e.g. `x > 5` in `case let x where x > 5:`
+	Guard      Expression `json:",omitempty"`
 	Statements []Statement
 	Range
 }
 
+// IsDefault returns true if the case is the default case
+func (s *SwitchCase) IsDefault() bool {
+	return s.Expression == nil && s.Pattern == nil
+}
+
 func (s *SwitchCase) MarshalJSON() ([]byte, error) {
 	type Alias SwitchCase
 	return json.Marshal(&struct {
@@ -898,6 +1018,8 @@ func (s *SwitchCase) MarshalJSON() ([]byte, error) {
 }
 
 const switchCaseKeywordSpaceDoc = prettier.Text("case ")
+const switchCaseLetKeywordSpaceDoc = prettier.Text("let ")
+const switchCaseWhereKeywordSpaceDoc = prettier.Text(" where ")
 const switchCaseColonSymbolDoc = prettier.Text(":")
 const switchCaseDefaultKeywordSpaceDoc = prettier.Text("default:")
 
@@ -906,17 +1028,38 @@ func (s *SwitchCase) Doc() prettier.Doc {
 		Doc: StatementsDoc(s.Statements),
 	}
 
-	if s.Expression == nil {
+	if s.IsDefault() {
 		return prettier.Concat{
 			switchCaseDefaultKeywordSpaceDoc,
 			statementsDoc,
 		}
 	}
 
-	return prettier.Concat{
+	doc := prettier.Concat{
 		switchCaseKeywordSpaceDoc,
-		s.Expression.Doc(),
+	}
+
+	if s.Pattern != nil {
+		doc = append(
+			doc,
+			switchCaseLetKeywordSpaceDoc,
+			s.Pattern.Doc(),
+		)
+	} else {
+		doc = append(doc, s.Expression.Doc())
+	}
+
+	if s.Guard != nil {
+		doc = append(
+			doc,
+			switchCaseWhereKeywordSpaceDoc,
+			s.Guard.Doc(),
+		)
+	}
+
+	return append(
+		doc,
 		switchCaseColonSymbolDoc,
 		statementsDoc,
-	}
+	)
 }
@@ -591,6 +591,173 @@ func TestWhileStatement_String(t *testing.T) {
 	)
 }
 
+func TestTryStatement_MarshalJSON(t *testing.T) {
+
+	t.Parallel()
+
+	stmt := &TryStatement{
+		Block: &Block{
+			Statements: []Statement{},
+			Range: Range{
+				StartPos: Position{Offset: 1, Line: 2, Column: 3},
+				EndPos:   Position{Offset: 4, Line: 5, Column: 6},
+			},
+		},
+		ErrorIdentifier: &Identifier{
+			Identifier: "err",
+			Pos:        Position{Offset: 7, Line: 8, Column: 9},
+		},
+		CatchBlock: &Block{
+			Statements: []Statement{},
+			Range: Range{
+				StartPos: Position{Offset: 10, Line: 11, Column: 12},
+				EndPos:   Position{Offset: 13, Line: 14, Column: 15},
+			},
+		},
+		StartPos: Position{Offset: 16, Line: 17, Column: 18},
+	}
+
+	actual, err := json.Marshal(stmt)
+	require.NoError(t, err)
+
+	assert.JSONEq(t,
+		`
+        {
+            "Type": "TryStatement",
+            "Block": {
+                "Type": "Block",
+                "Statements": [],
+                "StartPos": {"Offset": 1, "Line": 2, "Column": 3},
+                "EndPos": {"Offset": 4, "Line": 5, "Column": 6}
+            },
+            "ErrorIdentifier": {
+                "Identifier": "err",
+                "StartPos": {"Offset": 7, "Line": 8, "Column": 9},
+                "EndPos": {"Offset": 9, "Line": 8, "Column": 11}
+            },
+            "CatchBlock": {
+                "Type": "Block",
+                "Statements": [],
+                "StartPos": {"Offset": 10, "Line": 11, "Column": 12},
+                "EndPos": {"Offset": 13, "Line": 14, "Column": 15}
+            },
+            "StartPos": {"Offset": 16, "Line": 17, "Column": 18},
+            "EndPos":   {"Offset": 13, "Line": 14, "Column": 15}
+        }
+        `,
+		string(actual),
+	)
+}
+
+func TestTryStatement_Doc(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("without error identifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		stmt := &TryStatement{
+			Block: &Block{
+				Statements: []Statement{},
+			},
+			CatchBlock: &Block{
+				Statements: []Statement{},
+			},
+		}
+
+		assert.Equal(t,
+			prettier.Group{
+				Doc: prettier.Concat{
+					prettier.Text("try "),
+					prettier.Text("{}"),
+					prettier.Text(" catch "),
+					prettier.Text("{}"),
+				},
+			},
+			stmt.Doc(),
+		)
+	})
+
+	t.Run("with error identifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		stmt := &TryStatement{
+			Block: &Block{
+				Statements: []Statement{},
+			},
+			ErrorIdentifier: &Identifier{
+				Identifier: "err",
+			},
+			CatchBlock: &Block{
+				Statements: []Statement{},
+			},
+		}
+
+		assert.Equal(t,
+			prettier.Group{
+				Doc: prettier.Concat{
+					prettier.Text("try "),
+					prettier.Text("{}"),
+					prettier.Text(" catch "),
+					prettier.Text("("),
+					prettier.Text("err"),
+					prettier.Text(") "),
+					prettier.Text("{}"),
+				},
+			},
+			stmt.Doc(),
+		)
+	})
+}
+
+func TestTryStatement_String(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("without error identifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		stmt := &TryStatement{
+			Block: &Block{
+				Statements: []Statement{},
+			},
+			CatchBlock: &Block{
+				Statements: []Statement{},
+			},
+		}
+
+		assert.Equal(t,
+			"try {} catch {}",
+			stmt.String(),
+		)
+	})
+
+	t.Run("with error identifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		stmt := &TryStatement{
+			Block: &Block{
+				Statements: []Statement{},
+			},
+			ErrorIdentifier: &Identifier{
+				Identifier: "err",
+			},
+			CatchBlock: &Block{
+				Statements: []Statement{},
+			},
+		}
+
+		assert.Equal(t,
+			"try {} catch (err) {}",
+			stmt.String(),
+		)
+	})
+}
+
 func TestForStatement_MarshalJSON(t *testing.T) {
 
 	t.Parallel()
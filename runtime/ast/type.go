@@ -429,6 +429,65 @@ func (t *DictionaryType) CheckEqual(other Type, checker TypeEqualityChecker) err
 	return checker.CheckDictionaryTypeEquality(t, other)
 }
 
+// SetType
+
+type SetType struct {
+	ElementType Type
+	Range
+}
+
+var _ Type = &SetType{}
+
+func NewSetType(
+	memoryGauge common.MemoryGauge,
+	elementType Type,
+	astRange Range,
+) *SetType {
+	common.UseMemory(memoryGauge, common.SetTypeMemoryUsage)
+	return &SetType{
+		ElementType: elementType,
+		Range:       astRange,
+	}
+}
+
+func (*SetType) isType() {}
+
+func (t *SetType) String() string {
+	return Prettier(t)
+}
+
+const setTypeStartDoc = prettier.Text("{")
+const setTypeEndDoc = prettier.Text("}")
+
+func (t *SetType) Doc() prettier.Doc {
+	return prettier.Concat{
+		setTypeStartDoc,
+		prettier.Indent{
+			Doc: prettier.Concat{
+				prettier.SoftLine{},
+				t.ElementType.Doc(),
+			},
+		},
+		prettier.SoftLine{},
+		setTypeEndDoc,
+	}
+}
+
+func (t *SetType) MarshalJSON() ([]byte, error) {
+	type Alias SetType
+	return json.Marshal(&struct {
+		Type string
+		*Alias
+	}{
+		Type:  "SetType",
+		Alias: (*Alias)(t),
+	})
+}
+
+func (t *SetType) CheckEqual(other Type, checker TypeEqualityChecker) error {
+	return checker.CheckSetTypeEquality(t, other)
+}
+
 // FunctionType
 
 type FunctionType struct {
@@ -776,6 +835,7 @@ type TypeEqualityChecker interface {
 	CheckVariableSizedTypeEquality(*VariableSizedType, Type) error
 	CheckConstantSizedTypeEquality(*ConstantSizedType, Type) error
 	CheckDictionaryTypeEquality(*DictionaryType, Type) error
+	CheckSetTypeEquality(*SetType, Type) error
 	CheckFunctionTypeEquality(*FunctionType, Type) error
 	CheckReferenceTypeEquality(*ReferenceType, Type) error
 	CheckRestrictedTypeEquality(*RestrictedType, Type) error
@@ -652,6 +652,94 @@ func TestDictionaryType_MarshalJSON(t *testing.T) {
 	)
 }
 
+func TestSetType_Doc(t *testing.T) {
+
+	t.Parallel()
+
+	ty := &SetType{
+		ElementType: &NominalType{
+			Identifier: Identifier{
+				Identifier: "AB",
+			},
+		},
+	}
+
+	assert.Equal(t,
+		prettier.Concat{
+			prettier.Text("{"),
+			prettier.Indent{
+				Doc: prettier.Concat{
+					prettier.SoftLine{},
+					prettier.Text("AB"),
+				},
+			},
+			prettier.SoftLine{},
+			prettier.Text("}"),
+		},
+		ty.Doc(),
+	)
+}
+
+func TestSetType_String(t *testing.T) {
+
+	t.Parallel()
+
+	ty := &SetType{
+		ElementType: &NominalType{
+			Identifier: Identifier{
+				Identifier: "AB",
+			},
+		},
+	}
+
+	assert.Equal(t,
+		"{AB}",
+		ty.String(),
+	)
+}
+
+func TestSetType_MarshalJSON(t *testing.T) {
+
+	t.Parallel()
+
+	ty := &SetType{
+		ElementType: &NominalType{
+			Identifier: Identifier{
+				Identifier: "AB",
+				Pos:        Position{Offset: 1, Line: 2, Column: 3},
+			},
+		},
+		Range: Range{
+			StartPos: Position{Offset: 4, Line: 5, Column: 6},
+			EndPos:   Position{Offset: 7, Line: 8, Column: 9},
+		},
+	}
+
+	actual, err := json.Marshal(ty)
+	require.NoError(t, err)
+
+	assert.JSONEq(t,
+		`
+        {
+            "Type": "SetType",
+            "ElementType": {
+                "Type": "NominalType",
+                "Identifier": {
+                    "Identifier": "AB",
+                    "StartPos": {"Offset": 1, "Line": 2, "Column": 3},
+                    "EndPos": {"Offset": 2, "Line": 2, "Column": 4}
+                },
+                "StartPos": {"Offset": 1, "Line": 2, "Column": 3},
+                "EndPos": {"Offset": 2, "Line": 2, "Column": 4}
+            },
+            "StartPos": {"Offset": 4, "Line": 5, "Column": 6},
+            "EndPos": {"Offset": 7, "Line": 8, "Column": 9}
+        }
+        `,
+		string(actual),
+	)
+}
+
 func TestFunctionType_Doc(t *testing.T) {
 
 	t.Parallel()
@@ -30,6 +30,7 @@ type VariableDeclaration struct {
 	Access            Access
 	IsConstant        bool
 	Identifier        Identifier
+	Pattern           Pattern `json:",omitempty"`
 	TypeAnnotation    *TypeAnnotation
 	Value             Expression
 	Transfer          *Transfer
@@ -49,6 +50,7 @@ func NewVariableDeclaration(
 	access Access,
 	isLet bool,
 	identifier Identifier,
+	pattern Pattern,
 	typeAnnotation *TypeAnnotation,
 	value Expression,
 	transfer *Transfer,
@@ -63,6 +65,7 @@ func NewVariableDeclaration(
 		Access:         access,
 		IsConstant:     isLet,
 		Identifier:     identifier,
+		Pattern:        pattern,
 		TypeAnnotation: typeAnnotation,
 		Value:          value,
 		Transfer:       transfer,
@@ -105,6 +108,9 @@ func (d *VariableDeclaration) Accept(visitor Visitor) Repr {
 
 func (d *VariableDeclaration) Walk(walkChild func(Element)) {
 	// TODO: walk type
+	if d.Pattern != nil {
+		d.Pattern.Walk(walkChild)
+	}
 	walkChild(d.Value)
 	if d.SecondValue != nil {
 		walkChild(d.SecondValue)
@@ -143,8 +149,15 @@ func (d *VariableDeclaration) Doc() prettier.Doc {
 		keywordDoc = letKeywordDoc
 	}
 
+	var targetDoc prettier.Doc
+	if d.Pattern != nil {
+		targetDoc = d.Pattern.Doc()
+	} else {
+		targetDoc = prettier.Text(d.Identifier.Identifier)
+	}
+
 	identifierTypeDoc := prettier.Concat{
-		prettier.Text(d.Identifier.Identifier),
+		targetDoc,
 	}
 
 	if d.TypeAnnotation != nil {
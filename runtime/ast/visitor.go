@@ -69,6 +69,7 @@ type DeclarationVisitor interface {
 	VisitEnumCaseDeclaration(*EnumCaseDeclaration) Repr
 	VisitPragmaDeclaration(*PragmaDeclaration) Repr
 	VisitImportDeclaration(*ImportDeclaration) Repr
+	VisitTypeAliasDeclaration(*TypeAliasDeclaration) Repr
 }
 
 type StatementVisitor interface {
@@ -84,6 +85,7 @@ type StatementVisitor interface {
 	VisitAssignmentStatement(*AssignmentStatement) Repr
 	VisitSwapStatement(*SwapStatement) Repr
 	VisitExpressionStatement(*ExpressionStatement) Repr
+	VisitTryStatement(*TryStatement) Repr
 }
 
 type ExpressionVisitor interface {
@@ -228,6 +228,22 @@ func (i interpreterStorage) CheckHealth() error {
 	panic("unexpected CheckHealth call")
 }
 
+func (i interpreterStorage) Checkpoint() {
+	panic("unexpected Checkpoint call")
+}
+
+func (i interpreterStorage) CommitCheckpoint() {
+	panic("unexpected CommitCheckpoint call")
+}
+
+func (i interpreterStorage) RevertCheckpoint() error {
+	panic("unexpected RevertCheckpoint call")
+}
+
+func (i interpreterStorage) RecordSlab(_ atree.StorageID) error {
+	return nil
+}
+
 // load
 
 func load() {
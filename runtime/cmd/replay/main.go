@@ -0,0 +1,64 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/replay"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		exitWithError(errors.New("usage: replay <recording.json>"))
+	}
+
+	recording, err := replay.ReadRecording(os.Args[1])
+	if err != nil {
+		exitWithError(err)
+	}
+
+	value, err := replay.Replay(runtime.NewInterpreterRuntime(), recording)
+	if err != nil {
+		var divergenceErr replay.DivergenceError
+		var outcomeDivergenceErr replay.OutcomeDivergenceError
+		if errors.As(err, &divergenceErr) ||
+			errors.As(err, &outcomeDivergenceErr) ||
+			recording.Error == nil {
+
+			exitWithError(err)
+		}
+
+		// The replayed execution failed with the same error as the recorded execution
+		fmt.Println(err)
+		return
+	}
+
+	if value != nil {
+		fmt.Println(value)
+	}
+}
+
+func exitWithError(err error) {
+	_, _ = fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
@@ -0,0 +1,222 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/emulator"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/stdlib"
+)
+
+// testEnvironment is the isolated environment a single test function is run in.
+// It is the host of the test script, and the test framework of the Test contract.
+type testEnvironment struct {
+	*emulator.Emulator
+	runtime     runtime.Runtime
+	basePath    string
+	blockchains []*emulatorBlockchain
+}
+
+var _ runtime.Interface = &testEnvironment{}
+var _ stdlib.TestFramework = &testEnvironment{}
+
+func newTestEnvironment(rt runtime.Runtime, basePath string) *testEnvironment {
+	return &testEnvironment{
+		Emulator: emulator.NewEmulator(emulator.WithRuntime(rt)),
+		runtime:  rt,
+		basePath: basePath,
+	}
+}
+
+func (e *testEnvironment) NewEmulatorBlockchain() stdlib.TestBlockchain {
+	blockchain := &emulatorBlockchain{
+		emulator: emulator.NewEmulator(emulator.WithRuntime(e.runtime)),
+	}
+	e.blockchains = append(e.blockchains, blockchain)
+	return blockchain
+}
+
+// ReadFile reads the file at the given path.
+// Relative paths are relative to the directory of the test file.
+func (e *testEnvironment) ReadFile(path string) (string, error) {
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(e.basePath, path)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	return string(content), nil
+}
+
+// GetAccountContractCode returns the code of contracts deployed to the blockchains of the test,
+// so values of the contracts' types, e.g. events, can be imported into the test script.
+func (e *testEnvironment) GetAccountContractCode(address runtime.Address, name string) ([]byte, error) {
+	for _, blockchain := range e.blockchains {
+		code, err := blockchain.emulator.GetAccountContractCode(address, name)
+		if err != nil || code != nil {
+			return code, err
+		}
+	}
+
+	return e.Emulator.GetAccountContractCode(address, name)
+}
+
+// emulatorBlockchain is a blockchain of the Test contract, backed by an emulator.
+type emulatorBlockchain struct {
+	emulator *emulator.Emulator
+	// events are the events emitted by all successful transactions
+	events []cadence.Event
+}
+
+var _ stdlib.TestBlockchain = &emulatorBlockchain{}
+
+func (b *emulatorBlockchain) ExecuteScript(
+	inter *interpreter.Interpreter,
+	getLocationRange func() interpreter.LocationRange,
+	script string,
+	arguments []interpreter.Value,
+) (interpreter.Value, error) {
+
+	encodedArguments, err := encodeArguments(inter, getLocationRange, arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := b.emulator.ExecuteScript(
+		runtime.Script{
+			Source:    []byte(script),
+			Arguments: encodedArguments,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return runtime.ImportValue(inter, getLocationRange, value, nil)
+}
+
+func (b *emulatorBlockchain) ExecuteTransaction(
+	inter *interpreter.Interpreter,
+	getLocationRange func() interpreter.LocationRange,
+	code string,
+	signers []common.Address,
+	arguments []interpreter.Value,
+) error {
+
+	encodedArguments, err := encodeArguments(inter, getLocationRange, arguments)
+	if err != nil {
+		return err
+	}
+
+	err = b.emulator.ExecuteTransaction(
+		runtime.Script{
+			Source:    []byte(code),
+			Arguments: encodedArguments,
+		},
+		signers...,
+	)
+	if err != nil {
+		return err
+	}
+
+	b.events = append(b.events, b.emulator.Events()...)
+
+	return nil
+}
+
+func (b *emulatorBlockchain) CreateAccount() (common.Address, error) {
+	return b.emulator.CreateAccount(common.Address{})
+}
+
+func (b *emulatorBlockchain) DeployContract(name string, code string, address common.Address) error {
+	err := b.emulator.DeployContract(address, name, []byte(code))
+	if err != nil {
+		return err
+	}
+
+	b.events = append(b.events, b.emulator.Events()...)
+
+	return nil
+}
+
+func (b *emulatorBlockchain) CommitBlock() {
+	b.emulator.CommitBlock()
+}
+
+// Events returns the events emitted by contracts.
+// Events emitted by the blockchain itself, e.g. `flow.AccountCreated`, are not returned.
+// If an event cannot be imported into the test, an error is returned, which fails the test.
+func (b *emulatorBlockchain) Events(
+	inter *interpreter.Interpreter,
+	getLocationRange func() interpreter.LocationRange,
+) ([]interpreter.Value, error) {
+
+	values := make([]interpreter.Value, 0, len(b.events))
+
+	for _, event := range b.events {
+		if _, ok := event.EventType.Location.(common.AddressLocation); !ok {
+			continue
+		}
+
+		value, err := runtime.ImportValue(inter, getLocationRange, event, nil)
+		if err != nil {
+			return nil, fmt.Errorf("cannot import event `%s`: %w", event.EventType.ID(), err)
+		}
+
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+func encodeArguments(
+	inter *interpreter.Interpreter,
+	getLocationRange func() interpreter.LocationRange,
+	arguments []interpreter.Value,
+) ([][]byte, error) {
+
+	encodedArguments := make([][]byte, 0, len(arguments))
+
+	for _, argument := range arguments {
+		exportedArgument, err := runtime.ExportValue(argument, inter, getLocationRange)
+		if err != nil {
+			return nil, err
+		}
+
+		encodedArgument, err := jsoncdc.Encode(exportedArgument)
+		if err != nil {
+			return nil, err
+		}
+
+		encodedArguments = append(encodedArguments, encodedArgument)
+	}
+
+	return encodedArguments, nil
+}
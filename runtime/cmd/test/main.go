@@ -0,0 +1,108 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/onflow/cadence/runtime"
+)
+
+var coverProfileFlag = flag.String("coverprofile", "", "write a coverage report in JSON format to the given file")
+
+func main() {
+	flag.Parse()
+
+	paths := flag.Args()
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+
+	files, err := findTestFiles(paths)
+	if err != nil {
+		exitWithError(err)
+	}
+
+	var coverageReport *runtime.CoverageReport
+	if *coverProfileFlag != "" {
+		coverageReport = runtime.NewCoverageReport()
+	}
+
+	runner := newTestRunner(coverageReport)
+
+	passed := true
+
+	for _, file := range files {
+		results, err := runner.runFile(file)
+		if err != nil {
+			passed = false
+			fmt.Printf("--- FAIL: %s\n%s\n", file, indent(err.Error()))
+			continue
+		}
+
+		for _, result := range results {
+			if result.err != nil {
+				passed = false
+				fmt.Printf("--- FAIL: %s: %s\n", file, result.name)
+				for _, log := range result.logs {
+					fmt.Println(indent(log))
+				}
+				fmt.Println(indent(result.err.Error()))
+			} else {
+				fmt.Printf("--- PASS: %s: %s\n", file, result.name)
+			}
+		}
+	}
+
+	if coverageReport != nil {
+		err = writeCoverageReport(runner.testCoverage(), *coverProfileFlag)
+		if err != nil {
+			exitWithError(err)
+		}
+	}
+
+	if !passed {
+		fmt.Println("FAIL")
+		os.Exit(1)
+	}
+
+	fmt.Println("PASS")
+}
+
+func writeCoverageReport(coverageReport *runtime.CoverageReport, path string) error {
+	data, err := json.MarshalIndent(coverageReport, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0644)
+}
+
+func indent(message string) string {
+	return "    " + strings.ReplaceAll(message, "\n", "\n    ")
+}
+
+func exitWithError(err error) {
+	_, _ = fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
@@ -0,0 +1,217 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/stdlib"
+)
+
+const testFileSuffix = "_test.cdc"
+const testFunctionPrefix = "test"
+const setupFunctionName = "setup"
+
+// testScriptLocation is the location of the scripts which invoke the test functions
+var testScriptLocation = common.ScriptLocation{}
+
+type testResult struct {
+	name string
+	err  error
+	// logs are the messages logged by the test
+	logs []string
+}
+
+type testRunner struct {
+	runtime        runtime.Runtime
+	coverageReport *runtime.CoverageReport
+}
+
+// newTestRunner returns a new test runner.
+// If a coverage report is given, the coverage of the tests and the contracts they deploy is recorded in it.
+func newTestRunner(coverageReport *runtime.CoverageReport) *testRunner {
+	rt := runtime.NewInterpreterRuntime()
+	if coverageReport != nil {
+		rt.SetCoverageReport(coverageReport)
+	}
+
+	return &testRunner{
+		runtime:        rt,
+		coverageReport: coverageReport,
+	}
+}
+
+// runFile runs all test functions in the given test file.
+//
+// Test functions are the public functions which have no parameters and have a name starting with `test`.
+// If the file declares a `setup` function, it is called before each test function.
+// Each test function is run in its own, isolated environment.
+func (r *testRunner) runFile(path string) ([]testResult, error) {
+	code, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	location := common.StringLocation(path)
+	basePath := filepath.Dir(path)
+
+	program, err := r.runtime.ParseAndCheckProgram(
+		code,
+		r.newContext(newTestEnvironment(r.runtime, basePath), location),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var hasSetup bool
+	var testNames []string
+
+	for _, declaration := range program.Program.FunctionDeclarations() {
+		name := declaration.Identifier.Identifier
+
+		if name == setupFunctionName {
+			hasSetup = true
+			continue
+		}
+
+		if strings.HasPrefix(name, testFunctionPrefix) &&
+			declaration.Access == ast.AccessPublic &&
+			len(declaration.ParameterList.Parameters) == 0 {
+
+			testNames = append(testNames, name)
+		}
+	}
+
+	results := make([]testResult, 0, len(testNames))
+
+	for _, name := range testNames {
+		results = append(results, r.runTest(location, basePath, code, name, hasSetup))
+	}
+
+	return results, nil
+}
+
+// runTest runs the given test function in a new environment.
+//
+// The test function is imported into and invoked by a script,
+// so the coverage of the test file is recorded for its own location.
+func (r *testRunner) runTest(
+	location common.StringLocation,
+	basePath string,
+	code []byte,
+	name string,
+	hasSetup bool,
+) testResult {
+	environment := newTestEnvironment(r.runtime, basePath)
+	environment.SetCode(location, code)
+
+	var script strings.Builder
+
+	if hasSetup {
+		_, _ = fmt.Fprintf(&script, "import %s, %s from %q\n\n", setupFunctionName, name, location)
+		_, _ = fmt.Fprintf(&script, "pub fun %s() {\n    %s()\n    %s()\n}\n", sema.FunctionEntryPointName, setupFunctionName, name)
+	} else {
+		_, _ = fmt.Fprintf(&script, "import %s from %q\n\n", name, location)
+		_, _ = fmt.Fprintf(&script, "pub fun %s() {\n    %s()\n}\n", sema.FunctionEntryPointName, name)
+	}
+
+	_, err := r.runtime.ExecuteScript(
+		runtime.Script{
+			Source: []byte(script.String()),
+		},
+		r.newContext(environment, testScriptLocation),
+	)
+
+	return testResult{
+		name: name,
+		err:  err,
+		logs: environment.Logs(),
+	}
+}
+
+func (r *testRunner) newContext(environment *testEnvironment, location common.Location) runtime.Context {
+	return runtime.Context{
+		Interface:     environment,
+		Location:      location,
+		TestFramework: environment,
+	}
+}
+
+// testCoverage returns the coverage of the test files and the contracts deployed by the tests.
+// The coverage of scripts and transactions, e.g. the scripts invoking the test functions,
+// and the coverage of the Test contract are omitted.
+func (r *testRunner) testCoverage() *runtime.CoverageReport {
+	report := runtime.NewCoverageReport()
+
+	for locationID, coverage := range r.coverageReport.Coverage { //nolint:maprangecheck
+		switch {
+		case locationID == stdlib.TestContractLocation.ID(),
+			strings.HasPrefix(string(locationID), common.ScriptLocationPrefix+"."),
+			strings.HasPrefix(string(locationID), common.TransactionLocationPrefix+"."):
+
+			continue
+		}
+
+		report.Coverage[locationID] = coverage
+	}
+
+	return report
+}
+
+// findTestFiles returns the test files at the given paths.
+// Directories are searched recursively for files with the suffix `_test.cdc`.
+func findTestFiles(paths []string) ([]string, error) {
+	var files []string
+
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+
+		err = filepath.Walk(path, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if !info.IsDir() && strings.HasSuffix(path, testFileSuffix) {
+				files = append(files, path)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return files, nil
+}
@@ -0,0 +1,378 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/stdlib"
+)
+
+const testCounterContract = `
+  pub contract Counter {
+
+      pub event Incremented(count: Int)
+
+      pub var count: Int
+
+      init() {
+          self.count = 0
+      }
+
+      pub fun increment() {
+          self.count = self.count + 1
+          emit Incremented(count: self.count)
+      }
+  }
+`
+
+const testCounterTests = `
+  import Test
+
+  pub let blockchain = Test.newEmulatorBlockchain()
+  pub let account = blockchain.createAccount()
+
+  pub fun setup() {
+      let err = blockchain.deployContract(
+          name: "Counter",
+          code: Test.readFile("Counter.cdc"),
+          account: account
+      )
+      Test.expect(err, Test.beNil())
+  }
+
+  pub fun testIncrement() {
+      let result = blockchain.executeTransaction(
+          Test.Transaction(
+              code: "import Counter from 0x1; transaction { prepare(signer: AuthAccount) { Counter.increment() } }",
+              signers: [account],
+              arguments: []
+          )
+      )
+      Test.expect(result, Test.beSucceeded())
+      Test.assert(blockchain.events().length == 1)
+
+      let scriptResult = blockchain.executeScript(
+          "import Counter from 0x1; pub fun main(x: Int): Int { return Counter.count + x }",
+          [10]
+      )
+      Test.expect(scriptResult, Test.beSucceeded())
+      Test.expect(scriptResult.returnValue!, Test.equal(11))
+  }
+
+  pub fun testIsolation() {
+      // The increment of the other test is not visible
+      let scriptResult = blockchain.executeScript(
+          "import Counter from 0x1; pub fun main(): Int { return Counter.count }",
+          []
+      )
+      Test.expect(scriptResult.returnValue!, Test.equal(0))
+  }
+
+  pub fun testFailedTransaction() {
+      let result = blockchain.executeTransaction(
+          Test.Transaction(
+              code: "transaction { execute { panic(\"failed\") } }",
+              signers: [],
+              arguments: []
+          )
+      )
+      Test.expect(result, Test.beFailed())
+      Test.assert(result.error!.message.length > 0)
+  }
+
+  pub fun testFailing() {
+      log("failing")
+      Test.assert(1 == 2, message: "one is not two")
+  }
+
+  pub fun helper(_ x: Int) {}
+`
+
+func writeTestFiles(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+
+	for name, content := range files {
+		err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644)
+		require.NoError(t, err)
+	}
+
+	return dir
+}
+
+func TestRunFile(t *testing.T) {
+
+	t.Parallel()
+
+	dir := writeTestFiles(t, map[string]string{
+		"Counter.cdc":      testCounterContract,
+		"counter_test.cdc": testCounterTests,
+	})
+
+	runner := newTestRunner(nil)
+
+	results, err := runner.runFile(filepath.Join(dir, "counter_test.cdc"))
+	require.NoError(t, err)
+
+	require.Len(t, results, 4)
+
+	for _, result := range results[:3] {
+		assert.NoError(t, result.err, result.name)
+	}
+
+	assert.Equal(t, "testIncrement", results[0].name)
+	assert.Equal(t, "testIsolation", results[1].name)
+	assert.Equal(t, "testFailedTransaction", results[2].name)
+
+	failing := results[3]
+	assert.Equal(t, "testFailing", failing.name)
+	assert.Equal(t, []string{`"failing"`}, failing.logs)
+	require.ErrorAs(t, failing.err, &stdlib.AssertionError{})
+	assert.ErrorContains(t, failing.err, "one is not two")
+}
+
+func TestRunFileTestFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	dir := writeTestFiles(t, map[string]string{
+		"functions_test.cdc": `
+          pub fun testPublic() {}
+
+          access(all) fun testAll() {}
+
+          access(account) fun testAccount() {}
+
+          priv fun testPrivate() {}
+
+          pub fun testParameter(_ x: Int) {}
+        `,
+	})
+
+	runner := newTestRunner(nil)
+
+	results, err := runner.runFile(filepath.Join(dir, "functions_test.cdc"))
+	require.NoError(t, err)
+
+	names := make([]string, 0, len(results))
+	for _, result := range results {
+		assert.NoError(t, result.err, result.name)
+		names = append(names, result.name)
+	}
+
+	assert.Equal(t, []string{"testPublic", "testAll"}, names)
+}
+
+func TestRunFileEventImportFailure(t *testing.T) {
+
+	t.Parallel()
+
+	dir := writeTestFiles(t, map[string]string{
+		"Counter.cdc": testCounterContract,
+		"events_test.cdc": `
+          import Test
+
+          pub let blockchain = Test.newEmulatorBlockchain()
+          pub let account = blockchain.createAccount()
+
+          pub fun testEventsOfRemovedContract() {
+              let err = blockchain.deployContract(
+                  name: "Counter",
+                  code: Test.readFile("Counter.cdc"),
+                  account: account
+              )
+              Test.expect(err, Test.beNil())
+
+              let result = blockchain.executeTransaction(
+                  Test.Transaction(
+                      code: "import Counter from 0x1; transaction { prepare(signer: AuthAccount) { Counter.increment(); signer.contracts.remove(name: \"Counter\") } }",
+                      signers: [account],
+                      arguments: []
+                  )
+              )
+              Test.expect(result, Test.beSucceeded())
+
+              // The type of the emitted event can no longer be loaded
+              blockchain.events()
+          }
+        `,
+	})
+
+	runner := newTestRunner(nil)
+
+	results, err := runner.runFile(filepath.Join(dir, "events_test.cdc"))
+	require.NoError(t, err)
+
+	require.Len(t, results, 1)
+
+	result := results[0]
+	assert.Equal(t, "testEventsOfRemovedContract", result.name)
+	require.Error(t, result.err)
+	assert.ErrorContains(t, result.err, "cannot import event `A.0000000000000001.Counter.Incremented`")
+}
+
+func TestRunFileCheckError(t *testing.T) {
+
+	t.Parallel()
+
+	dir := writeTestFiles(t, map[string]string{
+		"invalid_test.cdc": `
+          import Test
+
+          pub fun testInvalid() {
+              Test.assert(1)
+          }
+        `,
+	})
+
+	runner := newTestRunner(nil)
+
+	_, err := runner.runFile(filepath.Join(dir, "invalid_test.cdc"))
+	require.Error(t, err)
+}
+
+func TestRunFileMatchers(t *testing.T) {
+
+	t.Parallel()
+
+	dir := writeTestFiles(t, map[string]string{
+		"matchers_test.cdc": `
+          import Test
+
+          pub fun testEqual() {
+              Test.expect(1, Test.equal(1))
+              Test.expect("a", Test.not(Test.equal("b")))
+              Test.expect([1, 2], Test.equal([1, 2]))
+          }
+
+          pub fun testCombined() {
+              Test.expect(2, Test.equal(1).or(Test.equal(2)))
+              Test.expect(2, Test.not(Test.equal(1)).and(Test.equal(2)))
+          }
+
+          pub fun testCustom() {
+              let bePositive = Test.Matcher(test: fun (value: AnyStruct): Bool {
+                  return (value as! Int) > 0
+              })
+              Test.expect(1, bePositive)
+          }
+
+          pub fun testMismatch() {
+              Test.expect(1, Test.equal(2))
+          }
+        `,
+	})
+
+	runner := newTestRunner(nil)
+
+	results, err := runner.runFile(filepath.Join(dir, "matchers_test.cdc"))
+	require.NoError(t, err)
+
+	require.Len(t, results, 4)
+
+	for _, result := range results[:3] {
+		assert.NoError(t, result.err, result.name)
+	}
+
+	assert.Equal(t, "testMismatch", results[3].name)
+	require.ErrorAs(t, results[3].err, &stdlib.AssertionError{})
+}
+
+func TestRunFileCoverage(t *testing.T) {
+
+	t.Parallel()
+
+	dir := writeTestFiles(t, map[string]string{
+		"Counter.cdc":      testCounterContract,
+		"counter_test.cdc": testCounterTests,
+	})
+
+	coverageReport := runtime.NewCoverageReport()
+	runner := newTestRunner(coverageReport)
+
+	path := filepath.Join(dir, "counter_test.cdc")
+
+	_, err := runner.runFile(path)
+	require.NoError(t, err)
+
+	report := runner.testCoverage()
+
+	counterLocationID := common.AddressLocation{
+		Address: common.MustBytesToAddress([]byte{0x1}),
+		Name:    "Counter",
+	}.ID()
+
+	assert.ElementsMatch(t,
+		[]common.LocationID{
+			common.StringLocation(path).ID(),
+			counterLocationID,
+		},
+		coverageLocationIDs(report),
+	)
+
+	// The body of the increment function was executed once
+	assert.Equal(t,
+		map[int]int{
+			9:  4,
+			13: 1,
+			14: 1,
+		},
+		report.Coverage[counterLocationID].LineHits,
+	)
+}
+
+func coverageLocationIDs(report *runtime.CoverageReport) []common.LocationID {
+	locationIDs := make([]common.LocationID, 0, len(report.Coverage))
+	for locationID := range report.Coverage { //nolint:maprangecheck
+		locationIDs = append(locationIDs, locationID)
+	}
+	return locationIDs
+}
+
+func TestFindTestFiles(t *testing.T) {
+
+	t.Parallel()
+
+	dir := writeTestFiles(t, map[string]string{
+		"Counter.cdc":      testCounterContract,
+		"counter_test.cdc": testCounterTests,
+	})
+
+	nested := filepath.Join(dir, "nested")
+	require.NoError(t, os.Mkdir(nested, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(nested, "nested_test.cdc"), nil, 0644))
+
+	files, err := findTestFiles([]string{dir})
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		[]string{
+			filepath.Join(dir, "counter_test.cdc"),
+			filepath.Join(nested, "nested_test.cdc"),
+		},
+		files,
+	)
+}
@@ -35,12 +35,15 @@ const (
 	CompositeKindContract
 	CompositeKindEvent
 	CompositeKindEnum
+	CompositeKindNewtype
 )
 
 func CompositeKindCount() int {
 	return len(_CompositeKind_index) - 1
 }
 
+// AllCompositeKinds does not include CompositeKindNewtype:
+// newtypes are declared with only an underlying type, and have no body.
 var AllCompositeKinds = []CompositeKind{
 	CompositeKindStructure,
 	CompositeKindResource,
@@ -67,6 +70,8 @@ func (k CompositeKind) Name() string {
 		return "event"
 	case CompositeKindEnum:
 		return "enum"
+	case CompositeKindNewtype:
+		return "newtype"
 	}
 
 	panic(errors.NewUnreachableError())
@@ -84,6 +89,8 @@ func (k CompositeKind) Keyword() string {
 		return "event"
 	case CompositeKindEnum:
 		return "enum"
+	case CompositeKindNewtype:
+		return "newtype"
 	}
 
 	panic(errors.NewUnreachableError())
@@ -120,6 +127,12 @@ func (k CompositeKind) DeclarationKind(isInterface bool) DeclarationKind {
 			return DeclarationKindUnknown
 		}
 		return DeclarationKindEnum
+
+	case CompositeKindNewtype:
+		if isInterface {
+			return DeclarationKindUnknown
+		}
+		return DeclarationKindNewtype
 	}
 
 	panic(errors.NewUnreachableError())
@@ -169,7 +182,8 @@ func (k CompositeKind) SupportsInterfaces() bool {
 		return true
 
 	case CompositeKindEvent,
-		CompositeKindEnum:
+		CompositeKindEnum,
+		CompositeKindNewtype:
 
 		return false
 	}
@@ -57,8 +57,8 @@ const (
 	ComputationKindCreateArrayValue
 	ComputationKindTransferArrayValue
 	ComputationKindDestroyArrayValue
-	_
-	_
+	ComputationKindIterateArrayValue
+	ComputationKindSortArrayValue
 	_
 	_
 	_
@@ -72,6 +72,7 @@ const (
 	ComputationKindCreateDictionaryValue
 	ComputationKindTransferDictionaryValue
 	ComputationKindDestroyDictionaryValue
+	ComputationKindIterateDictionaryValue
 	_
 	_
 	_
@@ -83,10 +84,9 @@ const (
 	_
 	_
 	_
-	_
-	_
-	_
-	_
+	ComputationKindCreateSetValue
+	ComputationKindTransferSetValue
+	ComputationKindIterateSetValue
 	_
 	_
 	_
@@ -18,9 +18,15 @@ func _() {
 	_ = x[ComputationKindCreateArrayValue-1025]
 	_ = x[ComputationKindTransferArrayValue-1026]
 	_ = x[ComputationKindDestroyArrayValue-1027]
+	_ = x[ComputationKindIterateArrayValue-1028]
+	_ = x[ComputationKindSortArrayValue-1029]
 	_ = x[ComputationKindCreateDictionaryValue-1040]
 	_ = x[ComputationKindTransferDictionaryValue-1041]
 	_ = x[ComputationKindDestroyDictionaryValue-1042]
+	_ = x[ComputationKindIterateDictionaryValue-1043]
+	_ = x[ComputationKindCreateSetValue-1055]
+	_ = x[ComputationKindTransferSetValue-1056]
+	_ = x[ComputationKindIterateSetValue-1057]
 	_ = x[ComputationKindSTDLIBPanic-1100]
 	_ = x[ComputationKindSTDLIBAssert-1101]
 	_ = x[ComputationKindSTDLIBUnsafeRandom-1102]
@@ -32,19 +38,21 @@ const (
 	_ComputationKind_name_0 = "Unknown"
 	_ComputationKind_name_1 = "StatementLoopFunctionInvocation"
 	_ComputationKind_name_2 = "CreateCompositeValueTransferCompositeValueDestroyCompositeValue"
-	_ComputationKind_name_3 = "CreateArrayValueTransferArrayValueDestroyArrayValue"
-	_ComputationKind_name_4 = "CreateDictionaryValueTransferDictionaryValueDestroyDictionaryValue"
-	_ComputationKind_name_5 = "STDLIBPanicSTDLIBAssertSTDLIBUnsafeRandom"
-	_ComputationKind_name_6 = "STDLIBRLPDecodeStringSTDLIBRLPDecodeList"
+	_ComputationKind_name_3 = "CreateArrayValueTransferArrayValueDestroyArrayValueIterateArrayValueSortArrayValue"
+	_ComputationKind_name_4 = "CreateDictionaryValueTransferDictionaryValueDestroyDictionaryValueIterateDictionaryValue"
+	_ComputationKind_name_5 = "CreateSetValueTransferSetValueIterateSetValue"
+	_ComputationKind_name_6 = "STDLIBPanicSTDLIBAssertSTDLIBUnsafeRandom"
+	_ComputationKind_name_7 = "STDLIBRLPDecodeStringSTDLIBRLPDecodeList"
 )
 
 var (
 	_ComputationKind_index_1 = [...]uint8{0, 9, 13, 31}
 	_ComputationKind_index_2 = [...]uint8{0, 20, 42, 63}
-	_ComputationKind_index_3 = [...]uint8{0, 16, 34, 51}
-	_ComputationKind_index_4 = [...]uint8{0, 21, 44, 66}
-	_ComputationKind_index_5 = [...]uint8{0, 11, 23, 41}
-	_ComputationKind_index_6 = [...]uint8{0, 21, 40}
+	_ComputationKind_index_3 = [...]uint8{0, 16, 34, 51, 68, 82}
+	_ComputationKind_index_4 = [...]uint8{0, 21, 44, 66, 88}
+	_ComputationKind_index_5 = [...]uint8{0, 14, 30, 45}
+	_ComputationKind_index_6 = [...]uint8{0, 11, 23, 41}
+	_ComputationKind_index_7 = [...]uint8{0, 21, 40}
 )
 
 func (i ComputationKind) String() string {
@@ -57,18 +65,21 @@ func (i ComputationKind) String() string {
 	case 1010 <= i && i <= 1012:
 		i -= 1010
 		return _ComputationKind_name_2[_ComputationKind_index_2[i]:_ComputationKind_index_2[i+1]]
-	case 1025 <= i && i <= 1027:
+	case 1025 <= i && i <= 1029:
 		i -= 1025
 		return _ComputationKind_name_3[_ComputationKind_index_3[i]:_ComputationKind_index_3[i+1]]
-	case 1040 <= i && i <= 1042:
+	case 1040 <= i && i <= 1043:
 		i -= 1040
 		return _ComputationKind_name_4[_ComputationKind_index_4[i]:_ComputationKind_index_4[i+1]]
+	case 1055 <= i && i <= 1057:
+		i -= 1055
+		return _ComputationKind_name_5[_ComputationKind_index_5[i]:_ComputationKind_index_5[i+1]]
 	case 1100 <= i && i <= 1102:
 		i -= 1100
-		return _ComputationKind_name_5[_ComputationKind_index_5[i]:_ComputationKind_index_5[i+1]]
+		return _ComputationKind_name_6[_ComputationKind_index_6[i]:_ComputationKind_index_6[i+1]]
 	case 1108 <= i && i <= 1109:
 		i -= 1108
-		return _ComputationKind_name_6[_ComputationKind_index_6[i]:_ComputationKind_index_6[i+1]]
+		return _ComputationKind_name_7[_ComputationKind_index_7[i]:_ComputationKind_index_7[i+1]]
 	default:
 		return "ComputationKind(" + strconv.FormatInt(int64(i), 10) + ")"
 	}
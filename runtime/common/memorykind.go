@@ -36,6 +36,7 @@ const (
 	MemoryKindNumberValue
 	MemoryKindArrayValueBase
 	MemoryKindDictionaryValueBase
+	MemoryKindSetValueBase
 	MemoryKindCompositeValueBase
 	MemoryKindSimpleCompositeValueBase
 	MemoryKindOptionalValue
@@ -70,6 +71,7 @@ const (
 	MemoryKindVariableSizedStaticType
 	MemoryKindConstantSizedStaticType
 	MemoryKindDictionaryStaticType
+	MemoryKindSetStaticType
 	MemoryKindOptionalStaticType
 	MemoryKindRestrictedStaticType
 	MemoryKindReferenceStaticType
@@ -88,6 +90,7 @@ const (
 	MemoryKindCadenceArrayValueBase
 	MemoryKindCadenceArrayValueLength
 	MemoryKindCadenceDictionaryValue
+	MemoryKindCadenceSetValue
 	MemoryKindCadenceKeyValuePair
 	MemoryKindCadenceStructValueBase
 	MemoryKindCadenceStructValueSize
@@ -99,6 +102,8 @@ const (
 	MemoryKindCadenceContractValueSize
 	MemoryKindCadenceEnumValueBase
 	MemoryKindCadenceEnumValueSize
+	MemoryKindCadenceNewtypeValueBase
+	MemoryKindCadenceNewtypeValueSize
 	MemoryKindCadenceLinkValue
 	MemoryKindCadencePathValue
 	MemoryKindCadenceTypeValue
@@ -110,6 +115,7 @@ const (
 	MemoryKindCadenceVariableSizedArrayType
 	MemoryKindCadenceConstantSizedArrayType
 	MemoryKindCadenceDictionaryType
+	MemoryKindCadenceSetType
 	MemoryKindCadenceField
 	MemoryKindCadenceParameter
 	MemoryKindCadenceStructType
@@ -124,6 +130,7 @@ const (
 	MemoryKindCadenceRestrictedType
 	MemoryKindCadenceCapabilityType
 	MemoryKindCadenceEnumType
+	MemoryKindCadenceNewtypeType
 
 	// Misc
 
@@ -152,6 +159,8 @@ const (
 	MemoryKindFunctionBlock
 	MemoryKindParameter
 	MemoryKindParameterList
+	MemoryKindTypeParameter
+	MemoryKindTypeParameterList
 	MemoryKindTransfer
 	MemoryKindMembers
 	MemoryKindTypeAnnotation
@@ -164,9 +173,11 @@ const (
 	MemoryKindFieldDeclaration
 	MemoryKindTransactionDeclaration
 	MemoryKindImportDeclaration
+	MemoryKindImportHash
 	MemoryKindVariableDeclaration
 	MemoryKindSpecialFunctionDeclaration
 	MemoryKindPragmaDeclaration
+	MemoryKindTypeAliasDeclaration
 
 	MemoryKindAssignmentStatement
 	MemoryKindBreakStatement
@@ -179,6 +190,7 @@ const (
 	MemoryKindSwapStatement
 	MemoryKindSwitchStatement
 	MemoryKindWhileStatement
+	MemoryKindTryStatement
 
 	MemoryKindBooleanExpression
 	MemoryKindNilExpression
@@ -210,8 +222,16 @@ const (
 	MemoryKindOptionalType
 	MemoryKindReferenceType
 	MemoryKindRestrictedType
+	MemoryKindSetType
 	MemoryKindVariableSizedType
 
+	MemoryKindIdentifierPattern
+	MemoryKindArrayPattern
+	MemoryKindDictionaryPattern
+	MemoryKindCompositePattern
+	MemoryKindOptionalPattern
+	MemoryKindTypePattern
+
 	MemoryKindPosition
 	MemoryKindRange
 
@@ -223,6 +243,7 @@ const (
 	MemoryKindVariableSizedSemaType
 	MemoryKindConstantSizedSemaType
 	MemoryKindDictionarySemaType
+	MemoryKindSetSemaType
 	MemoryKindOptionalSemaType
 	MemoryKindRestrictedSemaType
 	MemoryKindReferenceSemaType
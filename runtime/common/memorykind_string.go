@@ -16,181 +16,201 @@ func _() {
 	_ = x[MemoryKindNumberValue-5]
 	_ = x[MemoryKindArrayValueBase-6]
 	_ = x[MemoryKindDictionaryValueBase-7]
-	_ = x[MemoryKindCompositeValueBase-8]
-	_ = x[MemoryKindSimpleCompositeValueBase-9]
-	_ = x[MemoryKindOptionalValue-10]
-	_ = x[MemoryKindNilValue-11]
-	_ = x[MemoryKindVoidValue-12]
-	_ = x[MemoryKindTypeValue-13]
-	_ = x[MemoryKindPathValue-14]
-	_ = x[MemoryKindCapabilityValue-15]
-	_ = x[MemoryKindLinkValue-16]
-	_ = x[MemoryKindStorageReferenceValue-17]
-	_ = x[MemoryKindEphemeralReferenceValue-18]
-	_ = x[MemoryKindInterpretedFunctionValue-19]
-	_ = x[MemoryKindHostFunctionValue-20]
-	_ = x[MemoryKindBoundFunctionValue-21]
-	_ = x[MemoryKindBigInt-22]
-	_ = x[MemoryKindSimpleCompositeValue-23]
-	_ = x[MemoryKindAtreeArrayDataSlab-24]
-	_ = x[MemoryKindAtreeArrayMetaDataSlab-25]
-	_ = x[MemoryKindAtreeArrayElementOverhead-26]
-	_ = x[MemoryKindAtreeMapDataSlab-27]
-	_ = x[MemoryKindAtreeMapMetaDataSlab-28]
-	_ = x[MemoryKindAtreeMapElementOverhead-29]
-	_ = x[MemoryKindAtreeMapPreAllocatedElement-30]
-	_ = x[MemoryKindAtreeEncodedSlab-31]
-	_ = x[MemoryKindPrimitiveStaticType-32]
-	_ = x[MemoryKindCompositeStaticType-33]
-	_ = x[MemoryKindInterfaceStaticType-34]
-	_ = x[MemoryKindVariableSizedStaticType-35]
-	_ = x[MemoryKindConstantSizedStaticType-36]
-	_ = x[MemoryKindDictionaryStaticType-37]
-	_ = x[MemoryKindOptionalStaticType-38]
-	_ = x[MemoryKindRestrictedStaticType-39]
-	_ = x[MemoryKindReferenceStaticType-40]
-	_ = x[MemoryKindCapabilityStaticType-41]
-	_ = x[MemoryKindFunctionStaticType-42]
-	_ = x[MemoryKindCadenceVoidValue-43]
-	_ = x[MemoryKindCadenceOptionalValue-44]
-	_ = x[MemoryKindCadenceBoolValue-45]
-	_ = x[MemoryKindCadenceStringValue-46]
-	_ = x[MemoryKindCadenceCharacterValue-47]
-	_ = x[MemoryKindCadenceAddressValue-48]
-	_ = x[MemoryKindCadenceIntValue-49]
-	_ = x[MemoryKindCadenceNumberValue-50]
-	_ = x[MemoryKindCadenceArrayValueBase-51]
-	_ = x[MemoryKindCadenceArrayValueLength-52]
-	_ = x[MemoryKindCadenceDictionaryValue-53]
-	_ = x[MemoryKindCadenceKeyValuePair-54]
-	_ = x[MemoryKindCadenceStructValueBase-55]
-	_ = x[MemoryKindCadenceStructValueSize-56]
-	_ = x[MemoryKindCadenceResourceValueBase-57]
-	_ = x[MemoryKindCadenceResourceValueSize-58]
-	_ = x[MemoryKindCadenceEventValueBase-59]
-	_ = x[MemoryKindCadenceEventValueSize-60]
-	_ = x[MemoryKindCadenceContractValueBase-61]
-	_ = x[MemoryKindCadenceContractValueSize-62]
-	_ = x[MemoryKindCadenceEnumValueBase-63]
-	_ = x[MemoryKindCadenceEnumValueSize-64]
-	_ = x[MemoryKindCadenceLinkValue-65]
-	_ = x[MemoryKindCadencePathValue-66]
-	_ = x[MemoryKindCadenceTypeValue-67]
-	_ = x[MemoryKindCadenceCapabilityValue-68]
-	_ = x[MemoryKindCadenceSimpleType-69]
-	_ = x[MemoryKindCadenceOptionalType-70]
-	_ = x[MemoryKindCadenceVariableSizedArrayType-71]
-	_ = x[MemoryKindCadenceConstantSizedArrayType-72]
-	_ = x[MemoryKindCadenceDictionaryType-73]
-	_ = x[MemoryKindCadenceField-74]
-	_ = x[MemoryKindCadenceParameter-75]
-	_ = x[MemoryKindCadenceStructType-76]
-	_ = x[MemoryKindCadenceResourceType-77]
-	_ = x[MemoryKindCadenceEventType-78]
-	_ = x[MemoryKindCadenceContractType-79]
-	_ = x[MemoryKindCadenceStructInterfaceType-80]
-	_ = x[MemoryKindCadenceResourceInterfaceType-81]
-	_ = x[MemoryKindCadenceContractInterfaceType-82]
-	_ = x[MemoryKindCadenceFunctionType-83]
-	_ = x[MemoryKindCadenceReferenceType-84]
-	_ = x[MemoryKindCadenceRestrictedType-85]
-	_ = x[MemoryKindCadenceCapabilityType-86]
-	_ = x[MemoryKindCadenceEnumType-87]
-	_ = x[MemoryKindRawString-88]
-	_ = x[MemoryKindAddressLocation-89]
-	_ = x[MemoryKindBytes-90]
-	_ = x[MemoryKindVariable-91]
-	_ = x[MemoryKindCompositeTypeInfo-92]
-	_ = x[MemoryKindCompositeField-93]
-	_ = x[MemoryKindInvocation-94]
-	_ = x[MemoryKindStorageMap-95]
-	_ = x[MemoryKindStorageKey-96]
-	_ = x[MemoryKindValueToken-97]
-	_ = x[MemoryKindSyntaxToken-98]
-	_ = x[MemoryKindSpaceToken-99]
-	_ = x[MemoryKindProgram-100]
-	_ = x[MemoryKindIdentifier-101]
-	_ = x[MemoryKindArgument-102]
-	_ = x[MemoryKindBlock-103]
-	_ = x[MemoryKindFunctionBlock-104]
-	_ = x[MemoryKindParameter-105]
-	_ = x[MemoryKindParameterList-106]
-	_ = x[MemoryKindTransfer-107]
-	_ = x[MemoryKindMembers-108]
-	_ = x[MemoryKindTypeAnnotation-109]
-	_ = x[MemoryKindDictionaryEntry-110]
-	_ = x[MemoryKindFunctionDeclaration-111]
-	_ = x[MemoryKindCompositeDeclaration-112]
-	_ = x[MemoryKindInterfaceDeclaration-113]
-	_ = x[MemoryKindEnumCaseDeclaration-114]
-	_ = x[MemoryKindFieldDeclaration-115]
-	_ = x[MemoryKindTransactionDeclaration-116]
-	_ = x[MemoryKindImportDeclaration-117]
-	_ = x[MemoryKindVariableDeclaration-118]
-	_ = x[MemoryKindSpecialFunctionDeclaration-119]
-	_ = x[MemoryKindPragmaDeclaration-120]
-	_ = x[MemoryKindAssignmentStatement-121]
-	_ = x[MemoryKindBreakStatement-122]
-	_ = x[MemoryKindContinueStatement-123]
-	_ = x[MemoryKindEmitStatement-124]
-	_ = x[MemoryKindExpressionStatement-125]
-	_ = x[MemoryKindForStatement-126]
-	_ = x[MemoryKindIfStatement-127]
-	_ = x[MemoryKindReturnStatement-128]
-	_ = x[MemoryKindSwapStatement-129]
-	_ = x[MemoryKindSwitchStatement-130]
-	_ = x[MemoryKindWhileStatement-131]
-	_ = x[MemoryKindBooleanExpression-132]
-	_ = x[MemoryKindNilExpression-133]
-	_ = x[MemoryKindStringExpression-134]
-	_ = x[MemoryKindIntegerExpression-135]
-	_ = x[MemoryKindFixedPointExpression-136]
-	_ = x[MemoryKindArrayExpression-137]
-	_ = x[MemoryKindDictionaryExpression-138]
-	_ = x[MemoryKindIdentifierExpression-139]
-	_ = x[MemoryKindInvocationExpression-140]
-	_ = x[MemoryKindMemberExpression-141]
-	_ = x[MemoryKindIndexExpression-142]
-	_ = x[MemoryKindConditionalExpression-143]
-	_ = x[MemoryKindUnaryExpression-144]
-	_ = x[MemoryKindBinaryExpression-145]
-	_ = x[MemoryKindFunctionExpression-146]
-	_ = x[MemoryKindCastingExpression-147]
-	_ = x[MemoryKindCreateExpression-148]
-	_ = x[MemoryKindDestroyExpression-149]
-	_ = x[MemoryKindReferenceExpression-150]
-	_ = x[MemoryKindForceExpression-151]
-	_ = x[MemoryKindPathExpression-152]
-	_ = x[MemoryKindConstantSizedType-153]
-	_ = x[MemoryKindDictionaryType-154]
-	_ = x[MemoryKindFunctionType-155]
-	_ = x[MemoryKindInstantiationType-156]
-	_ = x[MemoryKindNominalType-157]
-	_ = x[MemoryKindOptionalType-158]
-	_ = x[MemoryKindReferenceType-159]
-	_ = x[MemoryKindRestrictedType-160]
-	_ = x[MemoryKindVariableSizedType-161]
-	_ = x[MemoryKindPosition-162]
-	_ = x[MemoryKindRange-163]
-	_ = x[MemoryKindElaboration-164]
-	_ = x[MemoryKindActivation-165]
-	_ = x[MemoryKindActivationEntries-166]
-	_ = x[MemoryKindVariableSizedSemaType-167]
-	_ = x[MemoryKindConstantSizedSemaType-168]
-	_ = x[MemoryKindDictionarySemaType-169]
-	_ = x[MemoryKindOptionalSemaType-170]
-	_ = x[MemoryKindRestrictedSemaType-171]
-	_ = x[MemoryKindReferenceSemaType-172]
-	_ = x[MemoryKindCapabilitySemaType-173]
-	_ = x[MemoryKindOrderedMap-174]
-	_ = x[MemoryKindOrderedMapEntryList-175]
-	_ = x[MemoryKindOrderedMapEntry-176]
-	_ = x[MemoryKindLast-177]
+	_ = x[MemoryKindSetValueBase-8]
+	_ = x[MemoryKindCompositeValueBase-9]
+	_ = x[MemoryKindSimpleCompositeValueBase-10]
+	_ = x[MemoryKindOptionalValue-11]
+	_ = x[MemoryKindNilValue-12]
+	_ = x[MemoryKindVoidValue-13]
+	_ = x[MemoryKindTypeValue-14]
+	_ = x[MemoryKindPathValue-15]
+	_ = x[MemoryKindCapabilityValue-16]
+	_ = x[MemoryKindLinkValue-17]
+	_ = x[MemoryKindStorageReferenceValue-18]
+	_ = x[MemoryKindEphemeralReferenceValue-19]
+	_ = x[MemoryKindInterpretedFunctionValue-20]
+	_ = x[MemoryKindHostFunctionValue-21]
+	_ = x[MemoryKindBoundFunctionValue-22]
+	_ = x[MemoryKindBigInt-23]
+	_ = x[MemoryKindSimpleCompositeValue-24]
+	_ = x[MemoryKindAtreeArrayDataSlab-25]
+	_ = x[MemoryKindAtreeArrayMetaDataSlab-26]
+	_ = x[MemoryKindAtreeArrayElementOverhead-27]
+	_ = x[MemoryKindAtreeMapDataSlab-28]
+	_ = x[MemoryKindAtreeMapMetaDataSlab-29]
+	_ = x[MemoryKindAtreeMapElementOverhead-30]
+	_ = x[MemoryKindAtreeMapPreAllocatedElement-31]
+	_ = x[MemoryKindAtreeEncodedSlab-32]
+	_ = x[MemoryKindPrimitiveStaticType-33]
+	_ = x[MemoryKindCompositeStaticType-34]
+	_ = x[MemoryKindInterfaceStaticType-35]
+	_ = x[MemoryKindVariableSizedStaticType-36]
+	_ = x[MemoryKindConstantSizedStaticType-37]
+	_ = x[MemoryKindDictionaryStaticType-38]
+	_ = x[MemoryKindSetStaticType-39]
+	_ = x[MemoryKindOptionalStaticType-40]
+	_ = x[MemoryKindRestrictedStaticType-41]
+	_ = x[MemoryKindReferenceStaticType-42]
+	_ = x[MemoryKindCapabilityStaticType-43]
+	_ = x[MemoryKindFunctionStaticType-44]
+	_ = x[MemoryKindCadenceVoidValue-45]
+	_ = x[MemoryKindCadenceOptionalValue-46]
+	_ = x[MemoryKindCadenceBoolValue-47]
+	_ = x[MemoryKindCadenceStringValue-48]
+	_ = x[MemoryKindCadenceCharacterValue-49]
+	_ = x[MemoryKindCadenceAddressValue-50]
+	_ = x[MemoryKindCadenceIntValue-51]
+	_ = x[MemoryKindCadenceNumberValue-52]
+	_ = x[MemoryKindCadenceArrayValueBase-53]
+	_ = x[MemoryKindCadenceArrayValueLength-54]
+	_ = x[MemoryKindCadenceDictionaryValue-55]
+	_ = x[MemoryKindCadenceSetValue-56]
+	_ = x[MemoryKindCadenceKeyValuePair-57]
+	_ = x[MemoryKindCadenceStructValueBase-58]
+	_ = x[MemoryKindCadenceStructValueSize-59]
+	_ = x[MemoryKindCadenceResourceValueBase-60]
+	_ = x[MemoryKindCadenceResourceValueSize-61]
+	_ = x[MemoryKindCadenceEventValueBase-62]
+	_ = x[MemoryKindCadenceEventValueSize-63]
+	_ = x[MemoryKindCadenceContractValueBase-64]
+	_ = x[MemoryKindCadenceContractValueSize-65]
+	_ = x[MemoryKindCadenceEnumValueBase-66]
+	_ = x[MemoryKindCadenceEnumValueSize-67]
+	_ = x[MemoryKindCadenceNewtypeValueBase-68]
+	_ = x[MemoryKindCadenceNewtypeValueSize-69]
+	_ = x[MemoryKindCadenceLinkValue-70]
+	_ = x[MemoryKindCadencePathValue-71]
+	_ = x[MemoryKindCadenceTypeValue-72]
+	_ = x[MemoryKindCadenceCapabilityValue-73]
+	_ = x[MemoryKindCadenceSimpleType-74]
+	_ = x[MemoryKindCadenceOptionalType-75]
+	_ = x[MemoryKindCadenceVariableSizedArrayType-76]
+	_ = x[MemoryKindCadenceConstantSizedArrayType-77]
+	_ = x[MemoryKindCadenceDictionaryType-78]
+	_ = x[MemoryKindCadenceSetType-79]
+	_ = x[MemoryKindCadenceField-80]
+	_ = x[MemoryKindCadenceParameter-81]
+	_ = x[MemoryKindCadenceStructType-82]
+	_ = x[MemoryKindCadenceResourceType-83]
+	_ = x[MemoryKindCadenceEventType-84]
+	_ = x[MemoryKindCadenceContractType-85]
+	_ = x[MemoryKindCadenceStructInterfaceType-86]
+	_ = x[MemoryKindCadenceResourceInterfaceType-87]
+	_ = x[MemoryKindCadenceContractInterfaceType-88]
+	_ = x[MemoryKindCadenceFunctionType-89]
+	_ = x[MemoryKindCadenceReferenceType-90]
+	_ = x[MemoryKindCadenceRestrictedType-91]
+	_ = x[MemoryKindCadenceCapabilityType-92]
+	_ = x[MemoryKindCadenceEnumType-93]
+	_ = x[MemoryKindCadenceNewtypeType-94]
+	_ = x[MemoryKindRawString-95]
+	_ = x[MemoryKindAddressLocation-96]
+	_ = x[MemoryKindBytes-97]
+	_ = x[MemoryKindVariable-98]
+	_ = x[MemoryKindCompositeTypeInfo-99]
+	_ = x[MemoryKindCompositeField-100]
+	_ = x[MemoryKindInvocation-101]
+	_ = x[MemoryKindStorageMap-102]
+	_ = x[MemoryKindStorageKey-103]
+	_ = x[MemoryKindValueToken-104]
+	_ = x[MemoryKindSyntaxToken-105]
+	_ = x[MemoryKindSpaceToken-106]
+	_ = x[MemoryKindProgram-107]
+	_ = x[MemoryKindIdentifier-108]
+	_ = x[MemoryKindArgument-109]
+	_ = x[MemoryKindBlock-110]
+	_ = x[MemoryKindFunctionBlock-111]
+	_ = x[MemoryKindParameter-112]
+	_ = x[MemoryKindParameterList-113]
+	_ = x[MemoryKindTypeParameter-114]
+	_ = x[MemoryKindTypeParameterList-115]
+	_ = x[MemoryKindTransfer-116]
+	_ = x[MemoryKindMembers-117]
+	_ = x[MemoryKindTypeAnnotation-118]
+	_ = x[MemoryKindDictionaryEntry-119]
+	_ = x[MemoryKindFunctionDeclaration-120]
+	_ = x[MemoryKindCompositeDeclaration-121]
+	_ = x[MemoryKindInterfaceDeclaration-122]
+	_ = x[MemoryKindEnumCaseDeclaration-123]
+	_ = x[MemoryKindFieldDeclaration-124]
+	_ = x[MemoryKindTransactionDeclaration-125]
+	_ = x[MemoryKindImportDeclaration-126]
+	_ = x[MemoryKindImportHash-127]
+	_ = x[MemoryKindVariableDeclaration-128]
+	_ = x[MemoryKindSpecialFunctionDeclaration-129]
+	_ = x[MemoryKindPragmaDeclaration-130]
+	_ = x[MemoryKindTypeAliasDeclaration-131]
+	_ = x[MemoryKindAssignmentStatement-132]
+	_ = x[MemoryKindBreakStatement-133]
+	_ = x[MemoryKindContinueStatement-134]
+	_ = x[MemoryKindEmitStatement-135]
+	_ = x[MemoryKindExpressionStatement-136]
+	_ = x[MemoryKindForStatement-137]
+	_ = x[MemoryKindIfStatement-138]
+	_ = x[MemoryKindReturnStatement-139]
+	_ = x[MemoryKindSwapStatement-140]
+	_ = x[MemoryKindSwitchStatement-141]
+	_ = x[MemoryKindWhileStatement-142]
+	_ = x[MemoryKindTryStatement-143]
+	_ = x[MemoryKindBooleanExpression-144]
+	_ = x[MemoryKindNilExpression-145]
+	_ = x[MemoryKindStringExpression-146]
+	_ = x[MemoryKindIntegerExpression-147]
+	_ = x[MemoryKindFixedPointExpression-148]
+	_ = x[MemoryKindArrayExpression-149]
+	_ = x[MemoryKindDictionaryExpression-150]
+	_ = x[MemoryKindIdentifierExpression-151]
+	_ = x[MemoryKindInvocationExpression-152]
+	_ = x[MemoryKindMemberExpression-153]
+	_ = x[MemoryKindIndexExpression-154]
+	_ = x[MemoryKindConditionalExpression-155]
+	_ = x[MemoryKindUnaryExpression-156]
+	_ = x[MemoryKindBinaryExpression-157]
+	_ = x[MemoryKindFunctionExpression-158]
+	_ = x[MemoryKindCastingExpression-159]
+	_ = x[MemoryKindCreateExpression-160]
+	_ = x[MemoryKindDestroyExpression-161]
+	_ = x[MemoryKindReferenceExpression-162]
+	_ = x[MemoryKindForceExpression-163]
+	_ = x[MemoryKindPathExpression-164]
+	_ = x[MemoryKindConstantSizedType-165]
+	_ = x[MemoryKindDictionaryType-166]
+	_ = x[MemoryKindFunctionType-167]
+	_ = x[MemoryKindInstantiationType-168]
+	_ = x[MemoryKindNominalType-169]
+	_ = x[MemoryKindOptionalType-170]
+	_ = x[MemoryKindReferenceType-171]
+	_ = x[MemoryKindRestrictedType-172]
+	_ = x[MemoryKindSetType-173]
+	_ = x[MemoryKindVariableSizedType-174]
+	_ = x[MemoryKindIdentifierPattern-175]
+	_ = x[MemoryKindArrayPattern-176]
+	_ = x[MemoryKindDictionaryPattern-177]
+	_ = x[MemoryKindCompositePattern-178]
+	_ = x[MemoryKindOptionalPattern-179]
+	_ = x[MemoryKindTypePattern-180]
+	_ = x[MemoryKindPosition-181]
+	_ = x[MemoryKindRange-182]
+	_ = x[MemoryKindElaboration-183]
+	_ = x[MemoryKindActivation-184]
+	_ = x[MemoryKindActivationEntries-185]
+	_ = x[MemoryKindVariableSizedSemaType-186]
+	_ = x[MemoryKindConstantSizedSemaType-187]
+	_ = x[MemoryKindDictionarySemaType-188]
+	_ = x[MemoryKindSetSemaType-189]
+	_ = x[MemoryKindOptionalSemaType-190]
+	_ = x[MemoryKindRestrictedSemaType-191]
+	_ = x[MemoryKindReferenceSemaType-192]
+	_ = x[MemoryKindCapabilitySemaType-193]
+	_ = x[MemoryKindOrderedMap-194]
+	_ = x[MemoryKindOrderedMapEntryList-195]
+	_ = x[MemoryKindOrderedMapEntry-196]
+	_ = x[MemoryKindLast-197]
 }
 
-const _MemoryKind_name = "UnknownBoolValueAddressValueStringValueCharacterValueNumberValueArrayValueBaseDictionaryValueBaseCompositeValueBaseSimpleCompositeValueBaseOptionalValueNilValueVoidValueTypeValuePathValueCapabilityValueLinkValueStorageReferenceValueEphemeralReferenceValueInterpretedFunctionValueHostFunctionValueBoundFunctionValueBigIntSimpleCompositeValueAtreeArrayDataSlabAtreeArrayMetaDataSlabAtreeArrayElementOverheadAtreeMapDataSlabAtreeMapMetaDataSlabAtreeMapElementOverheadAtreeMapPreAllocatedElementAtreeEncodedSlabPrimitiveStaticTypeCompositeStaticTypeInterfaceStaticTypeVariableSizedStaticTypeConstantSizedStaticTypeDictionaryStaticTypeOptionalStaticTypeRestrictedStaticTypeReferenceStaticTypeCapabilityStaticTypeFunctionStaticTypeCadenceVoidValueCadenceOptionalValueCadenceBoolValueCadenceStringValueCadenceCharacterValueCadenceAddressValueCadenceIntValueCadenceNumberValueCadenceArrayValueBaseCadenceArrayValueLengthCadenceDictionaryValueCadenceKeyValuePairCadenceStructValueBaseCadenceStructValueSizeCadenceResourceValueBaseCadenceResourceValueSizeCadenceEventValueBaseCadenceEventValueSizeCadenceContractValueBaseCadenceContractValueSizeCadenceEnumValueBaseCadenceEnumValueSizeCadenceLinkValueCadencePathValueCadenceTypeValueCadenceCapabilityValueCadenceSimpleTypeCadenceOptionalTypeCadenceVariableSizedArrayTypeCadenceConstantSizedArrayTypeCadenceDictionaryTypeCadenceFieldCadenceParameterCadenceStructTypeCadenceResourceTypeCadenceEventTypeCadenceContractTypeCadenceStructInterfaceTypeCadenceResourceInterfaceTypeCadenceContractInterfaceTypeCadenceFunctionTypeCadenceReferenceTypeCadenceRestrictedTypeCadenceCapabilityTypeCadenceEnumTypeRawStringAddressLocationBytesVariableCompositeTypeInfoCompositeFieldInvocationStorageMapStorageKeyValueTokenSyntaxTokenSpaceTokenProgramIdentifierArgumentBlockFunctionBlockParameterParameterListTransferMembersTypeAnnotationDictionaryEntryFunctionDeclarationCompositeDeclarationInterfaceDeclarationEnumCaseDeclarationFieldDeclarationTransactionDeclarationImportDeclarationVariableDeclarationSpecialFunctionDeclarationPragmaDeclarationAssignmentStatementBreakStatementContinueStatementEmitStatementExpressionStatementForStatementIfStatementReturnStatementSwapStatementSwitchStatementWhileStatementBooleanExpressionNilExpressionStringExpressionIntegerExpressionFixedPointExpressionArrayExpressionDictionaryExpressionIdentifierExpressionInvocationExpressionMemberExpressionIndexExpressionConditionalExpressionUnaryExpressionBinaryExpressionFunctionExpressionCastingExpressionCreateExpressionDestroyExpressionReferenceExpressionForceExpressionPathExpressionConstantSizedTypeDictionaryTypeFunctionTypeInstantiationTypeNominalTypeOptionalTypeReferenceTypeRestrictedTypeVariableSizedTypePositionRangeElaborationActivationActivationEntriesVariableSizedSemaTypeConstantSizedSemaTypeDictionarySemaTypeOptionalSemaTypeRestrictedSemaTypeReferenceSemaTypeCapabilitySemaTypeOrderedMapOrderedMapEntryListOrderedMapEntryLast"
+const _MemoryKind_name = "UnknownBoolValueAddressValueStringValueCharacterValueNumberValueArrayValueBaseDictionaryValueBaseSetValueBaseCompositeValueBaseSimpleCompositeValueBaseOptionalValueNilValueVoidValueTypeValuePathValueCapabilityValueLinkValueStorageReferenceValueEphemeralReferenceValueInterpretedFunctionValueHostFunctionValueBoundFunctionValueBigIntSimpleCompositeValueAtreeArrayDataSlabAtreeArrayMetaDataSlabAtreeArrayElementOverheadAtreeMapDataSlabAtreeMapMetaDataSlabAtreeMapElementOverheadAtreeMapPreAllocatedElementAtreeEncodedSlabPrimitiveStaticTypeCompositeStaticTypeInterfaceStaticTypeVariableSizedStaticTypeConstantSizedStaticTypeDictionaryStaticTypeSetStaticTypeOptionalStaticTypeRestrictedStaticTypeReferenceStaticTypeCapabilityStaticTypeFunctionStaticTypeCadenceVoidValueCadenceOptionalValueCadenceBoolValueCadenceStringValueCadenceCharacterValueCadenceAddressValueCadenceIntValueCadenceNumberValueCadenceArrayValueBaseCadenceArrayValueLengthCadenceDictionaryValueCadenceSetValueCadenceKeyValuePairCadenceStructValueBaseCadenceStructValueSizeCadenceResourceValueBaseCadenceResourceValueSizeCadenceEventValueBaseCadenceEventValueSizeCadenceContractValueBaseCadenceContractValueSizeCadenceEnumValueBaseCadenceEnumValueSizeCadenceNewtypeValueBaseCadenceNewtypeValueSizeCadenceLinkValueCadencePathValueCadenceTypeValueCadenceCapabilityValueCadenceSimpleTypeCadenceOptionalTypeCadenceVariableSizedArrayTypeCadenceConstantSizedArrayTypeCadenceDictionaryTypeCadenceSetTypeCadenceFieldCadenceParameterCadenceStructTypeCadenceResourceTypeCadenceEventTypeCadenceContractTypeCadenceStructInterfaceTypeCadenceResourceInterfaceTypeCadenceContractInterfaceTypeCadenceFunctionTypeCadenceReferenceTypeCadenceRestrictedTypeCadenceCapabilityTypeCadenceEnumTypeCadenceNewtypeTypeRawStringAddressLocationBytesVariableCompositeTypeInfoCompositeFieldInvocationStorageMapStorageKeyValueTokenSyntaxTokenSpaceTokenProgramIdentifierArgumentBlockFunctionBlockParameterParameterListTypeParameterTypeParameterListTransferMembersTypeAnnotationDictionaryEntryFunctionDeclarationCompositeDeclarationInterfaceDeclarationEnumCaseDeclarationFieldDeclarationTransactionDeclarationImportDeclarationImportHashVariableDeclarationSpecialFunctionDeclarationPragmaDeclarationTypeAliasDeclarationAssignmentStatementBreakStatementContinueStatementEmitStatementExpressionStatementForStatementIfStatementReturnStatementSwapStatementSwitchStatementWhileStatementTryStatementBooleanExpressionNilExpressionStringExpressionIntegerExpressionFixedPointExpressionArrayExpressionDictionaryExpressionIdentifierExpressionInvocationExpressionMemberExpressionIndexExpressionConditionalExpressionUnaryExpressionBinaryExpressionFunctionExpressionCastingExpressionCreateExpressionDestroyExpressionReferenceExpressionForceExpressionPathExpressionConstantSizedTypeDictionaryTypeFunctionTypeInstantiationTypeNominalTypeOptionalTypeReferenceTypeRestrictedTypeSetTypeVariableSizedTypeIdentifierPatternArrayPatternDictionaryPatternCompositePatternOptionalPatternTypePatternPositionRangeElaborationActivationActivationEntriesVariableSizedSemaTypeConstantSizedSemaTypeDictionarySemaTypeSetSemaTypeOptionalSemaTypeRestrictedSemaTypeReferenceSemaTypeCapabilitySemaTypeOrderedMapOrderedMapEntryListOrderedMapEntryLast"
 
-var _MemoryKind_index = [...]uint16{0, 7, 16, 28, 39, 53, 64, 78, 97, 115, 139, 152, 160, 169, 178, 187, 202, 211, 232, 255, 279, 296, 314, 320, 340, 358, 380, 405, 421, 441, 464, 491, 507, 526, 545, 564, 587, 610, 630, 648, 668, 687, 707, 725, 741, 761, 777, 795, 816, 835, 850, 868, 889, 912, 934, 953, 975, 997, 1021, 1045, 1066, 1087, 1111, 1135, 1155, 1175, 1191, 1207, 1223, 1245, 1262, 1281, 1310, 1339, 1360, 1372, 1388, 1405, 1424, 1440, 1459, 1485, 1513, 1541, 1560, 1580, 1601, 1622, 1637, 1646, 1661, 1666, 1674, 1691, 1705, 1715, 1725, 1735, 1745, 1756, 1766, 1773, 1783, 1791, 1796, 1809, 1818, 1831, 1839, 1846, 1860, 1875, 1894, 1914, 1934, 1953, 1969, 1991, 2008, 2027, 2053, 2070, 2089, 2103, 2120, 2133, 2152, 2164, 2175, 2190, 2203, 2218, 2232, 2249, 2262, 2278, 2295, 2315, 2330, 2350, 2370, 2390, 2406, 2421, 2442, 2457, 2473, 2491, 2508, 2524, 2541, 2560, 2575, 2589, 2606, 2620, 2632, 2649, 2660, 2672, 2685, 2699, 2716, 2724, 2729, 2740, 2750, 2767, 2788, 2809, 2827, 2843, 2861, 2878, 2896, 2906, 2925, 2940, 2944}
+var _MemoryKind_index = [...]uint16{0, 7, 16, 28, 39, 53, 64, 78, 97, 109, 127, 151, 164, 172, 181, 190, 199, 214, 223, 244, 267, 291, 308, 326, 332, 352, 370, 392, 417, 433, 453, 476, 503, 519, 538, 557, 576, 599, 622, 642, 655, 673, 693, 712, 732, 750, 766, 786, 802, 820, 841, 860, 875, 893, 914, 937, 959, 974, 993, 1015, 1037, 1061, 1085, 1106, 1127, 1151, 1175, 1195, 1215, 1238, 1261, 1277, 1293, 1309, 1331, 1348, 1367, 1396, 1425, 1446, 1460, 1472, 1488, 1505, 1524, 1540, 1559, 1585, 1613, 1641, 1660, 1680, 1701, 1722, 1737, 1755, 1764, 1779, 1784, 1792, 1809, 1823, 1833, 1843, 1853, 1863, 1874, 1884, 1891, 1901, 1909, 1914, 1927, 1936, 1949, 1962, 1979, 1987, 1994, 2008, 2023, 2042, 2062, 2082, 2101, 2117, 2139, 2156, 2166, 2185, 2211, 2228, 2248, 2267, 2281, 2298, 2311, 2330, 2342, 2353, 2368, 2381, 2396, 2410, 2422, 2439, 2452, 2468, 2485, 2505, 2520, 2540, 2560, 2580, 2596, 2611, 2632, 2647, 2663, 2681, 2698, 2714, 2731, 2750, 2765, 2779, 2796, 2810, 2822, 2839, 2850, 2862, 2875, 2889, 2896, 2913, 2930, 2942, 2959, 2975, 2990, 3001, 3009, 3014, 3025, 3035, 3052, 3073, 3094, 3112, 3123, 3139, 3157, 3174, 3192, 3202, 3221, 3236, 3240}
 
 func (i MemoryKind) String() string {
 	if i >= MemoryKind(len(_MemoryKind_index)-1) {
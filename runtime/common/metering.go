@@ -44,16 +44,18 @@ var (
 
 	// AST
 
-	ProgramMemoryUsage         = NewConstantMemoryUsage(MemoryKindProgram)
-	IdentifierMemoryUsage      = NewConstantMemoryUsage(MemoryKindIdentifier)
-	ArgumentMemoryUsage        = NewConstantMemoryUsage(MemoryKindArgument)
-	BlockMemoryUsage           = NewConstantMemoryUsage(MemoryKindBlock)
-	FunctionBlockMemoryUsage   = NewConstantMemoryUsage(MemoryKindFunctionBlock)
-	ParameterMemoryUsage       = NewConstantMemoryUsage(MemoryKindParameter)
-	ParameterListMemoryUsage   = NewConstantMemoryUsage(MemoryKindParameterList)
-	TransferMemoryUsage        = NewConstantMemoryUsage(MemoryKindTransfer)
-	TypeAnnotationMemoryUsage  = NewConstantMemoryUsage(MemoryKindTypeAnnotation)
-	DictionaryEntryMemoryUsage = NewConstantMemoryUsage(MemoryKindDictionaryEntry)
+	ProgramMemoryUsage           = NewConstantMemoryUsage(MemoryKindProgram)
+	IdentifierMemoryUsage        = NewConstantMemoryUsage(MemoryKindIdentifier)
+	ArgumentMemoryUsage          = NewConstantMemoryUsage(MemoryKindArgument)
+	BlockMemoryUsage             = NewConstantMemoryUsage(MemoryKindBlock)
+	FunctionBlockMemoryUsage     = NewConstantMemoryUsage(MemoryKindFunctionBlock)
+	ParameterMemoryUsage         = NewConstantMemoryUsage(MemoryKindParameter)
+	ParameterListMemoryUsage     = NewConstantMemoryUsage(MemoryKindParameterList)
+	TypeParameterMemoryUsage     = NewConstantMemoryUsage(MemoryKindTypeParameter)
+	TypeParameterListMemoryUsage = NewConstantMemoryUsage(MemoryKindTypeParameterList)
+	TransferMemoryUsage          = NewConstantMemoryUsage(MemoryKindTransfer)
+	TypeAnnotationMemoryUsage    = NewConstantMemoryUsage(MemoryKindTypeAnnotation)
+	DictionaryEntryMemoryUsage   = NewConstantMemoryUsage(MemoryKindDictionaryEntry)
 
 	// AST Declarations
 
@@ -61,12 +63,14 @@ var (
 	CompositeDeclarationMemoryUsage       = NewConstantMemoryUsage(MemoryKindCompositeDeclaration)
 	InterfaceDeclarationMemoryUsage       = NewConstantMemoryUsage(MemoryKindInterfaceDeclaration)
 	ImportDeclarationMemoryUsage          = NewConstantMemoryUsage(MemoryKindImportDeclaration)
+	ImportHashMemoryUsage                 = NewConstantMemoryUsage(MemoryKindImportHash)
 	TransactionDeclarationMemoryUsage     = NewConstantMemoryUsage(MemoryKindTransactionDeclaration)
 	FieldDeclarationMemoryUsage           = NewConstantMemoryUsage(MemoryKindFieldDeclaration)
 	EnumCaseDeclarationMemoryUsage        = NewConstantMemoryUsage(MemoryKindEnumCaseDeclaration)
 	VariableDeclarationMemoryUsage        = NewConstantMemoryUsage(MemoryKindVariableDeclaration)
 	SpecialFunctionDeclarationMemoryUsage = NewConstantMemoryUsage(MemoryKindSpecialFunctionDeclaration)
 	PragmaDeclarationMemoryUsage          = NewConstantMemoryUsage(MemoryKindPragmaDeclaration)
+	TypeAliasDeclarationMemoryUsage       = NewConstantMemoryUsage(MemoryKindTypeAliasDeclaration)
 
 	// AST Statements
 
@@ -81,6 +85,7 @@ var (
 	SwapStatementMemoryUsage       = NewConstantMemoryUsage(MemoryKindSwapStatement)
 	SwitchStatementMemoryUsage     = NewConstantMemoryUsage(MemoryKindSwitchStatement)
 	WhileStatementMemoryUsage      = NewConstantMemoryUsage(MemoryKindWhileStatement)
+	TryStatementMemoryUsage        = NewConstantMemoryUsage(MemoryKindTryStatement)
 
 	// AST Expressions
 
@@ -114,8 +119,18 @@ var (
 	OptionalTypeMemoryUsage      = NewConstantMemoryUsage(MemoryKindOptionalType)
 	ReferenceTypeMemoryUsage     = NewConstantMemoryUsage(MemoryKindReferenceType)
 	RestrictedTypeMemoryUsage    = NewConstantMemoryUsage(MemoryKindRestrictedType)
+	SetTypeMemoryUsage           = NewConstantMemoryUsage(MemoryKindSetType)
 	VariableSizedTypeMemoryUsage = NewConstantMemoryUsage(MemoryKindVariableSizedType)
 
+	// AST Patterns
+
+	IdentifierPatternMemoryUsage = NewConstantMemoryUsage(MemoryKindIdentifierPattern)
+	ArrayPatternMemoryUsage      = NewConstantMemoryUsage(MemoryKindArrayPattern)
+	DictionaryPatternMemoryUsage = NewConstantMemoryUsage(MemoryKindDictionaryPattern)
+	CompositePatternMemoryUsage  = NewConstantMemoryUsage(MemoryKindCompositePattern)
+	OptionalPatternMemoryUsage   = NewConstantMemoryUsage(MemoryKindOptionalPattern)
+	TypePatternMemoryUsage       = NewConstantMemoryUsage(MemoryKindTypePattern)
+
 	PositionMemoryUsage = NewConstantMemoryUsage(MemoryKindPosition)
 	RangeMemoryUsage    = NewConstantMemoryUsage(MemoryKindRange)
 
@@ -131,6 +146,7 @@ var (
 	CompositeTypeInfoMemoryUsage        = NewConstantMemoryUsage(MemoryKindCompositeTypeInfo)
 	CompositeFieldMemoryUsage           = NewConstantMemoryUsage(MemoryKindCompositeField)
 	DictionaryValueBaseMemoryUsage      = NewConstantMemoryUsage(MemoryKindDictionaryValueBase)
+	SetValueBaseMemoryUsage             = NewConstantMemoryUsage(MemoryKindSetValueBase)
 	ArrayValueBaseMemoryUsage           = NewConstantMemoryUsage(MemoryKindArrayValueBase)
 	CompositeValueBaseMemoryUsage       = NewConstantMemoryUsage(MemoryKindCompositeValueBase)
 	AddressValueMemoryUsage             = NewConstantMemoryUsage(MemoryKindAddressValue)
@@ -156,6 +172,7 @@ var (
 	VariableSizedStaticTypeMemoryUsage = NewConstantMemoryUsage(MemoryKindVariableSizedStaticType)
 	ConstantSizedStaticTypeMemoryUsage = NewConstantMemoryUsage(MemoryKindConstantSizedStaticType)
 	DictionaryStaticTypeMemoryUsage    = NewConstantMemoryUsage(MemoryKindDictionaryStaticType)
+	SetStaticTypeMemoryUsage           = NewConstantMemoryUsage(MemoryKindSetStaticType)
 	OptionalStaticTypeMemoryUsage      = NewConstantMemoryUsage(MemoryKindOptionalStaticType)
 	RestrictedStaticTypeMemoryUsage    = NewConstantMemoryUsage(MemoryKindRestrictedStaticType)
 	ReferenceStaticTypeMemoryUsage     = NewConstantMemoryUsage(MemoryKindReferenceStaticType)
@@ -167,6 +184,7 @@ var (
 	VariableSizedSemaTypeMemoryUsage = NewConstantMemoryUsage(MemoryKindVariableSizedSemaType)
 	ConstantSizedSemaTypeMemoryUsage = NewConstantMemoryUsage(MemoryKindConstantSizedSemaType)
 	DictionarySemaTypeMemoryUsage    = NewConstantMemoryUsage(MemoryKindDictionarySemaType)
+	SetSemaTypeMemoryUsage           = NewConstantMemoryUsage(MemoryKindSetSemaType)
 	OptionalSemaTypeMemoryUsage      = NewConstantMemoryUsage(MemoryKindOptionalSemaType)
 	RestrictedSemaTypeMemoryUsage    = NewConstantMemoryUsage(MemoryKindRestrictedSemaType)
 	ReferenceSemaTypeMemoryUsage     = NewConstantMemoryUsage(MemoryKindReferenceSemaType)
@@ -182,12 +200,14 @@ var (
 	// Cadence external values
 
 	CadenceDictionaryValueMemoryUsage   = NewConstantMemoryUsage(MemoryKindCadenceDictionaryValue)
+	CadenceSetValueMemoryUsage          = NewConstantMemoryUsage(MemoryKindCadenceSetValue)
 	CadenceArrayValueBaseMemoryUsage    = NewConstantMemoryUsage(MemoryKindCadenceArrayValueBase)
 	CadenceStructValueBaseMemoryUsage   = NewConstantMemoryUsage(MemoryKindCadenceStructValueBase)
 	CadenceResourceValueBaseMemoryUsage = NewConstantMemoryUsage(MemoryKindCadenceResourceValueBase)
 	CadenceEventValueBaseMemoryUsage    = NewConstantMemoryUsage(MemoryKindCadenceEventValueBase)
 	CadenceContractValueBaseMemoryUsage = NewConstantMemoryUsage(MemoryKindCadenceContractValueBase)
 	CadenceEnumValueBaseMemoryUsage     = NewConstantMemoryUsage(MemoryKindCadenceEnumValueBase)
+	CadenceNewtypeValueBaseMemoryUsage  = NewConstantMemoryUsage(MemoryKindCadenceNewtypeValueBase)
 	CadenceAddressValueMemoryUsage      = NewConstantMemoryUsage(MemoryKindCadenceAddressValue)
 	CadenceBoolValueMemoryUsage         = NewConstantMemoryUsage(MemoryKindCadenceBoolValue)
 	CadenceCapabilityValueMemoryUsage   = NewConstantMemoryUsage(MemoryKindCadenceCapabilityValue)
@@ -207,8 +227,10 @@ var (
 	CadenceContractInterfaceTypeMemoryUsage  = NewConstantMemoryUsage(MemoryKindCadenceContractInterfaceType)
 	CadenceContractTypeMemoryUsage           = NewConstantMemoryUsage(MemoryKindCadenceContractType)
 	CadenceDictionaryTypeMemoryUsage         = NewConstantMemoryUsage(MemoryKindCadenceDictionaryType)
+	CadenceSetTypeMemoryUsage                = NewConstantMemoryUsage(MemoryKindCadenceSetType)
 	CadenceEnumTypeMemoryUsage               = NewConstantMemoryUsage(MemoryKindCadenceEnumType)
 	CadenceEventTypeMemoryUsage              = NewConstantMemoryUsage(MemoryKindCadenceEventType)
+	CadenceNewtypeTypeMemoryUsage            = NewConstantMemoryUsage(MemoryKindCadenceNewtypeType)
 	CadenceFunctionTypeMemoryUsage           = NewConstantMemoryUsage(MemoryKindCadenceFunctionType)
 	CadenceOptionalTypeMemoryUsage           = NewConstantMemoryUsage(MemoryKindCadenceOptionalType)
 	CadenceReferenceTypeMemoryUsage          = NewConstantMemoryUsage(MemoryKindCadenceReferenceType)
@@ -243,6 +265,7 @@ var (
 
 	VariableSizedStaticTypeStringMemoryUsage = NewRawStringMemoryUsage(2)  // []
 	DictionaryStaticTypeStringMemoryUsage    = NewRawStringMemoryUsage(4)  // {: }
+	SetStaticTypeStringMemoryUsage           = NewRawStringMemoryUsage(2)  // {}
 	OptionalStaticTypeStringMemoryUsage      = NewRawStringMemoryUsage(1)  // ?
 	AuthReferenceStaticTypeStringMemoryUsage = NewRawStringMemoryUsage(5)  // auth&
 	ReferenceStaticTypeStringMemoryUsage     = NewRawStringMemoryUsage(1)  // &
@@ -363,6 +386,14 @@ func NewDictionaryMemoryUsages(count uint64, elementSize uint) (MemoryUsage, Mem
 	}, leaves, branches
 }
 
+func NewSetMemoryUsages(count uint64, elementSize uint) (MemoryUsage, MemoryUsage, MemoryUsage, MemoryUsage) {
+	leaves, branches := newAtreeMemoryUsage(count, elementSize, false)
+	return SetValueBaseMemoryUsage, MemoryUsage{
+		Kind:   MemoryKindAtreeMapElementOverhead,
+		Amount: count,
+	}, leaves, branches
+}
+
 func NewCompositeMemoryUsages(count uint64, elementSize uint) (MemoryUsage, MemoryUsage, MemoryUsage, MemoryUsage) {
 	leaves, branches := newAtreeMemoryUsage(count, elementSize, false)
 	return CompositeValueBaseMemoryUsage, MemoryUsage{
@@ -485,6 +516,13 @@ func NewCadenceEnumMemoryUsages(fields int) (MemoryUsage, MemoryUsage) {
 	}
 }
 
+func NewCadenceNewtypeMemoryUsages(fields int) (MemoryUsage, MemoryUsage) {
+	return CadenceNewtypeValueBaseMemoryUsage, MemoryUsage{
+		Kind:   MemoryKindCadenceNewtypeValueSize,
+		Amount: uint64(fields),
+	}
+}
+
 func max(a, b int) int {
 	if a > b {
 		return a
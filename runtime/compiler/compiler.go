@@ -131,6 +131,11 @@ func (compiler *Compiler) VisitExpressionStatement(_ *ast.ExpressionStatement) a
 	panic(errors.NewUnreachableError())
 }
 
+func (compiler *Compiler) VisitTryStatement(_ *ast.TryStatement) ast.Repr {
+	// TODO
+	panic(errors.NewUnreachableError())
+}
+
 func (compiler *Compiler) VisitBoolExpression(_ *ast.BoolExpression) ast.Repr {
 	// TODO
 	panic(errors.NewUnreachableError())
@@ -371,6 +376,11 @@ func (compiler *Compiler) VisitImportDeclaration(_ *ast.ImportDeclaration) ast.R
 	panic(errors.NewUnreachableError())
 }
 
+func (compiler *Compiler) VisitTypeAliasDeclaration(_ *ast.TypeAliasDeclaration) ast.Repr {
+	// TODO
+	panic(errors.NewUnreachableError())
+}
+
 func (compiler *Compiler) VisitTransactionDeclaration(_ *ast.TransactionDeclaration) ast.Repr {
 	// TODO
 	panic(errors.NewUnreachableError())
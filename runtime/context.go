@@ -21,14 +21,18 @@ package runtime
 import (
 	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/stdlib"
 )
 
 type Context struct {
 	Interface         Interface
 	Location          Location
 	PredeclaredValues []ValueDeclaration
-	codes             map[common.Location][]byte
-	programs          map[common.Location]*ast.Program
+	// TestFramework is the host environment of the Test contract.
+	// The Test contract can only be imported if a test framework is provided
+	TestFramework stdlib.TestFramework
+	codes         map[common.Location][]byte
+	programs      map[common.Location]*ast.Program
 }
 
 func (c Context) SetCode(location common.Location, code []byte) {
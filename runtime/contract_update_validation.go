@@ -23,6 +23,7 @@ import (
 
 	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
 )
 
 type ContractUpdateValidator struct {
@@ -34,6 +35,8 @@ type ContractUpdateValidator struct {
 	newProgram   *ast.Program
 	currentDecl  ast.Declaration
 	errors       []error
+
+	currentQualifiedIdentifier string
 }
 
 // ContractUpdateValidator should implement ast.TypeEqualityChecker
@@ -70,6 +73,9 @@ func (validator *ContractUpdateValidator) Validate() error {
 
 	validator.TypeComparator.RootDeclIdentifier = newRootDecl.DeclarationIdentifier()
 
+	validator.expectedTypeAliases = withTypeAliases(nil, "", validator.oldProgram.TypeAliasDeclarations())
+	validator.foundTypeAliases = withTypeAliases(nil, "", validator.newProgram.TypeAliasDeclarations())
+
 	validator.checkDeclarationUpdatability(oldRootDecl, newRootDecl)
 
 	if validator.hasErrors() {
@@ -132,17 +138,55 @@ func (validator *ContractUpdateValidator) checkDeclarationUpdatability(
 
 	parentDecl := validator.currentDecl
 	validator.currentDecl = newDeclaration
+
+	parentQualifiedIdentifier := validator.currentQualifiedIdentifier
+	validator.currentQualifiedIdentifier = qualifiedIdentifier(
+		parentQualifiedIdentifier,
+		newDeclaration.DeclarationIdentifier().Identifier,
+	)
+
+	// Type aliases declared in a declaration are in scope for the declaration itself,
+	// and all its nested declarations
+
+	parentExpectedTypeAliases := validator.expectedTypeAliases
+	parentFoundTypeAliases := validator.foundTypeAliases
+
+	validator.expectedTypeAliases = withTypeAliases(
+		parentExpectedTypeAliases,
+		validator.currentQualifiedIdentifier,
+		oldDeclaration.DeclarationMembers().TypeAliases(),
+	)
+	validator.foundTypeAliases = withTypeAliases(
+		parentFoundTypeAliases,
+		validator.currentQualifiedIdentifier,
+		newDeclaration.DeclarationMembers().TypeAliases(),
+	)
+
 	defer func() {
 		validator.currentDecl = parentDecl
+		validator.currentQualifiedIdentifier = parentQualifiedIdentifier
+		validator.expectedTypeAliases = parentExpectedTypeAliases
+		validator.foundTypeAliases = parentFoundTypeAliases
 	}()
 
+	validator.checkTypeAliases(oldDeclaration, newDeclaration)
+
 	validator.checkFields(oldDeclaration, newDeclaration)
 
+	validator.checkOverloads(oldDeclaration, newDeclaration)
+
 	validator.checkNestedDeclarations(oldDeclaration, newDeclaration)
 
 	if newDecl, ok := newDeclaration.(*ast.CompositeDeclaration); ok {
 		if oldDecl, ok := oldDeclaration.(*ast.CompositeDeclaration); ok {
 			validator.checkConformances(oldDecl, newDecl)
+			validator.checkNewtypeUnderlyingType(oldDecl, newDecl)
+		}
+	}
+
+	if newDecl, ok := newDeclaration.(*ast.InterfaceDeclaration); ok {
+		if oldDecl, ok := oldDeclaration.(*ast.InterfaceDeclaration); ok {
+			validator.checkInterfaceConformances(oldDecl, newDecl)
 		}
 	}
 }
@@ -185,6 +229,257 @@ func (validator *ContractUpdateValidator) checkField(oldField *ast.FieldDeclarat
 	}
 }
 
+// checkOverloads checks the overloaded functions and initializers of the old declaration.
+//
+// Invocations of overloaded functions and initializers refer to the overload by its argument labels,
+// e.g. `init(name:symbol:)`, so the code of other contracts that was deployed against the old declaration
+// relies on the existing overloads. Adding overloads is valid,
+// but removing an overload, or changing its parameter types or return type, is not.
+// Functions and initializers which are not overloaded may still be changed freely.
+func (validator *ContractUpdateValidator) checkOverloads(
+	oldDeclaration ast.Declaration,
+	newDeclaration ast.Declaration,
+) {
+	oldMembers := oldDeclaration.DeclarationMembers()
+	newMembers := newDeclaration.DeclarationMembers()
+
+	// Functions
+
+	oldFunctions := oldMembers.Functions()
+
+	oldFunctionCounts := make(map[string]int, len(oldFunctions))
+	for _, oldFunction := range oldFunctions {
+		oldFunctionCounts[oldFunction.Identifier.Identifier]++
+	}
+
+	newFunctions := map[string]*ast.FunctionDeclaration{}
+	for _, newFunction := range newMembers.Functions() {
+		newFunctions[functionOverloadIdentifier(
+			newFunction.Identifier.Identifier,
+			newFunction,
+		)] = newFunction
+	}
+
+	for _, oldFunction := range oldFunctions {
+		if oldFunctionCounts[oldFunction.Identifier.Identifier] < 2 {
+			continue
+		}
+
+		overload := functionOverloadIdentifier(oldFunction.Identifier.Identifier, oldFunction)
+
+		validator.checkOverload(
+			newDeclaration,
+			overload,
+			oldFunction,
+			newFunctions[overload],
+			true,
+		)
+	}
+
+	// Initializers
+
+	oldInitializers := oldMembers.Initializers()
+	if len(oldInitializers) < 2 {
+		return
+	}
+
+	initializerName := common.DeclarationKindInitializer.Keywords()
+
+	newInitializers := map[string]*ast.FunctionDeclaration{}
+	for _, newInitializer := range newMembers.Initializers() {
+		newInitializers[functionOverloadIdentifier(
+			initializerName,
+			newInitializer.FunctionDeclaration,
+		)] = newInitializer.FunctionDeclaration
+	}
+
+	for _, oldInitializer := range oldInitializers {
+		overload := functionOverloadIdentifier(initializerName, oldInitializer.FunctionDeclaration)
+
+		validator.checkOverload(
+			newDeclaration,
+			overload,
+			oldInitializer.FunctionDeclaration,
+			newInitializers[overload],
+			false,
+		)
+	}
+}
+
+func (validator *ContractUpdateValidator) checkOverload(
+	newDeclaration ast.Declaration,
+	overload string,
+	oldFunction *ast.FunctionDeclaration,
+	newFunction *ast.FunctionDeclaration,
+	checkReturnType bool,
+) {
+	declName := newDeclaration.DeclarationIdentifier().Identifier
+
+	if newFunction == nil {
+		validator.report(&MissingOverloadError{
+			DeclName: declName,
+			Overload: overload,
+			Range:    ast.NewUnmeteredRangeFromPositioned(newDeclaration.DeclarationIdentifier()),
+		})
+
+		return
+	}
+
+	reportMismatch := func(err error, positioned ast.HasPosition) {
+		validator.report(&OverloadMismatchError{
+			DeclName: declName,
+			Overload: overload,
+			Err:      err,
+			Range:    ast.NewUnmeteredRangeFromPositioned(positioned),
+		})
+	}
+
+	// The argument labels are part of the overload identifier,
+	// so the number of parameters is the same
+
+	newParameters := newFunction.ParameterList.Parameters
+	for i, oldParameter := range oldFunction.ParameterList.Parameters {
+		newParameter := newParameters[i]
+
+		err := oldParameter.TypeAnnotation.Type.CheckEqual(newParameter.TypeAnnotation.Type, validator)
+		if err != nil {
+			reportMismatch(err, newParameter.TypeAnnotation)
+			return
+		}
+	}
+
+	if !checkReturnType {
+		return
+	}
+
+	oldReturnType := functionReturnType(oldFunction)
+	newReturnType := functionReturnType(newFunction)
+
+	err := oldReturnType.CheckEqual(newReturnType, validator)
+	if err != nil {
+		reportMismatch(err, newFunction.Identifier)
+	}
+}
+
+func functionOverloadIdentifier(name string, function *ast.FunctionDeclaration) string {
+	return sema.FunctionOverloadIdentifier(
+		name,
+		function.ParameterList.EffectiveArgumentLabels(),
+	)
+}
+
+// functionReturnType returns the return type of the given function.
+// A missing return type annotation is equivalent to `Void`.
+func functionReturnType(function *ast.FunctionDeclaration) ast.Type {
+	returnTypeAnnotation := function.ReturnTypeAnnotation
+	if returnTypeAnnotation != nil {
+		if nominalType, ok := returnTypeAnnotation.Type.(*ast.NominalType); !ok ||
+			nominalType.Identifier.Identifier != "" {
+
+			return returnTypeAnnotation.Type
+		}
+	}
+
+	return &ast.NominalType{
+		Identifier: ast.Identifier{
+			Identifier: sema.VoidType.Name,
+		},
+	}
+}
+
+// checkTypeAliases checks that the type aliases of the old declaration
+// which are still declared in the new declaration still refer to the same type.
+// Type aliases are transparent, so changing the name a type is referred to by is not a breaking change,
+// but changing the aliased type is.
+func (validator *ContractUpdateValidator) checkTypeAliases(
+	oldDeclaration ast.Declaration,
+	newDeclaration ast.Declaration,
+) {
+	oldTypeAliases := map[string]*ast.TypeAliasDeclaration{}
+	for _, oldTypeAlias := range oldDeclaration.DeclarationMembers().TypeAliases() {
+		oldTypeAliases[oldTypeAlias.Identifier.Identifier] = oldTypeAlias
+	}
+
+	for _, newTypeAlias := range newDeclaration.DeclarationMembers().TypeAliases() {
+		oldTypeAlias, ok := oldTypeAliases[newTypeAlias.Identifier.Identifier]
+		if !ok {
+			// Then it's a new type alias
+			continue
+		}
+
+		err := oldTypeAlias.Type.CheckEqual(newTypeAlias.Type, validator)
+		if err != nil {
+			validator.report(&TypeAliasMismatchError{
+				DeclName:      validator.currentDecl.DeclarationIdentifier().Identifier,
+				TypeAliasName: newTypeAlias.Identifier.Identifier,
+				Err:           err,
+				Range:         ast.NewUnmeteredRangeFromPositioned(newTypeAlias.Type),
+			})
+		}
+	}
+}
+
+// withTypeAliases returns a copy of the given type aliases,
+// extended with the given type alias declarations.
+// The type aliases are accessible both by their name,
+// and by their name qualified by the given qualified identifier.
+func withTypeAliases(
+	parent map[string]ast.Type,
+	qualifiedIdentifier string,
+	declarations []*ast.TypeAliasDeclaration,
+) map[string]ast.Type {
+
+	if len(declarations) == 0 {
+		return parent
+	}
+
+	typeAliases := make(map[string]ast.Type, len(parent)+2*len(declarations))
+	for name, aliasedType := range parent { //nolint:maprangecheck
+		typeAliases[name] = aliasedType
+	}
+
+	for _, declaration := range declarations {
+		name := declaration.Identifier.Identifier
+		typeAliases[name] = declaration.Type
+		if qualifiedIdentifier != "" {
+			typeAliases[qualifiedIdentifier+"."+name] = declaration.Type
+		}
+	}
+
+	return typeAliases
+}
+
+func qualifiedIdentifier(parent string, identifier string) string {
+	if parent == "" {
+		return identifier
+	}
+	return parent + "." + identifier
+}
+
+// checkNewtypeUnderlyingType checks that the underlying type of a newtype was not changed.
+// Newtypes have no field declarations, but their values store the wrapped value
+// of the underlying type in a field.
+func (validator *ContractUpdateValidator) checkNewtypeUnderlyingType(
+	oldDeclaration *ast.CompositeDeclaration,
+	newDeclaration *ast.CompositeDeclaration,
+) {
+	oldTypeAnnotation := oldDeclaration.NewtypeUnderlyingTypeAnnotation()
+	newTypeAnnotation := newDeclaration.NewtypeUnderlyingTypeAnnotation()
+	if oldTypeAnnotation == nil || newTypeAnnotation == nil {
+		return
+	}
+
+	err := oldTypeAnnotation.Type.CheckEqual(newTypeAnnotation.Type, validator)
+	if err != nil {
+		validator.report(&FieldMismatchError{
+			DeclName:  newDeclaration.Identifier.Identifier,
+			FieldName: ast.NewtypeValueFieldName,
+			Err:       err,
+			Range:     ast.NewUnmeteredRangeFromPositioned(newTypeAnnotation),
+		})
+	}
+}
+
 func (validator *ContractUpdateValidator) checkNestedDeclarations(
 	oldDeclaration ast.Declaration,
 	newDeclaration ast.Declaration,
@@ -350,6 +645,57 @@ func (validator *ContractUpdateValidator) checkConformances(
 	}
 }
 
+func (validator *ContractUpdateValidator) checkInterfaceConformances(
+	oldDecl *ast.InterfaceDeclaration,
+	newDecl *ast.InterfaceDeclaration,
+) {
+
+	// Unlike for composites, the conformances of an interface must not change at all.
+	// Order is not important.
+	//
+	// Adding a conformance adds requirements that existing implementations might not fulfil,
+	// and removing a conformance breaks existing restricted types which rely on it.
+
+	oldConformances := oldDecl.Conformances
+	newConformances := newDecl.Conformances
+
+	if len(oldConformances) != len(newConformances) {
+		validator.report(&InterfaceConformanceMismatchError{
+			DeclName: newDecl.Identifier.Identifier,
+			Range:    ast.NewUnmeteredRangeFromPositioned(newDecl.Identifier),
+		})
+
+		return
+	}
+
+	matched := make([]bool, len(newConformances))
+
+	for _, oldConformance := range oldConformances {
+		found := false
+		for index, newConformance := range newConformances {
+			if matched[index] {
+				continue
+			}
+
+			err := oldConformance.CheckEqual(newConformance, validator)
+			if err == nil {
+				found = true
+				matched[index] = true
+				break
+			}
+		}
+
+		if !found {
+			validator.report(&InterfaceConformanceMismatchError{
+				DeclName: newDecl.Identifier.Identifier,
+				Range:    ast.NewUnmeteredRangeFromPositioned(newDecl.Identifier),
+			})
+
+			return
+		}
+	}
+}
+
 func (validator *ContractUpdateValidator) report(err error) {
 	if err == nil {
 		return
@@ -798,6 +798,296 @@ func TestRuntimeContractUpdateValidation(t *testing.T) {
 		assertFieldTypeMismatchError(t, cause, "UnusedStruct", "a", "Int", "String")
 	})
 
+	t.Run("add overloads", func(t *testing.T) {
+
+		t.Parallel()
+
+		const oldCode = `
+            pub contract Test {
+
+                pub var x: Token
+
+                init() {
+                    self.x = Token(name: "a")
+                }
+
+                pub fun describe(): String {
+                    return self.x.name
+                }
+
+                pub struct Token {
+                    pub let name: String
+
+                    init(name: String) {
+                        self.name = name
+                    }
+                }
+            }
+        `
+
+		const newCode = `
+            pub contract Test {
+
+                pub var x: Token
+
+                init() {
+                    self.x = Token(name: "a", symbol: "A")
+                }
+
+                pub fun describe(): String {
+                    return self.x.name
+                }
+
+                pub fun describe(prefix: String): String {
+                    return prefix.concat(self.x.name)
+                }
+
+                pub struct Token {
+                    pub let name: String
+
+                    init(name: String) {
+                        self.name = name
+                    }
+
+                    init(name: String, symbol: String) {
+                        self.name = name.concat(symbol)
+                    }
+                }
+            }
+        `
+
+		err := testDeployAndUpdate(t, contractValidationEnabled, "Test", oldCode, newCode)
+		require.NoError(t, err)
+	})
+
+	const overloadsCode = `
+        pub contract Test {
+
+            pub var x: Token
+
+            init() {
+                self.x = Token(name: "a", symbol: "A")
+            }
+
+            pub fun describe(): String {
+                return self.x.name
+            }
+
+            pub fun describe(prefix: String): String {
+                return prefix.concat(self.x.name)
+            }
+
+            pub struct Token {
+                pub let name: String
+
+                init(name: String) {
+                    self.name = name
+                }
+
+                init(name: String, symbol: String) {
+                    self.name = name.concat(symbol)
+                }
+            }
+        }
+    `
+
+	t.Run("change overload bodies and parameter names", func(t *testing.T) {
+
+		t.Parallel()
+
+		const newCode = `
+            pub contract Test {
+
+                pub var x: Token
+
+                init() {
+                    self.x = Token(name: "a", symbol: "A")
+                }
+
+                pub fun describe(): String {
+                    return self.x.name.concat("!")
+                }
+
+                pub fun describe(prefix p: String): String {
+                    return p.concat(" ").concat(self.x.name)
+                }
+
+                pub fun describe(suffix: String): String {
+                    return self.x.name.concat(suffix)
+                }
+
+                pub struct Token {
+                    pub let name: String
+
+                    init(name: String) {
+                        self.name = name
+                    }
+
+                    init(name n: String, symbol s: String) {
+                        self.name = s.concat(n)
+                    }
+                }
+            }
+        `
+
+		err := testDeployAndUpdate(t, contractValidationEnabled, "Test", overloadsCode, newCode)
+		require.NoError(t, err)
+	})
+
+	t.Run("remove function overload", func(t *testing.T) {
+
+		t.Parallel()
+
+		const newCode = `
+            pub contract Test {
+
+                pub var x: Token
+
+                init() {
+                    self.x = Token(name: "a", symbol: "A")
+                }
+
+                pub fun describe(): String {
+                    return self.x.name
+                }
+
+                pub struct Token {
+                    pub let name: String
+
+                    init(name: String) {
+                        self.name = name
+                    }
+
+                    init(name: String, symbol: String) {
+                        self.name = name.concat(symbol)
+                    }
+                }
+            }
+        `
+
+		err := testDeployAndUpdate(t, contractValidationEnabled, "Test", overloadsCode, newCode)
+		cause := getSingleContractUpdateErrorCause(t, err, "Test")
+
+		assertMissingOverloadError(t, cause, "Test", "describe(prefix:)")
+	})
+
+	t.Run("remove initializer overload", func(t *testing.T) {
+
+		t.Parallel()
+
+		const newCode = `
+            pub contract Test {
+
+                pub var x: Token
+
+                init() {
+                    self.x = Token(name: "a")
+                }
+
+                pub fun describe(): String {
+                    return self.x.name
+                }
+
+                pub fun describe(prefix: String): String {
+                    return prefix.concat(self.x.name)
+                }
+
+                pub struct Token {
+                    pub let name: String
+
+                    init(name: String) {
+                        self.name = name
+                    }
+                }
+            }
+        `
+
+		err := testDeployAndUpdate(t, contractValidationEnabled, "Test", overloadsCode, newCode)
+		cause := getSingleContractUpdateErrorCause(t, err, "Test")
+
+		assertMissingOverloadError(t, cause, "Token", "init(name:symbol:)")
+	})
+
+	t.Run("change overload parameter type", func(t *testing.T) {
+
+		t.Parallel()
+
+		const newCode = `
+            pub contract Test {
+
+                pub var x: Token
+
+                init() {
+                    self.x = Token(name: "a", symbol: 1)
+                }
+
+                pub fun describe(): String {
+                    return self.x.name
+                }
+
+                pub fun describe(prefix: String): String {
+                    return prefix.concat(self.x.name)
+                }
+
+                pub struct Token {
+                    pub let name: String
+
+                    init(name: String) {
+                        self.name = name
+                    }
+
+                    init(name: String, symbol: Int) {
+                        self.name = name.concat(symbol.toString())
+                    }
+                }
+            }
+        `
+
+		err := testDeployAndUpdate(t, contractValidationEnabled, "Test", overloadsCode, newCode)
+		cause := getSingleContractUpdateErrorCause(t, err, "Test")
+
+		assertOverloadMismatchError(t, cause, "Token", "init(name:symbol:)", "String", "Int")
+	})
+
+	t.Run("change overload return type", func(t *testing.T) {
+
+		t.Parallel()
+
+		const newCode = `
+            pub contract Test {
+
+                pub var x: Token
+
+                init() {
+                    self.x = Token(name: "a", symbol: "A")
+                }
+
+                pub fun describe() {}
+
+                pub fun describe(prefix: String): String {
+                    return prefix.concat(self.x.name)
+                }
+
+                pub struct Token {
+                    pub let name: String
+
+                    init(name: String) {
+                        self.name = name
+                    }
+
+                    init(name: String, symbol: String) {
+                        self.name = name.concat(symbol)
+                    }
+                }
+            }
+        `
+
+		err := testDeployAndUpdate(t, contractValidationEnabled, "Test", overloadsCode, newCode)
+		cause := getSingleContractUpdateErrorCause(t, err, "Test")
+
+		assertOverloadMismatchError(t, cause, "Test", "describe()", "String", "Void")
+	})
+
 	t.Run("change enum type", func(t *testing.T) {
 
 		t.Parallel()
@@ -1464,6 +1754,65 @@ func TestRuntimeContractUpdateValidation(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("Test set types", func(t *testing.T) {
+
+		t.Parallel()
+
+		const oldCode = `
+            pub contract Test {
+                pub var a: {Int}
+
+                init() {
+                    self.a = [1, 2]
+                }
+            }
+        `
+
+		const newCode = `
+            pub contract Test {
+                pub var a: {Int}
+
+                init() {
+                    self.a = []
+                }
+            }
+        `
+
+		err := testDeployAndUpdate(t, contractValidationEnabled, "Test", oldCode, newCode)
+		require.NoError(t, err)
+	})
+
+	t.Run("Test invalid set types change", func(t *testing.T) {
+
+		t.Parallel()
+
+		const oldCode = `
+            pub contract Test {
+                pub var a: {Int}
+
+                init() {
+                    self.a = [1, 2]
+                }
+            }
+        `
+
+		const newCode = `
+            pub contract Test {
+                pub var a: {String}
+
+                init() {
+                    self.a = ["1", "2"]
+                }
+            }
+        `
+
+		err := testDeployAndUpdate(t, contractValidationEnabled, "Test", oldCode, newCode)
+		require.Error(t, err)
+
+		cause := getSingleContractUpdateErrorCause(t, err, "Test")
+		assertFieldTypeMismatchError(t, cause, "Test", "a", "Int", "String")
+	})
+
 	t.Run("Test invalid restricted types change", func(t *testing.T) {
 
 		t.Parallel()
@@ -1797,6 +2146,195 @@ func TestRuntimeContractUpdateValidation(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("change field type to type alias", func(t *testing.T) {
+
+		t.Parallel()
+
+		const oldCode = `
+            pub contract Test {
+                pub var a: UInt64
+                pub var b: {UInt64: String}
+                init() {
+                    self.a = 0
+                    self.b = {}
+                }
+            }
+        `
+
+		const newCode = `
+            pub contract Test {
+                pub typealias ID = UInt64
+
+                pub var a: ID
+                pub var b: {Test.ID: String}
+                init() {
+                    self.a = 0
+                    self.b = {}
+                }
+            }
+        `
+
+		err := testDeployAndUpdate(t, contractValidationEnabled, "Test", oldCode, newCode)
+		require.NoError(t, err)
+	})
+
+	t.Run("change field type from type alias", func(t *testing.T) {
+
+		t.Parallel()
+
+		const oldCode = `
+            pub contract Test {
+                pub typealias ID = UInt64
+
+                pub struct S {
+                    pub var a: ID
+                    init() {
+                        self.a = 0
+                    }
+                }
+            }
+        `
+
+		const newCode = `
+            pub contract Test {
+                pub struct S {
+                    pub var a: UInt64
+                    init() {
+                        self.a = 0
+                    }
+                }
+            }
+        `
+
+		err := testDeployAndUpdate(t, contractValidationEnabled, "Test", oldCode, newCode)
+		require.NoError(t, err)
+	})
+
+	t.Run("change field type through type alias", func(t *testing.T) {
+
+		t.Parallel()
+
+		const oldCode = `
+            pub contract Test {
+                pub var a: UInt64
+                init() {
+                    self.a = 0
+                }
+            }
+        `
+
+		const newCode = `
+            pub contract Test {
+                pub typealias ID = String
+
+                pub var a: ID
+                init() {
+                    self.a = ""
+                }
+            }
+        `
+
+		err := testDeployAndUpdate(t, contractValidationEnabled, "Test", oldCode, newCode)
+		require.Error(t, err)
+
+		cause := getSingleContractUpdateErrorCause(t, err, "Test")
+		assertFieldTypeMismatchError(t, cause, "Test", "a", "UInt64", "String")
+	})
+
+	t.Run("change type alias", func(t *testing.T) {
+
+		t.Parallel()
+
+		const oldCode = `
+            pub contract Test {
+                pub typealias ID = UInt64
+            }
+        `
+
+		const newCode = `
+            pub contract Test {
+                pub typealias ID = UInt32
+            }
+        `
+
+		err := testDeployAndUpdate(t, contractValidationEnabled, "Test", oldCode, newCode)
+		require.Error(t, err)
+
+		cause := getSingleContractUpdateErrorCause(t, err, "Test")
+
+		var typeAliasMismatchError *TypeAliasMismatchError
+		require.ErrorAs(t, cause, &typeAliasMismatchError)
+
+		assert.Equal(t, "ID", typeAliasMismatchError.TypeAliasName)
+		assert.Equal(t, "Test", typeAliasMismatchError.DeclName)
+	})
+
+	t.Run("change type alias to equivalent type alias", func(t *testing.T) {
+
+		t.Parallel()
+
+		const oldCode = `
+            pub contract Test {
+                pub typealias ID = UInt64
+            }
+        `
+
+		const newCode = `
+            pub contract Test {
+                pub typealias Count = UInt64
+                pub typealias ID = Count
+            }
+        `
+
+		err := testDeployAndUpdate(t, contractValidationEnabled, "Test", oldCode, newCode)
+		require.NoError(t, err)
+	})
+
+	t.Run("change newtype underlying type", func(t *testing.T) {
+
+		t.Parallel()
+
+		const oldCode = `
+            pub contract Test {
+                pub newtype ID: UInt64
+            }
+        `
+
+		const newCode = `
+            pub contract Test {
+                pub newtype ID: String
+            }
+        `
+
+		err := testDeployAndUpdate(t, contractValidationEnabled, "Test", oldCode, newCode)
+		require.Error(t, err)
+
+		cause := getSingleContractUpdateErrorCause(t, err, "Test")
+
+		assertFieldTypeMismatchError(t, cause, "ID", "value", "UInt64", "String")
+	})
+
+	t.Run("change newtype underlying type to type alias", func(t *testing.T) {
+
+		t.Parallel()
+
+		const oldCode = `
+            pub contract Test {
+                pub newtype ID: UInt64
+            }
+        `
+
+		const newCode = `
+            pub contract Test {
+                pub typealias RawID = UInt64
+                pub newtype ID: RawID
+            }
+        `
+
+		err := testDeployAndUpdate(t, contractValidationEnabled, "Test", oldCode, newCode)
+		require.NoError(t, err)
+	})
+
 	t.Run("removing multiple nested structs", func(t *testing.T) {
 
 		t.Parallel()
@@ -1912,6 +2450,35 @@ func assertMissingEnumCasesError(t *testing.T, err error, declName string, expec
 	assert.Equal(t, foundCases, missingEnumCasesError.Found)
 }
 
+func assertMissingOverloadError(t *testing.T, err error, declName string, overload string) {
+	var missingOverloadError *MissingOverloadError
+	require.ErrorAs(t, err, &missingOverloadError)
+
+	assert.Equal(t, declName, missingOverloadError.DeclName)
+	assert.Equal(t, overload, missingOverloadError.Overload)
+}
+
+func assertOverloadMismatchError(
+	t *testing.T,
+	err error,
+	declName string,
+	overload string,
+	expectedType string,
+	foundType string,
+) {
+	var overloadMismatchError *OverloadMismatchError
+	require.ErrorAs(t, err, &overloadMismatchError)
+
+	assert.Equal(t, declName, overloadMismatchError.DeclName)
+	assert.Equal(t, overload, overloadMismatchError.Overload)
+
+	var typeMismatchError *TypeMismatchError
+	require.ErrorAs(t, overloadMismatchError.Err, &typeMismatchError)
+
+	assert.Equal(t, expectedType, typeMismatchError.ExpectedType.String())
+	assert.Equal(t, foundType, typeMismatchError.FoundType.String())
+}
+
 func assertMissingDeclarationError(t *testing.T, err error, declName string) bool {
 	var missingDeclError *MissingDeclarationError
 	require.ErrorAs(t, err, &missingDeclError)
@@ -2245,6 +2812,144 @@ func TestRuntimeContractUpdateConformanceChanges(t *testing.T) {
 	})
 }
 
+func TestRuntimeContractUpdateInterfaceConformanceChanges(t *testing.T) {
+
+	t.Parallel()
+
+	const contractValidationEnabled = true
+
+	t.Run("Adding interface conformance", func(t *testing.T) {
+
+		t.Parallel()
+
+		const oldCode = `
+            pub contract Test {
+                pub struct interface Foo {}
+
+                pub struct interface Bar {}
+            }
+        `
+
+		const newCode = `
+            pub contract Test {
+                pub struct interface Foo: Bar {}
+
+                pub struct interface Bar {}
+            }
+        `
+
+		err := testDeployAndUpdate(t, contractValidationEnabled, "Test", oldCode, newCode)
+		require.Error(t, err)
+
+		cause := getSingleContractUpdateErrorCause(t, err, "Test")
+
+		assertInterfaceConformanceMismatchError(t, cause, "Foo")
+	})
+
+	t.Run("Removing interface conformance", func(t *testing.T) {
+
+		t.Parallel()
+
+		const oldCode = `
+            pub contract Test {
+                pub struct interface Foo: Bar, Baz {}
+
+                pub struct interface Bar {}
+
+                pub struct interface Baz {}
+            }
+        `
+
+		const newCode = `
+            pub contract Test {
+                pub struct interface Foo: Baz {}
+
+                pub struct interface Bar {}
+
+                pub struct interface Baz {}
+            }
+        `
+
+		err := testDeployAndUpdate(t, contractValidationEnabled, "Test", oldCode, newCode)
+		require.Error(t, err)
+
+		cause := getSingleContractUpdateErrorCause(t, err, "Test")
+
+		assertInterfaceConformanceMismatchError(t, cause, "Foo")
+	})
+
+	t.Run("Replacing interface conformance", func(t *testing.T) {
+
+		t.Parallel()
+
+		const oldCode = `
+            pub contract Test {
+                pub struct interface Foo: Bar {}
+
+                pub struct interface Bar {}
+
+                pub struct interface Baz {}
+            }
+        `
+
+		const newCode = `
+            pub contract Test {
+                pub struct interface Foo: Baz {}
+
+                pub struct interface Bar {}
+
+                pub struct interface Baz {}
+            }
+        `
+
+		err := testDeployAndUpdate(t, contractValidationEnabled, "Test", oldCode, newCode)
+		require.Error(t, err)
+
+		cause := getSingleContractUpdateErrorCause(t, err, "Test")
+
+		assertInterfaceConformanceMismatchError(t, cause, "Foo")
+	})
+
+	t.Run("Change interface conformance order", func(t *testing.T) {
+
+		t.Parallel()
+
+		const oldCode = `
+            pub contract Test {
+                pub struct interface Foo: Bar, Baz {}
+
+                pub struct interface Bar {}
+
+                pub struct interface Baz {}
+            }
+        `
+
+		const newCode = `
+            pub contract Test {
+                pub struct interface Foo: Baz, Bar {}
+
+                pub struct interface Bar {}
+
+                pub struct interface Baz {}
+            }
+        `
+
+		err := testDeployAndUpdate(t, contractValidationEnabled, "Test", oldCode, newCode)
+		require.NoError(t, err)
+	})
+}
+
+func assertInterfaceConformanceMismatchError(
+	t *testing.T,
+	err error,
+	erroneousDeclName string,
+) {
+	var conformanceMismatchError *InterfaceConformanceMismatchError
+	require.ErrorAs(t, err, &conformanceMismatchError)
+
+	assert.Equal(t, erroneousDeclName, conformanceMismatchError.DeclName)
+}
+
 func TestRuntimeContractUpdateProgramCaching(t *testing.T) {
 
 	const name = "Test"
@@ -58,6 +58,8 @@ func ExportType(
 			return exportInterfaceType(gauge, t, results)
 		case *sema.DictionaryType:
 			return exportDictionaryType(gauge, t, results)
+		case *sema.SetType:
+			return exportSetType(gauge, t, results)
 		case *sema.FunctionType:
 			return exportFunctionType(gauge, t, results)
 		case *sema.AddressType:
@@ -119,10 +121,22 @@ func ExportType(
 			return cadence.NewMeteredWord32Type(gauge)
 		case sema.Word64Type:
 			return cadence.NewMeteredWord64Type(gauge)
+		case sema.Word128Type:
+			return cadence.NewMeteredWord128Type(gauge)
+		case sema.Word256Type:
+			return cadence.NewMeteredWord256Type(gauge)
 		case sema.Fix64Type:
 			return cadence.NewMeteredFix64Type(gauge)
 		case sema.UFix64Type:
 			return cadence.NewMeteredUFix64Type(gauge)
+		case sema.Fix128Type:
+			return cadence.NewMeteredFix128Type(gauge)
+		case sema.UFix128Type:
+			return cadence.NewMeteredUFix128Type(gauge)
+		case sema.TimestampType:
+			return cadence.NewMeteredTimestampType(gauge)
+		case sema.DurationType:
+			return cadence.NewMeteredDurationType(gauge)
 		case sema.PathType:
 			return cadence.NewMeteredPathType(gauge)
 		case sema.StoragePathType:
@@ -210,6 +224,8 @@ func ExportMeteredType(
 			return exportInterfaceType(gauge, t, results)
 		case *sema.DictionaryType:
 			return exportDictionaryType(gauge, t, results)
+		case *sema.SetType:
+			return exportSetType(gauge, t, results)
 		case *sema.FunctionType:
 			return exportFunctionType(gauge, t, results)
 		case *sema.AddressType:
@@ -271,10 +287,22 @@ func ExportMeteredType(
 			return cadence.NewMeteredWord32Type(gauge)
 		case sema.Word64Type:
 			return cadence.NewMeteredWord64Type(gauge)
+		case sema.Word128Type:
+			return cadence.NewMeteredWord128Type(gauge)
+		case sema.Word256Type:
+			return cadence.NewMeteredWord256Type(gauge)
 		case sema.Fix64Type:
 			return cadence.NewMeteredFix64Type(gauge)
 		case sema.UFix64Type:
 			return cadence.NewMeteredUFix64Type(gauge)
+		case sema.Fix128Type:
+			return cadence.NewMeteredFix128Type(gauge)
+		case sema.UFix128Type:
+			return cadence.NewMeteredUFix128Type(gauge)
+		case sema.TimestampType:
+			return cadence.NewMeteredTimestampType(gauge)
+		case sema.DurationType:
+			return cadence.NewMeteredDurationType(gauge)
 		case sema.PathType:
 			return cadence.NewMeteredPathType(gauge)
 		case sema.StoragePathType:
@@ -429,10 +457,36 @@ func exportCompositeType(
 			nil,
 		)
 
+	case common.CompositeKindNewtype:
+		result = cadence.NewMeteredNewtypeType(
+			gauge,
+			t.Location,
+			t.QualifiedIdentifier(),
+			ExportMeteredType(gauge, t.NewtypeUnderlyingType, results),
+			fields,
+			nil,
+		)
+
 	default:
 		panic(fmt.Sprintf("cannot export composite type %v of unknown kind %v", t, t.Kind))
 	}
 
+	// Instantiated generic composite types are exported with their type arguments
+
+	if typeArguments := t.TypeArguments(); len(typeArguments) > 0 {
+		exportedTypeArguments := make([]cadence.Type, len(typeArguments))
+		for i, typeArgument := range typeArguments {
+			exportedTypeArguments[i] = ExportMeteredType(gauge, typeArgument, results)
+		}
+
+		switch result := result.(type) {
+		case *cadence.StructType:
+			result.TypeArguments = exportedTypeArguments
+		case *cadence.ResourceType:
+			result.TypeArguments = exportedTypeArguments
+		}
+	}
+
 	// NOTE: ensure to set the result before recursively export field types
 
 	results[t.ID()] = result
@@ -536,6 +590,19 @@ func exportDictionaryType(
 	)
 }
 
+func exportSetType(
+	gauge common.MemoryGauge,
+	t *sema.SetType,
+	results map[sema.TypeID]cadence.Type,
+) cadence.Type {
+	convertedElementType := ExportMeteredType(gauge, t.ElementType, results)
+
+	return cadence.NewMeteredSetType(
+		gauge,
+		convertedElementType,
+	)
+}
+
 func exportFunctionType(
 	gauge common.MemoryGauge,
 	t *sema.FunctionType,
@@ -630,12 +697,29 @@ func importInterfaceType(memoryGauge common.MemoryGauge, t cadence.InterfaceType
 }
 
 func importCompositeType(memoryGauge common.MemoryGauge, t cadence.CompositeType) interpreter.CompositeStaticType {
-	return interpreter.NewCompositeStaticType(
+	staticType := interpreter.NewCompositeStaticType(
 		memoryGauge,
 		t.CompositeTypeLocation(),
 		t.CompositeTypeQualifiedIdentifier(),
 		"", // intentionally empty
 	)
+
+	var typeArguments []cadence.Type
+	switch t := t.(type) {
+	case *cadence.StructType:
+		typeArguments = t.TypeArguments
+	case *cadence.ResourceType:
+		typeArguments = t.TypeArguments
+	}
+
+	if len(typeArguments) > 0 {
+		staticType.TypeArguments = make([]interpreter.StaticType, len(typeArguments))
+		for i, typeArgument := range typeArguments {
+			staticType.TypeArguments[i] = ImportType(memoryGauge, typeArgument)
+		}
+	}
+
+	return staticType
 }
 
 func ImportType(memoryGauge common.MemoryGauge, t cadence.Type) interpreter.StaticType {
@@ -710,10 +794,22 @@ func ImportType(memoryGauge common.MemoryGauge, t cadence.Type) interpreter.Stat
 		return interpreter.NewPrimitiveStaticType(memoryGauge, interpreter.PrimitiveStaticTypeWord32)
 	case cadence.Word64Type:
 		return interpreter.NewPrimitiveStaticType(memoryGauge, interpreter.PrimitiveStaticTypeWord64)
+	case cadence.Word128Type:
+		return interpreter.NewPrimitiveStaticType(memoryGauge, interpreter.PrimitiveStaticTypeWord128)
+	case cadence.Word256Type:
+		return interpreter.NewPrimitiveStaticType(memoryGauge, interpreter.PrimitiveStaticTypeWord256)
 	case cadence.Fix64Type:
 		return interpreter.NewPrimitiveStaticType(memoryGauge, interpreter.PrimitiveStaticTypeFix64)
 	case cadence.UFix64Type:
 		return interpreter.NewPrimitiveStaticType(memoryGauge, interpreter.PrimitiveStaticTypeUFix64)
+	case cadence.Fix128Type:
+		return interpreter.NewPrimitiveStaticType(memoryGauge, interpreter.PrimitiveStaticTypeFix128)
+	case cadence.UFix128Type:
+		return interpreter.NewPrimitiveStaticType(memoryGauge, interpreter.PrimitiveStaticTypeUFix128)
+	case cadence.TimestampType:
+		return interpreter.NewPrimitiveStaticType(memoryGauge, interpreter.PrimitiveStaticTypeTimestamp)
+	case cadence.DurationType:
+		return interpreter.NewPrimitiveStaticType(memoryGauge, interpreter.PrimitiveStaticTypeDuration)
 	case cadence.VariableSizedArrayType:
 		return interpreter.NewVariableSizedStaticType(memoryGauge, ImportType(memoryGauge, t.ElementType))
 	case cadence.ConstantSizedArrayType:
@@ -728,11 +824,17 @@ func ImportType(memoryGauge common.MemoryGauge, t cadence.Type) interpreter.Stat
 			ImportType(memoryGauge, t.KeyType),
 			ImportType(memoryGauge, t.ElementType),
 		)
+	case cadence.SetType:
+		return interpreter.NewSetStaticType(
+			memoryGauge,
+			ImportType(memoryGauge, t.ElementType),
+		)
 	case *cadence.StructType,
 		*cadence.ResourceType,
 		*cadence.EventType,
 		*cadence.ContractType,
-		*cadence.EnumType:
+		*cadence.EnumType,
+		*cadence.NewtypeType:
 		return importCompositeType(memoryGauge, t.(cadence.CompositeType))
 	case *cadence.StructInterfaceType,
 		*cadence.ResourceInterfaceType,
@@ -185,10 +185,42 @@ func exportValueWithInterpreter(
 		return cadence.NewMeteredWord32(inter, uint32(v)), nil
 	case interpreter.Word64Value:
 		return cadence.NewMeteredWord64(inter, uint64(v)), nil
+	case interpreter.Word128Value:
+		return cadence.NewMeteredWord128FromBig(
+			inter,
+			func() *big.Int {
+				return v.ToBigInt(inter)
+			},
+		)
+	case interpreter.Word256Value:
+		return cadence.NewMeteredWord256FromBig(
+			inter,
+			func() *big.Int {
+				return v.ToBigInt(inter)
+			},
+		)
 	case interpreter.Fix64Value:
 		return cadence.Fix64(v), nil
 	case interpreter.UFix64Value:
 		return cadence.UFix64(v), nil
+	case interpreter.Fix128Value:
+		return cadence.NewMeteredFix128FromBig(
+			inter,
+			func() *big.Int {
+				return v.BigInt
+			},
+		)
+	case interpreter.UFix128Value:
+		return cadence.NewMeteredUFix128FromBig(
+			inter,
+			func() *big.Int {
+				return v.BigInt
+			},
+		)
+	case interpreter.TimestampValue:
+		return cadence.NewMeteredTimestamp(inter, int64(v)), nil
+	case interpreter.DurationValue:
+		return cadence.NewMeteredDuration(inter, int64(v)), nil
 	case *interpreter.CompositeValue:
 		return exportCompositeValue(
 			v,
@@ -210,6 +242,13 @@ func exportValueWithInterpreter(
 			getLocationRange,
 			seenReferences,
 		)
+	case *interpreter.SetValue:
+		return exportSetValue(
+			v,
+			inter,
+			getLocationRange,
+			seenReferences,
+		)
 	case interpreter.AddressValue:
 		return cadence.NewMeteredAddress(inter, v), nil
 	case interpreter.LinkValue:
@@ -446,6 +485,18 @@ func exportCompositeValue(
 			return nil, err
 		}
 		return enum.WithType(t.(*cadence.EnumType)), nil
+	case common.CompositeKindNewtype:
+		newtype, err := cadence.NewMeteredNewtype(
+			inter,
+			len(fieldNames),
+			func() ([]cadence.Value, error) {
+				return makeFields()
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		return newtype.WithType(t.(*cadence.NewtypeType)), nil
 	}
 
 	return nil, errors.NewDefaultUserError(
@@ -458,6 +509,7 @@ func exportCompositeValue(
 				common.CompositeKindEvent.Name(),
 				common.CompositeKindContract.Name(),
 				common.CompositeKindEnum.Name(),
+				common.CompositeKindNewtype.Name(),
 			},
 			"or",
 		),
@@ -671,6 +723,55 @@ func exportDictionaryValue(
 	return dictionary.WithType(exportType), err
 }
 
+func exportSetValue(
+	v *interpreter.SetValue,
+	inter *interpreter.Interpreter,
+	getLocationRange func() interpreter.LocationRange,
+	seenReferences seenReferences,
+) (
+	cadence.Set,
+	error,
+) {
+	set, err := cadence.NewMeteredSet(
+		inter,
+		func() ([]cadence.Value, error) {
+			var err error
+			elements := make([]cadence.Value, 0, v.Count())
+
+			v.Iterate(inter, func(element interpreter.Value) (resume bool) {
+
+				var convertedElement cadence.Value
+				convertedElement, err = exportValueWithInterpreter(
+					element,
+					inter,
+					getLocationRange,
+					seenReferences,
+				)
+				if err != nil {
+					return false
+				}
+
+				elements = append(elements, convertedElement)
+
+				return true
+			})
+
+			if err != nil {
+				return nil, err
+			}
+
+			return elements, nil
+		},
+	)
+	if err != nil {
+		return cadence.Set{}, err
+	}
+
+	exportType := ExportType(v.SemaType(inter), map[sema.TypeID]cadence.Type{}).(cadence.SetType)
+
+	return set.WithType(exportType), err
+}
+
 func exportLinkValue(v interpreter.LinkValue, inter *interpreter.Interpreter) cadence.Link {
 	path := exportPathValue(inter, v.TargetPath)
 	ty := string(inter.MustConvertStaticToSemaType(v.Type).ID())
@@ -759,6 +860,21 @@ func exportEvent(
 	return exported.WithType(eventType), nil
 }
 
+// ImportValue converts a Cadence value to a runtime value.
+func ImportValue(
+	inter *interpreter.Interpreter,
+	getLocationRange func() interpreter.LocationRange,
+	value cadence.Value,
+	expectedType sema.Type,
+) (interpreter.Value, error) {
+	return importValue(
+		inter,
+		getLocationRange,
+		value,
+		expectedType,
+	)
+}
+
 // importValue converts a Cadence value to a runtime value.
 func importValue(
 	inter *interpreter.Interpreter,
@@ -822,10 +938,22 @@ func importValue(
 		return importWord32(inter, v), nil
 	case cadence.Word64:
 		return importWord64(inter, v), nil
+	case cadence.Word128:
+		return importWord128(inter, v), nil
+	case cadence.Word256:
+		return importWord256(inter, v), nil
 	case cadence.Fix64:
 		return importFix64(inter, v), nil
 	case cadence.UFix64:
 		return importUFix64(inter, v), nil
+	case cadence.Fix128:
+		return importFix128(inter, v), nil
+	case cadence.UFix128:
+		return importUFix128(inter, v), nil
+	case cadence.Timestamp:
+		return importTimestamp(inter, v)
+	case cadence.Duration:
+		return importDuration(inter, v), nil
 	case cadence.Path:
 		return importPathValue(inter, v), nil
 	case cadence.Array:
@@ -842,6 +970,13 @@ func importValue(
 			v,
 			expectedType,
 		)
+	case cadence.Set:
+		return importSetValue(
+			inter,
+			getLocationRange,
+			v,
+			expectedType,
+		)
 	case cadence.Struct:
 		return importCompositeValue(
 			inter,
@@ -849,6 +984,7 @@ func importValue(
 			common.CompositeKindStructure,
 			v.StructType.Location,
 			v.StructType.QualifiedIdentifier,
+			v.StructType.TypeArguments,
 			v.StructType.Fields,
 			v.Fields,
 		)
@@ -859,6 +995,7 @@ func importValue(
 			common.CompositeKindResource,
 			v.ResourceType.Location,
 			v.ResourceType.QualifiedIdentifier,
+			v.ResourceType.TypeArguments,
 			v.ResourceType.Fields,
 			v.Fields,
 		)
@@ -869,6 +1006,7 @@ func importValue(
 			common.CompositeKindEvent,
 			v.EventType.Location,
 			v.EventType.QualifiedIdentifier,
+			nil,
 			v.EventType.Fields,
 			v.Fields,
 		)
@@ -879,9 +1017,21 @@ func importValue(
 			common.CompositeKindEnum,
 			v.EnumType.Location,
 			v.EnumType.QualifiedIdentifier,
+			nil,
 			v.EnumType.Fields,
 			v.Fields,
 		)
+	case cadence.Newtype:
+		return importCompositeValue(
+			inter,
+			getLocationRange,
+			common.CompositeKindNewtype,
+			v.NewtypeType.Location,
+			v.NewtypeType.QualifiedIdentifier,
+			nil,
+			v.NewtypeType.Fields,
+			v.Fields,
+		)
 	case cadence.TypeValue:
 		return importTypeValue(
 			inter,
@@ -1070,6 +1220,24 @@ func importWord64(inter *interpreter.Interpreter, v cadence.Word64) interpreter.
 	)
 }
 
+func importWord128(inter *interpreter.Interpreter, v cadence.Word128) interpreter.Word128Value {
+	return interpreter.NewWord128ValueFromBigInt(
+		inter,
+		func() *big.Int {
+			return v.Value
+		},
+	)
+}
+
+func importWord256(inter *interpreter.Interpreter, v cadence.Word256) interpreter.Word256Value {
+	return interpreter.NewWord256ValueFromBigInt(
+		inter,
+		func() *big.Int {
+			return v.Value
+		},
+	)
+}
+
 func importFix64(inter *interpreter.Interpreter, v cadence.Fix64) interpreter.Fix64Value {
 	return interpreter.NewFix64Value(
 		inter,
@@ -1088,6 +1256,50 @@ func importUFix64(inter *interpreter.Interpreter, v cadence.UFix64) interpreter.
 	)
 }
 
+func importFix128(inter *interpreter.Interpreter, v cadence.Fix128) interpreter.Fix128Value {
+	return interpreter.NewFix128Value(
+		inter,
+		func() *big.Int {
+			return v.Value
+		},
+	)
+}
+
+func importUFix128(inter *interpreter.Interpreter, v cadence.UFix128) interpreter.UFix128Value {
+	return interpreter.NewUFix128Value(
+		inter,
+		func() *big.Int {
+			return v.Value
+		},
+	)
+}
+
+func importTimestamp(inter *interpreter.Interpreter, v cadence.Timestamp) (interpreter.TimestampValue, error) {
+	seconds := int64(v)
+	if seconds < sema.TimestampTypeMinSeconds || seconds > sema.TimestampTypeMaxSeconds {
+		return 0, errors.NewDefaultUserError(
+			"cannot import timestamp: %d is out of range",
+			seconds,
+		)
+	}
+
+	return interpreter.NewTimestampValue(
+		inter,
+		func() int64 {
+			return seconds
+		},
+	), nil
+}
+
+func importDuration(inter *interpreter.Interpreter, v cadence.Duration) interpreter.DurationValue {
+	return interpreter.NewDurationValue(
+		inter,
+		func() int64 {
+			return int64(v)
+		},
+	)
+}
+
 func importString(inter *interpreter.Interpreter, v cadence.String) *interpreter.StringValue {
 	memoryUsage := common.NewStringMemoryUsage(len(v))
 	return interpreter.NewStringValue(
@@ -1364,12 +1576,80 @@ func importDictionaryValue(
 	), nil
 }
 
+func importSetValue(
+	inter *interpreter.Interpreter,
+	getLocationRange func() interpreter.LocationRange,
+	v cadence.Set,
+	expectedType sema.Type,
+) (
+	*interpreter.SetValue,
+	error,
+) {
+	elements := make([]interpreter.Value, len(v.Elements))
+
+	var elementType sema.Type
+
+	setType, ok := expectedType.(*sema.SetType)
+	if ok {
+		elementType = setType.ElementType
+	}
+
+	for i, element := range v.Elements {
+		value, err := importValue(
+			inter,
+			getLocationRange,
+			element,
+			elementType,
+		)
+		if err != nil {
+			return nil, err
+		}
+		elements[i] = value
+	}
+
+	var setStaticType interpreter.SetStaticType
+	if setType != nil {
+		setStaticType = interpreter.ConvertSemaSetTypeToStaticSetType(inter, setType)
+	} else {
+		elementTypes := make([]sema.Type, len(elements))
+
+		for i, element := range elements {
+			elementType, err := inter.ConvertStaticToSemaType(element.StaticType(inter))
+			if err != nil {
+				return nil, err
+			}
+			elementTypes[i] = elementType
+		}
+
+		elementSuperType := sema.LeastCommonSuperType(elementTypes...)
+
+		if !sema.IsValidDictionaryKeyType(elementSuperType) {
+			return nil, errors.NewDefaultUserError(
+				"cannot import set: elements does not belong to the same type",
+			)
+		}
+
+		setStaticType = interpreter.NewSetStaticType(
+			inter,
+			interpreter.ConvertSemaToStaticType(inter, elementSuperType),
+		)
+	}
+
+	return interpreter.NewSetValue(
+		inter,
+		getLocationRange,
+		setStaticType,
+		elements...,
+	), nil
+}
+
 func importCompositeValue(
 	inter *interpreter.Interpreter,
 	getLocationRange func() interpreter.LocationRange,
 	kind common.CompositeKind,
 	location Location,
 	qualifiedIdentifier string,
+	typeArguments []cadence.Type,
 	fieldTypes []cadence.Field,
 	fieldValues []cadence.Value,
 ) (
@@ -1384,6 +1664,39 @@ func importCompositeValue(
 		return nil, typeErr
 	}
 
+	// Values of instantiated generic composite types are imported with their type arguments,
+	// and the fields are imported with the types of the instantiation, e.g. `Int` instead of `T`
+
+	var importedTypeArguments []interpreter.StaticType
+
+	if len(typeArguments) > 0 {
+		if len(typeArguments) != len(compositeType.TypeParameters()) {
+			return nil, errors.NewDefaultUserError(
+				"cannot import value of type %s: expected %d type arguments, got %d",
+				qualifiedIdentifier,
+				len(compositeType.TypeParameters()),
+				len(typeArguments),
+			)
+		}
+
+		importedTypeArguments = make([]interpreter.StaticType, len(typeArguments))
+		semaTypeArguments := make([]sema.Type, len(typeArguments))
+
+		for i, typeArgument := range typeArguments {
+			importedTypeArgument := ImportType(inter, typeArgument)
+
+			semaTypeArgument, err := inter.ConvertStaticToSemaType(importedTypeArgument)
+			if err != nil {
+				return nil, err
+			}
+
+			importedTypeArguments[i] = importedTypeArgument
+			semaTypeArguments[i] = semaTypeArgument
+		}
+
+		compositeType = compositeType.Instantiate(semaTypeArguments, nil).(*sema.CompositeType)
+	}
+
 	for i := 0; i < len(fieldTypes) && i < len(fieldValues); i++ {
 		fieldType := fieldTypes[i]
 		fieldValue := fieldValues[i]
@@ -1439,12 +1752,13 @@ func importCompositeValue(
 		}
 	}
 
-	return interpreter.NewCompositeValue(
+	return interpreter.NewCompositeValueWithTypeArguments(
 		inter,
 		getLocationRange,
 		location,
 		qualifiedIdentifier,
 		kind,
+		importedTypeArguments,
 		fields,
 		common.Address{},
 	), nil
@@ -29,6 +29,7 @@ import (
 
 	"github.com/onflow/cadence"
 	"github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/errors"
 	"github.com/onflow/cadence/runtime/interpreter"
@@ -350,6 +351,26 @@ func TestExportValue(t *testing.T) {
 			value:    interpreter.NewUnmeteredWord64Value(42),
 			expected: cadence.NewWord64(42),
 		},
+		{
+			label:    "Word128",
+			value:    interpreter.NewUnmeteredWord128ValueFromUint64(42),
+			expected: cadence.NewWord128(42),
+		},
+		{
+			label:    "Word256",
+			value:    interpreter.NewUnmeteredWord256ValueFromUint64(42),
+			expected: cadence.NewWord256(42),
+		},
+		{
+			label:    "Timestamp",
+			value:    interpreter.NewUnmeteredTimestampValue(1584129163),
+			expected: cadence.NewTimestamp(1584129163),
+		},
+		{
+			label:    "Duration",
+			value:    interpreter.NewUnmeteredDurationValue(86400),
+			expected: cadence.NewDuration(86400),
+		},
 		{
 			label:    "Fix64",
 			value:    interpreter.NewUnmeteredFix64Value(-123000000),
@@ -752,6 +773,31 @@ func TestImportValue(t *testing.T) {
 			value:    cadence.NewWord64(42),
 			expected: interpreter.NewUnmeteredWord64Value(42),
 		},
+		{
+			label:    "Word128",
+			value:    cadence.NewWord128(42),
+			expected: interpreter.NewUnmeteredWord128ValueFromUint64(42),
+		},
+		{
+			label:    "Word256",
+			value:    cadence.NewWord256(42),
+			expected: interpreter.NewUnmeteredWord256ValueFromUint64(42),
+		},
+		{
+			label:    "Timestamp",
+			value:    cadence.NewTimestamp(1584129163),
+			expected: interpreter.NewUnmeteredTimestampValue(1584129163),
+		},
+		{
+			label:    "Timestamp (out of range)",
+			value:    cadence.NewTimestamp(sema.TimestampTypeMaxSeconds + 1),
+			expected: nil,
+		},
+		{
+			label:    "Duration",
+			value:    cadence.NewDuration(86400),
+			expected: interpreter.NewUnmeteredDurationValue(86400),
+		},
 		{
 			label:    "Fix64",
 			value:    cadence.Fix64(-123000000),
@@ -1010,6 +1056,26 @@ func TestImportRuntimeType(t *testing.T) {
 			actual:   cadence.Word64Type{},
 			expected: interpreter.PrimitiveStaticTypeWord64,
 		},
+		{
+			label:    "Word128",
+			actual:   cadence.Word128Type{},
+			expected: interpreter.PrimitiveStaticTypeWord128,
+		},
+		{
+			label:    "Word256",
+			actual:   cadence.Word256Type{},
+			expected: interpreter.PrimitiveStaticTypeWord256,
+		},
+		{
+			label:    "Timestamp",
+			actual:   cadence.TimestampType{},
+			expected: interpreter.PrimitiveStaticTypeTimestamp,
+		},
+		{
+			label:    "Duration",
+			actual:   cadence.DurationType{},
+			expected: interpreter.PrimitiveStaticTypeDuration,
+		},
 		{
 			label:    "Fix64",
 			actual:   cadence.Fix64Type{},
@@ -1200,6 +1266,17 @@ func TestImportRuntimeType(t *testing.T) {
 				QualifiedIdentifier: "S",
 			},
 		},
+		{
+			label: "Newtype",
+			actual: &cadence.NewtypeType{
+				Location:            TestLocation,
+				QualifiedIdentifier: "S",
+			},
+			expected: interpreter.CompositeStaticType{
+				Location:            TestLocation,
+				QualifiedIdentifier: "S",
+			},
+		},
 		{
 			label: "StructInterface",
 			actual: &cadence.StructInterfaceType{
@@ -2253,6 +2330,144 @@ func TestRuntimeEnumValue(t *testing.T) {
 	})
 }
 
+func TestRuntimeNewtypeValue(t *testing.T) {
+
+	t.Parallel()
+
+	newtypeValue := cadence.Newtype{
+		NewtypeType: &cadence.NewtypeType{
+			Location:            TestLocation,
+			QualifiedIdentifier: "PersonID",
+			Fields: []cadence.Field{
+				{
+					Identifier: ast.NewtypeValueFieldName,
+					Type:       cadence.StringType{},
+				},
+			},
+			UnderlyingType: cadence.StringType{},
+		},
+		Fields: []cadence.Value{
+			cadence.String("alice"),
+		},
+	}
+
+	t.Run("test export", func(t *testing.T) {
+		script := `
+            pub fun main(): PersonID {
+                return PersonID("alice")
+            }
+
+            pub newtype PersonID: String
+        `
+
+		actual := exportValueFromScript(t, script)
+		assert.Equal(t, newtypeValue, actual)
+	})
+
+	t.Run("test import", func(t *testing.T) {
+		script := `
+            pub fun main(id: PersonID): PersonID {
+                if !id.isInstance(Type<PersonID>()) {
+                    panic("Not a PersonID value")
+                }
+
+                return id
+            }
+
+            pub newtype PersonID: String
+        `
+
+		actual, err := executeTestScript(t, script, newtypeValue)
+		require.NoError(t, err)
+		assert.Equal(t, newtypeValue, actual)
+	})
+
+	t.Run("test import of underlying value", func(t *testing.T) {
+		script := `
+            pub fun main(id: PersonID): PersonID {
+                return id
+            }
+
+            pub newtype PersonID: String
+        `
+
+		_, err := executeTestScript(t, script, cadence.String("alice"))
+		require.Error(t, err)
+	})
+}
+
+func TestRuntimeHashableValue(t *testing.T) {
+
+	t.Parallel()
+
+	pointType := &cadence.StructType{
+		Location:            TestLocation,
+		QualifiedIdentifier: "Point",
+		Fields: []cadence.Field{
+			{
+				Identifier: "x",
+				Type:       cadence.IntType{},
+			},
+			{
+				Identifier: "y",
+				Type:       cadence.IntType{},
+			},
+		},
+	}
+
+	dictionaryValue := cadence.NewDictionary([]cadence.KeyValuePair{
+		{
+			Key: cadence.NewStruct([]cadence.Value{
+				cadence.NewInt(1),
+				cadence.NewInt(2),
+			}).WithType(pointType),
+			Value: cadence.String("a"),
+		},
+	}).WithType(cadence.DictionaryType{
+		KeyType:     pointType,
+		ElementType: cadence.StringType{},
+	})
+
+	const pointDeclaration = `
+        pub struct Point: Hashable {
+            pub let x: Int
+            pub let y: Int
+
+            init(x: Int, y: Int) {
+                self.x = x
+                self.y = y
+            }
+        }
+    `
+
+	t.Run("test export", func(t *testing.T) {
+		script := `
+            pub fun main(): {Point: String} {
+                return {Point(x: 1, y: 2): "a"}
+            }
+        ` + pointDeclaration
+
+		actual := exportValueFromScript(t, script)
+		assert.Equal(t, dictionaryValue, actual)
+	})
+
+	t.Run("test import", func(t *testing.T) {
+		script := `
+            pub fun main(names: {Point: String}): {Point: String} {
+                if names[Point(x: 1, y: 2)] != "a" {
+                    panic("missing key")
+                }
+
+                return names
+            }
+        ` + pointDeclaration
+
+		actual, err := executeTestScript(t, script, dictionaryValue)
+		require.NoError(t, err)
+		assert.Equal(t, dictionaryValue, actual)
+	})
+}
+
 func executeTestScript(t *testing.T, script string, arg cadence.Value) (cadence.Value, error) {
 	rt := newTestInterpreterRuntime()
 
@@ -2443,6 +2658,26 @@ func TestRuntimeArgumentPassing(t *testing.T) {
 			typeSignature: "Word64",
 			exportedValue: cadence.NewWord64(42),
 		},
+		{
+			label:         "Word128",
+			typeSignature: "Word128",
+			exportedValue: cadence.NewWord128(42),
+		},
+		{
+			label:         "Word256",
+			typeSignature: "Word256",
+			exportedValue: cadence.NewWord256(42),
+		},
+		{
+			label:         "Timestamp",
+			typeSignature: "Timestamp",
+			exportedValue: cadence.NewTimestamp(1584129163),
+		},
+		{
+			label:         "Duration",
+			typeSignature: "Duration",
+			exportedValue: cadence.NewDuration(86400),
+		},
 		{
 			label:         "Fix64",
 			typeSignature: "Fix64",
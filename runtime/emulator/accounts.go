@@ -0,0 +1,376 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package emulator
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/stdlib/rlp"
+)
+
+type accountKey struct {
+	runtime.AccountKey
+	// encoded is the encoded key, if the key was added using AddEncodedAccountKey
+	encoded []byte
+}
+
+type account struct {
+	keys      []accountKey
+	contracts map[string][]byte
+	balance   uint64
+}
+
+func newAccount() *account {
+	return &account{
+		contracts: map[string][]byte{},
+	}
+}
+
+func (a *account) clone() *account {
+	keys := make([]accountKey, len(a.keys))
+	copy(keys, a.keys)
+
+	contracts := make(map[string][]byte, len(a.contracts))
+	// NOTE: the order of the iteration is irrelevant, the contracts are copied
+	for name, code := range a.contracts { //nolint:maprangecheck
+		contracts[name] = code
+	}
+
+	return &account{
+		keys:      keys,
+		contracts: contracts,
+		balance:   a.balance,
+	}
+}
+
+func (a *account) addKey(key accountKey) *runtime.AccountKey {
+	key.KeyIndex = len(a.keys)
+	a.keys = append(a.keys, key)
+	return a.key(key.KeyIndex)
+}
+
+// key returns a copy of the key with the given index, or nil if it does not exist
+//
+func (a *account) key(index int) *runtime.AccountKey {
+	if index < 0 || index >= len(a.keys) {
+		return nil
+	}
+	key := a.keys[index].AccountKey
+	return &key
+}
+
+// AccountDoesNotExistError is returned when an operation refers to an account which was not created
+//
+type AccountDoesNotExistError struct {
+	Address common.Address
+}
+
+func (e AccountDoesNotExistError) Error() string {
+	return fmt.Sprintf("account %s does not exist", e.Address)
+}
+
+// AccountKeyDoesNotExistError is returned when an operation refers to a key which was not added
+//
+type AccountKeyDoesNotExistError struct {
+	Address  common.Address
+	KeyIndex int
+}
+
+func (e AccountKeyDoesNotExistError) Error() string {
+	return fmt.Sprintf("key %d of account %s does not exist", e.KeyIndex, e.Address)
+}
+
+func (e *Emulator) account(address common.Address) (*account, error) {
+	account, ok := e.state.accounts[address]
+	if !ok {
+		return nil, AccountDoesNotExistError{
+			Address: address,
+		}
+	}
+	return account, nil
+}
+
+// mutableAccount returns the account with the given address, so it can be changed
+//
+func (e *Emulator) mutableAccount(address common.Address) (*account, error) {
+	account, ok := e.state.mutableAccount(address)
+	if !ok {
+		return nil, AccountDoesNotExistError{
+			Address: address,
+		}
+	}
+	return account, nil
+}
+
+// AccountExists returns true if an account with the given address was created
+//
+func (e *Emulator) AccountExists(address common.Address) bool {
+	_, ok := e.state.accounts[address]
+	return ok
+}
+
+// SetAccountBalance sets the balance of the given account,
+// which is returned by GetAccountBalance and GetAccountAvailableBalance.
+//
+// The emulator does not deploy a fungible token contract,
+// so the balance is not affected by transactions.
+//
+func (e *Emulator) SetAccountBalance(address common.Address, balance uint64) error {
+	account, err := e.mutableAccount(address)
+	if err != nil {
+		return err
+	}
+	account.balance = balance
+	return nil
+}
+
+// CreateAccount creates a new account.
+//
+// Addresses are assigned sequentially, starting at 0x1.
+// The payer is not charged.
+//
+func (e *Emulator) CreateAccount(_ runtime.Address) (runtime.Address, error) {
+	e.state.addressCounter++
+
+	address, err := common.BytesToAddress(
+		new(big.Int).SetUint64(e.state.addressCounter).Bytes(),
+	)
+	if err != nil {
+		return runtime.Address{}, err
+	}
+
+	e.state.createAccount(address, newAccount())
+
+	return address, nil
+}
+
+func (e *Emulator) AddEncodedAccountKey(address runtime.Address, encodedPublicKey []byte) error {
+	account, err := e.mutableAccount(address)
+	if err != nil {
+		return err
+	}
+
+	key, err := decodeAccountKey(encodedPublicKey)
+	if err != nil {
+		return err
+	}
+
+	account.addKey(accountKey{
+		AccountKey: *key,
+		encoded:    encodedPublicKey,
+	})
+
+	return nil
+}
+
+func (e *Emulator) RevokeEncodedAccountKey(address runtime.Address, index int) ([]byte, error) {
+	account, err := e.mutableAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	if index < 0 || index >= len(account.keys) {
+		return nil, AccountKeyDoesNotExistError{
+			Address:  address,
+			KeyIndex: index,
+		}
+	}
+
+	key := &account.keys[index]
+	key.IsRevoked = true
+
+	return key.encoded, nil
+}
+
+func (e *Emulator) AddAccountKey(
+	address runtime.Address,
+	publicKey *runtime.PublicKey,
+	hashAlgo runtime.HashAlgorithm,
+	weight int,
+) (*runtime.AccountKey, error) {
+	account, err := e.mutableAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return account.addKey(accountKey{
+		AccountKey: runtime.AccountKey{
+			PublicKey: publicKey,
+			HashAlgo:  hashAlgo,
+			Weight:    weight,
+		},
+	}), nil
+}
+
+func (e *Emulator) GetAccountKey(address runtime.Address, index int) (*runtime.AccountKey, error) {
+	account, err := e.account(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return account.key(index), nil
+}
+
+func (e *Emulator) RevokeAccountKey(address runtime.Address, index int) (*runtime.AccountKey, error) {
+	account, err := e.mutableAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	if index < 0 || index >= len(account.keys) {
+		return nil, nil
+	}
+
+	account.keys[index].IsRevoked = true
+
+	return account.key(index), nil
+}
+
+func (e *Emulator) UpdateAccountContractCode(address runtime.Address, name string, code []byte) error {
+	account, err := e.mutableAccount(address)
+	if err != nil {
+		return err
+	}
+
+	account.contracts[name] = code
+
+	// The programs checked against the previous code must not be used anymore
+	e.clearPrograms()
+
+	return nil
+}
+
+func (e *Emulator) GetAccountContractCode(address runtime.Address, name string) ([]byte, error) {
+	account, ok := e.state.accounts[address]
+	if !ok {
+		return nil, nil
+	}
+
+	return account.contracts[name], nil
+}
+
+func (e *Emulator) RemoveAccountContractCode(address runtime.Address, name string) error {
+	account, err := e.mutableAccount(address)
+	if err != nil {
+		return err
+	}
+
+	delete(account.contracts, name)
+	e.clearPrograms()
+
+	return nil
+}
+
+func (e *Emulator) GetAccountContractNames(address runtime.Address) ([]string, error) {
+	account, ok := e.state.accounts[address]
+	if !ok {
+		return nil, nil
+	}
+
+	names := make([]string, 0, len(account.contracts))
+	// NOTE: the order of the iteration is irrelevant, the names are sorted
+	for name := range account.contracts { //nolint:maprangecheck
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
+
+func (e *Emulator) GetAccountBalance(address common.Address) (uint64, error) {
+	account, err := e.account(address)
+	if err != nil {
+		return 0, err
+	}
+
+	return account.balance, nil
+}
+
+func (e *Emulator) GetAccountAvailableBalance(address common.Address) (uint64, error) {
+	return e.GetAccountBalance(address)
+}
+
+func (e *Emulator) GetStorageUsed(address runtime.Address) (uint64, error) {
+	return e.state.ledger.storageUsed(address), nil
+}
+
+func (e *Emulator) GetStorageCapacity(_ runtime.Address) (uint64, error) {
+	return e.storageCapacity, nil
+}
+
+// decodeAccountKey decodes an account key in the encoding used by Flow,
+// an RLP list of the public key, the signature algorithm, the hash algorithm, and the weight
+//
+func decodeAccountKey(encoded []byte) (*runtime.AccountKey, error) {
+	items, _, err := rlp.DecodeList(encoded, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(items) != 4 {
+		return nil, fmt.Errorf("invalid encoded account key: expected 4 items, got %d", len(items))
+	}
+
+	publicKey, _, err := rlp.DecodeString(items[0], 0)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]uint64, 3)
+	for i, item := range items[1:] {
+		value, _, err := rlp.DecodeString(item, 0)
+		if err != nil {
+			return nil, err
+		}
+		if len(value) > 8 {
+			return nil, fmt.Errorf("invalid encoded account key: integer too large")
+		}
+		fields[i] = new(big.Int).SetBytes(value).Uint64()
+	}
+
+	signatureAlgorithm, ok := flowSignatureAlgorithms[fields[0]]
+	if !ok {
+		return nil, fmt.Errorf("invalid encoded account key: unknown signature algorithm %d", fields[0])
+	}
+
+	// Flow's hash algorithms have the same values as Cadence's
+	hashAlgorithm := sema.HashAlgorithm(fields[1])
+
+	return &runtime.AccountKey{
+		PublicKey: &runtime.PublicKey{
+			PublicKey: publicKey,
+			SignAlgo:  signatureAlgorithm,
+		},
+		HashAlgo: hashAlgorithm,
+		Weight:   int(fields[2]),
+	}, nil
+}
+
+// flowSignatureAlgorithms maps Flow's signature algorithm values to Cadence's
+//
+var flowSignatureAlgorithms = map[uint64]sema.SignatureAlgorithm{
+	1: sema.SignatureAlgorithmBLS_BLS12_381,
+	2: sema.SignatureAlgorithmECDSA_P256,
+	3: sema.SignatureAlgorithmECDSA_secp256k1,
+}
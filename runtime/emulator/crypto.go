@@ -0,0 +1,202 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package emulator
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"hash"
+	"math/big"
+
+	"golang.org/x/crypto/sha3"
+
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+// The emulator implements the cryptographic operations which are available in the Go standard library
+// and the Go crypto module: Hashing with SHA2, SHA3, and Keccak, and ECDSA on the NIST P-256 curve.
+//
+// Hashing uses the same domain separation tags as Flow,
+// so digests and signatures are compatible with Flow.
+
+// UnsupportedAlgorithmError is returned for cryptographic operations which the emulator does not implement
+//
+type UnsupportedAlgorithmError struct {
+	Algorithm string
+}
+
+func (e UnsupportedAlgorithmError) Error() string {
+	return fmt.Sprintf("algorithm is not supported by the emulator: %s", e.Algorithm)
+}
+
+// domainTagLength is the length of the domain separation tag prefix of hashed data
+//
+const domainTagLength = 32
+
+func newHasher(hashAlgorithm runtime.HashAlgorithm) (hash.Hash, error) {
+	switch hashAlgorithm {
+	case sema.HashAlgorithmSHA2_256:
+		return sha256.New(), nil
+	case sema.HashAlgorithmSHA2_384:
+		return sha512.New384(), nil
+	case sema.HashAlgorithmSHA3_256:
+		return sha3.New256(), nil
+	case sema.HashAlgorithmSHA3_384:
+		return sha3.New384(), nil
+	case sema.HashAlgorithmKECCAK_256:
+		return sha3.NewLegacyKeccak256(), nil
+	default:
+		return nil, UnsupportedAlgorithmError{
+			Algorithm: hashAlgorithm.Name(),
+		}
+	}
+}
+
+// hashWithTag hashes the given data.
+// If the tag is not empty, the data is prefixed with the tag, padded with zeros
+//
+func hashWithTag(data []byte, tag string, hashAlgorithm runtime.HashAlgorithm) ([]byte, error) {
+	hasher, err := newHasher(hashAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	if tag != "" {
+		if len(tag) > domainTagLength {
+			return nil, fmt.Errorf(
+				"domain tag cannot be longer than %d characters, got %s",
+				domainTagLength,
+				tag,
+			)
+		}
+
+		var paddedTag [domainTagLength]byte
+		copy(paddedTag[:], tag)
+		hasher.Write(paddedTag[:])
+	}
+
+	hasher.Write(data)
+
+	return hasher.Sum(nil), nil
+}
+
+func (e *Emulator) Hash(data []byte, tag string, hashAlgorithm runtime.HashAlgorithm) ([]byte, error) {
+	return hashWithTag(data, tag, hashAlgorithm)
+}
+
+// decodeP256PublicKey decodes an ECDSA P-256 public key,
+// the concatenation of the X and Y coordinates of the point
+//
+func decodeP256PublicKey(publicKey []byte) (*ecdsa.PublicKey, error) {
+	curve := elliptic.P256()
+
+	coordinateLength := (curve.Params().BitSize + 7) / 8
+	if len(publicKey) != 2*coordinateLength {
+		return nil, fmt.Errorf(
+			"invalid public key: expected %d bytes, got %d",
+			2*coordinateLength,
+			len(publicKey),
+		)
+	}
+
+	x := new(big.Int).SetBytes(publicKey[:coordinateLength])
+	y := new(big.Int).SetBytes(publicKey[coordinateLength:])
+
+	if !curve.IsOnCurve(x, y) {
+		return nil, fmt.Errorf("invalid public key: point is not on the curve")
+	}
+
+	return &ecdsa.PublicKey{
+		Curve: curve,
+		X:     x,
+		Y:     y,
+	}, nil
+}
+
+func (e *Emulator) ValidatePublicKey(key *runtime.PublicKey) error {
+	switch key.SignAlgo {
+	case sema.SignatureAlgorithmECDSA_P256:
+		_, err := decodeP256PublicKey(key.PublicKey)
+		return err
+
+	default:
+		return UnsupportedAlgorithmError{
+			Algorithm: key.SignAlgo.Name(),
+		}
+	}
+}
+
+func (e *Emulator) VerifySignature(
+	signature []byte,
+	tag string,
+	signedData []byte,
+	publicKey []byte,
+	signatureAlgorithm runtime.SignatureAlgorithm,
+	hashAlgorithm runtime.HashAlgorithm,
+) (bool, error) {
+	switch signatureAlgorithm {
+	case sema.SignatureAlgorithmECDSA_P256:
+		key, err := decodeP256PublicKey(publicKey)
+		if err != nil {
+			return false, err
+		}
+
+		digest, err := hashWithTag(signedData, tag, hashAlgorithm)
+		if err != nil {
+			return false, err
+		}
+
+		// The signature is the concatenation of r and s
+		if len(signature) != len(publicKey) {
+			return false, nil
+		}
+		half := len(signature) / 2
+		r := new(big.Int).SetBytes(signature[:half])
+		s := new(big.Int).SetBytes(signature[half:])
+
+		return ecdsa.Verify(key, digest, r, s), nil
+
+	default:
+		return false, UnsupportedAlgorithmError{
+			Algorithm: signatureAlgorithm.Name(),
+		}
+	}
+}
+
+func (e *Emulator) BLSVerifyPOP(_ *runtime.PublicKey, _ []byte) (bool, error) {
+	return false, UnsupportedAlgorithmError{
+		Algorithm: sema.SignatureAlgorithmBLS_BLS12_381.Name(),
+	}
+}
+
+func (e *Emulator) BLSAggregateSignatures(_ [][]byte) ([]byte, error) {
+	return nil, UnsupportedAlgorithmError{
+		Algorithm: sema.SignatureAlgorithmBLS_BLS12_381.Name(),
+	}
+}
+
+func (e *Emulator) BLSAggregatePublicKeys(_ []*runtime.PublicKey) (*runtime.PublicKey, error) {
+	return nil, UnsupportedAlgorithmError{
+		Algorithm: sema.SignatureAlgorithmBLS_BLS12_381.Name(),
+	}
+}
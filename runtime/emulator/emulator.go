@@ -0,0 +1,520 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package emulator
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/onflow/atree"
+	"go.opentelemetry.io/otel/attribute"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+// Emulator is an in-memory implementation of runtime.Interface.
+//
+// It keeps accounts, their keys, contracts and storage in memory,
+// and can execute transactions and scripts end-to-end,
+// for example in tests of applications which embed the Cadence runtime.
+//
+// Transactions are atomic: If a transaction fails,
+// all changes it made, including the emitted events, are discarded.
+//
+// All behaviour is deterministic: Account addresses, UUIDs, blocks,
+// and random numbers are derived from counters.
+//
+type Emulator struct {
+	runtime         runtime.Runtime
+	state           *state
+	programs        map[common.Location]*interpreter.Program
+	codes           map[common.Location][]byte
+	blocks          []runtime.Block
+	storageCapacity uint64
+	signers         []runtime.Address
+	events          []cadence.Event
+	logs            []string
+	locationCounter uint64
+}
+
+// state is the part of the emulator's state which is reverted when a transaction fails.
+//
+// Changes can be journaled: After a checkpoint, the state before the changes is recorded,
+// so the changes can be reverted without copying the whole state.
+// Accounts are copied on write, i.e. only when they are first changed after the checkpoint.
+//
+type state struct {
+	ledger         *ledger
+	accounts       map[common.Address]*account
+	addressCounter uint64
+	uuid           uint64
+	random         uint64
+	journal        *stateJournal
+}
+
+// stateJournal records the state at the time of a checkpoint.
+// Only the accounts changed since the checkpoint are recorded,
+// accounts created since the checkpoint are recorded as nil
+//
+type stateJournal struct {
+	accounts       map[common.Address]*account
+	addressCounter uint64
+	uuid           uint64
+	random         uint64
+}
+
+// checkpoint starts journaling the changes to the state
+//
+func (s *state) checkpoint() {
+	s.journal = &stateJournal{
+		accounts:       map[common.Address]*account{},
+		addressCounter: s.addressCounter,
+		uuid:           s.uuid,
+		random:         s.random,
+	}
+	s.ledger.checkpoint()
+}
+
+// commit keeps the changes since the checkpoint, and stops journaling
+//
+func (s *state) commit() {
+	s.journal = nil
+	s.ledger.commit()
+}
+
+// revert discards the changes since the checkpoint, and stops journaling
+//
+func (s *state) revert() {
+	journal := s.journal
+	s.journal = nil
+	s.ledger.revert()
+
+	if journal == nil {
+		return
+	}
+
+	// NOTE: the order of the iteration is irrelevant,
+	// each account is restored independently
+	for address, account := range journal.accounts { //nolint:maprangecheck
+		if account == nil {
+			delete(s.accounts, address)
+		} else {
+			s.accounts[address] = account
+		}
+	}
+
+	s.addressCounter = journal.addressCounter
+	s.uuid = journal.uuid
+	s.random = journal.random
+}
+
+// mutableAccount returns the account with the given address, so it can be changed.
+// If the changes are journaled, the account is copied when it is first changed after the checkpoint
+//
+func (s *state) mutableAccount(address common.Address) (*account, bool) {
+	account, ok := s.accounts[address]
+	if !ok {
+		return nil, false
+	}
+
+	if s.journal != nil {
+		if _, ok := s.journal.accounts[address]; !ok {
+			s.journal.accounts[address] = account
+			account = account.clone()
+			s.accounts[address] = account
+		}
+	}
+
+	return account, true
+}
+
+// createAccount adds the given account with the given address
+//
+func (s *state) createAccount(address common.Address, account *account) {
+	if s.journal != nil {
+		if _, ok := s.journal.accounts[address]; !ok {
+			s.journal.accounts[address] = s.accounts[address]
+		}
+	}
+
+	s.accounts[address] = account
+}
+
+var _ runtime.Interface = &Emulator{}
+
+// DefaultStorageCapacity is the storage capacity of each account, in bytes
+//
+const DefaultStorageCapacity = 100 * 1024 * 1024
+
+type Option func(*Emulator)
+
+// WithRuntime returns an emulator option which sets the runtime
+// used to execute transactions and scripts.
+//
+func WithRuntime(runtime runtime.Runtime) Option {
+	return func(emulator *Emulator) {
+		emulator.runtime = runtime
+	}
+}
+
+// WithStorageCapacity returns an emulator option which sets
+// the storage capacity of each account, in bytes.
+//
+func WithStorageCapacity(capacity uint64) Option {
+	return func(emulator *Emulator) {
+		emulator.storageCapacity = capacity
+	}
+}
+
+// NewEmulator returns a new emulator with no accounts,
+// and a genesis block at height 0.
+//
+func NewEmulator(options ...Option) *Emulator {
+	emulator := &Emulator{
+		state: &state{
+			ledger:   newLedger(),
+			accounts: map[common.Address]*account{},
+		},
+		programs:        map[common.Location]*interpreter.Program{},
+		codes:           map[common.Location][]byte{},
+		blocks:          []runtime.Block{newBlock(0, time.Unix(0, 0))},
+		storageCapacity: DefaultStorageCapacity,
+	}
+
+	for _, option := range options {
+		option(emulator)
+	}
+
+	if emulator.runtime == nil {
+		emulator.runtime = runtime.NewInterpreterRuntime()
+	}
+
+	return emulator
+}
+
+// ExecuteTransaction executes the given transaction, signed by the given accounts.
+//
+// If the transaction fails, all changes are reverted, and no events are reported.
+// The events and logs of the transaction are available through Events and Logs.
+//
+func (e *Emulator) ExecuteTransaction(script runtime.Script, signers ...runtime.Address) error {
+	e.state.checkpoint()
+
+	e.signers = signers
+	e.events = nil
+	e.logs = nil
+
+	defer func() {
+		e.signers = nil
+	}()
+
+	err := e.runtime.ExecuteTransaction(
+		script,
+		runtime.Context{
+			Interface: e,
+			Location:  common.TransactionLocation(e.nextLocation()),
+		},
+	)
+	if err != nil {
+		e.revert()
+		e.events = nil
+		return err
+	}
+
+	e.state.commit()
+
+	return nil
+}
+
+// ExecuteScript executes the given script and returns its result.
+//
+// Scripts cannot change the state of the emulator,
+// any changes are reverted.
+//
+func (e *Emulator) ExecuteScript(script runtime.Script) (cadence.Value, error) {
+	e.state.checkpoint()
+	defer e.revert()
+
+	e.events = nil
+	e.logs = nil
+
+	return e.runtime.ExecuteScript(
+		script,
+		runtime.Context{
+			Interface: e,
+			Location:  common.ScriptLocation(e.nextLocation()),
+		},
+	)
+}
+
+// revert discards the changes to the state since the last checkpoint.
+// All programs are discarded, as they might have been checked against discarded code.
+//
+func (e *Emulator) revert() {
+	e.state.revert()
+	e.clearPrograms()
+}
+
+// clearPrograms discards all cached programs.
+//
+// NOTE: Programs are checked against the programs they import,
+// so when the code of a location changes, the programs of all locations
+// which import it, directly or indirectly, must not be used anymore either
+//
+func (e *Emulator) clearPrograms() {
+	e.programs = map[common.Location]*interpreter.Program{}
+}
+
+// nextLocation returns a unique identifier for the location of a transaction or script
+//
+func (e *Emulator) nextLocation() (location [32]byte) {
+	e.locationCounter++
+	binary.BigEndian.PutUint64(location[len(location)-8:], e.locationCounter)
+	return
+}
+
+// DeployContract deploys the contract with the given name and code to the given account.
+//
+func (e *Emulator) DeployContract(address runtime.Address, name string, code []byte) error {
+	return e.ExecuteTransaction(
+		runtime.Script{
+			Source: []byte(fmt.Sprintf(
+				`
+                  transaction {
+                      prepare(signer: AuthAccount) {
+                          signer.contracts.add(name: "%s", code: "%s".decodeHex())
+                      }
+                  }
+                `,
+				name,
+				hex.EncodeToString(code),
+			)),
+		},
+		address,
+	)
+}
+
+// SetCode sets the code for the given location,
+// e.g. for imports of string or identifier locations.
+// The code of contracts deployed to accounts is managed by the accounts.
+//
+func (e *Emulator) SetCode(location common.Location, code []byte) {
+	e.codes[location] = code
+	e.clearPrograms()
+}
+
+// Events returns the events emitted by the last executed transaction or script
+//
+func (e *Emulator) Events() []cadence.Event {
+	return e.events
+}
+
+// Logs returns the messages logged by the last executed transaction or script
+//
+func (e *Emulator) Logs() []string {
+	return e.logs
+}
+
+func newBlock(height uint64, timestamp time.Time) runtime.Block {
+	var encodedHeight [8]byte
+	binary.BigEndian.PutUint64(encodedHeight[:], height)
+
+	return runtime.Block{
+		Height:    height,
+		View:      height,
+		Hash:      sha3.Sum256(encodedHeight[:]),
+		Timestamp: timestamp.UnixNano(),
+	}
+}
+
+// CommitBlock adds a new block, one second after the current block
+//
+func (e *Emulator) CommitBlock() runtime.Block {
+	current := e.blocks[len(e.blocks)-1]
+	timestamp := time.Unix(0, current.Timestamp).Add(time.Second)
+	return e.CommitBlockWithTimestamp(timestamp)
+}
+
+// CommitBlockWithTimestamp adds a new block with the given timestamp
+//
+func (e *Emulator) CommitBlockWithTimestamp(timestamp time.Time) runtime.Block {
+	block := newBlock(uint64(len(e.blocks)), timestamp)
+	e.blocks = append(e.blocks, block)
+	return block
+}
+
+func (e *Emulator) GetCurrentBlockHeight() (uint64, error) {
+	return uint64(len(e.blocks) - 1), nil
+}
+
+func (e *Emulator) GetBlockAtHeight(height uint64) (block runtime.Block, exists bool, err error) {
+	if height >= uint64(len(e.blocks)) {
+		return runtime.Block{}, false, nil
+	}
+	return e.blocks[height], true, nil
+}
+
+// UnsafeRandom returns pseudo-random numbers generated by SplitMix64.
+// The state of the generator is part of the state of the emulator,
+// so the numbers generated by a failed transaction are generated again
+//
+func (e *Emulator) UnsafeRandom() (uint64, error) {
+	e.state.random += 0x9e3779b97f4a7c15
+
+	z := e.state.random
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31), nil
+}
+
+func (e *Emulator) ResolveLocation(identifiers []runtime.Identifier, location runtime.Location) ([]runtime.ResolvedLocation, error) {
+	addressLocation, ok := location.(common.AddressLocation)
+
+	// If the location is not an address location, e.g. an identifier location (`import Crypto`),
+	// then return a single resolved location which declares all identifiers.
+
+	if !ok {
+		return []runtime.ResolvedLocation{
+			{
+				Location:    location,
+				Identifiers: identifiers,
+			},
+		}, nil
+	}
+
+	// If no specific identifiers were requested in the import statement,
+	// then import all contracts of the account
+
+	if len(identifiers) == 0 {
+		names, err := e.GetAccountContractNames(addressLocation.Address)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, name := range names {
+			identifiers = append(identifiers, runtime.Identifier{
+				Identifier: name,
+			})
+		}
+	}
+
+	// Return one resolved location per identifier,
+	// each resolved location is an address contract location
+
+	resolvedLocations := make([]runtime.ResolvedLocation, 0, len(identifiers))
+	for _, identifier := range identifiers {
+		resolvedLocations = append(resolvedLocations, runtime.ResolvedLocation{
+			Location: common.AddressLocation{
+				Address: addressLocation.Address,
+				Name:    identifier.Identifier,
+			},
+			Identifiers: []ast.Identifier{identifier},
+		})
+	}
+
+	return resolvedLocations, nil
+}
+
+func (e *Emulator) GetCode(location runtime.Location) ([]byte, error) {
+	return e.codes[location], nil
+}
+
+func (e *Emulator) GetProgram(location runtime.Location) (*interpreter.Program, error) {
+	return e.programs[location], nil
+}
+
+func (e *Emulator) SetProgram(location runtime.Location, program *interpreter.Program) error {
+	e.programs[location] = program
+	return nil
+}
+
+func (e *Emulator) GetValue(owner, key []byte) ([]byte, error) {
+	return e.state.ledger.GetValue(owner, key)
+}
+
+func (e *Emulator) SetValue(owner, key, value []byte) error {
+	return e.state.ledger.SetValue(owner, key, value)
+}
+
+func (e *Emulator) ValueExists(owner, key []byte) (bool, error) {
+	return e.state.ledger.ValueExists(owner, key)
+}
+
+func (e *Emulator) AllocateStorageIndex(owner []byte) (atree.StorageIndex, error) {
+	return e.state.ledger.AllocateStorageIndex(owner)
+}
+
+func (e *Emulator) GetSigningAccounts() ([]runtime.Address, error) {
+	return e.signers, nil
+}
+
+func (e *Emulator) ProgramLog(message string) error {
+	e.logs = append(e.logs, message)
+	return nil
+}
+
+func (e *Emulator) EmitEvent(event cadence.Event) error {
+	e.events = append(e.events, event)
+	return nil
+}
+
+// GenerateUUID returns sequential UUIDs, starting at 0
+//
+func (e *Emulator) GenerateUUID() (uint64, error) {
+	uuid := e.state.uuid
+	e.state.uuid++
+	return uuid, nil
+}
+
+func (e *Emulator) DecodeArgument(argument []byte, _ cadence.Type) (cadence.Value, error) {
+	return jsoncdc.Decode(nil, argument)
+}
+
+func (e *Emulator) MeterComputation(_ common.ComputationKind, _ uint) error {
+	return nil
+}
+
+func (e *Emulator) MeterMemory(_ common.MemoryUsage) error {
+	return nil
+}
+
+func (e *Emulator) ImplementationDebugLog(_ string) error {
+	return nil
+}
+
+func (e *Emulator) RecordTrace(_ string, _ common.Location, _ time.Duration, _ []attribute.KeyValue) {
+	// NO-OP
+}
+
+func (e *Emulator) ResourceOwnerChanged(
+	_ *interpreter.Interpreter,
+	_ *interpreter.CompositeValue,
+	_ common.Address,
+	_ common.Address,
+) {
+	// NO-OP
+}
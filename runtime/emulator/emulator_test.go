@@ -0,0 +1,816 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package emulator
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func newTestAccount(t *testing.T, emulator *Emulator) common.Address {
+	address, err := emulator.CreateAccount(common.Address{})
+	require.NoError(t, err)
+	return address
+}
+
+func encodeArguments(t *testing.T, values ...cadence.Value) [][]byte {
+	arguments := make([][]byte, 0, len(values))
+	for _, value := range values {
+		argument, err := jsoncdc.Encode(value)
+		require.NoError(t, err)
+		arguments = append(arguments, argument)
+	}
+	return arguments
+}
+
+func TestEmulatorCreateAccount(t *testing.T) {
+
+	t.Parallel()
+
+	emulator := NewEmulator()
+
+	first := newTestAccount(t, emulator)
+	second := newTestAccount(t, emulator)
+
+	assert.Equal(t, common.MustBytesToAddress([]byte{0x1}), first)
+	assert.Equal(t, common.MustBytesToAddress([]byte{0x2}), second)
+
+	assert.True(t, emulator.AccountExists(first))
+	assert.False(t, emulator.AccountExists(common.MustBytesToAddress([]byte{0x3})))
+
+	// Accounts can also be created by transactions
+
+	err := emulator.ExecuteTransaction(
+		runtime.Script{
+			Source: []byte(`
+              transaction {
+                  prepare(signer: AuthAccount) {
+                      let account = AuthAccount(payer: signer)
+                      log(account.address)
+                  }
+              }
+            `),
+		},
+		first,
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"0x0000000000000003"}, emulator.Logs())
+	assert.True(t, emulator.AccountExists(common.MustBytesToAddress([]byte{0x3})))
+}
+
+func TestEmulatorStorage(t *testing.T) {
+
+	t.Parallel()
+
+	emulator := NewEmulator()
+
+	address := newTestAccount(t, emulator)
+
+	err := emulator.ExecuteTransaction(
+		runtime.Script{
+			Source: []byte(`
+              transaction(value: Int) {
+                  prepare(signer: AuthAccount) {
+                      signer.save([value], to: /storage/values)
+                  }
+              }
+            `),
+			Arguments: encodeArguments(t, cadence.NewInt(42)),
+		},
+		address,
+	)
+	require.NoError(t, err)
+
+	storageUsed, err := emulator.GetStorageUsed(address)
+	require.NoError(t, err)
+	assert.NotZero(t, storageUsed)
+
+	result, err := emulator.ExecuteScript(
+		runtime.Script{
+			Source: []byte(`
+              pub fun main(address: Address): Int {
+                  return getAuthAccount(address).borrow<&[Int]>(from: /storage/values)![0]
+              }
+            `),
+			Arguments: encodeArguments(t, cadence.NewAddress(address)),
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, cadence.NewInt(42), result)
+
+	// Changes made by scripts are discarded
+
+	_, err = emulator.ExecuteScript(
+		runtime.Script{
+			Source: []byte(`
+              pub fun main(address: Address) {
+                  destroy getAuthAccount(address).load<@AnyResource>(from: /storage/values)
+              }
+            `),
+			Arguments: encodeArguments(t, cadence.NewAddress(address)),
+		},
+	)
+	require.Error(t, err)
+
+	_, err = emulator.ExecuteScript(
+		runtime.Script{
+			Source: []byte(`
+              pub fun main(address: Address) {
+                  getAuthAccount(address).load<[Int]>(from: /storage/values)
+              }
+            `),
+			Arguments: encodeArguments(t, cadence.NewAddress(address)),
+		},
+	)
+	require.NoError(t, err)
+
+	result, err = emulator.ExecuteScript(
+		runtime.Script{
+			Source: []byte(`
+              pub fun main(address: Address): Bool {
+                  return getAuthAccount(address).type(at: /storage/values) != nil
+              }
+            `),
+			Arguments: encodeArguments(t, cadence.NewAddress(address)),
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, cadence.NewBool(true), result)
+}
+
+const testContract = `
+  pub contract Counter {
+
+      pub event Incremented(count: Int)
+
+      pub var count: Int
+
+      pub fun increment() {
+          self.count = self.count + 1
+          emit Incremented(count: self.count)
+      }
+
+      init() {
+          self.count = 0
+      }
+  }
+`
+
+func TestEmulatorContracts(t *testing.T) {
+
+	t.Parallel()
+
+	emulator := NewEmulator()
+
+	address := newTestAccount(t, emulator)
+
+	err := emulator.DeployContract(address, "Counter", []byte(testContract))
+	require.NoError(t, err)
+
+	names, err := emulator.GetAccountContractNames(address)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Counter"}, names)
+
+	increment := runtime.Script{
+		Source: []byte(`
+          import Counter from 0x1
+
+          transaction {
+              execute {
+                  Counter.increment()
+              }
+          }
+        `),
+	}
+
+	err = emulator.ExecuteTransaction(increment)
+	require.NoError(t, err)
+
+	require.Len(t, emulator.Events(), 1)
+	assert.Equal(t,
+		"A.0000000000000001.Counter.Incremented",
+		emulator.Events()[0].EventType.ID(),
+	)
+	assert.Equal(t,
+		[]cadence.Value{cadence.NewInt(1)},
+		emulator.Events()[0].Fields,
+	)
+
+	getCount := runtime.Script{
+		Source: []byte(`
+          import Counter from 0x1
+
+          pub fun main(): Int {
+              return Counter.count
+          }
+        `),
+	}
+
+	result, err := emulator.ExecuteScript(getCount)
+	require.NoError(t, err)
+	assert.Equal(t, cadence.NewInt(1), result)
+
+	// Update the contract
+
+	err = emulator.ExecuteTransaction(
+		runtime.Script{
+			Source: []byte(`
+              transaction(code: String) {
+                  prepare(signer: AuthAccount) {
+                      signer.contracts.update__experimental(name: "Counter", code: code.utf8)
+                  }
+              }
+            `),
+			Arguments: encodeArguments(
+				t,
+				cadence.String(`
+                  pub contract Counter {
+
+                      pub event Incremented(count: Int)
+
+                      pub var count: Int
+
+                      pub fun increment() {
+                          self.count = self.count + 2
+                          emit Incremented(count: self.count)
+                      }
+
+                      init() {
+                          self.count = 0
+                      }
+                  }
+                `),
+			),
+		},
+		address,
+	)
+	require.NoError(t, err)
+
+	err = emulator.ExecuteTransaction(increment)
+	require.NoError(t, err)
+
+	result, err = emulator.ExecuteScript(getCount)
+	require.NoError(t, err)
+	assert.Equal(t, cadence.NewInt(3), result)
+
+	// Remove the contract
+
+	err = emulator.ExecuteTransaction(
+		runtime.Script{
+			Source: []byte(`
+              transaction {
+                  prepare(signer: AuthAccount) {
+                      signer.contracts.remove(name: "Counter")
+                  }
+              }
+            `),
+		},
+		address,
+	)
+	require.NoError(t, err)
+
+	code, err := emulator.GetAccountContractCode(address, "Counter")
+	require.NoError(t, err)
+	assert.Nil(t, code)
+
+	_, err = emulator.ExecuteScript(getCount)
+	require.Error(t, err)
+}
+
+func TestEmulatorFailedTransaction(t *testing.T) {
+
+	t.Parallel()
+
+	emulator := NewEmulator()
+
+	address := newTestAccount(t, emulator)
+
+	err := emulator.DeployContract(address, "Counter", []byte(testContract))
+	require.NoError(t, err)
+
+	err = emulator.ExecuteTransaction(
+		runtime.Script{
+			Source: []byte(`
+              import Counter from 0x1
+
+              transaction {
+                  prepare(signer: AuthAccount) {
+                      Counter.increment()
+                      signer.save(1, to: /storage/one)
+                      AuthAccount(payer: signer)
+                      log("failing")
+                      panic("failed")
+                  }
+              }
+            `),
+		},
+		address,
+	)
+	require.Error(t, err)
+
+	assert.Empty(t, emulator.Events())
+	assert.Equal(t, []string{`"failing"`}, emulator.Logs())
+
+	// The account creation was reverted
+	assert.False(t, emulator.AccountExists(common.MustBytesToAddress([]byte{0x2})))
+
+	result, err := emulator.ExecuteScript(
+		runtime.Script{
+			Source: []byte(`
+              import Counter from 0x1
+
+              pub fun main(): [AnyStruct] {
+                  return [
+                      Counter.count,
+                      getAuthAccount(0x1).type(at: /storage/one)
+                  ]
+              }
+            `),
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t,
+		cadence.NewArray([]cadence.Value{
+			cadence.NewInt(0),
+			cadence.NewOptional(nil),
+		}).WithType(cadence.VariableSizedArrayType{
+			ElementType: cadence.AnyStructType{},
+		}),
+		result,
+	)
+}
+
+func TestEmulatorFailedTransactionAccountChanges(t *testing.T) {
+
+	t.Parallel()
+
+	emulator := NewEmulator()
+
+	address := newTestAccount(t, emulator)
+
+	// RLP list of the public key [1, 2], ECDSA_P256 (2 in Flow), SHA3_256, and weight 1000
+	encodedKey := []byte{0xc8, 0x82, 0x1, 0x2, 0x2, 0x3, 0x82, 0x3, 0xe8}
+
+	err := emulator.AddEncodedAccountKey(address, encodedKey)
+	require.NoError(t, err)
+
+	err = emulator.ExecuteTransaction(
+		runtime.Script{
+			Source: []byte(`
+              transaction(key: [UInt8]) {
+                  prepare(signer: AuthAccount) {
+                      signer.removePublicKey(0)
+                      signer.addPublicKey(key)
+                      panic("failed")
+                  }
+              }
+            `),
+			Arguments: encodeArguments(
+				t,
+				cadence.NewArray([]cadence.Value{
+					cadence.NewUInt8(0xc8), cadence.NewUInt8(0x82), cadence.NewUInt8(0x1),
+					cadence.NewUInt8(0x2), cadence.NewUInt8(0x2), cadence.NewUInt8(0x3),
+					cadence.NewUInt8(0x82), cadence.NewUInt8(0x3), cadence.NewUInt8(0xe8),
+				}),
+			),
+		},
+		address,
+	)
+	require.Error(t, err)
+
+	// The revocation and the addition of the key were reverted
+
+	key, err := emulator.GetAccountKey(address, 0)
+	require.NoError(t, err)
+	require.NotNil(t, key)
+	assert.False(t, key.IsRevoked)
+
+	key, err = emulator.GetAccountKey(address, 1)
+	require.NoError(t, err)
+	assert.Nil(t, key)
+}
+
+func TestEmulatorContractUpdateInvalidatesPrograms(t *testing.T) {
+
+	t.Parallel()
+
+	emulator := NewEmulator()
+
+	address := newTestAccount(t, emulator)
+
+	counterLocation := common.AddressLocation{Address: address, Name: "Counter"}
+	importerLocation := common.AddressLocation{Address: address, Name: "Importer"}
+
+	err := emulator.SetProgram(counterLocation, &interpreter.Program{})
+	require.NoError(t, err)
+
+	err = emulator.SetProgram(importerLocation, &interpreter.Program{})
+	require.NoError(t, err)
+
+	err = emulator.UpdateAccountContractCode(address, "Counter", []byte(testContract))
+	require.NoError(t, err)
+
+	// Programs which import the updated contract were checked against the previous code,
+	// so they must not be used anymore either
+
+	program, err := emulator.GetProgram(counterLocation)
+	require.NoError(t, err)
+	assert.Nil(t, program)
+
+	program, err = emulator.GetProgram(importerLocation)
+	require.NoError(t, err)
+	assert.Nil(t, program)
+}
+
+func TestEmulatorBlocks(t *testing.T) {
+
+	t.Parallel()
+
+	newUInt64Array := func(integers ...uint64) cadence.Array {
+		values := make([]cadence.Value, 0, len(integers))
+		for _, integer := range integers {
+			values = append(values, cadence.NewUInt64(integer))
+		}
+		return cadence.NewArray(values).
+			WithType(cadence.VariableSizedArrayType{
+				ElementType: cadence.UInt64Type{},
+			})
+	}
+
+	emulator := NewEmulator()
+
+	getBlock := runtime.Script{
+		Source: []byte(`
+          pub fun main(): [UInt64] {
+              let block = getCurrentBlock()
+              return [block.height, UInt64(block.timestamp.seconds)]
+          }
+        `),
+	}
+
+	result, err := emulator.ExecuteScript(getBlock)
+	require.NoError(t, err)
+	assert.Equal(t,
+		newUInt64Array(0, 0),
+		result,
+	)
+
+	emulator.CommitBlock()
+	emulator.CommitBlock()
+
+	result, err = emulator.ExecuteScript(getBlock)
+	require.NoError(t, err)
+	assert.Equal(t,
+		newUInt64Array(2, 2),
+		result,
+	)
+
+	_, exists, err := emulator.GetBlockAtHeight(3)
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestEmulatorUUIDs(t *testing.T) {
+
+	t.Parallel()
+
+	emulator := NewEmulator()
+
+	address := newTestAccount(t, emulator)
+
+	err := emulator.DeployContract(
+		address,
+		"C",
+		[]byte(`
+          pub contract C {
+
+              pub resource R {}
+
+              pub fun createR(): @R {
+                  return <-create R()
+              }
+          }
+        `),
+	)
+	require.NoError(t, err)
+
+	tx := runtime.Script{
+		Source: []byte(`
+          import C from 0x1
+
+          transaction {
+              execute {
+                  let r <- C.createR()
+                  log(r.uuid)
+                  destroy r
+              }
+          }
+        `),
+	}
+
+	err = emulator.ExecuteTransaction(tx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"0"}, emulator.Logs())
+
+	err = emulator.ExecuteTransaction(tx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1"}, emulator.Logs())
+}
+
+func TestEmulatorUnsafeRandom(t *testing.T) {
+
+	t.Parallel()
+
+	tx := func(fail bool) runtime.Script {
+		return runtime.Script{
+			Source: []byte(`
+              transaction(fail: Bool) {
+                  execute {
+                      log(unsafeRandom())
+                      assert(!fail)
+                  }
+              }
+            `),
+			Arguments: encodeArguments(t, cadence.NewBool(fail)),
+		}
+	}
+
+	emulator := NewEmulator()
+
+	err := emulator.ExecuteTransaction(tx(false))
+	require.NoError(t, err)
+	first := emulator.Logs()
+
+	err = emulator.ExecuteTransaction(tx(false))
+	require.NoError(t, err)
+	second := emulator.Logs()
+
+	assert.NotEqual(t, first, second)
+
+	// The numbers generated by failed transactions and by scripts are generated again
+
+	otherEmulator := NewEmulator()
+
+	err = otherEmulator.ExecuteTransaction(tx(true))
+	require.Error(t, err)
+
+	_, err = otherEmulator.ExecuteScript(
+		runtime.Script{
+			Source: []byte(`
+              pub fun main(): UInt64 {
+                  return unsafeRandom()
+              }
+            `),
+		},
+	)
+	require.NoError(t, err)
+
+	err = otherEmulator.ExecuteTransaction(tx(false))
+	require.NoError(t, err)
+	assert.Equal(t, first, otherEmulator.Logs())
+
+	err = otherEmulator.ExecuteTransaction(tx(false))
+	require.NoError(t, err)
+	assert.Equal(t, second, otherEmulator.Logs())
+}
+
+func TestEmulatorStorageUsed(t *testing.T) {
+
+	t.Parallel()
+
+	emulator := NewEmulator()
+
+	address := newTestAccount(t, emulator)
+
+	// registerStorageUsed returns the storage used by the account,
+	// computed from all registers of the ledger
+
+	registerStorageUsed := func() uint64 {
+		var used uint64
+		for id, value := range emulator.state.ledger.registers { //nolint:maprangecheck
+			if id.owner == string(address[:]) {
+				used += registerSize(id, value)
+			}
+		}
+		return used
+	}
+
+	requireStorageUsed := func() uint64 {
+		storageUsed, err := emulator.GetStorageUsed(address)
+		require.NoError(t, err)
+		require.Equal(t, registerStorageUsed(), storageUsed)
+		return storageUsed
+	}
+
+	save := func(count int, fail bool) error {
+		return emulator.ExecuteTransaction(
+			runtime.Script{
+				Source: []byte(`
+                  transaction(count: Int, fail: Bool) {
+                      prepare(signer: AuthAccount) {
+                          let values: [Int] = []
+                          var i = 0
+                          while i < count {
+                              values.append(i)
+                              i = i + 1
+                          }
+                          signer.load<[Int]>(from: /storage/values)
+                          signer.save(values, to: /storage/values)
+                          assert(!fail)
+                      }
+                  }
+                `),
+				Arguments: encodeArguments(t, cadence.NewInt(count), cadence.NewBool(fail)),
+			},
+			address,
+		)
+	}
+
+	initial := requireStorageUsed()
+
+	err := save(1000, false)
+	require.NoError(t, err)
+	large := requireStorageUsed()
+	assert.Greater(t, large, initial)
+
+	// Changes of failed transactions are discarded
+
+	err = save(10, true)
+	require.Error(t, err)
+	assert.Equal(t, large, requireStorageUsed())
+
+	err = save(10, false)
+	require.NoError(t, err)
+	small := requireStorageUsed()
+	assert.Less(t, small, large)
+	assert.Greater(t, small, initial)
+}
+
+func TestEmulatorKeys(t *testing.T) {
+
+	t.Parallel()
+
+	emulator := NewEmulator()
+
+	address := newTestAccount(t, emulator)
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	publicKey := append(
+		privateKey.PublicKey.X.FillBytes(make([]byte, 32)),
+		privateKey.PublicKey.Y.FillBytes(make([]byte, 32))...,
+	)
+
+	publicKeyArgument := make([]cadence.Value, 0, len(publicKey))
+	for _, b := range publicKey {
+		publicKeyArgument = append(publicKeyArgument, cadence.NewUInt8(b))
+	}
+
+	err = emulator.ExecuteTransaction(
+		runtime.Script{
+			Source: []byte(`
+              transaction(publicKey: [UInt8]) {
+                  prepare(signer: AuthAccount) {
+                      signer.keys.add(
+                          publicKey: PublicKey(
+                              publicKey: publicKey,
+                              signatureAlgorithm: SignatureAlgorithm.ECDSA_P256
+                          ),
+                          hashAlgorithm: HashAlgorithm.SHA3_256,
+                          weight: 1000.0
+                      )
+                      signer.keys.revoke(keyIndex: 0)
+                  }
+              }
+            `),
+			Arguments: encodeArguments(t, cadence.NewArray(publicKeyArgument)),
+		},
+		address,
+	)
+	require.NoError(t, err)
+
+	key, err := emulator.GetAccountKey(address, 0)
+	require.NoError(t, err)
+	assert.Equal(t,
+		&runtime.AccountKey{
+			KeyIndex: 0,
+			PublicKey: &runtime.PublicKey{
+				PublicKey: publicKey,
+				SignAlgo:  sema.SignatureAlgorithmECDSA_P256,
+			},
+			HashAlgo:  sema.HashAlgorithmSHA3_256,
+			Weight:    1000,
+			IsRevoked: true,
+		},
+		key,
+	)
+
+	key, err = emulator.GetAccountKey(address, 1)
+	require.NoError(t, err)
+	assert.Nil(t, key)
+
+	// Verify a signature
+
+	const tag = "FLOW-V0.0-user"
+	signedData := []byte("test")
+
+	digest, err := emulator.Hash(signedData, tag, sema.HashAlgorithmSHA3_256)
+	require.NoError(t, err)
+
+	r, s, err := ecdsa.Sign(rand.Reader, privateKey, digest)
+	require.NoError(t, err)
+
+	signature := append(
+		r.FillBytes(make([]byte, 32)),
+		s.FillBytes(make([]byte, 32))...,
+	)
+
+	valid, err := emulator.VerifySignature(
+		signature,
+		tag,
+		signedData,
+		publicKey,
+		sema.SignatureAlgorithmECDSA_P256,
+		sema.HashAlgorithmSHA3_256,
+	)
+	require.NoError(t, err)
+	assert.True(t, valid)
+
+	valid, err = emulator.VerifySignature(
+		signature,
+		tag,
+		[]byte("other"),
+		publicKey,
+		sema.SignatureAlgorithmECDSA_P256,
+		sema.HashAlgorithmSHA3_256,
+	)
+	require.NoError(t, err)
+	assert.False(t, valid)
+}
+
+func TestEmulatorEncodedKeys(t *testing.T) {
+
+	t.Parallel()
+
+	emulator := NewEmulator()
+
+	address := newTestAccount(t, emulator)
+
+	// RLP list of the public key [1, 2], ECDSA_P256 (2 in Flow), SHA3_256, and weight 1000
+	encodedKey := []byte{0xc8, 0x82, 0x1, 0x2, 0x2, 0x3, 0x82, 0x3, 0xe8}
+
+	err := emulator.AddEncodedAccountKey(address, encodedKey)
+	require.NoError(t, err)
+
+	key, err := emulator.GetAccountKey(address, 0)
+	require.NoError(t, err)
+	assert.Equal(t,
+		&runtime.AccountKey{
+			KeyIndex: 0,
+			PublicKey: &runtime.PublicKey{
+				PublicKey: []byte{0x1, 0x2},
+				SignAlgo:  sema.SignatureAlgorithmECDSA_P256,
+			},
+			HashAlgo: sema.HashAlgorithmSHA3_256,
+			Weight:   1000,
+		},
+		key,
+	)
+
+	revoked, err := emulator.RevokeEncodedAccountKey(address, 0)
+	require.NoError(t, err)
+	assert.Equal(t, encodedKey, revoked)
+
+	_, err = emulator.RevokeEncodedAccountKey(address, 1)
+	require.ErrorAs(t, err, &AccountKeyDoesNotExistError{})
+}
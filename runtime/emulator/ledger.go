@@ -0,0 +1,194 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package emulator
+
+import (
+	"encoding/binary"
+
+	"github.com/onflow/atree"
+
+	"github.com/onflow/cadence/runtime/common"
+)
+
+type registerID struct {
+	owner string
+	key   string
+}
+
+// ledger is an in-memory atree.Ledger.
+//
+// The storage used by each owner, i.e. the total size of the keys and values of its registers,
+// is updated whenever a register is set, so it does not have to be computed from all registers.
+//
+// Changes can be journaled: After a checkpoint, the previous values of all changed registers,
+// storage indices, and storage used are recorded, so the changes can be reverted without copying the whole ledger.
+//
+type ledger struct {
+	registers      map[registerID][]byte
+	storageIndices map[string]uint64
+	storageUsage   map[string]uint64
+	journal        *ledgerJournal
+}
+
+// ledgerJournal records the values of the registers, storage indices, and storage used
+// at the time of a checkpoint, for the ones changed since
+//
+type ledgerJournal struct {
+	registers      map[registerID][]byte
+	storageIndices map[string]uint64
+	storageUsage   map[string]uint64
+}
+
+var _ atree.Ledger = &ledger{}
+
+func newLedger() *ledger {
+	return &ledger{
+		registers:      map[registerID][]byte{},
+		storageIndices: map[string]uint64{},
+		storageUsage:   map[string]uint64{},
+	}
+}
+
+func (l *ledger) GetValue(owner, key []byte) ([]byte, error) {
+	return l.registers[registerID{string(owner), string(key)}], nil
+}
+
+func (l *ledger) SetValue(owner, key, value []byte) error {
+	id := registerID{string(owner), string(key)}
+
+	if l.journal != nil {
+		if _, ok := l.journal.registers[id]; !ok {
+			l.journal.registers[id] = l.registers[id]
+		}
+		if _, ok := l.journal.storageUsage[id.owner]; !ok {
+			l.journal.storageUsage[id.owner] = l.storageUsage[id.owner]
+		}
+	}
+
+	used := l.storageUsage[id.owner]
+	if existing, ok := l.registers[id]; ok {
+		used -= registerSize(id, existing)
+	}
+	if len(value) > 0 {
+		used += registerSize(id, value)
+	}
+	if used == 0 {
+		delete(l.storageUsage, id.owner)
+	} else {
+		l.storageUsage[id.owner] = used
+	}
+
+	if len(value) == 0 {
+		delete(l.registers, id)
+		return nil
+	}
+
+	// The caller may reuse the given slice
+	l.registers[id] = append([]byte(nil), value...)
+	return nil
+}
+
+func (l *ledger) ValueExists(owner, key []byte) (bool, error) {
+	return len(l.registers[registerID{string(owner), string(key)}]) > 0, nil
+}
+
+func (l *ledger) AllocateStorageIndex(owner []byte) (result atree.StorageIndex, err error) {
+	previousIndex := l.storageIndices[string(owner)]
+
+	if l.journal != nil {
+		if _, ok := l.journal.storageIndices[string(owner)]; !ok {
+			l.journal.storageIndices[string(owner)] = previousIndex
+		}
+	}
+
+	index := previousIndex + 1
+	l.storageIndices[string(owner)] = index
+	binary.BigEndian.PutUint64(result[:], index)
+	return
+}
+
+// registerSize returns the storage used by the register with the given ID and value
+//
+func registerSize(id registerID, value []byte) uint64 {
+	return uint64(len(id.key) + len(value))
+}
+
+// storageUsed returns the total size of the keys and values
+// of all registers of the given owner
+//
+func (l *ledger) storageUsed(owner common.Address) uint64 {
+	return l.storageUsage[string(owner[:])]
+}
+
+// checkpoint starts journaling the changes to the ledger
+//
+func (l *ledger) checkpoint() {
+	l.journal = &ledgerJournal{
+		registers:      map[registerID][]byte{},
+		storageIndices: map[string]uint64{},
+		storageUsage:   map[string]uint64{},
+	}
+}
+
+// commit keeps the changes since the checkpoint, and stops journaling
+//
+func (l *ledger) commit() {
+	l.journal = nil
+}
+
+// revert discards the changes since the checkpoint, and stops journaling
+//
+func (l *ledger) revert() {
+	journal := l.journal
+	l.journal = nil
+
+	if journal == nil {
+		return
+	}
+
+	// NOTE: the order of the iteration is irrelevant,
+	// each register is restored independently
+	for id, value := range journal.registers { //nolint:maprangecheck
+		if len(value) == 0 {
+			delete(l.registers, id)
+		} else {
+			l.registers[id] = value
+		}
+	}
+
+	// NOTE: the order of the iteration is irrelevant,
+	// each storage index is restored independently
+	for owner, index := range journal.storageIndices { //nolint:maprangecheck
+		if index == 0 {
+			delete(l.storageIndices, owner)
+		} else {
+			l.storageIndices[owner] = index
+		}
+	}
+
+	// NOTE: the order of the iteration is irrelevant,
+	// the storage used by each owner is restored independently
+	for owner, used := range journal.storageUsage { //nolint:maprangecheck
+		if used == 0 {
+			delete(l.storageUsage, owner)
+		} else {
+			l.storageUsage[owner] = used
+		}
+	}
+}
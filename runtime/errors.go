@@ -76,9 +76,12 @@ type CallStackLimitExceededError struct {
 }
 
 var _ errors.UserError = CallStackLimitExceededError{}
+var _ errors.LimitError = CallStackLimitExceededError{}
 
 func (CallStackLimitExceededError) IsUserError() {}
 
+func (CallStackLimitExceededError) IsLimitError() {}
+
 func (e CallStackLimitExceededError) Error() string {
 	return fmt.Sprintf(
 		"call stack limit exceeded: %d",
@@ -357,6 +360,39 @@ func (e *ContractRemovalError) Error() string {
 	return fmt.Sprintf("cannot remove contract `%s`", e.Name)
 }
 
+// ImportHashMismatchError is reported when the code of an imported program
+// does not match the hash specified in the import declaration,
+// e.g. because the imported contract was updated.
+//
+// The range is the range of the hash in the import declaration,
+// i.e. it refers to the code of the importing program, not the imported program.
+//
+type ImportHashMismatchError struct {
+	Location          common.Location
+	ImportingLocation common.Location
+	ExpectedHash      string
+	ActualHash        string
+	ast.Range
+}
+
+var _ errors.UserError = &ImportHashMismatchError{}
+var _ common.HasLocation = &ImportHashMismatchError{}
+
+func (*ImportHashMismatchError) IsUserError() {}
+
+func (e *ImportHashMismatchError) ImportLocation() common.Location {
+	return e.ImportingLocation
+}
+
+func (e *ImportHashMismatchError) Error() string {
+	return fmt.Sprintf(
+		"hash of imported program `%s` does not match: expected %s, got %s",
+		e.Location,
+		e.ExpectedHash,
+		e.ActualHash,
+	)
+}
+
 // InvalidContractDeploymentOriginError
 //
 type InvalidContractDeploymentOriginError struct {
@@ -423,6 +459,75 @@ func (e *FieldMismatchError) SecondaryError() string {
 	return e.Err.Error()
 }
 
+// OverloadMismatchError is reported during a contract update, when the parameter types or the return type
+// of an overloaded function or initializer do not match the existing overload with the same argument labels.
+type OverloadMismatchError struct {
+	DeclName string
+	Overload string
+	Err      error
+	ast.Range
+}
+
+var _ errors.UserError = &OverloadMismatchError{}
+var _ errors.SecondaryError = &OverloadMismatchError{}
+
+func (*OverloadMismatchError) IsUserError() {}
+
+func (e *OverloadMismatchError) Error() string {
+	return fmt.Sprintf("mismatching overload `%s` in `%s`",
+		e.Overload,
+		e.DeclName,
+	)
+}
+
+func (e *OverloadMismatchError) SecondaryError() string {
+	return e.Err.Error()
+}
+
+// MissingOverloadError is reported during a contract update,
+// if an existing overload of an overloaded function or initializer is removed.
+type MissingOverloadError struct {
+	DeclName string
+	Overload string
+	ast.Range
+}
+
+var _ errors.UserError = &MissingOverloadError{}
+
+func (*MissingOverloadError) IsUserError() {}
+
+func (e *MissingOverloadError) Error() string {
+	return fmt.Sprintf("missing overload `%s` in `%s`",
+		e.Overload,
+		e.DeclName,
+	)
+}
+
+// TypeAliasMismatchError is reported during a contract update, when the aliased type of a type alias
+// does not match the existing aliased type of the same type alias.
+type TypeAliasMismatchError struct {
+	DeclName      string
+	TypeAliasName string
+	Err           error
+	ast.Range
+}
+
+var _ errors.UserError = &TypeAliasMismatchError{}
+var _ errors.SecondaryError = &TypeAliasMismatchError{}
+
+func (*TypeAliasMismatchError) IsUserError() {}
+
+func (e *TypeAliasMismatchError) Error() string {
+	return fmt.Sprintf("mismatching type alias `%s` in `%s`",
+		e.TypeAliasName,
+		e.DeclName,
+	)
+}
+
+func (e *TypeAliasMismatchError) SecondaryError() string {
+	return e.Err.Error()
+}
+
 // TypeMismatchError is reported during a contract update, when a type of the new program
 // does not match the existing type.
 type TypeMismatchError struct {
@@ -507,6 +612,23 @@ func (e *ConformanceMismatchError) Error() string {
 	return fmt.Sprintf("conformances does not match in `%s`", e.DeclName)
 }
 
+// InterfaceConformanceMismatchError is reported during a contract update, when the conformances
+// of an interface in the new program do not match the existing ones.
+// Existing implementations may not conform to added conformances,
+// and existing restricted types may rely on removed conformances.
+type InterfaceConformanceMismatchError struct {
+	DeclName string
+	ast.Range
+}
+
+var _ errors.UserError = &InterfaceConformanceMismatchError{}
+
+func (*InterfaceConformanceMismatchError) IsUserError() {}
+
+func (e *InterfaceConformanceMismatchError) Error() string {
+	return fmt.Sprintf("interface conformances do not match in `%s`", e.DeclName)
+}
+
 // EnumCaseMismatchError is reported during an enum update, when an updated enum case
 // does not match the existing enum case.
 type EnumCaseMismatchError struct {
@@ -94,6 +94,14 @@ type HasPrefix interface {
 	Prefix() string
 }
 
+// LimitError is an interface for errors that indicate a limit was reached,
+// e.g. the memory limit, which must end the execution,
+// i.e. the error cannot be handled by the program.
+type LimitError interface {
+	error
+	IsLimitError()
+}
+
 // MemoryError indicates a memory limit has reached and should end
 // the Cadence parsing, checking, or interpretation.
 type MemoryError struct {
@@ -101,9 +109,12 @@ type MemoryError struct {
 }
 
 var _ UserError = MemoryError{}
+var _ LimitError = MemoryError{}
 
 func (MemoryError) IsUserError() {}
 
+func (MemoryError) IsLimitError() {}
+
 func (e MemoryError) Unwrap() error {
 	return e.Err
 }
@@ -201,6 +212,20 @@ func IsUserError(err error) bool {
 	}
 }
 
+// IsLimitError Checks whether a given error was caused by a LimitError.
+// An error is a limit error, if it has at-least one LimitError in the error chain.
+//
+func IsLimitError(err error) bool {
+	switch err := err.(type) {
+	case LimitError:
+		return true
+	case xerrors.Wrapper:
+		return IsLimitError(err.Unwrap())
+	default:
+		return false
+	}
+}
+
 // GetExternalError returns the ExternalError in the error chain, if any
 func GetExternalError(err error) (ExternalError, bool) {
 	switch err := err.(type) {
@@ -20,6 +20,7 @@ package format
 
 import (
 	"fmt"
+	"math/big"
 	"strconv"
 	"strings"
 
@@ -53,3 +54,26 @@ func UFix64(v uint64) string {
 		PadLeft(strconv.Itoa(int(fraction)), '0', fixedpoint.Fix64Scale),
 	)
 }
+
+func Fix128(v *big.Int) string {
+	return bigFixedPoint(v, fixedpoint.Fix128FactorBig, fixedpoint.Fix128Scale)
+}
+
+func UFix128(v *big.Int) string {
+	return bigFixedPoint(v, fixedpoint.Fix128FactorBig, fixedpoint.Fix128Scale)
+}
+
+func bigFixedPoint(v *big.Int, factor *big.Int, scale uint) string {
+	integer, fraction := new(big.Int).QuoRem(v, factor, new(big.Int))
+	var builder strings.Builder
+	if fraction.Sign() < 0 {
+		fraction.Neg(fraction)
+		if integer.Sign() == 0 {
+			builder.WriteRune('-')
+		}
+	}
+	builder.WriteString(integer.String())
+	builder.WriteRune('.')
+	builder.WriteString(PadLeft(fraction.String(), '0', scale))
+	return builder.String()
+}
@@ -19,15 +19,20 @@
 package runtime
 
 import (
+	"encoding/hex"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
 
+	"github.com/onflow/cadence"
 	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/sema"
 	"github.com/onflow/cadence/runtime/tests/checker"
+	"github.com/onflow/cadence/runtime/tests/utils"
 )
 
 func TestRuntimeCyclicImport(t *testing.T) {
@@ -146,3 +151,295 @@ func TestRuntimeExport(t *testing.T) {
 		require.IsType(t, Error{}, err)
 	})
 }
+
+func TestRuntimeImportHash(t *testing.T) {
+
+	t.Parallel()
+
+	address := common.MustBytesToAddress([]byte{0x1})
+
+	contract := []byte(`
+      pub contract A {
+
+          pub fun a(): Int {
+              return 1
+          }
+      }
+    `)
+
+	updatedContract := []byte(`
+      pub contract A {
+
+          pub fun a(): Int {
+              return 2
+          }
+      }
+    `)
+
+	hashString := func(code []byte) string {
+		hash := sha3.Sum256(code)
+		return "sha3-256:" + hex.EncodeToString(hash[:])
+	}
+
+	newScript := func(hashes ...string) []byte {
+		var imports strings.Builder
+		for _, hash := range hashes {
+			imports.WriteString(
+				fmt.Sprintf(
+					"import A from 0x1 with hash %q\n",
+					hash,
+				),
+			)
+		}
+
+		return []byte(
+			imports.String() +
+				`
+                  pub fun main(): Int {
+                      return A.a()
+                  }
+                `,
+		)
+	}
+
+	type testCase struct {
+		runtime          Runtime
+		runtimeInterface *testRuntimeInterface
+		code             []byte
+	}
+
+	newTestCase := func(t *testing.T) *testCase {
+		testCase := &testCase{
+			runtime: newTestInterpreterRuntime(),
+		}
+
+		testCase.runtimeInterface = &testRuntimeInterface{
+			storage: newTestLedger(nil, nil),
+			getSigningAccounts: func() ([]Address, error) {
+				return []Address{address}, nil
+			},
+			getAccountContractCode: func(_ Address, _ string) ([]byte, error) {
+				return testCase.code, nil
+			},
+			updateAccountContractCode: func(_ Address, _ string, code []byte) error {
+				testCase.code = code
+				return nil
+			},
+			resolveLocation: singleIdentifierLocationResolver(t),
+			emitEvent: func(_ cadence.Event) error {
+				return nil
+			},
+		}
+
+		err := testCase.runtime.ExecuteTransaction(
+			Script{
+				Source: utils.DeploymentTransaction("A", contract),
+			},
+			Context{
+				Interface: testCase.runtimeInterface,
+				Location:  common.TransactionLocation{},
+			},
+		)
+		require.NoError(t, err)
+
+		return testCase
+	}
+
+	executeScript := func(testCase *testCase, script []byte) (cadence.Value, error) {
+		return testCase.runtime.ExecuteScript(
+			Script{
+				Source: script,
+			},
+			Context{
+				Interface: testCase.runtimeInterface,
+				Location:  common.ScriptLocation{},
+			},
+		)
+	}
+
+	requireHashMismatch := func(t *testing.T, err error, errorCount int) *ImportHashMismatchError {
+		require.Error(t, err)
+
+		var checkerErr *sema.CheckerError
+		require.ErrorAs(t, err, &checkerErr)
+
+		errs := checker.ExpectCheckerErrors(t, checkerErr, errorCount)
+
+		var importedProgramErr *sema.ImportedProgramError
+		require.ErrorAs(t, errs[0], &importedProgramErr)
+
+		var mismatchErr *ImportHashMismatchError
+		require.ErrorAs(t, importedProgramErr.Err, &mismatchErr)
+
+		return mismatchErr
+	}
+
+	t.Run("matching hash", func(t *testing.T) {
+
+		t.Parallel()
+
+		testCase := newTestCase(t)
+
+		result, err := executeScript(testCase, newScript(hashString(contract)))
+		require.NoError(t, err)
+		require.Equal(t, cadence.NewInt(1), result)
+	})
+
+	t.Run("mismatching hash", func(t *testing.T) {
+
+		t.Parallel()
+
+		testCase := newTestCase(t)
+		testCase.code = updatedContract
+
+		_, err := executeScript(testCase, newScript(hashString(contract)))
+		mismatchErr := requireHashMismatch(t, err, 2)
+
+		require.Equal(t,
+			&ImportHashMismatchError{
+				Location: common.AddressLocation{
+					Address: address,
+					Name:    "A",
+				},
+				ImportingLocation: common.ScriptLocation{},
+				ExpectedHash:      hashString(contract),
+				ActualHash:        hashString(updatedContract),
+				Range:             mismatchErr.Range,
+			},
+			mismatchErr,
+		)
+
+		// The range of the hash refers to the import declaration of the script,
+		// so the error must be reported with an excerpt of the script
+
+		require.Contains(t,
+			err.Error(),
+			fmt.Sprintf(`import A from 0x1 with hash "%s"`, hashString(contract)),
+		)
+	})
+
+	t.Run("updated after caching", func(t *testing.T) {
+
+		t.Parallel()
+
+		testCase := newTestCase(t)
+
+		script := newScript(hashString(contract))
+
+		_, err := executeScript(testCase, script)
+		require.NoError(t, err)
+
+		// The program of the contract is cached,
+		// but the code has changed, so the cached program must not be used
+
+		require.NotNil(t,
+			testCase.runtimeInterface.programs[common.AddressLocation{
+				Address: address,
+				Name:    "A",
+			}],
+		)
+
+		testCase.code = updatedContract
+
+		_, err = executeScript(testCase, script)
+		requireHashMismatch(t, err, 2)
+	})
+
+	t.Run("multiple hashes", func(t *testing.T) {
+
+		t.Parallel()
+
+		testCase := newTestCase(t)
+
+		_, err := executeScript(
+			testCase,
+			newScript(
+				hashString(contract),
+				hashString(updatedContract),
+			),
+		)
+		requireHashMismatch(t, err, 1)
+	})
+
+	t.Run("transaction", func(t *testing.T) {
+
+		t.Parallel()
+
+		testCase := newTestCase(t)
+		testCase.code = updatedContract
+
+		var loggedMessages []string
+		testCase.runtimeInterface.log = func(message string) {
+			loggedMessages = append(loggedMessages, message)
+		}
+
+		tx := []byte(fmt.Sprintf(
+			`
+              import A from 0x1 with hash %q
+
+              transaction {
+                  execute {
+                      log(A.a())
+                  }
+              }
+            `,
+			hashString(contract),
+		))
+
+		err := testCase.runtime.ExecuteTransaction(
+			Script{
+				Source: tx,
+			},
+			Context{
+				Interface: testCase.runtimeInterface,
+				Location:  common.TransactionLocation{},
+			},
+		)
+		requireHashMismatch(t, err, 2)
+
+		require.Empty(t, loggedMessages)
+	})
+}
+
+func TestRuntimeImportTestContract(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := newTestInterpreterRuntime()
+
+	script := []byte(`
+      import Test
+
+      pub fun main() {
+          Test.assert(true)
+      }
+    `)
+
+	runtimeInterface := &testRuntimeInterface{
+		storage: newTestLedger(nil, nil),
+	}
+
+	nextTransactionLocation := newTransactionLocationGenerator()
+
+	_, err := runtime.ExecuteScript(
+		Script{
+			Source: script,
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.Error(t, err)
+
+	var checkerErr *sema.CheckerError
+	require.ErrorAs(t, err, &checkerErr)
+
+	errs := checker.ExpectCheckerErrors(t, checkerErr, 2)
+
+	var importedProgramErr *sema.ImportedProgramError
+	require.ErrorAs(t, errs[0], &importedProgramErr)
+	require.ErrorContains(t, importedProgramErr.Err, "only available in tests")
+
+	require.IsType(t, &sema.NotDeclaredError{}, errs[1])
+}
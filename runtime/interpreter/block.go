@@ -53,7 +53,7 @@ func NewBlockValue(
 	height UInt64Value,
 	view UInt64Value,
 	id *ArrayValue,
-	timestamp UFix64Value,
+	timestamp TimestampValue,
 ) *SimpleCompositeValue {
 	return NewSimpleCompositeValue(
 		inter,
@@ -20,9 +20,16 @@ package interpreter
 
 import (
 	"math"
+	"math/big"
+	"strings"
+	"unicode"
 
+	"github.com/onflow/atree"
+
+	"github.com/onflow/cadence/fixedpoint"
 	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/errors"
+	"github.com/onflow/cadence/runtime/sema"
 )
 
 func ByteArrayValueToByteSlice(memoryGauge common.MemoryGauge, value Value) ([]byte, error) {
@@ -102,3 +109,533 @@ func ByteSliceToByteArrayValue(interpreter *Interpreter, buf []byte) *ArrayValue
 		values...,
 	)
 }
+
+// isFailableConvertibleType returns true if values of the given static type
+// may be converted by a failable cast.
+//
+// Only numbers and containers of numbers are converted,
+// so values which are statically only known to be e.g. `AnyStruct`
+// keep being tested for their run-time type only.
+//
+func isFailableConvertibleType(ty sema.Type) bool {
+	switch ty := ty.(type) {
+	case *sema.OptionalType:
+		return isFailableConvertibleType(ty.Type)
+
+	case sema.ArrayType:
+		return isFailableConvertibleType(ty.ElementType(false))
+
+	case *sema.DictionaryType:
+		return isFailableConvertibleType(ty.KeyType) &&
+			isFailableConvertibleType(ty.ValueType)
+	}
+
+	return sema.IsSubType(ty, sema.NumberType)
+}
+
+// failableConvert attempts to convert a value to the target type of a failable cast,
+// for values whose run-time type is not a subtype of the target type.
+//
+// Numbers are converted if the value fits into the target type exactly:
+// Converting a fixed-point number to an integer fails if the number has a fractional part.
+// Arrays and dictionaries are converted element-wise.
+//
+// The result is false if the value cannot be converted.
+//
+func (interpreter *Interpreter) failableConvert(
+	getLocationRange func() LocationRange,
+	value Value,
+	targetType sema.Type,
+) (Value, bool) {
+
+	if value.IsResourceKinded(interpreter) {
+		return nil, false
+	}
+
+	switch targetType := targetType.(type) {
+	case *sema.OptionalType:
+		switch value := value.(type) {
+		case NilValue:
+			return value, true
+
+		case *SomeValue:
+			innerValue := value.InnerValue(interpreter, getLocationRange)
+			convertedValue, ok := interpreter.failableConvertElement(
+				getLocationRange,
+				innerValue,
+				targetType.Type,
+			)
+			if !ok {
+				return nil, false
+			}
+			return NewSomeValueNonCopying(interpreter, convertedValue), true
+
+		default:
+			return interpreter.failableConvert(getLocationRange, value, targetType.Type)
+		}
+
+	case *sema.NumericType:
+		return interpreter.failableConvertToInteger(value, targetType)
+
+	case *sema.FixedPointNumericType:
+		return interpreter.failableConvertToFixedPoint(value, targetType)
+
+	case sema.ArrayType:
+		array, ok := value.(*ArrayValue)
+		if !ok {
+			return nil, false
+		}
+		return interpreter.failableConvertArray(getLocationRange, array, targetType)
+
+	case *sema.DictionaryType:
+		dictionary, ok := value.(*DictionaryValue)
+		if !ok {
+			return nil, false
+		}
+		return interpreter.failableConvertDictionary(getLocationRange, dictionary, targetType)
+	}
+
+	return nil, false
+}
+
+// failableConvertElement converts an element of a container to the given element type.
+// Elements which already have the element type are copied.
+//
+func (interpreter *Interpreter) failableConvertElement(
+	getLocationRange func() LocationRange,
+	element Value,
+	elementType sema.Type,
+) (Value, bool) {
+
+	if interpreter.IsSubTypeOfSemaType(element.StaticType(interpreter), elementType) {
+		element = element.Transfer(
+			interpreter,
+			getLocationRange,
+			atree.Address{},
+			false,
+			nil,
+		)
+	} else {
+		var ok bool
+		element, ok = interpreter.failableConvert(getLocationRange, element, elementType)
+		if !ok {
+			return nil, false
+		}
+	}
+
+	return interpreter.BoxOptional(getLocationRange, element, elementType), true
+}
+
+func (interpreter *Interpreter) failableConvertArray(
+	getLocationRange func() LocationRange,
+	array *ArrayValue,
+	targetType sema.ArrayType,
+) (Value, bool) {
+
+	if constantSizedType, ok := targetType.(*sema.ConstantSizedType); ok &&
+		int64(array.Count()) != constantSizedType.Size {
+
+		return nil, false
+	}
+
+	elementType := targetType.ElementType(false)
+
+	values := make([]Value, 0, array.Count())
+
+	ok := true
+	array.Iterate(interpreter, func(element Value) (resume bool) {
+		var convertedElement Value
+		convertedElement, ok = interpreter.failableConvertElement(
+			getLocationRange,
+			element,
+			elementType,
+		)
+		if !ok {
+			return false
+		}
+
+		values = append(values, convertedElement)
+
+		return true
+	})
+	if !ok {
+		return nil, false
+	}
+
+	return NewArrayValue(
+		interpreter,
+		getLocationRange,
+		ConvertSemaArrayTypeToStaticArrayType(interpreter, targetType),
+		common.Address{},
+		values...,
+	), true
+}
+
+func (interpreter *Interpreter) failableConvertDictionary(
+	getLocationRange func() LocationRange,
+	dictionary *DictionaryValue,
+	targetType *sema.DictionaryType,
+) (Value, bool) {
+
+	keysAndValues := make([]Value, 0, dictionary.Count()*2)
+
+	ok := true
+	dictionary.Iterate(interpreter, func(key, value Value) (resume bool) {
+		var convertedKey, convertedValue Value
+
+		convertedKey, ok = interpreter.failableConvertElement(
+			getLocationRange,
+			key,
+			targetType.KeyType,
+		)
+		if !ok {
+			return false
+		}
+
+		convertedValue, ok = interpreter.failableConvertElement(
+			getLocationRange,
+			value,
+			targetType.ValueType,
+		)
+		if !ok {
+			return false
+		}
+
+		keysAndValues = append(keysAndValues, convertedKey, convertedValue)
+
+		return true
+	})
+	if !ok {
+		return nil, false
+	}
+
+	return NewDictionaryValue(
+		interpreter,
+		getLocationRange,
+		ConvertSemaDictionaryTypeToStaticDictionaryType(interpreter, targetType),
+		keysAndValues...,
+	), true
+}
+
+func (interpreter *Interpreter) failableConvertToInteger(
+	value Value,
+	targetType *sema.NumericType,
+) (Value, bool) {
+
+	if targetType.IsSuperType() {
+		return nil, false
+	}
+
+	integer, ok := scaledNumberValue(interpreter, value, 0)
+	if !ok {
+		return nil, false
+	}
+
+	return interpreter.integerValueInRange(integer, targetType)
+}
+
+// integerValueInRange returns the given integer as a value of the given integer type.
+// The result is false if the integer is outside the bounds of the type.
+//
+func (interpreter *Interpreter) integerValueInRange(
+	integer *big.Int,
+	targetType *sema.NumericType,
+) (Value, bool) {
+
+	minInt := targetType.MinInt()
+	if minInt != nil && integer.Cmp(minInt) < 0 {
+		return nil, false
+	}
+
+	maxInt := targetType.MaxInt()
+	if maxInt != nil && integer.Cmp(maxInt) > 0 {
+		return nil, false
+	}
+
+	intValue := NewIntValueFromBigInt(
+		interpreter,
+		common.NewBigIntMemoryUsage(common.BigIntByteLength(integer)),
+		func() *big.Int {
+			return integer
+		},
+	)
+
+	return interpreter.convert(intValue, sema.IntType, targetType), true
+}
+
+func (interpreter *Interpreter) failableConvertToFixedPoint(
+	value Value,
+	targetType *sema.FixedPointNumericType,
+) (Value, bool) {
+
+	fixedPoint, ok := scaledNumberValue(interpreter, value, targetType.Scale())
+	if !ok {
+		return nil, false
+	}
+
+	switch targetType {
+	case sema.Fix64Type:
+		if !fixedPoint.IsInt64() {
+			return nil, false
+		}
+		return NewFix64Value(interpreter, fixedPoint.Int64), true
+
+	case sema.UFix64Type:
+		if !fixedPoint.IsUint64() {
+			return nil, false
+		}
+		return NewUFix64Value(interpreter, fixedPoint.Uint64), true
+
+	case sema.Fix128Type:
+		if fixedPoint.Cmp(sema.Fix128TypeMinBig) < 0 ||
+			fixedPoint.Cmp(sema.Fix128TypeMaxBig) > 0 {
+
+			return nil, false
+		}
+		return NewFix128Value(
+			interpreter,
+			func() *big.Int {
+				return fixedPoint
+			},
+		), true
+
+	case sema.UFix128Type:
+		if fixedPoint.Sign() < 0 ||
+			fixedPoint.Cmp(sema.UFix128TypeMaxBig) > 0 {
+
+			return nil, false
+		}
+		return NewUFix128Value(
+			interpreter,
+			func() *big.Int {
+				return fixedPoint
+			},
+		), true
+	}
+
+	return nil, false
+}
+
+// scaledNumberValue returns the given number value as an integer with the given scale,
+// e.g. the number 1.5 with scale 2 is returned as 150.
+// The result is false if the value is not a number,
+// or if it cannot be represented exactly with the given scale.
+//
+func scaledNumberValue(memoryGauge common.MemoryGauge, value Value, scale uint) (*big.Int, bool) {
+
+	var result *big.Int
+	var valueScale uint
+
+	switch value := value.(type) {
+	case Fix64Value:
+		result = big.NewInt(int64(value))
+		valueScale = sema.Fix64Scale
+
+	case UFix64Value:
+		result = new(big.Int).SetUint64(uint64(value))
+		valueScale = sema.Fix64Scale
+
+	case Fix128Value:
+		result = new(big.Int).Set(value.BigInt)
+		valueScale = sema.Fix128Scale
+
+	case UFix128Value:
+		result = new(big.Int).Set(value.BigInt)
+		valueScale = sema.Fix128Scale
+
+	case BigNumberValue:
+		result = new(big.Int).Set(value.ToBigInt(memoryGauge))
+
+	case NumberValue:
+		result = big.NewInt(int64(value.ToInt()))
+
+	default:
+		return nil, false
+	}
+
+	ten := big.NewInt(10)
+
+	if scale >= valueScale {
+		factor := new(big.Int).Exp(ten, big.NewInt(int64(scale-valueScale)), nil)
+		return result.Mul(result, factor), true
+	}
+
+	factor := new(big.Int).Exp(ten, big.NewInt(int64(valueScale-scale)), nil)
+	remainder := new(big.Int)
+	result.QuoRem(result, factor, remainder)
+	if remainder.Sign() != 0 {
+		return nil, false
+	}
+
+	return result, true
+}
+
+// parseNumber parses the given string as a number of the given type,
+// using the same syntax as number literals.
+// The result is false if the string is not a valid literal,
+// or if the number is outside the bounds of the type.
+//
+func (interpreter *Interpreter) parseNumber(str string, targetType sema.Type) (Value, bool) {
+	switch targetType := targetType.(type) {
+	case *sema.NumericType:
+		if targetType.IsSuperType() {
+			return nil, false
+		}
+
+		integer, ok := parseIntegerLiteral(str)
+		if !ok {
+			return nil, false
+		}
+
+		return interpreter.integerValueInRange(integer, targetType)
+
+	case *sema.FixedPointNumericType:
+		literal, ok := normalizeFixedPointLiteral(str)
+		if !ok {
+			return nil, false
+		}
+
+		switch targetType {
+		case sema.Fix64Type:
+			fixedPoint, err := fixedpoint.ParseFix64(literal)
+			if err != nil {
+				return nil, false
+			}
+			return NewFix64Value(interpreter, fixedPoint.Int64), true
+
+		case sema.UFix64Type:
+			fixedPoint, err := fixedpoint.ParseUFix64(literal)
+			if err != nil {
+				return nil, false
+			}
+			return NewUFix64Value(interpreter, fixedPoint.Uint64), true
+
+		case sema.Fix128Type:
+			fixedPoint, err := fixedpoint.ParseFix128(literal)
+			if err != nil {
+				return nil, false
+			}
+			return NewFix128Value(
+				interpreter,
+				func() *big.Int {
+					return fixedPoint
+				},
+			), true
+
+		case sema.UFix128Type:
+			fixedPoint, err := fixedpoint.ParseUFix128(literal)
+			if err != nil {
+				return nil, false
+			}
+			return NewUFix128Value(
+				interpreter,
+				func() *big.Int {
+					return fixedPoint
+				},
+			), true
+		}
+	}
+
+	return nil, false
+}
+
+// parseIntegerLiteral parses the given string with the syntax of an integer literal
+// as accepted by the lexer and parser: An optional minus sign,
+// an optional base prefix (0b, 0o, or 0x), and digits, which may be separated by underscores,
+// but which may neither start nor end with an underscore.
+//
+func parseIntegerLiteral(str string) (*big.Int, bool) {
+
+	text := strings.TrimPrefix(str, "-")
+	negative := len(text) < len(str)
+
+	// Like in the lexer, a number literal must start with a decimal digit
+
+	if len(text) == 0 || !isDecimalDigit(rune(text[0])) {
+		return nil, false
+	}
+
+	base := 10
+
+	if len(text) > 1 && text[0] == '0' {
+		switch text[1] {
+		case 'b':
+			base = 2
+		case 'o':
+			base = 8
+		case 'x':
+			base = 16
+		}
+
+		if base != 10 {
+			text = text[2:]
+		}
+	}
+
+	if strings.HasPrefix(text, "_") || strings.HasSuffix(text, "_") {
+		return nil, false
+	}
+
+	withoutUnderscores := strings.ReplaceAll(text, "_", "")
+
+	// Only allow digits, as big.Int.SetString also accepts signs
+
+	for _, r := range withoutUnderscores {
+		if !isDecimalDigit(r) && !unicode.IsLetter(r) {
+			return nil, false
+		}
+	}
+
+	integer, ok := new(big.Int).SetString(withoutUnderscores, base)
+	if !ok {
+		return nil, false
+	}
+
+	if negative {
+		integer.Neg(integer)
+	}
+
+	return integer, true
+}
+
+// normalizeFixedPointLiteral checks that the given string has the syntax of a fixed-point literal
+// as accepted by the lexer and parser: An optional minus sign,
+// and integer and fractional digits, separated by a decimal point.
+// The integer part must start with a digit, and digits may be separated by underscores.
+// The result is the string with the underscores removed,
+// as expected by the functions in the fixedpoint package.
+//
+func normalizeFixedPointLiteral(str string) (string, bool) {
+
+	text := strings.TrimPrefix(str, "-")
+
+	parts := strings.Split(text, ".")
+	if len(parts) != 2 {
+		return "", false
+	}
+
+	integerPart := parts[0]
+	fractionalPart := parts[1]
+
+	if len(integerPart) == 0 || !isDecimalDigit(rune(integerPart[0])) {
+		return "", false
+	}
+
+	for _, part := range parts {
+		for _, r := range part {
+			if r != '_' && !isDecimalDigit(r) {
+				return "", false
+			}
+		}
+	}
+
+	if strings.Trim(fractionalPart, "_") == "" {
+		return "", false
+	}
+
+	return strings.ReplaceAll(str, "_", ""), true
+}
+
+func isDecimalDigit(r rune) bool {
+	return '0' <= r && r <= '9'
+}
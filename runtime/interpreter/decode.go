@@ -284,16 +284,36 @@ func (d StorableDecoder) decodeStorable() (atree.Storable, error) {
 		case CBORTagWord64Value:
 			storable, err = d.decodeWord64()
 
+		case CBORTagWord128Value:
+			storable, err = d.decodeWord128()
+
+		case CBORTagWord256Value:
+			storable, err = d.decodeWord256()
+
 		// Fix*
 
 		case CBORTagFix64Value:
 			storable, err = d.decodeFix64()
 
+		case CBORTagFix128Value:
+			storable, err = d.decodeFix128()
+
 		// UFix*
 
 		case CBORTagUFix64Value:
 			storable, err = d.decodeUFix64()
 
+		case CBORTagUFix128Value:
+			storable, err = d.decodeUFix128()
+
+		// Time
+
+		case CBORTagTimestampValue:
+			storable, err = d.decodeTimestamp()
+
+		case CBORTagDurationValue:
+			storable, err = d.decodeDuration()
+
 		// Storage
 
 		case CBORTagPathValue:
@@ -715,6 +735,50 @@ func (d StorableDecoder) decodeWord64() (Word64Value, error) {
 	return NewUnmeteredWord64Value(value), nil
 }
 
+func (d StorableDecoder) decodeWord128() (Word128Value, error) {
+	bigInt, err := d.decodeBigInt()
+	if err != nil {
+		if e, ok := err.(*cbor.WrongTypeError); ok {
+			return Word128Value{}, errors.NewUnexpectedError("invalid Word128 encoding: %s", e.ActualType.String())
+		}
+		return Word128Value{}, err
+	}
+
+	if bigInt.Sign() < 0 {
+		return Word128Value{}, errors.NewUnexpectedError("invalid Word128: got %s, expected positive", bigInt)
+	}
+
+	max := sema.Word128TypeMaxIntBig
+	if bigInt.Cmp(max) > 0 {
+		return Word128Value{}, errors.NewUnexpectedError("invalid Word128: got %s, expected max %s", bigInt, max)
+	}
+
+	// NOTE: already metered by `decodeBigInt`
+	return NewUnmeteredWord128ValueFromBigInt(bigInt), nil
+}
+
+func (d StorableDecoder) decodeWord256() (Word256Value, error) {
+	bigInt, err := d.decodeBigInt()
+	if err != nil {
+		if e, ok := err.(*cbor.WrongTypeError); ok {
+			return Word256Value{}, errors.NewUnexpectedError("invalid Word256 encoding: %s", e.ActualType.String())
+		}
+		return Word256Value{}, err
+	}
+
+	if bigInt.Sign() < 0 {
+		return Word256Value{}, errors.NewUnexpectedError("invalid Word256: got %s, expected positive", bigInt)
+	}
+
+	max := sema.Word256TypeMaxIntBig
+	if bigInt.Cmp(max) > 0 {
+		return Word256Value{}, errors.NewUnexpectedError("invalid Word256: got %s, expected max %s", bigInt, max)
+	}
+
+	// NOTE: already metered by `decodeBigInt`
+	return NewUnmeteredWord256ValueFromBigInt(bigInt), nil
+}
+
 func (d StorableDecoder) decodeFix64() (Fix64Value, error) {
 	value, err := decodeInt64(d)
 	if err != nil {
@@ -741,6 +805,77 @@ func (d StorableDecoder) decodeUFix64() (UFix64Value, error) {
 	return NewUnmeteredUFix64Value(value), nil
 }
 
+func (d StorableDecoder) decodeFix128() (Fix128Value, error) {
+	bigInt, err := d.decodeBigInt()
+	if err != nil {
+		if e, ok := err.(*cbor.WrongTypeError); ok {
+			return Fix128Value{}, errors.NewUnexpectedError("invalid Fix128 encoding: %s", e.ActualType.String())
+		}
+		return Fix128Value{}, err
+	}
+
+	min := sema.Fix128TypeMinBig
+	if bigInt.Cmp(min) < 0 {
+		return Fix128Value{}, errors.NewUnexpectedError("invalid Fix128: got %s, expected min %s", bigInt, min)
+	}
+
+	max := sema.Fix128TypeMaxBig
+	if bigInt.Cmp(max) > 0 {
+		return Fix128Value{}, errors.NewUnexpectedError("invalid Fix128: got %s, expected max %s", bigInt, max)
+	}
+
+	// NOTE: already metered by `decodeBigInt`
+	return NewUnmeteredFix128Value(bigInt), nil
+}
+
+func (d StorableDecoder) decodeUFix128() (UFix128Value, error) {
+	bigInt, err := d.decodeBigInt()
+	if err != nil {
+		if e, ok := err.(*cbor.WrongTypeError); ok {
+			return UFix128Value{}, errors.NewUnexpectedError("invalid UFix128 encoding: %s", e.ActualType.String())
+		}
+		return UFix128Value{}, err
+	}
+
+	if bigInt.Sign() < 0 {
+		return UFix128Value{}, errors.NewUnexpectedError("invalid UFix128: got %s, expected positive", bigInt)
+	}
+
+	max := sema.UFix128TypeMaxBig
+	if bigInt.Cmp(max) > 0 {
+		return UFix128Value{}, errors.NewUnexpectedError("invalid UFix128: got %s, expected max %s", bigInt, max)
+	}
+
+	// NOTE: already metered by `decodeBigInt`
+	return NewUnmeteredUFix128Value(bigInt), nil
+}
+
+func (d StorableDecoder) decodeTimestamp() (TimestampValue, error) {
+	value, err := decodeInt64(d)
+	if err != nil {
+		if e, ok := err.(*cbor.WrongTypeError); ok {
+			return 0, errors.NewUnexpectedError("unknown Timestamp encoding: %s", e.ActualType.String())
+		}
+		return 0, err
+	}
+
+	// Already metered at `decodeInt64`
+	return NewUnmeteredTimestampValue(value), nil
+}
+
+func (d StorableDecoder) decodeDuration() (DurationValue, error) {
+	value, err := decodeInt64(d)
+	if err != nil {
+		if e, ok := err.(*cbor.WrongTypeError); ok {
+			return 0, errors.NewUnexpectedError("unknown Duration encoding: %s", e.ActualType.String())
+		}
+		return 0, err
+	}
+
+	// Already metered at `decodeInt64`
+	return NewUnmeteredDurationValue(value), nil
+}
+
 func (d StorableDecoder) decodeSome() (SomeStorable, error) {
 	storable, err := d.decodeStorable()
 	if err != nil {
@@ -1093,6 +1228,9 @@ func (d TypeDecoder) DecodeStaticType() (StaticType, error) {
 	case CBORTagCapabilityStaticType:
 		return d.decodeCapabilityStaticType()
 
+	case CBORTagSetStaticType:
+		return d.decodeSetStaticType()
+
 	default:
 		return nil, errors.NewUnexpectedError("invalid static type encoding tag: %d", number)
 	}
@@ -1139,7 +1277,7 @@ func (d TypeDecoder) decodeCompositeStaticType() (StaticType, error) {
 		return nil, err
 	}
 
-	if size != expectedLength {
+	if size != expectedLength && size != encodedGenericCompositeStaticTypeLength {
 		return nil, errors.NewUnexpectedError(
 			"invalid composite static type encoding: expected [%d]any, got [%d]any",
 			expectedLength,
@@ -1165,7 +1303,46 @@ func (d TypeDecoder) decodeCompositeStaticType() (StaticType, error) {
 		return nil, err
 	}
 
-	return NewCompositeStaticTypeComputeTypeID(d.memoryGauge, location, qualifiedIdentifier), nil
+	staticType := NewCompositeStaticTypeComputeTypeID(d.memoryGauge, location, qualifiedIdentifier)
+
+	// Decode type arguments at array index encodedCompositeStaticTypeTypeArgumentsFieldKey
+	if size == encodedGenericCompositeStaticTypeLength {
+		staticType.TypeArguments, err = d.decodeTypeArguments()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return staticType, nil
+}
+
+// decodeTypeArguments decodes the type arguments
+// of an instantiated generic composite type
+//
+func (d TypeDecoder) decodeTypeArguments() ([]StaticType, error) {
+	typeArgumentCount, err := d.decoder.DecodeArrayHead()
+	if err != nil {
+		if e, ok := err.(*cbor.WrongTypeError); ok {
+			return nil, errors.NewUnexpectedError(
+				"invalid type arguments encoding: %s",
+				e.ActualType.String(),
+			)
+		}
+		return nil, err
+	}
+
+	typeArguments := make([]StaticType, typeArgumentCount)
+	for i := 0; i < int(typeArgumentCount); i++ {
+		typeArguments[i], err = d.DecodeStaticType()
+		if err != nil {
+			return nil, errors.NewUnexpectedError(
+				"invalid type argument encoding: %w",
+				err,
+			)
+		}
+	}
+
+	return typeArguments, nil
 }
 
 func (d TypeDecoder) decodeInterfaceStaticType() (InterfaceStaticType, error) {
@@ -1230,6 +1407,17 @@ func (d TypeDecoder) decodeVariableSizedStaticType() (StaticType, error) {
 	return NewVariableSizedStaticType(d.memoryGauge, staticType), nil
 }
 
+func (d TypeDecoder) decodeSetStaticType() (StaticType, error) {
+	elementType, err := d.DecodeStaticType()
+	if err != nil {
+		return nil, errors.NewUnexpectedError(
+			"invalid set static type encoding: %w",
+			err,
+		)
+	}
+	return NewSetStaticType(d.memoryGauge, elementType), nil
+}
+
 func (d TypeDecoder) decodeConstantSizedStaticType() (StaticType, error) {
 
 	const expectedLength = encodedConstantSizedStaticTypeLength
@@ -1506,7 +1694,9 @@ func (d TypeDecoder) decodeCompositeTypeInfo() (atree.TypeInfo, error) {
 		return nil, err
 	}
 
-	if length != encodedCompositeTypeInfoLength {
+	if length != encodedCompositeTypeInfoLength &&
+		length != encodedGenericCompositeTypeInfoLength {
+
 		return nil, errors.NewUnexpectedError(
 			"invalid composite type info: expected %d elements, got %d",
 			encodedCompositeTypeInfoLength, length,
@@ -1535,12 +1725,21 @@ func (d TypeDecoder) decodeCompositeTypeInfo() (atree.TypeInfo, error) {
 		)
 	}
 
-	return NewCompositeTypeInfo(
+	typeInfo := NewCompositeTypeInfo(
 		d.memoryGauge,
 		location,
 		qualifiedIdentifier,
 		common.CompositeKind(kind),
-	), nil
+	)
+
+	if length == encodedGenericCompositeTypeInfoLength {
+		typeInfo.typeArguments, err = d.decodeTypeArguments()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return typeInfo, nil
 }
 
 func DecodeTypeInfo(decoder *cbor.StreamDecoder, memoryGauge common.MemoryGauge) (atree.TypeInfo, error) {
@@ -1565,6 +1764,8 @@ func DecodeTypeInfo(decoder *cbor.StreamDecoder, memoryGauge common.MemoryGauge)
 			return d.decodeVariableSizedStaticType()
 		case CBORTagDictionaryStaticType:
 			return d.decodeDictionaryStaticType()
+		case CBORTagSetStaticType:
+			return d.decodeSetStaticType()
 		case CBORTagCompositeValue:
 			return d.decodeCompositeTypeInfo()
 		default:
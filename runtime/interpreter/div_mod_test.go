@@ -20,6 +20,7 @@ package interpreter_test
 
 import (
 	"fmt"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -3413,6 +3414,11 @@ func TestNegativeMod(t *testing.T) {
 				NewUnmeteredFix64ValueWithInteger(5),
 				NewUnmeteredFix64ValueWithInteger(-1),
 			},
+			"Fix128": {
+				NewUnmeteredFix128ValueWithInteger(big.NewInt(-1)),
+				NewUnmeteredFix128ValueWithInteger(big.NewInt(5)),
+				NewUnmeteredFix128ValueWithInteger(big.NewInt(-1)),
+			},
 		}
 
 		for _, integerType := range sema.AllSignedFixedPointTypes {
@@ -116,8 +116,8 @@ const (
 	_ // DO *NOT* REPLACE. Previously used for array values
 	CBORTagStringValue
 	CBORTagCharacterValue
-	_
-	_
+	CBORTagTimestampValue
+	CBORTagDurationValue
 	_
 	_
 	_
@@ -158,8 +158,8 @@ const (
 	CBORTagWord16Value
 	CBORTagWord32Value
 	CBORTagWord64Value
-	_ // future: Word128
-	_ // future: Word256
+	CBORTagWord128Value
+	CBORTagWord256Value
 	_
 
 	// Fix*
@@ -168,7 +168,7 @@ const (
 	_ // future: Fix16
 	_ // future: Fix32
 	CBORTagFix64Value
-	_ // future: Fix128
+	CBORTagFix128Value
 	_ // future: Fix256
 	_
 
@@ -178,7 +178,7 @@ const (
 	_ // future: UFix16
 	_ // future: UFix32
 	CBORTagUFix64Value
-	_ // future: UFix128
+	CBORTagUFix128Value
 	_ // future: UFix256
 	_
 
@@ -218,6 +218,7 @@ const (
 	CBORTagReferenceStaticType
 	CBORTagRestrictedStaticType
 	CBORTagCapabilityStaticType
+	CBORTagSetStaticType
 
 	// !!! *WARNING* !!!
 	// ADD NEW TYPES *BEFORE* THIS WARNING.
@@ -598,6 +599,38 @@ func (v Word64Value) Encode(e *atree.Encoder) error {
 	return e.CBOR.EncodeUint64(uint64(v))
 }
 
+// Encode encodes Word128Value as
+// cbor.Tag{
+//		Number:  CBORTagWord128Value,
+//		Content: *big.Int(v.BigInt),
+// }
+func (v Word128Value) Encode(e *atree.Encoder) error {
+	err := e.CBOR.EncodeRawBytes([]byte{
+		// tag number
+		0xd8, CBORTagWord128Value,
+	})
+	if err != nil {
+		return err
+	}
+	return e.CBOR.EncodeBigInt(v.BigInt)
+}
+
+// Encode encodes Word256Value as
+// cbor.Tag{
+//		Number:  CBORTagWord256Value,
+//		Content: *big.Int(v.BigInt),
+// }
+func (v Word256Value) Encode(e *atree.Encoder) error {
+	err := e.CBOR.EncodeRawBytes([]byte{
+		// tag number
+		0xd8, CBORTagWord256Value,
+	})
+	if err != nil {
+		return err
+	}
+	return e.CBOR.EncodeBigInt(v.BigInt)
+}
+
 // Encode encodes Fix64Value as
 // cbor.Tag{
 //		Number:  CBORTagFix64Value,
@@ -630,6 +663,70 @@ func (v UFix64Value) Encode(e *atree.Encoder) error {
 	return e.CBOR.EncodeUint64(uint64(v))
 }
 
+// Encode encodes Fix128Value as
+// cbor.Tag{
+//		Number:  CBORTagFix128Value,
+//		Content: *big.Int(v.BigInt),
+// }
+func (v Fix128Value) Encode(e *atree.Encoder) error {
+	err := e.CBOR.EncodeRawBytes([]byte{
+		// tag number
+		0xd8, CBORTagFix128Value,
+	})
+	if err != nil {
+		return err
+	}
+	return e.CBOR.EncodeBigInt(v.BigInt)
+}
+
+// Encode encodes UFix128Value as
+// cbor.Tag{
+//		Number:  CBORTagUFix128Value,
+//		Content: *big.Int(v.BigInt),
+// }
+func (v UFix128Value) Encode(e *atree.Encoder) error {
+	err := e.CBOR.EncodeRawBytes([]byte{
+		// tag number
+		0xd8, CBORTagUFix128Value,
+	})
+	if err != nil {
+		return err
+	}
+	return e.CBOR.EncodeBigInt(v.BigInt)
+}
+
+// Encode encodes TimestampValue as
+// cbor.Tag{
+//		Number:  CBORTagTimestampValue,
+//		Content: int64(v),
+// }
+func (v TimestampValue) Encode(e *atree.Encoder) error {
+	err := e.CBOR.EncodeRawBytes([]byte{
+		// tag number
+		0xd8, CBORTagTimestampValue,
+	})
+	if err != nil {
+		return err
+	}
+	return e.CBOR.EncodeInt64(int64(v))
+}
+
+// Encode encodes DurationValue as
+// cbor.Tag{
+//		Number:  CBORTagDurationValue,
+//		Content: int64(v),
+// }
+func (v DurationValue) Encode(e *atree.Encoder) error {
+	err := e.CBOR.EncodeRawBytes([]byte{
+		// tag number
+		0xd8, CBORTagDurationValue,
+	})
+	if err != nil {
+		return err
+	}
+	return e.CBOR.EncodeInt64(int64(v))
+}
+
 // Encode encodes SomeStorable as
 // cbor.Tag{
 //		Number: CBORTagSomeValue,
@@ -1009,12 +1106,17 @@ func (t OptionalStaticType) Encode(e *cbor.StreamEncoder) error {
 const (
 	// encodedCompositeStaticTypeLocationFieldKey            uint64 = 0
 	// encodedCompositeStaticTypeQualifiedIdentifierFieldKey uint64 = 1
+	// encodedCompositeStaticTypeTypeArgumentsFieldKey       uint64 = 2
 
 	// !!! *WARNING* !!!
 	//
 	// encodedCompositeStaticTypeLength MUST be updated when new element is added.
 	// It is used to verify encoded composite static type length during decoding.
 	encodedCompositeStaticTypeLength = 2
+
+	// encodedGenericCompositeStaticTypeLength is the length of the encoding
+	// of an instantiated generic composite static type, which includes the type arguments
+	encodedGenericCompositeStaticTypeLength = 3
 )
 
 // Encode encodes CompositeStaticType as
@@ -1023,15 +1125,26 @@ const (
 // 			Content: cborArray{
 //				encodedCompositeStaticTypeLocationFieldKey:            Location(v.Location),
 //				encodedCompositeStaticTypeQualifiedIdentifierFieldKey: string(v.QualifiedIdentifier),
+//				encodedCompositeStaticTypeTypeArgumentsFieldKey:       []StaticType(v.TypeArguments),
 //		},
 // }
+//
+// The type arguments are only encoded for instantiated generic composite types
+//
 func (t CompositeStaticType) Encode(e *cbor.StreamEncoder) error {
+	hasTypeArguments := len(t.TypeArguments) > 0
+
 	// Encode tag number and array head
+	var arrayHead byte = 0x82
+	if hasTypeArguments {
+		arrayHead = 0x83
+	}
+
 	err := e.EncodeRawBytes([]byte{
 		// tag number
 		0xd8, CBORTagCompositeStaticType,
-		// array, 2 items follow
-		0x82,
+		// array, 2 or 3 items follow
+		arrayHead,
 	})
 	if err != nil {
 		return err
@@ -1044,7 +1157,28 @@ func (t CompositeStaticType) Encode(e *cbor.StreamEncoder) error {
 	}
 
 	// Encode qualified identifier at array index encodedCompositeStaticTypeQualifiedIdentifierFieldKey
-	return e.EncodeString(t.QualifiedIdentifier)
+	err = e.EncodeString(t.QualifiedIdentifier)
+	if err != nil {
+		return err
+	}
+
+	if !hasTypeArguments {
+		return nil
+	}
+
+	// Encode type arguments (as array) at array index encodedCompositeStaticTypeTypeArgumentsFieldKey
+	err = e.EncodeArrayHead(uint64(len(t.TypeArguments)))
+	if err != nil {
+		return err
+	}
+	for _, typeArgument := range t.TypeArguments {
+		// Encode type argument as array type arguments element
+		err = EncodeStaticType(e, typeArgument)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // NOTE: NEVER change, only add/increment; ensure uint64
@@ -1105,6 +1239,22 @@ func (t VariableSizedStaticType) Encode(e *cbor.StreamEncoder) error {
 	return EncodeStaticType(e, t.Type)
 }
 
+// Encode encodes SetStaticType as
+// cbor.Tag{
+//		Number:  CBORTagSetStaticType,
+//		Content: StaticType(v.ElementType),
+// }
+func (t SetStaticType) Encode(e *cbor.StreamEncoder) error {
+	err := e.EncodeRawBytes([]byte{
+		// tag number
+		0xd8, CBORTagSetStaticType,
+	})
+	if err != nil {
+		return err
+	}
+	return EncodeStaticType(e, t.ElementType)
+}
+
 // NOTE: NEVER change, only add/increment; ensure uint64
 const (
 	// encodedConstantSizedStaticTypeSizeFieldKey uint64 = 0
@@ -1304,6 +1454,7 @@ type compositeTypeInfo struct {
 	location            common.Location
 	qualifiedIdentifier string
 	kind                common.CompositeKind
+	typeArguments       []StaticType
 }
 
 func NewCompositeTypeInfo(
@@ -1325,12 +1476,24 @@ var _ atree.TypeInfo = compositeTypeInfo{}
 
 const encodedCompositeTypeInfoLength = 3
 
+// encodedGenericCompositeTypeInfoLength is the length of the encoding
+// of the type info of an instantiated generic composite value,
+// which additionally includes the type arguments
+const encodedGenericCompositeTypeInfoLength = 4
+
 func (c compositeTypeInfo) Encode(e *cbor.StreamEncoder) error {
+	hasTypeArguments := len(c.typeArguments) > 0
+
+	var arrayHead byte = 0x83
+	if hasTypeArguments {
+		arrayHead = 0x84
+	}
+
 	err := e.EncodeRawBytes([]byte{
 		// tag number
 		0xd8, CBORTagCompositeValue,
-		// array, 3 items follow
-		0x83,
+		// array, 3 or 4 items follow
+		arrayHead,
 	})
 	if err != nil {
 		return err
@@ -1351,15 +1514,42 @@ func (c compositeTypeInfo) Encode(e *cbor.StreamEncoder) error {
 		return err
 	}
 
+	if !hasTypeArguments {
+		return nil
+	}
+
+	err = e.EncodeArrayHead(uint64(len(c.typeArguments)))
+	if err != nil {
+		return err
+	}
+	for _, typeArgument := range c.typeArguments {
+		err = EncodeStaticType(e, typeArgument)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 func (c compositeTypeInfo) Equal(o atree.TypeInfo) bool {
 	other, ok := o.(compositeTypeInfo)
-	return ok &&
-		c.location == other.location &&
-		c.qualifiedIdentifier == other.qualifiedIdentifier &&
-		c.kind == other.kind
+	if !ok ||
+		c.location != other.location ||
+		c.qualifiedIdentifier != other.qualifiedIdentifier ||
+		c.kind != other.kind ||
+		len(c.typeArguments) != len(other.typeArguments) {
+
+		return false
+	}
+
+	for i, typeArgument := range c.typeArguments {
+		if !typeArgument.Equal(other.typeArguments[i]) {
+			return false
+		}
+	}
+
+	return true
 }
 
 // EmptyTypeInfo
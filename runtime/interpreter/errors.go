@@ -404,6 +404,26 @@ func (e InvalidPathDomainError) SecondaryError() string {
 	)
 }
 
+// InvalidTimestampError
+//
+type InvalidTimestampError struct {
+	Literal string
+	LocationRange
+}
+
+var _ errors.UserError = InvalidTimestampError{}
+var _ errors.SecondaryError = InvalidTimestampError{}
+
+func (InvalidTimestampError) IsUserError() {}
+
+func (e InvalidTimestampError) Error() string {
+	return fmt.Sprintf("invalid timestamp: `%s`", e.Literal)
+}
+
+func (e InvalidTimestampError) SecondaryError() string {
+	return "expected an ISO-8601 date and time in UTC, e.g. `2020-03-13T19:52:43Z`"
+}
+
 // OverwriteError
 //
 type OverwriteError struct {
@@ -424,6 +444,52 @@ func (e OverwriteError) Error() string {
 	)
 }
 
+// StorageMutatedDuringIterationError
+//
+type StorageMutatedDuringIterationError struct {
+	LocationRange
+}
+
+var _ errors.UserError = StorageMutatedDuringIterationError{}
+
+func (StorageMutatedDuringIterationError) IsUserError() {}
+
+func (StorageMutatedDuringIterationError) Error() string {
+	return "storage iteration continued after modifying storage"
+}
+
+// ContainerMutatedDuringIterationError
+//
+type ContainerMutatedDuringIterationError struct {
+	LocationRange
+}
+
+var _ errors.UserError = ContainerMutatedDuringIterationError{}
+
+func (ContainerMutatedDuringIterationError) IsUserError() {}
+
+func (ContainerMutatedDuringIterationError) Error() string {
+	return "container was mutated while it was iterated over"
+}
+
+// NonRevertibleOperationError
+//
+type NonRevertibleOperationError struct {
+	Operation string
+	LocationRange
+}
+
+var _ errors.UserError = NonRevertibleOperationError{}
+
+func (NonRevertibleOperationError) IsUserError() {}
+
+func (e NonRevertibleOperationError) Error() string {
+	return fmt.Sprintf(
+		"cannot %s in try block: operation cannot be reverted",
+		e.Operation,
+	)
+}
+
 // CyclicLinkError
 //
 type CyclicLinkError struct {
@@ -825,3 +891,23 @@ func (DuplicateKeyInResourceDictionaryError) IsUserError() {}
 func (e DuplicateKeyInResourceDictionaryError) Error() string {
 	return "duplicate key in resource dictionary"
 }
+
+// ArrayPatternLengthMismatchError
+//
+type ArrayPatternLengthMismatchError struct {
+	ExpectedLength int
+	ActualLength   int
+	LocationRange
+}
+
+var _ errors.UserError = ArrayPatternLengthMismatchError{}
+
+func (ArrayPatternLengthMismatchError) IsUserError() {}
+
+func (e ArrayPatternLengthMismatchError) Error() string {
+	return fmt.Sprintf(
+		"cannot destructure array: pattern expects %d elements, but size is %d",
+		e.ExpectedLength,
+		e.ActualLength,
+	)
+}
@@ -174,6 +174,9 @@ type HostFunctionValue struct {
 	Function        HostFunction
 	NestedVariables map[string]*Variable
 	Type            *sema.FunctionType
+	// Overloads are the constructors for the overloaded initializers of a composite,
+	// keyed by the overload identifier of the initializer, e.g. `init(name:symbol:)`
+	Overloads map[string]*HostFunctionValue
 }
 
 func (f *HostFunctionValue) String() string {
@@ -356,7 +359,27 @@ func (f BoundFunctionValue) Walk(_ *Interpreter, _ func(Value)) {
 }
 
 func (f BoundFunctionValue) StaticType(inter *Interpreter) StaticType {
-	return f.Function.StaticType(inter)
+	staticType := f.Function.StaticType(inter)
+
+	if f.Self == nil || len(f.Self.TypeArguments) == 0 {
+		return staticType
+	}
+
+	// The type parameters of a generic composite
+	// are bound by the type arguments of the receiver
+
+	functionStaticType, ok := staticType.(FunctionStaticType)
+	if !ok {
+		return staticType
+	}
+
+	functionType, ok := functionStaticType.Type.
+		Resolve(inter.compositeTypeArguments(f.Self)).(*sema.FunctionType)
+	if !ok {
+		return staticType
+	}
+
+	return NewFunctionStaticType(inter, functionType)
 }
 
 func (BoundFunctionValue) IsImportable(_ *Interpreter) bool {
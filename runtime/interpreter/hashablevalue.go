@@ -63,9 +63,9 @@ const (
 	HashInputTypePath
 	HashInputTypeType
 	HashInputTypeCharacter
-	_
-	_
-	_
+	HashInputTypeComposite
+	HashInputTypeTimestamp
+	HashInputTypeDuration
 	// Int*
 	HashInputTypeInt
 	HashInputTypeInt8
@@ -92,8 +92,8 @@ const (
 	HashInputTypeWord16
 	HashInputTypeWord32
 	HashInputTypeWord64
-	_ // future: Word128
-	_ // future: Word256
+	HashInputTypeWord128
+	HashInputTypeWord256
 	_
 
 	// Fix*
@@ -102,7 +102,7 @@ const (
 	_ // future: Fix16
 	_ // future: Fix32
 	HashInputTypeFix64
-	_ // future: Fix128
+	HashInputTypeFix128
 	_ // future: Fix256
 	_
 
@@ -112,7 +112,7 @@ const (
 	_ // future: UFix16
 	_ // future: UFix32
 	HashInputTypeUFix64
-	_ // future: UFix128
+	HashInputTypeUFix128
 	_ // future: UFix256
 	_
 
@@ -23,7 +23,9 @@ import (
 	goErrors "errors"
 	"fmt"
 	"math"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/fxamacker/cbor/v2"
 	"github.com/onflow/atree"
@@ -316,6 +318,15 @@ type Storage interface {
 	atree.SlabStorage
 	GetStorageMap(address common.Address, domain string, createIfNotExists bool) *StorageMap
 	CheckHealth() error
+	// Checkpoint starts a new, nested checkpoint
+	Checkpoint()
+	// CommitCheckpoint ends the current checkpoint and keeps all changes made since it was started
+	CommitCheckpoint()
+	// RevertCheckpoint ends the current checkpoint and reverts all changes made since it was started
+	RevertCheckpoint() error
+	// RecordSlab records the original state of the slab with the given storage ID,
+	// before it is mutated in-place, if a checkpoint is active
+	RecordSlab(id atree.StorageID) error
 }
 
 type ReferencedResourceKindedValues map[atree.StorageID]map[ReferenceTrackedResourceKindedValue]struct{}
@@ -363,8 +374,27 @@ type Interpreter struct {
 	resourceVariables                    map[ResourceKindedValue]*Variable
 	memoryGauge                          common.MemoryGauge
 	CallStack                            *CallStack
+	storageIteration                     *storageIterationState
+	iteratedContainers                   iteratedContainers
+	revertibleContexts                   *revertibleContexts
 }
 
+// storageIterationState tracks if account storage is currently being iterated over,
+// and if the storage was mutated during the iteration.
+// It is shared by all interpreters of an execution.
+//
+type storageIterationState struct {
+	inIteration            bool
+	mutatedDuringIteration bool
+}
+
+// iteratedContainers tracks the containers which are currently being iterated over
+// by built-in functions, e.g. the array function `forEach`,
+// and how many iterations are in progress for each container.
+// It is shared by all interpreters of an execution.
+//
+type iteratedContainers map[atree.StorageID]int
+
 var _ common.MemoryGauge = &Interpreter{}
 
 type Option func(*Interpreter) error
@@ -676,6 +706,24 @@ func withReferencedResourceKindedValues(referencedResourceKindedValues Reference
 	}
 }
 
+// withStorageIterationState returns an interpreter option which sets the storage iteration state.
+//
+func withStorageIterationState(state *storageIterationState) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.storageIteration = state
+		return nil
+	}
+}
+
+// withIteratedContainers returns an interpreter option which sets the iterated containers.
+//
+func withIteratedContainers(containers iteratedContainers) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.iteratedContainers = containers
+		return nil
+	}
+}
+
 // WithDebugger returns an interpreter option which sets the given debugger
 //
 func WithDebugger(debugger *Debugger) Option {
@@ -720,6 +768,9 @@ func NewInterpreter(program *Program, location common.Location, options ...Optio
 			TypeRequirementCodes: map[sema.TypeID]WrapperCode{},
 		}),
 		withReferencedResourceKindedValues(map[atree.StorageID]map[ReferenceTrackedResourceKindedValue]struct{}{}),
+		withStorageIterationState(&storageIterationState{}),
+		withIteratedContainers(iteratedContainers{}),
+		withRevertibleContexts(&revertibleContexts{}),
 		WithInvalidatedResourceValidationEnabled(true),
 	}
 
@@ -1279,6 +1330,7 @@ func (interpreter *Interpreter) VisitFunctionDeclaration(declaration *ast.Functi
 	identifier := declaration.Identifier.Identifier
 
 	functionType := interpreter.Program.Elaboration.FunctionDeclarationFunctionTypes[declaration]
+	functionType = interpreter.substituteTypeArguments(functionType).(*sema.FunctionType)
 
 	// NOTE: find *or* declare, as the function might have not been pre-declared (e.g. in the REPL)
 	variable := interpreter.findOrDeclareVariable(identifier)
@@ -1615,7 +1667,11 @@ func (interpreter *Interpreter) declareNonEnumCompositeValue(
 	}
 
 	var initializerFunction FunctionValue
-	if declaration.CompositeKind == common.CompositeKindEvent {
+	switch declaration.CompositeKind {
+	case common.CompositeKindEvent, common.CompositeKindNewtype:
+		// The initializers of events and newtypes are synthesized:
+		// Set the fields to the arguments
+
 		initializerFunction = NewHostFunctionValue(
 			interpreter,
 			func(invocation Invocation) Value {
@@ -1632,7 +1688,8 @@ func (interpreter *Interpreter) declareNonEnumCompositeValue(
 			},
 			constructorType,
 		)
-	} else {
+
+	default:
 		compositeInitializerFunction := interpreter.compositeInitializerFunction(declaration, lexicalScope)
 		if compositeInitializerFunction != nil {
 			initializerFunction = compositeInitializerFunction
@@ -1679,14 +1736,17 @@ func (interpreter *Interpreter) declareNonEnumCompositeValue(
 	}
 
 	// NOTE: First the conditions of the type requirements are evaluated,
-	//  then the conditions of this composite's conformances
+	//  then the conditions of this composite's conformances,
+	//  including the conformances inherited from the conformances
 	//
 	// Because the conditions are wrappers, they have to be applied
 	// in reverse order: first the conformances, then the type requirements;
 	// each conformances and type requirements in reverse order as well.
 
-	for i := len(compositeType.ExplicitInterfaceConformances) - 1; i >= 0; i-- {
-		conformance := compositeType.ExplicitInterfaceConformances[i]
+	conformances := compositeType.EffectiveInterfaceConformances()
+
+	for i := len(conformances) - 1; i >= 0; i-- {
+		conformance := conformances[i]
 
 		wrapFunctions(interpreter.typeCodes.InterfaceCodes[conformance.ID()])
 	}
@@ -1699,6 +1759,8 @@ func (interpreter *Interpreter) declareNonEnumCompositeValue(
 		wrapFunctions(interpreter.typeCodes.TypeRequirementCodes[typeRequirement.ID()])
 	}
 
+	addFunctionNames(declaration, functions)
+
 	interpreter.typeCodes.CompositeCodes[compositeType.ID()] = CompositeTypeCode{
 		DestructorFunction: destructorFunction,
 		CompositeFunctions: functions,
@@ -1708,7 +1770,11 @@ func (interpreter *Interpreter) declareNonEnumCompositeValue(
 
 	qualifiedIdentifier := compositeType.QualifiedIdentifier()
 
-	constructorGenerator := func(address common.Address) *HostFunctionValue {
+	generateConstructor := func(
+		address common.Address,
+		initializerFunction FunctionValue,
+		constructorType *sema.FunctionType,
+	) *HostFunctionValue {
 		return NewHostFunctionValue(
 			interpreter,
 			func(invocation Invocation) Value {
@@ -1766,12 +1832,36 @@ func (interpreter *Interpreter) declareNonEnumCompositeValue(
 					)
 				}
 
-				value := NewCompositeValue(
+				// Instances of generic composites record the type arguments
+				// inferred or given for the type parameters of the composite
+
+				var typeArguments []StaticType
+
+				typeParameters := compositeType.TypeParameters()
+				if len(typeParameters) > 0 {
+					typeArguments = make([]StaticType, len(typeParameters))
+
+					for i, typeParameter := range typeParameters {
+						var ty sema.Type
+						var ok bool
+						if invocation.TypeParameterTypes != nil {
+							ty, ok = invocation.TypeParameterTypes.Get(typeParameter)
+						}
+						if !ok {
+							panic(errors.NewUnreachableError())
+						}
+
+						typeArguments[i] = ConvertSemaToStaticType(interpreter, ty)
+					}
+				}
+
+				value := NewCompositeValueWithTypeArguments(
 					interpreter,
 					invocation.GetLocationRange,
 					location,
 					qualifiedIdentifier,
 					declaration.CompositeKind,
+					typeArguments,
 					fields,
 					address,
 				)
@@ -1805,6 +1895,10 @@ func (interpreter *Interpreter) declareNonEnumCompositeValue(
 		)
 	}
 
+	constructorGenerator := func(address common.Address) *HostFunctionValue {
+		return generateConstructor(address, initializerFunction, constructorType)
+	}
+
 	// Contract declarations declare a value / instance (singleton),
 	// for all other composite kinds, the constructor is declared
 
@@ -1823,6 +1917,40 @@ func (interpreter *Interpreter) declareNonEnumCompositeValue(
 	} else {
 		constructor := constructorGenerator(common.Address{})
 		constructor.NestedVariables = nestedVariables
+
+		// If the initializers are overloaded, declare a constructor for each initializer.
+		// Invocations select the constructor based on the argument labels.
+		//
+		// The first initializer is the one that interfaces require,
+		// so only it is wrapped with the conditions of the conformances.
+		// The checker rejects overloaded initializers if the required initializer has conditions
+
+		initializerOverloads := compositeType.InitializerOverloads
+		if len(initializerOverloads) > 0 {
+			initializers := declaration.Members.Initializers()
+
+			constructor.Overloads = make(map[string]*HostFunctionValue, len(initializerOverloads))
+
+			for i, overload := range initializerOverloads {
+				overloadConstructor := constructor
+				if i > 0 {
+					overloadConstructor = generateConstructor(
+						common.Address{},
+						interpreter.initializerFunction(initializers[i], lexicalScope),
+						&sema.FunctionType{
+							IsConstructor: true,
+							Parameters:    overload.Parameters,
+							ReturnTypeAnnotation: &sema.TypeAnnotation{
+								Type: compositeType,
+							},
+						},
+					)
+				}
+
+				constructor.Overloads[overload.Identifier] = overloadConstructor
+			}
+		}
+
 		variable.SetValue(constructor)
 	}
 
@@ -1951,15 +2079,19 @@ func (interpreter *Interpreter) compositeInitializerFunction(
 	lexicalScope *VariableActivation,
 ) *InterpretedFunctionValue {
 
-	// TODO: support multiple overloaded initializers
-
 	initializers := compositeDeclaration.Members.Initializers()
-	var initializer *ast.SpecialFunctionDeclaration
 	if len(initializers) == 0 {
 		return nil
 	}
 
-	initializer = initializers[0]
+	return interpreter.initializerFunction(initializers[0], lexicalScope)
+}
+
+func (interpreter *Interpreter) initializerFunction(
+	initializer *ast.SpecialFunctionDeclaration,
+	lexicalScope *VariableActivation,
+) *InterpretedFunctionValue {
+
 	functionType := interpreter.Program.Elaboration.ConstructorFunctionTypes[initializer]
 
 	parameterList := initializer.FunctionDeclaration.ParameterList
@@ -2038,6 +2170,12 @@ func (interpreter *Interpreter) compositeDestructorFunction(
 	)
 }
 
+// compositeFunctions returns the functions of the composite,
+// keyed by their overload identifiers, e.g. `transfer(from:amount:)`.
+//
+// Functions which are not overloaded are additionally keyed by their name,
+// see addFunctionNames
+//
 func (interpreter *Interpreter) compositeFunctions(
 	compositeDeclaration *ast.CompositeDeclaration,
 	lexicalScope *VariableActivation,
@@ -2046,8 +2184,7 @@ func (interpreter *Interpreter) compositeFunctions(
 	functions := map[string]FunctionValue{}
 
 	for _, functionDeclaration := range compositeDeclaration.Members.Functions() {
-		name := functionDeclaration.Identifier.Identifier
-		functions[name] =
+		functions[functionOverloadIdentifier(functionDeclaration)] =
 			interpreter.compositeFunction(
 				functionDeclaration,
 				lexicalScope,
@@ -2057,6 +2194,37 @@ func (interpreter *Interpreter) compositeFunctions(
 	return functions
 }
 
+// addFunctionNames adds the functions of the composite which are not overloaded
+// to the given functions under their name
+//
+func addFunctionNames(
+	compositeDeclaration *ast.CompositeDeclaration,
+	functions map[string]FunctionValue,
+) {
+	functionDeclarations := compositeDeclaration.Members.Functions()
+
+	declarationCounts := make(map[string]int, len(functionDeclarations))
+	for _, functionDeclaration := range functionDeclarations {
+		declarationCounts[functionDeclaration.Identifier.Identifier]++
+	}
+
+	for _, functionDeclaration := range functionDeclarations {
+		name := functionDeclaration.Identifier.Identifier
+		if declarationCounts[name] > 1 {
+			continue
+		}
+
+		functions[name] = functions[functionOverloadIdentifier(functionDeclaration)]
+	}
+}
+
+func functionOverloadIdentifier(functionDeclaration *ast.FunctionDeclaration) string {
+	return sema.FunctionOverloadIdentifier(
+		functionDeclaration.Identifier.Identifier,
+		functionDeclaration.ParameterList.EffectiveArgumentLabels(),
+	)
+}
+
 func (interpreter *Interpreter) functionWrappers(
 	members *ast.Members,
 	lexicalScope *VariableActivation,
@@ -2068,7 +2236,6 @@ func (interpreter *Interpreter) functionWrappers(
 
 		functionType := interpreter.Program.Elaboration.FunctionDeclarationFunctionTypes[functionDeclaration]
 
-		name := functionDeclaration.Identifier.Identifier
 		functionWrapper := interpreter.functionConditionsWrapper(
 			functionDeclaration,
 			functionType.ReturnTypeAnnotation.Type,
@@ -2077,7 +2244,7 @@ func (interpreter *Interpreter) functionWrappers(
 		if functionWrapper == nil {
 			continue
 		}
-		functionWrappers[name] = functionWrapper
+		functionWrappers[functionOverloadIdentifier(functionDeclaration)] = functionWrapper
 	}
 
 	return functionWrappers
@@ -2137,6 +2304,53 @@ func (interpreter *Interpreter) ValueIsSubtypeOfSemaType(value Value, targetType
 	return interpreter.IsSubTypeOfSemaType(value.StaticType(interpreter), targetType)
 }
 
+// substituteTypeArguments replaces the type parameters in the given type
+// with the type arguments bound in the current activation,
+// e.g. the type `[T]` in the generic function `fun wrap<T>(_ x: T): [T]`
+// is the type `[Int]` when the function is invoked with an integer
+//
+func (interpreter *Interpreter) substituteTypeArguments(ty sema.Type) sema.Type {
+	if ty == nil {
+		return nil
+	}
+
+	activation := interpreter.activations.Current()
+	if activation == nil || activation.typeArguments == nil {
+		return ty
+	}
+
+	substitutedType := ty.Resolve(activation.typeArguments)
+	if substitutedType == nil {
+		return ty
+	}
+
+	return substitutedType
+}
+
+// substituteTypeParameterTypes substitutes the type arguments bound in the current activation
+// in the types inferred for the type parameters of an invoked function,
+// e.g. the type argument `T` of the invocation `wrap(x)` in a generic function
+//
+func (interpreter *Interpreter) substituteTypeParameterTypes(
+	typeParameterTypes *sema.TypeParameterTypeOrderedMap,
+) *sema.TypeParameterTypeOrderedMap {
+	if typeParameterTypes == nil {
+		return nil
+	}
+
+	activation := interpreter.activations.Current()
+	if activation == nil || activation.typeArguments == nil {
+		return typeParameterTypes
+	}
+
+	substitutedTypes := &sema.TypeParameterTypeOrderedMap{}
+	typeParameterTypes.Foreach(func(typeParameter *sema.TypeParameter, ty sema.Type) {
+		substitutedTypes.Set(typeParameter, interpreter.substituteTypeArguments(ty))
+	})
+
+	return substitutedTypes
+}
+
 func (interpreter *Interpreter) transferAndConvert(
 	value Value,
 	valueType, targetType sema.Type,
@@ -2177,6 +2391,9 @@ func (interpreter *Interpreter) ConvertAndBox(
 	value Value,
 	valueType, targetType sema.Type,
 ) Value {
+	valueType = interpreter.substituteTypeArguments(valueType)
+	targetType = interpreter.substituteTypeArguments(targetType)
+
 	value = interpreter.convert(value, valueType, targetType)
 	return interpreter.BoxOptional(getLocationRange, value, targetType)
 }
@@ -2286,6 +2503,16 @@ func (interpreter *Interpreter) convert(value Value, valueType, targetType sema.
 			return ConvertWord64(interpreter, value)
 		}
 
+	case sema.Word128Type:
+		if !valueType.Equal(unwrappedTargetType) {
+			return ConvertWord128(interpreter, value)
+		}
+
+	case sema.Word256Type:
+		if !valueType.Equal(unwrappedTargetType) {
+			return ConvertWord256(interpreter, value)
+		}
+
 	// Fix*
 
 	case sema.Fix64Type:
@@ -2297,6 +2524,16 @@ func (interpreter *Interpreter) convert(value Value, valueType, targetType sema.
 		if !valueType.Equal(unwrappedTargetType) {
 			return ConvertUFix64(interpreter, value)
 		}
+
+	case sema.Fix128Type:
+		if !valueType.Equal(unwrappedTargetType) {
+			return ConvertFix128(interpreter, value)
+		}
+
+	case sema.UFix128Type:
+		if !valueType.Equal(unwrappedTargetType) {
+			return ConvertUFix128(interpreter, value)
+		}
 	}
 
 	switch unwrappedTargetType.(type) {
@@ -2436,7 +2673,7 @@ func (interpreter *Interpreter) initializerFunctionWrapper(
 	lexicalScope *VariableActivation,
 ) FunctionWrapper {
 
-	// TODO: support multiple overloaded initializers
+	// NOTE: interfaces and type requirements can only declare one initializer
 
 	initializers := members.Initializers()
 	if len(initializers) == 0 {
@@ -2709,6 +2946,9 @@ func (interpreter *Interpreter) NewSubInterpreter(
 		WithAtreeStorageValidationEnabled(interpreter.atreeStorageValidationEnabled),
 		withTypeCodes(interpreter.typeCodes),
 		withReferencedResourceKindedValues(interpreter.referencedResourceKindedValues),
+		withStorageIterationState(interpreter.storageIteration),
+		withIteratedContainers(interpreter.iteratedContainers),
+		withRevertibleContexts(interpreter.revertibleContexts),
 		WithPublicAccountHandler(interpreter.publicAccountHandler),
 		WithPublicKeyValidationHandler(interpreter.PublicKeyValidationHandler),
 		WithSignatureVerificationHandler(interpreter.SignatureVerificationHandler),
@@ -2767,13 +3007,48 @@ func (interpreter *Interpreter) writeStored(
 	identifier string,
 	value Value,
 ) {
+	if interpreter.storageIteration.inIteration {
+		interpreter.storageIteration.mutatedDuringIteration = true
+	}
+
 	accountStorage := interpreter.Storage.GetStorageMap(storageAddress, domain, true)
 	accountStorage.WriteValue(interpreter, identifier, value)
 }
 
+// iterateContainer calls the given function while the container with the given storage ID
+// is marked as being iterated over, so mutations of the container are rejected
+//
+func (interpreter *Interpreter) iterateContainer(storageID atree.StorageID, f func()) {
+	containers := interpreter.iteratedContainers
+
+	containers[storageID]++
+	defer func() {
+		containers[storageID]--
+		if containers[storageID] == 0 {
+			delete(containers, storageID)
+		}
+	}()
+
+	f()
+}
+
+// checkMutationDuringIteration aborts if the container with the given storage ID
+// is currently being iterated over
+//
+func (interpreter *Interpreter) checkMutationDuringIteration(
+	storageID atree.StorageID,
+	getLocationRange func() LocationRange,
+) {
+	if interpreter.iteratedContainers[storageID] > 0 {
+		panic(ContainerMutatedDuringIterationError{
+			LocationRange: getLocationRange(),
+		})
+	}
+}
+
 type ValueConverterDeclaration struct {
 	name         string
-	convert      func(*Interpreter, Value) Value
+	convert      func(*Interpreter, Value, func() LocationRange) Value
 	min          Value
 	max          Value
 	functionType *sema.FunctionType
@@ -2785,14 +3060,14 @@ var ConverterDeclarations = []ValueConverterDeclaration{
 	{
 		name:         sema.IntTypeName,
 		functionType: sema.NumberConversionFunctionType(sema.IntType),
-		convert: func(interpreter *Interpreter, value Value) Value {
+		convert: func(interpreter *Interpreter, value Value, _ func() LocationRange) Value {
 			return ConvertInt(interpreter, value)
 		},
 	},
 	{
 		name:         sema.UIntTypeName,
 		functionType: sema.NumberConversionFunctionType(sema.UIntType),
-		convert: func(interpreter *Interpreter, value Value) Value {
+		convert: func(interpreter *Interpreter, value Value, _ func() LocationRange) Value {
 			return ConvertUInt(interpreter, value)
 		},
 		min: NewUnmeteredUIntValueFromBigInt(sema.UIntTypeMin),
@@ -2800,7 +3075,7 @@ var ConverterDeclarations = []ValueConverterDeclaration{
 	{
 		name:         sema.Int8TypeName,
 		functionType: sema.NumberConversionFunctionType(sema.Int8Type),
-		convert: func(interpreter *Interpreter, value Value) Value {
+		convert: func(interpreter *Interpreter, value Value, _ func() LocationRange) Value {
 			return ConvertInt8(interpreter, value)
 		},
 		min: NewUnmeteredInt8Value(math.MinInt8),
@@ -2809,7 +3084,7 @@ var ConverterDeclarations = []ValueConverterDeclaration{
 	{
 		name:         sema.Int16TypeName,
 		functionType: sema.NumberConversionFunctionType(sema.Int16Type),
-		convert: func(interpreter *Interpreter, value Value) Value {
+		convert: func(interpreter *Interpreter, value Value, _ func() LocationRange) Value {
 			return ConvertInt16(interpreter, value)
 		},
 		min: NewUnmeteredInt16Value(math.MinInt16),
@@ -2818,7 +3093,7 @@ var ConverterDeclarations = []ValueConverterDeclaration{
 	{
 		name:         sema.Int32TypeName,
 		functionType: sema.NumberConversionFunctionType(sema.Int32Type),
-		convert: func(interpreter *Interpreter, value Value) Value {
+		convert: func(interpreter *Interpreter, value Value, _ func() LocationRange) Value {
 			return ConvertInt32(interpreter, value)
 		},
 		min: NewUnmeteredInt32Value(math.MinInt32),
@@ -2827,7 +3102,7 @@ var ConverterDeclarations = []ValueConverterDeclaration{
 	{
 		name:         sema.Int64TypeName,
 		functionType: sema.NumberConversionFunctionType(sema.Int64Type),
-		convert: func(interpreter *Interpreter, value Value) Value {
+		convert: func(interpreter *Interpreter, value Value, _ func() LocationRange) Value {
 			return ConvertInt64(interpreter, value)
 		},
 		min: NewUnmeteredInt64Value(math.MinInt64),
@@ -2836,7 +3111,7 @@ var ConverterDeclarations = []ValueConverterDeclaration{
 	{
 		name:         sema.Int128TypeName,
 		functionType: sema.NumberConversionFunctionType(sema.Int128Type),
-		convert: func(interpreter *Interpreter, value Value) Value {
+		convert: func(interpreter *Interpreter, value Value, _ func() LocationRange) Value {
 			return ConvertInt128(interpreter, value)
 		},
 		min: NewUnmeteredInt128ValueFromBigInt(sema.Int128TypeMinIntBig),
@@ -2845,7 +3120,7 @@ var ConverterDeclarations = []ValueConverterDeclaration{
 	{
 		name:         sema.Int256TypeName,
 		functionType: sema.NumberConversionFunctionType(sema.Int256Type),
-		convert: func(interpreter *Interpreter, value Value) Value {
+		convert: func(interpreter *Interpreter, value Value, _ func() LocationRange) Value {
 			return ConvertInt256(interpreter, value)
 		},
 		min: NewUnmeteredInt256ValueFromBigInt(sema.Int256TypeMinIntBig),
@@ -2854,7 +3129,7 @@ var ConverterDeclarations = []ValueConverterDeclaration{
 	{
 		name:         sema.UInt8TypeName,
 		functionType: sema.NumberConversionFunctionType(sema.UInt8Type),
-		convert: func(interpreter *Interpreter, value Value) Value {
+		convert: func(interpreter *Interpreter, value Value, _ func() LocationRange) Value {
 			return ConvertUInt8(interpreter, value)
 		},
 		min: NewUnmeteredUInt8Value(0),
@@ -2863,7 +3138,7 @@ var ConverterDeclarations = []ValueConverterDeclaration{
 	{
 		name:         sema.UInt16TypeName,
 		functionType: sema.NumberConversionFunctionType(sema.UInt16Type),
-		convert: func(interpreter *Interpreter, value Value) Value {
+		convert: func(interpreter *Interpreter, value Value, _ func() LocationRange) Value {
 			return ConvertUInt16(interpreter, value)
 		},
 		min: NewUnmeteredUInt16Value(0),
@@ -2872,7 +3147,7 @@ var ConverterDeclarations = []ValueConverterDeclaration{
 	{
 		name:         sema.UInt32TypeName,
 		functionType: sema.NumberConversionFunctionType(sema.UInt32Type),
-		convert: func(interpreter *Interpreter, value Value) Value {
+		convert: func(interpreter *Interpreter, value Value, _ func() LocationRange) Value {
 			return ConvertUInt32(interpreter, value)
 		},
 		min: NewUnmeteredUInt32Value(0),
@@ -2881,7 +3156,7 @@ var ConverterDeclarations = []ValueConverterDeclaration{
 	{
 		name:         sema.UInt64TypeName,
 		functionType: sema.NumberConversionFunctionType(sema.UInt64Type),
-		convert: func(interpreter *Interpreter, value Value) Value {
+		convert: func(interpreter *Interpreter, value Value, _ func() LocationRange) Value {
 			return ConvertUInt64(interpreter, value)
 		},
 		min: NewUnmeteredUInt64Value(0),
@@ -2890,7 +3165,7 @@ var ConverterDeclarations = []ValueConverterDeclaration{
 	{
 		name:         sema.UInt128TypeName,
 		functionType: sema.NumberConversionFunctionType(sema.UInt128Type),
-		convert: func(interpreter *Interpreter, value Value) Value {
+		convert: func(interpreter *Interpreter, value Value, _ func() LocationRange) Value {
 			return ConvertUInt128(interpreter, value)
 		},
 		min: NewUnmeteredUInt128ValueFromUint64(0),
@@ -2899,7 +3174,7 @@ var ConverterDeclarations = []ValueConverterDeclaration{
 	{
 		name:         sema.UInt256TypeName,
 		functionType: sema.NumberConversionFunctionType(sema.UInt256Type),
-		convert: func(interpreter *Interpreter, value Value) Value {
+		convert: func(interpreter *Interpreter, value Value, _ func() LocationRange) Value {
 			return ConvertUInt256(interpreter, value)
 		},
 		min: NewUnmeteredUInt256ValueFromUint64(0),
@@ -2908,7 +3183,7 @@ var ConverterDeclarations = []ValueConverterDeclaration{
 	{
 		name:         sema.Word8TypeName,
 		functionType: sema.NumberConversionFunctionType(sema.Word8Type),
-		convert: func(interpreter *Interpreter, value Value) Value {
+		convert: func(interpreter *Interpreter, value Value, _ func() LocationRange) Value {
 			return ConvertWord8(interpreter, value)
 		},
 		min: NewUnmeteredWord8Value(0),
@@ -2917,7 +3192,7 @@ var ConverterDeclarations = []ValueConverterDeclaration{
 	{
 		name:         sema.Word16TypeName,
 		functionType: sema.NumberConversionFunctionType(sema.Word16Type),
-		convert: func(interpreter *Interpreter, value Value) Value {
+		convert: func(interpreter *Interpreter, value Value, _ func() LocationRange) Value {
 			return ConvertWord16(interpreter, value)
 		},
 		min: NewUnmeteredWord16Value(0),
@@ -2926,7 +3201,7 @@ var ConverterDeclarations = []ValueConverterDeclaration{
 	{
 		name:         sema.Word32TypeName,
 		functionType: sema.NumberConversionFunctionType(sema.Word32Type),
-		convert: func(interpreter *Interpreter, value Value) Value {
+		convert: func(interpreter *Interpreter, value Value, _ func() LocationRange) Value {
 			return ConvertWord32(interpreter, value)
 		},
 		min: NewUnmeteredWord32Value(0),
@@ -2935,16 +3210,34 @@ var ConverterDeclarations = []ValueConverterDeclaration{
 	{
 		name:         sema.Word64TypeName,
 		functionType: sema.NumberConversionFunctionType(sema.Word64Type),
-		convert: func(interpreter *Interpreter, value Value) Value {
+		convert: func(interpreter *Interpreter, value Value, _ func() LocationRange) Value {
 			return ConvertWord64(interpreter, value)
 		},
 		min: NewUnmeteredWord64Value(0),
 		max: NewUnmeteredWord64Value(math.MaxUint64),
 	},
+	{
+		name:         sema.Word128TypeName,
+		functionType: sema.NumberConversionFunctionType(sema.Word128Type),
+		convert: func(interpreter *Interpreter, value Value, _ func() LocationRange) Value {
+			return ConvertWord128(interpreter, value)
+		},
+		min: NewUnmeteredWord128ValueFromUint64(0),
+		max: NewUnmeteredWord128ValueFromBigInt(sema.Word128TypeMaxIntBig),
+	},
+	{
+		name:         sema.Word256TypeName,
+		functionType: sema.NumberConversionFunctionType(sema.Word256Type),
+		convert: func(interpreter *Interpreter, value Value, _ func() LocationRange) Value {
+			return ConvertWord256(interpreter, value)
+		},
+		min: NewUnmeteredWord256ValueFromUint64(0),
+		max: NewUnmeteredWord256ValueFromBigInt(sema.Word256TypeMaxIntBig),
+	},
 	{
 		name:         sema.Fix64TypeName,
 		functionType: sema.NumberConversionFunctionType(sema.Fix64Type),
-		convert: func(interpreter *Interpreter, value Value) Value {
+		convert: func(interpreter *Interpreter, value Value, _ func() LocationRange) Value {
 			return ConvertFix64(interpreter, value)
 		},
 		min: NewUnmeteredFix64Value(math.MinInt64),
@@ -2953,33 +3246,71 @@ var ConverterDeclarations = []ValueConverterDeclaration{
 	{
 		name:         sema.UFix64TypeName,
 		functionType: sema.NumberConversionFunctionType(sema.UFix64Type),
-		convert: func(interpreter *Interpreter, value Value) Value {
+		convert: func(interpreter *Interpreter, value Value, _ func() LocationRange) Value {
 			return ConvertUFix64(interpreter, value)
 		},
 		min: NewUnmeteredUFix64Value(0),
 		max: NewUnmeteredUFix64Value(math.MaxUint64),
 	},
+	{
+		name:         sema.Fix128TypeName,
+		functionType: sema.NumberConversionFunctionType(sema.Fix128Type),
+		convert: func(interpreter *Interpreter, value Value, _ func() LocationRange) Value {
+			return ConvertFix128(interpreter, value)
+		},
+		min: NewUnmeteredFix128Value(sema.Fix128TypeMinBig),
+		max: NewUnmeteredFix128Value(sema.Fix128TypeMaxBig),
+	},
+	{
+		name:         sema.UFix128TypeName,
+		functionType: sema.NumberConversionFunctionType(sema.UFix128Type),
+		convert: func(interpreter *Interpreter, value Value, _ func() LocationRange) Value {
+			return ConvertUFix128(interpreter, value)
+		},
+		min: NewUnmeteredUFix128Value(sema.UFix128TypeMinBig),
+		max: NewUnmeteredUFix128Value(sema.UFix128TypeMaxBig),
+	},
 	{
 		name:         sema.AddressTypeName,
 		functionType: sema.AddressConversionFunctionType,
-		convert: func(interpreter *Interpreter, value Value) Value {
+		convert: func(interpreter *Interpreter, value Value, _ func() LocationRange) Value {
 			return ConvertAddress(interpreter, value)
 		},
 	},
+	{
+		name:         sema.TimestampTypeName,
+		functionType: sema.TimestampConversionFunctionType,
+		convert: func(interpreter *Interpreter, value Value, getLocationRange func() LocationRange) Value {
+			return ConvertTimestamp(interpreter, value, getLocationRange)
+		},
+	},
+	{
+		name:         sema.DurationTypeName,
+		functionType: sema.DurationConversionFunctionType,
+		convert: func(interpreter *Interpreter, value Value, _ func() LocationRange) Value {
+			return ConvertDuration(interpreter, value)
+		},
+	},
 	{
 		name:         sema.PublicPathType.Name,
 		functionType: sema.PublicPathConversionFunctionType,
-		convert:      ConvertPublicPath,
+		convert: func(interpreter *Interpreter, value Value, _ func() LocationRange) Value {
+			return ConvertPublicPath(interpreter, value)
+		},
 	},
 	{
 		name:         sema.PrivatePathType.Name,
 		functionType: sema.PrivatePathConversionFunctionType,
-		convert:      ConvertPrivatePath,
+		convert: func(interpreter *Interpreter, value Value, _ func() LocationRange) Value {
+			return ConvertPrivatePath(interpreter, value)
+		},
 	},
 	{
 		name:         sema.StoragePathType.Name,
 		functionType: sema.StoragePathConversionFunctionType,
-		convert:      ConvertStoragePath,
+		convert: func(interpreter *Interpreter, value Value, _ func() LocationRange) Value {
+			return ConvertStoragePath(interpreter, value)
+		},
 	},
 }
 
@@ -3292,7 +3623,11 @@ var converterFunctionValues = func() []converterFunction {
 		convert := declaration.convert
 		converterFunctionValue := NewUnmeteredHostFunctionValue(
 			func(invocation Invocation) Value {
-				return convert(invocation.Interpreter, invocation.Arguments[0])
+				return convert(
+					invocation.Interpreter,
+					invocation.Arguments[0],
+					invocation.GetLocationRange,
+				)
 			},
 			declaration.functionType,
 		)
@@ -3314,6 +3649,20 @@ var converterFunctionValues = func() []converterFunction {
 			addMember(sema.NumberTypeMaxFieldName, declaration.max)
 		}
 
+		switch numberType := declaration.functionType.ReturnTypeAnnotation.Type.(type) {
+		case *sema.NumericType, *sema.FixedPointNumericType:
+			addMember(
+				sema.NumberTypeFromStringFunctionName,
+				newNumberFromStringFunction(numberType),
+			)
+		}
+
+		if declaration.functionType == sema.DurationConversionFunctionType {
+			for _, constant := range sema.DurationConstants {
+				addMember(constant.Name, NewUnmeteredDurationValue(constant.Seconds))
+			}
+		}
+
 		converterFuncValues[index] = converterFunction{
 			name:      declaration.name,
 			converter: converterFunctionValue,
@@ -3323,6 +3672,30 @@ var converterFunctionValues = func() []converterFunction {
 	return converterFuncValues
 }()
 
+// newNumberFromStringFunction returns the `fromString` function for the given number type,
+// which is declared on the number type's conversion function.
+//
+func newNumberFromStringFunction(numberType sema.Type) *HostFunctionValue {
+	return NewUnmeteredHostFunctionValue(
+		func(invocation Invocation) Value {
+			argument, ok := invocation.Arguments[0].(*StringValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			inter := invocation.Interpreter
+
+			value, ok := inter.parseNumber(argument.Str, numberType)
+			if !ok {
+				return NewNilValue(inter)
+			}
+
+			return NewSomeValueNonCopying(inter, value)
+		},
+		sema.NumberTypeFromStringFunctionType(numberType),
+	)
+}
+
 func defineConverterFunctions(activation *VariableActivation) {
 	for _, converterFunc := range converterFunctionValues {
 		defineBaseValue(activation, converterFunc.name, converterFunc.converter)
@@ -3550,6 +3923,122 @@ var stringFunction = func() Value {
 		),
 	)
 
+	addMember(
+		sema.StringTypeJoinFunctionName,
+		NewUnmeteredHostFunctionValue(
+			func(invocation Invocation) Value {
+				stringArray, ok := invocation.Arguments[0].(*ArrayValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				separator, ok := invocation.Arguments[1].(*StringValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				inter := invocation.Interpreter
+
+				var parts []string
+				var length int
+				stringArray.Iterate(inter, func(element Value) (resume bool) {
+					str, ok := element.(*StringValue)
+					if !ok {
+						panic(errors.NewUnreachableError())
+					}
+					if len(parts) > 0 {
+						length = safeAdd(length, len(separator.Str))
+					}
+					length = safeAdd(length, len(str.Str))
+					parts = append(parts, str.Str)
+
+					// continue iteration
+					return true
+				})
+
+				return NewStringValue(
+					inter,
+					common.NewStringMemoryUsage(length),
+					func() string {
+						return strings.Join(parts, separator.Str)
+					},
+				)
+			},
+			sema.StringTypeJoinFunctionType,
+		),
+	)
+
+	addMember(
+		sema.StringTypeFromUTF8FunctionName,
+		NewUnmeteredHostFunctionValue(
+			func(invocation Invocation) Value {
+				argument, ok := invocation.Arguments[0].(*ArrayValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				inter := invocation.Interpreter
+
+				bytes, err := ByteArrayValueToByteSlice(inter, argument)
+				if err != nil {
+					panic(err)
+				}
+
+				if !utf8.Valid(bytes) {
+					return NewNilValue(inter)
+				}
+
+				str := NewStringValue(
+					inter,
+					common.NewStringMemoryUsage(len(bytes)),
+					func() string {
+						return string(bytes)
+					},
+				)
+
+				return NewSomeValueNonCopying(inter, str)
+			},
+			sema.StringTypeFromUTF8FunctionType,
+		),
+	)
+
+	addMember(
+		sema.StringTypeFromCharactersFunctionName,
+		NewUnmeteredHostFunctionValue(
+			func(invocation Invocation) Value {
+				characterArray, ok := invocation.Arguments[0].(*ArrayValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				inter := invocation.Interpreter
+
+				var characters []string
+				var length int
+				characterArray.Iterate(inter, func(element Value) (resume bool) {
+					character, ok := element.(CharacterValue)
+					if !ok {
+						panic(errors.NewUnreachableError())
+					}
+					length = safeAdd(length, len(character))
+					characters = append(characters, string(character))
+
+					// continue iteration
+					return true
+				})
+
+				return NewStringValue(
+					inter,
+					common.NewStringMemoryUsage(length),
+					func() string {
+						return strings.Join(characters, "")
+					},
+				)
+			},
+			sema.StringTypeFromCharactersFunctionType,
+		),
+	)
+
 	return functionValue
 }()
 
@@ -3581,6 +4070,8 @@ func (interpreter *Interpreter) IsSubTypeOfSemaType(subType StaticType, superTyp
 		return true
 	}
 
+	superType = interpreter.substituteTypeArguments(superType)
+
 	switch subType := subType.(type) {
 	case OptionalStaticType:
 		if superType, ok := superType.(*sema.OptionalType); ok {
@@ -3979,6 +4470,152 @@ func (interpreter *Interpreter) authAccountUnlinkFunction(addressValue AddressVa
 	)
 }
 
+func (interpreter *Interpreter) accountPaths(
+	addressValue AddressValue,
+	getLocationRange func() LocationRange,
+	domain common.PathDomain,
+	pathType StaticType,
+) *ArrayValue {
+
+	// Converted addresses can be cached and don't have to be recomputed on each function invocation
+	address := addressValue.ToAddress()
+
+	arrayType := NewVariableSizedStaticType(interpreter, pathType)
+
+	var values []Value
+
+	storageMap := interpreter.Storage.GetStorageMap(address, domain.Identifier(), false)
+	if storageMap != nil {
+		iterator := storageMap.Iterator(interpreter)
+		for {
+			identifier := iterator.NextKey()
+			if identifier == "" {
+				break
+			}
+
+			interpreter.ReportComputation(common.ComputationKindLoop, 1)
+
+			values = append(
+				values,
+				NewPathValue(interpreter, domain, identifier),
+			)
+		}
+	}
+
+	return NewArrayValue(
+		interpreter,
+		getLocationRange,
+		arrayType,
+		common.Address{},
+		values...,
+	)
+}
+
+func (interpreter *Interpreter) accountForEachFunction(
+	addressValue AddressValue,
+	domain common.PathDomain,
+	functionType *sema.FunctionType,
+) *HostFunctionValue {
+
+	// Converted addresses can be cached and don't have to be recomputed on each function invocation
+	address := addressValue.ToAddress()
+
+	iterationFunctionType, ok := functionType.Parameters[0].TypeAnnotation.Type.(*sema.FunctionType)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	argumentTypes := []sema.Type{
+		iterationFunctionType.Parameters[0].TypeAnnotation.Type,
+		iterationFunctionType.Parameters[1].TypeAnnotation.Type,
+	}
+
+	return NewHostFunctionValue(
+		interpreter,
+		func(invocation Invocation) Value {
+			inter := invocation.Interpreter
+			getLocationRange := invocation.GetLocationRange
+
+			function, ok := invocation.Arguments[0].(FunctionValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			storageMap := inter.Storage.GetStorageMap(address, domain.Identifier(), false)
+			if storageMap == nil {
+				// Nothing is stored in the domain, so there is nothing to iterate over
+				return NewVoidValue(inter)
+			}
+
+			iterationState := inter.storageIteration
+
+			wasInIteration := iterationState.inIteration
+			if !wasInIteration {
+				iterationState.mutatedDuringIteration = false
+			}
+			iterationState.inIteration = true
+			defer func() {
+				iterationState.inIteration = wasInIteration
+			}()
+
+			iterator := storageMap.Iterator(inter)
+
+			for {
+				identifier, value := iterator.Next()
+				if identifier == "" {
+					break
+				}
+
+				inter.ReportComputation(common.ComputationKindLoop, 1)
+
+				staticType := value.StaticType(inter)
+
+				// Links are stored in the public and private domains.
+				// The iteration function is called with the type of the capability for the link.
+
+				if link, ok := value.(LinkValue); ok {
+					staticType = NewCapabilityStaticType(inter, link.Type)
+				}
+
+				subInvocation := NewInvocation(
+					inter,
+					nil,
+					[]Value{
+						NewPathValue(inter, domain, identifier),
+						NewTypeValue(inter, staticType),
+					},
+					argumentTypes,
+					nil,
+					getLocationRange,
+				)
+
+				shouldContinue, ok := function.invoke(subInvocation).(BoolValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				if !shouldContinue {
+					break
+				}
+
+				// NOTE: Check for a mutation after the iteration function was invoked,
+				// and not before the next iteration starts:
+				// The mutation might have reorganized the storage map in such a way
+				// that the iterator is exhausted, in which case elements would be silently skipped.
+
+				if iterationState.mutatedDuringIteration {
+					panic(StorageMutatedDuringIterationError{
+						LocationRange: getLocationRange(),
+					})
+				}
+			}
+
+			return NewVoidValue(inter)
+		},
+		functionType,
+	)
+}
+
 func (interpreter *Interpreter) capabilityBorrowFunction(
 	addressValue AddressValue,
 	pathValue PathValue,
@@ -4272,9 +4909,18 @@ func (interpreter *Interpreter) getNativeCompositeType(qualifiedIdentifier strin
 	return ty, nil
 }
 
+func (interpreter *Interpreter) getNativeInterfaceType(qualifiedIdentifier string) (*sema.InterfaceType, error) {
+	ty := sema.NativeInterfaceTypes[qualifiedIdentifier]
+	if ty == nil {
+		return nil, InterfaceMissingLocationError{QualifiedIdentifier: qualifiedIdentifier}
+	}
+
+	return ty, nil
+}
+
 func (interpreter *Interpreter) getInterfaceType(location common.Location, qualifiedIdentifier string) (*sema.InterfaceType, error) {
 	if location == nil {
-		return nil, InterfaceMissingLocationError{QualifiedIdentifier: qualifiedIdentifier}
+		return interpreter.getNativeInterfaceType(qualifiedIdentifier)
 	}
 
 	typeID := location.TypeID(interpreter, qualifiedIdentifier)
@@ -4479,6 +5125,8 @@ func (interpreter *Interpreter) ValidateAtreeValue(value atree.Value) {
 			return info.Equal(other.(StaticType))
 		case DictionaryStaticType:
 			return info.Equal(other.(StaticType))
+		case SetStaticType:
+			return info.Equal(other.(StaticType))
 		case compositeTypeInfo:
 			return info.Equal(other)
 		case EmptyTypeInfo:
@@ -4599,9 +5247,12 @@ func (interpreter *Interpreter) updateReferencedResource(
 		return
 	}
 	for value := range values { //nolint:maprangecheck
+		interpreter.recordMutation(value)
 		updateFunc(value)
 	}
 	if newStorageID != currentStorageID {
+		interpreter.recordReferencedResourceKindedValuesMutation(currentStorageID)
+		interpreter.recordReferencedResourceKindedValuesMutation(newStorageID)
 		interpreter.referencedResourceKindedValues[newStorageID] = values
 		interpreter.referencedResourceKindedValues[currentStorageID] = nil
 	}
@@ -4644,6 +5295,7 @@ func (interpreter *Interpreter) startResourceTracking(
 		})
 	}
 
+	interpreter.recordResourceVariableMutation(resourceKindedValue)
 	interpreter.resourceVariables[resourceKindedValue] = variable
 }
 
@@ -4713,6 +5365,7 @@ func (interpreter *Interpreter) invalidateResource(value Value) {
 	}
 
 	// Remove the resource-to-variable mapping.
+	interpreter.recordResourceVariableMutation(resourceKindedValue)
 	delete(interpreter.resourceVariables, resourceKindedValue)
 }
 
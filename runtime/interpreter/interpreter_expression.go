@@ -70,6 +70,7 @@ func (interpreter *Interpreter) identifierExpressionGetterSetter(identifierExpre
 		},
 		set: func(value Value) {
 			interpreter.startResourceTracking(value, variable, identifier, identifierExpression)
+			interpreter.recordVariableMutation(variable)
 			variable.SetValue(value)
 		},
 	}
@@ -134,6 +135,14 @@ func (interpreter *Interpreter) memberExpressionGetterSetter(memberExpression *a
 	identifier := memberExpression.Identifier.Identifier
 	getLocationRange := locationRangeGetter(interpreter, interpreter.Location, memberExpression)
 
+	// Overloaded functions and functions of interfaces
+	// are accessed by their overload identifier
+
+	memberInfo := interpreter.Program.Elaboration.MemberExpressionMemberInfos[memberExpression]
+	if memberInfo.Member != nil && memberInfo.Member.OverloadIdentifier != "" {
+		identifier = memberInfo.Member.OverloadIdentifier
+	}
+
 	_, isNestedResourceMove := interpreter.Program.Elaboration.IsNestedResourceMoveExpression[memberExpression]
 
 	return getterSetter{
@@ -601,6 +610,12 @@ func (interpreter *Interpreter) NewIntegerValueFromBigInt(value *big.Int, intege
 	case sema.Word64Type:
 		common.UseMemory(memoryGauge, word64MemoryUsage)
 		return NewUnmeteredWord64Value(uint64(value.Int64()))
+	case sema.Word128Type:
+		common.UseMemory(memoryGauge, Word128MemoryUsage)
+		return NewUnmeteredWord128ValueFromBigInt(value)
+	case sema.Word256Type:
+		common.UseMemory(memoryGauge, Word256MemoryUsage)
+		return NewUnmeteredWord256ValueFromBigInt(value)
 
 	default:
 		panic(errors.NewUnreachableError())
@@ -608,10 +623,26 @@ func (interpreter *Interpreter) NewIntegerValueFromBigInt(value *big.Int, intege
 }
 
 func (interpreter *Interpreter) VisitFixedPointExpression(expression *ast.FixedPointExpression) ast.Repr {
-	// TODO: adjust once/if we support more fixed point types
-
 	fixedPointSubType := interpreter.Program.Elaboration.FixedPointExpression[expression]
 
+	switch fixedPointSubType {
+	case sema.Fix128Type, sema.UFix128Type:
+		value := fixedpoint.ConvertToFixedPointBigInt(
+			expression.Negative,
+			expression.UnsignedInteger,
+			expression.Fractional,
+			expression.Scale,
+			sema.Fix128Scale,
+		)
+		valueGetter := func() *big.Int {
+			return value
+		}
+		if fixedPointSubType == sema.Fix128Type {
+			return NewFix128Value(interpreter, valueGetter)
+		}
+		return NewUFix128Value(interpreter, valueGetter)
+	}
+
 	value := fixedpoint.ConvertToFixedPointBigInt(
 		expression.Negative,
 		expression.UnsignedInteger,
@@ -651,7 +682,16 @@ func (interpreter *Interpreter) VisitArrayExpression(expression *ast.ArrayExpres
 	values := interpreter.visitExpressionsNonCopying(expression.Values)
 
 	argumentTypes := interpreter.Program.Elaboration.ArrayExpressionArgumentTypes[expression]
+
+	// An array literal is a set literal if a set was expected
+
+	setType := interpreter.Program.Elaboration.ArrayExpressionSetType[expression]
+	if setType != nil {
+		return interpreter.visitSetExpression(expression, values, argumentTypes, setType)
+	}
+
 	arrayType := interpreter.Program.Elaboration.ArrayExpressionArrayType[expression]
+	arrayType = interpreter.substituteTypeArguments(arrayType).(sema.ArrayType)
 	elementType := arrayType.ElementType(false)
 
 	copies := make([]Value, len(values))
@@ -676,11 +716,40 @@ func (interpreter *Interpreter) VisitArrayExpression(expression *ast.ArrayExpres
 	)
 }
 
+func (interpreter *Interpreter) visitSetExpression(
+	expression *ast.ArrayExpression,
+	values []Value,
+	argumentTypes []sema.Type,
+	setType *sema.SetType,
+) Value {
+	elementType := setType.ElementType
+
+	copies := make([]Value, len(values))
+	for i, argument := range values {
+		argumentType := argumentTypes[i]
+		argumentExpression := expression.Values[i]
+		getLocationRange := locationRangeGetter(interpreter, interpreter.Location, argumentExpression)
+		copies[i] = interpreter.transferAndConvert(argument, argumentType, elementType, getLocationRange)
+	}
+
+	setStaticType := ConvertSemaSetTypeToStaticSetType(interpreter, setType)
+
+	getLocationRange := locationRangeGetter(interpreter, interpreter.Location, expression)
+
+	return NewSetValue(
+		interpreter,
+		getLocationRange,
+		setStaticType,
+		copies...,
+	)
+}
+
 func (interpreter *Interpreter) VisitDictionaryExpression(expression *ast.DictionaryExpression) ast.Repr {
 	values := interpreter.visitEntries(expression.Entries)
 
 	entryTypes := interpreter.Program.Elaboration.DictionaryExpressionEntryTypes[expression]
 	dictionaryType := interpreter.Program.Elaboration.DictionaryExpressionType[expression]
+	dictionaryType = interpreter.substituteTypeArguments(dictionaryType).(*sema.DictionaryType)
 
 	var keyValuePairs []Value
 
@@ -796,6 +865,24 @@ func (interpreter *Interpreter) VisitInvocationExpression(invocationExpression *
 	if !ok {
 		panic(errors.NewUnreachableError())
 	}
+
+	elaboration := interpreter.Program.Elaboration
+
+	// If the invoked function is a constructor with overloaded initializers,
+	// invoke the constructor for the initializer selected by the checker
+
+	if overloadIdentifier, ok := elaboration.InvocationExpressionOverloads[invocationExpression]; ok {
+		constructor, ok := function.(*HostFunctionValue)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		function, ok = constructor.Overloads[overloadIdentifier]
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+	}
+
 	// NOTE: evaluate all argument expressions in call-site scope, not in function body
 	argumentExpressions := make([]ast.Expression, len(invocationExpression.Arguments))
 	for i, argument := range invocationExpression.Arguments {
@@ -804,9 +891,9 @@ func (interpreter *Interpreter) VisitInvocationExpression(invocationExpression *
 
 	arguments := interpreter.visitExpressionsNonCopying(argumentExpressions)
 
-	elaboration := interpreter.Program.Elaboration
-
-	typeParameterTypes := elaboration.InvocationExpressionTypeArguments[invocationExpression]
+	typeParameterTypes := interpreter.substituteTypeParameterTypes(
+		elaboration.InvocationExpressionTypeArguments[invocationExpression],
+	)
 	argumentTypes := elaboration.InvocationExpressionArgumentTypes[invocationExpression]
 	parameterTypes := elaboration.InvocationExpressionParameterTypes[invocationExpression]
 
@@ -814,6 +901,10 @@ func (interpreter *Interpreter) VisitInvocationExpression(invocationExpression *
 
 	interpreter.reportFunctionInvocation(line)
 
+	// NOTE: report the return in a deferred call,
+	// so the report is not lost when the invocation fails and the failure is caught
+	defer interpreter.reportInvokedFunctionReturn(line)
+
 	resultValue := interpreter.invokeFunctionValue(
 		function,
 		arguments,
@@ -824,8 +915,6 @@ func (interpreter *Interpreter) VisitInvocationExpression(invocationExpression *
 		invocationExpression,
 	)
 
-	interpreter.reportInvokedFunctionReturn(line)
-
 	// If this is invocation is optional chaining, wrap the result
 	// as an optional, as the result is expected to be an optional
 	if isOptionalChaining {
@@ -871,6 +960,7 @@ func (interpreter *Interpreter) VisitFunctionExpression(expression *ast.Function
 	lexicalScope := interpreter.activations.CurrentOrNew()
 
 	functionType := interpreter.Program.Elaboration.FunctionExpressionFunctionType[expression]
+	functionType = interpreter.substituteTypeArguments(functionType).(*sema.FunctionType)
 
 	var preConditions ast.Conditions
 	if expression.FunctionBlock.PreConditions != nil {
@@ -908,6 +998,7 @@ func (interpreter *Interpreter) VisitCastingExpression(expression *ast.CastingEx
 	getLocationRange := locationRangeGetter(interpreter, interpreter.Location, expression.Expression)
 
 	expectedType := interpreter.Program.Elaboration.CastingTargetTypes[expression]
+	expectedType = interpreter.substituteTypeArguments(expectedType)
 
 	switch expression.Operation {
 	case ast.OperationFailableCast, ast.OperationForceCast:
@@ -916,7 +1007,23 @@ func (interpreter *Interpreter) VisitCastingExpression(expression *ast.CastingEx
 		switch expression.Operation {
 		case ast.OperationFailableCast:
 			if !isSubType {
-				return NewNilValue(interpreter)
+				// The value might still be convertible to the target type,
+				// e.g. an integer which fits into a smaller integer type.
+				// Only values which are statically known to be numbers,
+				// or containers of numbers, are converted
+
+				staticValueType := interpreter.Program.Elaboration.CastingStaticValueTypes[expression]
+				staticValueType = interpreter.substituteTypeArguments(staticValueType)
+
+				if !isFailableConvertibleType(staticValueType) {
+					return NewNilValue(interpreter)
+				}
+
+				var ok bool
+				value, ok = interpreter.failableConvert(getLocationRange, value, expectedType)
+				if !ok {
+					return NewNilValue(interpreter)
+				}
 			}
 
 			// The failable cast may upcast to an optional type, e.g. `1 as? Int?`, so box
@@ -969,6 +1076,7 @@ func (interpreter *Interpreter) VisitDestroyExpression(expression *ast.DestroyEx
 func (interpreter *Interpreter) VisitReferenceExpression(referenceExpression *ast.ReferenceExpression) ast.Repr {
 
 	borrowType := interpreter.Program.Elaboration.ReferenceExpressionBorrowTypes[referenceExpression]
+	borrowType = interpreter.substituteTypeArguments(borrowType)
 
 	result := interpreter.evalExpression(referenceExpression.Expression)
 
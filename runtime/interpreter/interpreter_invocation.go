@@ -22,6 +22,7 @@ import (
 	"github.com/onflow/atree"
 
 	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/errors"
 	"github.com/onflow/cadence/runtime/sema"
 )
 
@@ -128,9 +129,73 @@ func (interpreter *Interpreter) invokeInterpretedFunction(
 		interpreter.declareVariable(sema.SelfIdentifier, invocation.Self)
 	}
 
+	interpreter.bindTypeArguments(function.Type, invocation)
+
 	return interpreter.invokeInterpretedFunctionActivated(function, invocation.Arguments)
 }
 
+// compositeTypeArguments returns the type arguments of the given
+// instance of a generic composite, keyed by the type parameters of the composite
+//
+func (interpreter *Interpreter) compositeTypeArguments(value *CompositeValue) *sema.TypeParameterTypeOrderedMap {
+	typeArguments := &sema.TypeParameterTypeOrderedMap{}
+
+	compositeType, ok := interpreter.MustConvertStaticToSemaType(value.StaticType(interpreter)).(*sema.CompositeType)
+	if !ok {
+		return typeArguments
+	}
+
+	compositeTypeArguments := compositeType.TypeArguments()
+	for i, typeParameter := range compositeType.TypeParameters() {
+		typeArguments.Set(typeParameter, compositeTypeArguments[i])
+	}
+
+	return typeArguments
+}
+
+// bindTypeArguments binds the type arguments of the invocation
+// to the type parameters of the invoked generic function in the current activation.
+// If the function is a member of an instantiated generic composite,
+// e.g. `Box<Int>`, the type arguments of the composite are bound as well
+//
+func (interpreter *Interpreter) bindTypeArguments(functionType *sema.FunctionType, invocation Invocation) {
+
+	activation := interpreter.activations.Current()
+
+	var typeArguments *sema.TypeParameterTypeOrderedMap
+
+	bind := func(typeParameter *sema.TypeParameter, ty sema.Type) {
+		// Copy the inherited type arguments on first write,
+		// the parent activation must not observe the binding
+		if typeArguments == nil {
+			typeArguments = &sema.TypeParameterTypeOrderedMap{}
+			if activation.typeArguments != nil {
+				activation.typeArguments.Foreach(func(typeParameter *sema.TypeParameter, ty sema.Type) {
+					typeArguments.Set(typeParameter, ty)
+				})
+			}
+		}
+		typeArguments.Set(typeParameter, ty)
+	}
+
+	if self, ok := invocation.Self.(*CompositeValue); ok && len(self.TypeArguments) > 0 {
+		interpreter.compositeTypeArguments(self).Foreach(bind)
+	}
+
+	if functionType != nil && invocation.TypeParameterTypes != nil {
+		for _, typeParameter := range functionType.TypeParameters {
+			ty, ok := invocation.TypeParameterTypes.Get(typeParameter)
+			if ok {
+				bind(typeParameter, ty)
+			}
+		}
+	}
+
+	if typeArguments != nil {
+		activation.typeArguments = typeArguments
+	}
+}
+
 // NOTE: assumes the function's activation (or an extension of it) is pushed!
 //
 func (interpreter *Interpreter) invokeInterpretedFunctionActivated(
@@ -161,14 +226,43 @@ func (interpreter *Interpreter) invokeInterpretedFunctionActivated(
 	)
 }
 
-// bindParameterArguments binds the argument values to the given parameters
+// bindParameterArguments binds the argument values to the given parameters.
+// Parameters for which no argument was provided are bound to their default argument
 //
 func (interpreter *Interpreter) bindParameterArguments(
 	parameterList *ast.ParameterList,
 	arguments []Value,
 ) {
+	argumentCount := len(arguments)
+
 	for parameterIndex, parameter := range parameterList.Parameters {
-		argument := arguments[parameterIndex]
+		var argument Value
+		if parameterIndex < argumentCount {
+			argument = arguments[parameterIndex]
+		} else {
+			argument = interpreter.evalDefaultArgument(parameter)
+		}
 		interpreter.declareVariable(parameter.Identifier.Identifier, argument)
 	}
 }
+
+// evalDefaultArgument evaluates the default argument of the given parameter
+// in the current activation, i.e. in the scope of the invoked function,
+// where `self` and the preceding parameters are already declared
+//
+func (interpreter *Interpreter) evalDefaultArgument(parameter *ast.Parameter) Value {
+	defaultArgument := parameter.DefaultArgument
+	if defaultArgument == nil {
+		panic(errors.NewUnreachableError())
+	}
+
+	elaboration := interpreter.Program.Elaboration
+	valueType := elaboration.DefaultArgumentValueTypes[parameter]
+	parameterType := elaboration.DefaultArgumentParameterTypes[parameter]
+
+	value := interpreter.evalExpression(defaultArgument)
+
+	getLocationRange := locationRangeGetter(interpreter, interpreter.Location, defaultArgument)
+
+	return interpreter.transferAndConvert(value, valueType, parameterType, getLocationRange)
+}
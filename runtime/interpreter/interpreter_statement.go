@@ -24,6 +24,7 @@ import (
 	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/errors"
+	"github.com/onflow/cadence/runtime/sema"
 )
 
 func (interpreter *Interpreter) evalStatement(statement ast.Statement) any {
@@ -201,10 +202,7 @@ func (interpreter *Interpreter) visitIfStatementWithVariableDeclaration(
 
 func (interpreter *Interpreter) VisitSwitchStatement(switchStatement *ast.SwitchStatement) ast.Repr {
 
-	testValue, ok := interpreter.evalExpression(switchStatement.Expression).(EquatableValue)
-	if !ok {
-		panic(errors.NewUnreachableError())
-	}
+	testValue := interpreter.evalExpression(switchStatement.Expression)
 
 	for _, switchCase := range switchStatement.Cases {
 
@@ -226,16 +224,44 @@ func (interpreter *Interpreter) VisitSwitchStatement(switchStatement *ast.Switch
 			return result
 		}
 
-		// If the case has no expression it is the default case.
+		// If the case has no expression and no pattern, it is the default case.
 		// Evaluate it, i.e. all statements
 
-		if switchCase.Expression == nil {
+		if switchCase.IsDefault() {
 			return runStatements()
 		}
 
+		// If the case has a pattern, match the test value against it,
+		// and declare the constants bound by the pattern for the guard and the statements
+
+		if switchCase.Pattern != nil {
+			getLocationRange := locationRangeGetter(interpreter, interpreter.Location, switchCase.Pattern)
+
+			if !interpreter.matchesPattern(switchCase.Pattern, testValue, getLocationRange) {
+				continue
+			}
+
+			result, matched := interpreter.visitSwitchCaseWithPattern(
+				switchCase,
+				testValue,
+				getLocationRange,
+				runStatements,
+			)
+			if !matched {
+				continue
+			}
+
+			return result
+		}
+
 		// The case has an expression.
 		// Evaluate it and compare it to the test value
 
+		equatableTestValue, ok := testValue.(EquatableValue)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
 		result := interpreter.evalExpression(switchCase.Expression)
 
 		caseValue, ok := result.(EquatableValue)
@@ -245,11 +271,13 @@ func (interpreter *Interpreter) VisitSwitchStatement(switchStatement *ast.Switch
 		}
 
 		// If the test value and case values are equal,
-		// evaluate the case's statements
+		// and the guard holds, evaluate the case's statements
 
 		getLocationRange := locationRangeGetter(interpreter, interpreter.Location, switchCase.Expression)
 
-		if testValue.Equal(interpreter, getLocationRange, caseValue) {
+		if equatableTestValue.Equal(interpreter, getLocationRange, caseValue) &&
+			interpreter.evalSwitchCaseGuard(switchCase) {
+
 			return runStatements()
 		}
 
@@ -260,6 +288,55 @@ func (interpreter *Interpreter) VisitSwitchStatement(switchStatement *ast.Switch
 	return nil
 }
 
+// visitSwitchCaseWithPattern declares the constants bound by the pattern of the given case,
+// and evaluates the case's statements if the guard of the case holds.
+// It returns false if the guard does not hold
+//
+func (interpreter *Interpreter) visitSwitchCaseWithPattern(
+	switchCase *ast.SwitchCase,
+	testValue Value,
+	getLocationRange func() LocationRange,
+	runStatements func() ast.Repr,
+) (ast.Repr, bool) {
+
+	interpreter.activations.PushNewWithCurrent()
+	defer interpreter.activations.Pop()
+
+	// NOTE: copy the value, as it might be matched by other cases,
+	// if the guard does not hold
+
+	testType := interpreter.Program.Elaboration.PatternTypes[switchCase.Pattern]
+	value := interpreter.transferAndConvert(testValue, testType, testType, getLocationRange)
+
+	interpreter.destructure(
+		switchCase.Pattern,
+		value,
+		getLocationRange,
+		func(identifier string, value Value) {
+			interpreter.declareVariable(identifier, value)
+		},
+	)
+
+	if !interpreter.evalSwitchCaseGuard(switchCase) {
+		return nil, false
+	}
+
+	return runStatements(), true
+}
+
+func (interpreter *Interpreter) evalSwitchCaseGuard(switchCase *ast.SwitchCase) bool {
+	if switchCase.Guard == nil {
+		return true
+	}
+
+	result, ok := interpreter.evalExpression(switchCase.Guard).(BoolValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	return bool(result)
+}
+
 func (interpreter *Interpreter) VisitWhileStatement(statement *ast.WhileStatement) ast.Repr {
 
 	for {
@@ -378,18 +455,129 @@ func (interpreter *Interpreter) VisitEmitStatement(statement *ast.EmitStatement)
 		})
 	}
 
-	err := interpreter.onEventEmitted(interpreter, getLocationRange, event, eventType)
-	if err != nil {
-		panic(err)
-	}
+	// NOTE: events emitted in a try block are only emitted
+	// if the effects of the try block are committed
+
+	interpreter.RunWhenCommitted(func() {
+		err := interpreter.onEventEmitted(interpreter, getLocationRange, event, eventType)
+		if err != nil {
+			panic(err)
+		}
+	})
 
 	return nil
 }
 
+// VisitTryStatement evaluates the try block.
+// If the evaluation fails with an error that can be handled,
+// all effects of the try block are reverted,
+// and the catch block is evaluated, with the error message bound to the error identifier, if any.
+//
+func (interpreter *Interpreter) VisitTryStatement(statement *ast.TryStatement) ast.Repr {
+
+	result, err := interpreter.visitRevertibleBlock(statement.Block)
+	if err == nil {
+		return result
+	}
+
+	interpreter.activations.PushNewWithCurrent()
+	defer interpreter.activations.Pop()
+
+	errorIdentifier := statement.ErrorIdentifier
+	if errorIdentifier != nil {
+		message := err.Error()
+
+		interpreter.declareVariable(
+			errorIdentifier.Identifier,
+			NewStringValue(
+				interpreter,
+				common.NewStringMemoryUsage(len(message)),
+				func() string {
+					return message
+				},
+			),
+		)
+	}
+
+	return statement.CatchBlock.Accept(interpreter)
+}
+
+// visitRevertibleBlock evaluates the given block in a new revertible context.
+//
+// If the evaluation succeeds, the effects of the block are committed, and the result is returned.
+// If the evaluation fails, all effects of the block are reverted, i.e. storage writes,
+// mutations of values and variables, and delayed side effects like the emission of events.
+// If the error can be handled, it is returned, otherwise it is propagated.
+//
+func (interpreter *Interpreter) visitRevertibleBlock(block *ast.Block) (result ast.Repr, err error) {
+
+	// NOTE: the call stack is not unwound when an invocation fails,
+	// so it must be restored to its current depth when an error is handled
+
+	callStackDepth := len(interpreter.CallStack.Invocations)
+
+	interpreter.revertibleContexts.push()
+	interpreter.Storage.Checkpoint()
+
+	committed := false
+
+	defer func() {
+		if committed {
+			return
+		}
+
+		r := recover()
+
+		revertErr := interpreter.Storage.RevertCheckpoint()
+		interpreter.revertibleContexts.revert()
+		interpreter.CallStack.Invocations = interpreter.CallStack.Invocations[:callStackDepth]
+
+		recoveredErr, ok := r.(error)
+		if !ok || !isHandleableError(recoveredErr) {
+			panic(r)
+		}
+
+		if revertErr != nil {
+			panic(errors.NewExternalError(revertErr))
+		}
+
+		err = recoveredErr
+	}()
+
+	result = block.Accept(interpreter)
+
+	committed = true
+
+	interpreter.Storage.CommitCheckpoint()
+	interpreter.revertibleContexts.commit()
+
+	return result, nil
+}
+
+// isHandleableError returns true if the given error can be handled by a try statement.
+// Only user errors can be handled, and only if they are not caused by reaching a limit.
+//
+func isHandleableError(err error) bool {
+	if !errors.IsUserError(err) ||
+		errors.IsInternalError(err) ||
+		errors.IsLimitError(err) {
+
+		return false
+	}
+
+	_, ok := errors.GetExternalError(err)
+	return !ok
+}
+
 func (interpreter *Interpreter) VisitPragmaDeclaration(_ *ast.PragmaDeclaration) ast.Repr {
 	return nil
 }
 
+func (interpreter *Interpreter) VisitTypeAliasDeclaration(_ *ast.TypeAliasDeclaration) ast.Repr {
+	// NO-OP: type aliases are fully resolved by the checker
+	return nil
+}
+
 // VisitVariableDeclaration first visits the declaration's value,
 // then declares the variable with the name bound to the value
 func (interpreter *Interpreter) VisitVariableDeclaration(declaration *ast.VariableDeclaration) ast.Repr {
@@ -445,10 +633,19 @@ func (interpreter *Interpreter) visitVariableDeclaration(
 
 	transferredValue := interpreter.transferAndConvert(result, valueType, targetType, getLocationRange)
 
-	valueCallback(
-		declaration.Identifier.Identifier,
-		transferredValue,
-	)
+	if declaration.Pattern != nil {
+		interpreter.destructure(
+			declaration.Pattern,
+			transferredValue,
+			getLocationRange,
+			valueCallback,
+		)
+	} else {
+		valueCallback(
+			declaration.Identifier.Identifier,
+			transferredValue,
+		)
+	}
 
 	if declaration.SecondValue == nil {
 		return
@@ -464,6 +661,281 @@ func (interpreter *Interpreter) visitVariableDeclaration(
 	)
 }
 
+// destructure binds the parts of the given value which are matched by the given pattern.
+//
+// Resources are moved out of the destructured value,
+// which is then destroyed, as the checker ensured it has no destructor
+// and all its nested resources are bound.
+// Other values are copied.
+//
+func (interpreter *Interpreter) destructure(
+	pattern ast.Pattern,
+	value Value,
+	getLocationRange func() LocationRange,
+	bind func(identifier string, value Value),
+) {
+	switch pattern := pattern.(type) {
+	case *ast.IdentifierPattern:
+		if pattern.IsBlank() {
+			return
+		}
+
+		bind(pattern.Identifier.Identifier, value)
+
+	case *ast.ArrayPattern:
+		interpreter.destructureArray(pattern, value, getLocationRange, bind)
+
+	case *ast.DictionaryPattern:
+		interpreter.destructureDictionary(pattern, value, getLocationRange, bind)
+
+	case *ast.CompositePattern:
+		interpreter.destructureComposite(pattern, value, getLocationRange, bind)
+
+	case *ast.OptionalPattern:
+		someValue, ok := value.(*SomeValue)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		innerValue := someValue.InnerValue(interpreter, getLocationRange)
+		interpreter.destructure(pattern.Pattern, innerValue, getLocationRange, bind)
+
+	case *ast.TypePattern:
+		// The type pattern may upcast to an optional type, e.g. `x as Int?`, so box
+
+		targetType := interpreter.Program.Elaboration.TypePatternTargetTypes[pattern]
+		value = interpreter.BoxOptional(getLocationRange, value, targetType)
+		interpreter.destructure(pattern.Pattern, value, getLocationRange, bind)
+
+	default:
+		panic(errors.NewUnreachableError())
+	}
+}
+
+// matchesPattern returns true if the given value matches the given pattern of a switch case.
+// The value is not modified, so it can still be matched against the patterns of other cases
+//
+func (interpreter *Interpreter) matchesPattern(
+	pattern ast.Pattern,
+	value Value,
+	getLocationRange func() LocationRange,
+) bool {
+	switch pattern := pattern.(type) {
+	case *ast.IdentifierPattern,
+		*ast.DictionaryPattern:
+
+		// Dictionary patterns match optionals,
+		// so they also match dictionaries which do not contain the keys
+
+		return true
+
+	case *ast.ArrayPattern:
+		array, ok := value.(*ArrayValue)
+		if !ok || array.Count() != len(pattern.Elements) {
+			return false
+		}
+
+		for i, element := range pattern.Elements {
+			elementValue := array.Get(interpreter, getLocationRange, i)
+			if !interpreter.matchesPattern(element, elementValue, getLocationRange) {
+				return false
+			}
+		}
+
+		return true
+
+	case *ast.CompositePattern:
+		compositeType, ok := interpreter.Program.Elaboration.PatternTypes[pattern].(*sema.CompositeType)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		memberAccessibleValue, ok := value.(MemberAccessibleValue)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		var fieldNames []string
+		if !pattern.IsLabeled() {
+			fieldNames = compositeType.DeclaredFields()
+		}
+
+		for i, element := range pattern.Elements {
+			var fieldName string
+			if element.Label != nil {
+				fieldName = element.Label.Identifier
+			} else {
+				fieldName = fieldNames[i]
+			}
+
+			fieldValue := memberAccessibleValue.GetMember(interpreter, getLocationRange, fieldName)
+			if !interpreter.matchesPattern(element.Pattern, fieldValue, getLocationRange) {
+				return false
+			}
+		}
+
+		return true
+
+	case *ast.OptionalPattern:
+		someValue, ok := value.(*SomeValue)
+		if !ok {
+			return false
+		}
+
+		innerValue := someValue.InnerValue(interpreter, getLocationRange)
+		return interpreter.matchesPattern(pattern.Pattern, innerValue, getLocationRange)
+
+	case *ast.TypePattern:
+		targetType := interpreter.Program.Elaboration.TypePatternTargetTypes[pattern]
+		if !interpreter.IsSubTypeOfSemaType(value.StaticType(interpreter), targetType) {
+			return false
+		}
+
+		value = interpreter.BoxOptional(getLocationRange, value, targetType)
+		return interpreter.matchesPattern(pattern.Pattern, value, getLocationRange)
+
+	default:
+		panic(errors.NewUnreachableError())
+	}
+}
+
+func (interpreter *Interpreter) destructureArray(
+	pattern *ast.ArrayPattern,
+	value Value,
+	getLocationRange func() LocationRange,
+	bind func(identifier string, value Value),
+) {
+	array, ok := value.(*ArrayValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	elementCount := len(pattern.Elements)
+	arrayCount := array.Count()
+	if elementCount != arrayCount {
+		panic(ArrayPatternLengthMismatchError{
+			ExpectedLength: elementCount,
+			ActualLength:   arrayCount,
+			LocationRange:  getLocationRange(),
+		})
+	}
+
+	isResourceKinded := array.IsResourceKinded(interpreter)
+
+	for i, element := range pattern.Elements {
+		var elementValue Value
+		if isResourceKinded {
+			// Elements are removed from the front,
+			// so the next element is always the first
+			elementValue = array.RemoveFirst(interpreter, getLocationRange)
+		} else {
+			elementType := interpreter.Program.Elaboration.PatternTypes[element]
+			elementValue = interpreter.transferAndConvert(
+				array.Get(interpreter, getLocationRange, i),
+				elementType,
+				elementType,
+				getLocationRange,
+			)
+		}
+
+		interpreter.destructure(element, elementValue, getLocationRange, bind)
+	}
+
+	if isResourceKinded {
+		array.Destroy(interpreter, getLocationRange)
+	}
+}
+
+func (interpreter *Interpreter) destructureDictionary(
+	pattern *ast.DictionaryPattern,
+	value Value,
+	getLocationRange func() LocationRange,
+	bind func(identifier string, value Value),
+) {
+	dictionary, ok := value.(*DictionaryValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	for _, entry := range pattern.Entries {
+		keyValue := interpreter.evalExpression(entry.Key)
+
+		elementType := interpreter.Program.Elaboration.PatternTypes[entry.Value]
+		elementValue := interpreter.transferAndConvert(
+			dictionary.GetKey(interpreter, getLocationRange, keyValue),
+			elementType,
+			elementType,
+			getLocationRange,
+		)
+
+		interpreter.destructure(entry.Value, elementValue, getLocationRange, bind)
+	}
+}
+
+func (interpreter *Interpreter) destructureComposite(
+	pattern *ast.CompositePattern,
+	value Value,
+	getLocationRange func() LocationRange,
+	bind func(identifier string, value Value),
+) {
+	compositeType, ok := interpreter.Program.Elaboration.PatternTypes[pattern].(*sema.CompositeType)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	isResourceKinded := compositeType.Kind == common.CompositeKindResource
+
+	var fieldNames []string
+	if !pattern.IsLabeled() {
+		fieldNames = compositeType.DeclaredFields()
+	}
+
+	for i, element := range pattern.Elements {
+		var fieldName string
+		if element.Label != nil {
+			fieldName = element.Label.Identifier
+		} else {
+			fieldName = fieldNames[i]
+		}
+
+		var fieldValue Value
+		if isResourceKinded {
+			composite, ok := value.(*CompositeValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			fieldValue = composite.RemoveMember(interpreter, getLocationRange, fieldName)
+		} else {
+			memberAccessibleValue, ok := value.(MemberAccessibleValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			fieldType := interpreter.Program.Elaboration.PatternTypes[element.Pattern]
+			fieldValue = interpreter.transferAndConvert(
+				memberAccessibleValue.GetMember(interpreter, getLocationRange, fieldName),
+				fieldType,
+				fieldType,
+				getLocationRange,
+			)
+		}
+
+		if fieldValue == nil {
+			panic(errors.NewUnreachableError())
+		}
+
+		interpreter.destructure(element.Pattern, fieldValue, getLocationRange, bind)
+	}
+
+	// All resource fields were moved out of the resource,
+	// so it is consumed without calling its destructor
+
+	if isResourceKinded {
+		value.(*CompositeValue).destroy(interpreter, getLocationRange, false)
+	}
+}
+
 func (interpreter *Interpreter) VisitAssignmentStatement(assignment *ast.AssignmentStatement) ast.Repr {
 	targetType := interpreter.Program.Elaboration.AssignmentStatementTargetTypes[assignment]
 	valueType := interpreter.Program.Elaboration.AssignmentStatementValueTypes[assignment]
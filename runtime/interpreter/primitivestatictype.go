@@ -94,8 +94,8 @@ const (
 	PrimitiveStaticTypeCharacter
 	PrimitiveStaticTypeMetaType
 	PrimitiveStaticTypeBlock
-	_
-	_
+	PrimitiveStaticTypeTimestamp
+	PrimitiveStaticTypeDuration
 	_
 	_
 	_
@@ -151,8 +151,8 @@ const (
 	PrimitiveStaticTypeWord16
 	PrimitiveStaticTypeWord32
 	PrimitiveStaticTypeWord64
-	_ // future: Word128
-	_ // future: Word256
+	PrimitiveStaticTypeWord128
+	PrimitiveStaticTypeWord256
 	_
 
 	// Fix*
@@ -161,7 +161,7 @@ const (
 	_ // future: Fix16
 	_ // future: Fix32
 	PrimitiveStaticTypeFix64
-	_ // future: Fix128
+	PrimitiveStaticTypeFix128
 	_ // future: Fix256
 	_
 
@@ -171,7 +171,7 @@ const (
 	_ // future: UFix16
 	_ // future: UFix32
 	PrimitiveStaticTypeUFix64
-	_ // future: UFix128
+	PrimitiveStaticTypeUFix128
 	_ // future: UFix256
 	_
 
@@ -229,21 +229,23 @@ func (t PrimitiveStaticType) elementSize() uint {
 		PrimitiveStaticTypeBlock:
 		return UnknownElementSize
 
-	case PrimitiveStaticTypeFixedPoint,
-		PrimitiveStaticTypeSignedFixedPoint:
-		return cborTagSize + 8
-
 	// values of these types may wrap big.Int
 	case PrimitiveStaticTypeInt,
 		PrimitiveStaticTypeUInt,
 		PrimitiveStaticTypeUInt128,
 		PrimitiveStaticTypeUInt256,
+		PrimitiveStaticTypeWord128,
+		PrimitiveStaticTypeWord256,
 		PrimitiveStaticTypeInt128,
 		PrimitiveStaticTypeInt256,
 		PrimitiveStaticTypeInteger,
 		PrimitiveStaticTypeSignedInteger,
 		PrimitiveStaticTypeNumber,
-		PrimitiveStaticTypeSignedNumber:
+		PrimitiveStaticTypeSignedNumber,
+		PrimitiveStaticTypeFixedPoint,
+		PrimitiveStaticTypeSignedFixedPoint,
+		PrimitiveStaticTypeFix128,
+		PrimitiveStaticTypeUFix128:
 		return UnknownElementSize
 
 	case PrimitiveStaticTypeInt8,
@@ -262,7 +264,9 @@ func (t PrimitiveStaticType) elementSize() uint {
 		PrimitiveStaticTypeUInt64,
 		PrimitiveStaticTypeWord64,
 		PrimitiveStaticTypeFix64,
-		PrimitiveStaticTypeUFix64:
+		PrimitiveStaticTypeUFix64,
+		PrimitiveStaticTypeTimestamp,
+		PrimitiveStaticTypeDuration:
 		return cborTagSize + 9
 
 	case PrimitiveStaticTypePath,
@@ -318,6 +322,10 @@ func (i PrimitiveStaticType) SemaType() sema.Type {
 
 	case PrimitiveStaticTypeBlock:
 		return sema.BlockType
+	case PrimitiveStaticTypeTimestamp:
+		return sema.TimestampType
+	case PrimitiveStaticTypeDuration:
+		return sema.DurationType
 
 	// Number
 
@@ -380,14 +388,22 @@ func (i PrimitiveStaticType) SemaType() sema.Type {
 		return sema.Word32Type
 	case PrimitiveStaticTypeWord64:
 		return sema.Word64Type
+	case PrimitiveStaticTypeWord128:
+		return sema.Word128Type
+	case PrimitiveStaticTypeWord256:
+		return sema.Word256Type
 
 	// Fix*
 	case PrimitiveStaticTypeFix64:
 		return sema.Fix64Type
+	case PrimitiveStaticTypeFix128:
+		return sema.Fix128Type
 
 	// UFix*
 	case PrimitiveStaticTypeUFix64:
 		return sema.UFix64Type
+	case PrimitiveStaticTypeUFix128:
+		return sema.UFix128Type
 
 	// Storage
 
@@ -494,14 +510,22 @@ func ConvertSemaToPrimitiveStaticType(
 		typ = PrimitiveStaticTypeWord32
 	case sema.Word64Type:
 		typ = PrimitiveStaticTypeWord64
+	case sema.Word128Type:
+		typ = PrimitiveStaticTypeWord128
+	case sema.Word256Type:
+		typ = PrimitiveStaticTypeWord256
 
 	// Fix*
 	case sema.Fix64Type:
 		typ = PrimitiveStaticTypeFix64
+	case sema.Fix128Type:
+		typ = PrimitiveStaticTypeFix128
 
 	// UFix*
 	case sema.UFix64Type:
 		typ = PrimitiveStaticTypeUFix64
+	case sema.UFix128Type:
+		typ = PrimitiveStaticTypeUFix128
 
 	case sema.PathType:
 		typ = PrimitiveStaticTypePath
@@ -535,6 +559,10 @@ func ConvertSemaToPrimitiveStaticType(
 		typ = PrimitiveStaticTypePublicAccount
 	case sema.BlockType:
 		typ = PrimitiveStaticTypeBlock
+	case sema.TimestampType:
+		typ = PrimitiveStaticTypeTimestamp
+	case sema.DurationType:
+		typ = PrimitiveStaticTypeDuration
 	case sema.DeployedContractType:
 		typ = PrimitiveStaticTypeDeployedContract
 	case sema.AuthAccountContractsType:
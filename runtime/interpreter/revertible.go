@@ -0,0 +1,324 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"github.com/onflow/atree"
+
+	"github.com/onflow/cadence/runtime/errors"
+)
+
+// revertibleContext records the state needed to revert the effects
+// of the execution of a try block.
+//
+// The state of objects is recorded before they are first mutated in the context,
+// so the objects can be restored when the context is reverted.
+// Side effects which cannot be restored, like the emission of events,
+// are delayed until the outermost context is committed.
+//
+type revertibleContext struct {
+	recorded  map[any]struct{}
+	restores  []revertibleRestore
+	onCommits []func()
+}
+
+type revertibleRestore struct {
+	key     any
+	restore func()
+}
+
+func newRevertibleContext() *revertibleContext {
+	return &revertibleContext{
+		recorded: map[any]struct{}{},
+	}
+}
+
+// record records the given restore function for the object with the given key,
+// if the object was not recorded yet.
+// The restore function is only constructed if needed.
+//
+func (c *revertibleContext) record(key any, newRestore func() func()) {
+	if _, ok := c.recorded[key]; ok {
+		return
+	}
+	c.recorded[key] = struct{}{}
+	c.restores = append(
+		c.restores,
+		revertibleRestore{
+			key:     key,
+			restore: newRestore(),
+		},
+	)
+}
+
+// recordState records the current state of the object the given pointer points to.
+//
+func recordState[T any](context *revertibleContext, state *T) {
+	context.record(state, func() func() {
+		original := *state
+		return func() {
+			*state = original
+		}
+	})
+}
+
+// revertibleContexts is the stack of active revertible contexts.
+// It is shared by all interpreters of an execution.
+//
+type revertibleContexts struct {
+	stack []*revertibleContext
+}
+
+func (c *revertibleContexts) current() *revertibleContext {
+	count := len(c.stack)
+	if count == 0 {
+		return nil
+	}
+	return c.stack[count-1]
+}
+
+func (c *revertibleContexts) push() {
+	c.stack = append(c.stack, newRevertibleContext())
+}
+
+func (c *revertibleContexts) pop() *revertibleContext {
+	count := len(c.stack)
+	context := c.stack[count-1]
+	c.stack = c.stack[:count-1]
+	return context
+}
+
+// commit ends the current context and keeps its effects.
+//
+// If the context is nested, the recorded state and the delayed side effects
+// are merged into the enclosing context, so they can still be reverted by it.
+// Otherwise, the delayed side effects are performed.
+//
+func (c *revertibleContexts) commit() {
+	context := c.pop()
+
+	parent := c.current()
+	if parent == nil {
+		for _, onCommit := range context.onCommits {
+			onCommit()
+		}
+		return
+	}
+
+	for _, restore := range context.restores {
+		if _, ok := parent.recorded[restore.key]; ok {
+			continue
+		}
+		parent.recorded[restore.key] = struct{}{}
+		parent.restores = append(parent.restores, restore)
+	}
+
+	parent.onCommits = append(parent.onCommits, context.onCommits...)
+}
+
+// revert ends the current context and restores the recorded state,
+// in reverse order of recording. The delayed side effects are discarded.
+//
+func (c *revertibleContexts) revert() {
+	context := c.pop()
+
+	restores := context.restores
+	for i := len(restores) - 1; i >= 0; i-- {
+		restores[i].restore()
+	}
+}
+
+// withRevertibleContexts returns an interpreter option which sets the revertible contexts.
+//
+func withRevertibleContexts(contexts *revertibleContexts) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.revertibleContexts = contexts
+		return nil
+	}
+}
+
+// RunWhenCommitted runs the given function immediately, if no try block is executing.
+// Otherwise, the function is run when the effects of all enclosing try blocks are committed,
+// and it is discarded if the effects of any enclosing try block are reverted.
+//
+func (interpreter *Interpreter) RunWhenCommitted(f func()) {
+	context := interpreter.revertibleContexts.current()
+	if context == nil {
+		f()
+		return
+	}
+	context.onCommits = append(context.onCommits, f)
+}
+
+// CheckRevertibleOperation aborts if the given operation, which cannot be reverted,
+// is attempted while a try block is executing
+//
+func (interpreter *Interpreter) CheckRevertibleOperation(
+	operation string,
+	getLocationRange func() LocationRange,
+) {
+	if interpreter.revertibleContexts.current() == nil {
+		return
+	}
+
+	panic(NonRevertibleOperationError{
+		Operation:     operation,
+		LocationRange: getLocationRange(),
+	})
+}
+
+// recordMutation records the state of the given value before it is mutated,
+// if a try block is executing.
+//
+// For containers, the state of the backing atree value and its root slab is recorded as well,
+// as they are mutated in-place.
+//
+func (interpreter *Interpreter) recordMutation(value Value) {
+	context := interpreter.revertibleContexts.current()
+	if context == nil {
+		return
+	}
+
+	switch value := value.(type) {
+	case *ArrayValue:
+		recordState(context, value)
+		if value.array != nil {
+			recordState(context, value.array)
+			interpreter.recordSlab(value.array.StorageID())
+		}
+
+	case *DictionaryValue:
+		recordState(context, value)
+		if value.dictionary != nil {
+			recordState(context, value.dictionary)
+			interpreter.recordSlab(value.dictionary.StorageID())
+		}
+
+	case *CompositeValue:
+		recordState(context, value)
+		if value.dictionary != nil {
+			recordState(context, value.dictionary)
+			interpreter.recordSlab(value.dictionary.StorageID())
+		}
+
+	case *SetValue:
+		recordState(context, value)
+		if value.set != nil {
+			recordState(context, value.set)
+			interpreter.recordSlab(value.set.StorageID())
+		}
+
+	case *SomeValue:
+		recordState(context, value)
+	}
+}
+
+// recordStorageMapMutation records the state of the given storage map before it is mutated,
+// if a try block is executing
+//
+func (interpreter *Interpreter) recordStorageMapMutation(storageMap StorageMap) {
+	context := interpreter.revertibleContexts.current()
+	if context == nil {
+		return
+	}
+
+	recordState(context, storageMap.orderedMap)
+	interpreter.recordSlab(storageMap.orderedMap.StorageID())
+}
+
+func (interpreter *Interpreter) recordSlab(id atree.StorageID) {
+	err := interpreter.Storage.RecordSlab(id)
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+}
+
+// recordVariableMutation records the state of the given variable before it is assigned,
+// if a try block is executing
+//
+func (interpreter *Interpreter) recordVariableMutation(variable *Variable) {
+	context := interpreter.revertibleContexts.current()
+	if context == nil {
+		return
+	}
+
+	recordState(context, variable)
+}
+
+type resourceVariableKey struct {
+	interpreter *Interpreter
+	value       ResourceKindedValue
+}
+
+// recordResourceVariableMutation records the variable which is associated with the given resource,
+// before the association is changed, if a try block is executing
+//
+func (interpreter *Interpreter) recordResourceVariableMutation(value ResourceKindedValue) {
+	context := interpreter.revertibleContexts.current()
+	if context == nil {
+		return
+	}
+
+	key := resourceVariableKey{
+		interpreter: interpreter,
+		value:       value,
+	}
+
+	context.record(key, func() func() {
+		resourceVariables := interpreter.resourceVariables
+		variable, ok := resourceVariables[value]
+		return func() {
+			if ok {
+				resourceVariables[value] = variable
+			} else {
+				delete(resourceVariables, value)
+			}
+		}
+	})
+}
+
+type referencedResourceKindedValuesKey struct {
+	storageID atree.StorageID
+}
+
+// recordReferencedResourceKindedValuesMutation records the values which reference the resource
+// with the given storage ID, before they are changed, if a try block is executing
+//
+func (interpreter *Interpreter) recordReferencedResourceKindedValuesMutation(storageID atree.StorageID) {
+	context := interpreter.revertibleContexts.current()
+	if context == nil {
+		return
+	}
+
+	key := referencedResourceKindedValuesKey{
+		storageID: storageID,
+	}
+
+	context.record(key, func() func() {
+		referencedResourceKindedValues := interpreter.referencedResourceKindedValues
+		values, ok := referencedResourceKindedValues[storageID]
+		return func() {
+			if ok {
+				referencedResourceKindedValues[storageID] = values
+			} else {
+				delete(referencedResourceKindedValues, storageID)
+			}
+		}
+	})
+}
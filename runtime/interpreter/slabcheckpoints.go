@@ -0,0 +1,237 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter
+
+import (
+	"sort"
+
+	"github.com/onflow/atree"
+
+	"github.com/onflow/cadence/runtime/errors"
+)
+
+// SlabCheckpoints records the original state of the slabs of a slab storage
+// which are accessed while a checkpoint is active,
+// so that all changes to the slab storage since the checkpoint can be reverted.
+//
+// Slabs are recorded before they are retrieved, stored, or removed.
+// Root slabs of containers are mutated in-place, without being retrieved first,
+// so they must be recorded explicitly, using Record, before they are mutated.
+//
+type SlabCheckpoints struct {
+	base           atree.SlabStorage
+	decodeStorable atree.StorableDecoder
+	decodeTypeInfo atree.TypeInfoDecoder
+	checkpoints    []map[atree.StorageID]recordedSlab
+}
+
+// recordedSlab is the original state of a slab.
+// If the slab did not exist, slab is nil.
+//
+type recordedSlab struct {
+	slab    atree.Slab
+	encoded []byte
+}
+
+// NewSlabCheckpoints returns new slab checkpoints for the given base slab storage.
+// The base storage must not record slabs itself.
+//
+func NewSlabCheckpoints(
+	base atree.SlabStorage,
+	decodeStorable atree.StorableDecoder,
+	decodeTypeInfo atree.TypeInfoDecoder,
+) *SlabCheckpoints {
+	return &SlabCheckpoints{
+		base:           base,
+		decodeStorable: decodeStorable,
+		decodeTypeInfo: decodeTypeInfo,
+	}
+}
+
+// Active returns true if a checkpoint is active.
+//
+func (c *SlabCheckpoints) Active() bool {
+	return len(c.checkpoints) > 0
+}
+
+// Push starts a new, nested checkpoint.
+//
+func (c *SlabCheckpoints) Push() {
+	c.checkpoints = append(c.checkpoints, map[atree.StorageID]recordedSlab{})
+}
+
+// Record records the original state of the slab with the given storage ID,
+// if a checkpoint is active and the slab was not recorded yet.
+//
+func (c *SlabCheckpoints) Record(id atree.StorageID) error {
+	if len(c.checkpoints) == 0 {
+		return nil
+	}
+
+	checkpoint := c.checkpoints[len(c.checkpoints)-1]
+	if _, ok := checkpoint[id]; ok {
+		return nil
+	}
+
+	slab, ok, err := c.base.Retrieve(id)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		checkpoint[id] = recordedSlab{}
+		return nil
+	}
+
+	encoded, err := atree.Encode(slab, CBOREncMode)
+	if err != nil {
+		return err
+	}
+
+	checkpoint[id] = recordedSlab{
+		slab:    slab,
+		encoded: encoded,
+	}
+	return nil
+}
+
+// RecordNew records that the slab with the given, newly generated storage ID did not exist,
+// if a checkpoint is active.
+//
+func (c *SlabCheckpoints) RecordNew(id atree.StorageID) {
+	if len(c.checkpoints) == 0 {
+		return
+	}
+
+	checkpoint := c.checkpoints[len(c.checkpoints)-1]
+	if _, ok := checkpoint[id]; ok {
+		return
+	}
+	checkpoint[id] = recordedSlab{}
+}
+
+// Commit ends the current checkpoint and keeps all changes made since it was started.
+// If the checkpoint is nested, the recorded slabs are merged into the enclosing checkpoint,
+// so the changes can still be reverted by it.
+//
+func (c *SlabCheckpoints) Commit() {
+	count := len(c.checkpoints)
+	if count == 0 {
+		panic(errors.NewUnexpectedError("cannot commit: no active checkpoint"))
+	}
+
+	checkpoint := c.checkpoints[count-1]
+	c.checkpoints = c.checkpoints[:count-1]
+
+	if count == 1 {
+		return
+	}
+
+	parent := c.checkpoints[count-2]
+
+	// NOTE: ranging over maps is safe (deterministic),
+	// as the loop has no side effects other than merging into the parent
+
+	for id, recorded := range checkpoint { //nolint:maprangecheck
+		if _, ok := parent[id]; ok {
+			continue
+		}
+		parent[id] = recorded
+	}
+}
+
+// Revert ends the current checkpoint and reverts all changes made since it was started.
+//
+// Slabs which were changed are restored in-place,
+// as containers keep references to their root slabs.
+//
+func (c *SlabCheckpoints) Revert() error {
+	count := len(c.checkpoints)
+	if count == 0 {
+		return errors.NewUnexpectedError("cannot revert: no active checkpoint")
+	}
+
+	checkpoint := c.checkpoints[count-1]
+	c.checkpoints = c.checkpoints[:count-1]
+
+	ids := make([]atree.StorageID, 0, len(checkpoint))
+
+	// NOTE: ranging over maps is safe (deterministic),
+	// if it is side effect free and the keys are sorted afterwards
+
+	for id := range checkpoint { //nolint:maprangecheck
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i].Compare(ids[j]) < 0
+	})
+
+	for _, id := range ids {
+		err := c.restore(id, checkpoint[id])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *SlabCheckpoints) restore(id atree.StorageID, recorded recordedSlab) error {
+
+	if recorded.slab == nil {
+		_, ok, err := c.base.Retrieve(id)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		return c.base.Remove(id)
+	}
+
+	decoded, err := atree.DecodeSlab(
+		id,
+		recorded.encoded,
+		CBORDecMode,
+		c.decodeStorable,
+		c.decodeTypeInfo,
+	)
+	if err != nil {
+		return err
+	}
+
+	slab := recorded.slab
+
+	switch slab := slab.(type) {
+	case *atree.ArrayDataSlab:
+		*slab = *decoded.(*atree.ArrayDataSlab)
+	case *atree.ArrayMetaDataSlab:
+		*slab = *decoded.(*atree.ArrayMetaDataSlab)
+	case *atree.MapDataSlab:
+		*slab = *decoded.(*atree.MapDataSlab)
+	case *atree.MapMetaDataSlab:
+		*slab = *decoded.(*atree.MapMetaDataSlab)
+	case atree.StorableSlab:
+		// Storable slabs are immutable
+	default:
+		return errors.NewUnexpectedError("cannot restore slab of type %T", slab)
+	}
+
+	return c.base.Store(id, slab)
+}
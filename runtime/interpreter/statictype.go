@@ -56,6 +56,7 @@ type CompositeStaticType struct {
 	Location            common.Location
 	QualifiedIdentifier string
 	TypeID              common.TypeID
+	TypeArguments       []StaticType
 }
 
 var _ StaticType = CompositeStaticType{}
@@ -91,13 +92,26 @@ func (CompositeStaticType) elementSize() uint {
 	return UnknownElementSize
 }
 
-func (t CompositeStaticType) String() string {
+func (t CompositeStaticType) identifier() string {
 	if t.Location == nil {
 		return t.QualifiedIdentifier
 	}
 	return string(t.TypeID)
 }
 
+func (t CompositeStaticType) String() string {
+	if len(t.TypeArguments) == 0 {
+		return t.identifier()
+	}
+
+	typeArguments := make([]string, len(t.TypeArguments))
+	for i, typeArgument := range t.TypeArguments {
+		typeArguments[i] = typeArgument.String()
+	}
+
+	return fmt.Sprintf("%s<%s>", t.identifier(), strings.Join(typeArguments, ", "))
+}
+
 func (t CompositeStaticType) MeteredString(memoryGauge common.MemoryGauge) string {
 	var amount int
 	if t.Location == nil {
@@ -107,7 +121,17 @@ func (t CompositeStaticType) MeteredString(memoryGauge common.MemoryGauge) strin
 	}
 
 	common.UseMemory(memoryGauge, common.NewRawStringMemoryUsage(amount))
-	return t.String()
+
+	if len(t.TypeArguments) == 0 {
+		return t.identifier()
+	}
+
+	typeArguments := make([]string, len(t.TypeArguments))
+	for i, typeArgument := range t.TypeArguments {
+		typeArguments[i] = typeArgument.MeteredString(memoryGauge)
+	}
+
+	return fmt.Sprintf("%s<%s>", t.identifier(), strings.Join(typeArguments, ", "))
 }
 
 func (t CompositeStaticType) Equal(other StaticType) bool {
@@ -116,7 +140,19 @@ func (t CompositeStaticType) Equal(other StaticType) bool {
 		return false
 	}
 
-	return otherCompositeType.TypeID == t.TypeID
+	if otherCompositeType.TypeID != t.TypeID ||
+		len(otherCompositeType.TypeArguments) != len(t.TypeArguments) {
+
+		return false
+	}
+
+	for i, typeArgument := range t.TypeArguments {
+		if !typeArgument.Equal(otherCompositeType.TypeArguments[i]) {
+			return false
+		}
+	}
+
+	return true
 }
 
 // InterfaceStaticType
@@ -345,6 +381,53 @@ func (t DictionaryStaticType) Equal(other StaticType) bool {
 		t.ValueType.Equal(otherDictionaryType.ValueType)
 }
 
+// SetStaticType
+
+type SetStaticType struct {
+	ElementType StaticType
+}
+
+var _ StaticType = SetStaticType{}
+var _ atree.TypeInfo = SetStaticType{}
+
+func NewSetStaticType(
+	memoryGauge common.MemoryGauge,
+	elementType StaticType,
+) SetStaticType {
+	common.UseMemory(memoryGauge, common.SetStaticTypeMemoryUsage)
+
+	return SetStaticType{
+		ElementType: elementType,
+	}
+}
+
+func (SetStaticType) isStaticType() {}
+
+func (SetStaticType) elementSize() uint {
+	return UnknownElementSize
+}
+
+func (t SetStaticType) String() string {
+	return fmt.Sprintf("{%s}", t.ElementType)
+}
+
+func (t SetStaticType) MeteredString(memoryGauge common.MemoryGauge) string {
+	common.UseMemory(memoryGauge, common.SetStaticTypeStringMemoryUsage)
+
+	elementStr := t.ElementType.MeteredString(memoryGauge)
+
+	return fmt.Sprintf("{%s}", elementStr)
+}
+
+func (t SetStaticType) Equal(other StaticType) bool {
+	otherSetType, ok := other.(SetStaticType)
+	if !ok {
+		return false
+	}
+
+	return t.ElementType.Equal(otherSetType.ElementType)
+}
+
 // OptionalStaticType
 
 type OptionalStaticType struct {
@@ -601,7 +684,7 @@ func (t CapabilityStaticType) Equal(other StaticType) bool {
 func ConvertSemaToStaticType(memoryGauge common.MemoryGauge, t sema.Type) StaticType {
 	switch t := t.(type) {
 	case *sema.CompositeType:
-		return NewCompositeStaticType(memoryGauge, t.Location, t.QualifiedIdentifier(), t.ID())
+		return ConvertSemaCompositeTypeToStaticCompositeType(memoryGauge, t)
 
 	case *sema.InterfaceType:
 		return ConvertSemaInterfaceTypeToStaticInterfaceType(memoryGauge, t)
@@ -612,6 +695,9 @@ func ConvertSemaToStaticType(memoryGauge common.MemoryGauge, t sema.Type) Static
 	case *sema.DictionaryType:
 		return ConvertSemaDictionaryTypeToStaticDictionaryType(memoryGauge, t)
 
+	case *sema.SetType:
+		return ConvertSemaSetTypeToStaticSetType(memoryGauge, t)
+
 	case *sema.OptionalType:
 		return NewOptionalStaticType(
 			memoryGauge,
@@ -652,6 +738,29 @@ func ConvertSemaToStaticType(memoryGauge common.MemoryGauge, t sema.Type) Static
 	return primitiveStaticType
 }
 
+func ConvertSemaCompositeTypeToStaticCompositeType(
+	memoryGauge common.MemoryGauge,
+	t *sema.CompositeType,
+) CompositeStaticType {
+	baseType, ok := t.BaseType().(*sema.CompositeType)
+	if !ok {
+		return NewCompositeStaticType(memoryGauge, t.Location, t.QualifiedIdentifier(), t.ID())
+	}
+
+	// The type ID of an instantiated generic composite type is the type ID
+	// of the generic composite type, the type arguments are stored separately
+
+	staticType := NewCompositeStaticType(memoryGauge, t.Location, t.QualifiedIdentifier(), baseType.ID())
+
+	typeArguments := t.TypeArguments()
+	staticType.TypeArguments = make([]StaticType, len(typeArguments))
+	for i, typeArgument := range typeArguments {
+		staticType.TypeArguments[i] = ConvertSemaToStaticType(memoryGauge, typeArgument)
+	}
+
+	return staticType
+}
+
 func ConvertSemaArrayTypeToStaticArrayType(
 	memoryGauge common.MemoryGauge,
 	t sema.ArrayType,
@@ -684,6 +793,16 @@ func ConvertSemaDictionaryTypeToStaticDictionaryType(
 	)
 }
 
+func ConvertSemaSetTypeToStaticSetType(
+	memoryGauge common.MemoryGauge,
+	t *sema.SetType,
+) SetStaticType {
+	return NewSetStaticType(
+		memoryGauge,
+		ConvertSemaToStaticType(memoryGauge, t.ElementType),
+	)
+}
+
 func ConvertSemaReferenceTypeToStaticReferenceType(
 	memoryGauge common.MemoryGauge,
 	t *sema.ReferenceType,
@@ -711,7 +830,20 @@ func ConvertStaticToSemaType(
 ) (_ sema.Type, err error) {
 	switch t := typ.(type) {
 	case CompositeStaticType:
-		return getComposite(t.Location, t.QualifiedIdentifier, t.TypeID)
+		compositeType, err := getComposite(t.Location, t.QualifiedIdentifier, t.TypeID)
+		if err != nil || len(t.TypeArguments) == 0 {
+			return compositeType, err
+		}
+
+		typeArguments := make([]sema.Type, len(t.TypeArguments))
+		for i, typeArgument := range t.TypeArguments {
+			typeArguments[i], err = ConvertStaticToSemaType(memoryGauge, typeArgument, getInterface, getComposite)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return compositeType.Instantiate(typeArguments, nil), nil
 
 	case InterfaceStaticType:
 		return getInterface(t.Location, t.QualifiedIdentifier)
@@ -740,6 +872,10 @@ func ConvertStaticToSemaType(
 			valueType,
 		), err
 
+	case SetStaticType:
+		ty, err := ConvertStaticToSemaType(memoryGauge, t.ElementType, getInterface, getComposite)
+		return sema.NewSetType(memoryGauge, ty), err
+
 	case OptionalStaticType:
 		ty, err := ConvertStaticToSemaType(memoryGauge, t.Type, getInterface, getComposite)
 		return sema.NewOptionalType(memoryGauge, ty), err
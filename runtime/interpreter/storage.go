@@ -70,6 +70,8 @@ func ConvertStoredValue(gauge common.MemoryGauge, value atree.Value) (Value, err
 		switch typeInfo := typeInfo.(type) {
 		case DictionaryStaticType:
 			return newDictionaryValueFromConstructor(gauge, typeInfo, value.Count(), func() *atree.OrderedMap { return value }), nil
+		case SetStaticType:
+			return newSetValueFromConstructor(gauge, typeInfo, value.Count(), func() *atree.OrderedMap { return value }), nil
 		case compositeTypeInfo:
 			return newCompositeValueFromConstructor(gauge, value.Count(), typeInfo, func() *atree.OrderedMap { return value }), nil
 		default:
@@ -116,6 +118,12 @@ type InMemoryStorage struct {
 	*atree.BasicSlabStorage
 	StorageMaps map[StorageKey]*StorageMap
 	memoryGauge common.MemoryGauge
+	checkpoints *inMemoryStorageCheckpoints
+}
+
+type inMemoryStorageCheckpoints struct {
+	slabs       *SlabCheckpoints
+	storageMaps []map[StorageKey]*StorageMap
 }
 
 var _ Storage = InMemoryStorage{}
@@ -140,6 +148,13 @@ func NewInMemoryStorage(memoryGauge common.MemoryGauge) InMemoryStorage {
 		BasicSlabStorage: slabStorage,
 		StorageMaps:      make(map[StorageKey]*StorageMap),
 		memoryGauge:      memoryGauge,
+		checkpoints: &inMemoryStorageCheckpoints{
+			slabs: NewSlabCheckpoints(
+				slabStorage,
+				decodeStorable,
+				decodeTypeInfo,
+			),
+		},
 	}
 }
 
@@ -164,6 +179,88 @@ func (i InMemoryStorage) CheckHealth() error {
 	return err
 }
 
+func (i InMemoryStorage) GenerateStorageID(address atree.Address) (atree.StorageID, error) {
+	id, err := i.BasicSlabStorage.GenerateStorageID(address)
+	if err != nil {
+		return atree.StorageID{}, err
+	}
+	i.checkpoints.slabs.RecordNew(id)
+	return id, nil
+}
+
+func (i InMemoryStorage) Retrieve(id atree.StorageID) (atree.Slab, bool, error) {
+	err := i.checkpoints.slabs.Record(id)
+	if err != nil {
+		return nil, false, err
+	}
+	return i.BasicSlabStorage.Retrieve(id)
+}
+
+func (i InMemoryStorage) Store(id atree.StorageID, slab atree.Slab) error {
+	err := i.checkpoints.slabs.Record(id)
+	if err != nil {
+		return err
+	}
+	return i.BasicSlabStorage.Store(id, slab)
+}
+
+func (i InMemoryStorage) Remove(id atree.StorageID) error {
+	err := i.checkpoints.slabs.Record(id)
+	if err != nil {
+		return err
+	}
+	return i.BasicSlabStorage.Remove(id)
+}
+
+func (i InMemoryStorage) Checkpoint() {
+	storageMaps := make(map[StorageKey]*StorageMap, len(i.StorageMaps))
+
+	// NOTE: ranging over maps is safe (deterministic),
+	// as the loop only copies the map
+	for key, storageMap := range i.StorageMaps { //nolint:maprangecheck
+		storageMaps[key] = storageMap
+	}
+
+	i.checkpoints.storageMaps = append(i.checkpoints.storageMaps, storageMaps)
+	i.checkpoints.slabs.Push()
+}
+
+func (i InMemoryStorage) CommitCheckpoint() {
+	i.checkpoints.slabs.Commit()
+
+	count := len(i.checkpoints.storageMaps)
+	i.checkpoints.storageMaps = i.checkpoints.storageMaps[:count-1]
+}
+
+func (i InMemoryStorage) RevertCheckpoint() error {
+	err := i.checkpoints.slabs.Revert()
+	if err != nil {
+		return err
+	}
+
+	count := len(i.checkpoints.storageMaps)
+	storageMaps := i.checkpoints.storageMaps[count-1]
+	i.checkpoints.storageMaps = i.checkpoints.storageMaps[:count-1]
+
+	// NOTE: ranging over maps is safe (deterministic),
+	// as the loops only delete and copy entries
+
+	for key := range i.StorageMaps { //nolint:maprangecheck
+		if _, ok := storageMaps[key]; !ok {
+			delete(i.StorageMaps, key)
+		}
+	}
+	for key, storageMap := range storageMaps { //nolint:maprangecheck
+		i.StorageMaps[key] = storageMap
+	}
+
+	return nil
+}
+
+func (i InMemoryStorage) RecordSlab(id atree.StorageID) error {
+	return i.checkpoints.slabs.Record(id)
+}
+
 // writeCounter is an io.Writer which counts the amount of written data.
 //
 type writeCounter struct {
@@ -117,6 +117,8 @@ func (s StorageMap) WriteValue(interpreter *Interpreter, key string, value atree
 // If the given key already stores a value, it is overwritten.
 //
 func (s StorageMap) SetValue(interpreter *Interpreter, key string, value atree.Value) {
+	interpreter.recordStorageMapMutation(s)
+
 	existingStorable, err := s.orderedMap.Set(
 		StringAtreeComparator,
 		StringAtreeHashInput,
@@ -138,6 +140,8 @@ func (s StorageMap) SetValue(interpreter *Interpreter, key string, value atree.V
 // RemoveValue removes a value in the storage map, if it exists.
 //
 func (s StorageMap) RemoveValue(interpreter *Interpreter, key string) {
+	interpreter.recordStorageMapMutation(s)
+
 	existingKeyStorable, existingValueStorable, err := s.orderedMap.Remove(
 		StringAtreeComparator,
 		StringAtreeHashInput,
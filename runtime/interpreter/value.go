@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"sort"
 	"strings"
 	"time"
 	"unicode"
@@ -47,9 +48,13 @@ type Unsigned interface {
 
 type TypeConformanceResults map[typeConformanceResultEntry]bool
 
+// typeConformanceResultEntry is the key of a type conformance result.
+// The static type of the referenced value is not part of the key:
+// It is determined by the reference, and static types are not necessarily hashable,
+// e.g. the static types of instantiated generic composites have type arguments
+//
 type typeConformanceResultEntry struct {
 	EphemeralReferenceValue *EphemeralReferenceValue
-	EphemeralReferenceType  StaticType
 }
 
 // SeenReferences is a set of seen references.
@@ -1154,6 +1159,82 @@ func (v *StringValue) GetMember(interpreter *Interpreter, _ func() LocationRange
 			},
 			sema.StringTypeToLowerFunctionType,
 		)
+
+	case "toUpper":
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				return v.ToUpper(invocation.Interpreter)
+			},
+			sema.StringTypeToUpperFunctionType,
+		)
+
+	case "split":
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				separator, ok := invocation.Arguments[0].(*StringValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+				return v.Split(invocation.Interpreter, invocation.GetLocationRange, separator)
+			},
+			sema.StringTypeSplitFunctionType,
+		)
+
+	case "contains":
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				other, ok := invocation.Arguments[0].(*StringValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+				return v.Contains(invocation.Interpreter, other)
+			},
+			sema.StringTypeContainsFunctionType,
+		)
+
+	case "index":
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				other, ok := invocation.Arguments[0].(*StringValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+				return v.IndexOf(invocation.Interpreter, other)
+			},
+			sema.StringTypeIndexFunctionType,
+		)
+
+	case "replaceAll":
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				original, ok := invocation.Arguments[0].(*StringValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				replacement, ok := invocation.Arguments[1].(*StringValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				return v.ReplaceAll(invocation.Interpreter, original, replacement)
+			},
+			sema.StringTypeReplaceAllFunctionType,
+		)
+
+	case "trim":
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				return v.Trim(invocation.Interpreter)
+			},
+			sema.StringTypeTrimFunctionType,
+		)
 	}
 
 	return nil
@@ -1189,6 +1270,37 @@ func (v *StringValue) ToLower(interpreter *Interpreter) *StringValue {
 	// as an uppercase character may be converted to several lower-case characters, e.g İ => [i, ̇]
 	// see https://stackoverflow.com/questions/28683805/is-there-a-unicode-string-which-gets-longer-when-converted-to-lowercase
 
+	memoryUsage := common.NewStringMemoryUsage(v.caseMappingLengthEstimate())
+
+	return NewStringValue(
+		interpreter,
+		memoryUsage,
+		func() string {
+			return strings.ToLower(v.Str)
+		},
+	)
+}
+
+func (v *StringValue) ToUpper(interpreter *Interpreter) *StringValue {
+
+	// Over-estimate resulting string length,
+	// as a lowercase character may be converted to a longer upper-case encoding, e.g ı => I
+
+	memoryUsage := common.NewStringMemoryUsage(v.caseMappingLengthEstimate())
+
+	return NewStringValue(
+		interpreter,
+		memoryUsage,
+		func() string {
+			return strings.ToUpper(v.Str)
+		},
+	)
+}
+
+// caseMappingLengthEstimate returns an upper bound for the length
+// of the string after converting it to lower or upper case.
+//
+func (v *StringValue) caseMappingLengthEstimate() int {
 	var lengthEstimate int
 	for _, r := range v.Str {
 		if r < unicode.MaxASCII {
@@ -1197,14 +1309,231 @@ func (v *StringValue) ToLower(interpreter *Interpreter) *StringValue {
 			lengthEstimate += utf8.UTFMax
 		}
 	}
+	return lengthEstimate
+}
+
+// graphemeBoundaries returns the byte offsets of the start of each character (grapheme cluster),
+// followed by the length of the string.
+//
+func (v *StringValue) graphemeBoundaries() []int {
+	boundaries := []int{0}
+	v.prepareGraphemes()
+	for v.graphemes.Next() {
+		_, end := v.graphemes.Positions()
+		boundaries = append(boundaries, end)
+	}
+	return boundaries
+}
+
+// forEachMatch calls the given function with the byte offsets of each non-overlapping occurrence
+// of the given non-empty string, from left to right.
+// Occurrences which do not start and end on a character boundary are skipped,
+// e.g. "e" does not match the first character of "e\u{301}".
+// The iteration is stopped when the function returns false.
+//
+func (v *StringValue) forEachMatch(other string, boundaries []int, f func(start, end int) (resume bool)) {
+	if len(other) == 0 {
+		panic(errors.NewUnreachableError())
+	}
+
+	isBoundary := func(offset int) bool {
+		index := sort.SearchInts(boundaries, offset)
+		return index < len(boundaries) && boundaries[index] == offset
+	}
+
+	offset := 0
+	for offset <= len(v.Str)-len(other) {
+		index := strings.Index(v.Str[offset:], other)
+		if index < 0 {
+			return
+		}
+
+		start := offset + index
+		end := start + len(other)
+
+		if !isBoundary(start) || !isBoundary(end) {
+			offset = start + 1
+			continue
+		}
+
+		if !f(start, end) {
+			return
+		}
+
+		offset = end
+	}
+}
+
+func (v *StringValue) Contains(interpreter *Interpreter, other *StringValue) BoolValue {
+	if len(other.Str) == 0 {
+		return NewBoolValue(interpreter, true)
+	}
+
+	var found bool
+	v.forEachMatch(other.Str, v.graphemeBoundaries(), func(_, _ int) bool {
+		found = true
+		return false
+	})
+
+	return NewBoolValue(interpreter, found)
+}
+
+// IndexOf returns the character index of the first occurrence of the given string
+//
+func (v *StringValue) IndexOf(interpreter *Interpreter, other *StringValue) OptionalValue {
+	if len(other.Str) == 0 {
+		value := NewIntValueFromInt64(interpreter, 0)
+		return NewSomeValueNonCopying(interpreter, value)
+	}
+
+	boundaries := v.graphemeBoundaries()
+
+	index := -1
+	v.forEachMatch(other.Str, boundaries, func(start, _ int) bool {
+		index = sort.SearchInts(boundaries, start)
+		return false
+	})
+
+	if index < 0 {
+		return NewNilValue(interpreter)
+	}
+
+	value := NewIntValueFromInt64(interpreter, int64(index))
+	return NewSomeValueNonCopying(interpreter, value)
+}
+
+func (v *StringValue) Split(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	separator *StringValue,
+) *ArrayValue {
+
+	boundaries := v.graphemeBoundaries()
+
+	var parts []string
+
+	if len(separator.Str) == 0 {
+		for i := 1; i < len(boundaries); i++ {
+			parts = append(parts, v.Str[boundaries[i-1]:boundaries[i]])
+		}
+	} else {
+		partStart := 0
+		v.forEachMatch(separator.Str, boundaries, func(start, end int) bool {
+			parts = append(parts, v.Str[partStart:start])
+			partStart = end
+			return true
+		})
+		parts = append(parts, v.Str[partStart:])
+	}
+
+	values := make([]Value, len(parts))
+	for i, part := range parts {
+		part := part
+		values[i] = NewStringValue(
+			interpreter,
+			common.NewStringMemoryUsage(len(part)),
+			func() string {
+				return part
+			},
+		)
+	}
+
+	return NewArrayValue(
+		interpreter,
+		getLocationRange,
+		StringArrayStaticType,
+		common.Address{},
+		values...,
+	)
+}
+
+func (v *StringValue) ReplaceAll(interpreter *Interpreter, original *StringValue, replacement *StringValue) *StringValue {
+
+	boundaries := v.graphemeBoundaries()
+
+	// Determine the byte ranges which get replaced first,
+	// so the length of the resulting string is known before it is constructed
+
+	var matches [][2]int
+
+	if len(original.Str) == 0 {
+		for _, boundary := range boundaries {
+			matches = append(matches, [2]int{boundary, boundary})
+		}
+	} else {
+		v.forEachMatch(original.Str, boundaries, func(start, end int) bool {
+			matches = append(matches, [2]int{start, end})
+			return true
+		})
+	}
 
-	memoryUsage := common.NewStringMemoryUsage(lengthEstimate)
+	newLength := safeAdd(
+		len(v.Str)-len(matches)*len(original.Str),
+		safeMul(len(matches), len(replacement.Str)),
+	)
+
+	memoryUsage := common.NewStringMemoryUsage(newLength)
 
 	return NewStringValue(
 		interpreter,
 		memoryUsage,
 		func() string {
-			return strings.ToLower(v.Str)
+			var sb strings.Builder
+			sb.Grow(newLength)
+
+			offset := 0
+			for _, match := range matches {
+				sb.WriteString(v.Str[offset:match[0]])
+				sb.WriteString(replacement.Str)
+				offset = match[1]
+			}
+			sb.WriteString(v.Str[offset:])
+
+			return sb.String()
+		},
+	)
+}
+
+// Trim removes the leading and trailing characters (grapheme clusters)
+// which only consist of whitespace
+//
+func (v *StringValue) Trim(interpreter *Interpreter) *StringValue {
+
+	isWhitespace := func(character string) bool {
+		for _, r := range character {
+			if !unicode.IsSpace(r) {
+				return false
+			}
+		}
+		return true
+	}
+
+	start := -1
+	end := 0
+
+	v.prepareGraphemes()
+	for v.graphemes.Next() {
+		if isWhitespace(v.graphemes.Str()) {
+			continue
+		}
+		characterStart, characterEnd := v.graphemes.Positions()
+		if start < 0 {
+			start = characterStart
+		}
+		end = characterEnd
+	}
+
+	if start < 0 {
+		return emptyString
+	}
+
+	trimmed := v.Str[start:end]
+
+	return NewStringValue(
+		interpreter,
+		common.NewStringMemoryUsage(len(trimmed)),
+		func() string {
+			return trimmed
 		},
 	)
 }
@@ -1257,6 +1586,9 @@ func (*StringValue) ChildStorables() []atree.Storable {
 // Memory is NOT metered for this value
 var ByteArrayStaticType = ConvertSemaArrayTypeToStaticArrayType(nil, sema.ByteArrayType)
 
+// Memory is NOT metered for this value
+var StringArrayStaticType = ConvertSemaArrayTypeToStaticArrayType(nil, sema.StringArrayType)
+
 // DecodeHex hex-decodes this string and returns an array of UInt8 values
 //
 func (v *StringValue) DecodeHex(interpreter *Interpreter) *ArrayValue {
@@ -1510,6 +1842,9 @@ func (v *ArrayValue) Destroy(interpreter *Interpreter, getLocationRange func() L
 
 	storageID := v.StorageID()
 
+	interpreter.checkMutationDuringIteration(storageID, getLocationRange)
+	interpreter.recordMutation(v)
+
 	if interpreter.tracingEnabled {
 		startTime := time.Now()
 
@@ -1678,6 +2013,9 @@ func (v *ArrayValue) SetKey(interpreter *Interpreter, getLocationRange func() Lo
 
 func (v *ArrayValue) Set(interpreter *Interpreter, getLocationRange func() LocationRange, index int, element Value) {
 
+	interpreter.checkMutationDuringIteration(v.StorageID(), getLocationRange)
+	interpreter.recordMutation(v)
+
 	// We only need to check the lower bound before converting from `int` (signed) to `uint64` (unsigned).
 	// atree's Array.Set function will check the upper bound and report an atree.IndexOutOfBoundsError
 
@@ -1750,6 +2088,9 @@ func (v *ArrayValue) MeteredString(memoryGauge common.MemoryGauge, seenReference
 
 func (v *ArrayValue) Append(interpreter *Interpreter, getLocationRange func() LocationRange, element Value) {
 
+	interpreter.checkMutationDuringIteration(v.StorageID(), getLocationRange)
+	interpreter.recordMutation(v)
+
 	// length increases by 1
 	dataSlabs, metaDataSlabs := common.AdditionalAtreeMemoryUsage(
 		v.array.Count(),
@@ -1795,6 +2136,9 @@ func (v *ArrayValue) InsertKey(interpreter *Interpreter, getLocationRange func()
 
 func (v *ArrayValue) Insert(interpreter *Interpreter, getLocationRange func() LocationRange, index int, element Value) {
 
+	interpreter.checkMutationDuringIteration(v.StorageID(), getLocationRange)
+	interpreter.recordMutation(v)
+
 	// We only need to check the lower bound before converting from `int` (signed) to `uint64` (unsigned).
 	// atree's Array.Insert function will check the upper bound and report an atree.IndexOutOfBoundsError
 
@@ -1847,6 +2191,9 @@ func (v *ArrayValue) RemoveKey(interpreter *Interpreter, getLocationRange func()
 
 func (v *ArrayValue) Remove(interpreter *Interpreter, getLocationRange func() LocationRange, index int) Value {
 
+	interpreter.checkMutationDuringIteration(v.StorageID(), getLocationRange)
+	interpreter.recordMutation(v)
+
 	// We only need to check the lower bound before converting from `int` (signed) to `uint64` (unsigned).
 	// atree's Array.Remove function will check the upper bound and report an atree.IndexOutOfBoundsError
 
@@ -2134,37 +2481,163 @@ func (v *ArrayValue) GetMember(interpreter *Interpreter, getLocationRange func()
 				v.SemaType(interpreter).ElementType(false),
 			),
 		)
-	}
-
-	return nil
-}
 
-func (v *ArrayValue) RemoveMember(interpreter *Interpreter, getLocationRange func() LocationRange, _ string) Value {
+	case "forEach":
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				function, ok := invocation.Arguments[0].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
 
-	if interpreter.invalidatedResourceValidationEnabled {
-		v.checkInvalidatedResourceUse(interpreter, getLocationRange)
-	}
+				v.ForEach(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					function,
+				)
 
-	// Arrays have no removable members (fields / functions)
-	panic(errors.NewUnreachableError())
-}
+				return NewVoidValue(invocation.Interpreter)
+			},
+			sema.ArrayForEachFunctionType(
+				v.SemaType(interpreter).ElementType(false),
+			),
+		)
 
-func (v *ArrayValue) SetMember(interpreter *Interpreter, getLocationRange func() LocationRange, _ string, _ Value) {
+	case "map":
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				transform, ok := invocation.Arguments[0].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
 
-	if interpreter.invalidatedResourceValidationEnabled {
-		v.checkInvalidatedResourceUse(interpreter, getLocationRange)
-	}
+				typeParameterPair := invocation.TypeParameterTypes.Oldest()
+				if typeParameterPair == nil {
+					panic(errors.NewUnreachableError())
+				}
 
-	// Arrays have no settable members (fields / functions)
-	panic(errors.NewUnreachableError())
-}
+				return v.Map(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					transform,
+					ConvertSemaToStaticType(invocation.Interpreter, typeParameterPair.Value),
+				)
+			},
+			sema.ArrayMapFunctionType(
+				v.SemaType(interpreter),
+			),
+		)
 
-func (v *ArrayValue) Count() int {
-	return int(v.array.Count())
-}
+	case "filter":
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				predicate, ok := invocation.Arguments[0].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
 
-func (v *ArrayValue) ConformsToStaticType(
-	interpreter *Interpreter,
+				return v.Filter(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					predicate,
+				)
+			},
+			sema.ArrayFilterFunctionType(
+				v.SemaType(interpreter).ElementType(false),
+			),
+		)
+
+	case "reduce":
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				combine, ok := invocation.Arguments[1].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				return v.Reduce(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					invocation.Arguments[0],
+					invocation.ArgumentTypes[0],
+					combine,
+				)
+			},
+			sema.ArrayReduceFunctionType(
+				v.SemaType(interpreter).ElementType(false),
+			),
+		)
+
+	case "reverse":
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				return v.Reverse(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+				)
+			},
+			sema.ArrayReverseFunctionType(
+				v.SemaType(interpreter),
+			),
+		)
+
+	case "sort":
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				isOrderedBefore, ok := invocation.Arguments[0].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				v.Sort(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					isOrderedBefore,
+				)
+
+				return NewVoidValue(invocation.Interpreter)
+			},
+			sema.ArraySortFunctionType(
+				v.SemaType(interpreter).ElementType(false),
+			),
+		)
+	}
+
+	return nil
+}
+
+func (v *ArrayValue) RemoveMember(interpreter *Interpreter, getLocationRange func() LocationRange, _ string) Value {
+
+	if interpreter.invalidatedResourceValidationEnabled {
+		v.checkInvalidatedResourceUse(interpreter, getLocationRange)
+	}
+
+	// Arrays have no removable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (v *ArrayValue) SetMember(interpreter *Interpreter, getLocationRange func() LocationRange, _ string, _ Value) {
+
+	if interpreter.invalidatedResourceValidationEnabled {
+		v.checkInvalidatedResourceUse(interpreter, getLocationRange)
+	}
+
+	// Arrays have no settable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (v *ArrayValue) Count() int {
+	return int(v.array.Count())
+}
+
+func (v *ArrayValue) ConformsToStaticType(
+	interpreter *Interpreter,
 	getLocationRange func() LocationRange,
 	results TypeConformanceResults,
 ) bool {
@@ -2308,6 +2781,14 @@ func (v *ArrayValue) Transfer(
 	needsStoreTo := address != currentAddress
 	isResourceKinded := v.IsResourceKinded(interpreter)
 
+	if remove {
+		interpreter.checkMutationDuringIteration(currentStorageID, getLocationRange)
+	}
+
+	if remove || isResourceKinded {
+		interpreter.recordMutation(v)
+	}
+
 	if needsStoreTo || !isResourceKinded {
 
 		iterator, err := v.array.Iterator()
@@ -2442,6 +2923,9 @@ func (v *ArrayValue) Clone(interpreter *Interpreter) Value {
 
 func (v *ArrayValue) DeepRemove(interpreter *Interpreter) {
 
+	interpreter.checkMutationDuringIteration(v.StorageID(), ReturnEmptyLocationRange)
+	interpreter.recordMutation(v)
+
 	if interpreter.tracingEnabled {
 		startTime := time.Now()
 
@@ -2577,103 +3061,513 @@ func (v *ArrayValue) Slice(
 	)
 }
 
-// NumberValue
+// iterateElements calls the given function with a copy of each element of the array, in order,
+// until the function returns false.
+// The array must not be mutated during the iteration.
 //
-type NumberValue interface {
-	EquatableValue
-	ToInt() int
-	Negate(*Interpreter) NumberValue
-	Plus(interpreter *Interpreter, other NumberValue) NumberValue
-	SaturatingPlus(interpreter *Interpreter, other NumberValue) NumberValue
-	Minus(interpreter *Interpreter, other NumberValue) NumberValue
-	SaturatingMinus(interpreter *Interpreter, other NumberValue) NumberValue
-	Mod(interpreter *Interpreter, other NumberValue) NumberValue
-	Mul(interpreter *Interpreter, other NumberValue) NumberValue
-	SaturatingMul(interpreter *Interpreter, other NumberValue) NumberValue
-	Div(interpreter *Interpreter, other NumberValue) NumberValue
-	SaturatingDiv(interpreter *Interpreter, other NumberValue) NumberValue
-	Less(interpreter *Interpreter, other NumberValue) BoolValue
-	LessEqual(interpreter *Interpreter, other NumberValue) BoolValue
-	Greater(interpreter *Interpreter, other NumberValue) BoolValue
-	GreaterEqual(interpreter *Interpreter, other NumberValue) BoolValue
-	ToBigEndianBytes() []byte
+func (v *ArrayValue) iterateElements(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	f func(element Value) (resume bool),
+) {
+	interpreter.iterateContainer(v.StorageID(), func() {
+		v.Iterate(interpreter, func(element Value) (resume bool) {
+			interpreter.ReportComputation(common.ComputationKindIterateArrayValue, 1)
+
+			element = element.Transfer(
+				interpreter,
+				getLocationRange,
+				atree.Address{},
+				false,
+				nil,
+			)
+
+			return f(element)
+		})
+	})
 }
 
-func getNumberValueMember(interpreter *Interpreter, v NumberValue, name string, typ sema.Type) Value {
-	switch name {
+// newElementIterator returns a function which returns a copy of the next element of the array,
+// or nil if there are no more elements
+//
+func (v *ArrayValue) newElementIterator(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+) func() Value {
+	iterator, err := v.array.Iterator()
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
 
-	case sema.ToStringFunctionName:
-		return NewHostFunctionValue(
-			interpreter,
-			func(invocation Invocation) Value {
-				interpreter := invocation.Interpreter
-				memoryUsage := common.NewStringMemoryUsage(
-					OverEstimateNumberStringLength(interpreter, v),
-				)
-				return NewStringValue(
-					interpreter,
-					memoryUsage,
-					func() string {
-						return v.String()
-					},
-				)
-			},
-			sema.ToStringFunctionType,
-		)
+	return func() Value {
+		atreeValue, err := iterator.Next()
+		if err != nil {
+			panic(errors.NewExternalError(err))
+		}
 
-	case sema.ToBigEndianBytesFunctionName:
-		return NewHostFunctionValue(
+		if atreeValue == nil {
+			return nil
+		}
+
+		interpreter.ReportComputation(common.ComputationKindIterateArrayValue, 1)
+
+		return MustConvertStoredValue(interpreter, atreeValue).
+			Transfer(
+				interpreter,
+				getLocationRange,
+				atree.Address{},
+				false,
+				nil,
+			)
+	}
+}
+
+func (v *ArrayValue) elementArgumentTypes(interpreter *Interpreter) []sema.Type {
+	return []sema.Type{
+		v.SemaType(interpreter).ElementType(false),
+	}
+}
+
+func (v *ArrayValue) ForEach(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	function FunctionValue,
+) {
+	argumentTypes := v.elementArgumentTypes(interpreter)
+
+	v.iterateElements(
+		interpreter,
+		getLocationRange,
+		func(element Value) (resume bool) {
+			invocation := NewInvocation(
+				interpreter,
+				nil,
+				[]Value{element},
+				argumentTypes,
+				nil,
+				getLocationRange,
+			)
+
+			function.invoke(invocation)
+
+			return true
+		},
+	)
+}
+
+func (v *ArrayValue) Map(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	transform FunctionValue,
+	resultElementType StaticType,
+) *ArrayValue {
+
+	var resultType ArrayStaticType
+	if constantSizedType, ok := v.Type.(ConstantSizedStaticType); ok {
+		resultType = NewConstantSizedStaticType(
 			interpreter,
-			func(invocation Invocation) Value {
-				return ByteSliceToByteArrayValue(
-					invocation.Interpreter,
-					v.ToBigEndianBytes(),
-				)
-			},
-			&sema.FunctionType{
-				ReturnTypeAnnotation: sema.NewTypeAnnotation(
-					sema.ByteArrayType,
-				),
-			},
+			resultElementType,
+			constantSizedType.Size,
 		)
+	} else {
+		resultType = NewVariableSizedStaticType(interpreter, resultElementType)
+	}
 
-	case sema.NumericTypeSaturatingAddFunctionName:
-		return NewHostFunctionValue(
+	argumentTypes := v.elementArgumentTypes(interpreter)
+
+	nextElement := v.newElementIterator(interpreter, getLocationRange)
+
+	var result *ArrayValue
+
+	interpreter.iterateContainer(v.StorageID(), func() {
+		result = NewArrayValueWithIterator(
 			interpreter,
-			func(invocation Invocation) Value {
-				other, ok := invocation.Arguments[0].(NumberValue)
-				if !ok {
-					panic(errors.NewUnreachableError())
+			resultType,
+			common.Address{},
+			uint64(v.Count()),
+			func() Value {
+				element := nextElement()
+				if element == nil {
+					return nil
 				}
-				return v.SaturatingPlus(
-					invocation.Interpreter,
-					other,
+
+				invocation := NewInvocation(
+					interpreter,
+					nil,
+					[]Value{element},
+					argumentTypes,
+					nil,
+					getLocationRange,
 				)
-			},
-			&sema.FunctionType{
-				ReturnTypeAnnotation: sema.NewTypeAnnotation(
-					typ,
-				),
+
+				// NOTE: the result of the function is already a copy
+				return transform.invoke(invocation)
 			},
 		)
+	})
 
-	case sema.NumericTypeSaturatingSubtractFunctionName:
-		return NewHostFunctionValue(
+	return result
+}
+
+func (v *ArrayValue) Filter(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	predicate FunctionValue,
+) *ArrayValue {
+
+	argumentTypes := v.elementArgumentTypes(interpreter)
+
+	nextElement := v.newElementIterator(interpreter, getLocationRange)
+
+	var result *ArrayValue
+
+	interpreter.iterateContainer(v.StorageID(), func() {
+		result = NewArrayValueWithIterator(
 			interpreter,
-			func(invocation Invocation) Value {
-				other, ok := invocation.Arguments[0].(NumberValue)
-				if !ok {
-					panic(errors.NewUnreachableError())
+			NewVariableSizedStaticType(interpreter, v.Type.ElementType()),
+			common.Address{},
+			// NOTE: the number of elements of the result is not known in advance,
+			// so the number of elements of the array is used as an upper bound
+			uint64(v.Count()),
+			func() Value {
+				for {
+					element := nextElement()
+					if element == nil {
+						return nil
+					}
+
+					// The predicate is passed a separate copy of the element,
+					// as it may mutate its argument
+
+					invocation := NewInvocation(
+						interpreter,
+						nil,
+						[]Value{
+							element.Transfer(
+								interpreter,
+								getLocationRange,
+								atree.Address{},
+								false,
+								nil,
+							),
+						},
+						argumentTypes,
+						nil,
+						getLocationRange,
+					)
+
+					include, ok := predicate.invoke(invocation).(BoolValue)
+					if !ok {
+						panic(errors.NewUnreachableError())
+					}
+
+					if include {
+						return element
+					}
 				}
-				return v.SaturatingMinus(
-					invocation.Interpreter,
-					other,
-				)
 			},
-			&sema.FunctionType{
-				ReturnTypeAnnotation: sema.NewTypeAnnotation(
-					typ,
-				),
+		)
+	})
+
+	return result
+}
+
+func (v *ArrayValue) Reduce(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	initial Value,
+	resultType sema.Type,
+	combine FunctionValue,
+) Value {
+
+	argumentTypes := []sema.Type{
+		resultType,
+		v.SemaType(interpreter).ElementType(false),
+	}
+
+	result := initial
+
+	v.iterateElements(
+		interpreter,
+		getLocationRange,
+		func(element Value) (resume bool) {
+			invocation := NewInvocation(
+				interpreter,
+				nil,
+				[]Value{result, element},
+				argumentTypes,
+				nil,
+				getLocationRange,
+			)
+
+			result = combine.invoke(invocation)
+
+			return true
+		},
+	)
+
+	return result
+}
+
+func (v *ArrayValue) Reverse(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+) *ArrayValue {
+
+	count := v.Count()
+	index := count - 1
+
+	var result *ArrayValue
+
+	interpreter.iterateContainer(v.StorageID(), func() {
+		result = NewArrayValueWithIterator(
+			interpreter,
+			v.Type,
+			common.Address{},
+			uint64(count),
+			func() Value {
+				if index < 0 {
+					return nil
+				}
+
+				interpreter.ReportComputation(common.ComputationKindIterateArrayValue, 1)
+
+				value := v.Get(interpreter, getLocationRange, index)
+				index--
+
+				return value.Transfer(
+					interpreter,
+					getLocationRange,
+					atree.Address{},
+					false,
+					nil,
+				)
+			},
+		)
+	})
+
+	return result
+}
+
+func (v *ArrayValue) Sort(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	isOrderedBefore FunctionValue,
+) {
+	interpreter.checkMutationDuringIteration(v.StorageID(), getLocationRange)
+	interpreter.recordMutation(v)
+
+	elementType := v.SemaType(interpreter).ElementType(false)
+
+	sorter := arrayValueSorter{
+		interpreter:      interpreter,
+		getLocationRange: getLocationRange,
+		array:            v,
+		isOrderedBefore:  isOrderedBefore,
+		argumentTypes:    []sema.Type{elementType, elementType},
+	}
+
+	// The comparison function must not mutate the array while it is being sorted
+
+	interpreter.iterateContainer(v.StorageID(), func() {
+		sort.Stable(sorter)
+	})
+
+	interpreter.maybeValidateAtreeValue(v.array)
+}
+
+// arrayValueSorter sorts the elements of an array in place.
+// Elements are only moved within the underlying atree array, they are not transferred.
+// Only the arguments of the comparison function are copies of the elements
+//
+type arrayValueSorter struct {
+	interpreter      *Interpreter
+	getLocationRange func() LocationRange
+	array            *ArrayValue
+	isOrderedBefore  FunctionValue
+	argumentTypes    []sema.Type
+}
+
+var _ sort.Interface = arrayValueSorter{}
+
+func (s arrayValueSorter) Len() int {
+	return s.array.Count()
+}
+
+func (s arrayValueSorter) Less(i, j int) bool {
+	interpreter := s.interpreter
+	getLocationRange := s.getLocationRange
+
+	interpreter.ReportComputation(common.ComputationKindSortArrayValue, 1)
+
+	invocation := NewInvocation(
+		interpreter,
+		nil,
+		[]Value{
+			s.array.Get(interpreter, getLocationRange, i).
+				Transfer(
+					interpreter,
+					getLocationRange,
+					atree.Address{},
+					false,
+					nil,
+				),
+			s.array.Get(interpreter, getLocationRange, j).
+				Transfer(
+					interpreter,
+					getLocationRange,
+					atree.Address{},
+					false,
+					nil,
+				),
+		},
+		s.argumentTypes,
+		nil,
+		getLocationRange,
+	)
+
+	result, ok := s.isOrderedBefore.invoke(invocation).(BoolValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	return bool(result)
+}
+
+func (s arrayValueSorter) Swap(i, j int) {
+	array := s.array.array
+
+	first, err := array.Get(uint64(i))
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	second, err := array.Get(uint64(j))
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	_, err = array.Set(uint64(i), movedStorable{storable: second})
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	_, err = array.Set(uint64(j), movedStorable{storable: first})
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+}
+
+// movedStorable is an atree value for a storable which is moved within a container.
+// The storable is stored as-is, so the moved value is neither copied nor re-encoded
+//
+type movedStorable struct {
+	storable atree.Storable
+}
+
+var _ atree.Value = movedStorable{}
+
+func (s movedStorable) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+	return s.storable, nil
+}
+
+// NumberValue
+//
+type NumberValue interface {
+	EquatableValue
+	ToInt() int
+	Negate(*Interpreter) NumberValue
+	Plus(interpreter *Interpreter, other NumberValue) NumberValue
+	SaturatingPlus(interpreter *Interpreter, other NumberValue) NumberValue
+	Minus(interpreter *Interpreter, other NumberValue) NumberValue
+	SaturatingMinus(interpreter *Interpreter, other NumberValue) NumberValue
+	Mod(interpreter *Interpreter, other NumberValue) NumberValue
+	Mul(interpreter *Interpreter, other NumberValue) NumberValue
+	SaturatingMul(interpreter *Interpreter, other NumberValue) NumberValue
+	Div(interpreter *Interpreter, other NumberValue) NumberValue
+	SaturatingDiv(interpreter *Interpreter, other NumberValue) NumberValue
+	Less(interpreter *Interpreter, other NumberValue) BoolValue
+	LessEqual(interpreter *Interpreter, other NumberValue) BoolValue
+	Greater(interpreter *Interpreter, other NumberValue) BoolValue
+	GreaterEqual(interpreter *Interpreter, other NumberValue) BoolValue
+	ToBigEndianBytes() []byte
+}
+
+func getNumberValueMember(interpreter *Interpreter, v NumberValue, name string, typ sema.Type) Value {
+	switch name {
+
+	case sema.ToStringFunctionName:
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				interpreter := invocation.Interpreter
+				memoryUsage := common.NewStringMemoryUsage(
+					OverEstimateNumberStringLength(interpreter, v),
+				)
+				return NewStringValue(
+					interpreter,
+					memoryUsage,
+					func() string {
+						return v.String()
+					},
+				)
+			},
+			sema.ToStringFunctionType,
+		)
+
+	case sema.ToBigEndianBytesFunctionName:
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				return ByteSliceToByteArrayValue(
+					invocation.Interpreter,
+					v.ToBigEndianBytes(),
+				)
+			},
+			&sema.FunctionType{
+				ReturnTypeAnnotation: sema.NewTypeAnnotation(
+					sema.ByteArrayType,
+				),
+			},
+		)
+
+	case sema.NumericTypeSaturatingAddFunctionName:
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				other, ok := invocation.Arguments[0].(NumberValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+				return v.SaturatingPlus(
+					invocation.Interpreter,
+					other,
+				)
+			},
+			&sema.FunctionType{
+				ReturnTypeAnnotation: sema.NewTypeAnnotation(
+					typ,
+				),
+			},
+		)
+
+	case sema.NumericTypeSaturatingSubtractFunctionName:
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				other, ok := invocation.Arguments[0].(NumberValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+				return v.SaturatingMinus(
+					invocation.Interpreter,
+					other,
+				)
+			},
+			&sema.FunctionType{
+				ReturnTypeAnnotation: sema.NewTypeAnnotation(
+					typ,
+				),
 			},
 		)
 
@@ -5235,6 +6129,46 @@ func safeAddInt64(a, b int64) int64 {
 	return a + b
 }
 
+func safeSubtractInt64(a, b int64) int64 {
+	// INT32-C
+	if (b < 0) && (a > (math.MaxInt64 + b)) {
+		panic(OverflowError{})
+	} else if (b > 0) && (a < (math.MinInt64 + b)) {
+		panic(UnderflowError{})
+	}
+	return a - b
+}
+
+func safeMulInt64(a, b int64) int64 {
+	// INT32-C
+	if a > 0 {
+		if b > 0 {
+			// positive * positive = positive. overflow?
+			if a > (math.MaxInt64 / b) {
+				panic(OverflowError{})
+			}
+		} else {
+			// positive * negative = negative. underflow?
+			if b < (math.MinInt64 / a) {
+				panic(UnderflowError{})
+			}
+		}
+	} else {
+		if b > 0 {
+			// negative * positive = negative. underflow?
+			if a < (math.MinInt64 / b) {
+				panic(UnderflowError{})
+			}
+		} else {
+			// negative * negative = positive. overflow?
+			if (a != 0) && (b < (math.MaxInt64 / a)) {
+				panic(OverflowError{})
+			}
+		}
+	}
+	return a * b
+}
+
 func (v Int64Value) Plus(interpreter *Interpreter, other NumberValue) NumberValue {
 	o, ok := other.(Int64Value)
 	if !ok {
@@ -13039,86 +13973,90 @@ func (Word64Value) ChildStorables() []atree.Storable {
 	return nil
 }
 
-// FixedPointValue is a fixed-point number value
-//
-type FixedPointValue interface {
-	NumberValue
-	IntegerPart() NumberValue
-	Scale() int
+// Word128Value
+
+type Word128Value struct {
+	BigInt *big.Int
 }
 
-// Fix64Value
-//
-type Fix64Value int64
-
-const Fix64MaxValue = math.MaxInt64
+func NewWord128ValueFromUint64(interpreter *Interpreter, value uint64) Word128Value {
+	return NewWord128ValueFromBigInt(
+		interpreter,
+		func() *big.Int {
+			return new(big.Int).SetUint64(value)
+		},
+	)
+}
 
-const fix64Size = int(unsafe.Sizeof(Fix64Value(0)))
+func NewUnmeteredWord128ValueFromUint64(value uint64) Word128Value {
+	return NewUnmeteredWord128ValueFromBigInt(new(big.Int).SetUint64(value))
+}
 
-var fix64MemoryUsage = common.NewNumberMemoryUsage(fix64Size)
+var Word128MemoryUsage = common.NewBigIntMemoryUsage(16)
 
-func NewFix64ValueWithInteger(gauge common.MemoryGauge, constructor func() int64) Fix64Value {
-	common.UseMemory(gauge, fix64MemoryUsage)
-	return NewUnmeteredFix64ValueWithInteger(constructor())
+func NewWord128ValueFromBigInt(memoryGauge common.MemoryGauge, bigIntConstructor func() *big.Int) Word128Value {
+	common.UseMemory(memoryGauge, Word128MemoryUsage)
+	value := bigIntConstructor()
+	return NewUnmeteredWord128ValueFromBigInt(value)
 }
 
-func NewUnmeteredFix64ValueWithInteger(integer int64) Fix64Value {
-
-	if integer < sema.Fix64TypeMinInt {
-		panic(UnderflowError{})
+func NewUnmeteredWord128ValueFromBigInt(value *big.Int) Word128Value {
+	return Word128Value{
+		BigInt: value,
 	}
+}
 
-	if integer > sema.Fix64TypeMaxInt {
-		panic(OverflowError{})
-	}
+var _ Value = Word128Value{}
+var _ atree.Storable = Word128Value{}
+var _ NumberValue = Word128Value{}
+var _ IntegerValue = Word128Value{}
+var _ EquatableValue = Word128Value{}
+var _ HashableValue = Word128Value{}
+var _ MemberAccessibleValue = Word128Value{}
 
-	return NewUnmeteredFix64Value(integer * sema.Fix64Factor)
-}
+func (Word128Value) IsValue() {}
 
-func NewFix64Value(gauge common.MemoryGauge, valueGetter func() int64) Fix64Value {
-	common.UseMemory(gauge, fix64MemoryUsage)
-	return NewUnmeteredFix64Value(valueGetter())
+func (v Word128Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitWord128Value(interpreter, v)
 }
 
-func NewUnmeteredFix64Value(integer int64) Fix64Value {
-	return Fix64Value(integer)
+func (Word128Value) Walk(_ *Interpreter, _ func(Value)) {
+	// NO-OP
 }
 
-var _ Value = Fix64Value(0)
-var _ atree.Storable = Fix64Value(0)
-var _ NumberValue = Fix64Value(0)
-var _ FixedPointValue = Fix64Value(0)
-var _ EquatableValue = Fix64Value(0)
-var _ HashableValue = Fix64Value(0)
-var _ MemberAccessibleValue = Fix64Value(0)
-
-func (Fix64Value) IsValue() {}
+func (Word128Value) StaticType(interpreter *Interpreter) StaticType {
+	return NewPrimitiveStaticType(interpreter, PrimitiveStaticTypeWord128)
+}
 
-func (v Fix64Value) Accept(interpreter *Interpreter, visitor Visitor) {
-	visitor.VisitFix64Value(interpreter, v)
+func (Word128Value) IsImportable(_ *Interpreter) bool {
+	return true
 }
 
-func (Fix64Value) Walk(_ *Interpreter, _ func(Value)) {
-	// NO-OP
+func (v Word128Value) ToInt() int {
+	if !v.BigInt.IsInt64() {
+		panic(OverflowError{})
+	}
+	return int(v.BigInt.Int64())
 }
 
-func (Fix64Value) StaticType(interpreter *Interpreter) StaticType {
-	return NewPrimitiveStaticType(interpreter, PrimitiveStaticTypeFix64)
+func (v Word128Value) ByteLength() int {
+	return common.BigIntByteLength(v.BigInt)
 }
 
-func (Fix64Value) IsImportable(_ *Interpreter) bool {
-	return true
+func (v Word128Value) ToBigInt(memoryGauge common.MemoryGauge) *big.Int {
+	common.UseMemory(memoryGauge, common.NewBigIntMemoryUsage(v.ByteLength()))
+	return new(big.Int).Set(v.BigInt)
 }
 
-func (v Fix64Value) String() string {
-	return format.Fix64(int64(v))
+func (v Word128Value) String() string {
+	return format.BigInt(v.BigInt)
 }
 
-func (v Fix64Value) RecursiveString(_ SeenReferences) string {
+func (v Word128Value) RecursiveString(_ SeenReferences) string {
 	return v.String()
 }
 
-func (v Fix64Value) MeteredString(memoryGauge common.MemoryGauge, _ SeenReferences) string {
+func (v Word128Value) MeteredString(memoryGauge common.MemoryGauge, _ SeenReferences) string {
 	common.UseMemory(
 		memoryGauge,
 		common.NewRawStringMemoryUsage(
@@ -13128,25 +14066,12 @@ func (v Fix64Value) MeteredString(memoryGauge common.MemoryGauge, _ SeenReferenc
 	return v.String()
 }
 
-func (v Fix64Value) ToInt() int {
-	return int(v / sema.Fix64Factor)
-}
-
-func (v Fix64Value) Negate(interpreter *Interpreter) NumberValue {
-	// INT32-C
-	if v == math.MinInt64 {
-		panic(OverflowError{})
-	}
-
-	valueGetter := func() int64 {
-		return int64(-v)
-	}
-
-	return NewFix64Value(interpreter, valueGetter)
+func (v Word128Value) Negate(*Interpreter) NumberValue {
+	panic(errors.NewUnreachableError())
 }
 
-func (v Fix64Value) Plus(interpreter *Interpreter, other NumberValue) NumberValue {
-	o, ok := other.(Fix64Value)
+func (v Word128Value) Plus(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Word128Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationPlus,
@@ -13155,393 +14080,374 @@ func (v Fix64Value) Plus(interpreter *Interpreter, other NumberValue) NumberValu
 		})
 	}
 
-	valueGetter := func() int64 {
-		return safeAddInt64(int64(v), int64(o))
-	}
+	return NewWord128ValueFromBigInt(
+		interpreter,
+		func() *big.Int {
+			sum := new(big.Int)
+			sum.Add(v.BigInt, o.BigInt)
+			// Given that this value is backed by an arbitrary size integer,
+			// the result has to be truncated to 128 bits to wrap around
+			return sum.And(sum, sema.Word128TypeMaxIntBig)
+		},
+	)
+}
 
-	return NewFix64Value(interpreter, valueGetter)
+func (v Word128Value) SaturatingPlus(*Interpreter, NumberValue) NumberValue {
+	panic(errors.NewUnreachableError())
 }
 
-func (v Fix64Value) SaturatingPlus(interpreter *Interpreter, other NumberValue) NumberValue {
-	o, ok := other.(Fix64Value)
+func (v Word128Value) Minus(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Word128Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			FunctionName: sema.NumericTypeSaturatingAddFunctionName,
-			LeftType:     v.StaticType(interpreter),
-			RightType:    other.StaticType(interpreter),
+			Operation: ast.OperationMinus,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
 		})
 	}
 
-	valueGetter := func() int64 {
-		// INT32-C
-		if (o > 0) && (v > (math.MaxInt64 - o)) {
-			return math.MaxInt64
-		} else if (o < 0) && (v < (math.MinInt64 - o)) {
-			return math.MinInt64
-		}
-		return int64(v + o)
-	}
+	return NewWord128ValueFromBigInt(
+		interpreter,
+		func() *big.Int {
+			diff := new(big.Int)
+			diff.Sub(v.BigInt, o.BigInt)
+			// A negative difference is in two's complement representation,
+			// so truncating it to 128 bits wraps it around
+			return diff.And(diff, sema.Word128TypeMaxIntBig)
+		},
+	)
+}
 
-	return NewFix64Value(interpreter, valueGetter)
+func (v Word128Value) SaturatingMinus(*Interpreter, NumberValue) NumberValue {
+	panic(errors.NewUnreachableError())
 }
 
-func (v Fix64Value) Minus(interpreter *Interpreter, other NumberValue) NumberValue {
-	o, ok := other.(Fix64Value)
+func (v Word128Value) Mod(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Word128Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			Operation: ast.OperationMinus,
+			Operation: ast.OperationMod,
 			LeftType:  v.StaticType(interpreter),
 			RightType: other.StaticType(interpreter),
 		})
 	}
 
-	valueGetter := func() int64 {
-		// INT32-C
-		if (o > 0) && (v < (math.MinInt64 + o)) {
-			panic(OverflowError{})
-		} else if (o < 0) && (v > (math.MaxInt64 + o)) {
-			panic(UnderflowError{})
-		}
-
-		return int64(v - o)
-	}
-
-	return NewFix64Value(interpreter, valueGetter)
+	return NewWord128ValueFromBigInt(
+		interpreter,
+		func() *big.Int {
+			res := new(big.Int)
+			if o.BigInt.Cmp(res) == 0 {
+				panic(DivisionByZeroError{})
+			}
+			return res.Rem(v.BigInt, o.BigInt)
+		},
+	)
 }
 
-func (v Fix64Value) SaturatingMinus(interpreter *Interpreter, other NumberValue) NumberValue {
-	o, ok := other.(Fix64Value)
+func (v Word128Value) Mul(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Word128Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			FunctionName: sema.NumericTypeSaturatingSubtractFunctionName,
-			LeftType:     v.StaticType(interpreter),
-			RightType:    other.StaticType(interpreter),
+			Operation: ast.OperationMul,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
 		})
 	}
 
-	valueGetter := func() int64 {
-		// INT32-C
-		if (o > 0) && (v < (math.MinInt64 + o)) {
-			return math.MinInt64
-		} else if (o < 0) && (v > (math.MaxInt64 + o)) {
-			return math.MaxInt64
-		}
-		return int64(v - o)
-	}
-
-	return NewFix64Value(interpreter, valueGetter)
+	return NewWord128ValueFromBigInt(
+		interpreter,
+		func() *big.Int {
+			res := new(big.Int)
+			res.Mul(v.BigInt, o.BigInt)
+			return res.And(res, sema.Word128TypeMaxIntBig)
+		},
+	)
 }
 
-var minInt64Big = big.NewInt(math.MinInt64)
-var maxInt64Big = big.NewInt(math.MaxInt64)
+func (v Word128Value) SaturatingMul(*Interpreter, NumberValue) NumberValue {
+	panic(errors.NewUnreachableError())
+}
 
-func (v Fix64Value) Mul(interpreter *Interpreter, other NumberValue) NumberValue {
-	o, ok := other.(Fix64Value)
+func (v Word128Value) Div(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Word128Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			Operation: ast.OperationMul,
+			Operation: ast.OperationDiv,
 			LeftType:  v.StaticType(interpreter),
 			RightType: other.StaticType(interpreter),
 		})
 	}
 
-	a := new(big.Int).SetInt64(int64(v))
-	b := new(big.Int).SetInt64(int64(o))
-
-	valueGetter := func() int64 {
-		result := new(big.Int).Mul(a, b)
-		result.Div(result, sema.Fix64FactorBig)
-
-		if result.Cmp(minInt64Big) < 0 {
-			panic(UnderflowError{})
-		} else if result.Cmp(maxInt64Big) > 0 {
-			panic(OverflowError{})
-		}
+	return NewWord128ValueFromBigInt(
+		interpreter,
+		func() *big.Int {
+			res := new(big.Int)
+			if o.BigInt.Cmp(res) == 0 {
+				panic(DivisionByZeroError{})
+			}
+			return res.Div(v.BigInt, o.BigInt)
+		},
+	)
 
-		return result.Int64()
-	}
+}
 
-	return NewFix64Value(interpreter, valueGetter)
+func (v Word128Value) SaturatingDiv(*Interpreter, NumberValue) NumberValue {
+	panic(errors.NewUnreachableError())
 }
 
-func (v Fix64Value) SaturatingMul(interpreter *Interpreter, other NumberValue) NumberValue {
-	o, ok := other.(Fix64Value)
+func (v Word128Value) Less(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(Word128Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			FunctionName: sema.NumericTypeSaturatingMultiplyFunctionName,
-			LeftType:     v.StaticType(interpreter),
-			RightType:    other.StaticType(interpreter),
+			Operation: ast.OperationLess,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
 		})
 	}
 
-	a := new(big.Int).SetInt64(int64(v))
-	b := new(big.Int).SetInt64(int64(o))
-
-	valueGetter := func() int64 {
-		result := new(big.Int).Mul(a, b)
-		result.Div(result, sema.Fix64FactorBig)
-
-		if result.Cmp(minInt64Big) < 0 {
-			return math.MinInt64
-		} else if result.Cmp(maxInt64Big) > 0 {
-			return math.MaxInt64
-		}
+	return NewBoolValueFromConstructor(
+		interpreter,
+		func() bool {
+			cmp := v.BigInt.Cmp(o.BigInt)
+			return cmp == -1
+		},
+	)
+}
 
-		return result.Int64()
+func (v Word128Value) LessEqual(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(Word128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationLessEqual,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
 	}
 
-	return NewFix64Value(interpreter, valueGetter)
+	return NewBoolValueFromConstructor(
+		interpreter,
+		func() bool {
+			cmp := v.BigInt.Cmp(o.BigInt)
+			return cmp <= 0
+		},
+	)
 }
 
-func (v Fix64Value) Div(interpreter *Interpreter, other NumberValue) NumberValue {
-	o, ok := other.(Fix64Value)
+func (v Word128Value) Greater(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(Word128Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			Operation: ast.OperationDiv,
+			Operation: ast.OperationGreater,
 			LeftType:  v.StaticType(interpreter),
 			RightType: other.StaticType(interpreter),
 		})
 	}
 
-	a := new(big.Int).SetInt64(int64(v))
-	b := new(big.Int).SetInt64(int64(o))
-
-	valueGetter := func() int64 {
-		result := new(big.Int).Mul(a, sema.Fix64FactorBig)
-		result.Div(result, b)
-
-		if result.Cmp(minInt64Big) < 0 {
-			panic(UnderflowError{})
-		} else if result.Cmp(maxInt64Big) > 0 {
-			panic(OverflowError{})
-		}
-
-		return result.Int64()
-	}
-
-	return NewFix64Value(interpreter, valueGetter)
+	return NewBoolValueFromConstructor(
+		interpreter,
+		func() bool {
+			cmp := v.BigInt.Cmp(o.BigInt)
+			return cmp == 1
+		},
+	)
 }
 
-func (v Fix64Value) SaturatingDiv(interpreter *Interpreter, other NumberValue) NumberValue {
-	o, ok := other.(Fix64Value)
+func (v Word128Value) GreaterEqual(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(Word128Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			FunctionName: sema.NumericTypeSaturatingDivideFunctionName,
-			LeftType:     v.StaticType(interpreter),
-			RightType:    other.StaticType(interpreter),
+			Operation: ast.OperationGreaterEqual,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
 		})
 	}
 
-	a := new(big.Int).SetInt64(int64(v))
-	b := new(big.Int).SetInt64(int64(o))
+	return NewBoolValueFromConstructor(
+		interpreter,
+		func() bool {
+			cmp := v.BigInt.Cmp(o.BigInt)
+			return cmp >= 0
+		},
+	)
+}
 
-	valueGetter := func() int64 {
-		result := new(big.Int).Mul(a, sema.Fix64FactorBig)
-		result.Div(result, b)
+func (v Word128Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherInt, ok := other.(Word128Value)
+	if !ok {
+		return false
+	}
+	cmp := v.BigInt.Cmp(otherInt.BigInt)
+	return cmp == 0
+}
 
-		if result.Cmp(minInt64Big) < 0 {
-			return math.MinInt64
-		} else if result.Cmp(maxInt64Big) > 0 {
-			return math.MaxInt64
-		}
+// HashInput returns a byte slice containing:
+// - HashInputTypeWord128 (1 byte)
+// - big int encoded in big endian (n bytes)
+func (v Word128Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	b := UnsignedBigIntToBigEndianBytes(v.BigInt)
 
-		return result.Int64()
+	length := 1 + len(b)
+	var buffer []byte
+	if length <= len(scratch) {
+		buffer = scratch[:length]
+	} else {
+		buffer = make([]byte, length)
 	}
 
-	return NewFix64Value(interpreter, valueGetter)
+	buffer[0] = byte(HashInputTypeWord128)
+	copy(buffer[1:], b)
+	return buffer
 }
 
-func (v Fix64Value) Mod(interpreter *Interpreter, other NumberValue) NumberValue {
-	o, ok := other.(Fix64Value)
-	if !ok {
-		panic(InvalidOperandsError{
-			Operation: ast.OperationMod,
-			LeftType:  v.StaticType(interpreter),
-			RightType: other.StaticType(interpreter),
-		})
-	}
+func ConvertWord128(memoryGauge common.MemoryGauge, value Value) Value {
+	return NewWord128ValueFromBigInt(
+		memoryGauge,
+		func() *big.Int {
 
-	// v - int(v/o) * o
-	quotient, ok := v.Div(interpreter, o).(Fix64Value)
+			var v *big.Int
+
+			switch value := value.(type) {
+			case BigNumberValue:
+				v = value.ToBigInt(memoryGauge)
+
+			case NumberValue:
+				v = big.NewInt(int64(value.ToInt()))
+
+			default:
+				panic(errors.NewUnreachableError())
+			}
+
+			// Like the other Word types, the conversion wraps around,
+			// i.e. the value is truncated to 128 bits
+			return v.And(v, sema.Word128TypeMaxIntBig)
+		},
+	)
+}
+
+func (v Word128Value) BitwiseOr(interpreter *Interpreter, other IntegerValue) IntegerValue {
+	o, ok := other.(Word128Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			Operation: ast.OperationMod,
+			Operation: ast.OperationBitwiseOr,
 			LeftType:  v.StaticType(interpreter),
 			RightType: other.StaticType(interpreter),
 		})
 	}
 
-	truncatedQuotient := NewFix64Value(
+	return NewWord128ValueFromBigInt(
 		interpreter,
-		func() int64 {
-			return (int64(quotient) / sema.Fix64Factor) * sema.Fix64Factor
+		func() *big.Int {
+			res := new(big.Int)
+			return res.Or(v.BigInt, o.BigInt)
 		},
 	)
-
-	return v.Minus(
-		interpreter,
-		truncatedQuotient.Mul(interpreter, o),
-	)
 }
 
-func (v Fix64Value) Less(interpreter *Interpreter, other NumberValue) BoolValue {
-	o, ok := other.(Fix64Value)
+func (v Word128Value) BitwiseXor(interpreter *Interpreter, other IntegerValue) IntegerValue {
+	o, ok := other.(Word128Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			Operation: ast.OperationLess,
+			Operation: ast.OperationBitwiseXor,
 			LeftType:  v.StaticType(interpreter),
 			RightType: other.StaticType(interpreter),
 		})
 	}
 
-	return NewBoolValueFromConstructor(
+	return NewWord128ValueFromBigInt(
 		interpreter,
-		func() bool {
-			return v < o
+		func() *big.Int {
+			res := new(big.Int)
+			return res.Xor(v.BigInt, o.BigInt)
 		},
 	)
 }
 
-func (v Fix64Value) LessEqual(interpreter *Interpreter, other NumberValue) BoolValue {
-	o, ok := other.(Fix64Value)
+func (v Word128Value) BitwiseAnd(interpreter *Interpreter, other IntegerValue) IntegerValue {
+	o, ok := other.(Word128Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			Operation: ast.OperationLessEqual,
+			Operation: ast.OperationBitwiseAnd,
 			LeftType:  v.StaticType(interpreter),
 			RightType: other.StaticType(interpreter),
 		})
 	}
 
-	return NewBoolValueFromConstructor(
+	return NewWord128ValueFromBigInt(
 		interpreter,
-		func() bool {
-			return v <= o
+		func() *big.Int {
+			res := new(big.Int)
+			return res.And(v.BigInt, o.BigInt)
 		},
 	)
+
 }
 
-func (v Fix64Value) Greater(interpreter *Interpreter, other NumberValue) BoolValue {
-	o, ok := other.(Fix64Value)
+func (v Word128Value) BitwiseLeftShift(interpreter *Interpreter, other IntegerValue) IntegerValue {
+	o, ok := other.(Word128Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			Operation: ast.OperationGreater,
+			Operation: ast.OperationBitwiseLeftShift,
 			LeftType:  v.StaticType(interpreter),
 			RightType: other.StaticType(interpreter),
 		})
 	}
 
-	return NewBoolValueFromConstructor(
+	return NewWord128ValueFromBigInt(
 		interpreter,
-		func() bool {
-			return v > o
+		func() *big.Int {
+			res := new(big.Int)
+			// Shifting by the bit size or more shifts out all bits
+			if !o.BigInt.IsUint64() || o.BigInt.Uint64() >= 128 {
+				return res
+			}
+			res.Lsh(v.BigInt, uint(o.BigInt.Uint64()))
+			return res.And(res, sema.Word128TypeMaxIntBig)
 		},
 	)
 }
 
-func (v Fix64Value) GreaterEqual(interpreter *Interpreter, other NumberValue) BoolValue {
-	o, ok := other.(Fix64Value)
+func (v Word128Value) BitwiseRightShift(interpreter *Interpreter, other IntegerValue) IntegerValue {
+	o, ok := other.(Word128Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			Operation: ast.OperationGreaterEqual,
+			Operation: ast.OperationBitwiseRightShift,
 			LeftType:  v.StaticType(interpreter),
 			RightType: other.StaticType(interpreter),
 		})
 	}
 
-	return NewBoolValueFromConstructor(
+	return NewWord128ValueFromBigInt(
 		interpreter,
-		func() bool {
-			return v >= o
+		func() *big.Int {
+			res := new(big.Int)
+			// Shifting by the bit size or more shifts out all bits
+			if !o.BigInt.IsUint64() || o.BigInt.Uint64() >= 128 {
+				return res
+			}
+			return res.Rsh(v.BigInt, uint(o.BigInt.Uint64()))
 		},
 	)
 }
 
-func (v Fix64Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
-	otherFix64, ok := other.(Fix64Value)
-	if !ok {
-		return false
-	}
-	return v == otherFix64
-}
-
-// HashInput returns a byte slice containing:
-// - HashInputTypeFix64 (1 byte)
-// - int64 value encoded in big-endian (8 bytes)
-func (v Fix64Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
-	scratch[0] = byte(HashInputTypeFix64)
-	binary.BigEndian.PutUint64(scratch[1:], uint64(v))
-	return scratch[:9]
-}
-
-func ConvertFix64(memoryGauge common.MemoryGauge, value Value) Fix64Value {
-	switch value := value.(type) {
-	case Fix64Value:
-		return value
-
-	case UFix64Value:
-		if value > Fix64MaxValue {
-			panic(OverflowError{})
-		}
-		return NewFix64Value(
-			memoryGauge,
-			func() int64 {
-				return int64(value)
-			},
-		)
-
-	case BigNumberValue:
-		converter := func() int64 {
-			v := value.ToBigInt(memoryGauge)
-
-			// First, check if the value is at least in the int64 range.
-			// The integer range for Fix64 is smaller, but this test at least
-			// allows us to call `v.Int64()` safely.
-
-			if !v.IsInt64() {
-				panic(OverflowError{})
-			}
-
-			return v.Int64()
-		}
-
-		// Now check that the integer value fits the range of Fix64
-		return NewFix64ValueWithInteger(memoryGauge, converter)
-
-	case NumberValue:
-		// Check that the integer value fits the range of Fix64
-		return NewFix64ValueWithInteger(
-			memoryGauge,
-			func() int64 {
-				return int64(value.ToInt())
-			},
-		)
-
-	default:
-		panic(fmt.Sprintf("can't convert Fix64: %s", value))
-	}
-}
-
-func (v Fix64Value) GetMember(interpreter *Interpreter, _ func() LocationRange, name string) Value {
-	return getNumberValueMember(interpreter, v, name, sema.Fix64Type)
+func (v Word128Value) GetMember(interpreter *Interpreter, _ func() LocationRange, name string) Value {
+	return getNumberValueMember(interpreter, v, name, sema.Word128Type)
 }
 
-func (Fix64Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+func (Word128Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
 	// Numbers have no removable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (Fix64Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+func (Word128Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
 	// Numbers have no settable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (v Fix64Value) ToBigEndianBytes() []byte {
-	b := make([]byte, 8)
-	binary.BigEndian.PutUint64(b, uint64(v))
-	return b
+func (v Word128Value) ToBigEndianBytes() []byte {
+	return UnsignedBigIntToBigEndianBytes(v.BigInt)
 }
 
-func (v Fix64Value) ConformsToStaticType(
+func (v Word128Value) ConformsToStaticType(
 	_ *Interpreter,
 	_ func() LocationRange,
 	_ TypeConformanceResults,
@@ -13549,23 +14455,23 @@ func (v Fix64Value) ConformsToStaticType(
 	return true
 }
 
-func (Fix64Value) IsStorable() bool {
+func (Word128Value) IsStorable() bool {
 	return true
 }
 
-func (v Fix64Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+func (v Word128Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
 	return v, nil
 }
 
-func (Fix64Value) NeedsStoreTo(_ atree.Address) bool {
+func (Word128Value) NeedsStoreTo(_ atree.Address) bool {
 	return false
 }
 
-func (Fix64Value) IsResourceKinded(_ *Interpreter) bool {
+func (Word128Value) IsResourceKinded(_ *Interpreter) bool {
 	return false
 }
 
-func (v Fix64Value) Transfer(
+func (v Word128Value) Transfer(
 	interpreter *Interpreter,
 	_ func() LocationRange,
 	_ atree.Address,
@@ -13578,101 +14484,110 @@ func (v Fix64Value) Transfer(
 	return v
 }
 
-func (v Fix64Value) Clone(_ *Interpreter) Value {
-	return v
+func (v Word128Value) Clone(_ *Interpreter) Value {
+	return NewUnmeteredWord128ValueFromBigInt(v.BigInt)
 }
 
-func (Fix64Value) DeepRemove(_ *Interpreter) {
+func (Word128Value) DeepRemove(_ *Interpreter) {
 	// NO-OP
 }
 
-func (v Fix64Value) ByteSize() uint32 {
-	return cborTagSize + getIntCBORSize(int64(v))
+func (v Word128Value) ByteSize() uint32 {
+	return cborTagSize + getBigIntCBORSize(v.BigInt)
 }
 
-func (v Fix64Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+func (v Word128Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
 	return v, nil
 }
 
-func (Fix64Value) ChildStorables() []atree.Storable {
+func (Word128Value) ChildStorables() []atree.Storable {
 	return nil
 }
 
-func (v Fix64Value) IntegerPart() NumberValue {
-	return UInt64Value(v / sema.Fix64Factor)
-}
+// Word256Value
 
-func (Fix64Value) Scale() int {
-	return sema.Fix64Scale
+type Word256Value struct {
+	BigInt *big.Int
 }
 
-// UFix64Value
-//
-type UFix64Value uint64
+func NewWord256ValueFromUint64(interpreter *Interpreter, value uint64) Word256Value {
+	return NewWord256ValueFromBigInt(
+		interpreter,
+		func() *big.Int {
+			return new(big.Int).SetUint64(value)
+		},
+	)
+}
 
-const UFix64MaxValue = math.MaxUint64
+func NewUnmeteredWord256ValueFromUint64(value uint64) Word256Value {
+	return NewUnmeteredWord256ValueFromBigInt(new(big.Int).SetUint64(value))
+}
 
-const ufix64Size = int(unsafe.Sizeof(UFix64Value(0)))
+var Word256MemoryUsage = common.NewBigIntMemoryUsage(32)
 
-var ufix64MemoryUsage = common.NewNumberMemoryUsage(ufix64Size)
-
-func NewUFix64ValueWithInteger(gauge common.MemoryGauge, constructor func() uint64) UFix64Value {
-	common.UseMemory(gauge, ufix64MemoryUsage)
-	return NewUnmeteredUFix64ValueWithInteger(constructor())
+func NewWord256ValueFromBigInt(memoryGauge common.MemoryGauge, bigIntConstructor func() *big.Int) Word256Value {
+	common.UseMemory(memoryGauge, Word256MemoryUsage)
+	value := bigIntConstructor()
+	return NewUnmeteredWord256ValueFromBigInt(value)
 }
 
-func NewUnmeteredUFix64ValueWithInteger(integer uint64) UFix64Value {
-	if integer > sema.UFix64TypeMaxInt {
-		panic(OverflowError{})
+func NewUnmeteredWord256ValueFromBigInt(value *big.Int) Word256Value {
+	return Word256Value{
+		BigInt: value,
 	}
-
-	return NewUnmeteredUFix64Value(integer * sema.Fix64Factor)
 }
 
-func NewUFix64Value(gauge common.MemoryGauge, constructor func() uint64) UFix64Value {
-	common.UseMemory(gauge, ufix64MemoryUsage)
-	return NewUnmeteredUFix64Value(constructor())
-}
+var _ Value = Word256Value{}
+var _ atree.Storable = Word256Value{}
+var _ NumberValue = Word256Value{}
+var _ IntegerValue = Word256Value{}
+var _ EquatableValue = Word256Value{}
+var _ HashableValue = Word256Value{}
+var _ MemberAccessibleValue = Word256Value{}
 
-func NewUnmeteredUFix64Value(integer uint64) UFix64Value {
-	return UFix64Value(integer)
+func (Word256Value) IsValue() {}
+
+func (v Word256Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitWord256Value(interpreter, v)
 }
 
-var _ Value = UFix64Value(0)
-var _ atree.Storable = UFix64Value(0)
-var _ NumberValue = UFix64Value(0)
-var _ FixedPointValue = Fix64Value(0)
-var _ EquatableValue = UFix64Value(0)
-var _ HashableValue = UFix64Value(0)
-var _ MemberAccessibleValue = UFix64Value(0)
+func (Word256Value) Walk(_ *Interpreter, _ func(Value)) {
+	// NO-OP
+}
 
-func (UFix64Value) IsValue() {}
+func (Word256Value) StaticType(interpreter *Interpreter) StaticType {
+	return NewPrimitiveStaticType(interpreter, PrimitiveStaticTypeWord256)
+}
 
-func (v UFix64Value) Accept(interpreter *Interpreter, visitor Visitor) {
-	visitor.VisitUFix64Value(interpreter, v)
+func (Word256Value) IsImportable(_ *Interpreter) bool {
+	return true
 }
 
-func (UFix64Value) Walk(_ *Interpreter, _ func(Value)) {
-	// NO-OP
+func (v Word256Value) ToInt() int {
+	if !v.BigInt.IsInt64() {
+		panic(OverflowError{})
+	}
+	return int(v.BigInt.Int64())
 }
 
-func (UFix64Value) StaticType(interpreter *Interpreter) StaticType {
-	return NewPrimitiveStaticType(interpreter, PrimitiveStaticTypeUFix64)
+func (v Word256Value) ByteLength() int {
+	return common.BigIntByteLength(v.BigInt)
 }
 
-func (UFix64Value) IsImportable(_ *Interpreter) bool {
-	return true
+func (v Word256Value) ToBigInt(memoryGauge common.MemoryGauge) *big.Int {
+	common.UseMemory(memoryGauge, common.NewBigIntMemoryUsage(v.ByteLength()))
+	return new(big.Int).Set(v.BigInt)
 }
 
-func (v UFix64Value) String() string {
-	return format.UFix64(uint64(v))
+func (v Word256Value) String() string {
+	return format.BigInt(v.BigInt)
 }
 
-func (v UFix64Value) RecursiveString(_ SeenReferences) string {
+func (v Word256Value) RecursiveString(_ SeenReferences) string {
 	return v.String()
 }
 
-func (v UFix64Value) MeteredString(memoryGauge common.MemoryGauge, _ SeenReferences) string {
+func (v Word256Value) MeteredString(memoryGauge common.MemoryGauge, _ SeenReferences) string {
 	common.UseMemory(
 		memoryGauge,
 		common.NewRawStringMemoryUsage(
@@ -13682,16 +14597,12 @@ func (v UFix64Value) MeteredString(memoryGauge common.MemoryGauge, _ SeenReferen
 	return v.String()
 }
 
-func (v UFix64Value) ToInt() int {
-	return int(v / sema.Fix64Factor)
-}
-
-func (v UFix64Value) Negate(*Interpreter) NumberValue {
+func (v Word256Value) Negate(*Interpreter) NumberValue {
 	panic(errors.NewUnreachableError())
 }
 
-func (v UFix64Value) Plus(interpreter *Interpreter, other NumberValue) NumberValue {
-	o, ok := other.(UFix64Value)
+func (v Word256Value) Plus(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Word256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationPlus,
@@ -13700,37 +14611,24 @@ func (v UFix64Value) Plus(interpreter *Interpreter, other NumberValue) NumberVal
 		})
 	}
 
-	valueGetter := func() uint64 {
-		return safeAddUint64(uint64(v), uint64(o))
-	}
-
-	return NewUFix64Value(interpreter, valueGetter)
+	return NewWord256ValueFromBigInt(
+		interpreter,
+		func() *big.Int {
+			sum := new(big.Int)
+			sum.Add(v.BigInt, o.BigInt)
+			// Given that this value is backed by an arbitrary size integer,
+			// the result has to be truncated to 256 bits to wrap around
+			return sum.And(sum, sema.Word256TypeMaxIntBig)
+		},
+	)
 }
 
-func (v UFix64Value) SaturatingPlus(interpreter *Interpreter, other NumberValue) NumberValue {
-	o, ok := other.(UFix64Value)
-	if !ok {
-		panic(InvalidOperandsError{
-			FunctionName: sema.NumericTypeSaturatingAddFunctionName,
-			LeftType:     v.StaticType(interpreter),
-			RightType:    other.StaticType(interpreter),
-		})
-	}
-
-	valueGetter := func() uint64 {
-		sum := v + o
-		// INT30-C
-		if sum < v {
-			return math.MaxUint64
-		}
-		return uint64(sum)
-	}
-
-	return NewUFix64Value(interpreter, valueGetter)
+func (v Word256Value) SaturatingPlus(*Interpreter, NumberValue) NumberValue {
+	panic(errors.NewUnreachableError())
 }
 
-func (v UFix64Value) Minus(interpreter *Interpreter, other NumberValue) NumberValue {
-	o, ok := other.(UFix64Value)
+func (v Word256Value) Minus(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Word256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMinus,
@@ -13739,44 +14637,46 @@ func (v UFix64Value) Minus(interpreter *Interpreter, other NumberValue) NumberVa
 		})
 	}
 
-	valueGetter := func() uint64 {
-		diff := v - o
-
-		// INT30-C
-		if diff > v {
-			panic(UnderflowError{})
-		}
-		return uint64(diff)
-	}
+	return NewWord256ValueFromBigInt(
+		interpreter,
+		func() *big.Int {
+			diff := new(big.Int)
+			diff.Sub(v.BigInt, o.BigInt)
+			// A negative difference is in two's complement representation,
+			// so truncating it to 256 bits wraps it around
+			return diff.And(diff, sema.Word256TypeMaxIntBig)
+		},
+	)
+}
 
-	return NewUFix64Value(interpreter, valueGetter)
+func (v Word256Value) SaturatingMinus(*Interpreter, NumberValue) NumberValue {
+	panic(errors.NewUnreachableError())
 }
 
-func (v UFix64Value) SaturatingMinus(interpreter *Interpreter, other NumberValue) NumberValue {
-	o, ok := other.(UFix64Value)
+func (v Word256Value) Mod(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Word256Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			FunctionName: sema.NumericTypeSaturatingSubtractFunctionName,
-			LeftType:     v.StaticType(interpreter),
-			RightType:    other.StaticType(interpreter),
+			Operation: ast.OperationMod,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
 		})
 	}
 
-	valueGetter := func() uint64 {
-		diff := v - o
-
-		// INT30-C
-		if diff > v {
-			return 0
-		}
-		return uint64(diff)
-	}
-
-	return NewUFix64Value(interpreter, valueGetter)
+	return NewWord256ValueFromBigInt(
+		interpreter,
+		func() *big.Int {
+			res := new(big.Int)
+			if o.BigInt.Cmp(res) == 0 {
+				panic(DivisionByZeroError{})
+			}
+			return res.Rem(v.BigInt, o.BigInt)
+		},
+	)
 }
 
-func (v UFix64Value) Mul(interpreter *Interpreter, other NumberValue) NumberValue {
-	o, ok := other.(UFix64Value)
+func (v Word256Value) Mul(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Word256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationMul,
@@ -13785,52 +14685,22 @@ func (v UFix64Value) Mul(interpreter *Interpreter, other NumberValue) NumberValu
 		})
 	}
 
-	a := new(big.Int).SetUint64(uint64(v))
-	b := new(big.Int).SetUint64(uint64(o))
-
-	valueGetter := func() uint64 {
-		result := new(big.Int).Mul(a, b)
-		result.Div(result, sema.Fix64FactorBig)
-
-		if !result.IsUint64() {
-			panic(OverflowError{})
-		}
-
-		return result.Uint64()
-	}
-
-	return NewUFix64Value(interpreter, valueGetter)
+	return NewWord256ValueFromBigInt(
+		interpreter,
+		func() *big.Int {
+			res := new(big.Int)
+			res.Mul(v.BigInt, o.BigInt)
+			return res.And(res, sema.Word256TypeMaxIntBig)
+		},
+	)
 }
 
-func (v UFix64Value) SaturatingMul(interpreter *Interpreter, other NumberValue) NumberValue {
-	o, ok := other.(UFix64Value)
-	if !ok {
-		panic(InvalidOperandsError{
-			FunctionName: sema.NumericTypeSaturatingMultiplyFunctionName,
-			LeftType:     v.StaticType(interpreter),
-			RightType:    other.StaticType(interpreter),
-		})
-	}
-
-	a := new(big.Int).SetUint64(uint64(v))
-	b := new(big.Int).SetUint64(uint64(o))
-
-	valueGetter := func() uint64 {
-		result := new(big.Int).Mul(a, b)
-		result.Div(result, sema.Fix64FactorBig)
-
-		if !result.IsUint64() {
-			return math.MaxUint64
-		}
-
-		return result.Uint64()
-	}
-
-	return NewUFix64Value(interpreter, valueGetter)
+func (v Word256Value) SaturatingMul(*Interpreter, NumberValue) NumberValue {
+	panic(errors.NewUnreachableError())
 }
 
-func (v UFix64Value) Div(interpreter *Interpreter, other NumberValue) NumberValue {
-	o, ok := other.(UFix64Value)
+func (v Word256Value) Div(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Word256Value)
 	if !ok {
 		panic(InvalidOperandsError{
 			Operation: ast.OperationDiv,
@@ -13839,72 +14709,66 @@ func (v UFix64Value) Div(interpreter *Interpreter, other NumberValue) NumberValu
 		})
 	}
 
-	a := new(big.Int).SetUint64(uint64(v))
-	b := new(big.Int).SetUint64(uint64(o))
-
-	valueGetter := func() uint64 {
-		result := new(big.Int).Mul(a, sema.Fix64FactorBig)
-		result.Div(result, b)
-
-		return result.Uint64()
-	}
+	return NewWord256ValueFromBigInt(
+		interpreter,
+		func() *big.Int {
+			res := new(big.Int)
+			if o.BigInt.Cmp(res) == 0 {
+				panic(DivisionByZeroError{})
+			}
+			return res.Div(v.BigInt, o.BigInt)
+		},
+	)
 
-	return NewUFix64Value(interpreter, valueGetter)
 }
 
-func (v UFix64Value) SaturatingDiv(interpreter *Interpreter, other NumberValue) NumberValue {
-	defer func() {
-		r := recover()
-		if _, ok := r.(InvalidOperandsError); ok {
-			panic(InvalidOperandsError{
-				FunctionName: sema.NumericTypeSaturatingDivideFunctionName,
-				LeftType:     v.StaticType(interpreter),
-				RightType:    other.StaticType(interpreter),
-			})
-		}
-	}()
-
-	return v.Div(interpreter, other)
+func (v Word256Value) SaturatingDiv(*Interpreter, NumberValue) NumberValue {
+	panic(errors.NewUnreachableError())
 }
 
-func (v UFix64Value) Mod(interpreter *Interpreter, other NumberValue) NumberValue {
-	o, ok := other.(UFix64Value)
+func (v Word256Value) Less(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(Word256Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			Operation: ast.OperationMod,
+			Operation: ast.OperationLess,
 			LeftType:  v.StaticType(interpreter),
 			RightType: other.StaticType(interpreter),
 		})
 	}
 
-	// v - int(v/o) * o
-	quotient, ok := v.Div(interpreter, o).(UFix64Value)
+	return NewBoolValueFromConstructor(
+		interpreter,
+		func() bool {
+			cmp := v.BigInt.Cmp(o.BigInt)
+			return cmp == -1
+		},
+	)
+}
+
+func (v Word256Value) LessEqual(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(Word256Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			Operation: ast.OperationMod,
+			Operation: ast.OperationLessEqual,
 			LeftType:  v.StaticType(interpreter),
 			RightType: other.StaticType(interpreter),
 		})
 	}
 
-	truncatedQuotient := NewUFix64Value(
+	return NewBoolValueFromConstructor(
 		interpreter,
-		func() uint64 {
-			return (uint64(quotient) / sema.Fix64Factor) * sema.Fix64Factor
+		func() bool {
+			cmp := v.BigInt.Cmp(o.BigInt)
+			return cmp <= 0
 		},
 	)
-
-	return v.Minus(
-		interpreter,
-		truncatedQuotient.Mul(interpreter, o),
-	)
 }
 
-func (v UFix64Value) Less(interpreter *Interpreter, other NumberValue) BoolValue {
-	o, ok := other.(UFix64Value)
+func (v Word256Value) Greater(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(Word256Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			Operation: ast.OperationLess,
+			Operation: ast.OperationGreater,
 			LeftType:  v.StaticType(interpreter),
 			RightType: other.StaticType(interpreter),
 		})
@@ -13913,16 +14777,17 @@ func (v UFix64Value) Less(interpreter *Interpreter, other NumberValue) BoolValue
 	return NewBoolValueFromConstructor(
 		interpreter,
 		func() bool {
-			return v < o
+			cmp := v.BigInt.Cmp(o.BigInt)
+			return cmp == 1
 		},
 	)
 }
 
-func (v UFix64Value) LessEqual(interpreter *Interpreter, other NumberValue) BoolValue {
-	o, ok := other.(UFix64Value)
+func (v Word256Value) GreaterEqual(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(Word256Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			Operation: ast.OperationLessEqual,
+			Operation: ast.OperationGreaterEqual,
 			LeftType:  v.StaticType(interpreter),
 			RightType: other.StaticType(interpreter),
 		})
@@ -13931,141 +14796,189 @@ func (v UFix64Value) LessEqual(interpreter *Interpreter, other NumberValue) Bool
 	return NewBoolValueFromConstructor(
 		interpreter,
 		func() bool {
-			return v <= o
+			cmp := v.BigInt.Cmp(o.BigInt)
+			return cmp >= 0
 		},
 	)
 }
 
-func (v UFix64Value) Greater(interpreter *Interpreter, other NumberValue) BoolValue {
-	o, ok := other.(UFix64Value)
+func (v Word256Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherInt, ok := other.(Word256Value)
+	if !ok {
+		return false
+	}
+	cmp := v.BigInt.Cmp(otherInt.BigInt)
+	return cmp == 0
+}
+
+// HashInput returns a byte slice containing:
+// - HashInputTypeWord256 (1 byte)
+// - big int encoded in big endian (n bytes)
+func (v Word256Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	b := UnsignedBigIntToBigEndianBytes(v.BigInt)
+
+	length := 1 + len(b)
+	var buffer []byte
+	if length <= len(scratch) {
+		buffer = scratch[:length]
+	} else {
+		buffer = make([]byte, length)
+	}
+
+	buffer[0] = byte(HashInputTypeWord256)
+	copy(buffer[1:], b)
+	return buffer
+}
+
+func ConvertWord256(memoryGauge common.MemoryGauge, value Value) Value {
+	return NewWord256ValueFromBigInt(
+		memoryGauge,
+		func() *big.Int {
+
+			var v *big.Int
+
+			switch value := value.(type) {
+			case BigNumberValue:
+				v = value.ToBigInt(memoryGauge)
+
+			case NumberValue:
+				v = big.NewInt(int64(value.ToInt()))
+
+			default:
+				panic(errors.NewUnreachableError())
+			}
+
+			// Like the other Word types, the conversion wraps around,
+			// i.e. the value is truncated to 256 bits
+			return v.And(v, sema.Word256TypeMaxIntBig)
+		},
+	)
+}
+
+func (v Word256Value) BitwiseOr(interpreter *Interpreter, other IntegerValue) IntegerValue {
+	o, ok := other.(Word256Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			Operation: ast.OperationGreater,
+			Operation: ast.OperationBitwiseOr,
 			LeftType:  v.StaticType(interpreter),
 			RightType: other.StaticType(interpreter),
 		})
 	}
 
-	return NewBoolValueFromConstructor(
+	return NewWord256ValueFromBigInt(
 		interpreter,
-		func() bool {
-			return v > o
+		func() *big.Int {
+			res := new(big.Int)
+			return res.Or(v.BigInt, o.BigInt)
 		},
 	)
 }
 
-func (v UFix64Value) GreaterEqual(interpreter *Interpreter, other NumberValue) BoolValue {
-	o, ok := other.(UFix64Value)
+func (v Word256Value) BitwiseXor(interpreter *Interpreter, other IntegerValue) IntegerValue {
+	o, ok := other.(Word256Value)
 	if !ok {
 		panic(InvalidOperandsError{
-			Operation: ast.OperationGreaterEqual,
+			Operation: ast.OperationBitwiseXor,
 			LeftType:  v.StaticType(interpreter),
 			RightType: other.StaticType(interpreter),
 		})
 	}
 
-	return NewBoolValueFromConstructor(
+	return NewWord256ValueFromBigInt(
 		interpreter,
-		func() bool {
-			return v >= o
+		func() *big.Int {
+			res := new(big.Int)
+			return res.Xor(v.BigInt, o.BigInt)
 		},
 	)
 }
 
-func (v UFix64Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
-	otherUFix64, ok := other.(UFix64Value)
+func (v Word256Value) BitwiseAnd(interpreter *Interpreter, other IntegerValue) IntegerValue {
+	o, ok := other.(Word256Value)
 	if !ok {
-		return false
+		panic(InvalidOperandsError{
+			Operation: ast.OperationBitwiseAnd,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
 	}
-	return v == otherUFix64
-}
-
-// HashInput returns a byte slice containing:
-// - HashInputTypeUFix64 (1 byte)
-// - uint64 value encoded in big-endian (8 bytes)
-func (v UFix64Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
-	scratch[0] = byte(HashInputTypeUFix64)
-	binary.BigEndian.PutUint64(scratch[1:], uint64(v))
-	return scratch[:9]
-}
-
-func ConvertUFix64(memoryGauge common.MemoryGauge, value Value) UFix64Value {
-	switch value := value.(type) {
-	case UFix64Value:
-		return value
-
-	case Fix64Value:
-		if value < 0 {
-			panic(UnderflowError{})
-		}
-		return NewUFix64Value(
-			memoryGauge,
-			func() uint64 {
-				return uint64(value)
-			},
-		)
 
-	case BigNumberValue:
-		converter := func() uint64 {
-			v := value.ToBigInt(memoryGauge)
+	return NewWord256ValueFromBigInt(
+		interpreter,
+		func() *big.Int {
+			res := new(big.Int)
+			return res.And(v.BigInt, o.BigInt)
+		},
+	)
 
-			if v.Sign() < 0 {
-				panic(UnderflowError{})
-			}
+}
 
-			// First, check if the value is at least in the uint64 range.
-			// The integer range for UFix64 is smaller, but this test at least
-			// allows us to call `v.UInt64()` safely.
+func (v Word256Value) BitwiseLeftShift(interpreter *Interpreter, other IntegerValue) IntegerValue {
+	o, ok := other.(Word256Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationBitwiseLeftShift,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
 
-			if !v.IsUint64() {
-				panic(OverflowError{})
+	return NewWord256ValueFromBigInt(
+		interpreter,
+		func() *big.Int {
+			res := new(big.Int)
+			// Shifting by the bit size or more shifts out all bits
+			if !o.BigInt.IsUint64() || o.BigInt.Uint64() >= 256 {
+				return res
 			}
+			res.Lsh(v.BigInt, uint(o.BigInt.Uint64()))
+			return res.And(res, sema.Word256TypeMaxIntBig)
+		},
+	)
+}
 
-			return v.Uint64()
-		}
-
-		// Now check that the integer value fits the range of UFix64
-		return NewUFix64ValueWithInteger(memoryGauge, converter)
+func (v Word256Value) BitwiseRightShift(interpreter *Interpreter, other IntegerValue) IntegerValue {
+	o, ok := other.(Word256Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationBitwiseRightShift,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
 
-	case NumberValue:
-		converter := func() uint64 {
-			v := value.ToInt()
-			if v < 0 {
-				panic(UnderflowError{})
+	return NewWord256ValueFromBigInt(
+		interpreter,
+		func() *big.Int {
+			res := new(big.Int)
+			// Shifting by the bit size or more shifts out all bits
+			if !o.BigInt.IsUint64() || o.BigInt.Uint64() >= 256 {
+				return res
 			}
-
-			return uint64(v)
-		}
-
-		// Check that the integer value fits the range of UFix64
-		return NewUFix64ValueWithInteger(memoryGauge, converter)
-
-	default:
-		panic(fmt.Sprintf("can't convert to UFix64: %s", value))
-	}
+			return res.Rsh(v.BigInt, uint(o.BigInt.Uint64()))
+		},
+	)
 }
 
-func (v UFix64Value) GetMember(interpreter *Interpreter, _ func() LocationRange, name string) Value {
-	return getNumberValueMember(interpreter, v, name, sema.UFix64Type)
+func (v Word256Value) GetMember(interpreter *Interpreter, _ func() LocationRange, name string) Value {
+	return getNumberValueMember(interpreter, v, name, sema.Word256Type)
 }
 
-func (UFix64Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+func (Word256Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
 	// Numbers have no removable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (UFix64Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+func (Word256Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
 	// Numbers have no settable members (fields / functions)
 	panic(errors.NewUnreachableError())
 }
 
-func (v UFix64Value) ToBigEndianBytes() []byte {
-	b := make([]byte, 8)
-	binary.BigEndian.PutUint64(b, uint64(v))
-	return b
+func (v Word256Value) ToBigEndianBytes() []byte {
+	return UnsignedBigIntToBigEndianBytes(v.BigInt)
 }
 
-func (v UFix64Value) ConformsToStaticType(
+func (v Word256Value) ConformsToStaticType(
 	_ *Interpreter,
 	_ func() LocationRange,
 	_ TypeConformanceResults,
@@ -14073,23 +14986,23 @@ func (v UFix64Value) ConformsToStaticType(
 	return true
 }
 
-func (UFix64Value) IsStorable() bool {
+func (Word256Value) IsStorable() bool {
 	return true
 }
 
-func (v UFix64Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+func (v Word256Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
 	return v, nil
 }
 
-func (UFix64Value) NeedsStoreTo(_ atree.Address) bool {
+func (Word256Value) NeedsStoreTo(_ atree.Address) bool {
 	return false
 }
 
-func (UFix64Value) IsResourceKinded(_ *Interpreter) bool {
+func (Word256Value) IsResourceKinded(_ *Interpreter) bool {
 	return false
 }
 
-func (v UFix64Value) Transfer(
+func (v Word256Value) Transfer(
 	interpreter *Interpreter,
 	_ func() LocationRange,
 	_ atree.Address,
@@ -14102,237 +15015,4052 @@ func (v UFix64Value) Transfer(
 	return v
 }
 
-func (v UFix64Value) Clone(_ *Interpreter) Value {
-	return v
+func (v Word256Value) Clone(_ *Interpreter) Value {
+	return NewUnmeteredWord256ValueFromBigInt(v.BigInt)
 }
 
-func (UFix64Value) DeepRemove(_ *Interpreter) {
+func (Word256Value) DeepRemove(_ *Interpreter) {
 	// NO-OP
 }
 
-func (v UFix64Value) ByteSize() uint32 {
-	return cborTagSize + getUintCBORSize(uint64(v))
+func (v Word256Value) ByteSize() uint32 {
+	return cborTagSize + getBigIntCBORSize(v.BigInt)
 }
 
-func (v UFix64Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+func (v Word256Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
 	return v, nil
 }
 
-func (UFix64Value) ChildStorables() []atree.Storable {
+func (Word256Value) ChildStorables() []atree.Storable {
 	return nil
 }
 
-func (v UFix64Value) IntegerPart() NumberValue {
-	return UInt64Value(v / sema.Fix64Factor)
+// FixedPointValue is a fixed-point number value
+//
+type FixedPointValue interface {
+	NumberValue
+	IntegerPart() NumberValue
+	Scale() int
 }
 
-func (UFix64Value) Scale() int {
-	return sema.Fix64Scale
-}
+// Fix64Value
+//
+type Fix64Value int64
 
-// CompositeValue
+const Fix64MaxValue = math.MaxInt64
 
-type CompositeValue struct {
-	dictionary          *atree.OrderedMap
-	Location            common.Location
-	QualifiedIdentifier string
-	Kind                common.CompositeKind
-	InjectedFields      map[string]Value
-	ComputedFields      map[string]ComputedField
-	NestedVariables     map[string]*Variable
-	Functions           map[string]FunctionValue
-	Destructor          FunctionValue
-	Stringer            func(gauge common.MemoryGauge, value *CompositeValue, seenReferences SeenReferences) string
-	isDestroyed         bool
-	typeID              common.TypeID
-	staticType          StaticType
-}
+const fix64Size = int(unsafe.Sizeof(Fix64Value(0)))
 
-type ComputedField func(*Interpreter, func() LocationRange) Value
+var fix64MemoryUsage = common.NewNumberMemoryUsage(fix64Size)
 
-type CompositeField struct {
-	Name  string
-	Value Value
+func NewFix64ValueWithInteger(gauge common.MemoryGauge, constructor func() int64) Fix64Value {
+	common.UseMemory(gauge, fix64MemoryUsage)
+	return NewUnmeteredFix64ValueWithInteger(constructor())
 }
 
-func NewCompositeField(memoryGauge common.MemoryGauge, name string, value Value) CompositeField {
-	common.UseMemory(memoryGauge, common.CompositeFieldMemoryUsage)
-	return NewUnmeteredCompositeField(name, value)
-}
+func NewUnmeteredFix64ValueWithInteger(integer int64) Fix64Value {
 
-func NewUnmeteredCompositeField(name string, value Value) CompositeField {
-	return CompositeField{
-		Name:  name,
-		Value: value,
+	if integer < sema.Fix64TypeMinInt {
+		panic(UnderflowError{})
+	}
+
+	if integer > sema.Fix64TypeMaxInt {
+		panic(OverflowError{})
 	}
+
+	return NewUnmeteredFix64Value(integer * sema.Fix64Factor)
 }
 
-func NewCompositeValue(
-	interpreter *Interpreter,
-	getLocationRange func() LocationRange,
-	location common.Location,
+func NewFix64Value(gauge common.MemoryGauge, valueGetter func() int64) Fix64Value {
+	common.UseMemory(gauge, fix64MemoryUsage)
+	return NewUnmeteredFix64Value(valueGetter())
+}
+
+func NewUnmeteredFix64Value(integer int64) Fix64Value {
+	return Fix64Value(integer)
+}
+
+var _ Value = Fix64Value(0)
+var _ atree.Storable = Fix64Value(0)
+var _ NumberValue = Fix64Value(0)
+var _ FixedPointValue = Fix64Value(0)
+var _ EquatableValue = Fix64Value(0)
+var _ HashableValue = Fix64Value(0)
+var _ MemberAccessibleValue = Fix64Value(0)
+
+func (Fix64Value) IsValue() {}
+
+func (v Fix64Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitFix64Value(interpreter, v)
+}
+
+func (Fix64Value) Walk(_ *Interpreter, _ func(Value)) {
+	// NO-OP
+}
+
+func (Fix64Value) StaticType(interpreter *Interpreter) StaticType {
+	return NewPrimitiveStaticType(interpreter, PrimitiveStaticTypeFix64)
+}
+
+func (Fix64Value) IsImportable(_ *Interpreter) bool {
+	return true
+}
+
+func (v Fix64Value) String() string {
+	return format.Fix64(int64(v))
+}
+
+func (v Fix64Value) RecursiveString(_ SeenReferences) string {
+	return v.String()
+}
+
+func (v Fix64Value) MeteredString(memoryGauge common.MemoryGauge, _ SeenReferences) string {
+	common.UseMemory(
+		memoryGauge,
+		common.NewRawStringMemoryUsage(
+			OverEstimateNumberStringLength(memoryGauge, v),
+		),
+	)
+	return v.String()
+}
+
+func (v Fix64Value) ToInt() int {
+	return int(v / sema.Fix64Factor)
+}
+
+func (v Fix64Value) Negate(interpreter *Interpreter) NumberValue {
+	// INT32-C
+	if v == math.MinInt64 {
+		panic(OverflowError{})
+	}
+
+	valueGetter := func() int64 {
+		return int64(-v)
+	}
+
+	return NewFix64Value(interpreter, valueGetter)
+}
+
+func (v Fix64Value) Plus(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Fix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationPlus,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() int64 {
+		return safeAddInt64(int64(v), int64(o))
+	}
+
+	return NewFix64Value(interpreter, valueGetter)
+}
+
+func (v Fix64Value) SaturatingPlus(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Fix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingAddFunctionName,
+			LeftType:     v.StaticType(interpreter),
+			RightType:    other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() int64 {
+		// INT32-C
+		if (o > 0) && (v > (math.MaxInt64 - o)) {
+			return math.MaxInt64
+		} else if (o < 0) && (v < (math.MinInt64 - o)) {
+			return math.MinInt64
+		}
+		return int64(v + o)
+	}
+
+	return NewFix64Value(interpreter, valueGetter)
+}
+
+func (v Fix64Value) Minus(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Fix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMinus,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() int64 {
+		// INT32-C
+		if (o > 0) && (v < (math.MinInt64 + o)) {
+			panic(OverflowError{})
+		} else if (o < 0) && (v > (math.MaxInt64 + o)) {
+			panic(UnderflowError{})
+		}
+
+		return int64(v - o)
+	}
+
+	return NewFix64Value(interpreter, valueGetter)
+}
+
+func (v Fix64Value) SaturatingMinus(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Fix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingSubtractFunctionName,
+			LeftType:     v.StaticType(interpreter),
+			RightType:    other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() int64 {
+		// INT32-C
+		if (o > 0) && (v < (math.MinInt64 + o)) {
+			return math.MinInt64
+		} else if (o < 0) && (v > (math.MaxInt64 + o)) {
+			return math.MaxInt64
+		}
+		return int64(v - o)
+	}
+
+	return NewFix64Value(interpreter, valueGetter)
+}
+
+var minInt64Big = big.NewInt(math.MinInt64)
+var maxInt64Big = big.NewInt(math.MaxInt64)
+
+func (v Fix64Value) Mul(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Fix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMul,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	a := new(big.Int).SetInt64(int64(v))
+	b := new(big.Int).SetInt64(int64(o))
+
+	valueGetter := func() int64 {
+		result := new(big.Int).Mul(a, b)
+		result.Div(result, sema.Fix64FactorBig)
+
+		if result.Cmp(minInt64Big) < 0 {
+			panic(UnderflowError{})
+		} else if result.Cmp(maxInt64Big) > 0 {
+			panic(OverflowError{})
+		}
+
+		return result.Int64()
+	}
+
+	return NewFix64Value(interpreter, valueGetter)
+}
+
+func (v Fix64Value) SaturatingMul(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Fix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingMultiplyFunctionName,
+			LeftType:     v.StaticType(interpreter),
+			RightType:    other.StaticType(interpreter),
+		})
+	}
+
+	a := new(big.Int).SetInt64(int64(v))
+	b := new(big.Int).SetInt64(int64(o))
+
+	valueGetter := func() int64 {
+		result := new(big.Int).Mul(a, b)
+		result.Div(result, sema.Fix64FactorBig)
+
+		if result.Cmp(minInt64Big) < 0 {
+			return math.MinInt64
+		} else if result.Cmp(maxInt64Big) > 0 {
+			return math.MaxInt64
+		}
+
+		return result.Int64()
+	}
+
+	return NewFix64Value(interpreter, valueGetter)
+}
+
+func (v Fix64Value) Div(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Fix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationDiv,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	a := new(big.Int).SetInt64(int64(v))
+	b := new(big.Int).SetInt64(int64(o))
+
+	valueGetter := func() int64 {
+		result := new(big.Int).Mul(a, sema.Fix64FactorBig)
+		result.Div(result, b)
+
+		if result.Cmp(minInt64Big) < 0 {
+			panic(UnderflowError{})
+		} else if result.Cmp(maxInt64Big) > 0 {
+			panic(OverflowError{})
+		}
+
+		return result.Int64()
+	}
+
+	return NewFix64Value(interpreter, valueGetter)
+}
+
+func (v Fix64Value) SaturatingDiv(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Fix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingDivideFunctionName,
+			LeftType:     v.StaticType(interpreter),
+			RightType:    other.StaticType(interpreter),
+		})
+	}
+
+	a := new(big.Int).SetInt64(int64(v))
+	b := new(big.Int).SetInt64(int64(o))
+
+	valueGetter := func() int64 {
+		result := new(big.Int).Mul(a, sema.Fix64FactorBig)
+		result.Div(result, b)
+
+		if result.Cmp(minInt64Big) < 0 {
+			return math.MinInt64
+		} else if result.Cmp(maxInt64Big) > 0 {
+			return math.MaxInt64
+		}
+
+		return result.Int64()
+	}
+
+	return NewFix64Value(interpreter, valueGetter)
+}
+
+func (v Fix64Value) Mod(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Fix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMod,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	// v - int(v/o) * o
+	quotient, ok := v.Div(interpreter, o).(Fix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMod,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	truncatedQuotient := NewFix64Value(
+		interpreter,
+		func() int64 {
+			return (int64(quotient) / sema.Fix64Factor) * sema.Fix64Factor
+		},
+	)
+
+	return v.Minus(
+		interpreter,
+		truncatedQuotient.Mul(interpreter, o),
+	)
+}
+
+func (v Fix64Value) Less(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(Fix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationLess,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	return NewBoolValueFromConstructor(
+		interpreter,
+		func() bool {
+			return v < o
+		},
+	)
+}
+
+func (v Fix64Value) LessEqual(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(Fix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationLessEqual,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	return NewBoolValueFromConstructor(
+		interpreter,
+		func() bool {
+			return v <= o
+		},
+	)
+}
+
+func (v Fix64Value) Greater(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(Fix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationGreater,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	return NewBoolValueFromConstructor(
+		interpreter,
+		func() bool {
+			return v > o
+		},
+	)
+}
+
+func (v Fix64Value) GreaterEqual(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(Fix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationGreaterEqual,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	return NewBoolValueFromConstructor(
+		interpreter,
+		func() bool {
+			return v >= o
+		},
+	)
+}
+
+func (v Fix64Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherFix64, ok := other.(Fix64Value)
+	if !ok {
+		return false
+	}
+	return v == otherFix64
+}
+
+// HashInput returns a byte slice containing:
+// - HashInputTypeFix64 (1 byte)
+// - int64 value encoded in big-endian (8 bytes)
+func (v Fix64Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	scratch[0] = byte(HashInputTypeFix64)
+	binary.BigEndian.PutUint64(scratch[1:], uint64(v))
+	return scratch[:9]
+}
+
+func ConvertFix64(memoryGauge common.MemoryGauge, value Value) Fix64Value {
+	switch value := value.(type) {
+	case Fix64Value:
+		return value
+
+	case UFix64Value:
+		if value > Fix64MaxValue {
+			panic(OverflowError{})
+		}
+		return NewFix64Value(
+			memoryGauge,
+			func() int64 {
+				return int64(value)
+			},
+		)
+
+	case Fix128Value:
+		return NewFix64Value(
+			memoryGauge,
+			func() int64 {
+				return fix128ToFix64(value.BigInt)
+			},
+		)
+
+	case UFix128Value:
+		return NewFix64Value(
+			memoryGauge,
+			func() int64 {
+				return fix128ToFix64(value.BigInt)
+			},
+		)
+
+	case BigNumberValue:
+		converter := func() int64 {
+			v := value.ToBigInt(memoryGauge)
+
+			// First, check if the value is at least in the int64 range.
+			// The integer range for Fix64 is smaller, but this test at least
+			// allows us to call `v.Int64()` safely.
+
+			if !v.IsInt64() {
+				panic(OverflowError{})
+			}
+
+			return v.Int64()
+		}
+
+		// Now check that the integer value fits the range of Fix64
+		return NewFix64ValueWithInteger(memoryGauge, converter)
+
+	case NumberValue:
+		// Check that the integer value fits the range of Fix64
+		return NewFix64ValueWithInteger(
+			memoryGauge,
+			func() int64 {
+				return int64(value.ToInt())
+			},
+		)
+
+	default:
+		panic(fmt.Sprintf("can't convert Fix64: %s", value))
+	}
+}
+
+func (v Fix64Value) GetMember(interpreter *Interpreter, _ func() LocationRange, name string) Value {
+	return getNumberValueMember(interpreter, v, name, sema.Fix64Type)
+}
+
+func (Fix64Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+	// Numbers have no removable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (Fix64Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+	// Numbers have no settable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (v Fix64Value) ToBigEndianBytes() []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(v))
+	return b
+}
+
+func (v Fix64Value) ConformsToStaticType(
+	_ *Interpreter,
+	_ func() LocationRange,
+	_ TypeConformanceResults,
+) bool {
+	return true
+}
+
+func (Fix64Value) IsStorable() bool {
+	return true
+}
+
+func (v Fix64Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+	return v, nil
+}
+
+func (Fix64Value) NeedsStoreTo(_ atree.Address) bool {
+	return false
+}
+
+func (Fix64Value) IsResourceKinded(_ *Interpreter) bool {
+	return false
+}
+
+func (v Fix64Value) Transfer(
+	interpreter *Interpreter,
+	_ func() LocationRange,
+	_ atree.Address,
+	remove bool,
+	storable atree.Storable,
+) Value {
+	if remove {
+		interpreter.RemoveReferencedSlab(storable)
+	}
+	return v
+}
+
+func (v Fix64Value) Clone(_ *Interpreter) Value {
+	return v
+}
+
+func (Fix64Value) DeepRemove(_ *Interpreter) {
+	// NO-OP
+}
+
+func (v Fix64Value) ByteSize() uint32 {
+	return cborTagSize + getIntCBORSize(int64(v))
+}
+
+func (v Fix64Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+	return v, nil
+}
+
+func (Fix64Value) ChildStorables() []atree.Storable {
+	return nil
+}
+
+func (v Fix64Value) IntegerPart() NumberValue {
+	return UInt64Value(v / sema.Fix64Factor)
+}
+
+func (Fix64Value) Scale() int {
+	return sema.Fix64Scale
+}
+
+// UFix64Value
+//
+type UFix64Value uint64
+
+const UFix64MaxValue = math.MaxUint64
+
+const ufix64Size = int(unsafe.Sizeof(UFix64Value(0)))
+
+var ufix64MemoryUsage = common.NewNumberMemoryUsage(ufix64Size)
+
+func NewUFix64ValueWithInteger(gauge common.MemoryGauge, constructor func() uint64) UFix64Value {
+	common.UseMemory(gauge, ufix64MemoryUsage)
+	return NewUnmeteredUFix64ValueWithInteger(constructor())
+}
+
+func NewUnmeteredUFix64ValueWithInteger(integer uint64) UFix64Value {
+	if integer > sema.UFix64TypeMaxInt {
+		panic(OverflowError{})
+	}
+
+	return NewUnmeteredUFix64Value(integer * sema.Fix64Factor)
+}
+
+func NewUFix64Value(gauge common.MemoryGauge, constructor func() uint64) UFix64Value {
+	common.UseMemory(gauge, ufix64MemoryUsage)
+	return NewUnmeteredUFix64Value(constructor())
+}
+
+func NewUnmeteredUFix64Value(integer uint64) UFix64Value {
+	return UFix64Value(integer)
+}
+
+var _ Value = UFix64Value(0)
+var _ atree.Storable = UFix64Value(0)
+var _ NumberValue = UFix64Value(0)
+var _ FixedPointValue = Fix64Value(0)
+var _ EquatableValue = UFix64Value(0)
+var _ HashableValue = UFix64Value(0)
+var _ MemberAccessibleValue = UFix64Value(0)
+
+func (UFix64Value) IsValue() {}
+
+func (v UFix64Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitUFix64Value(interpreter, v)
+}
+
+func (UFix64Value) Walk(_ *Interpreter, _ func(Value)) {
+	// NO-OP
+}
+
+func (UFix64Value) StaticType(interpreter *Interpreter) StaticType {
+	return NewPrimitiveStaticType(interpreter, PrimitiveStaticTypeUFix64)
+}
+
+func (UFix64Value) IsImportable(_ *Interpreter) bool {
+	return true
+}
+
+func (v UFix64Value) String() string {
+	return format.UFix64(uint64(v))
+}
+
+func (v UFix64Value) RecursiveString(_ SeenReferences) string {
+	return v.String()
+}
+
+func (v UFix64Value) MeteredString(memoryGauge common.MemoryGauge, _ SeenReferences) string {
+	common.UseMemory(
+		memoryGauge,
+		common.NewRawStringMemoryUsage(
+			OverEstimateNumberStringLength(memoryGauge, v),
+		),
+	)
+	return v.String()
+}
+
+func (v UFix64Value) ToInt() int {
+	return int(v / sema.Fix64Factor)
+}
+
+func (v UFix64Value) Negate(*Interpreter) NumberValue {
+	panic(errors.NewUnreachableError())
+}
+
+func (v UFix64Value) Plus(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(UFix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationPlus,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() uint64 {
+		return safeAddUint64(uint64(v), uint64(o))
+	}
+
+	return NewUFix64Value(interpreter, valueGetter)
+}
+
+func (v UFix64Value) SaturatingPlus(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(UFix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingAddFunctionName,
+			LeftType:     v.StaticType(interpreter),
+			RightType:    other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() uint64 {
+		sum := v + o
+		// INT30-C
+		if sum < v {
+			return math.MaxUint64
+		}
+		return uint64(sum)
+	}
+
+	return NewUFix64Value(interpreter, valueGetter)
+}
+
+func (v UFix64Value) Minus(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(UFix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMinus,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() uint64 {
+		diff := v - o
+
+		// INT30-C
+		if diff > v {
+			panic(UnderflowError{})
+		}
+		return uint64(diff)
+	}
+
+	return NewUFix64Value(interpreter, valueGetter)
+}
+
+func (v UFix64Value) SaturatingMinus(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(UFix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingSubtractFunctionName,
+			LeftType:     v.StaticType(interpreter),
+			RightType:    other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() uint64 {
+		diff := v - o
+
+		// INT30-C
+		if diff > v {
+			return 0
+		}
+		return uint64(diff)
+	}
+
+	return NewUFix64Value(interpreter, valueGetter)
+}
+
+func (v UFix64Value) Mul(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(UFix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMul,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	a := new(big.Int).SetUint64(uint64(v))
+	b := new(big.Int).SetUint64(uint64(o))
+
+	valueGetter := func() uint64 {
+		result := new(big.Int).Mul(a, b)
+		result.Div(result, sema.Fix64FactorBig)
+
+		if !result.IsUint64() {
+			panic(OverflowError{})
+		}
+
+		return result.Uint64()
+	}
+
+	return NewUFix64Value(interpreter, valueGetter)
+}
+
+func (v UFix64Value) SaturatingMul(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(UFix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingMultiplyFunctionName,
+			LeftType:     v.StaticType(interpreter),
+			RightType:    other.StaticType(interpreter),
+		})
+	}
+
+	a := new(big.Int).SetUint64(uint64(v))
+	b := new(big.Int).SetUint64(uint64(o))
+
+	valueGetter := func() uint64 {
+		result := new(big.Int).Mul(a, b)
+		result.Div(result, sema.Fix64FactorBig)
+
+		if !result.IsUint64() {
+			return math.MaxUint64
+		}
+
+		return result.Uint64()
+	}
+
+	return NewUFix64Value(interpreter, valueGetter)
+}
+
+func (v UFix64Value) Div(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(UFix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationDiv,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	a := new(big.Int).SetUint64(uint64(v))
+	b := new(big.Int).SetUint64(uint64(o))
+
+	valueGetter := func() uint64 {
+		result := new(big.Int).Mul(a, sema.Fix64FactorBig)
+		result.Div(result, b)
+
+		return result.Uint64()
+	}
+
+	return NewUFix64Value(interpreter, valueGetter)
+}
+
+func (v UFix64Value) SaturatingDiv(interpreter *Interpreter, other NumberValue) NumberValue {
+	defer func() {
+		r := recover()
+		if _, ok := r.(InvalidOperandsError); ok {
+			panic(InvalidOperandsError{
+				FunctionName: sema.NumericTypeSaturatingDivideFunctionName,
+				LeftType:     v.StaticType(interpreter),
+				RightType:    other.StaticType(interpreter),
+			})
+		}
+	}()
+
+	return v.Div(interpreter, other)
+}
+
+func (v UFix64Value) Mod(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(UFix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMod,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	// v - int(v/o) * o
+	quotient, ok := v.Div(interpreter, o).(UFix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMod,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	truncatedQuotient := NewUFix64Value(
+		interpreter,
+		func() uint64 {
+			return (uint64(quotient) / sema.Fix64Factor) * sema.Fix64Factor
+		},
+	)
+
+	return v.Minus(
+		interpreter,
+		truncatedQuotient.Mul(interpreter, o),
+	)
+}
+
+func (v UFix64Value) Less(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(UFix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationLess,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	return NewBoolValueFromConstructor(
+		interpreter,
+		func() bool {
+			return v < o
+		},
+	)
+}
+
+func (v UFix64Value) LessEqual(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(UFix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationLessEqual,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	return NewBoolValueFromConstructor(
+		interpreter,
+		func() bool {
+			return v <= o
+		},
+	)
+}
+
+func (v UFix64Value) Greater(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(UFix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationGreater,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	return NewBoolValueFromConstructor(
+		interpreter,
+		func() bool {
+			return v > o
+		},
+	)
+}
+
+func (v UFix64Value) GreaterEqual(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(UFix64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationGreaterEqual,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	return NewBoolValueFromConstructor(
+		interpreter,
+		func() bool {
+			return v >= o
+		},
+	)
+}
+
+func (v UFix64Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherUFix64, ok := other.(UFix64Value)
+	if !ok {
+		return false
+	}
+	return v == otherUFix64
+}
+
+// HashInput returns a byte slice containing:
+// - HashInputTypeUFix64 (1 byte)
+// - uint64 value encoded in big-endian (8 bytes)
+func (v UFix64Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	scratch[0] = byte(HashInputTypeUFix64)
+	binary.BigEndian.PutUint64(scratch[1:], uint64(v))
+	return scratch[:9]
+}
+
+func ConvertUFix64(memoryGauge common.MemoryGauge, value Value) UFix64Value {
+	switch value := value.(type) {
+	case UFix64Value:
+		return value
+
+	case Fix64Value:
+		if value < 0 {
+			panic(UnderflowError{})
+		}
+		return NewUFix64Value(
+			memoryGauge,
+			func() uint64 {
+				return uint64(value)
+			},
+		)
+
+	case Fix128Value:
+		return NewUFix64Value(
+			memoryGauge,
+			func() uint64 {
+				return fix128ToUFix64(value.BigInt)
+			},
+		)
+
+	case UFix128Value:
+		return NewUFix64Value(
+			memoryGauge,
+			func() uint64 {
+				return fix128ToUFix64(value.BigInt)
+			},
+		)
+
+	case BigNumberValue:
+		converter := func() uint64 {
+			v := value.ToBigInt(memoryGauge)
+
+			if v.Sign() < 0 {
+				panic(UnderflowError{})
+			}
+
+			// First, check if the value is at least in the uint64 range.
+			// The integer range for UFix64 is smaller, but this test at least
+			// allows us to call `v.UInt64()` safely.
+
+			if !v.IsUint64() {
+				panic(OverflowError{})
+			}
+
+			return v.Uint64()
+		}
+
+		// Now check that the integer value fits the range of UFix64
+		return NewUFix64ValueWithInteger(memoryGauge, converter)
+
+	case NumberValue:
+		converter := func() uint64 {
+			v := value.ToInt()
+			if v < 0 {
+				panic(UnderflowError{})
+			}
+
+			return uint64(v)
+		}
+
+		// Check that the integer value fits the range of UFix64
+		return NewUFix64ValueWithInteger(memoryGauge, converter)
+
+	default:
+		panic(fmt.Sprintf("can't convert to UFix64: %s", value))
+	}
+}
+
+func (v UFix64Value) GetMember(interpreter *Interpreter, _ func() LocationRange, name string) Value {
+	return getNumberValueMember(interpreter, v, name, sema.UFix64Type)
+}
+
+func (UFix64Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+	// Numbers have no removable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (UFix64Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+	// Numbers have no settable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (v UFix64Value) ToBigEndianBytes() []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(v))
+	return b
+}
+
+func (v UFix64Value) ConformsToStaticType(
+	_ *Interpreter,
+	_ func() LocationRange,
+	_ TypeConformanceResults,
+) bool {
+	return true
+}
+
+func (UFix64Value) IsStorable() bool {
+	return true
+}
+
+func (v UFix64Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+	return v, nil
+}
+
+func (UFix64Value) NeedsStoreTo(_ atree.Address) bool {
+	return false
+}
+
+func (UFix64Value) IsResourceKinded(_ *Interpreter) bool {
+	return false
+}
+
+func (v UFix64Value) Transfer(
+	interpreter *Interpreter,
+	_ func() LocationRange,
+	_ atree.Address,
+	remove bool,
+	storable atree.Storable,
+) Value {
+	if remove {
+		interpreter.RemoveReferencedSlab(storable)
+	}
+	return v
+}
+
+func (v UFix64Value) Clone(_ *Interpreter) Value {
+	return v
+}
+
+func (UFix64Value) DeepRemove(_ *Interpreter) {
+	// NO-OP
+}
+
+func (v UFix64Value) ByteSize() uint32 {
+	return cborTagSize + getUintCBORSize(uint64(v))
+}
+
+func (v UFix64Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+	return v, nil
+}
+
+func (UFix64Value) ChildStorables() []atree.Storable {
+	return nil
+}
+
+func (v UFix64Value) IntegerPart() NumberValue {
+	return UInt64Value(v / sema.Fix64Factor)
+}
+
+func (UFix64Value) Scale() int {
+	return sema.Fix64Scale
+}
+
+// Fix128Value
+//
+type Fix128Value struct {
+	BigInt *big.Int
+}
+
+var Fix128MemoryUsage = common.NewBigIntMemoryUsage(16)
+
+func NewFix128ValueWithInteger(gauge common.MemoryGauge, constructor func() *big.Int) Fix128Value {
+	common.UseMemory(gauge, Fix128MemoryUsage)
+	return NewUnmeteredFix128ValueWithInteger(constructor())
+}
+
+func NewUnmeteredFix128ValueWithInteger(integer *big.Int) Fix128Value {
+
+	if integer.Cmp(sema.Fix128TypeMinIntBig) < 0 {
+		panic(UnderflowError{})
+	}
+
+	if integer.Cmp(sema.Fix128TypeMaxIntBig) > 0 {
+		panic(OverflowError{})
+	}
+
+	return NewUnmeteredFix128Value(new(big.Int).Mul(integer, sema.Fix128FactorBig))
+}
+
+func NewFix128Value(gauge common.MemoryGauge, valueGetter func() *big.Int) Fix128Value {
+	common.UseMemory(gauge, Fix128MemoryUsage)
+	return NewUnmeteredFix128Value(valueGetter())
+}
+
+func NewUnmeteredFix128Value(value *big.Int) Fix128Value {
+	return Fix128Value{
+		BigInt: value,
+	}
+}
+
+var _ Value = Fix128Value{}
+var _ atree.Storable = Fix128Value{}
+var _ NumberValue = Fix128Value{}
+var _ FixedPointValue = Fix128Value{}
+var _ EquatableValue = Fix128Value{}
+var _ HashableValue = Fix128Value{}
+var _ MemberAccessibleValue = Fix128Value{}
+
+func (Fix128Value) IsValue() {}
+
+func (v Fix128Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitFix128Value(interpreter, v)
+}
+
+func (Fix128Value) Walk(_ *Interpreter, _ func(Value)) {
+	// NO-OP
+}
+
+func (Fix128Value) StaticType(interpreter *Interpreter) StaticType {
+	return NewPrimitiveStaticType(interpreter, PrimitiveStaticTypeFix128)
+}
+
+func (Fix128Value) IsImportable(_ *Interpreter) bool {
+	return true
+}
+
+func (v Fix128Value) String() string {
+	return format.Fix128(v.BigInt)
+}
+
+func (v Fix128Value) RecursiveString(_ SeenReferences) string {
+	return v.String()
+}
+
+func (v Fix128Value) MeteredString(memoryGauge common.MemoryGauge, _ SeenReferences) string {
+	common.UseMemory(
+		memoryGauge,
+		common.NewRawStringMemoryUsage(
+			OverEstimateNumberStringLength(memoryGauge, v),
+		),
+	)
+	return v.String()
+}
+
+func (v Fix128Value) ToInt() int {
+	integer := new(big.Int).Quo(v.BigInt, sema.Fix128FactorBig)
+	if !integer.IsInt64() {
+		panic(OverflowError{})
+	}
+	return int(integer.Int64())
+}
+
+func (v Fix128Value) Negate(interpreter *Interpreter) NumberValue {
+	// INT32-C
+	if v.BigInt.Cmp(sema.Fix128TypeMinBig) == 0 {
+		panic(OverflowError{})
+	}
+
+	valueGetter := func() *big.Int {
+		return new(big.Int).Neg(v.BigInt)
+	}
+
+	return NewFix128Value(interpreter, valueGetter)
+}
+
+func (v Fix128Value) Plus(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationPlus,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		res := new(big.Int).Add(v.BigInt, o.BigInt)
+		if res.Cmp(sema.Fix128TypeMinBig) < 0 {
+			panic(UnderflowError{})
+		} else if res.Cmp(sema.Fix128TypeMaxBig) > 0 {
+			panic(OverflowError{})
+		}
+
+		return res
+	}
+
+	return NewFix128Value(interpreter, valueGetter)
+}
+
+func (v Fix128Value) SaturatingPlus(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingAddFunctionName,
+			LeftType:     v.StaticType(interpreter),
+			RightType:    other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		res := new(big.Int).Add(v.BigInt, o.BigInt)
+		if res.Cmp(sema.Fix128TypeMinBig) < 0 {
+			return sema.Fix128TypeMinBig
+		} else if res.Cmp(sema.Fix128TypeMaxBig) > 0 {
+			return sema.Fix128TypeMaxBig
+		}
+
+		return res
+	}
+
+	return NewFix128Value(interpreter, valueGetter)
+}
+
+func (v Fix128Value) Minus(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMinus,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		res := new(big.Int).Sub(v.BigInt, o.BigInt)
+		if res.Cmp(sema.Fix128TypeMinBig) < 0 {
+			panic(UnderflowError{})
+		} else if res.Cmp(sema.Fix128TypeMaxBig) > 0 {
+			panic(OverflowError{})
+		}
+
+		return res
+	}
+
+	return NewFix128Value(interpreter, valueGetter)
+}
+
+func (v Fix128Value) SaturatingMinus(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingSubtractFunctionName,
+			LeftType:     v.StaticType(interpreter),
+			RightType:    other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		res := new(big.Int).Sub(v.BigInt, o.BigInt)
+		if res.Cmp(sema.Fix128TypeMinBig) < 0 {
+			return sema.Fix128TypeMinBig
+		} else if res.Cmp(sema.Fix128TypeMaxBig) > 0 {
+			return sema.Fix128TypeMaxBig
+		}
+
+		return res
+	}
+
+	return NewFix128Value(interpreter, valueGetter)
+}
+
+func (v Fix128Value) Mul(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMul,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		res := new(big.Int).Mul(v.BigInt, o.BigInt)
+		res.Div(res, sema.Fix128FactorBig)
+
+		if res.Cmp(sema.Fix128TypeMinBig) < 0 {
+			panic(UnderflowError{})
+		} else if res.Cmp(sema.Fix128TypeMaxBig) > 0 {
+			panic(OverflowError{})
+		}
+
+		return res
+	}
+
+	return NewFix128Value(interpreter, valueGetter)
+}
+
+func (v Fix128Value) SaturatingMul(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingMultiplyFunctionName,
+			LeftType:     v.StaticType(interpreter),
+			RightType:    other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		res := new(big.Int).Mul(v.BigInt, o.BigInt)
+		res.Div(res, sema.Fix128FactorBig)
+
+		if res.Cmp(sema.Fix128TypeMinBig) < 0 {
+			return sema.Fix128TypeMinBig
+		} else if res.Cmp(sema.Fix128TypeMaxBig) > 0 {
+			return sema.Fix128TypeMaxBig
+		}
+
+		return res
+	}
+
+	return NewFix128Value(interpreter, valueGetter)
+}
+
+func (v Fix128Value) Div(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationDiv,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		// INT33-C
+		if o.BigInt.Sign() == 0 {
+			panic(DivisionByZeroError{})
+		}
+
+		res := new(big.Int).Mul(v.BigInt, sema.Fix128FactorBig)
+		res.Div(res, o.BigInt)
+
+		if res.Cmp(sema.Fix128TypeMinBig) < 0 {
+			panic(UnderflowError{})
+		} else if res.Cmp(sema.Fix128TypeMaxBig) > 0 {
+			panic(OverflowError{})
+		}
+
+		return res
+	}
+
+	return NewFix128Value(interpreter, valueGetter)
+}
+
+func (v Fix128Value) SaturatingDiv(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingDivideFunctionName,
+			LeftType:     v.StaticType(interpreter),
+			RightType:    other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		// INT33-C
+		if o.BigInt.Sign() == 0 {
+			panic(DivisionByZeroError{})
+		}
+
+		res := new(big.Int).Mul(v.BigInt, sema.Fix128FactorBig)
+		res.Div(res, o.BigInt)
+
+		if res.Cmp(sema.Fix128TypeMinBig) < 0 {
+			return sema.Fix128TypeMinBig
+		} else if res.Cmp(sema.Fix128TypeMaxBig) > 0 {
+			return sema.Fix128TypeMaxBig
+		}
+
+		return res
+	}
+
+	return NewFix128Value(interpreter, valueGetter)
+}
+
+func (v Fix128Value) Mod(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMod,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	// v - int(v/o) * o
+	quotient, ok := v.Div(interpreter, o).(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMod,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	truncatedQuotient := NewFix128Value(
+		interpreter,
+		func() *big.Int {
+			res := new(big.Int).Quo(quotient.BigInt, sema.Fix128FactorBig)
+			return res.Mul(res, sema.Fix128FactorBig)
+		},
+	)
+
+	return v.Minus(
+		interpreter,
+		truncatedQuotient.Mul(interpreter, o),
+	)
+}
+
+func (v Fix128Value) Less(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationLess,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	return NewBoolValueFromConstructor(
+		interpreter,
+		func() bool {
+			return v.BigInt.Cmp(o.BigInt) < 0
+		},
+	)
+}
+
+func (v Fix128Value) LessEqual(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationLessEqual,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	return NewBoolValueFromConstructor(
+		interpreter,
+		func() bool {
+			return v.BigInt.Cmp(o.BigInt) <= 0
+		},
+	)
+}
+
+func (v Fix128Value) Greater(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationGreater,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	return NewBoolValueFromConstructor(
+		interpreter,
+		func() bool {
+			return v.BigInt.Cmp(o.BigInt) > 0
+		},
+	)
+}
+
+func (v Fix128Value) GreaterEqual(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(Fix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationGreaterEqual,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	return NewBoolValueFromConstructor(
+		interpreter,
+		func() bool {
+			return v.BigInt.Cmp(o.BigInt) >= 0
+		},
+	)
+}
+
+func (v Fix128Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherFix128, ok := other.(Fix128Value)
+	if !ok {
+		return false
+	}
+	return v.BigInt.Cmp(otherFix128.BigInt) == 0
+}
+
+// HashInput returns a byte slice containing:
+// - HashInputTypeFix128 (1 byte)
+// - big int value encoded in big-endian (n bytes)
+func (v Fix128Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	b := SignedBigIntToBigEndianBytes(v.BigInt)
+
+	length := 1 + len(b)
+	var buffer []byte
+	if length <= len(scratch) {
+		buffer = scratch[:length]
+	} else {
+		buffer = make([]byte, length)
+	}
+
+	buffer[0] = byte(HashInputTypeFix128)
+	copy(buffer[1:], b)
+	return buffer
+}
+
+func ConvertFix128(memoryGauge common.MemoryGauge, value Value) Fix128Value {
+	switch value := value.(type) {
+	case Fix128Value:
+		return value
+
+	case UFix128Value:
+		if value.BigInt.Cmp(sema.Fix128TypeMaxBig) > 0 {
+			panic(OverflowError{})
+		}
+		return NewFix128Value(
+			memoryGauge,
+			func() *big.Int {
+				return new(big.Int).Set(value.BigInt)
+			},
+		)
+
+	case Fix64Value:
+		return NewFix128Value(
+			memoryGauge,
+			func() *big.Int {
+				return scaleFix64ToFix128(big.NewInt(int64(value)))
+			},
+		)
+
+	case UFix64Value:
+		return NewFix128Value(
+			memoryGauge,
+			func() *big.Int {
+				return scaleFix64ToFix128(new(big.Int).SetUint64(uint64(value)))
+			},
+		)
+
+	case BigNumberValue:
+		// Check that the integer value fits the range of Fix128
+		return NewFix128ValueWithInteger(
+			memoryGauge,
+			func() *big.Int {
+				return value.ToBigInt(memoryGauge)
+			},
+		)
+
+	case NumberValue:
+		// Check that the integer value fits the range of Fix128
+		return NewFix128ValueWithInteger(
+			memoryGauge,
+			func() *big.Int {
+				return big.NewInt(int64(value.ToInt()))
+			},
+		)
+
+	default:
+		panic(fmt.Sprintf("can't convert to Fix128: %s", value))
+	}
+}
+
+var fix64ToFix128FactorBig = new(big.Int).Quo(sema.Fix128FactorBig, sema.Fix64FactorBig)
+
+// scaleFix64ToFix128 rescales the given Fix64 or UFix64 value
+// to the scale of Fix128 and UFix128. This is always exact
+//
+func scaleFix64ToFix128(value *big.Int) *big.Int {
+	return value.Mul(value, fix64ToFix128FactorBig)
+}
+
+// fix128ToFix64 converts the given Fix128 or UFix128 value to a Fix64 value,
+// truncating the fractional digits that do not fit the smaller scale
+//
+func fix128ToFix64(value *big.Int) int64 {
+	result := new(big.Int).Quo(value, fix64ToFix128FactorBig)
+
+	if result.Cmp(minInt64Big) < 0 {
+		panic(UnderflowError{})
+	} else if result.Cmp(maxInt64Big) > 0 {
+		panic(OverflowError{})
+	}
+
+	return result.Int64()
+}
+
+// fix128ToUFix64 converts the given Fix128 or UFix128 value to an UFix64 value,
+// truncating the fractional digits that do not fit the smaller scale
+//
+func fix128ToUFix64(value *big.Int) uint64 {
+	result := new(big.Int).Quo(value, fix64ToFix128FactorBig)
+
+	if result.Sign() < 0 {
+		panic(UnderflowError{})
+	} else if !result.IsUint64() {
+		panic(OverflowError{})
+	}
+
+	return result.Uint64()
+}
+
+func (v Fix128Value) GetMember(interpreter *Interpreter, _ func() LocationRange, name string) Value {
+	return getNumberValueMember(interpreter, v, name, sema.Fix128Type)
+}
+
+func (Fix128Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+	// Numbers have no removable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (Fix128Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+	// Numbers have no settable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (v Fix128Value) ToBigEndianBytes() []byte {
+	return SignedBigIntToBigEndianBytes(v.BigInt)
+}
+
+func (v Fix128Value) ConformsToStaticType(
+	_ *Interpreter,
+	_ func() LocationRange,
+	_ TypeConformanceResults,
+) bool {
+	return true
+}
+
+func (Fix128Value) IsStorable() bool {
+	return true
+}
+
+func (v Fix128Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+	return v, nil
+}
+
+func (Fix128Value) NeedsStoreTo(_ atree.Address) bool {
+	return false
+}
+
+func (Fix128Value) IsResourceKinded(_ *Interpreter) bool {
+	return false
+}
+
+func (v Fix128Value) Transfer(
+	interpreter *Interpreter,
+	_ func() LocationRange,
+	_ atree.Address,
+	remove bool,
+	storable atree.Storable,
+) Value {
+	if remove {
+		interpreter.RemoveReferencedSlab(storable)
+	}
+	return v
+}
+
+func (v Fix128Value) Clone(_ *Interpreter) Value {
+	return NewUnmeteredFix128Value(v.BigInt)
+}
+
+func (Fix128Value) DeepRemove(_ *Interpreter) {
+	// NO-OP
+}
+
+func (v Fix128Value) ByteSize() uint32 {
+	return cborTagSize + getBigIntCBORSize(v.BigInt)
+}
+
+func (v Fix128Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+	return v, nil
+}
+
+func (Fix128Value) ChildStorables() []atree.Storable {
+	return nil
+}
+
+func (v Fix128Value) IntegerPart() NumberValue {
+	return NewUnmeteredInt128ValueFromBigInt(
+		new(big.Int).Quo(v.BigInt, sema.Fix128FactorBig),
+	)
+}
+
+func (Fix128Value) Scale() int {
+	return sema.Fix128Scale
+}
+
+// UFix128Value
+//
+type UFix128Value struct {
+	BigInt *big.Int
+}
+
+var UFix128MemoryUsage = common.NewBigIntMemoryUsage(16)
+
+func NewUFix128ValueWithInteger(gauge common.MemoryGauge, constructor func() *big.Int) UFix128Value {
+	common.UseMemory(gauge, UFix128MemoryUsage)
+	return NewUnmeteredUFix128ValueWithInteger(constructor())
+}
+
+func NewUnmeteredUFix128ValueWithInteger(integer *big.Int) UFix128Value {
+
+	if integer.Sign() < 0 {
+		panic(UnderflowError{})
+	}
+
+	if integer.Cmp(sema.UFix128TypeMaxIntBig) > 0 {
+		panic(OverflowError{})
+	}
+
+	return NewUnmeteredUFix128Value(new(big.Int).Mul(integer, sema.Fix128FactorBig))
+}
+
+func NewUFix128Value(gauge common.MemoryGauge, valueGetter func() *big.Int) UFix128Value {
+	common.UseMemory(gauge, UFix128MemoryUsage)
+	return NewUnmeteredUFix128Value(valueGetter())
+}
+
+func NewUnmeteredUFix128Value(value *big.Int) UFix128Value {
+	return UFix128Value{
+		BigInt: value,
+	}
+}
+
+var _ Value = UFix128Value{}
+var _ atree.Storable = UFix128Value{}
+var _ NumberValue = UFix128Value{}
+var _ FixedPointValue = UFix128Value{}
+var _ EquatableValue = UFix128Value{}
+var _ HashableValue = UFix128Value{}
+var _ MemberAccessibleValue = UFix128Value{}
+
+func (UFix128Value) IsValue() {}
+
+func (v UFix128Value) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitUFix128Value(interpreter, v)
+}
+
+func (UFix128Value) Walk(_ *Interpreter, _ func(Value)) {
+	// NO-OP
+}
+
+func (UFix128Value) StaticType(interpreter *Interpreter) StaticType {
+	return NewPrimitiveStaticType(interpreter, PrimitiveStaticTypeUFix128)
+}
+
+func (UFix128Value) IsImportable(_ *Interpreter) bool {
+	return true
+}
+
+func (v UFix128Value) String() string {
+	return format.UFix128(v.BigInt)
+}
+
+func (v UFix128Value) RecursiveString(_ SeenReferences) string {
+	return v.String()
+}
+
+func (v UFix128Value) MeteredString(memoryGauge common.MemoryGauge, _ SeenReferences) string {
+	common.UseMemory(
+		memoryGauge,
+		common.NewRawStringMemoryUsage(
+			OverEstimateNumberStringLength(memoryGauge, v),
+		),
+	)
+	return v.String()
+}
+
+func (v UFix128Value) ToInt() int {
+	integer := new(big.Int).Quo(v.BigInt, sema.Fix128FactorBig)
+	if !integer.IsInt64() {
+		panic(OverflowError{})
+	}
+	return int(integer.Int64())
+}
+
+func (v UFix128Value) Negate(*Interpreter) NumberValue {
+	panic(errors.NewUnreachableError())
+}
+
+func (v UFix128Value) Plus(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationPlus,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		res := new(big.Int).Add(v.BigInt, o.BigInt)
+		if res.Cmp(sema.UFix128TypeMaxBig) > 0 {
+			panic(OverflowError{})
+		}
+
+		return res
+	}
+
+	return NewUFix128Value(interpreter, valueGetter)
+}
+
+func (v UFix128Value) SaturatingPlus(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingAddFunctionName,
+			LeftType:     v.StaticType(interpreter),
+			RightType:    other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		res := new(big.Int).Add(v.BigInt, o.BigInt)
+		if res.Cmp(sema.UFix128TypeMaxBig) > 0 {
+			return sema.UFix128TypeMaxBig
+		}
+
+		return res
+	}
+
+	return NewUFix128Value(interpreter, valueGetter)
+}
+
+func (v UFix128Value) Minus(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMinus,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		res := new(big.Int).Sub(v.BigInt, o.BigInt)
+		if res.Sign() < 0 {
+			panic(UnderflowError{})
+		}
+
+		return res
+	}
+
+	return NewUFix128Value(interpreter, valueGetter)
+}
+
+func (v UFix128Value) SaturatingMinus(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingSubtractFunctionName,
+			LeftType:     v.StaticType(interpreter),
+			RightType:    other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		res := new(big.Int).Sub(v.BigInt, o.BigInt)
+		if res.Sign() < 0 {
+			return sema.UFix128TypeMinBig
+		}
+
+		return res
+	}
+
+	return NewUFix128Value(interpreter, valueGetter)
+}
+
+func (v UFix128Value) Mul(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMul,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		res := new(big.Int).Mul(v.BigInt, o.BigInt)
+		res.Div(res, sema.Fix128FactorBig)
+
+		if res.Cmp(sema.UFix128TypeMaxBig) > 0 {
+			panic(OverflowError{})
+		}
+
+		return res
+	}
+
+	return NewUFix128Value(interpreter, valueGetter)
+}
+
+func (v UFix128Value) SaturatingMul(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			FunctionName: sema.NumericTypeSaturatingMultiplyFunctionName,
+			LeftType:     v.StaticType(interpreter),
+			RightType:    other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		res := new(big.Int).Mul(v.BigInt, o.BigInt)
+		res.Div(res, sema.Fix128FactorBig)
+
+		if res.Cmp(sema.UFix128TypeMaxBig) > 0 {
+			return sema.UFix128TypeMaxBig
+		}
+
+		return res
+	}
+
+	return NewUFix128Value(interpreter, valueGetter)
+}
+
+func (v UFix128Value) Div(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationDiv,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() *big.Int {
+		// INT33-C
+		if o.BigInt.Sign() == 0 {
+			panic(DivisionByZeroError{})
+		}
+
+		res := new(big.Int).Mul(v.BigInt, sema.Fix128FactorBig)
+		res.Div(res, o.BigInt)
+
+		if res.Cmp(sema.UFix128TypeMaxBig) > 0 {
+			panic(OverflowError{})
+		}
+
+		return res
+	}
+
+	return NewUFix128Value(interpreter, valueGetter)
+}
+
+func (v UFix128Value) SaturatingDiv(interpreter *Interpreter, other NumberValue) NumberValue {
+	defer func() {
+		r := recover()
+		if _, ok := r.(InvalidOperandsError); ok {
+			panic(InvalidOperandsError{
+				FunctionName: sema.NumericTypeSaturatingDivideFunctionName,
+				LeftType:     v.StaticType(interpreter),
+				RightType:    other.StaticType(interpreter),
+			})
+		}
+	}()
+
+	return v.Div(interpreter, other)
+}
+
+func (v UFix128Value) Mod(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMod,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	// v - int(v/o) * o
+	quotient, ok := v.Div(interpreter, o).(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMod,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	truncatedQuotient := NewUFix128Value(
+		interpreter,
+		func() *big.Int {
+			res := new(big.Int).Quo(quotient.BigInt, sema.Fix128FactorBig)
+			return res.Mul(res, sema.Fix128FactorBig)
+		},
+	)
+
+	return v.Minus(
+		interpreter,
+		truncatedQuotient.Mul(interpreter, o),
+	)
+}
+
+func (v UFix128Value) Less(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationLess,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	return NewBoolValueFromConstructor(
+		interpreter,
+		func() bool {
+			return v.BigInt.Cmp(o.BigInt) < 0
+		},
+	)
+}
+
+func (v UFix128Value) LessEqual(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationLessEqual,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	return NewBoolValueFromConstructor(
+		interpreter,
+		func() bool {
+			return v.BigInt.Cmp(o.BigInt) <= 0
+		},
+	)
+}
+
+func (v UFix128Value) Greater(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationGreater,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	return NewBoolValueFromConstructor(
+		interpreter,
+		func() bool {
+			return v.BigInt.Cmp(o.BigInt) > 0
+		},
+	)
+}
+
+func (v UFix128Value) GreaterEqual(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(UFix128Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationGreaterEqual,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	return NewBoolValueFromConstructor(
+		interpreter,
+		func() bool {
+			return v.BigInt.Cmp(o.BigInt) >= 0
+		},
+	)
+}
+
+func (v UFix128Value) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherUFix128, ok := other.(UFix128Value)
+	if !ok {
+		return false
+	}
+	return v.BigInt.Cmp(otherUFix128.BigInt) == 0
+}
+
+// HashInput returns a byte slice containing:
+// - HashInputTypeUFix128 (1 byte)
+// - big int value encoded in big-endian (n bytes)
+func (v UFix128Value) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	b := UnsignedBigIntToBigEndianBytes(v.BigInt)
+
+	length := 1 + len(b)
+	var buffer []byte
+	if length <= len(scratch) {
+		buffer = scratch[:length]
+	} else {
+		buffer = make([]byte, length)
+	}
+
+	buffer[0] = byte(HashInputTypeUFix128)
+	copy(buffer[1:], b)
+	return buffer
+}
+
+func ConvertUFix128(memoryGauge common.MemoryGauge, value Value) UFix128Value {
+	switch value := value.(type) {
+	case UFix128Value:
+		return value
+
+	case Fix128Value:
+		if value.BigInt.Sign() < 0 {
+			panic(UnderflowError{})
+		}
+		return NewUFix128Value(
+			memoryGauge,
+			func() *big.Int {
+				return new(big.Int).Set(value.BigInt)
+			},
+		)
+
+	case Fix64Value:
+		if value < 0 {
+			panic(UnderflowError{})
+		}
+		return NewUFix128Value(
+			memoryGauge,
+			func() *big.Int {
+				return scaleFix64ToFix128(big.NewInt(int64(value)))
+			},
+		)
+
+	case UFix64Value:
+		return NewUFix128Value(
+			memoryGauge,
+			func() *big.Int {
+				return scaleFix64ToFix128(new(big.Int).SetUint64(uint64(value)))
+			},
+		)
+
+	case BigNumberValue:
+		// Check that the integer value fits the range of UFix128
+		return NewUFix128ValueWithInteger(
+			memoryGauge,
+			func() *big.Int {
+				return value.ToBigInt(memoryGauge)
+			},
+		)
+
+	case NumberValue:
+		// Check that the integer value fits the range of UFix128
+		return NewUFix128ValueWithInteger(
+			memoryGauge,
+			func() *big.Int {
+				return big.NewInt(int64(value.ToInt()))
+			},
+		)
+
+	default:
+		panic(fmt.Sprintf("can't convert to UFix128: %s", value))
+	}
+}
+
+func (v UFix128Value) GetMember(interpreter *Interpreter, _ func() LocationRange, name string) Value {
+	return getNumberValueMember(interpreter, v, name, sema.UFix128Type)
+}
+
+func (UFix128Value) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+	// Numbers have no removable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (UFix128Value) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+	// Numbers have no settable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (v UFix128Value) ToBigEndianBytes() []byte {
+	return UnsignedBigIntToBigEndianBytes(v.BigInt)
+}
+
+func (v UFix128Value) ConformsToStaticType(
+	_ *Interpreter,
+	_ func() LocationRange,
+	_ TypeConformanceResults,
+) bool {
+	return true
+}
+
+func (UFix128Value) IsStorable() bool {
+	return true
+}
+
+func (v UFix128Value) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+	return v, nil
+}
+
+func (UFix128Value) NeedsStoreTo(_ atree.Address) bool {
+	return false
+}
+
+func (UFix128Value) IsResourceKinded(_ *Interpreter) bool {
+	return false
+}
+
+func (v UFix128Value) Transfer(
+	interpreter *Interpreter,
+	_ func() LocationRange,
+	_ atree.Address,
+	remove bool,
+	storable atree.Storable,
+) Value {
+	if remove {
+		interpreter.RemoveReferencedSlab(storable)
+	}
+	return v
+}
+
+func (v UFix128Value) Clone(_ *Interpreter) Value {
+	return NewUnmeteredUFix128Value(v.BigInt)
+}
+
+func (UFix128Value) DeepRemove(_ *Interpreter) {
+	// NO-OP
+}
+
+func (v UFix128Value) ByteSize() uint32 {
+	return cborTagSize + getBigIntCBORSize(v.BigInt)
+}
+
+func (v UFix128Value) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+	return v, nil
+}
+
+func (UFix128Value) ChildStorables() []atree.Storable {
+	return nil
+}
+
+func (v UFix128Value) IntegerPart() NumberValue {
+	return NewUnmeteredUInt128ValueFromBigInt(
+		new(big.Int).Quo(v.BigInt, sema.Fix128FactorBig),
+	)
+}
+
+func (UFix128Value) Scale() int {
+	return sema.Fix128Scale
+}
+
+// TimestampValue
+
+type TimestampValue int64
+
+var TimestampMemoryUsage = common.NewNumberMemoryUsage(int64Size)
+
+func NewTimestampValue(gauge common.MemoryGauge, valueGetter func() int64) TimestampValue {
+	common.UseMemory(gauge, TimestampMemoryUsage)
+
+	return NewUnmeteredTimestampValue(valueGetter())
+}
+
+func NewUnmeteredTimestampValue(seconds int64) TimestampValue {
+	return TimestampValue(seconds)
+}
+
+// checkTimestampRange checks that the given number of seconds is in the range of timestamps,
+// i.e. the result of timestamp arithmetic can still be represented as an ISO-8601 string
+//
+func checkTimestampRange(seconds int64) int64 {
+	if seconds > sema.TimestampTypeMaxSeconds {
+		panic(OverflowError{})
+	} else if seconds < sema.TimestampTypeMinSeconds {
+		panic(UnderflowError{})
+	}
+	return seconds
+}
+
+var _ Value = TimestampValue(0)
+var _ atree.Storable = TimestampValue(0)
+var _ NumberValue = TimestampValue(0)
+var _ EquatableValue = TimestampValue(0)
+var _ HashableValue = TimestampValue(0)
+var _ MemberAccessibleValue = TimestampValue(0)
+
+func (TimestampValue) IsValue() {}
+
+func (v TimestampValue) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitTimestampValue(interpreter, v)
+}
+
+func (TimestampValue) Walk(_ *Interpreter, _ func(Value)) {
+	// NO-OP
+}
+
+func (TimestampValue) StaticType(interpreter *Interpreter) StaticType {
+	return NewPrimitiveStaticType(interpreter, PrimitiveStaticTypeTimestamp)
+}
+
+func (TimestampValue) IsImportable(_ *Interpreter) bool {
+	return true
+}
+
+func (v TimestampValue) String() string {
+	return format.Timestamp(int64(v))
+}
+
+func (v TimestampValue) RecursiveString(_ SeenReferences) string {
+	return v.String()
+}
+
+func (v TimestampValue) MeteredString(memoryGauge common.MemoryGauge, _ SeenReferences) string {
+	common.UseMemory(
+		memoryGauge,
+		common.NewRawStringMemoryUsage(len(format.TimestampLayout)),
+	)
+	return v.String()
+}
+
+func (v TimestampValue) ToInt() int {
+	return int(v)
+}
+
+func (TimestampValue) Negate(_ *Interpreter) NumberValue {
+	panic(errors.NewUnreachableError())
+}
+
+func (v TimestampValue) Plus(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(DurationValue)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationPlus,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() int64 {
+		return checkTimestampRange(safeAddInt64(int64(v), int64(o)))
+	}
+
+	return NewTimestampValue(interpreter, valueGetter)
+}
+
+func (TimestampValue) SaturatingPlus(_ *Interpreter, _ NumberValue) NumberValue {
+	panic(errors.NewUnreachableError())
+}
+
+func (v TimestampValue) Minus(interpreter *Interpreter, other NumberValue) NumberValue {
+	switch o := other.(type) {
+	case DurationValue:
+		valueGetter := func() int64 {
+			return checkTimestampRange(safeSubtractInt64(int64(v), int64(o)))
+		}
+
+		return NewTimestampValue(interpreter, valueGetter)
+
+	case TimestampValue:
+		valueGetter := func() int64 {
+			return safeSubtractInt64(int64(v), int64(o))
+		}
+
+		return NewDurationValue(interpreter, valueGetter)
+
+	default:
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMinus,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+}
+
+func (TimestampValue) SaturatingMinus(_ *Interpreter, _ NumberValue) NumberValue {
+	panic(errors.NewUnreachableError())
+}
+
+func (TimestampValue) Mod(_ *Interpreter, _ NumberValue) NumberValue {
+	panic(errors.NewUnreachableError())
+}
+
+func (TimestampValue) Mul(_ *Interpreter, _ NumberValue) NumberValue {
+	panic(errors.NewUnreachableError())
+}
+
+func (TimestampValue) SaturatingMul(_ *Interpreter, _ NumberValue) NumberValue {
+	panic(errors.NewUnreachableError())
+}
+
+func (TimestampValue) Div(_ *Interpreter, _ NumberValue) NumberValue {
+	panic(errors.NewUnreachableError())
+}
+
+func (TimestampValue) SaturatingDiv(_ *Interpreter, _ NumberValue) NumberValue {
+	panic(errors.NewUnreachableError())
+}
+
+func (v TimestampValue) Less(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(TimestampValue)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationLess,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	return NewBoolValue(interpreter, v < o)
+}
+
+func (v TimestampValue) LessEqual(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(TimestampValue)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationLessEqual,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	return NewBoolValue(interpreter, v <= o)
+}
+
+func (v TimestampValue) Greater(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(TimestampValue)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationGreater,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	return NewBoolValue(interpreter, v > o)
+}
+
+func (v TimestampValue) GreaterEqual(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(TimestampValue)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationGreaterEqual,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	return NewBoolValue(interpreter, v >= o)
+}
+
+func (v TimestampValue) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherTimestamp, ok := other.(TimestampValue)
+	if !ok {
+		return false
+	}
+	return v == otherTimestamp
+}
+
+// HashInput returns a byte slice containing:
+// - HashInputTypeTimestamp (1 byte)
+// - int64 seconds encoded in big-endian (8 bytes)
+func (v TimestampValue) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	scratch[0] = byte(HashInputTypeTimestamp)
+	binary.BigEndian.PutUint64(scratch[1:], uint64(v))
+	return scratch[:9]
+}
+
+// ConvertTimestamp converts the given ISO-8601 string into a timestamp.
+// Invalid strings are rejected statically if they are literals,
+// so this only fails for dynamically constructed strings.
+//
+func ConvertTimestamp(
+	memoryGauge common.MemoryGauge,
+	value Value,
+	getLocationRange func() LocationRange,
+) TimestampValue {
+	stringValue, ok := value.(*StringValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	seconds, ok := sema.ParseTimestamp(stringValue.Str)
+	if !ok {
+		panic(InvalidTimestampError{
+			Literal:       stringValue.Str,
+			LocationRange: getLocationRange(),
+		})
+	}
+
+	return NewTimestampValue(
+		memoryGauge,
+		func() int64 {
+			return seconds
+		},
+	)
+}
+
+func (v TimestampValue) GetMember(interpreter *Interpreter, _ func() LocationRange, name string) Value {
+	switch name {
+	case sema.TimestampTypeSecondsFieldName:
+		return NewInt64Value(
+			interpreter,
+			func() int64 {
+				return int64(v)
+			},
+		)
+
+	case sema.ToStringFunctionName:
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				memoryUsage := common.NewStringMemoryUsage(len(format.TimestampLayout))
+
+				return NewStringValue(
+					invocation.Interpreter,
+					memoryUsage,
+					v.String,
+				)
+			},
+			sema.ToStringFunctionType,
+		)
+	}
+
+	return nil
+}
+
+func (TimestampValue) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+	// Timestamps have no removable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (TimestampValue) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+	// Timestamps have no settable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (v TimestampValue) ToBigEndianBytes() []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(v))
+	return b
+}
+
+func (v TimestampValue) ConformsToStaticType(
+	_ *Interpreter,
+	_ func() LocationRange,
+	_ TypeConformanceResults,
+) bool {
+	return true
+}
+
+func (v TimestampValue) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+	return v, nil
+}
+
+func (TimestampValue) NeedsStoreTo(_ atree.Address) bool {
+	return false
+}
+
+func (TimestampValue) IsResourceKinded(_ *Interpreter) bool {
+	return false
+}
+
+func (v TimestampValue) Transfer(
+	interpreter *Interpreter,
+	_ func() LocationRange,
+	_ atree.Address,
+	remove bool,
+	storable atree.Storable,
+) Value {
+	if remove {
+		interpreter.RemoveReferencedSlab(storable)
+	}
+	return v
+}
+
+func (v TimestampValue) Clone(_ *Interpreter) Value {
+	return v
+}
+
+func (TimestampValue) DeepRemove(_ *Interpreter) {
+	// NO-OP
+}
+
+func (v TimestampValue) ByteSize() uint32 {
+	return cborTagSize + getIntCBORSize(int64(v))
+}
+
+func (v TimestampValue) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+	return v, nil
+}
+
+func (TimestampValue) ChildStorables() []atree.Storable {
+	return nil
+}
+
+// DurationValue
+
+type DurationValue int64
+
+var DurationMemoryUsage = common.NewNumberMemoryUsage(int64Size)
+
+func NewDurationValue(gauge common.MemoryGauge, valueGetter func() int64) DurationValue {
+	common.UseMemory(gauge, DurationMemoryUsage)
+
+	return NewUnmeteredDurationValue(valueGetter())
+}
+
+func NewUnmeteredDurationValue(seconds int64) DurationValue {
+	return DurationValue(seconds)
+}
+
+func ConvertDuration(memoryGauge common.MemoryGauge, value Value) DurationValue {
+	seconds, ok := value.(Int64Value)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	return NewDurationValue(
+		memoryGauge,
+		func() int64 {
+			return int64(seconds)
+		},
+	)
+}
+
+var _ Value = DurationValue(0)
+var _ atree.Storable = DurationValue(0)
+var _ NumberValue = DurationValue(0)
+var _ EquatableValue = DurationValue(0)
+var _ HashableValue = DurationValue(0)
+var _ MemberAccessibleValue = DurationValue(0)
+
+func (DurationValue) IsValue() {}
+
+func (v DurationValue) Accept(interpreter *Interpreter, visitor Visitor) {
+	visitor.VisitDurationValue(interpreter, v)
+}
+
+func (DurationValue) Walk(_ *Interpreter, _ func(Value)) {
+	// NO-OP
+}
+
+func (DurationValue) StaticType(interpreter *Interpreter) StaticType {
+	return NewPrimitiveStaticType(interpreter, PrimitiveStaticTypeDuration)
+}
+
+func (DurationValue) IsImportable(_ *Interpreter) bool {
+	return true
+}
+
+func (v DurationValue) String() string {
+	return format.Int(int64(v))
+}
+
+func (v DurationValue) RecursiveString(_ SeenReferences) string {
+	return v.String()
+}
+
+func (v DurationValue) MeteredString(memoryGauge common.MemoryGauge, _ SeenReferences) string {
+	common.UseMemory(
+		memoryGauge,
+		common.NewRawStringMemoryUsage(
+			OverEstimateNumberStringLength(memoryGauge, v),
+		),
+	)
+	return v.String()
+}
+
+func (v DurationValue) ToInt() int {
+	return int(v)
+}
+
+func (DurationValue) Negate(_ *Interpreter) NumberValue {
+	panic(errors.NewUnreachableError())
+}
+
+func (v DurationValue) Plus(interpreter *Interpreter, other NumberValue) NumberValue {
+	switch o := other.(type) {
+	case DurationValue:
+		valueGetter := func() int64 {
+			return safeAddInt64(int64(v), int64(o))
+		}
+
+		return NewDurationValue(interpreter, valueGetter)
+
+	case TimestampValue:
+		valueGetter := func() int64 {
+			return checkTimestampRange(safeAddInt64(int64(v), int64(o)))
+		}
+
+		return NewTimestampValue(interpreter, valueGetter)
+
+	default:
+		panic(InvalidOperandsError{
+			Operation: ast.OperationPlus,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+}
+
+func (DurationValue) SaturatingPlus(_ *Interpreter, _ NumberValue) NumberValue {
+	panic(errors.NewUnreachableError())
+}
+
+func (v DurationValue) Minus(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(DurationValue)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMinus,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() int64 {
+		return safeSubtractInt64(int64(v), int64(o))
+	}
+
+	return NewDurationValue(interpreter, valueGetter)
+}
+
+func (DurationValue) SaturatingMinus(_ *Interpreter, _ NumberValue) NumberValue {
+	panic(errors.NewUnreachableError())
+}
+
+func (DurationValue) Mod(_ *Interpreter, _ NumberValue) NumberValue {
+	panic(errors.NewUnreachableError())
+}
+
+func (v DurationValue) Mul(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Int64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationMul,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	valueGetter := func() int64 {
+		return safeMulInt64(int64(v), int64(o))
+	}
+
+	return NewDurationValue(interpreter, valueGetter)
+}
+
+func (DurationValue) SaturatingMul(_ *Interpreter, _ NumberValue) NumberValue {
+	panic(errors.NewUnreachableError())
+}
+
+func (v DurationValue) Div(interpreter *Interpreter, other NumberValue) NumberValue {
+	o, ok := other.(Int64Value)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationDiv,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	// INT33-C
+	if o == 0 {
+		panic(DivisionByZeroError{})
+	} else if (v == math.MinInt64) && (o == -1) {
+		panic(OverflowError{})
+	}
+
+	valueGetter := func() int64 {
+		return int64(v) / int64(o)
+	}
+
+	return NewDurationValue(interpreter, valueGetter)
+}
+
+func (DurationValue) SaturatingDiv(_ *Interpreter, _ NumberValue) NumberValue {
+	panic(errors.NewUnreachableError())
+}
+
+func (v DurationValue) Less(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(DurationValue)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationLess,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	return NewBoolValue(interpreter, v < o)
+}
+
+func (v DurationValue) LessEqual(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(DurationValue)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationLessEqual,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	return NewBoolValue(interpreter, v <= o)
+}
+
+func (v DurationValue) Greater(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(DurationValue)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationGreater,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	return NewBoolValue(interpreter, v > o)
+}
+
+func (v DurationValue) GreaterEqual(interpreter *Interpreter, other NumberValue) BoolValue {
+	o, ok := other.(DurationValue)
+	if !ok {
+		panic(InvalidOperandsError{
+			Operation: ast.OperationGreaterEqual,
+			LeftType:  v.StaticType(interpreter),
+			RightType: other.StaticType(interpreter),
+		})
+	}
+
+	return NewBoolValue(interpreter, v >= o)
+}
+
+func (v DurationValue) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherDuration, ok := other.(DurationValue)
+	if !ok {
+		return false
+	}
+	return v == otherDuration
+}
+
+// HashInput returns a byte slice containing:
+// - HashInputTypeDuration (1 byte)
+// - int64 seconds encoded in big-endian (8 bytes)
+func (v DurationValue) HashInput(_ *Interpreter, _ func() LocationRange, scratch []byte) []byte {
+	scratch[0] = byte(HashInputTypeDuration)
+	binary.BigEndian.PutUint64(scratch[1:], uint64(v))
+	return scratch[:9]
+}
+
+func (v DurationValue) GetMember(interpreter *Interpreter, _ func() LocationRange, name string) Value {
+	switch name {
+	case sema.DurationTypeSecondsFieldName:
+		return NewInt64Value(
+			interpreter,
+			func() int64 {
+				return int64(v)
+			},
+		)
+
+	case sema.ToStringFunctionName:
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				interpreter := invocation.Interpreter
+				memoryUsage := common.NewStringMemoryUsage(
+					OverEstimateNumberStringLength(interpreter, v),
+				)
+
+				return NewStringValue(
+					interpreter,
+					memoryUsage,
+					v.String,
+				)
+			},
+			sema.ToStringFunctionType,
+		)
+	}
+
+	return nil
+}
+
+func (DurationValue) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+	// Durations have no removable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (DurationValue) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+	// Durations have no settable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
+
+func (v DurationValue) ToBigEndianBytes() []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(v))
+	return b
+}
+
+func (v DurationValue) ConformsToStaticType(
+	_ *Interpreter,
+	_ func() LocationRange,
+	_ TypeConformanceResults,
+) bool {
+	return true
+}
+
+func (v DurationValue) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+	return v, nil
+}
+
+func (DurationValue) NeedsStoreTo(_ atree.Address) bool {
+	return false
+}
+
+func (DurationValue) IsResourceKinded(_ *Interpreter) bool {
+	return false
+}
+
+func (v DurationValue) Transfer(
+	interpreter *Interpreter,
+	_ func() LocationRange,
+	_ atree.Address,
+	remove bool,
+	storable atree.Storable,
+) Value {
+	if remove {
+		interpreter.RemoveReferencedSlab(storable)
+	}
+	return v
+}
+
+func (v DurationValue) Clone(_ *Interpreter) Value {
+	return v
+}
+
+func (DurationValue) DeepRemove(_ *Interpreter) {
+	// NO-OP
+}
+
+func (v DurationValue) ByteSize() uint32 {
+	return cborTagSize + getIntCBORSize(int64(v))
+}
+
+func (v DurationValue) StoredValue(_ atree.SlabStorage) (atree.Value, error) {
+	return v, nil
+}
+
+func (DurationValue) ChildStorables() []atree.Storable {
+	return nil
+}
+
+// CompositeValue
+
+type CompositeValue struct {
+	dictionary          *atree.OrderedMap
+	Location            common.Location
+	QualifiedIdentifier string
+	Kind                common.CompositeKind
+	TypeArguments       []StaticType
+	InjectedFields      map[string]Value
+	ComputedFields      map[string]ComputedField
+	NestedVariables     map[string]*Variable
+	Functions           map[string]FunctionValue
+	Destructor          FunctionValue
+	Stringer            func(gauge common.MemoryGauge, value *CompositeValue, seenReferences SeenReferences) string
+	isDestroyed         bool
+	typeID              common.TypeID
+	staticType          StaticType
+}
+
+type ComputedField func(*Interpreter, func() LocationRange) Value
+
+type CompositeField struct {
+	Name  string
+	Value Value
+}
+
+func NewCompositeField(memoryGauge common.MemoryGauge, name string, value Value) CompositeField {
+	common.UseMemory(memoryGauge, common.CompositeFieldMemoryUsage)
+	return NewUnmeteredCompositeField(name, value)
+}
+
+func NewUnmeteredCompositeField(name string, value Value) CompositeField {
+	return CompositeField{
+		Name:  name,
+		Value: value,
+	}
+}
+
+func NewCompositeValue(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	location common.Location,
+	qualifiedIdentifier string,
+	kind common.CompositeKind,
+	fields []CompositeField,
+	address common.Address,
+) *CompositeValue {
+	return NewCompositeValueWithTypeArguments(
+		interpreter,
+		getLocationRange,
+		location,
+		qualifiedIdentifier,
+		kind,
+		nil,
+		fields,
+		address,
+	)
+}
+
+// NewCompositeValueWithTypeArguments creates a new composite value
+// of an instantiated generic composite type, e.g. `Box<Int>`
+//
+func NewCompositeValueWithTypeArguments(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	location common.Location,
 	qualifiedIdentifier string,
 	kind common.CompositeKind,
+	typeArguments []StaticType,
 	fields []CompositeField,
 	address common.Address,
 ) *CompositeValue {
 
-	interpreter.ReportComputation(common.ComputationKindCreateCompositeValue, 1)
+	interpreter.ReportComputation(common.ComputationKindCreateCompositeValue, 1)
+
+	var v *CompositeValue
+	if interpreter.tracingEnabled {
+		startTime := time.Now()
+
+		defer func() {
+			// NOTE: in defer, as v is only initialized at the end of the function
+			// if there was no error during construction
+			if v == nil {
+				return
+			}
+
+			owner := v.GetOwner().String()
+			typeID := string(v.TypeID())
+			kind := v.Kind.String()
+
+			interpreter.reportCompositeValueConstructTrace(
+				owner,
+				typeID,
+				kind,
+				time.Since(startTime),
+			)
+		}()
+	}
+
+	constructor := func() *atree.OrderedMap {
+		typeInfo := NewCompositeTypeInfo(
+			interpreter,
+			location,
+			qualifiedIdentifier,
+			kind,
+		)
+		typeInfo.typeArguments = typeArguments
+
+		dictionary, err := atree.NewMap(
+			interpreter.Storage,
+			atree.Address(address),
+			atree.NewDefaultDigesterBuilder(),
+			typeInfo,
+		)
+		if err != nil {
+			panic(errors.NewExternalError(err))
+		}
+		return dictionary
+	}
+
+	typeInfo := NewCompositeTypeInfo(
+		interpreter,
+		location,
+		qualifiedIdentifier,
+		kind,
+	)
+	typeInfo.typeArguments = typeArguments
+
+	v = newCompositeValueFromConstructor(interpreter, uint64(len(fields)), typeInfo, constructor)
+
+	for _, field := range fields {
+		v.SetMember(
+			interpreter,
+			getLocationRange,
+			field.Name,
+			field.Value,
+		)
+	}
+
+	return v
+}
+
+func newCompositeValueFromOrderedMap(
+	dict *atree.OrderedMap,
+	typeInfo compositeTypeInfo,
+) *CompositeValue {
+	return &CompositeValue{
+		dictionary:          dict,
+		Location:            typeInfo.location,
+		QualifiedIdentifier: typeInfo.qualifiedIdentifier,
+		Kind:                typeInfo.kind,
+		TypeArguments:       typeInfo.typeArguments,
+	}
+}
+
+func newCompositeValueFromConstructor(
+	gauge common.MemoryGauge,
+	count uint64,
+	typeInfo compositeTypeInfo,
+	constructor func() *atree.OrderedMap,
+) *CompositeValue {
+	baseUse, elementOverhead, dataUse, metaDataUse := common.NewCompositeMemoryUsages(count, 0)
+	common.UseMemory(gauge, baseUse)
+	common.UseMemory(gauge, elementOverhead)
+	common.UseMemory(gauge, dataUse)
+	common.UseMemory(gauge, metaDataUse)
+	return newCompositeValueFromOrderedMap(constructor(), typeInfo)
+}
+
+var _ Value = &CompositeValue{}
+var _ EquatableValue = &CompositeValue{}
+var _ HashableValue = &CompositeValue{}
+var _ MemberAccessibleValue = &CompositeValue{}
+var _ ReferenceTrackedResourceKindedValue = &CompositeValue{}
+
+func (*CompositeValue) IsValue() {}
+
+func (v *CompositeValue) Accept(interpreter *Interpreter, visitor Visitor) {
+	descend := visitor.VisitCompositeValue(interpreter, v)
+	if !descend {
+		return
+	}
+
+	v.ForEachField(interpreter, func(_ string, value Value) {
+		value.Accept(interpreter, visitor)
+	})
+}
+
+// Walk iterates over all field values of the composite value.
+// It does NOT walk the computed fields and functions!
+//
+func (v *CompositeValue) Walk(interpreter *Interpreter, walkChild func(Value)) {
+	v.ForEachField(interpreter, func(_ string, value Value) {
+		walkChild(value)
+	})
+}
+
+func (v *CompositeValue) StaticType(interpreter *Interpreter) StaticType {
+	if v.staticType == nil {
+		// NOTE: Instead of using NewCompositeStaticType, which always generates the type ID,
+		// use the TypeID accessor, which may return an already computed type ID
+		staticType := NewCompositeStaticType(
+			interpreter,
+			v.Location,
+			v.QualifiedIdentifier,
+			v.TypeID(), // TODO TypeID metering
+		)
+		staticType.TypeArguments = v.TypeArguments
+		v.staticType = staticType
+	}
+	return v.staticType
+}
+
+func (v *CompositeValue) IsImportable(inter *Interpreter) bool {
+	staticType := v.StaticType(inter)
+	semaType, err := inter.ConvertStaticToSemaType(staticType)
+	if err != nil {
+		panic(err)
+	}
+	return semaType.IsImportable(map[*sema.Member]bool{})
+}
+
+func (v *CompositeValue) IsDestroyed() bool {
+	return v.isDestroyed
+}
+
+func (v *CompositeValue) Destroy(interpreter *Interpreter, getLocationRange func() LocationRange) {
+	v.destroy(interpreter, getLocationRange, true)
+}
+
+func (v *CompositeValue) destroy(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	invokeDestructor bool,
+) {
+
+	interpreter.ReportComputation(common.ComputationKindDestroyCompositeValue, 1)
+
+	if interpreter.invalidatedResourceValidationEnabled {
+		v.checkInvalidatedResourceUse(getLocationRange)
+	}
+
+	storageID := v.StorageID()
+
+	interpreter.recordMutation(v)
+
+	if interpreter.tracingEnabled {
+		startTime := time.Now()
+
+		owner := v.GetOwner().String()
+		typeID := string(v.TypeID())
+		kind := v.Kind.String()
+
+		defer func() {
+
+			interpreter.reportCompositeValueDestroyTrace(
+				owner,
+				typeID,
+				kind,
+				time.Since(startTime),
+			)
+		}()
+	}
+
+	interpreter = v.getInterpreter(interpreter)
+
+	// if composite was deserialized, dynamically link in the destructor
+	if v.Destructor == nil {
+		v.Destructor = interpreter.typeCodes.CompositeCodes[v.TypeID()].DestructorFunction
+	}
+
+	destructor := v.Destructor
+
+	if invokeDestructor && destructor != nil {
+		invocation := NewInvocation(
+			interpreter,
+			v,
+			nil,
+			nil,
+			nil,
+			getLocationRange,
+		)
+
+		destructor.invoke(invocation)
+	}
+
+	v.isDestroyed = true
+
+	if interpreter.invalidatedResourceValidationEnabled {
+		v.dictionary = nil
+	}
+
+	interpreter.updateReferencedResource(
+		storageID,
+		storageID,
+		func(value ReferenceTrackedResourceKindedValue) {
+			compositeValue, ok := value.(*CompositeValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			compositeValue.isDestroyed = true
+
+			if interpreter.invalidatedResourceValidationEnabled {
+				compositeValue.dictionary = nil
+			}
+		},
+	)
+}
+
+func (v *CompositeValue) GetMember(interpreter *Interpreter, getLocationRange func() LocationRange, name string) Value {
+
+	if interpreter.invalidatedResourceValidationEnabled {
+		v.checkInvalidatedResourceUse(getLocationRange)
+	}
+
+	if interpreter.tracingEnabled {
+		startTime := time.Now()
+
+		owner := v.GetOwner().String()
+		typeID := string(v.TypeID())
+		kind := v.Kind.String()
+
+		defer func() {
+			interpreter.reportCompositeValueGetMemberTrace(
+				owner,
+				typeID,
+				kind,
+				name,
+				time.Since(startTime),
+			)
+		}()
+	}
+
+	if v.Kind == common.CompositeKindResource &&
+		name == sema.ResourceOwnerFieldName {
+
+		return v.OwnerValue(interpreter, getLocationRange)
+	}
+
+	storable, err := v.dictionary.Get(
+		StringAtreeComparator,
+		StringAtreeHashInput,
+		StringAtreeValue(name),
+	)
+	if err != nil {
+		if _, ok := err.(*atree.KeyNotFoundError); !ok {
+			panic(errors.NewExternalError(err))
+		}
+	}
+	if storable != nil {
+		return StoredValue(interpreter, storable, interpreter.Storage)
+	}
+
+	if v.NestedVariables != nil {
+		variable, ok := v.NestedVariables[name]
+		if ok {
+			return variable.GetValue()
+		}
+	}
+
+	interpreter = v.getInterpreter(interpreter)
+
+	if v.ComputedFields != nil {
+		if computedField, ok := v.ComputedFields[name]; ok {
+			return computedField(interpreter, getLocationRange)
+		}
+	}
+
+	// If the composite value was deserialized, dynamically link in the functions
+	// and get injected fields
+
+	v.InitializeFunctions(interpreter)
+
+	if v.InjectedFields == nil && interpreter.injectedCompositeFieldsHandler != nil {
+		v.InjectedFields = interpreter.injectedCompositeFieldsHandler(
+			interpreter,
+			v.Location,
+			v.QualifiedIdentifier,
+			v.Kind,
+		)
+	}
+
+	if v.InjectedFields != nil {
+		value, ok := v.InjectedFields[name]
+		if ok {
+			return value
+		}
+	}
+
+	function, ok := v.Functions[name]
+	if ok {
+		return NewBoundFunctionValue(interpreter, function, v)
+	}
+
+	return nil
+}
+
+func (v *CompositeValue) checkInvalidatedResourceUse(getLocationRange func() LocationRange) {
+	if v.isDestroyed || (v.dictionary == nil && v.Kind == common.CompositeKindResource) {
+		panic(InvalidatedResourceError{
+			LocationRange: getLocationRange(),
+		})
+	}
+}
+
+func (v *CompositeValue) getInterpreter(interpreter *Interpreter) *Interpreter {
+
+	// Get the correct interpreter. The program code might need to be loaded.
+	// NOTE: standard library values have no location
+
+	location := v.Location
+
+	if location == nil || interpreter.Location == location {
+		return interpreter
+	}
+
+	return interpreter.EnsureLoaded(v.Location)
+}
+
+func (v *CompositeValue) InitializeFunctions(interpreter *Interpreter) {
+	if v.Functions != nil {
+		return
+	}
+
+	v.Functions = interpreter.typeCodes.CompositeCodes[v.TypeID()].CompositeFunctions
+}
+
+func (v *CompositeValue) OwnerValue(interpreter *Interpreter, getLocationRange func() LocationRange) OptionalValue {
+	address := v.StorageID().Address
+
+	if address == (atree.Address{}) {
+		return NewNilValue(interpreter)
+	}
+
+	ownerAccount := interpreter.publicAccountHandler(interpreter, AddressValue(address))
+
+	// Owner must be of `PublicAccount` type.
+	interpreter.ExpectType(ownerAccount, sema.PublicAccountType, getLocationRange)
+
+	return NewSomeValueNonCopying(interpreter, ownerAccount)
+}
+
+func (v *CompositeValue) RemoveMember(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	name string,
+) Value {
+
+	if interpreter.invalidatedResourceValidationEnabled {
+		v.checkInvalidatedResourceUse(getLocationRange)
+	}
+
+	if interpreter.tracingEnabled {
+		startTime := time.Now()
+
+		owner := v.GetOwner().String()
+		typeID := string(v.TypeID())
+		kind := v.Kind.String()
+
+		defer func() {
+			interpreter.reportCompositeValueRemoveMemberTrace(
+				owner,
+				typeID,
+				kind,
+				name,
+				time.Since(startTime),
+			)
+		}()
+	}
+
+	interpreter.recordMutation(v)
+
+	// No need to clean up storable for passed-in key value,
+	// as atree never calls Storable()
+	existingKeyStorable, existingValueStorable, err := v.dictionary.Remove(
+		StringAtreeComparator,
+		StringAtreeHashInput,
+		StringAtreeValue(name),
+	)
+	if err != nil {
+		if _, ok := err.(*atree.KeyNotFoundError); ok {
+			return nil
+		}
+		panic(errors.NewExternalError(err))
+	}
+	interpreter.maybeValidateAtreeValue(v.dictionary)
+
+	storage := interpreter.Storage
+
+	// Key
+	interpreter.RemoveReferencedSlab(existingKeyStorable)
+
+	// Value
+
+	storedValue := StoredValue(interpreter, existingValueStorable, storage)
+	return storedValue.
+		Transfer(
+			interpreter,
+			getLocationRange,
+			atree.Address{},
+			true,
+			existingValueStorable,
+		)
+}
+
+func (v *CompositeValue) SetMember(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	name string,
+	value Value,
+) {
+	if interpreter.invalidatedResourceValidationEnabled {
+		v.checkInvalidatedResourceUse(getLocationRange)
+	}
+
+	if interpreter.tracingEnabled {
+		startTime := time.Now()
+
+		owner := v.GetOwner().String()
+		typeID := string(v.TypeID())
+		kind := v.Kind.String()
+
+		defer func() {
+			interpreter.reportCompositeValueSetMemberTrace(
+				owner,
+				typeID,
+				kind,
+				name,
+				time.Since(startTime),
+			)
+		}()
+	}
+
+	address := v.StorageID().Address
+
+	value = value.Transfer(
+		interpreter,
+		getLocationRange,
+		address,
+		true,
+		nil,
+	)
+
+	interpreter.recordMutation(v)
+
+	existingStorable, err := v.dictionary.Set(
+		StringAtreeComparator,
+		StringAtreeHashInput,
+		NewStringAtreeValue(interpreter, name),
+		value,
+	)
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+	interpreter.maybeValidateAtreeValue(v.dictionary)
+
+	if existingStorable != nil {
+		existingValue := StoredValue(interpreter, existingStorable, interpreter.Storage)
+
+		existingValue.DeepRemove(interpreter)
+
+		interpreter.RemoveReferencedSlab(existingStorable)
+	}
+}
+
+func (v *CompositeValue) String() string {
+	return v.RecursiveString(SeenReferences{})
+}
+
+func (v *CompositeValue) RecursiveString(seenReferences SeenReferences) string {
+	return v.MeteredString(nil, seenReferences)
+}
+
+var emptyCompositeStringLen = len(format.Composite("", nil))
+
+func (v *CompositeValue) MeteredString(memoryGauge common.MemoryGauge, seenReferences SeenReferences) string {
+
+	if v.Stringer != nil {
+		return v.Stringer(memoryGauge, v, seenReferences)
+	}
+
+	strLen := emptyCompositeStringLen
+
+	var fields []CompositeField
+	_ = v.dictionary.Iterate(func(key atree.Value, value atree.Value) (resume bool, err error) {
+		field := NewCompositeField(
+			memoryGauge,
+			string(key.(StringAtreeValue)),
+			MustConvertStoredValue(memoryGauge, value),
+		)
+
+		fields = append(fields, field)
+
+		strLen += len(field.Name)
+
+		return true, nil
+	})
+
+	typeId := string(v.TypeID())
+
+	// bodyLen = len(fieldNames) + len(typeId) + (n times colon+space) + ((n-1) times comma+space)
+	//         = len(fieldNames) + len(typeId) + 2n + 2n - 2
+	//         = len(fieldNames) + len(typeId) + 4n - 2
+	//
+	// Since (-2) only occurs if its non-empty, ignore the (-2). i.e: overestimate
+	// 		bodyLen = len(fieldNames) + len(typeId) + 4n
+	//
+	strLen = strLen + len(typeId) + len(fields)*4
+
+	common.UseMemory(memoryGauge, common.NewRawStringMemoryUsage(strLen))
+
+	return formatComposite(memoryGauge, typeId, fields, seenReferences)
+}
+
+func formatComposite(memoryGauge common.MemoryGauge, typeId string, fields []CompositeField, seenReferences SeenReferences) string {
+	preparedFields := make(
+		[]struct {
+			Name  string
+			Value string
+		},
+		0,
+		len(fields),
+	)
+
+	for _, field := range fields {
+		preparedFields = append(
+			preparedFields,
+			struct {
+				Name  string
+				Value string
+			}{
+				Name:  field.Name,
+				Value: field.Value.MeteredString(memoryGauge, seenReferences),
+			},
+		)
+	}
+
+	return format.Composite(typeId, preparedFields)
+}
+
+func (v *CompositeValue) GetField(interpreter *Interpreter, getLocationRange func() LocationRange, name string) Value {
+
+	if interpreter.invalidatedResourceValidationEnabled {
+		v.checkInvalidatedResourceUse(getLocationRange)
+	}
+
+	storable, err := v.dictionary.Get(
+		StringAtreeComparator,
+		StringAtreeHashInput,
+		StringAtreeValue(name),
+	)
+	if err != nil {
+		if _, ok := err.(*atree.KeyNotFoundError); ok {
+			return nil
+		}
+		panic(errors.NewExternalError(err))
+	}
+
+	return StoredValue(interpreter, storable, v.dictionary.Storage)
+}
+
+func (v *CompositeValue) Equal(interpreter *Interpreter, getLocationRange func() LocationRange, other Value) bool {
+	otherComposite, ok := other.(*CompositeValue)
+	if !ok {
+		return false
+	}
+
+	if !v.StaticType(interpreter).Equal(otherComposite.StaticType(interpreter)) ||
+		v.Kind != otherComposite.Kind {
+
+		return false
+	}
+
+	if v.Kind == common.CompositeKindStructure {
+		equalsFunction := v.equatableEqualsFunction(interpreter, getLocationRange)
+		if equalsFunction != nil {
+			return v.invokeEquatableEqualsFunction(
+				interpreter,
+				getLocationRange,
+				equalsFunction,
+				otherComposite,
+			)
+		}
+	}
+
+	if v.dictionary.Count() != otherComposite.dictionary.Count() {
+		return false
+	}
+
+	iterator, err := v.dictionary.Iterator()
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	for {
+		key, value, err := iterator.Next()
+		if err != nil {
+			panic(errors.NewExternalError(err))
+		}
+		if key == nil {
+			return true
+		}
+
+		fieldName := string(key.(StringAtreeValue))
+
+		// NOTE: Do NOT use an iterator, iteration order of fields may be different
+		// (if stored in different account, as storage ID is used as hash seed)
+		otherValue := otherComposite.GetField(interpreter, getLocationRange, fieldName)
+
+		equatableValue, ok := MustConvertStoredValue(interpreter, value).(EquatableValue)
+		if !ok || !equatableValue.Equal(interpreter, getLocationRange, otherValue) {
+			return false
+		}
+	}
+}
+
+// equatableEqualsFunction returns the user-defined `equals` function
+// of the structure value, if the structure conforms to the Equatable interface.
+// If the structure does not declare an `equals` function, values are compared field-wise.
+//
+func (v *CompositeValue) equatableEqualsFunction(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+) FunctionValue {
+	if v.Location == nil {
+		return nil
+	}
+
+	typeID := v.TypeID()
+
+	// NOTE: Check the functions of the type before loading the type:
+	// values which were not created by a program (e.g. in tests) have no functions.
+
+	compositeCode, ok := interpreter.typeCodes.CompositeCodes[typeID]
+	if !ok {
+		return nil
+	}
+
+	if _, ok := compositeCode.CompositeFunctions[sema.EquatableTypeEqualsFunctionName]; !ok {
+		return nil
+	}
+
+	compositeType, err := interpreter.GetCompositeType(v.Location, v.QualifiedIdentifier, typeID)
+	if err != nil {
+		panic(err)
+	}
+
+	if !compositeType.IsUserEquatable() {
+		return nil
+	}
+
+	return v.GetMember(interpreter, getLocationRange, sema.EquatableTypeEqualsFunctionName).(FunctionValue)
+}
+
+func (v *CompositeValue) invokeEquatableEqualsFunction(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	equalsFunction FunctionValue,
+	other *CompositeValue,
+) bool {
+	// The argument is passed by value, like for any other invocation
+	argument := other.Transfer(
+		interpreter,
+		getLocationRange,
+		atree.Address{},
+		false,
+		nil,
+	)
+
+	otherType := interpreter.MustConvertStaticToSemaType(other.StaticType(interpreter))
+
+	invocation := NewInvocation(
+		interpreter,
+		nil,
+		[]Value{argument},
+		[]sema.Type{otherType},
+		nil,
+		getLocationRange,
+	)
+
+	result := equalsFunction.invoke(invocation)
+
+	return bool(result.(BoolValue))
+}
+
+// HashInput returns a byte slice containing:
+//
+// For enums:
+// - HashInputTypeEnum (1 byte)
+// - type id (n bytes)
+// - hash input of raw value field name (n bytes)
+//
+// For hashable structures:
+// - HashInputTypeComposite (1 byte)
+// - type id (n bytes)
+// - hash inputs of the fields, ordered by field name (n bytes)
+func (v *CompositeValue) HashInput(interpreter *Interpreter, getLocationRange func() LocationRange, scratch []byte) []byte {
+	switch v.Kind {
+	case common.CompositeKindStructure:
+		return v.structureHashInput(interpreter, getLocationRange, scratch)
+
+	case common.CompositeKindEnum:
+		typeID := v.TypeID()
+
+		rawValue := v.GetField(interpreter, getLocationRange, sema.EnumRawValueFieldName)
+		rawValueHashInput := rawValue.(HashableValue).
+			HashInput(interpreter, getLocationRange, scratch)
+
+		length := 1 + len(typeID) + len(rawValueHashInput)
+		if length <= len(scratch) {
+			// Copy rawValueHashInput first because
+			// rawValueHashInput and scratch can point to the same underlying scratch buffer
+			copy(scratch[1+len(typeID):], rawValueHashInput)
+
+			scratch[0] = byte(HashInputTypeEnum)
+			copy(scratch[1:], typeID)
+			return scratch[:length]
+		}
+
+		buffer := make([]byte, length)
+		buffer[0] = byte(HashInputTypeEnum)
+		copy(buffer[1:], typeID)
+		copy(buffer[1+len(typeID):], rawValueHashInput)
+		return buffer
+	}
+
+	panic(errors.NewUnreachableError())
+}
+
+func (v *CompositeValue) structureHashInput(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	scratch []byte,
+) []byte {
+
+	// NOTE: Do NOT use the iteration order of the fields,
+	// it may be different for equal values (if stored in different accounts,
+	// as the storage ID is used as the hash seed).
+	// Instead, order the fields by name, so the hash input is deterministic
+
+	fieldNames := make([]string, 0, v.dictionary.Count())
+	v.ForEachField(interpreter, func(fieldName string, _ Value) {
+		fieldNames = append(fieldNames, fieldName)
+	})
+	sort.Strings(fieldNames)
+
+	typeID := v.TypeID()
+
+	buffer := make([]byte, 1+len(typeID))
+	buffer[0] = byte(HashInputTypeComposite)
+	copy(buffer[1:], typeID)
+
+	for _, fieldName := range fieldNames {
+		fieldValue := v.GetField(interpreter, getLocationRange, fieldName)
+
+		// NOTE: the field's hash input may point to the scratch buffer,
+		// so it must be copied into the buffer before the next field is hashed
+
+		fieldHashInput := fieldValue.(HashableValue).
+			HashInput(interpreter, getLocationRange, scratch)
+		buffer = append(buffer, fieldHashInput...)
+	}
+
+	return buffer
+}
+
+func (v *CompositeValue) TypeID() common.TypeID {
+	if v.typeID == "" {
+		location := v.Location
+		qualifiedIdentifier := v.QualifiedIdentifier
+		if location == nil {
+			return common.TypeID(qualifiedIdentifier)
+		}
+		v.typeID = location.TypeID(nil, qualifiedIdentifier)
+	}
+	return v.typeID
+}
+
+func (v *CompositeValue) ConformsToStaticType(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	results TypeConformanceResults,
+) bool {
 
-	var v *CompositeValue
 	if interpreter.tracingEnabled {
 		startTime := time.Now()
 
+		owner := v.GetOwner().String()
+		typeID := string(v.TypeID())
+		kind := v.Kind.String()
+
 		defer func() {
-			// NOTE: in defer, as v is only initialized at the end of the function
-			// if there was no error during construction
-			if v == nil {
-				return
+			interpreter.reportCompositeValueConformsToStaticTypeTrace(
+				owner,
+				typeID,
+				kind,
+				time.Since(startTime),
+			)
+		}()
+	}
+
+	staticType := v.StaticType(interpreter).(CompositeStaticType)
+
+	semaType, err := interpreter.ConvertStaticToSemaType(staticType)
+	if err != nil {
+		return false
+	}
+
+	compositeType, ok := semaType.(*sema.CompositeType)
+	if !ok || v.Kind != compositeType.Kind {
+		return false
+	}
+
+	// The type ID of the value is the type ID of the generic composite type,
+	// if the value is an instance of an instantiated generic composite type
+
+	typeID := compositeType.ID()
+	if baseType, ok := compositeType.BaseType().(*sema.CompositeType); ok {
+		typeID = baseType.ID()
+	}
+
+	if v.TypeID() != typeID {
+		return false
+	}
+
+	fieldsLen := int(v.dictionary.Count())
+	if v.ComputedFields != nil {
+		fieldsLen += len(v.ComputedFields)
+	}
+
+	if fieldsLen != len(compositeType.Fields) {
+		return false
+	}
+
+	for _, fieldName := range compositeType.Fields {
+		value := v.GetField(interpreter, getLocationRange, fieldName)
+		if value == nil {
+			if v.ComputedFields == nil {
+				return false
 			}
 
-			owner := v.GetOwner().String()
-			typeID := string(v.TypeID())
-			kind := v.Kind.String()
+			fieldGetter, ok := v.ComputedFields[fieldName]
+			if !ok {
+				return false
+			}
 
-			interpreter.reportCompositeValueConstructTrace(
-				owner,
-				typeID,
-				kind,
-				time.Since(startTime),
-			)
-		}()
-	}
+			value = fieldGetter(interpreter, getLocationRange)
+		}
 
-	constructor := func() *atree.OrderedMap {
-		dictionary, err := atree.NewMap(
-			interpreter.Storage,
-			atree.Address(address),
-			atree.NewDefaultDigesterBuilder(),
-			NewCompositeTypeInfo(
-				interpreter,
-				location,
-				qualifiedIdentifier,
-				kind,
-			),
-		)
-		if err != nil {
-			panic(errors.NewExternalError(err))
+		member, ok := compositeType.Members.Get(fieldName)
+		if !ok {
+			return false
 		}
-		return dictionary
-	}
 
-	typeInfo := NewCompositeTypeInfo(
-		interpreter,
-		location,
-		qualifiedIdentifier,
-		kind,
-	)
+		fieldStaticType := value.StaticType(interpreter)
 
-	v = newCompositeValueFromConstructor(interpreter, uint64(len(fields)), typeInfo, constructor)
+		if !interpreter.IsSubTypeOfSemaType(fieldStaticType, member.TypeAnnotation.Type) {
+			return false
+		}
 
-	for _, field := range fields {
-		v.SetMember(
+		if !value.ConformsToStaticType(
 			interpreter,
 			getLocationRange,
-			field.Name,
-			field.Value,
-		)
+			results,
+		) {
+			return false
+		}
 	}
 
-	return v
+	return true
 }
 
-func newCompositeValueFromOrderedMap(
-	dict *atree.OrderedMap,
-	typeInfo compositeTypeInfo,
-) *CompositeValue {
-	return &CompositeValue{
-		dictionary:          dict,
-		Location:            typeInfo.location,
-		QualifiedIdentifier: typeInfo.qualifiedIdentifier,
-		Kind:                typeInfo.kind,
-	}
-}
+func (v *CompositeValue) IsStorable() bool {
 
-func newCompositeValueFromConstructor(
-	gauge common.MemoryGauge,
-	count uint64,
-	typeInfo compositeTypeInfo,
-	constructor func() *atree.OrderedMap,
-) *CompositeValue {
-	baseUse, elementOverhead, dataUse, metaDataUse := common.NewCompositeMemoryUsages(count, 0)
-	common.UseMemory(gauge, baseUse)
-	common.UseMemory(gauge, elementOverhead)
-	common.UseMemory(gauge, dataUse)
-	common.UseMemory(gauge, metaDataUse)
-	return newCompositeValueFromOrderedMap(constructor(), typeInfo)
-}
+	// Only structures, resources, enums, newtypes, and contracts can be stored.
+	// Contracts are not directly storable by programs,
+	// but they are still stored in storage by the interpreter
 
-var _ Value = &CompositeValue{}
-var _ EquatableValue = &CompositeValue{}
-var _ HashableValue = &CompositeValue{}
-var _ MemberAccessibleValue = &CompositeValue{}
-var _ ReferenceTrackedResourceKindedValue = &CompositeValue{}
+	switch v.Kind {
+	case common.CompositeKindStructure,
+		common.CompositeKindResource,
+		common.CompositeKindEnum,
+		common.CompositeKindNewtype,
+		common.CompositeKindContract:
+		break
+	default:
+		return false
+	}
 
-func (*CompositeValue) IsValue() {}
+	// Composite value's of native/built-in types are not storable for now
+	return v.Location != nil
+}
 
-func (v *CompositeValue) Accept(interpreter *Interpreter, visitor Visitor) {
-	descend := visitor.VisitCompositeValue(interpreter, v)
-	if !descend {
-		return
+func (v *CompositeValue) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+	if !v.IsStorable() {
+		return NonStorable{Value: v}, nil
 	}
 
-	v.ForEachField(interpreter, func(_ string, value Value) {
-		value.Accept(interpreter, visitor)
-	})
+	return atree.StorageIDStorable(v.StorageID()), nil
 }
 
-// Walk iterates over all field values of the composite value.
-// It does NOT walk the computed fields and functions!
-//
-func (v *CompositeValue) Walk(interpreter *Interpreter, walkChild func(Value)) {
-	v.ForEachField(interpreter, func(_ string, value Value) {
-		walkChild(value)
-	})
+func (v *CompositeValue) NeedsStoreTo(address atree.Address) bool {
+	return address != v.StorageID().Address
 }
 
-func (v *CompositeValue) StaticType(interpreter *Interpreter) StaticType {
-	if v.staticType == nil {
-		// NOTE: Instead of using NewCompositeStaticType, which always generates the type ID,
-		// use the TypeID accessor, which may return an already computed type ID
-		v.staticType = NewCompositeStaticType(
-			interpreter,
-			v.Location,
-			v.QualifiedIdentifier,
-			v.TypeID(), // TODO TypeID metering
-		)
-	}
-	return v.staticType
+func (v *CompositeValue) IsResourceKinded(_ *Interpreter) bool {
+	return v.Kind == common.CompositeKindResource
 }
 
-func (v *CompositeValue) IsImportable(inter *Interpreter) bool {
-	staticType := v.StaticType(inter)
-	semaType, err := inter.ConvertStaticToSemaType(staticType)
-	if err != nil {
-		panic(err)
-	}
-	return semaType.IsImportable(map[*sema.Member]bool{})
-}
+func (v *CompositeValue) IsReferenceTrackedResourceKindedValue() {}
 
-func (v *CompositeValue) IsDestroyed() bool {
-	return v.isDestroyed
-}
+func (v *CompositeValue) Transfer(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	address atree.Address,
+	remove bool,
+	storable atree.Storable,
+) Value {
 
-func (v *CompositeValue) Destroy(interpreter *Interpreter, getLocationRange func() LocationRange) {
+	baseUse, elementOverhead, dataUse, metaDataUse := common.NewCompositeMemoryUsages(v.dictionary.Count(), 0)
+	common.UseMemory(interpreter, baseUse)
+	common.UseMemory(interpreter, elementOverhead)
+	common.UseMemory(interpreter, dataUse)
+	common.UseMemory(interpreter, metaDataUse)
 
-	interpreter.ReportComputation(common.ComputationKindDestroyCompositeValue, 1)
+	interpreter.ReportComputation(common.ComputationKindTransferCompositeValue, 1)
 
 	if interpreter.invalidatedResourceValidationEnabled {
 		v.checkInvalidatedResourceUse(getLocationRange)
 	}
 
-	storageID := v.StorageID()
-
 	if interpreter.tracingEnabled {
 		startTime := time.Now()
 
@@ -14341,67 +19069,224 @@ func (v *CompositeValue) Destroy(interpreter *Interpreter, getLocationRange func
 		kind := v.Kind.String()
 
 		defer func() {
+			interpreter.reportCompositeValueTransferTrace(
+				owner,
+				typeID,
+				kind,
+				time.Since(startTime),
+			)
+		}()
+	}
+
+	currentStorageID := v.StorageID()
+	currentAddress := currentStorageID.Address
+
+	dictionary := v.dictionary
+
+	needsStoreTo := address != currentAddress
+	isResourceKinded := v.IsResourceKinded(interpreter)
+
+	if remove || isResourceKinded {
+		interpreter.recordMutation(v)
+	}
+
+	if needsStoreTo && v.Kind == common.CompositeKindContract {
+		panic(NonTransferableValueError{
+			Value: v,
+		})
+	}
+
+	if needsStoreTo || !isResourceKinded {
+		iterator, err := v.dictionary.Iterator()
+		if err != nil {
+			panic(errors.NewExternalError(err))
+		}
+
+		elementMemoryUse := common.NewAtreeMapPreAllocatedElementsMemoryUsage(v.dictionary.Count(), 0)
+		common.UseMemory(interpreter.memoryGauge, elementMemoryUse)
+
+		dictionary, err = atree.NewMapFromBatchData(
+			interpreter.Storage,
+			address,
+			atree.NewDefaultDigesterBuilder(),
+			v.dictionary.Type(),
+			StringAtreeComparator,
+			StringAtreeHashInput,
+			v.dictionary.Seed(),
+			func() (atree.Value, atree.Value, error) {
+
+				atreeKey, atreeValue, err := iterator.Next()
+				if err != nil {
+					return nil, nil, err
+				}
+				if atreeKey == nil || atreeValue == nil {
+					return nil, nil, nil
+				}
+
+				// NOTE: key is stringAtreeValue
+				// and does not need to be converted or copied
+
+				value := MustConvertStoredValue(interpreter, atreeValue).
+					Transfer(interpreter, getLocationRange, address, remove, nil)
+
+				return atreeKey, value, nil
+			},
+		)
+		if err != nil {
+			panic(errors.NewExternalError(err))
+		}
+
+		if remove {
+			err = v.dictionary.PopIterate(func(nameStorable atree.Storable, valueStorable atree.Storable) {
+				interpreter.RemoveReferencedSlab(nameStorable)
+				interpreter.RemoveReferencedSlab(valueStorable)
+			})
+			if err != nil {
+				panic(errors.NewExternalError(err))
+			}
+			interpreter.maybeValidateAtreeValue(v.dictionary)
+
+			interpreter.RemoveReferencedSlab(storable)
+		}
+	}
+
+	var res *CompositeValue
+
+	if isResourceKinded {
+		// Update the resource in-place,
+		// and also update all values that are referencing the same value
+		// (but currently point to an outdated Go instance of the value)
+
+		// If checking of transfers of invalidated resource is enabled,
+		// then mark the resource as invalidated, by unsetting the backing dictionary.
+		// This allows raising an error when the resource is attempted
+		// to be transferred/moved again (see beginning of this function)
+
+		if interpreter.invalidatedResourceValidationEnabled {
+			v.dictionary = nil
+		} else {
+			v.dictionary = dictionary
+			res = v
+		}
+
+		newStorageID := dictionary.StorageID()
 
-			interpreter.reportCompositeValueDestroyTrace(
-				owner,
-				typeID,
-				kind,
-				time.Since(startTime),
-			)
-		}()
+		interpreter.updateReferencedResource(
+			currentStorageID,
+			newStorageID,
+			func(value ReferenceTrackedResourceKindedValue) {
+				compositeValue, ok := value.(*CompositeValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+				compositeValue.dictionary = dictionary
+			},
+		)
 	}
 
-	interpreter = v.getInterpreter(interpreter)
-
-	// if composite was deserialized, dynamically link in the destructor
-	if v.Destructor == nil {
-		v.Destructor = interpreter.typeCodes.CompositeCodes[v.TypeID()].DestructorFunction
+	if res == nil {
+		info := NewCompositeTypeInfo(
+			interpreter,
+			v.Location,
+			v.QualifiedIdentifier,
+			v.Kind,
+		)
+		info.typeArguments = v.TypeArguments
+		res = newCompositeValueFromOrderedMap(dictionary, info)
+		res.InjectedFields = v.InjectedFields
+		res.ComputedFields = v.ComputedFields
+		res.NestedVariables = v.NestedVariables
+		res.Functions = v.Functions
+		res.Destructor = v.Destructor
+		res.Stringer = v.Stringer
+		res.isDestroyed = v.isDestroyed
+		res.typeID = v.typeID
+		res.staticType = v.staticType
 	}
 
-	destructor := v.Destructor
+	if needsStoreTo &&
+		res.Kind == common.CompositeKindResource &&
+		interpreter.onResourceOwnerChange != nil {
 
-	if destructor != nil {
-		invocation := NewInvocation(
+		interpreter.onResourceOwnerChange(
 			interpreter,
-			v,
-			nil,
-			nil,
-			nil,
-			getLocationRange,
+			res,
+			common.Address(currentAddress),
+			common.Address(address),
 		)
+	}
 
-		destructor.invoke(invocation)
+	return res
+}
+
+func (v *CompositeValue) ResourceUUID(interpreter *Interpreter, getLocationRange func() LocationRange) *UInt64Value {
+	fieldValue := v.GetField(interpreter, getLocationRange, sema.ResourceUUIDFieldName)
+	uuid, ok := fieldValue.(UInt64Value)
+	if !ok {
+		return nil
 	}
+	return &uuid
+}
 
-	v.isDestroyed = true
+func (v *CompositeValue) Clone(interpreter *Interpreter) Value {
 
-	if interpreter.invalidatedResourceValidationEnabled {
-		v.dictionary = nil
+	iterator, err := v.dictionary.Iterator()
+	if err != nil {
+		panic(errors.NewExternalError(err))
 	}
 
-	interpreter.updateReferencedResource(
-		storageID,
-		storageID,
-		func(value ReferenceTrackedResourceKindedValue) {
-			compositeValue, ok := value.(*CompositeValue)
-			if !ok {
-				panic(errors.NewUnreachableError())
-			}
+	elementMemoryUse := common.NewAtreeMapPreAllocatedElementsMemoryUsage(v.dictionary.Count(), 0)
+	common.UseMemory(interpreter.memoryGauge, elementMemoryUse)
 
-			compositeValue.isDestroyed = true
+	dictionary, err := atree.NewMapFromBatchData(
+		interpreter.Storage,
+		v.StorageID().Address,
+		atree.NewDefaultDigesterBuilder(),
+		v.dictionary.Type(),
+		StringAtreeComparator,
+		StringAtreeHashInput,
+		v.dictionary.Seed(),
+		func() (atree.Value, atree.Value, error) {
 
-			if interpreter.invalidatedResourceValidationEnabled {
-				compositeValue.dictionary = nil
+			atreeKey, atreeValue, err := iterator.Next()
+			if err != nil {
+				return nil, nil, err
+			}
+			if atreeKey == nil || atreeValue == nil {
+				return nil, nil, nil
 			}
+
+			key := MustConvertStoredValue(interpreter, atreeKey).Clone(interpreter)
+			value := MustConvertStoredValue(interpreter, atreeValue).Clone(interpreter)
+
+			return key, value, nil
 		},
 	)
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	return &CompositeValue{
+		dictionary:          dictionary,
+		Location:            v.Location,
+		QualifiedIdentifier: v.QualifiedIdentifier,
+		Kind:                v.Kind,
+		TypeArguments:       v.TypeArguments,
+		InjectedFields:      v.InjectedFields,
+		ComputedFields:      v.ComputedFields,
+		NestedVariables:     v.NestedVariables,
+		Functions:           v.Functions,
+		Destructor:          v.Destructor,
+		Stringer:            v.Stringer,
+		isDestroyed:         v.isDestroyed,
+		typeID:              v.typeID,
+		staticType:          v.staticType,
+	}
 }
 
-func (v *CompositeValue) GetMember(interpreter *Interpreter, getLocationRange func() LocationRange, name string) Value {
+func (v *CompositeValue) DeepRemove(interpreter *Interpreter) {
 
-	if interpreter.invalidatedResourceValidationEnabled {
-		v.checkInvalidatedResourceUse(getLocationRange)
-	}
+	interpreter.recordMutation(v)
 
 	if interpreter.tracingEnabled {
 		startTime := time.Now()
@@ -14411,155 +19296,67 @@ func (v *CompositeValue) GetMember(interpreter *Interpreter, getLocationRange fu
 		kind := v.Kind.String()
 
 		defer func() {
-			interpreter.reportCompositeValueGetMemberTrace(
+			interpreter.reportCompositeValueDeepRemoveTrace(
 				owner,
 				typeID,
 				kind,
-				name,
 				time.Since(startTime),
 			)
 		}()
 	}
 
-	if v.Kind == common.CompositeKindResource &&
-		name == sema.ResourceOwnerFieldName {
-
-		return v.OwnerValue(interpreter, getLocationRange)
-	}
-
-	storable, err := v.dictionary.Get(
-		StringAtreeComparator,
-		StringAtreeHashInput,
-		StringAtreeValue(name),
-	)
-	if err != nil {
-		if _, ok := err.(*atree.KeyNotFoundError); !ok {
-			panic(errors.NewExternalError(err))
-		}
-	}
-	if storable != nil {
-		return StoredValue(interpreter, storable, interpreter.Storage)
-	}
-
-	if v.NestedVariables != nil {
-		variable, ok := v.NestedVariables[name]
-		if ok {
-			return variable.GetValue()
-		}
-	}
-
-	interpreter = v.getInterpreter(interpreter)
-
-	if v.ComputedFields != nil {
-		if computedField, ok := v.ComputedFields[name]; ok {
-			return computedField(interpreter, getLocationRange)
-		}
-	}
-
-	// If the composite value was deserialized, dynamically link in the functions
-	// and get injected fields
-
-	v.InitializeFunctions(interpreter)
-
-	if v.InjectedFields == nil && interpreter.injectedCompositeFieldsHandler != nil {
-		v.InjectedFields = interpreter.injectedCompositeFieldsHandler(
-			interpreter,
-			v.Location,
-			v.QualifiedIdentifier,
-			v.Kind,
-		)
-	}
-
-	if v.InjectedFields != nil {
-		value, ok := v.InjectedFields[name]
-		if ok {
-			return value
-		}
-	}
-
-	function, ok := v.Functions[name]
-	if ok {
-		return NewBoundFunctionValue(interpreter, function, v)
-	}
-
-	return nil
-}
-
-func (v *CompositeValue) checkInvalidatedResourceUse(getLocationRange func() LocationRange) {
-	if v.isDestroyed || (v.dictionary == nil && v.Kind == common.CompositeKindResource) {
-		panic(InvalidatedResourceError{
-			LocationRange: getLocationRange(),
-		})
-	}
-}
-
-func (v *CompositeValue) getInterpreter(interpreter *Interpreter) *Interpreter {
+	// Remove nested values and storables
 
-	// Get the correct interpreter. The program code might need to be loaded.
-	// NOTE: standard library values have no location
+	storage := v.dictionary.Storage
 
-	location := v.Location
+	err := v.dictionary.PopIterate(func(nameStorable atree.Storable, valueStorable atree.Storable) {
+		// NOTE: key / field name is stringAtreeValue,
+		// and not a Value, so no need to deep remove
+		interpreter.RemoveReferencedSlab(nameStorable)
 
-	if location == nil || interpreter.Location == location {
-		return interpreter
+		value := StoredValue(interpreter, valueStorable, storage)
+		value.DeepRemove(interpreter)
+		interpreter.RemoveReferencedSlab(valueStorable)
+	})
+	if err != nil {
+		panic(errors.NewExternalError(err))
 	}
-
-	return interpreter.EnsureLoaded(v.Location)
+	interpreter.maybeValidateAtreeValue(v.dictionary)
 }
 
-func (v *CompositeValue) InitializeFunctions(interpreter *Interpreter) {
-	if v.Functions != nil {
-		return
-	}
-
-	v.Functions = interpreter.typeCodes.CompositeCodes[v.TypeID()].CompositeFunctions
+func (v *CompositeValue) GetOwner() common.Address {
+	return common.Address(v.StorageID().Address)
 }
 
-func (v *CompositeValue) OwnerValue(interpreter *Interpreter, getLocationRange func() LocationRange) OptionalValue {
-	address := v.StorageID().Address
-
-	if address == (atree.Address{}) {
-		return NewNilValue(interpreter)
-	}
-
-	ownerAccount := interpreter.publicAccountHandler(interpreter, AddressValue(address))
+// ForEachField iterates over all field-name field-value pairs of the composite value.
+// It does NOT iterate over computed fields and functions!
+//
+func (v *CompositeValue) ForEachField(gauge common.MemoryGauge, f func(fieldName string, fieldValue Value)) {
 
-	// Owner must be of `PublicAccount` type.
-	interpreter.ExpectType(ownerAccount, sema.PublicAccountType, getLocationRange)
+	err := v.dictionary.Iterate(func(key atree.Value, value atree.Value) (resume bool, err error) {
+		f(
+			string(key.(StringAtreeValue)),
+			MustConvertStoredValue(gauge, value),
+		)
+		return true, nil
+	})
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+}
 
-	return NewSomeValueNonCopying(interpreter, ownerAccount)
+func (v *CompositeValue) StorageID() atree.StorageID {
+	return v.dictionary.StorageID()
 }
 
-func (v *CompositeValue) RemoveMember(
+func (v *CompositeValue) RemoveField(
 	interpreter *Interpreter,
-	getLocationRange func() LocationRange,
+	_ func() LocationRange,
 	name string,
-) Value {
-
-	if interpreter.invalidatedResourceValidationEnabled {
-		v.checkInvalidatedResourceUse(getLocationRange)
-	}
-
-	if interpreter.tracingEnabled {
-		startTime := time.Now()
-
-		owner := v.GetOwner().String()
-		typeID := string(v.TypeID())
-		kind := v.Kind.String()
+) {
 
-		defer func() {
-			interpreter.reportCompositeValueRemoveMemberTrace(
-				owner,
-				typeID,
-				kind,
-				name,
-				time.Since(startTime),
-			)
-		}()
-	}
+	interpreter.recordMutation(v)
 
-	// No need to clean up storable for passed-in key value,
-	// as atree never calls Storable()
 	existingKeyStorable, existingValueStorable, err := v.dictionary.Remove(
 		StringAtreeComparator,
 		StringAtreeHashInput,
@@ -14567,7 +19364,7 @@ func (v *CompositeValue) RemoveMember(
 	)
 	if err != nil {
 		if _, ok := err.(*atree.KeyNotFoundError); ok {
-			return nil
+			return
 		}
 		panic(errors.NewExternalError(err))
 	}
@@ -14576,697 +19373,801 @@ func (v *CompositeValue) RemoveMember(
 	storage := interpreter.Storage
 
 	// Key
+
+	// NOTE: key / field name is stringAtreeValue,
+	// and not a Value, so no need to deep remove
 	interpreter.RemoveReferencedSlab(existingKeyStorable)
 
 	// Value
 
-	storedValue := StoredValue(interpreter, existingValueStorable, storage)
-	return storedValue.
-		Transfer(
-			interpreter,
-			getLocationRange,
-			atree.Address{},
-			true,
-			existingValueStorable,
-		)
+	existingValue := StoredValue(interpreter, existingValueStorable, storage)
+	existingValue.DeepRemove(interpreter)
+	interpreter.RemoveReferencedSlab(existingValueStorable)
 }
 
-func (v *CompositeValue) SetMember(
+func NewEnumCaseValue(
 	interpreter *Interpreter,
 	getLocationRange func() LocationRange,
-	name string,
-	value Value,
-) {
-	if interpreter.invalidatedResourceValidationEnabled {
-		v.checkInvalidatedResourceUse(getLocationRange)
+	enumType *sema.CompositeType,
+	rawValue NumberValue,
+	functions map[string]FunctionValue,
+) *CompositeValue {
+
+	fields := []CompositeField{
+		{
+			Name:  sema.EnumRawValueFieldName,
+			Value: rawValue,
+		},
 	}
 
+	v := NewCompositeValue(
+		interpreter,
+		getLocationRange,
+		enumType.Location,
+		enumType.QualifiedIdentifier(),
+		enumType.Kind,
+		fields,
+		common.Address{},
+	)
+
+	v.Functions = functions
+
+	return v
+}
+
+// DictionaryValue
+
+type DictionaryValue struct {
+	Type             DictionaryStaticType
+	semaType         *sema.DictionaryType
+	isResourceKinded *bool
+	dictionary       *atree.OrderedMap
+	isDestroyed      bool
+	elementSize      uint
+}
+
+func NewDictionaryValue(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	dictionaryType DictionaryStaticType,
+	keysAndValues ...Value,
+) *DictionaryValue {
+	return NewDictionaryValueWithAddress(
+		interpreter,
+		getLocationRange,
+		dictionaryType,
+		common.Address{},
+		keysAndValues...,
+	)
+}
+
+func NewDictionaryValueWithAddress(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	dictionaryType DictionaryStaticType,
+	address common.Address,
+	keysAndValues ...Value,
+) *DictionaryValue {
+
+	interpreter.ReportComputation(common.ComputationKindCreateDictionaryValue, 1)
+
+	var v *DictionaryValue
+
 	if interpreter.tracingEnabled {
 		startTime := time.Now()
 
-		owner := v.GetOwner().String()
-		typeID := string(v.TypeID())
-		kind := v.Kind.String()
-
 		defer func() {
-			interpreter.reportCompositeValueSetMemberTrace(
-				owner,
-				typeID,
-				kind,
-				name,
+			// NOTE: in defer, as v is only initialized at the end of the function
+			// if there was no error during construction
+			if v == nil {
+				return
+			}
+
+			typeInfo := v.Type.String()
+			count := v.Count()
+
+			interpreter.reportDictionaryValueConstructTrace(
+				typeInfo,
+				count,
 				time.Since(startTime),
 			)
 		}()
 	}
 
-	address := v.StorageID().Address
-
-	value = value.Transfer(
-		interpreter,
-		getLocationRange,
-		address,
-		true,
-		nil,
-	)
+	keysAndValuesCount := len(keysAndValues)
+	if keysAndValuesCount%2 != 0 {
+		panic("uneven number of keys and values")
+	}
 
-	existingStorable, err := v.dictionary.Set(
-		StringAtreeComparator,
-		StringAtreeHashInput,
-		NewStringAtreeValue(interpreter, name),
-		value,
-	)
-	if err != nil {
-		panic(errors.NewExternalError(err))
+	constructor := func() *atree.OrderedMap {
+		dictionary, err := atree.NewMap(
+			interpreter.Storage,
+			atree.Address(address),
+			atree.NewDefaultDigesterBuilder(),
+			dictionaryType,
+		)
+		if err != nil {
+			panic(errors.NewExternalError(err))
+		}
+		return dictionary
 	}
-	interpreter.maybeValidateAtreeValue(v.dictionary)
 
-	if existingStorable != nil {
-		existingValue := StoredValue(interpreter, existingStorable, interpreter.Storage)
+	// values are added to the dictionary after creation, not here
+	v = newDictionaryValueFromConstructor(interpreter, dictionaryType, 0, constructor)
 
-		existingValue.DeepRemove(interpreter)
+	// NOTE: lazily initialized when needed for performance reasons
+	var lazyIsResourceTyped *bool
 
-		interpreter.RemoveReferencedSlab(existingStorable)
+	for i := 0; i < keysAndValuesCount; i += 2 {
+		key := keysAndValues[i]
+		value := keysAndValues[i+1]
+		existingValue := v.Insert(interpreter, getLocationRange, key, value)
+		// If the dictionary already contained a value for the key,
+		// and the dictionary is resource-typed,
+		// then we need to prevent a resource loss
+		if _, ok := existingValue.(*SomeValue); ok {
+			// Lazily determine if the dictionary is resource-typed, once
+			if lazyIsResourceTyped == nil {
+				isResourceTyped := v.SemaType(interpreter).IsResourceType()
+				lazyIsResourceTyped = &isResourceTyped
+			}
+			if *lazyIsResourceTyped {
+				panic(DuplicateKeyInResourceDictionaryError{
+					LocationRange: getLocationRange(),
+				})
+			}
+		}
 	}
+
+	return v
 }
 
-func (v *CompositeValue) String() string {
-	return v.RecursiveString(SeenReferences{})
+func newDictionaryValueFromOrderedMap(
+	dict *atree.OrderedMap,
+	staticType DictionaryStaticType,
+) *DictionaryValue {
+	return &DictionaryValue{
+		Type:       staticType,
+		dictionary: dict,
+	}
 }
 
-func (v *CompositeValue) RecursiveString(seenReferences SeenReferences) string {
-	return v.MeteredString(nil, seenReferences)
+func newDictionaryValueFromConstructor(
+	gauge common.MemoryGauge,
+	staticType DictionaryStaticType,
+	count uint64,
+	constructor func() *atree.OrderedMap,
+) (dict *DictionaryValue) {
+
+	keySize := staticType.KeyType.elementSize()
+	valueSize := staticType.ValueType.elementSize()
+	var elementSize uint
+	if keySize != 0 && valueSize != 0 {
+		elementSize = keySize + valueSize
+	}
+	baseUsage, overheadUsage, dataSlabs, metaDataSlabs := common.NewDictionaryMemoryUsages(count, elementSize)
+	common.UseMemory(gauge, baseUsage)
+	common.UseMemory(gauge, overheadUsage)
+	common.UseMemory(gauge, dataSlabs)
+	common.UseMemory(gauge, metaDataSlabs)
+
+	dict = newDictionaryValueFromOrderedMap(constructor(), staticType)
+	dict.elementSize = elementSize
+	return
 }
 
-var emptyCompositeStringLen = len(format.Composite("", nil))
+var _ Value = &DictionaryValue{}
+var _ atree.Value = &DictionaryValue{}
+var _ EquatableValue = &DictionaryValue{}
+var _ ValueIndexableValue = &DictionaryValue{}
+var _ MemberAccessibleValue = &DictionaryValue{}
+var _ ReferenceTrackedResourceKindedValue = &DictionaryValue{}
 
-func (v *CompositeValue) MeteredString(memoryGauge common.MemoryGauge, seenReferences SeenReferences) string {
+func (*DictionaryValue) IsValue() {}
 
-	if v.Stringer != nil {
-		return v.Stringer(memoryGauge, v, seenReferences)
+func (v *DictionaryValue) Accept(interpreter *Interpreter, visitor Visitor) {
+	descend := visitor.VisitDictionaryValue(interpreter, v)
+	if !descend {
+		return
 	}
 
-	strLen := emptyCompositeStringLen
+	v.Walk(interpreter, func(value Value) {
+		value.Accept(interpreter, visitor)
+	})
+}
+
+func (v *DictionaryValue) Iterate(gauge common.MemoryGauge, f func(key, value Value) (resume bool)) {
+	err := v.dictionary.Iterate(func(key, value atree.Value) (resume bool, err error) {
+		// atree.OrderedMap iteration provides low-level atree.Value,
+		// convert to high-level interpreter.Value
 
-	var fields []CompositeField
-	_ = v.dictionary.Iterate(func(key atree.Value, value atree.Value) (resume bool, err error) {
-		field := NewCompositeField(
-			memoryGauge,
-			string(key.(StringAtreeValue)),
-			MustConvertStoredValue(memoryGauge, value),
+		resume = f(
+			MustConvertStoredValue(gauge, key),
+			MustConvertStoredValue(gauge, value),
 		)
 
-		fields = append(fields, field)
-
-		strLen += len(field.Name)
+		return resume, nil
+	})
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+}
 
-		return true, nil
+func (v *DictionaryValue) Walk(interpreter *Interpreter, walkChild func(Value)) {
+	v.Iterate(interpreter, func(key, value Value) (resume bool) {
+		walkChild(key)
+		walkChild(value)
+		return true
 	})
+}
 
-	typeId := string(v.TypeID())
+func (v *DictionaryValue) StaticType(_ *Interpreter) StaticType {
+	// TODO meter
+	return v.Type
+}
 
-	// bodyLen = len(fieldNames) + len(typeId) + (n times colon+space) + ((n-1) times comma+space)
-	//         = len(fieldNames) + len(typeId) + 2n + 2n - 2
-	//         = len(fieldNames) + len(typeId) + 4n - 2
-	//
-	// Since (-2) only occurs if its non-empty, ignore the (-2). i.e: overestimate
-	// 		bodyLen = len(fieldNames) + len(typeId) + 4n
-	//
-	strLen = strLen + len(typeId) + len(fields)*4
+func (v *DictionaryValue) IsImportable(inter *Interpreter) bool {
+	importable := true
+	v.Iterate(inter, func(key, value Value) (resume bool) {
+		if !key.IsImportable(inter) || !value.IsImportable(inter) {
+			importable = false
+			// stop iteration
+			return false
+		}
 
-	common.UseMemory(memoryGauge, common.NewRawStringMemoryUsage(strLen))
+		// continue iteration
+		return true
+	})
 
-	return formatComposite(memoryGauge, typeId, fields, seenReferences)
+	return importable
 }
 
-func formatComposite(memoryGauge common.MemoryGauge, typeId string, fields []CompositeField, seenReferences SeenReferences) string {
-	preparedFields := make(
-		[]struct {
-			Name  string
-			Value string
-		},
-		0,
-		len(fields),
-	)
+func (v *DictionaryValue) IsDestroyed() bool {
+	return v.isDestroyed
+}
 
-	for _, field := range fields {
-		preparedFields = append(
-			preparedFields,
-			struct {
-				Name  string
-				Value string
-			}{
-				Name:  field.Name,
-				Value: field.Value.MeteredString(memoryGauge, seenReferences),
-			},
-		)
+func (v *DictionaryValue) checkInvalidatedResourceUse(interpreter *Interpreter, getLocationRange func() LocationRange) {
+	if v.isDestroyed || (v.dictionary == nil && v.IsResourceKinded(interpreter)) {
+		panic(InvalidatedResourceError{
+			LocationRange: getLocationRange(),
+		})
 	}
-
-	return format.Composite(typeId, preparedFields)
 }
 
-func (v *CompositeValue) GetField(interpreter *Interpreter, getLocationRange func() LocationRange, name string) Value {
+func (v *DictionaryValue) Destroy(interpreter *Interpreter, getLocationRange func() LocationRange) {
 
-	if interpreter.invalidatedResourceValidationEnabled {
-		v.checkInvalidatedResourceUse(getLocationRange)
-	}
+	interpreter.ReportComputation(common.ComputationKindDestroyDictionaryValue, 1)
 
-	storable, err := v.dictionary.Get(
-		StringAtreeComparator,
-		StringAtreeHashInput,
-		StringAtreeValue(name),
-	)
-	if err != nil {
-		if _, ok := err.(*atree.KeyNotFoundError); ok {
-			return nil
-		}
-		panic(errors.NewExternalError(err))
+	if interpreter.invalidatedResourceValidationEnabled {
+		v.checkInvalidatedResourceUse(interpreter, getLocationRange)
 	}
 
-	return StoredValue(interpreter, storable, v.dictionary.Storage)
-}
+	storageID := v.StorageID()
 
-func (v *CompositeValue) Equal(interpreter *Interpreter, getLocationRange func() LocationRange, other Value) bool {
-	otherComposite, ok := other.(*CompositeValue)
-	if !ok {
-		return false
-	}
+	interpreter.checkMutationDuringIteration(storageID, getLocationRange)
+	interpreter.recordMutation(v)
 
-	if !v.StaticType(interpreter).Equal(otherComposite.StaticType(interpreter)) ||
-		v.Kind != otherComposite.Kind ||
-		v.dictionary.Count() != otherComposite.dictionary.Count() {
+	if interpreter.tracingEnabled {
+		startTime := time.Now()
 
-		return false
-	}
+		typeInfo := v.Type.String()
+		count := v.Count()
 
-	iterator, err := v.dictionary.Iterator()
-	if err != nil {
-		panic(errors.NewExternalError(err))
+		defer func() {
+			interpreter.reportDictionaryValueDestroyTrace(
+				typeInfo,
+				count,
+				time.Since(startTime),
+			)
+		}()
 	}
 
-	for {
-		key, value, err := iterator.Next()
-		if err != nil {
-			panic(errors.NewExternalError(err))
-		}
-		if key == nil {
-			return true
-		}
-
-		fieldName := string(key.(StringAtreeValue))
+	v.Iterate(interpreter, func(key, value Value) (resume bool) {
+		// Resources cannot be keys at the moment, so should theoretically not be needed
+		maybeDestroy(interpreter, getLocationRange, key)
+		maybeDestroy(interpreter, getLocationRange, value)
+		return true
+	})
 
-		// NOTE: Do NOT use an iterator, iteration order of fields may be different
-		// (if stored in different account, as storage ID is used as hash seed)
-		otherValue := otherComposite.GetField(interpreter, getLocationRange, fieldName)
+	v.isDestroyed = true
 
-		equatableValue, ok := MustConvertStoredValue(interpreter, value).(EquatableValue)
-		if !ok || !equatableValue.Equal(interpreter, getLocationRange, otherValue) {
-			return false
-		}
+	if interpreter.invalidatedResourceValidationEnabled {
+		v.dictionary = nil
 	}
-}
 
-// HashInput returns a byte slice containing:
-// - HashInputTypeEnum (1 byte)
-// - type id (n bytes)
-// - hash input of raw value field name (n bytes)
-func (v *CompositeValue) HashInput(interpreter *Interpreter, getLocationRange func() LocationRange, scratch []byte) []byte {
-	if v.Kind == common.CompositeKindEnum {
-		typeID := v.TypeID()
+	interpreter.updateReferencedResource(
+		storageID,
+		storageID,
+		func(value ReferenceTrackedResourceKindedValue) {
+			dictionaryValue, ok := value.(*DictionaryValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
 
-		rawValue := v.GetField(interpreter, getLocationRange, sema.EnumRawValueFieldName)
-		rawValueHashInput := rawValue.(HashableValue).
-			HashInput(interpreter, getLocationRange, scratch)
+			dictionaryValue.isDestroyed = true
 
-		length := 1 + len(typeID) + len(rawValueHashInput)
-		if length <= len(scratch) {
-			// Copy rawValueHashInput first because
-			// rawValueHashInput and scratch can point to the same underlying scratch buffer
-			copy(scratch[1+len(typeID):], rawValueHashInput)
+			if interpreter.invalidatedResourceValidationEnabled {
+				dictionaryValue.dictionary = nil
+			}
+		},
+	)
+}
 
-			scratch[0] = byte(HashInputTypeEnum)
-			copy(scratch[1:], typeID)
-			return scratch[:length]
-		}
+func (v *DictionaryValue) ContainsKey(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	keyValue Value,
+) BoolValue {
 
-		buffer := make([]byte, length)
-		buffer[0] = byte(HashInputTypeEnum)
-		copy(buffer[1:], typeID)
-		copy(buffer[1+len(typeID):], rawValueHashInput)
-		return buffer
-	}
+	valueComparator := newValueComparator(interpreter, getLocationRange)
+	hashInputProvider := newHashInputProvider(interpreter, getLocationRange)
 
-	panic(errors.NewUnreachableError())
-}
+	_, err := v.dictionary.Get(
+		valueComparator,
+		hashInputProvider,
+		keyValue,
+	)
 
-func (v *CompositeValue) TypeID() common.TypeID {
-	if v.typeID == "" {
-		location := v.Location
-		qualifiedIdentifier := v.QualifiedIdentifier
-		if location == nil {
-			return common.TypeID(qualifiedIdentifier)
+	valueGetter := func() bool {
+		if err != nil {
+			if _, ok := err.(*atree.KeyNotFoundError); ok {
+				return false
+			}
+			panic(errors.NewExternalError(err))
 		}
-		v.typeID = location.TypeID(nil, qualifiedIdentifier)
+		return true
 	}
-	return v.typeID
+
+	return NewBoolValueFromConstructor(interpreter, valueGetter)
 }
 
-func (v *CompositeValue) ConformsToStaticType(
+func (v *DictionaryValue) Get(
 	interpreter *Interpreter,
 	getLocationRange func() LocationRange,
-	results TypeConformanceResults,
-) bool {
-
-	if interpreter.tracingEnabled {
-		startTime := time.Now()
-
-		owner := v.GetOwner().String()
-		typeID := string(v.TypeID())
-		kind := v.Kind.String()
-
-		defer func() {
-			interpreter.reportCompositeValueConformsToStaticTypeTrace(
-				owner,
-				typeID,
-				kind,
-				time.Since(startTime),
-			)
-		}()
-	}
+	keyValue Value,
+) (Value, bool) {
 
-	staticType := v.StaticType(interpreter).(CompositeStaticType)
+	valueComparator := newValueComparator(interpreter, getLocationRange)
+	hashInputProvider := newHashInputProvider(interpreter, getLocationRange)
 
-	semaType, err := interpreter.ConvertStaticToSemaType(staticType)
+	storable, err := v.dictionary.Get(
+		valueComparator,
+		hashInputProvider,
+		keyValue,
+	)
 	if err != nil {
-		return false
+		if _, ok := err.(*atree.KeyNotFoundError); ok {
+			return nil, false
+		}
+		panic(errors.NewExternalError(err))
 	}
 
-	compositeType, ok := semaType.(*sema.CompositeType)
-	if !ok ||
-		v.Kind != compositeType.Kind ||
-		v.TypeID() != compositeType.ID() {
+	storage := v.dictionary.Storage
+	value := StoredValue(interpreter, storable, storage)
+	return value, true
+}
 
-		return false
-	}
+func (v *DictionaryValue) GetKey(interpreter *Interpreter, getLocationRange func() LocationRange, keyValue Value) Value {
 
-	fieldsLen := int(v.dictionary.Count())
-	if v.ComputedFields != nil {
-		fieldsLen += len(v.ComputedFields)
+	if interpreter.invalidatedResourceValidationEnabled {
+		v.checkInvalidatedResourceUse(interpreter, getLocationRange)
 	}
 
-	if fieldsLen != len(compositeType.Fields) {
-		return false
+	value, ok := v.Get(interpreter, getLocationRange, keyValue)
+	if ok {
+		return NewSomeValueNonCopying(interpreter, value)
 	}
 
-	for _, fieldName := range compositeType.Fields {
-		value := v.GetField(interpreter, getLocationRange, fieldName)
-		if value == nil {
-			if v.ComputedFields == nil {
-				return false
-			}
+	return NewNilValue(interpreter)
+}
 
-			fieldGetter, ok := v.ComputedFields[fieldName]
-			if !ok {
-				return false
-			}
+func (v *DictionaryValue) SetKey(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	keyValue Value,
+	value Value,
+) {
 
-			value = fieldGetter(interpreter, getLocationRange)
-		}
+	if interpreter.invalidatedResourceValidationEnabled {
+		v.checkInvalidatedResourceUse(interpreter, getLocationRange)
+	}
 
-		member, ok := compositeType.Members.Get(fieldName)
-		if !ok {
-			return false
-		}
+	interpreter.checkContainerMutation(v.Type.KeyType, keyValue, getLocationRange)
+	interpreter.checkContainerMutation(
+		OptionalStaticType{ // intentionally unmetered
+			Type: v.Type.ValueType,
+		},
+		value,
+		getLocationRange,
+	)
 
-		fieldStaticType := value.StaticType(interpreter)
+	switch value := value.(type) {
+	case *SomeValue:
+		innerValue := value.InnerValue(interpreter, getLocationRange)
+		_ = v.Insert(interpreter, getLocationRange, keyValue, innerValue)
 
-		if !interpreter.IsSubTypeOfSemaType(fieldStaticType, member.TypeAnnotation.Type) {
-			return false
-		}
+	case NilValue:
+		_ = v.Remove(interpreter, getLocationRange, keyValue)
 
-		if !value.ConformsToStaticType(
-			interpreter,
-			getLocationRange,
-			results,
-		) {
-			return false
-		}
+	default:
+		panic(errors.NewUnreachableError())
 	}
+}
 
-	return true
+func (v *DictionaryValue) String() string {
+	return v.RecursiveString(SeenReferences{})
 }
 
-func (v *CompositeValue) IsStorable() bool {
+func (v *DictionaryValue) RecursiveString(seenReferences SeenReferences) string {
+	return v.MeteredString(nil, seenReferences)
+}
 
-	// Only structures, resources, enums, and contracts can be stored.
-	// Contracts are not directly storable by programs,
-	// but they are still stored in storage by the interpreter
+func (v *DictionaryValue) MeteredString(memoryGauge common.MemoryGauge, seenReferences SeenReferences) string {
 
-	switch v.Kind {
-	case common.CompositeKindStructure,
-		common.CompositeKindResource,
-		common.CompositeKindEnum,
-		common.CompositeKindContract:
-		break
-	default:
-		return false
-	}
+	pairs := make([]struct {
+		Key   string
+		Value string
+	}, v.Count())
 
-	// Composite value's of native/built-in types are not storable for now
-	return v.Location != nil
-}
+	index := 0
+	_ = v.dictionary.Iterate(func(key, value atree.Value) (resume bool, err error) {
+		// atree.OrderedMap iteration provides low-level atree.Value,
+		// convert to high-level interpreter.Value
 
-func (v *CompositeValue) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
-	if !v.IsStorable() {
-		return NonStorable{Value: v}, nil
-	}
+		pairs[index] = struct {
+			Key   string
+			Value string
+		}{
+			MustConvertStoredValue(memoryGauge, key).MeteredString(memoryGauge, seenReferences),
+			MustConvertStoredValue(memoryGauge, value).MeteredString(memoryGauge, seenReferences),
+		}
+		index++
+		return true, nil
+	})
 
-	return atree.StorageIDStorable(v.StorageID()), nil
-}
+	// len = len(open-brace) + len(close-brace) + (n times colon+space) + ((n-1) times comma+space)
+	//     = 2 + 2n + 2n - 2
+	//     = 4n + 2 - 2
+	//
+	// Since (-2) only occurs if its non-empty (i.e: n>0), ignore the (-2). i.e: overestimate
+	//    len = 4n + 2
+	//
+	// String of each key and value are metered separately.
+	strLen := len(pairs)*4 + 2
 
-func (v *CompositeValue) NeedsStoreTo(address atree.Address) bool {
-	return address != v.StorageID().Address
-}
+	common.UseMemory(memoryGauge, common.NewRawStringMemoryUsage(strLen))
 
-func (v *CompositeValue) IsResourceKinded(_ *Interpreter) bool {
-	return v.Kind == common.CompositeKindResource
+	return format.Dictionary(pairs)
 }
 
-func (v *CompositeValue) IsReferenceTrackedResourceKindedValue() {}
-
-func (v *CompositeValue) Transfer(
+func (v *DictionaryValue) GetMember(
 	interpreter *Interpreter,
 	getLocationRange func() LocationRange,
-	address atree.Address,
-	remove bool,
-	storable atree.Storable,
+	name string,
 ) Value {
 
-	baseUse, elementOverhead, dataUse, metaDataUse := common.NewCompositeMemoryUsages(v.dictionary.Count(), 0)
-	common.UseMemory(interpreter, baseUse)
-	common.UseMemory(interpreter, elementOverhead)
-	common.UseMemory(interpreter, dataUse)
-	common.UseMemory(interpreter, metaDataUse)
-
-	interpreter.ReportComputation(common.ComputationKindTransferCompositeValue, 1)
-
 	if interpreter.invalidatedResourceValidationEnabled {
-		v.checkInvalidatedResourceUse(getLocationRange)
+		v.checkInvalidatedResourceUse(interpreter, getLocationRange)
 	}
 
 	if interpreter.tracingEnabled {
 		startTime := time.Now()
 
-		owner := v.GetOwner().String()
-		typeID := string(v.TypeID())
-		kind := v.Kind.String()
+		typeInfo := v.Type.String()
+		count := v.Count()
 
 		defer func() {
-			interpreter.reportCompositeValueTransferTrace(
-				owner,
-				typeID,
-				kind,
+			interpreter.reportDictionaryValueGetMemberTrace(
+				typeInfo,
+				count,
+				name,
 				time.Since(startTime),
 			)
 		}()
 	}
 
-	currentStorageID := v.StorageID()
-	currentAddress := currentStorageID.Address
-
-	dictionary := v.dictionary
-
-	needsStoreTo := address != currentAddress
-	isResourceKinded := v.IsResourceKinded(interpreter)
+	switch name {
+	case "length":
+		return NewIntValueFromInt64(interpreter, int64(v.Count()))
 
-	if needsStoreTo && v.Kind == common.CompositeKindContract {
-		panic(NonTransferableValueError{
-			Value: v,
-		})
-	}
+	case "keys":
 
-	if needsStoreTo || !isResourceKinded {
 		iterator, err := v.dictionary.Iterator()
 		if err != nil {
 			panic(errors.NewExternalError(err))
 		}
 
-		elementMemoryUse := common.NewAtreeMapPreAllocatedElementsMemoryUsage(v.dictionary.Count(), 0)
-		common.UseMemory(interpreter.memoryGauge, elementMemoryUse)
-
-		dictionary, err = atree.NewMapFromBatchData(
-			interpreter.Storage,
-			address,
-			atree.NewDefaultDigesterBuilder(),
-			v.dictionary.Type(),
-			StringAtreeComparator,
-			StringAtreeHashInput,
-			v.dictionary.Seed(),
-			func() (atree.Value, atree.Value, error) {
+		return NewArrayValueWithIterator(
+			interpreter,
+			NewVariableSizedStaticType(interpreter, v.Type.KeyType),
+			common.Address{},
+			v.dictionary.Count(),
+			func() Value {
 
-				atreeKey, atreeValue, err := iterator.Next()
+				key, err := iterator.NextKey()
 				if err != nil {
-					return nil, nil, err
+					panic(errors.NewExternalError(err))
 				}
-				if atreeKey == nil || atreeValue == nil {
-					return nil, nil, nil
+				if key == nil {
+					return nil
 				}
 
-				// NOTE: key is stringAtreeValue
-				// and does not need to be converted or copied
-
-				value := MustConvertStoredValue(interpreter, atreeValue).
-					Transfer(interpreter, getLocationRange, address, remove, nil)
-
-				return atreeKey, value, nil
+				return MustConvertStoredValue(interpreter, key).
+					Transfer(interpreter, getLocationRange, atree.Address{}, false, nil)
 			},
 		)
+
+	case "values":
+
+		iterator, err := v.dictionary.Iterator()
 		if err != nil {
 			panic(errors.NewExternalError(err))
 		}
 
-		if remove {
-			err = v.dictionary.PopIterate(func(nameStorable atree.Storable, valueStorable atree.Storable) {
-				interpreter.RemoveReferencedSlab(nameStorable)
-				interpreter.RemoveReferencedSlab(valueStorable)
-			})
-			if err != nil {
-				panic(errors.NewExternalError(err))
-			}
-			interpreter.maybeValidateAtreeValue(v.dictionary)
+		return NewArrayValueWithIterator(
+			interpreter,
+			NewVariableSizedStaticType(interpreter, v.Type.ValueType),
+			common.Address{},
+			v.dictionary.Count(),
+			func() Value {
+
+				value, err := iterator.NextValue()
+				if err != nil {
+					panic(errors.NewExternalError(err))
+				}
+				if value == nil {
+					return nil
+				}
 
-			interpreter.RemoveReferencedSlab(storable)
-		}
-	}
+				return MustConvertStoredValue(interpreter, value).
+					Transfer(interpreter, getLocationRange, atree.Address{}, false, nil)
+			})
 
-	var res *CompositeValue
+	case "remove":
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				keyValue := invocation.Arguments[0]
 
-	if isResourceKinded {
-		// Update the resource in-place,
-		// and also update all values that are referencing the same value
-		// (but currently point to an outdated Go instance of the value)
+				return v.Remove(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					keyValue,
+				)
+			},
+			sema.DictionaryRemoveFunctionType(
+				v.SemaType(interpreter),
+			),
+		)
 
-		// If checking of transfers of invalidated resource is enabled,
-		// then mark the resource as invalidated, by unsetting the backing dictionary.
-		// This allows raising an error when the resource is attempted
-		// to be transferred/moved again (see beginning of this function)
+	case "insert":
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				keyValue := invocation.Arguments[0]
+				newValue := invocation.Arguments[1]
 
-		if interpreter.invalidatedResourceValidationEnabled {
-			v.dictionary = nil
-		} else {
-			v.dictionary = dictionary
-			res = v
-		}
+				return v.Insert(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					keyValue,
+					newValue,
+				)
+			},
+			sema.DictionaryInsertFunctionType(
+				v.SemaType(interpreter),
+			),
+		)
 
-		newStorageID := dictionary.StorageID()
+	case "containsKey":
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				return v.ContainsKey(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					invocation.Arguments[0],
+				)
+			},
+			sema.DictionaryContainsKeyFunctionType(
+				v.SemaType(interpreter),
+			),
+		)
 
-		interpreter.updateReferencedResource(
-			currentStorageID,
-			newStorageID,
-			func(value ReferenceTrackedResourceKindedValue) {
-				compositeValue, ok := value.(*CompositeValue)
+	case "forEachKey":
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				function, ok := invocation.Arguments[0].(FunctionValue)
 				if !ok {
 					panic(errors.NewUnreachableError())
 				}
-				compositeValue.dictionary = dictionary
+
+				v.ForEachKey(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					function,
+				)
+
+				return NewVoidValue(invocation.Interpreter)
 			},
+			sema.DictionaryForEachKeyFunctionType(
+				v.SemaType(interpreter),
+			),
 		)
-	}
 
-	if res == nil {
-		info := NewCompositeTypeInfo(
+	case "forEach":
+		return NewHostFunctionValue(
 			interpreter,
-			v.Location,
-			v.QualifiedIdentifier,
-			v.Kind,
-		)
-		res = newCompositeValueFromOrderedMap(dictionary, info)
-		res.InjectedFields = v.InjectedFields
-		res.ComputedFields = v.ComputedFields
-		res.NestedVariables = v.NestedVariables
-		res.Functions = v.Functions
-		res.Destructor = v.Destructor
-		res.Stringer = v.Stringer
-		res.isDestroyed = v.isDestroyed
-		res.typeID = v.typeID
-		res.staticType = v.staticType
-	}
+			func(invocation Invocation) Value {
+				function, ok := invocation.Arguments[0].(FunctionValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
 
-	if needsStoreTo &&
-		res.Kind == common.CompositeKindResource &&
-		interpreter.onResourceOwnerChange != nil {
+				v.ForEach(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					function,
+				)
 
-		interpreter.onResourceOwnerChange(
-			interpreter,
-			res,
-			common.Address(currentAddress),
-			common.Address(address),
+				return NewVoidValue(invocation.Interpreter)
+			},
+			sema.DictionaryForEachFunctionType(
+				v.SemaType(interpreter),
+			),
 		)
+
 	}
 
-	return res
+	return nil
 }
 
-func (v *CompositeValue) ResourceUUID(interpreter *Interpreter, getLocationRange func() LocationRange) *UInt64Value {
-	fieldValue := v.GetField(interpreter, getLocationRange, sema.ResourceUUIDFieldName)
-	uuid, ok := fieldValue.(UInt64Value)
-	if !ok {
-		return nil
+// ForEachKey calls the given function with a copy of each key of the dictionary,
+// until the function returns false.
+// The keys are streamed from the underlying ordered map, without materializing them.
+// The dictionary must not be mutated during the iteration.
+//
+func (v *DictionaryValue) ForEachKey(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	function FunctionValue,
+) {
+	argumentTypes := []sema.Type{
+		v.SemaType(interpreter).KeyType,
 	}
-	return &uuid
-}
-
-func (v *CompositeValue) Clone(interpreter *Interpreter) Value {
 
-	iterator, err := v.dictionary.Iterator()
-	if err != nil {
-		panic(errors.NewExternalError(err))
-	}
+	interpreter.iterateContainer(v.StorageID(), func() {
+		err := v.dictionary.IterateKeys(func(key atree.Value) (resume bool, err error) {
+			interpreter.ReportComputation(common.ComputationKindIterateDictionaryValue, 1)
 
-	elementMemoryUse := common.NewAtreeMapPreAllocatedElementsMemoryUsage(v.dictionary.Count(), 0)
-	common.UseMemory(interpreter.memoryGauge, elementMemoryUse)
+			keyValue := MustConvertStoredValue(interpreter, key).
+				Transfer(interpreter, getLocationRange, atree.Address{}, false, nil)
 
-	dictionary, err := atree.NewMapFromBatchData(
-		interpreter.Storage,
-		v.StorageID().Address,
-		atree.NewDefaultDigesterBuilder(),
-		v.dictionary.Type(),
-		StringAtreeComparator,
-		StringAtreeHashInput,
-		v.dictionary.Seed(),
-		func() (atree.Value, atree.Value, error) {
+			invocation := NewInvocation(
+				interpreter,
+				nil,
+				[]Value{keyValue},
+				argumentTypes,
+				nil,
+				getLocationRange,
+			)
 
-			atreeKey, atreeValue, err := iterator.Next()
-			if err != nil {
-				return nil, nil, err
-			}
-			if atreeKey == nil || atreeValue == nil {
-				return nil, nil, nil
+			shouldContinue, ok := function.invoke(invocation).(BoolValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
 			}
 
-			key := MustConvertStoredValue(interpreter, atreeKey).Clone(interpreter)
-			value := MustConvertStoredValue(interpreter, atreeValue).Clone(interpreter)
+			return bool(shouldContinue), nil
+		})
+		if err != nil {
+			panic(errors.NewExternalError(err))
+		}
+	})
+}
 
-			return key, value, nil
-		},
-	)
-	if err != nil {
-		panic(errors.NewExternalError(err))
-	}
+// ForEach calls the given function with a copy of each key and value of the dictionary,
+// until the function returns false.
+// The entries are streamed from the underlying ordered map, without materializing them.
+// The dictionary must not be mutated during the iteration.
+//
+func (v *DictionaryValue) ForEach(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	function FunctionValue,
+) {
+	dictionaryType := v.SemaType(interpreter)
 
-	return &CompositeValue{
-		dictionary:          dictionary,
-		Location:            v.Location,
-		QualifiedIdentifier: v.QualifiedIdentifier,
-		Kind:                v.Kind,
-		InjectedFields:      v.InjectedFields,
-		ComputedFields:      v.ComputedFields,
-		NestedVariables:     v.NestedVariables,
-		Functions:           v.Functions,
-		Destructor:          v.Destructor,
-		Stringer:            v.Stringer,
-		isDestroyed:         v.isDestroyed,
-		typeID:              v.typeID,
-		staticType:          v.staticType,
+	argumentTypes := []sema.Type{
+		dictionaryType.KeyType,
+		dictionaryType.ValueType,
 	}
-}
 
-func (v *CompositeValue) DeepRemove(interpreter *Interpreter) {
+	interpreter.iterateContainer(v.StorageID(), func() {
+		v.Iterate(interpreter, func(key, value Value) (resume bool) {
+			interpreter.ReportComputation(common.ComputationKindIterateDictionaryValue, 1)
 
-	if interpreter.tracingEnabled {
-		startTime := time.Now()
+			invocation := NewInvocation(
+				interpreter,
+				nil,
+				[]Value{
+					key.Transfer(interpreter, getLocationRange, atree.Address{}, false, nil),
+					value.Transfer(interpreter, getLocationRange, atree.Address{}, false, nil),
+				},
+				argumentTypes,
+				nil,
+				getLocationRange,
+			)
 
-		owner := v.GetOwner().String()
-		typeID := string(v.TypeID())
-		kind := v.Kind.String()
+			shouldContinue, ok := function.invoke(invocation).(BoolValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
 
-		defer func() {
-			interpreter.reportCompositeValueDeepRemoveTrace(
-				owner,
-				typeID,
-				kind,
-				time.Since(startTime),
-			)
-		}()
-	}
+			return bool(shouldContinue)
+		})
+	})
+}
 
-	// Remove nested values and storables
+func (v *DictionaryValue) RemoveMember(interpreter *Interpreter, getLocationRange func() LocationRange, _ string) Value {
 
-	storage := v.dictionary.Storage
+	if interpreter.invalidatedResourceValidationEnabled {
+		v.checkInvalidatedResourceUse(interpreter, getLocationRange)
+	}
 
-	err := v.dictionary.PopIterate(func(nameStorable atree.Storable, valueStorable atree.Storable) {
-		// NOTE: key / field name is stringAtreeValue,
-		// and not a Value, so no need to deep remove
-		interpreter.RemoveReferencedSlab(nameStorable)
+	// Dictionaries have no removable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
 
-		value := StoredValue(interpreter, valueStorable, storage)
-		value.DeepRemove(interpreter)
-		interpreter.RemoveReferencedSlab(valueStorable)
-	})
-	if err != nil {
-		panic(errors.NewExternalError(err))
+func (v *DictionaryValue) SetMember(interpreter *Interpreter, getLocationRange func() LocationRange, _ string, _ Value) {
+
+	if interpreter.invalidatedResourceValidationEnabled {
+		v.checkInvalidatedResourceUse(interpreter, getLocationRange)
 	}
-	interpreter.maybeValidateAtreeValue(v.dictionary)
+
+	// Dictionaries have no settable members (fields / functions)
+	panic(errors.NewUnreachableError())
 }
 
-func (v *CompositeValue) GetOwner() common.Address {
-	return common.Address(v.StorageID().Address)
+func (v *DictionaryValue) Count() int {
+	return int(v.dictionary.Count())
 }
 
-// ForEachField iterates over all field-name field-value pairs of the composite value.
-// It does NOT iterate over computed fields and functions!
-//
-func (v *CompositeValue) ForEachField(gauge common.MemoryGauge, f func(fieldName string, fieldValue Value)) {
+func (v *DictionaryValue) RemoveKey(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	key Value,
+) Value {
 
-	err := v.dictionary.Iterate(func(key atree.Value, value atree.Value) (resume bool, err error) {
-		f(
-			string(key.(StringAtreeValue)),
-			MustConvertStoredValue(gauge, value),
-		)
-		return true, nil
-	})
-	if err != nil {
-		panic(errors.NewExternalError(err))
+	if interpreter.invalidatedResourceValidationEnabled {
+		v.checkInvalidatedResourceUse(interpreter, getLocationRange)
 	}
-}
 
-func (v *CompositeValue) StorageID() atree.StorageID {
-	return v.dictionary.StorageID()
+	return v.Remove(interpreter, getLocationRange, key)
 }
 
-func (v *CompositeValue) RemoveField(
+func (v *DictionaryValue) Remove(
 	interpreter *Interpreter,
-	_ func() LocationRange,
-	name string,
-) {
+	getLocationRange func() LocationRange,
+	keyValue Value,
+) OptionalValue {
+
+	interpreter.checkMutationDuringIteration(v.StorageID(), getLocationRange)
+	interpreter.recordMutation(v)
+
+	valueComparator := newValueComparator(interpreter, getLocationRange)
+	hashInputProvider := newHashInputProvider(interpreter, getLocationRange)
 
+	// No need to clean up storable for passed-in key value,
+	// as atree never calls Storable()
 	existingKeyStorable, existingValueStorable, err := v.dictionary.Remove(
-		StringAtreeComparator,
-		StringAtreeHashInput,
-		StringAtreeValue(name),
+		valueComparator,
+		hashInputProvider,
+		keyValue,
 	)
 	if err != nil {
 		if _, ok := err.(*atree.KeyNotFoundError); ok {
-			return
+			return NewNilValue(interpreter)
 		}
 		panic(errors.NewExternalError(err))
 	}
@@ -15276,915 +20177,1029 @@ func (v *CompositeValue) RemoveField(
 
 	// Key
 
-	// NOTE: key / field name is stringAtreeValue,
-	// and not a Value, so no need to deep remove
+	existingKeyValue := StoredValue(interpreter, existingKeyStorable, storage)
+	existingKeyValue.DeepRemove(interpreter)
 	interpreter.RemoveReferencedSlab(existingKeyStorable)
 
 	// Value
 
-	existingValue := StoredValue(interpreter, existingValueStorable, storage)
-	existingValue.DeepRemove(interpreter)
-	interpreter.RemoveReferencedSlab(existingValueStorable)
+	existingValue := StoredValue(interpreter, existingValueStorable, storage).
+		Transfer(
+			interpreter,
+			getLocationRange,
+			atree.Address{},
+			true,
+			existingValueStorable,
+		)
+
+	return NewSomeValueNonCopying(interpreter, existingValue)
 }
 
-func NewEnumCaseValue(
+func (v *DictionaryValue) InsertKey(
 	interpreter *Interpreter,
 	getLocationRange func() LocationRange,
-	enumType *sema.CompositeType,
-	rawValue NumberValue,
-	functions map[string]FunctionValue,
-) *CompositeValue {
+	key, value Value,
+) {
+	v.SetKey(interpreter, getLocationRange, key, value)
+}
 
-	fields := []CompositeField{
-		{
-			Name:  sema.EnumRawValueFieldName,
-			Value: rawValue,
-		},
-	}
+func (v *DictionaryValue) Insert(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	keyValue, value Value,
+) OptionalValue {
 
-	v := NewCompositeValue(
+	interpreter.checkMutationDuringIteration(v.StorageID(), getLocationRange)
+	interpreter.recordMutation(v)
+
+	// length increases by 1
+	dataSlabs, metaDataSlabs := common.AdditionalAtreeMemoryUsage(v.dictionary.Count(), v.elementSize, false)
+	common.UseMemory(interpreter, common.AtreeMapElementOverhead)
+	common.UseMemory(interpreter, dataSlabs)
+	common.UseMemory(interpreter, metaDataSlabs)
+
+	interpreter.checkContainerMutation(v.Type.KeyType, keyValue, getLocationRange)
+	interpreter.checkContainerMutation(v.Type.ValueType, value, getLocationRange)
+
+	address := v.dictionary.Address()
+
+	keyValue = keyValue.Transfer(
 		interpreter,
 		getLocationRange,
-		enumType.Location,
-		enumType.QualifiedIdentifier(),
-		enumType.Kind,
-		fields,
-		common.Address{},
+		address,
+		true,
+		nil,
 	)
 
-	v.Functions = functions
+	value = value.Transfer(
+		interpreter,
+		getLocationRange,
+		address,
+		true,
+		nil,
+	)
 
-	return v
-}
+	valueComparator := newValueComparator(interpreter, getLocationRange)
+	hashInputProvider := newHashInputProvider(interpreter, getLocationRange)
 
-// DictionaryValue
+	// atree only calls Storable() on keyValue if needed,
+	// i.e., if the key is a new key
+	existingValueStorable, err := v.dictionary.Set(
+		valueComparator,
+		hashInputProvider,
+		keyValue,
+		value,
+	)
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+	interpreter.maybeValidateAtreeValue(v.dictionary)
 
-type DictionaryValue struct {
-	Type             DictionaryStaticType
-	semaType         *sema.DictionaryType
-	isResourceKinded *bool
-	dictionary       *atree.OrderedMap
-	isDestroyed      bool
-	elementSize      uint
+	if existingValueStorable == nil {
+		return NewNilValue(interpreter)
+	}
+
+	existingValue := StoredValue(interpreter, existingValueStorable, interpreter.Storage).
+		Transfer(
+			interpreter,
+			getLocationRange,
+			atree.Address{},
+			true,
+			existingValueStorable,
+		)
+
+	return NewSomeValueNonCopying(interpreter, existingValue)
 }
 
-func NewDictionaryValue(
-	interpreter *Interpreter,
-	getLocationRange func() LocationRange,
-	dictionaryType DictionaryStaticType,
-	keysAndValues ...Value,
-) *DictionaryValue {
-	return NewDictionaryValueWithAddress(
-		interpreter,
-		getLocationRange,
-		dictionaryType,
-		common.Address{},
-		keysAndValues...,
-	)
+type DictionaryEntryValues struct {
+	Key   Value
+	Value Value
 }
 
-func NewDictionaryValueWithAddress(
+func (v *DictionaryValue) ConformsToStaticType(
 	interpreter *Interpreter,
 	getLocationRange func() LocationRange,
-	dictionaryType DictionaryStaticType,
-	address common.Address,
-	keysAndValues ...Value,
-) *DictionaryValue {
+	results TypeConformanceResults,
+) bool {
 
-	interpreter.ReportComputation(common.ComputationKindCreateDictionaryValue, 1)
+	count := v.Count()
 
-	var v *DictionaryValue
+	if interpreter.tracingEnabled {
+		startTime := time.Now()
+
+		typeInfo := v.Type.String()
+
+		defer func() {
+			interpreter.reportDictionaryValueConformsToStaticTypeTrace(
+				typeInfo,
+				count,
+				time.Since(startTime),
+			)
+		}()
+	}
+
+	staticType, ok := v.StaticType(interpreter).(DictionaryStaticType)
+	if !ok {
+		return false
+	}
+
+	keyType := staticType.KeyType
+	valueType := staticType.ValueType
+
+	iterator, err := v.dictionary.Iterator()
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+
+	for {
+		key, value, err := iterator.Next()
+		if err != nil {
+			panic(errors.NewExternalError(err))
+		}
+		if key == nil {
+			return true
+		}
+
+		// Check the key
+
+		// atree.OrderedMap iteration provides low-level atree.Value,
+		// convert to high-level interpreter.Value
+		entryKey := MustConvertStoredValue(interpreter, key)
+
+		if !interpreter.IsSubType(entryKey.StaticType(interpreter), keyType) {
+			return false
+		}
+
+		if !entryKey.ConformsToStaticType(
+			interpreter,
+			getLocationRange,
+			results,
+		) {
+			return false
+		}
+
+		// Check the value
+
+		// atree.OrderedMap iteration provides low-level atree.Value,
+		// convert to high-level interpreter.Value
+		entryValue := MustConvertStoredValue(interpreter, value)
+
+		if !interpreter.IsSubType(entryValue.StaticType(interpreter), valueType) {
+			return false
+		}
+
+		if !entryValue.ConformsToStaticType(
+			interpreter,
+			getLocationRange,
+			results,
+		) {
+			return false
+		}
+	}
+}
 
-	if interpreter.tracingEnabled {
-		startTime := time.Now()
+func (v *DictionaryValue) Equal(interpreter *Interpreter, getLocationRange func() LocationRange, other Value) bool {
 
-		defer func() {
-			// NOTE: in defer, as v is only initialized at the end of the function
-			// if there was no error during construction
-			if v == nil {
-				return
-			}
+	otherDictionary, ok := other.(*DictionaryValue)
+	if !ok {
+		return false
+	}
 
-			typeInfo := v.Type.String()
-			count := v.Count()
+	if v.Count() != otherDictionary.Count() {
+		return false
+	}
 
-			interpreter.reportDictionaryValueConstructTrace(
-				typeInfo,
-				count,
-				time.Since(startTime),
-			)
-		}()
+	if !v.Type.Equal(otherDictionary.Type) {
+		return false
 	}
 
-	keysAndValuesCount := len(keysAndValues)
-	if keysAndValuesCount%2 != 0 {
-		panic("uneven number of keys and values")
+	iterator, err := v.dictionary.Iterator()
+	if err != nil {
+		panic(errors.NewExternalError(err))
 	}
 
-	constructor := func() *atree.OrderedMap {
-		dictionary, err := atree.NewMap(
-			interpreter.Storage,
-			atree.Address(address),
-			atree.NewDefaultDigesterBuilder(),
-			dictionaryType,
-		)
+	for {
+		key, value, err := iterator.Next()
 		if err != nil {
 			panic(errors.NewExternalError(err))
 		}
-		return dictionary
-	}
+		if key == nil {
+			return true
+		}
 
-	// values are added to the dictionary after creation, not here
-	v = newDictionaryValueFromConstructor(interpreter, dictionaryType, 0, constructor)
+		// Do NOT use an iterator, as other value may be stored in another account,
+		// leading to a different iteration order, as the storage ID is used in the seed
+		otherValue, otherValueExists :=
+			otherDictionary.Get(
+				interpreter,
+				getLocationRange,
+				MustConvertStoredValue(interpreter, key),
+			)
 
-	// NOTE: lazily initialized when needed for performance reasons
-	var lazyIsResourceTyped *bool
+		if !otherValueExists {
+			return false
+		}
 
-	for i := 0; i < keysAndValuesCount; i += 2 {
-		key := keysAndValues[i]
-		value := keysAndValues[i+1]
-		existingValue := v.Insert(interpreter, getLocationRange, key, value)
-		// If the dictionary already contained a value for the key,
-		// and the dictionary is resource-typed,
-		// then we need to prevent a resource loss
-		if _, ok := existingValue.(*SomeValue); ok {
-			// Lazily determine if the dictionary is resource-typed, once
-			if lazyIsResourceTyped == nil {
-				isResourceTyped := v.SemaType(interpreter).IsResourceType()
-				lazyIsResourceTyped = &isResourceTyped
-			}
-			if *lazyIsResourceTyped {
-				panic(DuplicateKeyInResourceDictionaryError{
-					LocationRange: getLocationRange(),
-				})
-			}
+		equatableValue, ok := MustConvertStoredValue(interpreter, value).(EquatableValue)
+		if !ok || !equatableValue.Equal(interpreter, getLocationRange, otherValue) {
+			return false
 		}
 	}
-
-	return v
 }
 
-func newDictionaryValueFromOrderedMap(
-	dict *atree.OrderedMap,
-	staticType DictionaryStaticType,
-) *DictionaryValue {
-	return &DictionaryValue{
-		Type:       staticType,
-		dictionary: dict,
-	}
+func (v *DictionaryValue) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+	return atree.StorageIDStorable(v.StorageID()), nil
 }
 
-func newDictionaryValueFromConstructor(
-	gauge common.MemoryGauge,
-	staticType DictionaryStaticType,
-	count uint64,
-	constructor func() *atree.OrderedMap,
-) (dict *DictionaryValue) {
+func (v *DictionaryValue) IsReferenceTrackedResourceKindedValue() {}
 
-	keySize := staticType.KeyType.elementSize()
-	valueSize := staticType.ValueType.elementSize()
-	var elementSize uint
-	if keySize != 0 && valueSize != 0 {
-		elementSize = keySize + valueSize
-	}
-	baseUsage, overheadUsage, dataSlabs, metaDataSlabs := common.NewDictionaryMemoryUsages(count, elementSize)
-	common.UseMemory(gauge, baseUsage)
-	common.UseMemory(gauge, overheadUsage)
-	common.UseMemory(gauge, dataSlabs)
-	common.UseMemory(gauge, metaDataSlabs)
+func (v *DictionaryValue) Transfer(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	address atree.Address,
+	remove bool,
+	storable atree.Storable,
+) Value {
+	baseUse, elementOverhead, dataUse, metaDataUse := common.NewDictionaryMemoryUsages(
+		v.dictionary.Count(),
+		v.elementSize,
+	)
+	common.UseMemory(interpreter, baseUse)
+	common.UseMemory(interpreter, elementOverhead)
+	common.UseMemory(interpreter, dataUse)
+	common.UseMemory(interpreter, metaDataUse)
 
-	dict = newDictionaryValueFromOrderedMap(constructor(), staticType)
-	dict.elementSize = elementSize
-	return
-}
+	interpreter.ReportComputation(common.ComputationKindTransferDictionaryValue, uint(v.Count()))
 
-var _ Value = &DictionaryValue{}
-var _ atree.Value = &DictionaryValue{}
-var _ EquatableValue = &DictionaryValue{}
-var _ ValueIndexableValue = &DictionaryValue{}
-var _ MemberAccessibleValue = &DictionaryValue{}
-var _ ReferenceTrackedResourceKindedValue = &DictionaryValue{}
+	if interpreter.invalidatedResourceValidationEnabled {
+		v.checkInvalidatedResourceUse(interpreter, getLocationRange)
+	}
 
-func (*DictionaryValue) IsValue() {}
+	if interpreter.tracingEnabled {
+		startTime := time.Now()
 
-func (v *DictionaryValue) Accept(interpreter *Interpreter, visitor Visitor) {
-	descend := visitor.VisitDictionaryValue(interpreter, v)
-	if !descend {
-		return
+		typeInfo := v.Type.String()
+		count := v.Count()
+
+		defer func() {
+			interpreter.reportDictionaryValueTransferTrace(
+				typeInfo,
+				count,
+				time.Since(startTime),
+			)
+		}()
 	}
 
-	v.Walk(interpreter, func(value Value) {
-		value.Accept(interpreter, visitor)
-	})
-}
+	currentStorageID := v.StorageID()
+	currentAddress := currentStorageID.Address
 
-func (v *DictionaryValue) Iterate(gauge common.MemoryGauge, f func(key, value Value) (resume bool)) {
-	err := v.dictionary.Iterate(func(key, value atree.Value) (resume bool, err error) {
-		// atree.OrderedMap iteration provides low-level atree.Value,
-		// convert to high-level interpreter.Value
+	dictionary := v.dictionary
 
-		resume = f(
-			MustConvertStoredValue(gauge, key),
-			MustConvertStoredValue(gauge, value),
-		)
+	needsStoreTo := address != currentAddress
+	isResourceKinded := v.IsResourceKinded(interpreter)
 
-		return resume, nil
-	})
-	if err != nil {
-		panic(errors.NewExternalError(err))
+	if remove {
+		interpreter.checkMutationDuringIteration(currentStorageID, getLocationRange)
 	}
-}
 
-func (v *DictionaryValue) Walk(interpreter *Interpreter, walkChild func(Value)) {
-	v.Iterate(interpreter, func(key, value Value) (resume bool) {
-		walkChild(key)
-		walkChild(value)
-		return true
-	})
-}
+	if remove || isResourceKinded {
+		interpreter.recordMutation(v)
+	}
 
-func (v *DictionaryValue) StaticType(_ *Interpreter) StaticType {
-	// TODO meter
-	return v.Type
-}
+	if needsStoreTo || !isResourceKinded {
 
-func (v *DictionaryValue) IsImportable(inter *Interpreter) bool {
-	importable := true
-	v.Iterate(inter, func(key, value Value) (resume bool) {
-		if !key.IsImportable(inter) || !value.IsImportable(inter) {
-			importable = false
-			// stop iteration
-			return false
+		valueComparator := newValueComparator(interpreter, getLocationRange)
+		hashInputProvider := newHashInputProvider(interpreter, getLocationRange)
+
+		iterator, err := v.dictionary.Iterator()
+		if err != nil {
+			panic(errors.NewExternalError(err))
 		}
 
-		// continue iteration
-		return true
-	})
+		elementMemoryUse := common.NewAtreeMapPreAllocatedElementsMemoryUsage(v.dictionary.Count(), v.elementSize)
+		common.UseMemory(interpreter.memoryGauge, elementMemoryUse)
 
-	return importable
-}
+		dictionary, err = atree.NewMapFromBatchData(
+			interpreter.Storage,
+			address,
+			atree.NewDefaultDigesterBuilder(),
+			v.dictionary.Type(),
+			valueComparator,
+			hashInputProvider,
+			v.dictionary.Seed(),
+			func() (atree.Value, atree.Value, error) {
 
-func (v *DictionaryValue) IsDestroyed() bool {
-	return v.isDestroyed
-}
+				atreeKey, atreeValue, err := iterator.Next()
+				if err != nil {
+					return nil, nil, err
+				}
+				if atreeKey == nil || atreeValue == nil {
+					return nil, nil, nil
+				}
 
-func (v *DictionaryValue) checkInvalidatedResourceUse(interpreter *Interpreter, getLocationRange func() LocationRange) {
-	if v.isDestroyed || (v.dictionary == nil && v.IsResourceKinded(interpreter)) {
-		panic(InvalidatedResourceError{
-			LocationRange: getLocationRange(),
-		})
-	}
-}
+				key := MustConvertStoredValue(interpreter, atreeKey).
+					Transfer(interpreter, getLocationRange, address, remove, nil)
 
-func (v *DictionaryValue) Destroy(interpreter *Interpreter, getLocationRange func() LocationRange) {
+				value := MustConvertStoredValue(interpreter, atreeValue).
+					Transfer(interpreter, getLocationRange, address, remove, nil)
 
-	interpreter.ReportComputation(common.ComputationKindDestroyDictionaryValue, 1)
+				return key, value, nil
+			},
+		)
+		if err != nil {
+			panic(errors.NewExternalError(err))
+		}
+
+		if remove {
+			err = v.dictionary.PopIterate(func(keyStorable atree.Storable, valueStorable atree.Storable) {
+				interpreter.RemoveReferencedSlab(keyStorable)
+				interpreter.RemoveReferencedSlab(valueStorable)
+			})
+			if err != nil {
+				panic(errors.NewExternalError(err))
+			}
+			interpreter.maybeValidateAtreeValue(v.dictionary)
 
-	if interpreter.invalidatedResourceValidationEnabled {
-		v.checkInvalidatedResourceUse(interpreter, getLocationRange)
+			interpreter.RemoveReferencedSlab(storable)
+		}
 	}
 
-	storageID := v.StorageID()
+	var res *DictionaryValue
 
-	if interpreter.tracingEnabled {
-		startTime := time.Now()
+	if isResourceKinded {
+		// Update the resource in-place,
+		// and also update all values that are referencing the same value
+		// (but currently point to an outdated Go instance of the value)
 
-		typeInfo := v.Type.String()
-		count := v.Count()
+		// If checking of transfers of invalidated resource is enabled,
+		// then mark the resource array as invalidated, by unsetting the backing array.
+		// This allows raising an error when the resource array is attempted
+		// to be transferred/moved again (see beginning of this function)
 
-		defer func() {
-			interpreter.reportDictionaryValueDestroyTrace(
-				typeInfo,
-				count,
-				time.Since(startTime),
-			)
-		}()
-	}
+		if interpreter.invalidatedResourceValidationEnabled {
+			v.dictionary = nil
+		} else {
+			v.dictionary = dictionary
+			res = v
+		}
 
-	v.Iterate(interpreter, func(key, value Value) (resume bool) {
-		// Resources cannot be keys at the moment, so should theoretically not be needed
-		maybeDestroy(interpreter, getLocationRange, key)
-		maybeDestroy(interpreter, getLocationRange, value)
-		return true
-	})
+		newStorageID := dictionary.StorageID()
 
-	v.isDestroyed = true
+		interpreter.updateReferencedResource(
+			currentStorageID,
+			newStorageID,
+			func(value ReferenceTrackedResourceKindedValue) {
+				dictionaryValue, ok := value.(*DictionaryValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+				dictionaryValue.dictionary = dictionary
+			},
+		)
+	}
 
-	if interpreter.invalidatedResourceValidationEnabled {
-		v.dictionary = nil
+	if res == nil {
+		res = newDictionaryValueFromOrderedMap(dictionary, v.Type)
+		res.elementSize = v.elementSize
+		res.semaType = v.semaType
+		res.isResourceKinded = v.isResourceKinded
+		res.isDestroyed = v.isDestroyed
 	}
 
-	interpreter.updateReferencedResource(
-		storageID,
-		storageID,
-		func(value ReferenceTrackedResourceKindedValue) {
-			dictionaryValue, ok := value.(*DictionaryValue)
-			if !ok {
-				panic(errors.NewUnreachableError())
-			}
+	return res
+}
 
-			dictionaryValue.isDestroyed = true
+func (v *DictionaryValue) Clone(interpreter *Interpreter) Value {
 
-			if interpreter.invalidatedResourceValidationEnabled {
-				dictionaryValue.dictionary = nil
-			}
-		},
-	)
-}
+	valueComparator := newValueComparator(interpreter, ReturnEmptyLocationRange)
+	hashInputProvider := newHashInputProvider(interpreter, ReturnEmptyLocationRange)
 
-func (v *DictionaryValue) ContainsKey(
-	interpreter *Interpreter,
-	getLocationRange func() LocationRange,
-	keyValue Value,
-) BoolValue {
+	iterator, err := v.dictionary.Iterator()
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
 
-	valueComparator := newValueComparator(interpreter, getLocationRange)
-	hashInputProvider := newHashInputProvider(interpreter, getLocationRange)
+	elementMemoryUse := common.NewAtreeMapPreAllocatedElementsMemoryUsage(v.dictionary.Count(), v.elementSize)
+	common.UseMemory(interpreter.memoryGauge, elementMemoryUse)
 
-	_, err := v.dictionary.Get(
+	dictionary, err := atree.NewMapFromBatchData(
+		interpreter.Storage,
+		v.StorageID().Address,
+		atree.NewDefaultDigesterBuilder(),
+		v.dictionary.Type(),
 		valueComparator,
 		hashInputProvider,
-		keyValue,
-	)
+		v.dictionary.Seed(),
+		func() (atree.Value, atree.Value, error) {
 
-	valueGetter := func() bool {
-		if err != nil {
-			if _, ok := err.(*atree.KeyNotFoundError); ok {
-				return false
+			atreeKey, atreeValue, err := iterator.Next()
+			if err != nil {
+				return nil, nil, err
+			}
+			if atreeKey == nil || atreeValue == nil {
+				return nil, nil, nil
 			}
-			panic(errors.NewExternalError(err))
-		}
-		return true
-	}
-
-	return NewBoolValueFromConstructor(interpreter, valueGetter)
-}
 
-func (v *DictionaryValue) Get(
-	interpreter *Interpreter,
-	getLocationRange func() LocationRange,
-	keyValue Value,
-) (Value, bool) {
+			key := MustConvertStoredValue(interpreter, atreeKey).
+				Clone(interpreter)
 
-	valueComparator := newValueComparator(interpreter, getLocationRange)
-	hashInputProvider := newHashInputProvider(interpreter, getLocationRange)
+			value := MustConvertStoredValue(interpreter, atreeValue).
+				Clone(interpreter)
 
-	storable, err := v.dictionary.Get(
-		valueComparator,
-		hashInputProvider,
-		keyValue,
+			return key, value, nil
+		},
 	)
 	if err != nil {
-		if _, ok := err.(*atree.KeyNotFoundError); ok {
-			return nil, false
-		}
 		panic(errors.NewExternalError(err))
 	}
 
-	storage := v.dictionary.Storage
-	value := StoredValue(interpreter, storable, storage)
-	return value, true
-}
-
-func (v *DictionaryValue) GetKey(interpreter *Interpreter, getLocationRange func() LocationRange, keyValue Value) Value {
-
-	if interpreter.invalidatedResourceValidationEnabled {
-		v.checkInvalidatedResourceUse(interpreter, getLocationRange)
-	}
-
-	value, ok := v.Get(interpreter, getLocationRange, keyValue)
-	if ok {
-		return NewSomeValueNonCopying(interpreter, value)
+	return &DictionaryValue{
+		Type:             v.Type,
+		semaType:         v.semaType,
+		isResourceKinded: v.isResourceKinded,
+		dictionary:       dictionary,
+		isDestroyed:      v.isDestroyed,
 	}
-
-	return NewNilValue(interpreter)
 }
 
-func (v *DictionaryValue) SetKey(
-	interpreter *Interpreter,
-	getLocationRange func() LocationRange,
-	keyValue Value,
-	value Value,
-) {
-
-	if interpreter.invalidatedResourceValidationEnabled {
-		v.checkInvalidatedResourceUse(interpreter, getLocationRange)
-	}
+func (v *DictionaryValue) DeepRemove(interpreter *Interpreter) {
 
-	interpreter.checkContainerMutation(v.Type.KeyType, keyValue, getLocationRange)
-	interpreter.checkContainerMutation(
-		OptionalStaticType{ // intentionally unmetered
-			Type: v.Type.ValueType,
-		},
-		value,
-		getLocationRange,
-	)
+	interpreter.checkMutationDuringIteration(v.StorageID(), ReturnEmptyLocationRange)
+	interpreter.recordMutation(v)
 
-	switch value := value.(type) {
-	case *SomeValue:
-		innerValue := value.InnerValue(interpreter, getLocationRange)
-		_ = v.Insert(interpreter, getLocationRange, keyValue, innerValue)
+	if interpreter.tracingEnabled {
+		startTime := time.Now()
 
-	case NilValue:
-		_ = v.Remove(interpreter, getLocationRange, keyValue)
+		typeInfo := v.Type.String()
+		count := v.Count()
 
-	default:
-		panic(errors.NewUnreachableError())
+		defer func() {
+			interpreter.reportDictionaryValueDeepRemoveTrace(
+				typeInfo,
+				count,
+				time.Since(startTime),
+			)
+		}()
 	}
-}
-
-func (v *DictionaryValue) String() string {
-	return v.RecursiveString(SeenReferences{})
-}
 
-func (v *DictionaryValue) RecursiveString(seenReferences SeenReferences) string {
-	return v.MeteredString(nil, seenReferences)
-}
+	// Remove nested values and storables
 
-func (v *DictionaryValue) MeteredString(memoryGauge common.MemoryGauge, seenReferences SeenReferences) string {
+	storage := v.dictionary.Storage
 
-	pairs := make([]struct {
-		Key   string
-		Value string
-	}, v.Count())
+	err := v.dictionary.PopIterate(func(keyStorable atree.Storable, valueStorable atree.Storable) {
 
-	index := 0
-	_ = v.dictionary.Iterate(func(key, value atree.Value) (resume bool, err error) {
-		// atree.OrderedMap iteration provides low-level atree.Value,
-		// convert to high-level interpreter.Value
+		key := StoredValue(interpreter, keyStorable, storage)
+		key.DeepRemove(interpreter)
+		interpreter.RemoveReferencedSlab(keyStorable)
 
-		pairs[index] = struct {
-			Key   string
-			Value string
-		}{
-			MustConvertStoredValue(memoryGauge, key).MeteredString(memoryGauge, seenReferences),
-			MustConvertStoredValue(memoryGauge, value).MeteredString(memoryGauge, seenReferences),
-		}
-		index++
-		return true, nil
+		value := StoredValue(interpreter, valueStorable, storage)
+		value.DeepRemove(interpreter)
+		interpreter.RemoveReferencedSlab(valueStorable)
 	})
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
+	interpreter.maybeValidateAtreeValue(v.dictionary)
+}
 
-	// len = len(open-brace) + len(close-brace) + (n times colon+space) + ((n-1) times comma+space)
-	//     = 2 + 2n + 2n - 2
-	//     = 4n + 2 - 2
-	//
-	// Since (-2) only occurs if its non-empty (i.e: n>0), ignore the (-2). i.e: overestimate
-	//    len = 4n + 2
-	//
-	// String of each key and value are metered separately.
-	strLen := len(pairs)*4 + 2
-
-	common.UseMemory(memoryGauge, common.NewRawStringMemoryUsage(strLen))
-
-	return format.Dictionary(pairs)
+func (v *DictionaryValue) GetOwner() common.Address {
+	return common.Address(v.StorageID().Address)
 }
 
-func (v *DictionaryValue) GetMember(
-	interpreter *Interpreter,
-	getLocationRange func() LocationRange,
-	name string,
-) Value {
+func (v *DictionaryValue) StorageID() atree.StorageID {
+	return v.dictionary.StorageID()
+}
 
-	if interpreter.invalidatedResourceValidationEnabled {
-		v.checkInvalidatedResourceUse(interpreter, getLocationRange)
+func (v *DictionaryValue) SemaType(interpreter *Interpreter) *sema.DictionaryType {
+	if v.semaType == nil {
+		// this function will panic already if this conversion fails
+		v.semaType, _ = interpreter.MustConvertStaticToSemaType(v.Type).(*sema.DictionaryType)
 	}
+	return v.semaType
+}
 
-	if interpreter.tracingEnabled {
-		startTime := time.Now()
-
-		typeInfo := v.Type.String()
-		count := v.Count()
+func (v *DictionaryValue) NeedsStoreTo(address atree.Address) bool {
+	return address != v.StorageID().Address
+}
 
-		defer func() {
-			interpreter.reportDictionaryValueGetMemberTrace(
-				typeInfo,
-				count,
-				name,
-				time.Since(startTime),
-			)
-		}()
+func (v *DictionaryValue) IsResourceKinded(interpreter *Interpreter) bool {
+	if v.isResourceKinded == nil {
+		isResourceKinded := v.SemaType(interpreter).IsResourceType()
+		v.isResourceKinded = &isResourceKinded
 	}
+	return *v.isResourceKinded
+}
 
-	switch name {
-	case "length":
-		return NewIntValueFromInt64(interpreter, int64(v.Count()))
-
-	case "keys":
+// SetValue
 
-		iterator, err := v.dictionary.Iterator()
-		if err != nil {
-			panic(errors.NewExternalError(err))
-		}
+type SetValue struct {
+	Type        SetStaticType
+	semaType    *sema.SetType
+	set         *atree.OrderedMap
+	elementSize uint
+}
 
-		return NewArrayValueWithIterator(
-			interpreter,
-			NewVariableSizedStaticType(interpreter, v.Type.KeyType),
-			common.Address{},
-			v.dictionary.Count(),
-			func() Value {
+func NewSetValue(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	setType SetStaticType,
+	elements ...Value,
+) *SetValue {
 
-				key, err := iterator.NextKey()
-				if err != nil {
-					panic(errors.NewExternalError(err))
-				}
-				if key == nil {
-					return nil
-				}
+	interpreter.ReportComputation(common.ComputationKindCreateSetValue, 1)
 
-				return MustConvertStoredValue(interpreter, key).
-					Transfer(interpreter, getLocationRange, atree.Address{}, false, nil)
-			},
+	constructor := func() *atree.OrderedMap {
+		set, err := atree.NewMap(
+			interpreter.Storage,
+			atree.Address{},
+			atree.NewDefaultDigesterBuilder(),
+			setType,
 		)
-
-	case "values":
-
-		iterator, err := v.dictionary.Iterator()
 		if err != nil {
 			panic(errors.NewExternalError(err))
 		}
+		return set
+	}
 
-		return NewArrayValueWithIterator(
-			interpreter,
-			NewVariableSizedStaticType(interpreter, v.Type.ValueType),
-			common.Address{},
-			v.dictionary.Count(),
-			func() Value {
+	// elements are added to the set after creation, not here
+	v := newSetValueFromConstructor(interpreter, setType, 0, constructor)
 
-				value, err := iterator.NextValue()
-				if err != nil {
-					panic(errors.NewExternalError(err))
-				}
-				if value == nil {
-					return nil
-				}
+	for _, element := range elements {
+		_ = v.Insert(interpreter, getLocationRange, element)
+	}
 
-				return MustConvertStoredValue(interpreter, value).
-					Transfer(interpreter, getLocationRange, atree.Address{}, false, nil)
-			})
+	return v
+}
 
-	case "remove":
-		return NewHostFunctionValue(
-			interpreter,
-			func(invocation Invocation) Value {
-				keyValue := invocation.Arguments[0]
+func newSetValueFromOrderedMap(
+	set *atree.OrderedMap,
+	staticType SetStaticType,
+) *SetValue {
+	return &SetValue{
+		Type: staticType,
+		set:  set,
+	}
+}
 
-				return v.Remove(
-					invocation.Interpreter,
-					invocation.GetLocationRange,
-					keyValue,
-				)
-			},
-			sema.DictionaryRemoveFunctionType(
-				v.SemaType(interpreter),
-			),
-		)
+func newSetValueFromConstructor(
+	gauge common.MemoryGauge,
+	staticType SetStaticType,
+	count uint64,
+	constructor func() *atree.OrderedMap,
+) (set *SetValue) {
 
-	case "insert":
-		return NewHostFunctionValue(
-			interpreter,
-			func(invocation Invocation) Value {
-				keyValue := invocation.Arguments[0]
-				newValue := invocation.Arguments[1]
+	elementSize := staticType.ElementType.elementSize()
+	baseUsage, overheadUsage, dataSlabs, metaDataSlabs := common.NewSetMemoryUsages(count, elementSize)
+	common.UseMemory(gauge, baseUsage)
+	common.UseMemory(gauge, overheadUsage)
+	common.UseMemory(gauge, dataSlabs)
+	common.UseMemory(gauge, metaDataSlabs)
 
-				return v.Insert(
-					invocation.Interpreter,
-					invocation.GetLocationRange,
-					keyValue,
-					newValue,
-				)
-			},
-			sema.DictionaryInsertFunctionType(
-				v.SemaType(interpreter),
-			),
-		)
+	set = newSetValueFromOrderedMap(constructor(), staticType)
+	set.elementSize = elementSize
+	return
+}
 
-	case "containsKey":
-		return NewHostFunctionValue(
-			interpreter,
-			func(invocation Invocation) Value {
-				return v.ContainsKey(
-					invocation.Interpreter,
-					invocation.GetLocationRange,
-					invocation.Arguments[0],
-				)
-			},
-			sema.DictionaryContainsKeyFunctionType(
-				v.SemaType(interpreter),
-			),
-		)
+var _ Value = &SetValue{}
+var _ atree.Value = &SetValue{}
+var _ EquatableValue = &SetValue{}
+var _ MemberAccessibleValue = &SetValue{}
 
+func (*SetValue) IsValue() {}
+
+func (v *SetValue) Accept(interpreter *Interpreter, visitor Visitor) {
+	descend := visitor.VisitSetValue(interpreter, v)
+	if !descend {
+		return
 	}
 
-	return nil
+	v.Walk(interpreter, func(value Value) {
+		value.Accept(interpreter, visitor)
+	})
 }
 
-func (v *DictionaryValue) RemoveMember(interpreter *Interpreter, getLocationRange func() LocationRange, _ string) Value {
+func (v *SetValue) Iterate(gauge common.MemoryGauge, f func(element Value) (resume bool)) {
+	err := v.set.IterateKeys(func(element atree.Value) (resume bool, err error) {
+		// atree.OrderedMap iteration provides low-level atree.Value,
+		// convert to high-level interpreter.Value
 
-	if interpreter.invalidatedResourceValidationEnabled {
-		v.checkInvalidatedResourceUse(interpreter, getLocationRange)
+		resume = f(MustConvertStoredValue(gauge, element))
+
+		return resume, nil
+	})
+	if err != nil {
+		panic(errors.NewExternalError(err))
 	}
+}
 
-	// Dictionaries have no removable members (fields / functions)
-	panic(errors.NewUnreachableError())
+func (v *SetValue) Walk(interpreter *Interpreter, walkChild func(Value)) {
+	v.Iterate(interpreter, func(element Value) (resume bool) {
+		walkChild(element)
+		return true
+	})
 }
 
-func (v *DictionaryValue) SetMember(interpreter *Interpreter, getLocationRange func() LocationRange, _ string, _ Value) {
+func (v *SetValue) StaticType(_ *Interpreter) StaticType {
+	// TODO meter
+	return v.Type
+}
 
-	if interpreter.invalidatedResourceValidationEnabled {
-		v.checkInvalidatedResourceUse(interpreter, getLocationRange)
-	}
+func (v *SetValue) IsImportable(inter *Interpreter) bool {
+	importable := true
+	v.Iterate(inter, func(element Value) (resume bool) {
+		if !element.IsImportable(inter) {
+			importable = false
+			// stop iteration
+			return false
+		}
 
-	// Dictionaries have no settable members (fields / functions)
-	panic(errors.NewUnreachableError())
+		// continue iteration
+		return true
+	})
+
+	return importable
 }
 
-func (v *DictionaryValue) Count() int {
-	return int(v.dictionary.Count())
+func (v *SetValue) Contains(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	element Value,
+) BoolValue {
+
+	valueComparator := newValueComparator(interpreter, getLocationRange)
+	hashInputProvider := newHashInputProvider(interpreter, getLocationRange)
+
+	_, err := v.set.Get(
+		valueComparator,
+		hashInputProvider,
+		element,
+	)
+
+	valueGetter := func() bool {
+		if err != nil {
+			if _, ok := err.(*atree.KeyNotFoundError); ok {
+				return false
+			}
+			panic(errors.NewExternalError(err))
+		}
+		return true
+	}
+
+	return NewBoolValueFromConstructor(interpreter, valueGetter)
 }
 
-func (v *DictionaryValue) RemoveKey(
+// Insert inserts the given element into the set,
+// and returns true if the set did not already contain the element.
+//
+func (v *SetValue) Insert(
 	interpreter *Interpreter,
 	getLocationRange func() LocationRange,
-	key Value,
-) Value {
+	element Value,
+) BoolValue {
 
-	if interpreter.invalidatedResourceValidationEnabled {
-		v.checkInvalidatedResourceUse(interpreter, getLocationRange)
+	interpreter.checkContainerMutation(v.Type.ElementType, element, getLocationRange)
+
+	// Only transfer the element if the set does not contain it yet:
+	// atree keeps the existing element, so a transferred duplicate
+	// would never be stored, and its slabs would be orphaned
+
+	if v.Contains(interpreter, getLocationRange, element) {
+		return NewBoolValue(interpreter, false)
+	}
+
+	// length increases by 1
+	dataSlabs, metaDataSlabs := common.AdditionalAtreeMemoryUsage(v.set.Count(), v.elementSize, false)
+	common.UseMemory(interpreter, common.AtreeMapElementOverhead)
+	common.UseMemory(interpreter, dataSlabs)
+	common.UseMemory(interpreter, metaDataSlabs)
+
+	address := v.set.Address()
+
+	element = element.Transfer(
+		interpreter,
+		getLocationRange,
+		address,
+		true,
+		nil,
+	)
+
+	valueComparator := newValueComparator(interpreter, getLocationRange)
+	hashInputProvider := newHashInputProvider(interpreter, getLocationRange)
+
+	interpreter.recordMutation(v)
+
+	_, err := v.set.Set(
+		valueComparator,
+		hashInputProvider,
+		element,
+		VoidValue{},
+	)
+	if err != nil {
+		panic(errors.NewExternalError(err))
 	}
+	interpreter.maybeValidateAtreeValue(v.set)
 
-	return v.Remove(interpreter, getLocationRange, key)
+	return NewBoolValue(interpreter, true)
 }
 
-func (v *DictionaryValue) Remove(
+// Remove removes the given element from the set,
+// and returns true if the set contained the element.
+//
+func (v *SetValue) Remove(
 	interpreter *Interpreter,
 	getLocationRange func() LocationRange,
-	keyValue Value,
-) OptionalValue {
+	element Value,
+) BoolValue {
 
 	valueComparator := newValueComparator(interpreter, getLocationRange)
 	hashInputProvider := newHashInputProvider(interpreter, getLocationRange)
 
-	// No need to clean up storable for passed-in key value,
+	interpreter.recordMutation(v)
+
+	// No need to clean up storable for passed-in element,
 	// as atree never calls Storable()
-	existingKeyStorable, existingValueStorable, err := v.dictionary.Remove(
+	existingElementStorable, _, err := v.set.Remove(
 		valueComparator,
 		hashInputProvider,
-		keyValue,
+		element,
 	)
 	if err != nil {
 		if _, ok := err.(*atree.KeyNotFoundError); ok {
-			return NewNilValue(interpreter)
+			return NewBoolValue(interpreter, false)
 		}
 		panic(errors.NewExternalError(err))
 	}
-	interpreter.maybeValidateAtreeValue(v.dictionary)
+	interpreter.maybeValidateAtreeValue(v.set)
 
-	storage := interpreter.Storage
+	existingElement := StoredValue(interpreter, existingElementStorable, interpreter.Storage)
+	existingElement.DeepRemove(interpreter)
+	interpreter.RemoveReferencedSlab(existingElementStorable)
 
-	// Key
+	return NewBoolValue(interpreter, true)
+}
 
-	existingKeyValue := StoredValue(interpreter, existingKeyStorable, storage)
-	existingKeyValue.DeepRemove(interpreter)
-	interpreter.RemoveReferencedSlab(existingKeyStorable)
+// Union returns a new set containing the elements of this set
+// and the elements of the given set.
+//
+func (v *SetValue) Union(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	other *SetValue,
+) *SetValue {
 
-	// Value
+	result := v.Transfer(
+		interpreter,
+		getLocationRange,
+		atree.Address{},
+		false,
+		nil,
+	).(*SetValue)
 
-	existingValue := StoredValue(interpreter, existingValueStorable, storage).
-		Transfer(
-			interpreter,
-			getLocationRange,
-			atree.Address{},
-			true,
-			existingValueStorable,
-		)
+	other.Iterate(interpreter, func(element Value) (resume bool) {
+		interpreter.ReportComputation(common.ComputationKindIterateSetValue, 1)
 
-	return NewSomeValueNonCopying(interpreter, existingValue)
-}
+		_ = result.Insert(interpreter, getLocationRange, element)
+		return true
+	})
 
-func (v *DictionaryValue) InsertKey(
-	interpreter *Interpreter,
-	getLocationRange func() LocationRange,
-	key, value Value,
-) {
-	v.SetKey(interpreter, getLocationRange, key, value)
+	return result
 }
 
-func (v *DictionaryValue) Insert(
+// Intersection returns a new set containing the elements of this set
+// which are also elements of the given set.
+//
+func (v *SetValue) Intersection(
 	interpreter *Interpreter,
 	getLocationRange func() LocationRange,
-	keyValue, value Value,
-) OptionalValue {
+	other *SetValue,
+) *SetValue {
 
-	// length increases by 1
-	dataSlabs, metaDataSlabs := common.AdditionalAtreeMemoryUsage(v.dictionary.Count(), v.elementSize, false)
-	common.UseMemory(interpreter, common.AtreeMapElementOverhead)
-	common.UseMemory(interpreter, dataSlabs)
-	common.UseMemory(interpreter, metaDataSlabs)
+	result := NewSetValue(interpreter, getLocationRange, v.Type)
 
-	interpreter.checkContainerMutation(v.Type.KeyType, keyValue, getLocationRange)
-	interpreter.checkContainerMutation(v.Type.ValueType, value, getLocationRange)
+	v.Iterate(interpreter, func(element Value) (resume bool) {
+		interpreter.ReportComputation(common.ComputationKindIterateSetValue, 1)
 
-	address := v.dictionary.Address()
+		if other.Contains(interpreter, getLocationRange, element) {
+			_ = result.Insert(interpreter, getLocationRange, element)
+		}
+		return true
+	})
 
-	keyValue = keyValue.Transfer(
-		interpreter,
-		getLocationRange,
-		address,
-		true,
-		nil,
-	)
+	return result
+}
 
-	value = value.Transfer(
-		interpreter,
-		getLocationRange,
-		address,
-		true,
-		nil,
-	)
+func (v *SetValue) String() string {
+	return v.RecursiveString(SeenReferences{})
+}
 
-	valueComparator := newValueComparator(interpreter, getLocationRange)
-	hashInputProvider := newHashInputProvider(interpreter, getLocationRange)
+func (v *SetValue) RecursiveString(seenReferences SeenReferences) string {
+	return v.MeteredString(nil, seenReferences)
+}
 
-	// atree only calls Storable() on keyValue if needed,
-	// i.e., if the key is a new key
-	existingValueStorable, err := v.dictionary.Set(
-		valueComparator,
-		hashInputProvider,
-		keyValue,
-		value,
-	)
-	if err != nil {
-		panic(errors.NewExternalError(err))
-	}
-	interpreter.maybeValidateAtreeValue(v.dictionary)
+func (v *SetValue) MeteredString(memoryGauge common.MemoryGauge, seenReferences SeenReferences) string {
 
-	if existingValueStorable == nil {
-		return NewNilValue(interpreter)
-	}
+	elements := make([]string, 0, v.Count())
 
-	existingValue := StoredValue(interpreter, existingValueStorable, interpreter.Storage).
-		Transfer(
-			interpreter,
-			getLocationRange,
-			atree.Address{},
-			true,
-			existingValueStorable,
-		)
+	v.Iterate(memoryGauge, func(element Value) (resume bool) {
+		elements = append(elements, element.MeteredString(memoryGauge, seenReferences))
+		return true
+	})
 
-	return NewSomeValueNonCopying(interpreter, existingValue)
-}
+	// len = len(open-brace) + len(close-brace) + ((n-1) times comma+space)
+	//     = 2 + 2n - 2
+	//     = 2n
+	//
+	// Since (-2) only occurs if its non-empty (i.e: n>0), ignore the (-2). i.e: overestimate
+	//    len = 2n + 2
+	//
+	// String of each element is metered separately.
+	strLen := len(elements)*2 + 2
 
-type DictionaryEntryValues struct {
-	Key   Value
-	Value Value
+	common.UseMemory(memoryGauge, common.NewRawStringMemoryUsage(strLen))
+
+	return format.Set(elements)
 }
 
-func (v *DictionaryValue) ConformsToStaticType(
+func (v *SetValue) GetMember(
 	interpreter *Interpreter,
-	getLocationRange func() LocationRange,
-	results TypeConformanceResults,
-) bool {
+	_ func() LocationRange,
+	name string,
+) Value {
 
-	count := v.Count()
+	switch name {
+	case "length":
+		return NewIntValueFromInt64(interpreter, int64(v.Count()))
 
-	if interpreter.tracingEnabled {
-		startTime := time.Now()
+	case "contains":
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				return v.Contains(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					invocation.Arguments[0],
+				)
+			},
+			sema.SetElementFunctionType(
+				v.SemaType(interpreter),
+			),
+		)
 
-		typeInfo := v.Type.String()
+	case "insert":
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				return v.Insert(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					invocation.Arguments[0],
+				)
+			},
+			sema.SetElementFunctionType(
+				v.SemaType(interpreter),
+			),
+		)
 
-		defer func() {
-			interpreter.reportDictionaryValueConformsToStaticTypeTrace(
-				typeInfo,
-				count,
-				time.Since(startTime),
-			)
-		}()
-	}
+	case "remove":
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				return v.Remove(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					invocation.Arguments[0],
+				)
+			},
+			sema.SetElementFunctionType(
+				v.SemaType(interpreter),
+			),
+		)
 
-	staticType, ok := v.StaticType(interpreter).(DictionaryStaticType)
-	if !ok {
-		return false
-	}
+	case "union":
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				other, ok := invocation.Arguments[0].(*SetValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
 
-	keyType := staticType.KeyType
-	valueType := staticType.ValueType
+				return v.Union(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					other,
+				)
+			},
+			sema.SetCombineFunctionType(
+				v.SemaType(interpreter),
+			),
+		)
 
-	iterator, err := v.dictionary.Iterator()
-	if err != nil {
-		panic(errors.NewExternalError(err))
+	case "intersection":
+		return NewHostFunctionValue(
+			interpreter,
+			func(invocation Invocation) Value {
+				other, ok := invocation.Arguments[0].(*SetValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				return v.Intersection(
+					invocation.Interpreter,
+					invocation.GetLocationRange,
+					other,
+				)
+			},
+			sema.SetCombineFunctionType(
+				v.SemaType(interpreter),
+			),
+		)
 	}
 
-	for {
-		key, value, err := iterator.Next()
-		if err != nil {
-			panic(errors.NewExternalError(err))
-		}
-		if key == nil {
-			return true
-		}
+	return nil
+}
 
-		// Check the key
+func (*SetValue) RemoveMember(_ *Interpreter, _ func() LocationRange, _ string) Value {
+	// Sets have no removable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
 
-		// atree.OrderedMap iteration provides low-level atree.Value,
-		// convert to high-level interpreter.Value
-		entryKey := MustConvertStoredValue(interpreter, key)
+func (*SetValue) SetMember(_ *Interpreter, _ func() LocationRange, _ string, _ Value) {
+	// Sets have no settable members (fields / functions)
+	panic(errors.NewUnreachableError())
+}
 
-		if !interpreter.IsSubType(entryKey.StaticType(interpreter), keyType) {
-			return false
-		}
+func (v *SetValue) Count() int {
+	return int(v.set.Count())
+}
 
-		if !entryKey.ConformsToStaticType(
-			interpreter,
-			getLocationRange,
-			results,
-		) {
-			return false
-		}
+func (v *SetValue) ConformsToStaticType(
+	interpreter *Interpreter,
+	getLocationRange func() LocationRange,
+	results TypeConformanceResults,
+) bool {
 
-		// Check the value
+	elementType := v.Type.ElementType
 
-		// atree.OrderedMap iteration provides low-level atree.Value,
-		// convert to high-level interpreter.Value
-		entryValue := MustConvertStoredValue(interpreter, value)
+	conforms := true
 
-		if !interpreter.IsSubType(entryValue.StaticType(interpreter), valueType) {
-			return false
-		}
+	v.Iterate(interpreter, func(element Value) (resume bool) {
+		if !interpreter.IsSubType(element.StaticType(interpreter), elementType) ||
+			!element.ConformsToStaticType(interpreter, getLocationRange, results) {
 
-		if !entryValue.ConformsToStaticType(
-			interpreter,
-			getLocationRange,
-			results,
-		) {
+			conforms = false
 			return false
 		}
-	}
+
+		return true
+	})
+
+	return conforms
 }
 
-func (v *DictionaryValue) Equal(interpreter *Interpreter, getLocationRange func() LocationRange, other Value) bool {
+func (v *SetValue) Equal(interpreter *Interpreter, getLocationRange func() LocationRange, other Value) bool {
 
-	otherDictionary, ok := other.(*DictionaryValue)
+	otherSet, ok := other.(*SetValue)
 	if !ok {
 		return false
 	}
 
-	if v.Count() != otherDictionary.Count() {
+	if v.Count() != otherSet.Count() {
 		return false
 	}
 
-	if !v.Type.Equal(otherDictionary.Type) {
+	if !v.Type.Equal(otherSet.Type) {
 		return false
 	}
 
-	iterator, err := v.dictionary.Iterator()
-	if err != nil {
-		panic(errors.NewExternalError(err))
-	}
-
-	for {
-		key, value, err := iterator.Next()
-		if err != nil {
-			panic(errors.NewExternalError(err))
-		}
-		if key == nil {
-			return true
-		}
-
-		// Do NOT use an iterator, as other value may be stored in another account,
-		// leading to a different iteration order, as the storage ID is used in the seed
-		otherValue, otherValueExists :=
-			otherDictionary.Get(
-				interpreter,
-				getLocationRange,
-				MustConvertStoredValue(interpreter, key),
-			)
+	equal := true
 
-		if !otherValueExists {
+	// Do NOT iterate both sets, as the other set may be stored in another account,
+	// leading to a different iteration order, as the storage ID is used in the seed
+	v.Iterate(interpreter, func(element Value) (resume bool) {
+		if !otherSet.Contains(interpreter, getLocationRange, element) {
+			equal = false
 			return false
 		}
+		return true
+	})
 
-		equatableValue, ok := MustConvertStoredValue(interpreter, value).(EquatableValue)
-		if !ok || !equatableValue.Equal(interpreter, getLocationRange, otherValue) {
-			return false
-		}
-	}
+	return equal
 }
 
-func (v *DictionaryValue) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
+func (v *SetValue) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
 	return atree.StorageIDStorable(v.StorageID()), nil
 }
 
-func (v *DictionaryValue) IsReferenceTrackedResourceKindedValue() {}
-
-func (v *DictionaryValue) Transfer(
+func (v *SetValue) Transfer(
 	interpreter *Interpreter,
 	getLocationRange func() LocationRange,
 	address atree.Address,
 	remove bool,
 	storable atree.Storable,
 ) Value {
-	baseUse, elementOverhead, dataUse, metaDataUse := common.NewDictionaryMemoryUsages(
-		v.dictionary.Count(),
+	baseUse, elementOverhead, dataUse, metaDataUse := common.NewSetMemoryUsages(
+		v.set.Count(),
 		v.elementSize,
 	)
 	common.UseMemory(interpreter, baseUse)
@@ -16192,254 +21207,158 @@ func (v *DictionaryValue) Transfer(
 	common.UseMemory(interpreter, dataUse)
 	common.UseMemory(interpreter, metaDataUse)
 
-	interpreter.ReportComputation(common.ComputationKindTransferDictionaryValue, uint(v.Count()))
-
-	if interpreter.invalidatedResourceValidationEnabled {
-		v.checkInvalidatedResourceUse(interpreter, getLocationRange)
-	}
+	interpreter.ReportComputation(common.ComputationKindTransferSetValue, uint(v.Count()))
 
-	if interpreter.tracingEnabled {
-		startTime := time.Now()
+	// Sets never contain resources, so they are always copied
 
-		typeInfo := v.Type.String()
-		count := v.Count()
+	valueComparator := newValueComparator(interpreter, getLocationRange)
+	hashInputProvider := newHashInputProvider(interpreter, getLocationRange)
 
-		defer func() {
-			interpreter.reportDictionaryValueTransferTrace(
-				typeInfo,
-				count,
-				time.Since(startTime),
-			)
-		}()
+	iterator, err := v.set.Iterator()
+	if err != nil {
+		panic(errors.NewExternalError(err))
 	}
 
-	currentStorageID := v.StorageID()
-	currentAddress := currentStorageID.Address
-
-	dictionary := v.dictionary
-
-	needsStoreTo := address != currentAddress
-	isResourceKinded := v.IsResourceKinded(interpreter)
-
-	if needsStoreTo || !isResourceKinded {
-
-		valueComparator := newValueComparator(interpreter, getLocationRange)
-		hashInputProvider := newHashInputProvider(interpreter, getLocationRange)
-
-		iterator, err := v.dictionary.Iterator()
-		if err != nil {
-			panic(errors.NewExternalError(err))
-		}
-
-		elementMemoryUse := common.NewAtreeMapPreAllocatedElementsMemoryUsage(v.dictionary.Count(), v.elementSize)
-		common.UseMemory(interpreter.memoryGauge, elementMemoryUse)
-
-		dictionary, err = atree.NewMapFromBatchData(
-			interpreter.Storage,
-			address,
-			atree.NewDefaultDigesterBuilder(),
-			v.dictionary.Type(),
-			valueComparator,
-			hashInputProvider,
-			v.dictionary.Seed(),
-			func() (atree.Value, atree.Value, error) {
-
-				atreeKey, atreeValue, err := iterator.Next()
-				if err != nil {
-					return nil, nil, err
-				}
-				if atreeKey == nil || atreeValue == nil {
-					return nil, nil, nil
-				}
-
-				key := MustConvertStoredValue(interpreter, atreeKey).
-					Transfer(interpreter, getLocationRange, address, remove, nil)
-
-				value := MustConvertStoredValue(interpreter, atreeValue).
-					Transfer(interpreter, getLocationRange, address, remove, nil)
+	elementMemoryUse := common.NewAtreeMapPreAllocatedElementsMemoryUsage(v.set.Count(), v.elementSize)
+	common.UseMemory(interpreter.memoryGauge, elementMemoryUse)
 
-				return key, value, nil
-			},
-		)
-		if err != nil {
-			panic(errors.NewExternalError(err))
-		}
+	set, err := atree.NewMapFromBatchData(
+		interpreter.Storage,
+		address,
+		atree.NewDefaultDigesterBuilder(),
+		v.set.Type(),
+		valueComparator,
+		hashInputProvider,
+		v.set.Seed(),
+		func() (atree.Value, atree.Value, error) {
 
-		if remove {
-			err = v.dictionary.PopIterate(func(keyStorable atree.Storable, valueStorable atree.Storable) {
-				interpreter.RemoveReferencedSlab(keyStorable)
-				interpreter.RemoveReferencedSlab(valueStorable)
-			})
+			atreeElement, err := iterator.NextKey()
 			if err != nil {
-				panic(errors.NewExternalError(err))
+				return nil, nil, err
+			}
+			if atreeElement == nil {
+				return nil, nil, nil
 			}
-			interpreter.maybeValidateAtreeValue(v.dictionary)
-
-			interpreter.RemoveReferencedSlab(storable)
-		}
-	}
 
-	var res *DictionaryValue
+			element := MustConvertStoredValue(interpreter, atreeElement).
+				Transfer(interpreter, getLocationRange, address, remove, nil)
 
-	if isResourceKinded {
-		// Update the resource in-place,
-		// and also update all values that are referencing the same value
-		// (but currently point to an outdated Go instance of the value)
+			return element, VoidValue{}, nil
+		},
+	)
+	if err != nil {
+		panic(errors.NewExternalError(err))
+	}
 
-		// If checking of transfers of invalidated resource is enabled,
-		// then mark the resource array as invalidated, by unsetting the backing array.
-		// This allows raising an error when the resource array is attempted
-		// to be transferred/moved again (see beginning of this function)
+	if remove {
+		interpreter.recordMutation(v)
 
-		if interpreter.invalidatedResourceValidationEnabled {
-			v.dictionary = nil
-		} else {
-			v.dictionary = dictionary
-			res = v
+		err = v.set.PopIterate(func(elementStorable atree.Storable, _ atree.Storable) {
+			interpreter.RemoveReferencedSlab(elementStorable)
+		})
+		if err != nil {
+			panic(errors.NewExternalError(err))
 		}
+		interpreter.maybeValidateAtreeValue(v.set)
 
-		newStorageID := dictionary.StorageID()
-
-		interpreter.updateReferencedResource(
-			currentStorageID,
-			newStorageID,
-			func(value ReferenceTrackedResourceKindedValue) {
-				dictionaryValue, ok := value.(*DictionaryValue)
-				if !ok {
-					panic(errors.NewUnreachableError())
-				}
-				dictionaryValue.dictionary = dictionary
-			},
-		)
+		interpreter.RemoveReferencedSlab(storable)
 	}
 
-	if res == nil {
-		res = newDictionaryValueFromOrderedMap(dictionary, v.Type)
-		res.elementSize = v.elementSize
-		res.semaType = v.semaType
-		res.isResourceKinded = v.isResourceKinded
-		res.isDestroyed = v.isDestroyed
-	}
+	res := newSetValueFromOrderedMap(set, v.Type)
+	res.elementSize = v.elementSize
+	res.semaType = v.semaType
 
 	return res
 }
 
-func (v *DictionaryValue) Clone(interpreter *Interpreter) Value {
+func (v *SetValue) Clone(interpreter *Interpreter) Value {
 
 	valueComparator := newValueComparator(interpreter, ReturnEmptyLocationRange)
 	hashInputProvider := newHashInputProvider(interpreter, ReturnEmptyLocationRange)
 
-	iterator, err := v.dictionary.Iterator()
+	iterator, err := v.set.Iterator()
 	if err != nil {
 		panic(errors.NewExternalError(err))
 	}
 
-	elementMemoryUse := common.NewAtreeMapPreAllocatedElementsMemoryUsage(v.dictionary.Count(), v.elementSize)
-	common.UseMemory(interpreter.memoryGauge, elementMemoryUse)
-
-	dictionary, err := atree.NewMapFromBatchData(
+	set, err := atree.NewMapFromBatchData(
 		interpreter.Storage,
 		v.StorageID().Address,
 		atree.NewDefaultDigesterBuilder(),
-		v.dictionary.Type(),
+		v.set.Type(),
 		valueComparator,
 		hashInputProvider,
-		v.dictionary.Seed(),
+		v.set.Seed(),
 		func() (atree.Value, atree.Value, error) {
 
-			atreeKey, atreeValue, err := iterator.Next()
+			atreeElement, err := iterator.NextKey()
 			if err != nil {
 				return nil, nil, err
 			}
-			if atreeKey == nil || atreeValue == nil {
+			if atreeElement == nil {
 				return nil, nil, nil
 			}
 
-			key := MustConvertStoredValue(interpreter, atreeKey).
-				Clone(interpreter)
-
-			value := MustConvertStoredValue(interpreter, atreeValue).
+			element := MustConvertStoredValue(interpreter, atreeElement).
 				Clone(interpreter)
 
-			return key, value, nil
+			return element, VoidValue{}, nil
 		},
 	)
 	if err != nil {
 		panic(errors.NewExternalError(err))
 	}
 
-	return &DictionaryValue{
-		Type:             v.Type,
-		semaType:         v.semaType,
-		isResourceKinded: v.isResourceKinded,
-		dictionary:       dictionary,
-		isDestroyed:      v.isDestroyed,
+	return &SetValue{
+		Type:        v.Type,
+		semaType:    v.semaType,
+		set:         set,
+		elementSize: v.elementSize,
 	}
 }
 
-func (v *DictionaryValue) DeepRemove(interpreter *Interpreter) {
-
-	if interpreter.tracingEnabled {
-		startTime := time.Now()
-
-		typeInfo := v.Type.String()
-		count := v.Count()
+func (v *SetValue) DeepRemove(interpreter *Interpreter) {
 
-		defer func() {
-			interpreter.reportDictionaryValueDeepRemoveTrace(
-				typeInfo,
-				count,
-				time.Since(startTime),
-			)
-		}()
-	}
+	interpreter.recordMutation(v)
 
 	// Remove nested values and storables
 
-	storage := v.dictionary.Storage
-
-	err := v.dictionary.PopIterate(func(keyStorable atree.Storable, valueStorable atree.Storable) {
+	storage := v.set.Storage
 
-		key := StoredValue(interpreter, keyStorable, storage)
-		key.DeepRemove(interpreter)
-		interpreter.RemoveReferencedSlab(keyStorable)
+	err := v.set.PopIterate(func(elementStorable atree.Storable, _ atree.Storable) {
 
-		value := StoredValue(interpreter, valueStorable, storage)
-		value.DeepRemove(interpreter)
-		interpreter.RemoveReferencedSlab(valueStorable)
+		element := StoredValue(interpreter, elementStorable, storage)
+		element.DeepRemove(interpreter)
+		interpreter.RemoveReferencedSlab(elementStorable)
 	})
 	if err != nil {
 		panic(errors.NewExternalError(err))
 	}
-	interpreter.maybeValidateAtreeValue(v.dictionary)
+	interpreter.maybeValidateAtreeValue(v.set)
 }
 
-func (v *DictionaryValue) GetOwner() common.Address {
+func (v *SetValue) GetOwner() common.Address {
 	return common.Address(v.StorageID().Address)
 }
 
-func (v *DictionaryValue) StorageID() atree.StorageID {
-	return v.dictionary.StorageID()
+func (v *SetValue) StorageID() atree.StorageID {
+	return v.set.StorageID()
 }
 
-func (v *DictionaryValue) SemaType(interpreter *Interpreter) *sema.DictionaryType {
+func (v *SetValue) SemaType(interpreter *Interpreter) *sema.SetType {
 	if v.semaType == nil {
 		// this function will panic already if this conversion fails
-		v.semaType, _ = interpreter.MustConvertStaticToSemaType(v.Type).(*sema.DictionaryType)
+		v.semaType, _ = interpreter.MustConvertStaticToSemaType(v.Type).(*sema.SetType)
 	}
 	return v.semaType
 }
 
-func (v *DictionaryValue) NeedsStoreTo(address atree.Address) bool {
+func (v *SetValue) NeedsStoreTo(address atree.Address) bool {
 	return address != v.StorageID().Address
 }
 
-func (v *DictionaryValue) IsResourceKinded(interpreter *Interpreter) bool {
-	if v.isResourceKinded == nil {
-		isResourceKinded := v.SemaType(interpreter).IsResourceType()
-		v.isResourceKinded = &isResourceKinded
-	}
-	return *v.isResourceKinded
+func (*SetValue) IsResourceKinded(_ *Interpreter) bool {
+	return false
 }
 
 // OptionalValue
@@ -16673,6 +21592,8 @@ func (v *SomeValue) Destroy(interpreter *Interpreter, getLocationRange func() Lo
 
 	innerValue := v.InnerValue(interpreter, getLocationRange)
 
+	interpreter.recordMutation(v)
+
 	maybeDestroy(interpreter, getLocationRange, innerValue)
 	v.isDestroyed = true
 
@@ -16854,6 +21775,10 @@ func (v *SomeValue) Transfer(
 	needsStoreTo := v.NeedsStoreTo(address)
 	isResourceKinded := v.IsResourceKinded(interpreter)
 
+	if remove || isResourceKinded {
+		interpreter.recordMutation(v)
+	}
+
 	if needsStoreTo || !isResourceKinded {
 
 		innerValue = v.value.Transfer(interpreter, getLocationRange, address, remove, nil)
@@ -17561,7 +22486,6 @@ func (v *EphemeralReferenceValue) ConformsToStaticType(
 
 	entry := typeConformanceResultEntry{
 		EphemeralReferenceValue: v,
-		EphemeralReferenceType:  staticType,
 	}
 
 	if result, contains := results[entry]; contains {
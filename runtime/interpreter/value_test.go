@@ -920,6 +920,14 @@ func TestStringer(t *testing.T) {
 			value:    NewUnmeteredWord64Value(64),
 			expected: "64",
 		},
+		"Word128": {
+			value:    NewUnmeteredWord128ValueFromUint64(128),
+			expected: "128",
+		},
+		"Word256": {
+			value:    NewUnmeteredWord256ValueFromUint64(256),
+			expected: "256",
+		},
 		"UFix64": {
 			value:    NewUnmeteredUFix64ValueWithInteger(64),
 			expected: "64.00000000",
@@ -1401,6 +1409,30 @@ func TestGetHashInput(t *testing.T) {
 			value:    NewUnmeteredWord64Value(math.MaxUint64),
 			expected: []byte{byte(HashInputTypeWord64), 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
 		},
+		"Word128": {
+			value:    NewUnmeteredWord128ValueFromUint64(128),
+			expected: []byte{byte(HashInputTypeWord128), 128},
+		},
+		"Word128 min": {
+			value:    NewUnmeteredWord128ValueFromUint64(0),
+			expected: append([]byte{byte(HashInputTypeWord128)}, 0),
+		},
+		"Word128 max": {
+			value:    NewUnmeteredWord128ValueFromBigInt(sema.Word128TypeMaxIntBig),
+			expected: append([]byte{byte(HashInputTypeWord128)}, sema.Word128TypeMaxIntBig.Bytes()...),
+		},
+		"Word256": {
+			value:    NewUnmeteredWord256ValueFromUint64(256),
+			expected: []byte{byte(HashInputTypeWord256), 1, 0},
+		},
+		"Word256 min": {
+			value:    NewUnmeteredWord256ValueFromUint64(0),
+			expected: append([]byte{byte(HashInputTypeWord256)}, 0),
+		},
+		"Word256 max": {
+			value:    NewUnmeteredWord256ValueFromBigInt(sema.Word256TypeMaxIntBig),
+			expected: append([]byte{byte(HashInputTypeWord256)}, sema.Word256TypeMaxIntBig.Bytes()...),
+		},
 		"UFix64": {
 			value:    NewUnmeteredUFix64ValueWithInteger(64),
 			expected: []byte{byte(HashInputTypeUFix64), 0x0, 0x0, 0x0, 0x1, 0x7d, 0x78, 0x40, 0x0},
@@ -1425,6 +1457,14 @@ func TestGetHashInput(t *testing.T) {
 			value:    NewUnmeteredFix64ValueWithInteger(sema.Fix64TypeMaxInt),
 			expected: []byte{byte(HashInputTypeFix64), 0x7f, 0xff, 0xff, 0xff, 0xfc, 0xbc, 0x30, 0x00},
 		},
+		"UFix128": {
+			value:    NewUnmeteredUFix128Value(big.NewInt(64)),
+			expected: []byte{byte(HashInputTypeUFix128), 64},
+		},
+		"Fix128": {
+			value:    NewUnmeteredFix128Value(big.NewInt(-32)),
+			expected: []byte{byte(HashInputTypeFix128), 0xe0},
+		},
 		"true": {
 			value:    BoolValue(true),
 			expected: []byte{byte(HashInputTypeBool), 1},
@@ -1585,12 +1625,12 @@ func TestBlockValue(t *testing.T) {
 			ByteArrayStaticType,
 			common.Address{},
 		),
-		5.0,
+		5,
 	)
 
 	// static type test
 	var actualTs = block.Fields[sema.BlockTypeTimestampFieldName]
-	const expectedTs UFix64Value = 5.0
+	const expectedTs TimestampValue = 5
 	assert.Equal(t, expectedTs, actualTs)
 }
 
@@ -3182,6 +3222,8 @@ func TestNumberValue_Equal(t *testing.T) {
 		"Word16":  NewUnmeteredWord16Value(16),
 		"Word32":  NewUnmeteredWord32Value(32),
 		"Word64":  NewUnmeteredWord64Value(64),
+		"Word128": NewUnmeteredWord128ValueFromUint64(128),
+		"Word256": NewUnmeteredWord256ValueFromUint64(256),
 		"UFix64":  NewUnmeteredUFix64ValueWithInteger(64),
 		"Fix64":   NewUnmeteredFix64ValueWithInteger(-32),
 	}
@@ -3553,6 +3595,8 @@ func TestNumberValueIntegerConversion(t *testing.T) {
 		sema.Word16Type:  NewUnmeteredWord16Value(42),
 		sema.Word32Type:  NewUnmeteredWord32Value(42),
 		sema.Word64Type:  NewUnmeteredWord64Value(42),
+		sema.Word128Type: NewUnmeteredWord128ValueFromUint64(42),
+		sema.Word256Type: NewUnmeteredWord256ValueFromUint64(42),
 		sema.Int8Type:    NewUnmeteredInt8Value(42),
 		sema.Int16Type:   NewUnmeteredInt16Value(42),
 		sema.Int32Type:   NewUnmeteredInt32Value(42),
@@ -3852,6 +3896,8 @@ func TestValue_ConformsToStaticType(t *testing.T) {
 			sema.Word16Type:  NewUnmeteredWord16Value(42),
 			sema.Word32Type:  NewUnmeteredWord32Value(42),
 			sema.Word64Type:  NewUnmeteredWord64Value(42),
+			sema.Word128Type: NewUnmeteredWord128ValueFromUint64(42),
+			sema.Word256Type: NewUnmeteredWord256ValueFromUint64(42),
 			sema.Int8Type:    NewUnmeteredInt8Value(42),
 			sema.Int16Type:   NewUnmeteredInt16Value(42),
 			sema.Int32Type:   NewUnmeteredInt32Value(42),
@@ -3888,8 +3934,10 @@ func TestValue_ConformsToStaticType(t *testing.T) {
 		t.Parallel()
 
 		testCases := map[*sema.FixedPointNumericType]NumberValue{
-			sema.UFix64Type: NewUnmeteredUFix64ValueWithInteger(42),
-			sema.Fix64Type:  NewUnmeteredFix64ValueWithInteger(42),
+			sema.UFix64Type:  NewUnmeteredUFix64ValueWithInteger(42),
+			sema.Fix64Type:   NewUnmeteredFix64ValueWithInteger(42),
+			sema.UFix128Type: NewUnmeteredUFix128ValueWithInteger(big.NewInt(42)),
+			sema.Fix128Type:  NewUnmeteredFix128ValueWithInteger(big.NewInt(42)),
 		}
 
 		for _, ty := range sema.AllFixedPointTypes {
@@ -22,7 +22,10 @@
 
 package interpreter
 
-import "github.com/onflow/cadence/runtime/common"
+import (
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+)
 
 // A VariableActivation is a map of strings to values.
 // It can be used to represent an active scope in a program,
@@ -35,20 +38,27 @@ type VariableActivation struct {
 	Parent      *VariableActivation
 	isFunction  bool
 	memoryGauge common.MemoryGauge
+	// typeArguments are the types bound to the type parameters
+	// of the invoked generic functions and composites.
+	// They are inherited by nested activations
+	typeArguments *sema.TypeParameterTypeOrderedMap
 }
 
 func NewVariableActivation(memoryGauge common.MemoryGauge, parent *VariableActivation) *VariableActivation {
 	var depth int
+	var typeArguments *sema.TypeParameterTypeOrderedMap
 	if parent != nil {
 		depth = parent.Depth + 1
+		typeArguments = parent.typeArguments
 	}
 
 	common.UseMemory(memoryGauge, common.ActivationMemoryUsage)
 
 	return &VariableActivation{
-		Depth:       depth,
-		Parent:      parent,
-		memoryGauge: memoryGauge,
+		Depth:         depth,
+		Parent:        parent,
+		memoryGauge:   memoryGauge,
+		typeArguments: typeArguments,
 	}
 }
 
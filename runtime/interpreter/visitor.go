@@ -44,10 +44,17 @@ type Visitor interface {
 	VisitWord16Value(interpreter *Interpreter, value Word16Value)
 	VisitWord32Value(interpreter *Interpreter, value Word32Value)
 	VisitWord64Value(interpreter *Interpreter, value Word64Value)
+	VisitWord128Value(interpreter *Interpreter, value Word128Value)
+	VisitWord256Value(interpreter *Interpreter, value Word256Value)
 	VisitFix64Value(interpreter *Interpreter, value Fix64Value)
 	VisitUFix64Value(interpreter *Interpreter, value UFix64Value)
+	VisitFix128Value(interpreter *Interpreter, value Fix128Value)
+	VisitUFix128Value(interpreter *Interpreter, value UFix128Value)
+	VisitTimestampValue(interpreter *Interpreter, value TimestampValue)
+	VisitDurationValue(interpreter *Interpreter, value DurationValue)
 	VisitCompositeValue(interpreter *Interpreter, value *CompositeValue) bool
 	VisitDictionaryValue(interpreter *Interpreter, value *DictionaryValue) bool
+	VisitSetValue(interpreter *Interpreter, value *SetValue) bool
 	VisitNilValue(interpreter *Interpreter, value NilValue)
 	VisitSomeValue(interpreter *Interpreter, value *SomeValue) bool
 	VisitStorageReferenceValue(interpreter *Interpreter, value *StorageReferenceValue)
@@ -87,10 +94,17 @@ type EmptyVisitor struct {
 	Word16ValueVisitor              func(interpreter *Interpreter, value Word16Value)
 	Word32ValueVisitor              func(interpreter *Interpreter, value Word32Value)
 	Word64ValueVisitor              func(interpreter *Interpreter, value Word64Value)
+	Word128ValueVisitor             func(interpreter *Interpreter, value Word128Value)
+	Word256ValueVisitor             func(interpreter *Interpreter, value Word256Value)
 	Fix64ValueVisitor               func(interpreter *Interpreter, value Fix64Value)
 	UFix64ValueVisitor              func(interpreter *Interpreter, value UFix64Value)
+	Fix128ValueVisitor              func(interpreter *Interpreter, value Fix128Value)
+	UFix128ValueVisitor             func(interpreter *Interpreter, value UFix128Value)
+	TimestampValueVisitor           func(interpreter *Interpreter, value TimestampValue)
+	DurationValueVisitor            func(interpreter *Interpreter, value DurationValue)
 	CompositeValueVisitor           func(interpreter *Interpreter, value *CompositeValue) bool
 	DictionaryValueVisitor          func(interpreter *Interpreter, value *DictionaryValue) bool
+	SetValueVisitor                 func(interpreter *Interpreter, value *SetValue) bool
 	NilValueVisitor                 func(interpreter *Interpreter, value NilValue)
 	SomeValueVisitor                func(interpreter *Interpreter, value *SomeValue) bool
 	StorageReferenceValueVisitor    func(interpreter *Interpreter, value *StorageReferenceValue)
@@ -281,6 +295,20 @@ func (v EmptyVisitor) VisitWord64Value(interpreter *Interpreter, value Word64Val
 	v.Word64ValueVisitor(interpreter, value)
 }
 
+func (v EmptyVisitor) VisitWord128Value(interpreter *Interpreter, value Word128Value) {
+	if v.Word128ValueVisitor == nil {
+		return
+	}
+	v.Word128ValueVisitor(interpreter, value)
+}
+
+func (v EmptyVisitor) VisitWord256Value(interpreter *Interpreter, value Word256Value) {
+	if v.Word256ValueVisitor == nil {
+		return
+	}
+	v.Word256ValueVisitor(interpreter, value)
+}
+
 func (v EmptyVisitor) VisitFix64Value(interpreter *Interpreter, value Fix64Value) {
 	if v.Fix64ValueVisitor == nil {
 		return
@@ -295,6 +323,34 @@ func (v EmptyVisitor) VisitUFix64Value(interpreter *Interpreter, value UFix64Val
 	v.UFix64ValueVisitor(interpreter, value)
 }
 
+func (v EmptyVisitor) VisitFix128Value(interpreter *Interpreter, value Fix128Value) {
+	if v.Fix128ValueVisitor == nil {
+		return
+	}
+	v.Fix128ValueVisitor(interpreter, value)
+}
+
+func (v EmptyVisitor) VisitUFix128Value(interpreter *Interpreter, value UFix128Value) {
+	if v.UFix128ValueVisitor == nil {
+		return
+	}
+	v.UFix128ValueVisitor(interpreter, value)
+}
+
+func (v EmptyVisitor) VisitTimestampValue(interpreter *Interpreter, value TimestampValue) {
+	if v.TimestampValueVisitor == nil {
+		return
+	}
+	v.TimestampValueVisitor(interpreter, value)
+}
+
+func (v EmptyVisitor) VisitDurationValue(interpreter *Interpreter, value DurationValue) {
+	if v.DurationValueVisitor == nil {
+		return
+	}
+	v.DurationValueVisitor(interpreter, value)
+}
+
 func (v EmptyVisitor) VisitCompositeValue(interpreter *Interpreter, value *CompositeValue) bool {
 	if v.CompositeValueVisitor == nil {
 		return true
@@ -309,6 +365,13 @@ func (v EmptyVisitor) VisitDictionaryValue(interpreter *Interpreter, value *Dict
 	return v.DictionaryValueVisitor(interpreter, value)
 }
 
+func (v EmptyVisitor) VisitSetValue(interpreter *Interpreter, value *SetValue) bool {
+	if v.SetValueVisitor == nil {
+		return true
+	}
+	return v.SetValueVisitor(interpreter, value)
+}
+
 func (v EmptyVisitor) VisitNilValue(interpreter *Interpreter, value NilValue) {
 	if v.NilValueVisitor == nil {
 		return
@@ -125,6 +125,24 @@ func arrayLiteralValue(inter *interpreter.Interpreter, elements []ast.Expression
 		})
 }
 
+func setLiteralValue(inter *interpreter.Interpreter, elements []ast.Expression, elementType sema.Type) (cadence.Value, error) {
+	return cadence.NewMeteredSet(
+		inter,
+		func() ([]cadence.Value, error) {
+			values := make([]cadence.Value, len(elements))
+
+			for i, element := range elements {
+				convertedElement, err := LiteralValue(inter, element, elementType)
+				if err != nil {
+					return nil, err
+				}
+				values[i] = convertedElement
+			}
+
+			return values, nil
+		})
+}
+
 func pathLiteralValue(memoryGauge common.MemoryGauge, expression ast.Expression, ty sema.Type) (result cadence.Value, errResult error) {
 	pathExpression, ok := expression.(*ast.PathExpression)
 	if !ok {
@@ -244,6 +262,10 @@ func convertIntValue(
 		return interpreter.ConvertWord32(memoryGauge, intValue), nil
 	case sema.Word64Type:
 		return interpreter.ConvertWord64(memoryGauge, intValue), nil
+	case sema.Word128Type:
+		return interpreter.ConvertWord128(memoryGauge, intValue), nil
+	case sema.Word256Type:
+		return interpreter.ConvertWord256(memoryGauge, intValue), nil
 
 	default:
 		return nil, UnsupportedLiteralError
@@ -260,15 +282,24 @@ func fixedPointLiteralValue(memoryGauge common.MemoryGauge, expression ast.Expre
 		return nil, InvalidLiteralError
 	}
 
-	// TODO: adjust once/if we support more fixed point types
+	switch ty {
+	case sema.Fix128Type:
+		return cadence.NewMeteredFix128FromBig(
+			memoryGauge,
+			func() *big.Int {
+				return fixedPointBigIntLiteralValue(fixedPointExpression, sema.Fix128Scale)
+			},
+		)
+	case sema.UFix128Type:
+		return cadence.NewMeteredUFix128FromBig(
+			memoryGauge,
+			func() *big.Int {
+				return fixedPointBigIntLiteralValue(fixedPointExpression, sema.Fix128Scale)
+			},
+		)
+	}
 
-	value := fixedpoint.ConvertToFixedPointBigInt(
-		fixedPointExpression.Negative,
-		fixedPointExpression.UnsignedInteger,
-		fixedPointExpression.Fractional,
-		fixedPointExpression.Scale,
-		sema.Fix64Scale,
-	)
+	value := fixedPointBigIntLiteralValue(fixedPointExpression, sema.Fix64Scale)
 
 	switch ty {
 	case sema.Fix64Type, sema.FixedPointType, sema.SignedFixedPointType:
@@ -280,6 +311,16 @@ func fixedPointLiteralValue(memoryGauge common.MemoryGauge, expression ast.Expre
 	return nil, UnsupportedLiteralError
 }
 
+func fixedPointBigIntLiteralValue(expression *ast.FixedPointExpression, scale uint) *big.Int {
+	return fixedpoint.ConvertToFixedPointBigInt(
+		expression.Negative,
+		expression.UnsignedInteger,
+		expression.Fractional,
+		expression.Scale,
+		scale,
+	)
+}
+
 func LiteralValue(inter *interpreter.Interpreter, expression ast.Expression, ty sema.Type) (cadence.Value, error) {
 	switch ty := ty.(type) {
 	case *sema.VariableSizedType:
@@ -298,6 +339,14 @@ func LiteralValue(inter *interpreter.Interpreter, expression ast.Expression, ty
 
 		return arrayLiteralValue(inter, expression.Values, ty.Type)
 
+	case *sema.SetType:
+		expression, ok := expression.(*ast.ArrayExpression)
+		if !ok {
+			return nil, LiteralExpressionTypeError
+		}
+
+		return setLiteralValue(inter, expression.Values, ty.ElementType)
+
 	case *sema.OptionalType:
 		if _, ok := expression.(*ast.NilExpression); ok {
 			return cadence.NewMeteredOptional(inter, nil), nil
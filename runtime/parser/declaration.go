@@ -92,6 +92,12 @@ func parseDeclaration(p *parser, docString string) (ast.Declaration, error) {
 			case keywordStruct, keywordResource, keywordContract, keywordEnum:
 				return parseCompositeOrInterfaceDeclaration(p, access, accessPos, docString)
 
+			case keywordTypeAlias:
+				return parseTypeAliasDeclaration(p, access, accessPos, docString)
+
+			case keywordNewtype:
+				return parseNewtypeDeclaration(p, access, accessPos, docString)
+
 			case KeywordTransaction:
 				if access != ast.AccessNotSpecified {
 					return nil, p.syntaxError("invalid access modifier for transaction")
@@ -273,21 +279,34 @@ func parseVariableDeclaration(
 	p.next()
 
 	p.skipSpaceAndComments(true)
-	if !p.current.Is(lexer.TokenIdentifier) {
+
+	var identifier ast.Identifier
+	var pattern ast.Pattern
+	var err error
+
+	switch p.current.Type {
+	case lexer.TokenIdentifier:
+		identifier = p.tokenToIdentifier(p.current)
+
+		// Skip the identifier
+		p.next()
+
+	case lexer.TokenBracketOpen, lexer.TokenBraceOpen, lexer.TokenParenOpen:
+		pattern, err = parsePattern(p)
+		if err != nil {
+			return nil, err
+		}
+
+	default:
 		return nil, p.syntaxError(
 			"expected identifier after start of variable declaration, got %s",
 			p.current.Type,
 		)
 	}
 
-	identifier := p.tokenToIdentifier(p.current)
-
-	// Skip the identifier
-	p.next()
 	p.skipSpaceAndComments(true)
 
 	var typeAnnotation *ast.TypeAnnotation
-	var err error
 
 	if p.current.Is(lexer.TokenColon) {
 		// Skip the colon
@@ -327,6 +346,7 @@ func parseVariableDeclaration(
 		access,
 		isLet,
 		identifier,
+		pattern,
 		typeAnnotation,
 		value,
 		transfer,
@@ -616,6 +636,25 @@ func parseImportDeclaration(p *parser) (*ast.ImportDeclaration, error) {
 		)
 	}
 
+	hash, err := parseImportHash(p)
+	if err != nil {
+		return nil, err
+	}
+	if hash != nil {
+		endPos = hash.EndPos
+
+		// An address import resolves to a separate program for each imported identifier,
+		// so a single hash can only be verified if exactly one identifier is imported
+
+		if _, ok := location.(common.AddressLocation); ok && len(identifiers) != 1 {
+			p.report(NewSyntaxError(
+				hash.StartPos,
+				"invalid import hash: import from address must import exactly one declaration, got %d",
+				len(identifiers),
+			))
+		}
+	}
+
 	return ast.NewImportDeclaration(
 		p.memoryGauge,
 		identifiers,
@@ -626,9 +665,108 @@ func parseImportDeclaration(p *parser) (*ast.ImportDeclaration, error) {
 			endPos,
 		),
 		locationPos,
+		hash,
 	), nil
 }
 
+// parseImportHash parses the optional hash of an import declaration,
+// e.g. `with hash "sha3-256:..."`.
+//
+// The hash must start on the same line as the location.
+// This avoids having to look ahead past newlines and comments,
+// which may belong to the following declaration.
+//
+func parseImportHash(p *parser) (*ast.ImportHash, error) {
+
+	if p.current.Is(lexer.TokenSpace) {
+		space, ok := p.current.Value.(lexer.Space)
+		// we just checked that this is a space
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		if space.ContainsNewline {
+			return nil, nil
+		}
+
+		p.next()
+	}
+
+	if !p.current.IsString(lexer.TokenIdentifier, keywordWith) {
+		return nil, nil
+	}
+
+	startPos := p.current.StartPos
+
+	// Skip the `with` keyword
+	p.next()
+	p.skipSpaceAndComments(true)
+
+	if !p.current.IsString(lexer.TokenIdentifier, keywordHash) {
+		return nil, p.syntaxError(
+			"expected keyword %q, got %s",
+			keywordHash,
+			p.current.Type,
+		)
+	}
+
+	// Skip the `hash` keyword
+	p.next()
+	p.skipSpaceAndComments(true)
+
+	if !p.current.Is(lexer.TokenString) {
+		return nil, p.syntaxError(
+			"expected string for import hash, got %s",
+			p.current.Type,
+		)
+	}
+
+	endPos := p.current.EndPos
+
+	literal := parseStringLiteral(p, p.current.Value.(string))
+
+	var digest []byte
+
+	algorithm, encodedDigest, ok := strings.Cut(literal, ":")
+	if !ok {
+		p.reportSyntaxError(
+			"invalid import hash: expected format %q",
+			"<algorithm>:<digest>",
+		)
+	} else if algorithm != ast.ImportHashAlgorithmSHA3_256 {
+		p.reportSyntaxError(
+			"invalid import hash: unsupported algorithm %q, expected %q",
+			algorithm,
+			ast.ImportHashAlgorithmSHA3_256,
+		)
+	} else {
+		var err error
+		digest, err = hex.DecodeString(encodedDigest)
+		if err != nil || len(digest) != sha3_256DigestLength {
+			p.reportSyntaxError(
+				"invalid import hash: expected %d hex-encoded bytes",
+				sha3_256DigestLength,
+			)
+		}
+	}
+
+	// Skip the hash string
+	p.next()
+
+	return ast.NewImportHash(
+		p.memoryGauge,
+		algorithm,
+		digest,
+		ast.NewRange(
+			p.memoryGauge,
+			startPos,
+			endPos,
+		),
+	), nil
+}
+
+const sha3_256DigestLength = 32
+
 // isNextTokenCommaOrFrom check whether the token to follow is a comma or a from token.
 func isNextTokenCommaOrFrom(p *parser) (b bool, err error) {
 	p.startBuffering()
@@ -678,6 +816,65 @@ func parseHexadecimalLocation(p *parser) common.AddressLocation {
 	return common.NewAddressLocation(p.memoryGauge, address, "")
 }
 
+// parseTypeAliasDeclaration parses a type alias declaration.
+//
+//     typeAliasDeclaration : 'typealias' identifier '=' type
+//
+func parseTypeAliasDeclaration(
+	p *parser,
+	access ast.Access,
+	accessPos *ast.Position,
+	docString string,
+) (*ast.TypeAliasDeclaration, error) {
+
+	startPos := p.current.StartPos
+	if accessPos != nil {
+		startPos = *accessPos
+	}
+
+	// Skip the `typealias` keyword
+	p.next()
+
+	p.skipSpaceAndComments(true)
+	if !p.current.Is(lexer.TokenIdentifier) {
+		return nil, p.syntaxError(
+			"expected identifier after start of type alias declaration, got %s",
+			p.current.Type,
+		)
+	}
+
+	identifier := p.tokenToIdentifier(p.current)
+
+	// Skip the identifier
+	p.next()
+	p.skipSpaceAndComments(true)
+
+	_, err := p.mustOne(lexer.TokenEqual)
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpaceAndComments(true)
+
+	aliasedType, err := parseType(p, lowestBindingPower)
+	if err != nil {
+		return nil, err
+	}
+
+	return ast.NewTypeAliasDeclaration(
+		p.memoryGauge,
+		access,
+		identifier,
+		aliasedType,
+		docString,
+		ast.NewRange(
+			p.memoryGauge,
+			startPos,
+			aliasedType.EndPosition(p.memoryGauge),
+		),
+	), nil
+}
+
 // parseEventDeclaration parses an event declaration.
 //
 //     eventDeclaration : 'event' identifier parameterList
@@ -721,6 +918,7 @@ func parseEventDeclaration(
 			p.memoryGauge,
 			ast.AccessNotSpecified,
 			ast.NewEmptyIdentifier(p.memoryGauge, ast.EmptyPosition),
+			nil,
 			parameterList,
 			nil,
 			nil,
@@ -742,6 +940,7 @@ func parseEventDeclaration(
 		common.CompositeKindEvent,
 		identifier,
 		nil,
+		nil,
 		members,
 		docString,
 		ast.NewRange(
@@ -752,6 +951,116 @@ func parseEventDeclaration(
 	), nil
 }
 
+// parseNewtypeDeclaration parses a newtype declaration.
+//
+//     newtypeDeclaration : 'newtype' identifier ':' typeAnnotation
+//
+// The declaration is desugared into a composite declaration
+// with a synthesized initializer, which has a single parameter `_ value`
+// of the underlying type.
+//
+func parseNewtypeDeclaration(
+	p *parser,
+	access ast.Access,
+	accessPos *ast.Position,
+	docString string,
+) (*ast.CompositeDeclaration, error) {
+
+	startPos := p.current.StartPos
+	if accessPos != nil {
+		startPos = *accessPos
+	}
+
+	// Skip the `newtype` keyword
+	p.next()
+
+	p.skipSpaceAndComments(true)
+	if !p.current.Is(lexer.TokenIdentifier) {
+		return nil, p.syntaxError(
+			"expected identifier after start of newtype declaration, got %s",
+			p.current.Type,
+		)
+	}
+
+	identifier := p.tokenToIdentifier(p.current)
+
+	// Skip the identifier
+	p.next()
+	p.skipSpaceAndComments(true)
+
+	_, err := p.mustOne(lexer.TokenColon)
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpaceAndComments(true)
+
+	typeAnnotation, err := parseTypeAnnotation(p)
+	if err != nil {
+		return nil, err
+	}
+
+	typeAnnotationRange := ast.NewRangeFromPositioned(p.memoryGauge, typeAnnotation)
+
+	parameterList := ast.NewParameterList(
+		p.memoryGauge,
+		[]*ast.Parameter{
+			ast.NewParameter(
+				p.memoryGauge,
+				// no argument label is required
+				"_",
+				ast.NewIdentifier(
+					p.memoryGauge,
+					ast.NewtypeValueFieldName,
+					typeAnnotationRange.StartPos,
+				),
+				typeAnnotation,
+				typeAnnotationRange,
+			),
+		},
+		typeAnnotationRange,
+	)
+
+	initializer := ast.NewSpecialFunctionDeclaration(
+		p.memoryGauge,
+		common.DeclarationKindInitializer,
+		ast.NewFunctionDeclaration(
+			p.memoryGauge,
+			ast.AccessNotSpecified,
+			ast.NewEmptyIdentifier(p.memoryGauge, ast.EmptyPosition),
+			nil,
+			parameterList,
+			nil,
+			nil,
+			parameterList.StartPos,
+			"",
+		),
+	)
+
+	members := ast.NewMembers(
+		p.memoryGauge,
+		[]ast.Declaration{
+			initializer,
+		},
+	)
+
+	return ast.NewCompositeDeclaration(
+		p.memoryGauge,
+		access,
+		common.CompositeKindNewtype,
+		identifier,
+		nil,
+		nil,
+		members,
+		docString,
+		ast.NewRange(
+			p.memoryGauge,
+			startPos,
+			typeAnnotationRange.EndPos,
+		),
+	), nil
+}
+
 // parseCompositeKind parses a composite kind.
 //
 //     compositeKind : 'struct' | 'resource' | 'contract' | 'enum'
@@ -851,7 +1160,7 @@ func parseFieldWithVariableKind(
 //
 //     conformances : ':' nominalType ( ',' nominalType )*
 //
-//     compositeDeclaration : compositeKind identifier conformances?
+//     compositeDeclaration : compositeKind identifier typeParameterList? conformances?
 //                            '{' membersAndNestedDeclarations '}'
 //
 //     interfaceDeclaration : compositeKind 'interface' identifier conformances?
@@ -908,6 +1217,15 @@ func parseCompositeOrInterfaceDeclaration(
 		}
 	}
 
+	var typeParameterList *ast.TypeParameterList
+	if !isInterface {
+		var err error
+		typeParameterList, err = parseTypeParameterList(p)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	p.skipSpaceAndComments(true)
 
 	var conformances []*ast.NominalType
@@ -956,17 +1274,12 @@ func parseCompositeOrInterfaceDeclaration(
 	)
 
 	if isInterface {
-		// TODO: remove once interface conformances are supported
-		if len(conformances) > 0 {
-			// TODO: improve
-			return nil, p.syntaxError("unexpected conformances")
-		}
-
 		return ast.NewInterfaceDeclaration(
 			p.memoryGauge,
 			access,
 			compositeKind,
 			identifier,
+			conformances,
 			members,
 			docString,
 			declarationRange,
@@ -977,6 +1290,7 @@ func parseCompositeOrInterfaceDeclaration(
 			access,
 			compositeKind,
 			identifier,
+			typeParameterList,
 			conformances,
 			members,
 			docString,
@@ -1034,6 +1348,7 @@ func parseMembersAndNestedDeclarations(p *parser, endTokenType lexer.TokenType)
 //                               | compositeDeclaration
 //                               | eventDeclaration
 //                               | enumCase
+//                               | typeAliasDeclaration
 //
 func parseMemberOrNestedDeclaration(p *parser, docString string) (ast.Declaration, error) {
 
@@ -1065,6 +1380,12 @@ func parseMemberOrNestedDeclaration(p *parser, docString string) (ast.Declaratio
 			case keywordStruct, keywordResource, keywordContract, keywordEnum:
 				return parseCompositeOrInterfaceDeclaration(p, access, accessPos, docString)
 
+			case keywordTypeAlias:
+				return parseTypeAliasDeclaration(p, access, accessPos, docString)
+
+			case keywordNewtype:
+				return parseNewtypeDeclaration(p, access, accessPos, docString)
+
 			case keywordPriv, keywordPub, keywordAccess:
 				if access != ast.AccessNotSpecified {
 					return nil, p.syntaxError("unexpected access modifier")
@@ -1205,6 +1526,7 @@ func parseSpecialFunctionDeclaration(
 			p.memoryGauge,
 			access,
 			identifier,
+			nil,
 			parameterList,
 			nil,
 			functionBlock,
@@ -19,6 +19,7 @@
 package parser
 
 import (
+	"encoding/hex"
 	"fmt"
 	"math/big"
 	"testing"
@@ -261,6 +262,201 @@ func TestParseVariableDeclaration(t *testing.T) {
 
 }
 
+func TestParseDestructuringVariableDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("array pattern", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements("let [a, _] = xs", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.VariableDeclaration{
+					IsConstant: true,
+					Pattern: &ast.ArrayPattern{
+						Elements: []ast.Pattern{
+							&ast.IdentifierPattern{
+								Identifier: ast.Identifier{
+									Identifier: "a",
+									Pos:        ast.Position{Line: 1, Column: 5, Offset: 5},
+								},
+							},
+							&ast.IdentifierPattern{
+								Identifier: ast.Identifier{
+									Identifier: "_",
+									Pos:        ast.Position{Line: 1, Column: 8, Offset: 8},
+								},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 4, Offset: 4},
+							EndPos:   ast.Position{Line: 1, Column: 9, Offset: 9},
+						},
+					},
+					Value: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "xs",
+							Pos:        ast.Position{Line: 1, Column: 13, Offset: 13},
+						},
+					},
+					Transfer: &ast.Transfer{
+						Operation: ast.TransferOperationCopy,
+						Pos:       ast.Position{Line: 1, Column: 11, Offset: 11},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("positional composite pattern, nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements("var (a, [b]) = p", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.VariableDeclaration{
+					IsConstant: false,
+					Pattern: &ast.CompositePattern{
+						Elements: []ast.CompositePatternElement{
+							{
+								Pattern: &ast.IdentifierPattern{
+									Identifier: ast.Identifier{
+										Identifier: "a",
+										Pos:        ast.Position{Line: 1, Column: 5, Offset: 5},
+									},
+								},
+							},
+							{
+								Pattern: &ast.ArrayPattern{
+									Elements: []ast.Pattern{
+										&ast.IdentifierPattern{
+											Identifier: ast.Identifier{
+												Identifier: "b",
+												Pos:        ast.Position{Line: 1, Column: 9, Offset: 9},
+											},
+										},
+									},
+									Range: ast.Range{
+										StartPos: ast.Position{Line: 1, Column: 8, Offset: 8},
+										EndPos:   ast.Position{Line: 1, Column: 10, Offset: 10},
+									},
+								},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 4, Offset: 4},
+							EndPos:   ast.Position{Line: 1, Column: 11, Offset: 11},
+						},
+					},
+					Value: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "p",
+							Pos:        ast.Position{Line: 1, Column: 15, Offset: 15},
+						},
+					},
+					Transfer: &ast.Transfer{
+						Operation: ast.TransferOperationCopy,
+						Pos:       ast.Position{Line: 1, Column: 13, Offset: 13},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("labeled composite pattern, dictionary pattern, move", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements(`let (first: {"k": a}) <- p`, nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.VariableDeclaration{
+					IsConstant: true,
+					Pattern: &ast.CompositePattern{
+						Elements: []ast.CompositePatternElement{
+							{
+								Label: &ast.Identifier{
+									Identifier: "first",
+									Pos:        ast.Position{Line: 1, Column: 5, Offset: 5},
+								},
+								Pattern: &ast.DictionaryPattern{
+									Entries: []ast.DictionaryPatternEntry{
+										{
+											Key: &ast.StringExpression{
+												Value: "k",
+												Range: ast.Range{
+													StartPos: ast.Position{Line: 1, Column: 13, Offset: 13},
+													EndPos:   ast.Position{Line: 1, Column: 15, Offset: 15},
+												},
+											},
+											Value: &ast.IdentifierPattern{
+												Identifier: ast.Identifier{
+													Identifier: "a",
+													Pos:        ast.Position{Line: 1, Column: 18, Offset: 18},
+												},
+											},
+										},
+									},
+									Range: ast.Range{
+										StartPos: ast.Position{Line: 1, Column: 12, Offset: 12},
+										EndPos:   ast.Position{Line: 1, Column: 19, Offset: 19},
+									},
+								},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 4, Offset: 4},
+							EndPos:   ast.Position{Line: 1, Column: 20, Offset: 20},
+						},
+					},
+					Value: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "p",
+							Pos:        ast.Position{Line: 1, Column: 25, Offset: 25},
+						},
+					},
+					Transfer: &ast.Transfer{
+						Operation: ast.TransferOperationMove,
+						Pos:       ast.Position{Line: 1, Column: 22, Offset: 22},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("composite pattern, mixed labels", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseStatements("let (first: a, b) = p", nil)
+
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "either all or no elements of a composite pattern must have a label",
+					Pos:     ast.Position{Offset: 16, Line: 1, Column: 16},
+				},
+			},
+			errs,
+		)
+	})
+}
+
 func TestParseParameterList(t *testing.T) {
 
 	t.Parallel()
@@ -956,6 +1152,214 @@ func TestParseFunctionDeclaration(t *testing.T) {
 			result,
 		)
 	})
+
+	t.Run("with default argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations("fun foo(a: Int, b: Int = 1) { }", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.FunctionDeclaration{
+					Identifier: ast.Identifier{
+						Identifier: "foo",
+						Pos:        ast.Position{Line: 1, Column: 4, Offset: 4},
+					},
+					ParameterList: &ast.ParameterList{
+						Parameters: []*ast.Parameter{
+							{
+								Label: "",
+								Identifier: ast.Identifier{
+									Identifier: "a",
+									Pos:        ast.Position{Line: 1, Column: 8, Offset: 8},
+								},
+								TypeAnnotation: &ast.TypeAnnotation{
+									IsResource: false,
+									Type: &ast.NominalType{
+										Identifier: ast.Identifier{
+											Identifier: "Int",
+											Pos:        ast.Position{Line: 1, Column: 11, Offset: 11},
+										},
+									},
+									StartPos: ast.Position{Line: 1, Column: 11, Offset: 11},
+								},
+								Range: ast.Range{
+									StartPos: ast.Position{Line: 1, Column: 8, Offset: 8},
+									EndPos:   ast.Position{Line: 1, Column: 13, Offset: 13},
+								},
+							},
+							{
+								Label: "",
+								Identifier: ast.Identifier{
+									Identifier: "b",
+									Pos:        ast.Position{Line: 1, Column: 16, Offset: 16},
+								},
+								TypeAnnotation: &ast.TypeAnnotation{
+									IsResource: false,
+									Type: &ast.NominalType{
+										Identifier: ast.Identifier{
+											Identifier: "Int",
+											Pos:        ast.Position{Line: 1, Column: 19, Offset: 19},
+										},
+									},
+									StartPos: ast.Position{Line: 1, Column: 19, Offset: 19},
+								},
+								DefaultArgument: &ast.IntegerExpression{
+									PositiveLiteral: "1",
+									Value:           big.NewInt(1),
+									Base:            10,
+									Range: ast.Range{
+										StartPos: ast.Position{Line: 1, Column: 25, Offset: 25},
+										EndPos:   ast.Position{Line: 1, Column: 25, Offset: 25},
+									},
+								},
+								Range: ast.Range{
+									StartPos: ast.Position{Line: 1, Column: 16, Offset: 16},
+									EndPos:   ast.Position{Line: 1, Column: 25, Offset: 25},
+								},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 7, Offset: 7},
+							EndPos:   ast.Position{Line: 1, Column: 26, Offset: 26},
+						},
+					},
+					ReturnTypeAnnotation: &ast.TypeAnnotation{
+						IsResource: false,
+						Type: &ast.NominalType{
+							Identifier: ast.Identifier{
+								Identifier: "",
+								Pos:        ast.Position{Line: 1, Column: 26, Offset: 26},
+							},
+						},
+						StartPos: ast.Position{Line: 1, Column: 26, Offset: 26},
+					},
+					FunctionBlock: &ast.FunctionBlock{
+						Block: &ast.Block{
+							Range: ast.Range{
+								StartPos: ast.Position{Line: 1, Column: 28, Offset: 28},
+								EndPos:   ast.Position{Line: 1, Column: 30, Offset: 30},
+							},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("with missing default argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseDeclarations("fun foo(a: Int = ) { }", nil)
+
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "unexpected token in expression: ')'",
+					Pos:     ast.Position{Offset: 19, Line: 1, Column: 19},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("with type parameters", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations("fun foo<T, U: Integer>() { }", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.FunctionDeclaration{
+					Identifier: ast.Identifier{
+						Identifier: "foo",
+						Pos:        ast.Position{Line: 1, Column: 4, Offset: 4},
+					},
+					TypeParameterList: &ast.TypeParameterList{
+						TypeParameters: []*ast.TypeParameter{
+							{
+								Identifier: ast.Identifier{
+									Identifier: "T",
+									Pos:        ast.Position{Line: 1, Column: 8, Offset: 8},
+								},
+							},
+							{
+								Identifier: ast.Identifier{
+									Identifier: "U",
+									Pos:        ast.Position{Line: 1, Column: 11, Offset: 11},
+								},
+								TypeBound: &ast.TypeAnnotation{
+									IsResource: false,
+									Type: &ast.NominalType{
+										Identifier: ast.Identifier{
+											Identifier: "Integer",
+											Pos:        ast.Position{Line: 1, Column: 14, Offset: 14},
+										},
+									},
+									StartPos: ast.Position{Line: 1, Column: 14, Offset: 14},
+								},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 7, Offset: 7},
+							EndPos:   ast.Position{Line: 1, Column: 21, Offset: 21},
+						},
+					},
+					ParameterList: &ast.ParameterList{
+						Parameters: nil,
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 22, Offset: 22},
+							EndPos:   ast.Position{Line: 1, Column: 23, Offset: 23},
+						},
+					},
+					ReturnTypeAnnotation: &ast.TypeAnnotation{
+						IsResource: false,
+						Type: &ast.NominalType{
+							Identifier: ast.Identifier{
+								Identifier: "",
+								Pos:        ast.Position{Line: 1, Column: 23, Offset: 23},
+							},
+						},
+						StartPos: ast.Position{Line: 1, Column: 23, Offset: 23},
+					},
+					FunctionBlock: &ast.FunctionBlock{
+						Block: &ast.Block{
+							Range: ast.Range{
+								StartPos: ast.Position{Line: 1, Column: 25, Offset: 25},
+								EndPos:   ast.Position{Line: 1, Column: 27, Offset: 27},
+							},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("with empty type parameter list", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseDeclarations("fun foo<>() { }", nil)
+
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected type parameter, got '>'",
+					Pos:     ast.Position{Offset: 8, Line: 1, Column: 8},
+				},
+			},
+			errs,
+		)
+	})
 }
 
 func TestParseAccess(t *testing.T) {
@@ -1457,68 +1861,300 @@ func TestParseImportDeclaration(t *testing.T) {
 					},
 				},
 			},
-			result,
+			result,
+		)
+	})
+
+	t.Run("from keyword as second identifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations(`
+			import foo, from from 0x42
+			import foo, from, bar from 0x42
+		`, nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.ImportDeclaration{
+					Identifiers: []ast.Identifier{
+						{
+							Identifier: "foo",
+							Pos:        ast.Position{Line: 2, Column: 10, Offset: 11},
+						},
+						{
+							Identifier: "from",
+							Pos:        ast.Position{Line: 2, Column: 15, Offset: 16},
+						},
+					},
+					Location: common.AddressLocation{
+						Address: common.MustBytesToAddress([]byte{0x42}),
+					},
+					LocationPos: ast.Position{Line: 2, Column: 25, Offset: 26},
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 2, Column: 3, Offset: 4},
+						EndPos:   ast.Position{Line: 2, Column: 28, Offset: 29},
+					},
+				},
+				&ast.ImportDeclaration{
+					Identifiers: []ast.Identifier{
+						{
+							Identifier: "foo",
+							Pos:        ast.Position{Line: 3, Column: 10, Offset: 41},
+						},
+						{
+							Identifier: "from",
+							Pos:        ast.Position{Line: 3, Column: 15, Offset: 46},
+						},
+						{
+							Identifier: "bar",
+							Pos:        ast.Position{Line: 3, Column: 21, Offset: 52},
+						},
+					},
+					Location: common.AddressLocation{
+						Address: common.MustBytesToAddress([]byte{0x42}),
+					},
+					LocationPos: ast.Position{Line: 3, Column: 30, Offset: 61},
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 3, Column: 3, Offset: 34},
+						EndPos:   ast.Position{Line: 3, Column: 33, Offset: 64},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	const testImportHash = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+	testImportHashDigest, err := hex.DecodeString(testImportHash)
+	require.NoError(t, err)
+
+	t.Run("hash", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations(
+			` import foo from 0x42 with hash "sha3-256:`+testImportHash+`"`,
+			nil,
+		)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.ImportDeclaration{
+					Identifiers: []ast.Identifier{
+						{
+							Identifier: "foo",
+							Pos:        ast.Position{Line: 1, Column: 8, Offset: 8},
+						},
+					},
+					Location: common.AddressLocation{
+						Address: common.MustBytesToAddress([]byte{0x42}),
+					},
+					LocationPos: ast.Position{Line: 1, Column: 17, Offset: 17},
+					Hash: &ast.ImportHash{
+						Algorithm: ast.ImportHashAlgorithmSHA3_256,
+						Digest:    testImportHashDigest,
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 22, Offset: 22},
+							EndPos:   ast.Position{Line: 1, Column: 106, Offset: 106},
+						},
+					},
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 1, Offset: 1},
+						EndPos:   ast.Position{Line: 1, Column: 106, Offset: 106},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("hash, identifier location", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations(
+			` import foo with hash "sha3-256:`+testImportHash+`"`,
+			nil,
+		)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.ImportDeclaration{
+					Identifiers: nil,
+					Location:    common.IdentifierLocation("foo"),
+					LocationPos: ast.Position{Line: 1, Column: 8, Offset: 8},
+					Hash: &ast.ImportHash{
+						Algorithm: ast.ImportHashAlgorithmSHA3_256,
+						Digest:    testImportHashDigest,
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 12, Offset: 12},
+							EndPos:   ast.Position{Line: 1, Column: 96, Offset: 96},
+						},
+					},
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 1, Offset: 1},
+						EndPos:   ast.Position{Line: 1, Column: 96, Offset: 96},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("hash, multiple identifiers, address location", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseDeclarations(
+			`import foo, bar from 0x42 with hash "sha3-256:`+testImportHash+`"`,
+			nil,
+		)
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "invalid import hash: import from address must import exactly one declaration, got 2",
+					Pos:     ast.Position{Offset: 26, Line: 1, Column: 26},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("hash, no identifiers, address location", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseDeclarations(
+			`import 0x42 with hash "sha3-256:`+testImportHash+`"`,
+			nil,
+		)
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "invalid import hash: import from address must import exactly one declaration, got 0",
+					Pos:     ast.Position{Offset: 12, Line: 1, Column: 12},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("hash, multiple identifiers, string location", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations(
+			`import foo, bar from "test.cdc" with hash "sha3-256:`+testImportHash+`"`,
+			nil,
+		)
+		require.Empty(t, errs)
+
+		require.Len(t, result, 1)
+		require.IsType(t, &ast.ImportDeclaration{}, result[0])
+		require.NotNil(t, result[0].(*ast.ImportDeclaration).Hash)
+	})
+
+	t.Run("no hash, following declaration", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations(`
+			import foo from 0x42
+			/// with
+			let with = 1
+		`, nil)
+		require.Empty(t, errs)
+
+		require.Len(t, result, 2)
+		require.IsType(t, &ast.ImportDeclaration{}, result[0])
+		require.Nil(t, result[0].(*ast.ImportDeclaration).Hash)
+
+		require.IsType(t, &ast.VariableDeclaration{}, result[1])
+		require.Equal(t, " with", result[1].DeclarationDocString())
+	})
+
+	t.Run("hash, missing keyword", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseDeclarations(`import foo from 0x42 with "sha3-256:`+testImportHash+`"`, nil)
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected keyword \"hash\", got string",
+					Pos:     ast.Position{Offset: 26, Line: 1, Column: 26},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("hash, missing string", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseDeclarations(`import foo from 0x42 with hash 0x1`, nil)
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected string for import hash, got hexadecimal integer",
+					Pos:     ast.Position{Offset: 31, Line: 1, Column: 31},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("hash, invalid format", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseDeclarations(`import foo from 0x42 with hash "`+testImportHash+`"`, nil)
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "invalid import hash: expected format \"<algorithm>:<digest>\"",
+					Pos:     ast.Position{Offset: 31, Line: 1, Column: 31},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("hash, unsupported algorithm", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseDeclarations(`import foo from 0x42 with hash "md5:`+testImportHash+`"`, nil)
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "invalid import hash: unsupported algorithm \"md5\", expected \"sha3-256\"",
+					Pos:     ast.Position{Offset: 31, Line: 1, Column: 31},
+				},
+			},
+			errs,
 		)
 	})
 
-	t.Run("from keyword as second identifier", func(t *testing.T) {
+	t.Run("hash, invalid digest", func(t *testing.T) {
 
 		t.Parallel()
 
-		result, errs := ParseDeclarations(`
-			import foo, from from 0x42
-			import foo, from, bar from 0x42
-		`, nil)
-		require.Empty(t, errs)
-
+		_, errs := ParseDeclarations(`import foo from 0x42 with hash "sha3-256:cafe"`, nil)
 		utils.AssertEqualWithDiff(t,
-			[]ast.Declaration{
-				&ast.ImportDeclaration{
-					Identifiers: []ast.Identifier{
-						{
-							Identifier: "foo",
-							Pos:        ast.Position{Line: 2, Column: 10, Offset: 11},
-						},
-						{
-							Identifier: "from",
-							Pos:        ast.Position{Line: 2, Column: 15, Offset: 16},
-						},
-					},
-					Location: common.AddressLocation{
-						Address: common.MustBytesToAddress([]byte{0x42}),
-					},
-					LocationPos: ast.Position{Line: 2, Column: 25, Offset: 26},
-					Range: ast.Range{
-						StartPos: ast.Position{Line: 2, Column: 3, Offset: 4},
-						EndPos:   ast.Position{Line: 2, Column: 28, Offset: 29},
-					},
-				},
-				&ast.ImportDeclaration{
-					Identifiers: []ast.Identifier{
-						{
-							Identifier: "foo",
-							Pos:        ast.Position{Line: 3, Column: 10, Offset: 41},
-						},
-						{
-							Identifier: "from",
-							Pos:        ast.Position{Line: 3, Column: 15, Offset: 46},
-						},
-						{
-							Identifier: "bar",
-							Pos:        ast.Position{Line: 3, Column: 21, Offset: 52},
-						},
-					},
-					Location: common.AddressLocation{
-						Address: common.MustBytesToAddress([]byte{0x42}),
-					},
-					LocationPos: ast.Position{Line: 3, Column: 30, Offset: 61},
-					Range: ast.Range{
-						StartPos: ast.Position{Line: 3, Column: 3, Offset: 34},
-						EndPos:   ast.Position{Line: 3, Column: 33, Offset: 64},
-					},
+			[]error{
+				&SyntaxError{
+					Message: "invalid import hash: expected 32 hex-encoded bytes",
+					Pos:     ast.Position{Offset: 31, Line: 1, Column: 31},
 				},
 			},
-			result,
+			errs,
 		)
 	})
 }
@@ -1995,6 +2631,57 @@ func TestParseCompositeDeclaration(t *testing.T) {
 			result,
 		)
 	})
+
+	t.Run("struct, with type parameters", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations(" pub struct Box<T: AnyStruct> { }", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.CompositeDeclaration{
+					Access:        ast.AccessPublic,
+					CompositeKind: common.CompositeKindStructure,
+					Identifier: ast.Identifier{
+						Identifier: "Box",
+						Pos:        ast.Position{Line: 1, Column: 12, Offset: 12},
+					},
+					TypeParameterList: &ast.TypeParameterList{
+						TypeParameters: []*ast.TypeParameter{
+							{
+								Identifier: ast.Identifier{
+									Identifier: "T",
+									Pos:        ast.Position{Line: 1, Column: 16, Offset: 16},
+								},
+								TypeBound: &ast.TypeAnnotation{
+									IsResource: false,
+									Type: &ast.NominalType{
+										Identifier: ast.Identifier{
+											Identifier: "AnyStruct",
+											Pos:        ast.Position{Line: 1, Column: 19, Offset: 19},
+										},
+									},
+									StartPos: ast.Position{Line: 1, Column: 19, Offset: 19},
+								},
+							},
+						},
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 15, Offset: 15},
+							EndPos:   ast.Position{Line: 1, Column: 28, Offset: 28},
+						},
+					},
+					Members: &ast.Members{},
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 1, Offset: 1},
+						EndPos:   ast.Position{Line: 1, Column: 32, Offset: 32},
+					},
+				},
+			},
+			result,
+		)
+	})
 }
 
 func TestParseInterfaceDeclaration(t *testing.T) {
@@ -2028,6 +2715,47 @@ func TestParseInterfaceDeclaration(t *testing.T) {
 		)
 	})
 
+	t.Run("resource, conformances", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations(" pub resource interface R: A, B { }", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.InterfaceDeclaration{
+					Access:        ast.AccessPublic,
+					CompositeKind: common.CompositeKindResource,
+					Identifier: ast.Identifier{
+						Identifier: "R",
+						Pos:        ast.Position{Line: 1, Column: 24, Offset: 24},
+					},
+					Conformances: []*ast.NominalType{
+						{
+							Identifier: ast.Identifier{
+								Identifier: "A",
+								Pos:        ast.Position{Line: 1, Column: 27, Offset: 27},
+							},
+						},
+						{
+							Identifier: ast.Identifier{
+								Identifier: "B",
+								Pos:        ast.Position{Line: 1, Column: 30, Offset: 30},
+							},
+						},
+					},
+					Members: &ast.Members{},
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 1, Offset: 1},
+						EndPos:   ast.Position{Line: 1, Column: 34, Offset: 34},
+					},
+				},
+			},
+			result,
+		)
+	})
+
 	t.Run("struct, interface keyword as name", func(t *testing.T) {
 
 		t.Parallel()
@@ -3938,6 +4666,125 @@ func TestParsePragmaArguments(t *testing.T) {
 	)
 }
 
+func TestParseTypeAliasDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("nominal", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `pub typealias NFTID = UInt64`
+		result, err := ParseProgram(code, nil)
+		require.NoError(t, err)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.TypeAliasDeclaration{
+					Access: ast.AccessPublic,
+					Identifier: ast.Identifier{
+						Identifier: "NFTID",
+						Pos:        ast.Position{Offset: 14, Line: 1, Column: 14},
+					},
+					Type: &ast.NominalType{
+						Identifier: ast.Identifier{
+							Identifier: "UInt64",
+							Pos:        ast.Position{Offset: 22, Line: 1, Column: 22},
+						},
+					},
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 0, Line: 1, Column: 0},
+						EndPos:   ast.Position{Offset: 27, Line: 1, Column: 27},
+					},
+				},
+			},
+			result.Declarations(),
+		)
+	})
+
+	t.Run("reference to restricted type", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `typealias Ref = &R{I}`
+		result, err := ParseProgram(code, nil)
+		require.NoError(t, err)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.TypeAliasDeclaration{
+					Access: ast.AccessNotSpecified,
+					Identifier: ast.Identifier{
+						Identifier: "Ref",
+						Pos:        ast.Position{Offset: 10, Line: 1, Column: 10},
+					},
+					Type: &ast.ReferenceType{
+						Type: &ast.RestrictedType{
+							Type: &ast.NominalType{
+								Identifier: ast.Identifier{
+									Identifier: "R",
+									Pos:        ast.Position{Offset: 17, Line: 1, Column: 17},
+								},
+							},
+							Restrictions: []*ast.NominalType{
+								{
+									Identifier: ast.Identifier{
+										Identifier: "I",
+										Pos:        ast.Position{Offset: 19, Line: 1, Column: 19},
+									},
+								},
+							},
+							Range: ast.Range{
+								StartPos: ast.Position{Offset: 17, Line: 1, Column: 17},
+								EndPos:   ast.Position{Offset: 20, Line: 1, Column: 20},
+							},
+						},
+						StartPos: ast.Position{Offset: 16, Line: 1, Column: 16},
+					},
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 0, Line: 1, Column: 0},
+						EndPos:   ast.Position{Offset: 20, Line: 1, Column: 20},
+					},
+				},
+			},
+			result.Declarations(),
+		)
+	})
+
+	t.Run("member", func(t *testing.T) {
+
+		t.Parallel()
+
+		const code = `
+          contract C {
+              /// The ID of an NFT
+              pub typealias ID = UInt64
+          }
+        `
+		result, err := ParseProgram(code, nil)
+		require.NoError(t, err)
+
+		contract := result.SoleContractDeclaration()
+		require.NotNil(t, contract)
+
+		typeAliases := contract.Members.TypeAliases()
+		require.Len(t, typeAliases, 1)
+
+		typeAlias := typeAliases[0]
+		require.Equal(t, "ID", typeAlias.Identifier.Identifier)
+		require.Equal(t, " The ID of an NFT", typeAlias.DocString)
+		require.Equal(t, "UInt64", typeAlias.Type.String())
+	})
+
+	t.Run("missing equal sign", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseDeclarations("typealias ID UInt64", nil)
+		require.NotEmpty(t, errs)
+	})
+}
+
 func TestParseImportWithString(t *testing.T) {
 
 	t.Parallel()
@@ -4170,6 +5017,85 @@ func TestParseResource(t *testing.T) {
 	)
 }
 
+func TestParseNewtype(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseDeclarations("pub newtype PersonID: String", nil)
+		require.Empty(t, errs)
+
+		typeAnnotation := &ast.TypeAnnotation{
+			IsResource: false,
+			Type: &ast.NominalType{
+				Identifier: ast.Identifier{
+					Identifier: "String",
+					Pos:        ast.Position{Offset: 22, Line: 1, Column: 22},
+				},
+			},
+			StartPos: ast.Position{Offset: 22, Line: 1, Column: 22},
+		}
+
+		typeAnnotationRange := ast.Range{
+			StartPos: ast.Position{Offset: 22, Line: 1, Column: 22},
+			EndPos:   ast.Position{Offset: 27, Line: 1, Column: 27},
+		}
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Declaration{
+				&ast.CompositeDeclaration{
+					Access:        ast.AccessPublic,
+					CompositeKind: common.CompositeKindNewtype,
+					Identifier: ast.Identifier{
+						Identifier: "PersonID",
+						Pos:        ast.Position{Offset: 12, Line: 1, Column: 12},
+					},
+					Members: ast.NewUnmeteredMembers(
+						[]ast.Declaration{
+							&ast.SpecialFunctionDeclaration{
+								Kind: common.DeclarationKindInitializer,
+								FunctionDeclaration: &ast.FunctionDeclaration{
+									ParameterList: &ast.ParameterList{
+										Parameters: []*ast.Parameter{
+											{
+												Label: "_",
+												Identifier: ast.Identifier{
+													Identifier: "value",
+													Pos:        ast.Position{Offset: 22, Line: 1, Column: 22},
+												},
+												TypeAnnotation: typeAnnotation,
+												Range:          typeAnnotationRange,
+											},
+										},
+										Range: typeAnnotationRange,
+									},
+									StartPos: ast.Position{Offset: 22, Line: 1, Column: 22},
+								},
+							},
+						},
+					),
+					Range: ast.Range{
+						StartPos: ast.Position{Offset: 0, Line: 1, Column: 0},
+						EndPos:   ast.Position{Offset: 27, Line: 1, Column: 27},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("missing underlying type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseDeclarations("newtype PersonID", nil)
+		require.NotEmpty(t, errs)
+	})
+}
+
 func TestParseEventDeclaration(t *testing.T) {
 
 	t.Parallel()
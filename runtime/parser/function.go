@@ -111,6 +111,129 @@ func parseParameterList(p *parser) (parameterList *ast.ParameterList, err error)
 	), err
 }
 
+// parseTypeParameterList parses an optional type parameter list.
+// It returns nil if there is no type parameter list.
+//
+//     typeParameterList : '<' typeParameter ( ',' typeParameter )* '>'
+//
+//     typeParameter : identifier ( ':' typeAnnotation )?
+//
+func parseTypeParameterList(p *parser) (*ast.TypeParameterList, error) {
+	p.skipSpaceAndComments(true)
+
+	if !p.current.Is(lexer.TokenLess) {
+		return nil, nil
+	}
+
+	startPos := p.current.StartPos
+	// Skip the opening less
+	p.next()
+
+	var typeParameters []*ast.TypeParameter
+
+	expectTypeParameter := true
+
+	for {
+		p.skipSpaceAndComments(true)
+		switch p.current.Type {
+		case lexer.TokenIdentifier:
+			if !expectTypeParameter {
+				return nil, p.syntaxError(
+					"expected comma or end of type parameter list, got %s",
+					p.current.Type,
+				)
+			}
+
+			typeParameter, err := parseTypeParameter(p)
+			if err != nil {
+				return nil, err
+			}
+
+			typeParameters = append(typeParameters, typeParameter)
+			expectTypeParameter = false
+
+		case lexer.TokenComma:
+			if expectTypeParameter {
+				return nil, p.syntaxError(
+					"expected type parameter, got %s",
+					p.current.Type,
+				)
+			}
+			// Skip the comma
+			p.next()
+			expectTypeParameter = true
+
+		case lexer.TokenGreater:
+			if expectTypeParameter {
+				return nil, p.syntaxError(
+					"expected type parameter, got %s",
+					p.current.Type,
+				)
+			}
+
+			endPos := p.current.EndPos
+			// Skip the closing greater
+			p.next()
+
+			return ast.NewTypeParameterList(
+				p.memoryGauge,
+				typeParameters,
+				ast.NewRange(
+					p.memoryGauge,
+					startPos,
+					endPos,
+				),
+			), nil
+
+		case lexer.TokenEOF:
+			return nil, p.syntaxError(
+				"missing %s at end of type parameter list",
+				lexer.TokenGreater,
+			)
+
+		default:
+			if expectTypeParameter {
+				return nil, p.syntaxError(
+					"expected type parameter, got %s",
+					p.current.Type,
+				)
+			} else {
+				return nil, p.syntaxError(
+					"expected comma or end of type parameter list, got %s",
+					p.current.Type,
+				)
+			}
+		}
+	}
+}
+
+func parseTypeParameter(p *parser) (*ast.TypeParameter, error) {
+	identifier := p.tokenToIdentifier(p.current)
+	// Skip the identifier
+	p.next()
+
+	var typeBound *ast.TypeAnnotation
+
+	p.skipSpaceAndComments(true)
+	if p.current.Is(lexer.TokenColon) {
+		// Skip the colon
+		p.next()
+		p.skipSpaceAndComments(true)
+
+		var err error
+		typeBound, err = parseTypeAnnotation(p)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return ast.NewTypeParameter(
+		p.memoryGauge,
+		identifier,
+		typeBound,
+	), nil
+}
+
 func parseParameter(p *parser) (*ast.Parameter, error) {
 	p.skipSpaceAndComments(true)
 
@@ -172,7 +295,24 @@ func parseParameter(p *parser) (*ast.Parameter, error) {
 
 	endPos := typeAnnotation.EndPosition(p.memoryGauge)
 
-	return ast.NewParameter(
+	// The type annotation might be followed by a default argument
+
+	var defaultArgument ast.Expression
+
+	p.skipSpaceAndComments(true)
+	if p.current.Is(lexer.TokenEqual) {
+		// Skip the equal sign
+		p.next()
+
+		defaultArgument, err = parseExpression(p, lowestBindingPower)
+		if err != nil {
+			return nil, err
+		}
+
+		endPos = defaultArgument.EndPosition(p.memoryGauge)
+	}
+
+	parameter := ast.NewParameter(
 		p.memoryGauge,
 		argumentLabel,
 		ast.NewIdentifier(
@@ -186,7 +326,10 @@ func parseParameter(p *parser) (*ast.Parameter, error) {
 			startPos,
 			endPos,
 		),
-	), nil
+	)
+	parameter.DefaultArgument = defaultArgument
+
+	return parameter, nil
 }
 
 func parseFunctionDeclaration(
@@ -218,6 +361,11 @@ func parseFunctionDeclaration(
 	// Skip the identifier
 	p.next()
 
+	typeParameterList, err := parseTypeParameterList(p)
+	if err != nil {
+		return nil, err
+	}
+
 	parameterList, returnTypeAnnotation, functionBlock, err :=
 		parseFunctionParameterListAndRest(p, functionBlockIsOptional)
 
@@ -229,6 +377,7 @@ func parseFunctionDeclaration(
 		p.memoryGauge,
 		access,
 		identifier,
+		typeParameterList,
 		parameterList,
 		returnTypeAnnotation,
 		functionBlock,
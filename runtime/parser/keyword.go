@@ -49,6 +49,8 @@ const (
 	keywordAccount     = "account"
 	keywordImport      = "import"
 	keywordFrom        = "from"
+	keywordWith        = "with"
+	keywordHash        = "hash"
 	keywordPre         = "pre"
 	keywordPost        = "post"
 	keywordEvent       = "event"
@@ -61,5 +63,10 @@ const (
 	keywordCase        = "case"
 	keywordSwitch      = "switch"
 	keywordDefault     = "default"
+	keywordWhere       = "where"
 	keywordEnum        = "enum"
+	keywordTypeAlias   = "typealias"
+	keywordNewtype     = "newtype"
+	keywordTry         = "try"
+	keywordCatch       = "catch"
 )
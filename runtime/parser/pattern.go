@@ -0,0 +1,313 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parser
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/parser/lexer"
+)
+
+// parsePattern parses a destructuring pattern.
+//
+//     pattern : identifier
+//             | arrayPattern
+//             | dictionaryPattern
+//             | compositePattern
+//
+func parsePattern(p *parser) (ast.Pattern, error) {
+	p.skipSpaceAndComments(true)
+
+	switch p.current.Type {
+	case lexer.TokenIdentifier:
+		identifier := p.tokenToIdentifier(p.current)
+		// Skip the identifier
+		p.next()
+		return ast.NewIdentifierPattern(p.memoryGauge, identifier), nil
+
+	case lexer.TokenBracketOpen:
+		return parseArrayPattern(p)
+
+	case lexer.TokenBraceOpen:
+		return parseDictionaryPattern(p)
+
+	case lexer.TokenParenOpen:
+		return parseCompositePattern(p)
+
+	default:
+		return nil, p.syntaxError(
+			"expected identifier or pattern, got %s",
+			p.current.Type,
+		)
+	}
+}
+
+// parseArrayPattern parses an array pattern.
+//
+//     arrayPattern : '[' ( pattern ( ',' pattern )* )? ']'
+//
+func parseArrayPattern(p *parser) (ast.Pattern, error) {
+	startPos := p.current.StartPos
+
+	// Skip the opening bracket
+	p.next()
+	p.skipSpaceAndComments(true)
+
+	var elements []ast.Pattern
+	for !p.current.Is(lexer.TokenBracketClose) {
+		element, err := parsePattern(p)
+		if err != nil {
+			return nil, err
+		}
+
+		elements = append(elements, element)
+
+		p.skipSpaceAndComments(true)
+		if !p.current.Is(lexer.TokenComma) {
+			break
+		}
+
+		// Skip the comma
+		p.next()
+		p.skipSpaceAndComments(true)
+	}
+
+	endToken, err := p.mustOne(lexer.TokenBracketClose)
+	if err != nil {
+		return nil, err
+	}
+
+	return ast.NewArrayPattern(
+		p.memoryGauge,
+		elements,
+		ast.NewRange(
+			p.memoryGauge,
+			startPos,
+			endToken.EndPos,
+		),
+	), nil
+}
+
+// parseDictionaryPattern parses a dictionary pattern.
+//
+//     dictionaryPattern : '{' ( dictionaryPatternEntry ( ',' dictionaryPatternEntry )* )? '}'
+//
+//     dictionaryPatternEntry : expression ':' pattern
+//
+func parseDictionaryPattern(p *parser) (ast.Pattern, error) {
+	startPos := p.current.StartPos
+
+	// Skip the opening brace
+	p.next()
+	p.skipSpaceAndComments(true)
+
+	var entries []ast.DictionaryPatternEntry
+	for !p.current.Is(lexer.TokenBraceClose) {
+		key, err := parseExpression(p, lowestBindingPower)
+		if err != nil {
+			return nil, err
+		}
+
+		p.skipSpaceAndComments(true)
+		_, err = p.mustOne(lexer.TokenColon)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := parsePattern(p)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(
+			entries,
+			ast.DictionaryPatternEntry{
+				Key:   key,
+				Value: value,
+			},
+		)
+
+		p.skipSpaceAndComments(true)
+		if !p.current.Is(lexer.TokenComma) {
+			break
+		}
+
+		// Skip the comma
+		p.next()
+		p.skipSpaceAndComments(true)
+	}
+
+	endToken, err := p.mustOne(lexer.TokenBraceClose)
+	if err != nil {
+		return nil, err
+	}
+
+	return ast.NewDictionaryPattern(
+		p.memoryGauge,
+		entries,
+		ast.NewRange(
+			p.memoryGauge,
+			startPos,
+			endToken.EndPos,
+		),
+	), nil
+}
+
+// parseCompositePattern parses a composite pattern.
+// Either all or none of the elements must have a label.
+//
+//     compositePattern : '(' ( compositePatternElement ( ',' compositePatternElement )* )? ')'
+//
+//     compositePatternElement : ( identifier ':' )? pattern
+//
+func parseCompositePattern(p *parser) (ast.Pattern, error) {
+	startPos := p.current.StartPos
+
+	// Skip the opening parenthesis
+	p.next()
+	p.skipSpaceAndComments(true)
+
+	var elements []ast.CompositePatternElement
+	for !p.current.Is(lexer.TokenParenClose) {
+		element, err := parseCompositePatternElement(p)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(elements) > 0 &&
+			(element.Label == nil) != (elements[0].Label == nil) {
+
+			return nil, p.syntaxError("either all or no elements of a composite pattern must have a label")
+		}
+
+		elements = append(elements, element)
+
+		p.skipSpaceAndComments(true)
+		if !p.current.Is(lexer.TokenComma) {
+			break
+		}
+
+		// Skip the comma
+		p.next()
+		p.skipSpaceAndComments(true)
+	}
+
+	endToken, err := p.mustOne(lexer.TokenParenClose)
+	if err != nil {
+		return nil, err
+	}
+
+	return ast.NewCompositePattern(
+		p.memoryGauge,
+		elements,
+		ast.NewRange(
+			p.memoryGauge,
+			startPos,
+			endToken.EndPos,
+		),
+	), nil
+}
+
+func parseCompositePatternElement(p *parser) (ast.CompositePatternElement, error) {
+	if !p.current.Is(lexer.TokenIdentifier) {
+		pattern, err := parsePattern(p)
+		if err != nil {
+			return ast.CompositePatternElement{}, err
+		}
+
+		return ast.CompositePatternElement{
+			Pattern: pattern,
+		}, nil
+	}
+
+	// The element starts with an identifier,
+	// which is either a label, or an identifier pattern
+
+	identifier := p.tokenToIdentifier(p.current)
+
+	// Skip the identifier
+	p.next()
+	p.skipSpaceAndComments(true)
+
+	if !p.current.Is(lexer.TokenColon) {
+		return ast.CompositePatternElement{
+			Pattern: ast.NewIdentifierPattern(p.memoryGauge, identifier),
+		}, nil
+	}
+
+	// Skip the colon
+	p.next()
+
+	pattern, err := parsePattern(p)
+	if err != nil {
+		return ast.CompositePatternElement{}, err
+	}
+
+	return ast.CompositePatternElement{
+		Label:   &identifier,
+		Pattern: pattern,
+	}, nil
+}
+
+// parseSwitchCasePattern parses the pattern of a switch case.
+// In addition to the patterns of variable declarations,
+// the pattern may match a non-nil optional or a value of a type.
+//
+//     switchCasePattern : pattern `?`? ( `as` typeAnnotation )?
+//
+func parseSwitchCasePattern(p *parser) (ast.Pattern, error) {
+	pattern, err := parsePattern(p)
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpaceAndComments(true)
+
+	if p.current.Is(lexer.TokenQuestionMark) {
+		pattern = ast.NewOptionalPattern(
+			p.memoryGauge,
+			pattern,
+			p.current.EndPos,
+		)
+
+		// Skip the question mark
+		p.next()
+		p.skipSpaceAndComments(true)
+	}
+
+	if p.current.Is(lexer.TokenIdentifier) &&
+		p.current.Value == keywordAs {
+
+		// Skip the `as` keyword
+		p.next()
+		p.skipSpaceAndComments(true)
+
+		typeAnnotation, err := parseTypeAnnotation(p)
+		if err != nil {
+			return nil, err
+		}
+
+		pattern = ast.NewTypePattern(
+			p.memoryGauge,
+			pattern,
+			typeAnnotation,
+		)
+	}
+
+	return pattern, nil
+}
@@ -92,6 +92,16 @@ func parseStatement(p *parser) (ast.Statement, error) {
 			return parseWhileStatement(p)
 		case keywordFor:
 			return parseForStatement(p)
+		case keywordTry:
+			// The `try` keyword only introduces a try statement if a block follows.
+			// Otherwise, it is an identifier, e.g. in the function call `try()`
+			isTryStatement, err := isNextTokenBraceOpen(p)
+			if err != nil {
+				return nil, err
+			}
+			if isTryStatement {
+				return parseTryStatement(p)
+			}
 		case keywordEmit:
 			return parseEmitStatement(p)
 		case keywordFun:
@@ -165,6 +175,11 @@ func parseFunctionDeclarationOrFunctionExpressionStatement(p *parser) (ast.State
 
 		p.next()
 
+		typeParameterList, err := parseTypeParameterList(p)
+		if err != nil {
+			return nil, err
+		}
+
 		parameterList, returnTypeAnnotation, functionBlock, err :=
 			parseFunctionParameterListAndRest(p, false)
 
@@ -176,6 +191,7 @@ func parseFunctionDeclarationOrFunctionExpressionStatement(p *parser) (ast.State
 			p.memoryGauge,
 			ast.AccessNotSpecified,
 			identifier,
+			typeParameterList,
 			parameterList,
 			returnTypeAnnotation,
 			functionBlock,
@@ -440,6 +456,81 @@ func parseForStatement(p *parser) (*ast.ForStatement, error) {
 	), nil
 }
 
+// isNextTokenBraceOpen checks whether the token to follow is an opening brace.
+func isNextTokenBraceOpen(p *parser) (b bool, err error) {
+	p.startBuffering()
+	defer func() {
+		err = p.replayBuffered()
+	}()
+
+	// skip the current token
+	p.next()
+	p.skipSpaceAndComments(true)
+
+	// Lookahead the next token
+	return p.current.Is(lexer.TokenBraceOpen), nil
+}
+
+func parseTryStatement(p *parser) (*ast.TryStatement, error) {
+
+	startPos := p.current.StartPos
+	p.next()
+	p.skipSpaceAndComments(true)
+
+	block, err := parseBlock(p)
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpaceAndComments(true)
+
+	if !p.current.IsString(lexer.TokenIdentifier, keywordCatch) {
+		return nil, p.syntaxError(
+			"expected keyword %q, got %s",
+			keywordCatch,
+			p.current.Type,
+		)
+	}
+
+	p.next()
+	p.skipSpaceAndComments(true)
+
+	var errorIdentifier *ast.Identifier
+
+	if p.current.Is(lexer.TokenParenOpen) {
+		p.next()
+		p.skipSpaceAndComments(true)
+
+		identifier, err := p.mustIdentifier()
+		if err != nil {
+			return nil, err
+		}
+		errorIdentifier = &identifier
+
+		p.skipSpaceAndComments(true)
+
+		_, err = p.mustOne(lexer.TokenParenClose)
+		if err != nil {
+			return nil, err
+		}
+
+		p.skipSpaceAndComments(true)
+	}
+
+	catchBlock, err := parseBlock(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return ast.NewTryStatement(
+		p.memoryGauge,
+		block,
+		errorIdentifier,
+		catchBlock,
+		startPos,
+	), nil
+}
+
 func parseBlock(p *parser) (*ast.Block, error) {
 	startToken, err := p.mustOne(lexer.TokenBraceOpen)
 	if err != nil {
@@ -702,9 +793,16 @@ func parseSwitchCases(p *parser) (cases []*ast.SwitchCase, err error) {
 // parseSwitchCase parses a switch case (hasExpression == true)
 // or default case (hasExpression == false)
 //
-//     switchCase : `case` expression `:` statements
+//     switchCase : `case` switchCaseTest ( `where` expression )? `:` statements
 //                | `default` `:` statements
 //
+//     switchCaseTest : expression
+//                    | `let` switchCasePattern
+//                    | identifier ( `where` expression )
+//
+// An identifier which is followed by a guard binds the tested value,
+// e.g. `case x where x > 5`, just like `case let x where x > 5`.
+//
 func parseSwitchCase(p *parser, hasExpression bool) (*ast.SwitchCase, error) {
 
 	startPos := p.current.StartPos
@@ -713,13 +811,50 @@ func parseSwitchCase(p *parser, hasExpression bool) (*ast.SwitchCase, error) {
 	p.next()
 
 	var expression ast.Expression
+	var pattern ast.Pattern
+	var guard ast.Expression
 	var err error
 
 	if hasExpression {
-		expression, err = parseExpression(p, lowestBindingPower)
+		p.skipSpaceAndComments(true)
+
+		if p.current.Is(lexer.TokenIdentifier) &&
+			p.current.Value == keywordLet {
+
+			// Skip the `let` keyword
+			p.next()
+
+			pattern, err = parseSwitchCasePattern(p)
+		} else {
+			expression, err = parseExpression(p, lowestBindingPower)
+		}
 		if err != nil {
 			return nil, err
 		}
+
+		p.skipSpaceAndComments(true)
+
+		if p.current.Is(lexer.TokenIdentifier) &&
+			p.current.Value == keywordWhere {
+
+			// An identifier followed by a guard is a pattern which binds the tested value
+
+			if identifierExpression, ok := expression.(*ast.IdentifierExpression); ok {
+				pattern = ast.NewIdentifierPattern(
+					p.memoryGauge,
+					identifierExpression.Identifier,
+				)
+				expression = nil
+			}
+
+			// Skip the `where` keyword
+			p.next()
+
+			guard, err = parseExpression(p, lowestBindingPower)
+			if err != nil {
+				return nil, err
+			}
+		}
 	} else {
 		p.skipSpaceAndComments(true)
 	}
@@ -766,6 +901,8 @@ func parseSwitchCase(p *parser, hasExpression bool) (*ast.SwitchCase, error) {
 
 	return &ast.SwitchCase{
 		Expression: expression,
+		Pattern:    pattern,
+		Guard:      guard,
 		Statements: statements,
 		Range: ast.NewRange(
 			p.memoryGauge,
@@ -608,6 +608,144 @@ func TestParseWhileStatement(t *testing.T) {
 	})
 }
 
+func TestParseTryStatement(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("without error identifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements("try { } catch { }", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.TryStatement{
+					Block: &ast.Block{
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 4, Offset: 4},
+							EndPos:   ast.Position{Line: 1, Column: 6, Offset: 6},
+						},
+					},
+					CatchBlock: &ast.Block{
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 14, Offset: 14},
+							EndPos:   ast.Position{Line: 1, Column: 16, Offset: 16},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("with error identifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements("try { } catch (err) { }", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.TryStatement{
+					Block: &ast.Block{
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 4, Offset: 4},
+							EndPos:   ast.Position{Line: 1, Column: 6, Offset: 6},
+						},
+					},
+					ErrorIdentifier: &ast.Identifier{
+						Identifier: "err",
+						Pos:        ast.Position{Line: 1, Column: 15, Offset: 15},
+					},
+					CatchBlock: &ast.Block{
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 20, Offset: 20},
+							EndPos:   ast.Position{Line: 1, Column: 22, Offset: 22},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("missing catch", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, errs := ParseStatements("try { }", nil)
+		utils.AssertEqualWithDiff(t,
+			[]error{
+				&SyntaxError{
+					Message: "expected keyword \"catch\", got EOF",
+					Pos:     ast.Position{Offset: 7, Line: 1, Column: 7},
+				},
+			},
+			errs,
+		)
+	})
+
+	t.Run("with comment before block", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements("try /* a */ { } catch { }", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.TryStatement{
+					Block: &ast.Block{
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 12, Offset: 12},
+							EndPos:   ast.Position{Line: 1, Column: 14, Offset: 14},
+						},
+					},
+					CatchBlock: &ast.Block{
+						Range: ast.Range{
+							StartPos: ast.Position{Line: 1, Column: 22, Offset: 22},
+							EndPos:   ast.Position{Line: 1, Column: 24, Offset: 24},
+						},
+					},
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("function call", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements("try()", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.ExpressionStatement{
+					Expression: &ast.InvocationExpression{
+						InvokedExpression: &ast.IdentifierExpression{
+							Identifier: ast.Identifier{
+								Identifier: "try",
+								Pos:        ast.Position{Line: 1, Column: 0, Offset: 0},
+							},
+						},
+						ArgumentsStartPos: ast.Position{Line: 1, Column: 3, Offset: 3},
+						EndPos:            ast.Position{Line: 1, Column: 4, Offset: 4},
+					},
+				},
+			},
+			result,
+		)
+	})
+}
+
 func TestParseAssignmentStatement(t *testing.T) {
 
 	t.Parallel()
@@ -1242,6 +1380,265 @@ func TestParseSwitchStatement(t *testing.T) {
 			result,
 		)
 	})
+
+	t.Run("optional pattern", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements("switch x { case let y?: a }", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.SwitchStatement{
+					Expression: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "x",
+							Pos:        ast.Position{Line: 1, Column: 7, Offset: 7},
+						},
+					},
+					Cases: []*ast.SwitchCase{
+						{
+							Pattern: &ast.OptionalPattern{
+								Pattern: &ast.IdentifierPattern{
+									Identifier: ast.Identifier{
+										Identifier: "y",
+										Pos:        ast.Position{Line: 1, Column: 20, Offset: 20},
+									},
+								},
+								EndPos: ast.Position{Line: 1, Column: 21, Offset: 21},
+							},
+							Statements: []ast.Statement{
+								&ast.ExpressionStatement{
+									Expression: &ast.IdentifierExpression{
+										Identifier: ast.Identifier{
+											Identifier: "a",
+											Pos:        ast.Position{Line: 1, Column: 24, Offset: 24},
+										},
+									},
+								},
+							},
+							Range: ast.Range{
+								StartPos: ast.Position{Line: 1, Column: 11, Offset: 11},
+								EndPos:   ast.Position{Line: 1, Column: 24, Offset: 24},
+							},
+						},
+					},
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 26, Offset: 26},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("type pattern, guard", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements("switch x { case let v as R where v.ok: a }", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.SwitchStatement{
+					Expression: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "x",
+							Pos:        ast.Position{Line: 1, Column: 7, Offset: 7},
+						},
+					},
+					Cases: []*ast.SwitchCase{
+						{
+							Pattern: &ast.TypePattern{
+								Pattern: &ast.IdentifierPattern{
+									Identifier: ast.Identifier{
+										Identifier: "v",
+										Pos:        ast.Position{Line: 1, Column: 20, Offset: 20},
+									},
+								},
+								TypeAnnotation: &ast.TypeAnnotation{
+									IsResource: false,
+									Type: &ast.NominalType{
+										Identifier: ast.Identifier{
+											Identifier: "R",
+											Pos:        ast.Position{Line: 1, Column: 25, Offset: 25},
+										},
+									},
+									StartPos: ast.Position{Line: 1, Column: 25, Offset: 25},
+								},
+							},
+							Guard: &ast.MemberExpression{
+								Expression: &ast.IdentifierExpression{
+									Identifier: ast.Identifier{
+										Identifier: "v",
+										Pos:        ast.Position{Line: 1, Column: 33, Offset: 33},
+									},
+								},
+								AccessPos: ast.Position{Line: 1, Column: 34, Offset: 34},
+								Identifier: ast.Identifier{
+									Identifier: "ok",
+									Pos:        ast.Position{Line: 1, Column: 35, Offset: 35},
+								},
+							},
+							Statements: []ast.Statement{
+								&ast.ExpressionStatement{
+									Expression: &ast.IdentifierExpression{
+										Identifier: ast.Identifier{
+											Identifier: "a",
+											Pos:        ast.Position{Line: 1, Column: 39, Offset: 39},
+										},
+									},
+								},
+							},
+							Range: ast.Range{
+								StartPos: ast.Position{Line: 1, Column: 11, Offset: 11},
+								EndPos:   ast.Position{Line: 1, Column: 39, Offset: 39},
+							},
+						},
+					},
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 41, Offset: 41},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("expression, guard", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements("switch x { case y.b where z: a }", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.SwitchStatement{
+					Expression: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "x",
+							Pos:        ast.Position{Line: 1, Column: 7, Offset: 7},
+						},
+					},
+					Cases: []*ast.SwitchCase{
+						{
+							Expression: &ast.MemberExpression{
+								Expression: &ast.IdentifierExpression{
+									Identifier: ast.Identifier{
+										Identifier: "y",
+										Pos:        ast.Position{Line: 1, Column: 16, Offset: 16},
+									},
+								},
+								AccessPos: ast.Position{Line: 1, Column: 17, Offset: 17},
+								Identifier: ast.Identifier{
+									Identifier: "b",
+									Pos:        ast.Position{Line: 1, Column: 18, Offset: 18},
+								},
+							},
+							Guard: &ast.IdentifierExpression{
+								Identifier: ast.Identifier{
+									Identifier: "z",
+									Pos:        ast.Position{Line: 1, Column: 26, Offset: 26},
+								},
+							},
+							Statements: []ast.Statement{
+								&ast.ExpressionStatement{
+									Expression: &ast.IdentifierExpression{
+										Identifier: ast.Identifier{
+											Identifier: "a",
+											Pos:        ast.Position{Line: 1, Column: 29, Offset: 29},
+										},
+									},
+								},
+							},
+							Range: ast.Range{
+								StartPos: ast.Position{Line: 1, Column: 11, Offset: 11},
+								EndPos:   ast.Position{Line: 1, Column: 29, Offset: 29},
+							},
+						},
+					},
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 31, Offset: 31},
+					},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("identifier, guard", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseStatements("switch x { case y where y > 5: a }", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			[]ast.Statement{
+				&ast.SwitchStatement{
+					Expression: &ast.IdentifierExpression{
+						Identifier: ast.Identifier{
+							Identifier: "x",
+							Pos:        ast.Position{Line: 1, Column: 7, Offset: 7},
+						},
+					},
+					Cases: []*ast.SwitchCase{
+						{
+							Pattern: &ast.IdentifierPattern{
+								Identifier: ast.Identifier{
+									Identifier: "y",
+									Pos:        ast.Position{Line: 1, Column: 16, Offset: 16},
+								},
+							},
+							Guard: &ast.BinaryExpression{
+								Operation: ast.OperationGreater,
+								Left: &ast.IdentifierExpression{
+									Identifier: ast.Identifier{
+										Identifier: "y",
+										Pos:        ast.Position{Line: 1, Column: 24, Offset: 24},
+									},
+								},
+								Right: &ast.IntegerExpression{
+									PositiveLiteral: "5",
+									Value:           big.NewInt(5),
+									Base:            10,
+									Range: ast.Range{
+										StartPos: ast.Position{Line: 1, Column: 28, Offset: 28},
+										EndPos:   ast.Position{Line: 1, Column: 28, Offset: 28},
+									},
+								},
+							},
+							Statements: []ast.Statement{
+								&ast.ExpressionStatement{
+									Expression: &ast.IdentifierExpression{
+										Identifier: ast.Identifier{
+											Identifier: "a",
+											Pos:        ast.Position{Line: 1, Column: 31, Offset: 31},
+										},
+									},
+								},
+							},
+							Range: ast.Range{
+								StartPos: ast.Position{Line: 1, Column: 11, Offset: 11},
+								EndPos:   ast.Position{Line: 1, Column: 31, Offset: 31},
+							},
+						},
+					},
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+						EndPos:   ast.Position{Line: 1, Column: 33, Offset: 33},
+					},
+				},
+			},
+			result,
+		)
+	})
 }
 
 func TestParseIfStatementInFunctionDeclaration(t *testing.T) {
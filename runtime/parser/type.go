@@ -339,10 +339,11 @@ func defineReferenceType() {
 func defineRestrictedOrDictionaryType() {
 
 	// For the null denotation it is not clear after the start
-	// if it is a restricted type or a dictionary type.
+	// if it is a restricted type, a set type, or a dictionary type.
 	//
 	// If a colon is seen it is a dictionary type.
-	// If no colon is seen it is a restricted type.
+	// If a single type is seen it is a set type.
+	// Otherwise it is a restricted type.
 
 	setTypeNullDenotation(
 		lexer.TokenBraceOpen,
@@ -477,8 +478,21 @@ func defineRestrictedOrDictionaryType() {
 			case dictionaryType != nil:
 				dictionaryType.EndPos = endPos
 				return dictionaryType, nil
+			case firstType != nil:
+				// A single type in braces is a set type.
+				// NOTE: the checker treats it as a restricted type
+				// if the element type is an interface type
+				return ast.NewSetType(
+					p.memoryGauge,
+					firstType,
+					ast.NewRange(
+						p.memoryGauge,
+						startToken.StartPos,
+						endPos,
+					),
+				), nil
 			default:
-				restrictedType = ast.NewRestrictedType(
+				return ast.NewRestrictedType(
 					p.memoryGauge,
 					nil,
 					nil,
@@ -487,15 +501,7 @@ func defineRestrictedOrDictionaryType() {
 						startToken.StartPos,
 						endPos,
 					),
-				)
-				if firstType != nil {
-					firstNominalType, ok := firstType.(*ast.NominalType)
-					if !ok {
-						return nil, p.syntaxError("non-nominal type in restriction list: %s", firstType)
-					}
-					restrictedType.Restrictions = append(restrictedType.Restrictions, firstNominalType)
-				}
-				return restrictedType, nil
+				), nil
 			}
 		},
 	)
@@ -867,6 +873,28 @@ func parseNominalTypeInvocationRemainder(p *parser) (*ast.InvocationExpression,
 	}
 
 	p.skipSpaceAndComments(true)
+
+	// Optional type arguments, e.g. `create Vault<String>()`.
+	// Unlike in other invocations, the less token is unambiguous here
+
+	var typeArguments []*ast.TypeAnnotation
+	if p.current.Is(lexer.TokenLess) {
+		p.next()
+		p.skipSpaceAndComments(true)
+
+		typeArguments, err = parseCommaSeparatedTypeAnnotations(p, lexer.TokenGreater)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = p.mustOne(lexer.TokenGreater)
+		if err != nil {
+			return nil, err
+		}
+
+		p.skipSpaceAndComments(true)
+	}
+
 	parenOpenToken, err := p.mustOne(lexer.TokenParenOpen)
 	if err != nil {
 		return nil, err
@@ -896,7 +924,7 @@ func parseNominalTypeInvocationRemainder(p *parser) (*ast.InvocationExpression,
 	return ast.NewInvocationExpression(
 		p.memoryGauge,
 		invokedExpression,
-		nil,
+		typeArguments,
 		arguments,
 		argumentsStartPos,
 		endPos,
@@ -471,17 +471,19 @@ func TestParseRestrictedType(t *testing.T) {
 
 		t.Parallel()
 
+		// A single type in braces is parsed as a set type.
+		// The checker treats it as a restricted type
+		// if the element type is an interface type
+
 		result, errs := ParseType("{ T }", nil)
 		require.Empty(t, errs)
 
 		utils.AssertEqualWithDiff(t,
-			&ast.RestrictedType{
-				Restrictions: []*ast.NominalType{
-					{
-						Identifier: ast.Identifier{
-							Identifier: "T",
-							Pos:        ast.Position{Line: 1, Column: 2, Offset: 2},
-						},
+			&ast.SetType{
+				ElementType: &ast.NominalType{
+					Identifier: ast.Identifier{
+						Identifier: "T",
+						Pos:        ast.Position{Line: 1, Column: 2, Offset: 2},
 					},
 				},
 				Range: ast.Range{
@@ -584,16 +586,48 @@ func TestParseRestrictedType(t *testing.T) {
 		assert.Nil(t, result)
 	})
 
-	t.Run("invalid: without restricted type, first is non-nominal", func(t *testing.T) {
+	t.Run("without restricted type, first is non-nominal", func(t *testing.T) {
 
 		t.Parallel()
 
+		// A single non-nominal type in braces is parsed as a set type
+
 		result, errs := ParseType("{[T]}", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.SetType{
+				ElementType: &ast.VariableSizedType{
+					Type: &ast.NominalType{
+						Identifier: ast.Identifier{
+							Identifier: "T",
+							Pos:        ast.Position{Line: 1, Column: 2, Offset: 2},
+						},
+					},
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 1, Offset: 1},
+						EndPos:   ast.Position{Line: 1, Column: 3, Offset: 3},
+					},
+				},
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					EndPos:   ast.Position{Line: 1, Column: 4, Offset: 4},
+				},
+			},
+			result,
+		)
+	})
+
+	t.Run("invalid: without restricted type, first is non-nominal, multiple types", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseType("{[T], U}", nil)
 		utils.AssertEqualWithDiff(t,
 			[]error{
 				&SyntaxError{
 					Message: "non-nominal type in restriction list: [T]",
-					Pos:     ast.Position{Offset: 5, Line: 1, Column: 5},
+					Pos:     ast.Position{Offset: 4, Line: 1, Column: 4},
 				},
 			},
 			errs,
@@ -805,6 +839,41 @@ func TestParseRestrictedType(t *testing.T) {
 	})
 }
 
+func TestParseSetType(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		result, errs := ParseType("{{T}}", nil)
+		require.Empty(t, errs)
+
+		utils.AssertEqualWithDiff(t,
+			&ast.SetType{
+				ElementType: &ast.SetType{
+					ElementType: &ast.NominalType{
+						Identifier: ast.Identifier{
+							Identifier: "T",
+							Pos:        ast.Position{Line: 1, Column: 2, Offset: 2},
+						},
+					},
+					Range: ast.Range{
+						StartPos: ast.Position{Line: 1, Column: 1, Offset: 1},
+						EndPos:   ast.Position{Line: 1, Column: 3, Offset: 3},
+					},
+				},
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 0, Offset: 0},
+					EndPos:   ast.Position{Line: 1, Column: 4, Offset: 4},
+				},
+			},
+			result,
+		)
+	})
+}
+
 func TestParseDictionaryType(t *testing.T) {
 
 	t.Parallel()
@@ -2537,13 +2606,11 @@ func TestParseRestrictedReferenceTypeWithoutBaseType(t *testing.T) {
 				TypeAnnotation: &ast.TypeAnnotation{
 					IsResource: false,
 					Type: &ast.ReferenceType{
-						Type: &ast.RestrictedType{
-							Restrictions: []*ast.NominalType{
-								{
-									Identifier: ast.Identifier{
-										Identifier: "I",
-										Pos:        ast.Position{Offset: 17, Line: 2, Column: 16},
-									},
+						Type: &ast.SetType{
+							ElementType: &ast.NominalType{
+								Identifier: ast.Identifier{
+									Identifier: "I",
+									Pos:        ast.Position{Offset: 17, Line: 2, Column: 16},
 								},
 							},
 							Range: ast.Range{
@@ -2661,13 +2728,11 @@ func TestParseOptionalRestrictedTypeOnlyRestrictions(t *testing.T) {
 				TypeAnnotation: &ast.TypeAnnotation{
 					IsResource: true,
 					Type: &ast.OptionalType{
-						Type: &ast.RestrictedType{
-							Restrictions: []*ast.NominalType{
-								{
-									Identifier: ast.Identifier{
-										Identifier: "I",
-										Pos:        ast.Position{Offset: 17, Line: 2, Column: 16},
-									},
+						Type: &ast.SetType{
+							ElementType: &ast.NominalType{
+								Identifier: ast.Identifier{
+									Identifier: "I",
+									Pos:        ast.Position{Offset: 17, Line: 2, Column: 16},
 								},
 							},
 							Range: ast.Range{
@@ -0,0 +1,454 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replay
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/onflow/atree"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+// Recorder is an implementation of runtime.Interface which delegates to another interface,
+// and records all calls and their results.
+//
+// Programs are not delegated and not recorded, but cached by the recorder itself:
+// The code of all imported programs is recorded, so they can be parsed and checked again in a replay.
+// Traces, implementation debug logs, and resource owner changes are delegated, but not recorded,
+// as they do not influence the execution.
+//
+type Recorder struct {
+	inter    runtime.Interface
+	calls    []Call
+	programs map[common.Location]*interpreter.Program
+}
+
+var _ runtime.Interface = &Recorder{}
+
+// NewRecorder returns a new recorder which delegates to the given interface
+//
+func NewRecorder(inter runtime.Interface) *Recorder {
+	return &Recorder{
+		inter:    inter,
+		programs: map[common.Location]*interpreter.Program{},
+	}
+}
+
+// Calls returns the recorded calls
+//
+func (r *Recorder) Calls() []Call {
+	return r.calls
+}
+
+// RecordTransaction executes the given transaction,
+// and returns the recording of the execution, even if the execution failed.
+//
+func RecordTransaction(rt runtime.Runtime, script runtime.Script, context runtime.Context) (*Recording, error) {
+	recorder := NewRecorder(context.Interface)
+	context.Interface = recorder
+
+	err := rt.ExecuteTransaction(script, context)
+
+	return newRecording(ExecutionKindTransaction, script, context.Location, recorder.calls, nil, err), err
+}
+
+// RecordScript executes the given script,
+// and returns the recording of the execution, even if the execution failed.
+//
+func RecordScript(rt runtime.Runtime, script runtime.Script, context runtime.Context) (*Recording, cadence.Value, error) {
+	recorder := NewRecorder(context.Interface)
+	context.Interface = recorder
+
+	value, err := rt.ExecuteScript(script, context)
+
+	return newRecording(ExecutionKindScript, script, context.Location, recorder.calls, value, err), value, err
+}
+
+func newRecording(
+	kind ExecutionKind,
+	script runtime.Script,
+	location common.Location,
+	calls []Call,
+	value cadence.Value,
+	err error,
+) *Recording {
+	result, message := outcome(value, err)
+
+	return &Recording{
+		Kind:      kind,
+		Location:  newLocation(location),
+		Source:    string(script.Source),
+		Arguments: script.Arguments,
+		Calls:     calls,
+		Result:    result,
+		Error:     message,
+	}
+}
+
+func (r *Recorder) record(function string, arguments []any, results []any, err error) {
+	call := Call{
+		Function:  function,
+		Arguments: encodeCallValues(arguments),
+		Results:   encodeCallValues(results),
+	}
+
+	if err != nil {
+		message := err.Error()
+		call.Error = &message
+	}
+
+	r.calls = append(r.calls, call)
+}
+
+// encodeValue encodes the given value in JSON-Cadence format
+//
+func encodeValue(value cadence.Value) json.RawMessage {
+	if value == nil {
+		return json.RawMessage("null")
+	}
+
+	data, err := jsoncdc.Encode(value)
+	if err != nil {
+		panic(errors.NewUnexpectedErrorFromCause(err))
+	}
+	return data
+}
+
+// resolvedLocation is the encoding of a runtime.ResolvedLocation in a recording
+//
+type resolvedLocation struct {
+	Location    *Location `json:"location"`
+	Identifiers []string  `json:"identifiers"`
+}
+
+func identifierStrings(identifiers []runtime.Identifier) []string {
+	result := make([]string, 0, len(identifiers))
+	for _, identifier := range identifiers {
+		result = append(result, identifier.Identifier)
+	}
+	return result
+}
+
+func (r *Recorder) ResolveLocation(
+	identifiers []runtime.Identifier,
+	location runtime.Location,
+) (
+	resolvedLocations []runtime.ResolvedLocation,
+	err error,
+) {
+	resolvedLocations, err = r.inter.ResolveLocation(identifiers, location)
+
+	encodedResolvedLocations := make([]resolvedLocation, 0, len(resolvedLocations))
+	for _, resolved := range resolvedLocations {
+		encodedResolvedLocations = append(
+			encodedResolvedLocations,
+			resolvedLocation{
+				Location:    newLocation(resolved.Location),
+				Identifiers: identifierStrings(resolved.Identifiers),
+			},
+		)
+	}
+
+	r.record(
+		"ResolveLocation",
+		[]any{identifierStrings(identifiers), newLocation(location)},
+		[]any{encodedResolvedLocations},
+		err,
+	)
+	return
+}
+
+func (r *Recorder) GetCode(location runtime.Location) (code []byte, err error) {
+	code, err = r.inter.GetCode(location)
+	r.record("GetCode", []any{newLocation(location)}, []any{code}, err)
+	return
+}
+
+func (r *Recorder) GetProgram(location runtime.Location) (*interpreter.Program, error) {
+	return r.programs[location], nil
+}
+
+func (r *Recorder) SetProgram(location runtime.Location, program *interpreter.Program) error {
+	r.programs[location] = program
+	return nil
+}
+
+func (r *Recorder) GetValue(owner, key []byte) (value []byte, err error) {
+	value, err = r.inter.GetValue(owner, key)
+	r.record("GetValue", []any{owner, key}, []any{value}, err)
+	return
+}
+
+func (r *Recorder) SetValue(owner, key, value []byte) (err error) {
+	err = r.inter.SetValue(owner, key, value)
+	r.record("SetValue", []any{owner, key, value}, nil, err)
+	return
+}
+
+func (r *Recorder) ValueExists(owner, key []byte) (exists bool, err error) {
+	exists, err = r.inter.ValueExists(owner, key)
+	r.record("ValueExists", []any{owner, key}, []any{exists}, err)
+	return
+}
+
+func (r *Recorder) AllocateStorageIndex(owner []byte) (index atree.StorageIndex, err error) {
+	index, err = r.inter.AllocateStorageIndex(owner)
+	r.record("AllocateStorageIndex", []any{owner}, []any{index}, err)
+	return
+}
+
+func (r *Recorder) CreateAccount(payer runtime.Address) (address runtime.Address, err error) {
+	address, err = r.inter.CreateAccount(payer)
+	r.record("CreateAccount", []any{payer}, []any{address}, err)
+	return
+}
+
+func (r *Recorder) AddEncodedAccountKey(address runtime.Address, publicKey []byte) (err error) {
+	err = r.inter.AddEncodedAccountKey(address, publicKey)
+	r.record("AddEncodedAccountKey", []any{address, publicKey}, nil, err)
+	return
+}
+
+func (r *Recorder) RevokeEncodedAccountKey(address runtime.Address, index int) (publicKey []byte, err error) {
+	publicKey, err = r.inter.RevokeEncodedAccountKey(address, index)
+	r.record("RevokeEncodedAccountKey", []any{address, index}, []any{publicKey}, err)
+	return
+}
+
+func (r *Recorder) AddAccountKey(
+	address runtime.Address,
+	publicKey *runtime.PublicKey,
+	hashAlgo runtime.HashAlgorithm,
+	weight int,
+) (
+	accountKey *runtime.AccountKey,
+	err error,
+) {
+	accountKey, err = r.inter.AddAccountKey(address, publicKey, hashAlgo, weight)
+	r.record("AddAccountKey", []any{address, publicKey, hashAlgo, weight}, []any{accountKey}, err)
+	return
+}
+
+func (r *Recorder) GetAccountKey(address runtime.Address, index int) (accountKey *runtime.AccountKey, err error) {
+	accountKey, err = r.inter.GetAccountKey(address, index)
+	r.record("GetAccountKey", []any{address, index}, []any{accountKey}, err)
+	return
+}
+
+func (r *Recorder) RevokeAccountKey(address runtime.Address, index int) (accountKey *runtime.AccountKey, err error) {
+	accountKey, err = r.inter.RevokeAccountKey(address, index)
+	r.record("RevokeAccountKey", []any{address, index}, []any{accountKey}, err)
+	return
+}
+
+func (r *Recorder) UpdateAccountContractCode(address runtime.Address, name string, code []byte) (err error) {
+	err = r.inter.UpdateAccountContractCode(address, name, code)
+	r.record("UpdateAccountContractCode", []any{address, name, code}, nil, err)
+	return
+}
+
+func (r *Recorder) GetAccountContractCode(address runtime.Address, name string) (code []byte, err error) {
+	code, err = r.inter.GetAccountContractCode(address, name)
+	r.record("GetAccountContractCode", []any{address, name}, []any{code}, err)
+	return
+}
+
+func (r *Recorder) RemoveAccountContractCode(address runtime.Address, name string) (err error) {
+	err = r.inter.RemoveAccountContractCode(address, name)
+	r.record("RemoveAccountContractCode", []any{address, name}, nil, err)
+	return
+}
+
+func (r *Recorder) GetSigningAccounts() (signers []runtime.Address, err error) {
+	signers, err = r.inter.GetSigningAccounts()
+	r.record("GetSigningAccounts", nil, []any{signers}, err)
+	return
+}
+
+func (r *Recorder) ProgramLog(message string) (err error) {
+	err = r.inter.ProgramLog(message)
+	r.record("ProgramLog", []any{message}, nil, err)
+	return
+}
+
+func (r *Recorder) EmitEvent(event cadence.Event) (err error) {
+	err = r.inter.EmitEvent(event)
+	r.record("EmitEvent", []any{encodeValue(event)}, nil, err)
+	return
+}
+
+func (r *Recorder) GenerateUUID() (uuid uint64, err error) {
+	uuid, err = r.inter.GenerateUUID()
+	r.record("GenerateUUID", nil, []any{uuid}, err)
+	return
+}
+
+func (r *Recorder) MeterComputation(operationType common.ComputationKind, intensity uint) (err error) {
+	err = r.inter.MeterComputation(operationType, intensity)
+	r.record("MeterComputation", []any{operationType, intensity}, nil, err)
+	return
+}
+
+func (r *Recorder) DecodeArgument(argument []byte, argumentType cadence.Type) (value cadence.Value, err error) {
+	value, err = r.inter.DecodeArgument(argument, argumentType)
+	r.record("DecodeArgument", []any{argument, argumentType.ID()}, []any{encodeValue(value)}, err)
+	return
+}
+
+func (r *Recorder) GetCurrentBlockHeight() (height uint64, err error) {
+	height, err = r.inter.GetCurrentBlockHeight()
+	r.record("GetCurrentBlockHeight", nil, []any{height}, err)
+	return
+}
+
+func (r *Recorder) GetBlockAtHeight(height uint64) (block runtime.Block, exists bool, err error) {
+	block, exists, err = r.inter.GetBlockAtHeight(height)
+	r.record("GetBlockAtHeight", []any{height}, []any{block, exists}, err)
+	return
+}
+
+func (r *Recorder) UnsafeRandom() (random uint64, err error) {
+	random, err = r.inter.UnsafeRandom()
+	r.record("UnsafeRandom", nil, []any{random}, err)
+	return
+}
+
+func (r *Recorder) VerifySignature(
+	signature []byte,
+	tag string,
+	signedData []byte,
+	publicKey []byte,
+	signatureAlgorithm runtime.SignatureAlgorithm,
+	hashAlgorithm runtime.HashAlgorithm,
+) (
+	valid bool,
+	err error,
+) {
+	valid, err = r.inter.VerifySignature(
+		signature,
+		tag,
+		signedData,
+		publicKey,
+		signatureAlgorithm,
+		hashAlgorithm,
+	)
+	r.record(
+		"VerifySignature",
+		[]any{signature, tag, signedData, publicKey, signatureAlgorithm, hashAlgorithm},
+		[]any{valid},
+		err,
+	)
+	return
+}
+
+func (r *Recorder) Hash(data []byte, tag string, hashAlgorithm runtime.HashAlgorithm) (digest []byte, err error) {
+	digest, err = r.inter.Hash(data, tag, hashAlgorithm)
+	r.record("Hash", []any{data, tag, hashAlgorithm}, []any{digest}, err)
+	return
+}
+
+func (r *Recorder) GetAccountBalance(address common.Address) (value uint64, err error) {
+	value, err = r.inter.GetAccountBalance(address)
+	r.record("GetAccountBalance", []any{address}, []any{value}, err)
+	return
+}
+
+func (r *Recorder) GetAccountAvailableBalance(address common.Address) (value uint64, err error) {
+	value, err = r.inter.GetAccountAvailableBalance(address)
+	r.record("GetAccountAvailableBalance", []any{address}, []any{value}, err)
+	return
+}
+
+func (r *Recorder) GetStorageUsed(address runtime.Address) (value uint64, err error) {
+	value, err = r.inter.GetStorageUsed(address)
+	r.record("GetStorageUsed", []any{address}, []any{value}, err)
+	return
+}
+
+func (r *Recorder) GetStorageCapacity(address runtime.Address) (value uint64, err error) {
+	value, err = r.inter.GetStorageCapacity(address)
+	r.record("GetStorageCapacity", []any{address}, []any{value}, err)
+	return
+}
+
+func (r *Recorder) ImplementationDebugLog(message string) error {
+	return r.inter.ImplementationDebugLog(message)
+}
+
+func (r *Recorder) ValidatePublicKey(key *runtime.PublicKey) (err error) {
+	err = r.inter.ValidatePublicKey(key)
+	r.record("ValidatePublicKey", []any{key}, nil, err)
+	return
+}
+
+func (r *Recorder) GetAccountContractNames(address runtime.Address) (names []string, err error) {
+	names, err = r.inter.GetAccountContractNames(address)
+	r.record("GetAccountContractNames", []any{address}, []any{names}, err)
+	return
+}
+
+func (r *Recorder) RecordTrace(
+	operation string,
+	location common.Location,
+	duration time.Duration,
+	attrs []attribute.KeyValue,
+) {
+	r.inter.RecordTrace(operation, location, duration, attrs)
+}
+
+func (r *Recorder) BLSVerifyPOP(publicKey *runtime.PublicKey, signature []byte) (valid bool, err error) {
+	valid, err = r.inter.BLSVerifyPOP(publicKey, signature)
+	r.record("BLSVerifyPOP", []any{publicKey, signature}, []any{valid}, err)
+	return
+}
+
+func (r *Recorder) BLSAggregateSignatures(signatures [][]byte) (signature []byte, err error) {
+	signature, err = r.inter.BLSAggregateSignatures(signatures)
+	r.record("BLSAggregateSignatures", []any{signatures}, []any{signature}, err)
+	return
+}
+
+func (r *Recorder) BLSAggregatePublicKeys(publicKeys []*runtime.PublicKey) (publicKey *runtime.PublicKey, err error) {
+	publicKey, err = r.inter.BLSAggregatePublicKeys(publicKeys)
+	r.record("BLSAggregatePublicKeys", []any{publicKeys}, []any{publicKey}, err)
+	return
+}
+
+func (r *Recorder) ResourceOwnerChanged(
+	inter *interpreter.Interpreter,
+	resource *interpreter.CompositeValue,
+	oldOwner common.Address,
+	newOwner common.Address,
+) {
+	r.inter.ResourceOwnerChanged(inter, resource, oldOwner, newOwner)
+}
+
+func (r *Recorder) MeterMemory(usage common.MemoryUsage) (err error) {
+	err = r.inter.MeterMemory(usage)
+	r.record("MeterMemory", []any{usage}, nil, err)
+	return
+}
@@ -0,0 +1,259 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replay
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+)
+
+// ExecutionKind is the kind of a recorded execution
+//
+type ExecutionKind string
+
+const (
+	ExecutionKindTransaction ExecutionKind = "transaction"
+	ExecutionKindScript      ExecutionKind = "script"
+)
+
+// Recording is the log of all calls an execution of a transaction or script
+// made to the runtime interface, in order, together with their results.
+//
+// A recording contains everything that is needed to re-run the execution
+// without the original environment, see Replay.
+//
+// The outcome of the execution is recorded, so a replay can be checked against it:
+// The result is the value returned by a script, in JSON-Cadence format, if any.
+// The error is the message of the error the execution failed with, if any.
+//
+type Recording struct {
+	Kind      ExecutionKind   `json:"kind"`
+	Location  *Location       `json:"location"`
+	Source    string          `json:"source"`
+	Arguments [][]byte        `json:"arguments"`
+	Calls     []Call          `json:"calls"`
+	Result    json.RawMessage `json:"result,omitempty"`
+	Error     *string         `json:"error,omitempty"`
+}
+
+// outcome returns the encoding of the outcome of an execution,
+// i.e. the result value in JSON-Cadence format, and the message of the error
+//
+func outcome(value cadence.Value, err error) (result json.RawMessage, message *string) {
+	if value != nil {
+		result = encodeValue(value)
+	}
+
+	if err != nil {
+		errorMessage := err.Error()
+		message = &errorMessage
+	}
+
+	return
+}
+
+// Call is a recorded call to a function of the runtime interface.
+//
+// The arguments and the results are JSON arrays.
+// The error is the message of the error returned by the call, if any.
+//
+type Call struct {
+	Function  string          `json:"function"`
+	Arguments json.RawMessage `json:"arguments"`
+	Results   json.RawMessage `json:"results"`
+	Error     *string         `json:"error,omitempty"`
+}
+
+func (c Call) String() string {
+	return fmt.Sprintf("%s(%s)", c.Function, c.Arguments)
+}
+
+// ReadRecording reads the recording in the file at the given path
+//
+func ReadRecording(path string) (*Recording, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var recording *Recording
+	err = json.Unmarshal(data, &recording)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recording: %w", err)
+	}
+
+	return recording, nil
+}
+
+// WriteFile writes the recording to the file at the given path
+//
+func (r *Recording) WriteFile(path string) error {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0644)
+}
+
+// encodeCallValues encodes the arguments or the results of a call
+//
+func encodeCallValues(values []any) json.RawMessage {
+	if values == nil {
+		values = []any{}
+	}
+
+	data, err := json.Marshal(values)
+	if err != nil {
+		panic(errors.NewUnexpectedErrorFromCause(err))
+	}
+	return data
+}
+
+// compactJSON returns the given JSON without insignificant whitespace,
+// so recordings which were reformatted still match
+//
+func compactJSON(data []byte) []byte {
+	var buffer bytes.Buffer
+	err := json.Compact(&buffer, data)
+	if err != nil {
+		return data
+	}
+	return buffer.Bytes()
+}
+
+// Location is the encoding of a common.Location in a recording
+//
+type Location struct {
+	Prefix string `json:"prefix"`
+	ID     string `json:"id,omitempty"`
+	Name   string `json:"name,omitempty"`
+}
+
+func newLocation(location common.Location) *Location {
+	switch location := location.(type) {
+	case nil:
+		return nil
+
+	case common.AddressLocation:
+		return &Location{
+			Prefix: common.AddressLocationPrefix,
+			ID:     location.Address.Hex(),
+			Name:   location.Name,
+		}
+
+	case common.TransactionLocation:
+		return &Location{
+			Prefix: common.TransactionLocationPrefix,
+			ID:     hex.EncodeToString(location[:]),
+		}
+
+	case common.ScriptLocation:
+		return &Location{
+			Prefix: common.ScriptLocationPrefix,
+			ID:     hex.EncodeToString(location[:]),
+		}
+
+	case common.StringLocation:
+		return &Location{
+			Prefix: common.StringLocationPrefix,
+			ID:     string(location),
+		}
+
+	case common.IdentifierLocation:
+		return &Location{
+			Prefix: common.IdentifierLocationPrefix,
+			ID:     string(location),
+		}
+
+	case common.REPLLocation:
+		return &Location{
+			Prefix: common.REPLLocationPrefix,
+		}
+
+	default:
+		panic(errors.NewUnexpectedError("cannot record location: %s", location))
+	}
+}
+
+// CommonLocation returns the location which is encoded by the given recorded location
+//
+func (l *Location) CommonLocation() (common.Location, error) {
+	if l == nil {
+		return nil, nil
+	}
+
+	decodeHash := func() (hash [32]byte, err error) {
+		decoded, err := hex.DecodeString(l.ID)
+		if err != nil {
+			return
+		}
+		if len(decoded) != len(hash) {
+			err = fmt.Errorf("invalid location hash: %s", l.ID)
+			return
+		}
+		copy(hash[:], decoded)
+		return
+	}
+
+	switch l.Prefix {
+	case common.AddressLocationPrefix:
+		address, err := common.HexToAddress(l.ID)
+		if err != nil {
+			return nil, err
+		}
+		return common.AddressLocation{
+			Address: address,
+			Name:    l.Name,
+		}, nil
+
+	case common.TransactionLocationPrefix:
+		hash, err := decodeHash()
+		if err != nil {
+			return nil, err
+		}
+		return common.TransactionLocation(hash), nil
+
+	case common.ScriptLocationPrefix:
+		hash, err := decodeHash()
+		if err != nil {
+			return nil, err
+		}
+		return common.ScriptLocation(hash), nil
+
+	case common.StringLocationPrefix:
+		return common.StringLocation(l.ID), nil
+
+	case common.IdentifierLocationPrefix:
+		return common.IdentifierLocation(l.ID), nil
+
+	case common.REPLLocationPrefix:
+		return common.REPLLocation{}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported location prefix: %s", l.Prefix)
+	}
+}
@@ -0,0 +1,454 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replay
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/emulator"
+)
+
+const testContract = `
+  pub contract C {
+
+      pub event Incremented(count: Int)
+
+      pub var count: Int
+
+      init() {
+          self.count = 0
+      }
+
+      pub fun increment() {
+          self.count = self.count + 1
+          emit Incremented(count: self.count)
+      }
+  }
+`
+
+func newTestEmulator(t *testing.T) *emulator.Emulator {
+	e := emulator.NewEmulator()
+
+	address, err := e.CreateAccount(common.Address{})
+	require.NoError(t, err)
+
+	err = e.DeployContract(address, "C", []byte(testContract))
+	require.NoError(t, err)
+
+	return e
+}
+
+func encodeArgument(t *testing.T, value cadence.Value) []byte {
+	argument, err := jsoncdc.Encode(value)
+	require.NoError(t, err)
+	return argument
+}
+
+func TestReplayTransaction(t *testing.T) {
+
+	t.Parallel()
+
+	e := newTestEmulator(t)
+
+	script := runtime.Script{
+		Source: []byte(`
+          import C from 0x1
+
+          transaction(amount: Int) {
+              execute {
+                  var i = 0
+                  while i < amount {
+                      C.increment()
+                      i = i + 1
+                  }
+                  log(C.count)
+                  log(unsafeRandom())
+                  log(getCurrentBlock().height)
+              }
+          }
+        `),
+		Arguments: [][]byte{
+			encodeArgument(t, cadence.NewInt(2)),
+		},
+	}
+
+	recording, err := RecordTransaction(
+		runtime.NewInterpreterRuntime(),
+		script,
+		runtime.Context{
+			Interface: e,
+			Location:  common.TransactionLocation{0x1},
+		},
+	)
+	require.NoError(t, err)
+
+	functions := map[string]int{}
+	for _, call := range recording.Calls {
+		functions[call.Function]++
+	}
+
+	assert.Equal(t, 3, functions["ProgramLog"])
+	assert.Equal(t, 2, functions["EmitEvent"])
+	assert.Equal(t, 1, functions["UnsafeRandom"])
+	assert.Equal(t, 1, functions["DecodeArgument"])
+	assert.NotZero(t, functions["GetAccountContractCode"])
+	assert.NotZero(t, functions["SetValue"])
+
+	// The state was changed by the recorded execution
+
+	value, err := e.ExecuteScript(runtime.Script{
+		Source: []byte(`
+          import C from 0x1
+
+          pub fun main(): Int {
+              return C.count
+          }
+        `),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, cadence.NewInt(2), value)
+
+	// Write and read the recording, and replay it without the emulator
+
+	recordingPath := path.Join(t.TempDir(), "recording.json")
+
+	err = recording.WriteFile(recordingPath)
+	require.NoError(t, err)
+
+	readRecording, err := ReadRecording(recordingPath)
+	require.NoError(t, err)
+
+	assert.Equal(t, ExecutionKindTransaction, readRecording.Kind)
+
+	location, err := readRecording.Location.CommonLocation()
+	require.NoError(t, err)
+	assert.Equal(t, common.TransactionLocation{0x1}, location)
+
+	_, err = Replay(runtime.NewInterpreterRuntime(), readRecording)
+	require.NoError(t, err)
+}
+
+func TestReplayScript(t *testing.T) {
+
+	t.Parallel()
+
+	e := newTestEmulator(t)
+
+	script := runtime.Script{
+		Source: []byte(`
+          import C from 0x1
+
+          pub fun main(address: Address): [AnyStruct] {
+              let account = getAccount(address)
+              return [C.count, account.contracts.names, account.storageUsed > 0]
+          }
+        `),
+		Arguments: [][]byte{
+			encodeArgument(t, cadence.BytesToAddress([]byte{0x1})),
+		},
+	}
+
+	recording, value, err := RecordScript(
+		runtime.NewInterpreterRuntime(),
+		script,
+		runtime.Context{
+			Interface: e,
+			Location:  common.ScriptLocation{0x1},
+		},
+	)
+	require.NoError(t, err)
+
+	require.NotNil(t, recording.Result)
+	assert.Nil(t, recording.Error)
+
+	replayedValue, err := Replay(runtime.NewInterpreterRuntime(), recording)
+	require.NoError(t, err)
+
+	assert.Equal(t, value, replayedValue)
+}
+
+func TestReplayFailure(t *testing.T) {
+
+	t.Parallel()
+
+	e := newTestEmulator(t)
+
+	script := runtime.Script{
+		Source: []byte(`
+          import C from 0x1
+
+          transaction {
+              execute {
+                  C.increment()
+                  panic("failing")
+              }
+          }
+        `),
+	}
+
+	recording, err := RecordTransaction(
+		runtime.NewInterpreterRuntime(),
+		script,
+		runtime.Context{
+			Interface: e,
+			Location:  common.TransactionLocation{0x1},
+		},
+	)
+	require.Error(t, err)
+
+	require.NotNil(t, recording.Error)
+	assert.Equal(t, err.Error(), *recording.Error)
+
+	_, replayErr := Replay(runtime.NewInterpreterRuntime(), recording)
+	require.Error(t, replayErr)
+
+	var divergenceErr DivergenceError
+	require.False(t, errors.As(replayErr, &divergenceErr))
+
+	var outcomeDivergenceErr OutcomeDivergenceError
+	require.False(t, errors.As(replayErr, &outcomeDivergenceErr))
+
+	assert.Equal(t, err.Error(), replayErr.Error())
+}
+
+func TestReplayOutcomeDivergence(t *testing.T) {
+
+	t.Parallel()
+
+	recordScript := func(t *testing.T, source string) *Recording {
+		e := newTestEmulator(t)
+
+		recording, _, _ := RecordScript(
+			runtime.NewInterpreterRuntime(),
+			runtime.Script{
+				Source: []byte(source),
+			},
+			runtime.Context{
+				Interface: e,
+				Location:  common.ScriptLocation{0x1},
+			},
+		)
+
+		return recording
+	}
+
+	const succeedingScript = `
+      import C from 0x1
+
+      pub fun main(): Int {
+          return C.count
+      }
+    `
+
+	const failingScript = `
+      import C from 0x1
+
+      pub fun main(): Int {
+          panic("failing")
+      }
+    `
+
+	t.Run("different result", func(t *testing.T) {
+
+		t.Parallel()
+
+		recording := recordScript(t, succeedingScript)
+		recording.Result = encodeArgument(t, cadence.NewInt(42))
+
+		_, err := Replay(runtime.NewInterpreterRuntime(), recording)
+		require.Error(t, err)
+
+		var divergenceErr OutcomeDivergenceError
+		require.ErrorAs(t, err, &divergenceErr)
+
+		assert.Nil(t, divergenceErr.ActualError)
+		assert.Nil(t, divergenceErr.ExpectedError)
+		assert.JSONEq(t, string(encodeArgument(t, cadence.NewInt(0))), string(divergenceErr.ActualResult))
+	})
+
+	t.Run("recorded execution failed", func(t *testing.T) {
+
+		t.Parallel()
+
+		recording := recordScript(t, succeedingScript)
+		message := "failing"
+		recording.Error = &message
+
+		_, err := Replay(runtime.NewInterpreterRuntime(), recording)
+		require.Error(t, err)
+
+		var divergenceErr OutcomeDivergenceError
+		require.ErrorAs(t, err, &divergenceErr)
+
+		assert.Nil(t, divergenceErr.ActualError)
+		require.NotNil(t, divergenceErr.ExpectedError)
+		assert.Equal(t, message, *divergenceErr.ExpectedError)
+	})
+
+	t.Run("replayed execution failed", func(t *testing.T) {
+
+		t.Parallel()
+
+		recording := recordScript(t, failingScript)
+		require.NotNil(t, recording.Error)
+		recording.Error = nil
+
+		_, err := Replay(runtime.NewInterpreterRuntime(), recording)
+		require.Error(t, err)
+
+		var divergenceErr OutcomeDivergenceError
+		require.ErrorAs(t, err, &divergenceErr)
+
+		assert.NotNil(t, divergenceErr.ActualError)
+		assert.Nil(t, divergenceErr.ExpectedError)
+	})
+
+	t.Run("different error", func(t *testing.T) {
+
+		t.Parallel()
+
+		recording := recordScript(t, failingScript)
+		message := "different"
+		recording.Error = &message
+
+		_, err := Replay(runtime.NewInterpreterRuntime(), recording)
+		require.Error(t, err)
+
+		var divergenceErr OutcomeDivergenceError
+		require.ErrorAs(t, err, &divergenceErr)
+
+		require.NotNil(t, divergenceErr.ActualError)
+		assert.NotEqual(t, message, *divergenceErr.ActualError)
+	})
+}
+
+func TestReplayDivergence(t *testing.T) {
+
+	t.Parallel()
+
+	transaction := func(message string) string {
+		return fmt.Sprintf(
+			`
+              import C from 0x1
+
+              transaction {
+                  execute {
+                      C.increment()
+                      log("%s")
+                  }
+              }
+            `,
+			message,
+		)
+	}
+
+	record := func(t *testing.T) *Recording {
+		e := newTestEmulator(t)
+
+		recording, err := RecordTransaction(
+			runtime.NewInterpreterRuntime(),
+			runtime.Script{
+				Source: []byte(transaction("done")),
+			},
+			runtime.Context{
+				Interface: e,
+				Location:  common.TransactionLocation{0x1},
+			},
+		)
+		require.NoError(t, err)
+
+		return recording
+	}
+
+	t.Run("different arguments", func(t *testing.T) {
+
+		t.Parallel()
+
+		// Only change the logged message,
+		// but not its length, which would change the metered memory
+
+		recording := record(t)
+		recording.Source = transaction("gone")
+
+		_, err := Replay(runtime.NewInterpreterRuntime(), recording)
+		require.Error(t, err)
+
+		var divergenceErr DivergenceError
+		require.ErrorAs(t, err, &divergenceErr)
+
+		require.NotNil(t, divergenceErr.Expected)
+		require.NotNil(t, divergenceErr.Actual)
+		assert.Equal(t, "ProgramLog", divergenceErr.Expected.Function)
+		assert.Equal(t, "ProgramLog", divergenceErr.Actual.Function)
+		assert.Equal(t, `["\"gone\""]`, string(divergenceErr.Actual.Arguments))
+	})
+
+	t.Run("recording ended", func(t *testing.T) {
+
+		t.Parallel()
+
+		recording := record(t)
+		recording.Calls = recording.Calls[:len(recording.Calls)/2]
+
+		_, err := Replay(runtime.NewInterpreterRuntime(), recording)
+		require.Error(t, err)
+
+		var divergenceErr DivergenceError
+		require.ErrorAs(t, err, &divergenceErr)
+
+		assert.Nil(t, divergenceErr.Expected)
+		require.NotNil(t, divergenceErr.Actual)
+		assert.Equal(t, len(recording.Calls), divergenceErr.Index)
+	})
+
+	t.Run("execution ended", func(t *testing.T) {
+
+		t.Parallel()
+
+		recording := record(t)
+		recording.Calls = append(
+			recording.Calls,
+			Call{
+				Function:  "ProgramLog",
+				Arguments: encodeCallValues([]any{"missing"}),
+				Results:   encodeCallValues(nil),
+			},
+		)
+
+		_, err := Replay(runtime.NewInterpreterRuntime(), recording)
+		require.Error(t, err)
+
+		var divergenceErr DivergenceError
+		require.ErrorAs(t, err, &divergenceErr)
+
+		assert.Nil(t, divergenceErr.Actual)
+		require.NotNil(t, divergenceErr.Expected)
+		assert.Equal(t, "ProgramLog", divergenceErr.Expected.Function)
+	})
+}
@@ -0,0 +1,560 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replay
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/onflow/atree"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/runtime"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+// DivergenceError is reported when a replayed execution
+// does not call the runtime interface in the same way as the recorded execution
+//
+type DivergenceError struct {
+	// Index is the index of the call in the recording
+	Index int
+	// Expected is the recorded call, if any
+	Expected *Call
+	// Actual is the call made by the replayed execution, if any
+	Actual *Call
+}
+
+var _ error = DivergenceError{}
+
+func (e DivergenceError) Error() string {
+	switch {
+	case e.Actual == nil:
+		return fmt.Sprintf(
+			"replay diverged at call %d: execution ended, but the recording expected %s",
+			e.Index,
+			e.Expected,
+		)
+
+	case e.Expected == nil:
+		return fmt.Sprintf(
+			"replay diverged at call %d: execution called %s, but the recording ended",
+			e.Index,
+			e.Actual,
+		)
+
+	default:
+		return fmt.Sprintf(
+			"replay diverged at call %d: execution called %s, but the recording expected %s",
+			e.Index,
+			e.Actual,
+			e.Expected,
+		)
+	}
+}
+
+// OutcomeDivergenceError is reported when a replayed execution
+// made the same calls to the runtime interface as the recorded execution,
+// but has a different outcome, i.e. a different result or error
+//
+type OutcomeDivergenceError struct {
+	// ExpectedResult is the recorded result, if any
+	ExpectedResult json.RawMessage
+	// ExpectedError is the recorded error message, if any
+	ExpectedError *string
+	// ActualResult is the result of the replayed execution, if any
+	ActualResult json.RawMessage
+	// ActualError is the error message of the replayed execution, if any
+	ActualError *string
+}
+
+var _ error = OutcomeDivergenceError{}
+
+func (e OutcomeDivergenceError) Error() string {
+	return fmt.Sprintf(
+		"replay diverged: the recorded execution %s, but the replayed execution %s",
+		describeOutcome(e.ExpectedResult, e.ExpectedError),
+		describeOutcome(e.ActualResult, e.ActualError),
+	)
+}
+
+func describeOutcome(result json.RawMessage, message *string) string {
+	switch {
+	case message != nil:
+		return fmt.Sprintf("failed:\n%s", *message)
+	case result != nil:
+		return fmt.Sprintf("returned %s", compactJSON(result))
+	default:
+		return "succeeded"
+	}
+}
+
+// Replayer is an implementation of runtime.Interface
+// which answers all calls with the results of a recording.
+//
+// Each call must match the next call of the recording, i.e. the function and the arguments must be the same.
+// On the first mismatch, the replayer panics with a DivergenceError, and answers all further calls the same way.
+//
+type Replayer struct {
+	calls      []Call
+	index      int
+	programs   map[common.Location]*interpreter.Program
+	divergence *DivergenceError
+}
+
+var _ runtime.Interface = &Replayer{}
+
+// NewReplayer returns a new replayer for the given recorded calls
+//
+func NewReplayer(calls []Call) *Replayer {
+	return &Replayer{
+		calls:    calls,
+		programs: map[common.Location]*interpreter.Program{},
+	}
+}
+
+// Divergence returns the divergence of the replay from the recording, if any.
+// A replay diverges if a call does not match the recording,
+// or if not all calls of the recording were replayed.
+//
+func (r *Replayer) Divergence() *DivergenceError {
+	if r.divergence == nil && r.index < len(r.calls) {
+		return &DivergenceError{
+			Index:    r.index,
+			Expected: &r.calls[r.index],
+		}
+	}
+
+	return r.divergence
+}
+
+// Replay re-runs the recorded execution offline,
+// using the given runtime, and a Replayer for the recorded calls.
+//
+// If the replay diverges from the recording, the DivergenceError is returned,
+// instead of the result of the execution.
+// If the replayed execution has a different result or error than the recorded execution,
+// an OutcomeDivergenceError is returned.
+//
+func Replay(rt runtime.Runtime, recording *Recording) (cadence.Value, error) {
+	location, err := recording.Location.CommonLocation()
+	if err != nil {
+		return nil, err
+	}
+
+	replayer := NewReplayer(recording.Calls)
+
+	script := runtime.Script{
+		Source:    []byte(recording.Source),
+		Arguments: recording.Arguments,
+	}
+
+	context := runtime.Context{
+		Interface: replayer,
+		Location:  location,
+	}
+
+	var value cadence.Value
+
+	switch recording.Kind {
+	case ExecutionKindTransaction:
+		err = rt.ExecuteTransaction(script, context)
+
+	case ExecutionKindScript:
+		value, err = rt.ExecuteScript(script, context)
+
+	default:
+		return nil, fmt.Errorf("unsupported execution kind: %s", recording.Kind)
+	}
+
+	if divergence := replayer.Divergence(); divergence != nil {
+		return nil, *divergence
+	}
+
+	result, message := outcome(value, err)
+
+	if !equalOutcomeMessages(message, recording.Error) ||
+		!bytes.Equal(compactJSON(result), compactJSON(recording.Result)) {
+
+		return nil, OutcomeDivergenceError{
+			ExpectedResult: recording.Result,
+			ExpectedError:  recording.Error,
+			ActualResult:   result,
+			ActualError:    message,
+		}
+	}
+
+	return value, err
+}
+
+func equalOutcomeMessages(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// replay checks that the call with the given function and arguments matches the next recorded call,
+// decodes the recorded results into the given result pointers, and returns the recorded error
+//
+func (r *Replayer) replay(function string, arguments []any, results ...any) error {
+	if r.divergence != nil {
+		panic(*r.divergence)
+	}
+
+	actual := Call{
+		Function:  function,
+		Arguments: encodeCallValues(arguments),
+	}
+
+	if r.index >= len(r.calls) {
+		r.diverge(&actual, nil)
+	}
+
+	expected := &r.calls[r.index]
+
+	if expected.Function != function ||
+		!bytes.Equal(compactJSON(expected.Arguments), actual.Arguments) {
+
+		r.diverge(&actual, expected)
+	}
+
+	r.index++
+
+	if len(results) > 0 {
+		var encodedResults []json.RawMessage
+		err := json.Unmarshal(expected.Results, &encodedResults)
+		if err != nil || len(encodedResults) != len(results) {
+			r.diverge(&actual, expected)
+		}
+
+		for i, result := range results {
+			err := json.Unmarshal(encodedResults[i], result)
+			if err != nil {
+				r.diverge(&actual, expected)
+			}
+		}
+	}
+
+	if expected.Error != nil {
+		return recordedError{
+			message: *expected.Error,
+		}
+	}
+
+	return nil
+}
+
+func (r *Replayer) diverge(actual *Call, expected *Call) {
+	r.divergence = &DivergenceError{
+		Index:    r.index,
+		Expected: expected,
+		Actual:   actual,
+	}
+	panic(*r.divergence)
+}
+
+// recordedError is an error which was returned by a recorded call
+//
+type recordedError struct {
+	message string
+}
+
+func (e recordedError) Error() string {
+	return e.message
+}
+
+// decodeValue decodes the given value in JSON-Cadence format
+//
+func decodeValue(data json.RawMessage) (cadence.Value, error) {
+	if bytes.Equal(data, []byte("null")) {
+		return nil, nil
+	}
+
+	return jsoncdc.Decode(nil, data)
+}
+
+func (r *Replayer) ResolveLocation(
+	identifiers []runtime.Identifier,
+	location runtime.Location,
+) (
+	[]runtime.ResolvedLocation,
+	error,
+) {
+	var encodedResolvedLocations []resolvedLocation
+
+	err := r.replay(
+		"ResolveLocation",
+		[]any{identifierStrings(identifiers), newLocation(location)},
+		&encodedResolvedLocations,
+	)
+
+	resolvedLocations := make([]runtime.ResolvedLocation, 0, len(encodedResolvedLocations))
+	for _, resolved := range encodedResolvedLocations {
+		resolvedLocation, locationErr := resolved.Location.CommonLocation()
+		if locationErr != nil {
+			return nil, locationErr
+		}
+
+		resolvedIdentifiers := make([]runtime.Identifier, 0, len(resolved.Identifiers))
+		for _, identifier := range resolved.Identifiers {
+			resolvedIdentifiers = append(
+				resolvedIdentifiers,
+				runtime.Identifier{
+					Identifier: identifier,
+				},
+			)
+		}
+
+		resolvedLocations = append(
+			resolvedLocations,
+			runtime.ResolvedLocation{
+				Location:    resolvedLocation,
+				Identifiers: resolvedIdentifiers,
+			},
+		)
+	}
+
+	return resolvedLocations, err
+}
+
+func (r *Replayer) GetCode(location runtime.Location) (code []byte, err error) {
+	err = r.replay("GetCode", []any{newLocation(location)}, &code)
+	return
+}
+
+func (r *Replayer) GetProgram(location runtime.Location) (*interpreter.Program, error) {
+	return r.programs[location], nil
+}
+
+func (r *Replayer) SetProgram(location runtime.Location, program *interpreter.Program) error {
+	r.programs[location] = program
+	return nil
+}
+
+func (r *Replayer) GetValue(owner, key []byte) (value []byte, err error) {
+	err = r.replay("GetValue", []any{owner, key}, &value)
+	return
+}
+
+func (r *Replayer) SetValue(owner, key, value []byte) error {
+	return r.replay("SetValue", []any{owner, key, value})
+}
+
+func (r *Replayer) ValueExists(owner, key []byte) (exists bool, err error) {
+	err = r.replay("ValueExists", []any{owner, key}, &exists)
+	return
+}
+
+func (r *Replayer) AllocateStorageIndex(owner []byte) (index atree.StorageIndex, err error) {
+	err = r.replay("AllocateStorageIndex", []any{owner}, &index)
+	return
+}
+
+func (r *Replayer) CreateAccount(payer runtime.Address) (address runtime.Address, err error) {
+	err = r.replay("CreateAccount", []any{payer}, &address)
+	return
+}
+
+func (r *Replayer) AddEncodedAccountKey(address runtime.Address, publicKey []byte) error {
+	return r.replay("AddEncodedAccountKey", []any{address, publicKey})
+}
+
+func (r *Replayer) RevokeEncodedAccountKey(address runtime.Address, index int) (publicKey []byte, err error) {
+	err = r.replay("RevokeEncodedAccountKey", []any{address, index}, &publicKey)
+	return
+}
+
+func (r *Replayer) AddAccountKey(
+	address runtime.Address,
+	publicKey *runtime.PublicKey,
+	hashAlgo runtime.HashAlgorithm,
+	weight int,
+) (
+	accountKey *runtime.AccountKey,
+	err error,
+) {
+	err = r.replay("AddAccountKey", []any{address, publicKey, hashAlgo, weight}, &accountKey)
+	return
+}
+
+func (r *Replayer) GetAccountKey(address runtime.Address, index int) (accountKey *runtime.AccountKey, err error) {
+	err = r.replay("GetAccountKey", []any{address, index}, &accountKey)
+	return
+}
+
+func (r *Replayer) RevokeAccountKey(address runtime.Address, index int) (accountKey *runtime.AccountKey, err error) {
+	err = r.replay("RevokeAccountKey", []any{address, index}, &accountKey)
+	return
+}
+
+func (r *Replayer) UpdateAccountContractCode(address runtime.Address, name string, code []byte) error {
+	return r.replay("UpdateAccountContractCode", []any{address, name, code})
+}
+
+func (r *Replayer) GetAccountContractCode(address runtime.Address, name string) (code []byte, err error) {
+	err = r.replay("GetAccountContractCode", []any{address, name}, &code)
+	return
+}
+
+func (r *Replayer) RemoveAccountContractCode(address runtime.Address, name string) error {
+	return r.replay("RemoveAccountContractCode", []any{address, name})
+}
+
+func (r *Replayer) GetSigningAccounts() (signers []runtime.Address, err error) {
+	err = r.replay("GetSigningAccounts", nil, &signers)
+	return
+}
+
+func (r *Replayer) ProgramLog(message string) error {
+	return r.replay("ProgramLog", []any{message})
+}
+
+func (r *Replayer) EmitEvent(event cadence.Event) error {
+	return r.replay("EmitEvent", []any{encodeValue(event)})
+}
+
+func (r *Replayer) GenerateUUID() (uuid uint64, err error) {
+	err = r.replay("GenerateUUID", nil, &uuid)
+	return
+}
+
+func (r *Replayer) MeterComputation(operationType common.ComputationKind, intensity uint) error {
+	return r.replay("MeterComputation", []any{operationType, intensity})
+}
+
+func (r *Replayer) DecodeArgument(argument []byte, argumentType cadence.Type) (cadence.Value, error) {
+	var encodedValue json.RawMessage
+
+	err := r.replay("DecodeArgument", []any{argument, argumentType.ID()}, &encodedValue)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeValue(encodedValue)
+}
+
+func (r *Replayer) GetCurrentBlockHeight() (height uint64, err error) {
+	err = r.replay("GetCurrentBlockHeight", nil, &height)
+	return
+}
+
+func (r *Replayer) GetBlockAtHeight(height uint64) (block runtime.Block, exists bool, err error) {
+	err = r.replay("GetBlockAtHeight", []any{height}, &block, &exists)
+	return
+}
+
+func (r *Replayer) UnsafeRandom() (random uint64, err error) {
+	err = r.replay("UnsafeRandom", nil, &random)
+	return
+}
+
+func (r *Replayer) VerifySignature(
+	signature []byte,
+	tag string,
+	signedData []byte,
+	publicKey []byte,
+	signatureAlgorithm runtime.SignatureAlgorithm,
+	hashAlgorithm runtime.HashAlgorithm,
+) (
+	valid bool,
+	err error,
+) {
+	err = r.replay(
+		"VerifySignature",
+		[]any{signature, tag, signedData, publicKey, signatureAlgorithm, hashAlgorithm},
+		&valid,
+	)
+	return
+}
+
+func (r *Replayer) Hash(data []byte, tag string, hashAlgorithm runtime.HashAlgorithm) (digest []byte, err error) {
+	err = r.replay("Hash", []any{data, tag, hashAlgorithm}, &digest)
+	return
+}
+
+func (r *Replayer) GetAccountBalance(address common.Address) (value uint64, err error) {
+	err = r.replay("GetAccountBalance", []any{address}, &value)
+	return
+}
+
+func (r *Replayer) GetAccountAvailableBalance(address common.Address) (value uint64, err error) {
+	err = r.replay("GetAccountAvailableBalance", []any{address}, &value)
+	return
+}
+
+func (r *Replayer) GetStorageUsed(address runtime.Address) (value uint64, err error) {
+	err = r.replay("GetStorageUsed", []any{address}, &value)
+	return
+}
+
+func (r *Replayer) GetStorageCapacity(address runtime.Address) (value uint64, err error) {
+	err = r.replay("GetStorageCapacity", []any{address}, &value)
+	return
+}
+
+func (r *Replayer) ImplementationDebugLog(_ string) error {
+	return nil
+}
+
+func (r *Replayer) ValidatePublicKey(key *runtime.PublicKey) error {
+	return r.replay("ValidatePublicKey", []any{key})
+}
+
+func (r *Replayer) GetAccountContractNames(address runtime.Address) (names []string, err error) {
+	err = r.replay("GetAccountContractNames", []any{address}, &names)
+	return
+}
+
+func (r *Replayer) RecordTrace(_ string, _ common.Location, _ time.Duration, _ []attribute.KeyValue) {
+	// NO-OP
+}
+
+func (r *Replayer) BLSVerifyPOP(publicKey *runtime.PublicKey, signature []byte) (valid bool, err error) {
+	err = r.replay("BLSVerifyPOP", []any{publicKey, signature}, &valid)
+	return
+}
+
+func (r *Replayer) BLSAggregateSignatures(signatures [][]byte) (signature []byte, err error) {
+	err = r.replay("BLSAggregateSignatures", []any{signatures}, &signature)
+	return
+}
+
+func (r *Replayer) BLSAggregatePublicKeys(publicKeys []*runtime.PublicKey) (publicKey *runtime.PublicKey, err error) {
+	err = r.replay("BLSAggregatePublicKeys", []any{publicKeys}, &publicKey)
+	return
+}
+
+func (r *Replayer) ResourceOwnerChanged(
+	_ *interpreter.Interpreter,
+	_ *interpreter.CompositeValue,
+	_ common.Address,
+	_ common.Address,
+) {
+	// NO-OP
+}
+
+func (r *Replayer) MeterMemory(usage common.MemoryUsage) error {
+	return r.replay("MeterMemory", []any{usage})
+}
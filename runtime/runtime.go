@@ -19,6 +19,8 @@
 package runtime
 
 import (
+	"bytes"
+	"encoding/hex"
 	goRuntime "runtime"
 	"time"
 	"unsafe"
@@ -195,6 +197,7 @@ var validTopLevelDeclarationsInTransaction = []common.DeclarationKind{
 	common.DeclarationKindImport,
 	common.DeclarationKindFunction,
 	common.DeclarationKindTransaction,
+	common.DeclarationKindTypeAlias,
 }
 
 var validTopLevelDeclarationsInAccountCode = []common.DeclarationKind{
@@ -202,6 +205,7 @@ var validTopLevelDeclarationsInAccountCode = []common.DeclarationKind{
 	common.DeclarationKindImport,
 	common.DeclarationKindContract,
 	common.DeclarationKindContractInterface,
+	common.DeclarationKindTypeAlias,
 }
 
 func validTopLevelDeclarations(location common.Location) []common.DeclarationKind {
@@ -745,6 +749,8 @@ func hasValidStaticType(inter *interpreter.Interpreter, value interpreter.Value)
 	case *interpreter.DictionaryValue:
 		return value.Type.KeyType != nil &&
 			value.Type.ValueType != nil
+	case *interpreter.SetValue:
+		return value.Type.ElementType != nil
 	default:
 		// For other values, static type is NOT inferred.
 		// Hence no need to validate it here.
@@ -919,6 +925,15 @@ func (r *interpreterRuntime) check(
 						case stdlib.CryptoChecker.Location:
 							elaboration = stdlib.CryptoChecker.Elaboration
 
+						case stdlib.TestContractLocation:
+							if startContext.TestFramework == nil {
+								return nil, runtimeErrors.NewDefaultUserError(
+									"cannot import `%s`: only available in tests",
+									importedLocation,
+								)
+							}
+							elaboration = stdlib.TestContractChecker.Elaboration
+
 						default:
 							context := startContext.WithLocation(importedLocation)
 
@@ -933,7 +948,17 @@ func (r *interpreterRuntime) check(
 								defer delete(checkedImports, importedLocation)
 							}
 
-							program, err := r.getProgram(context, functions, values, checkerOptions, checkedImports)
+							expectedHashes := importHashes(checker.Program, checker.Elaboration, importedLocation)
+
+							program, err := r.getProgram(
+								context,
+								functions,
+								values,
+								checkerOptions,
+								checkedImports,
+								checker.Location,
+								expectedHashes,
+							)
 							if err != nil {
 								return nil, err
 							}
@@ -1045,6 +1070,7 @@ func (r *interpreterRuntime) newInterpreter(
 
 				return r.loadContract(
 					inter,
+					context,
 					compositeType,
 					constructorGenerator,
 					invocationRange,
@@ -1204,10 +1230,33 @@ func (r *interpreterRuntime) importLocationHandler(
 				Interpreter: subInterpreter,
 			}
 
+		case stdlib.TestContractLocation:
+			program := interpreter.ProgramFromChecker(stdlib.TestContractChecker)
+			subInterpreter, err := inter.NewSubInterpreter(program, location)
+			if err != nil {
+				panic(err)
+			}
+			return interpreter.InterpreterImport{
+				Interpreter: subInterpreter,
+			}
+
 		default:
 			context := startContext.WithLocation(location)
 
-			program, err := r.getProgram(context, functions, values, checkerOptions, importResolutionResults{})
+			var expectedHashes []*ast.ImportHash
+			if inter.Program != nil {
+				expectedHashes = importHashes(inter.Program.Program, inter.Program.Elaboration, location)
+			}
+
+			program, err := r.getProgram(
+				context,
+				functions,
+				values,
+				checkerOptions,
+				importResolutionResults{},
+				inter.Location,
+				expectedHashes,
+			)
 			if err != nil {
 				panic(err)
 			}
@@ -1226,17 +1275,41 @@ func (r *interpreterRuntime) importLocationHandler(
 // getProgram returns the existing program at the given location, if available.
 // If it is not available, it loads the code, and then parses and checks it.
 //
+// If the importing program at the given location pinned the hash of the program,
+// the code is always loaded and verified first,
+// even if an existing program is available.
+//
 func (r *interpreterRuntime) getProgram(
 	context Context,
 	functions stdlib.StandardLibraryFunctions,
 	values stdlib.StandardLibraryValues,
 	checkerOptions []sema.Option,
 	checkedImports importResolutionResults,
+	importingLocation common.Location,
+	expectedHashes []*ast.ImportHash,
 ) (
 	program *interpreter.Program,
 	err error,
 ) {
 
+	var code []byte
+	codeLoaded := false
+
+	if len(expectedHashes) > 0 {
+		code, err = r.getCode(context)
+		if err != nil {
+			return nil, err
+		}
+		codeLoaded = true
+
+		for _, expectedHash := range expectedHashes {
+			err = verifyImportHash(context.Location, importingLocation, code, expectedHash)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	wrapPanic(func() {
 		program, err = context.Interface.GetProgram(context.Location)
 	})
@@ -1246,10 +1319,11 @@ func (r *interpreterRuntime) getProgram(
 
 	if program == nil {
 
-		var code []byte
-		code, err = r.getCode(context)
-		if err != nil {
-			return nil, err
+		if !codeLoaded {
+			code, err = r.getCode(context)
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		program, err = r.parseAndCheckProgram(
@@ -1271,6 +1345,67 @@ func (r *interpreterRuntime) getProgram(
 	return program, nil
 }
 
+// importHashes returns the hashes which the import declarations of the given program
+// expect the code of the given imported location to have.
+//
+func importHashes(
+	program *ast.Program,
+	elaboration *sema.Elaboration,
+	location common.Location,
+) (hashes []*ast.ImportHash) {
+
+	for _, declaration := range program.ImportDeclarations() {
+		if declaration.Hash == nil {
+			continue
+		}
+
+		for _, resolvedLocation := range elaboration.ImportDeclarationsResolvedLocations[declaration] {
+			if resolvedLocation.Location == location {
+				hashes = append(hashes, declaration.Hash)
+				break
+			}
+		}
+	}
+
+	return hashes
+}
+
+// verifyImportHash returns an error if the hash of the given code
+// does not match the expected hash of an import declaration in the importing program.
+//
+func verifyImportHash(
+	location common.Location,
+	importingLocation common.Location,
+	code []byte,
+	expectedHash *ast.ImportHash,
+) error {
+	var actualDigest []byte
+
+	switch expectedHash.Algorithm {
+	case ast.ImportHashAlgorithmSHA3_256:
+		digest := sha3.Sum256(code)
+		actualDigest = digest[:]
+
+	default:
+		return runtimeErrors.NewUnexpectedError(
+			"unsupported import hash algorithm: %s",
+			expectedHash.Algorithm,
+		)
+	}
+
+	if bytes.Equal(actualDigest, expectedHash.Digest) {
+		return nil
+	}
+
+	return &ImportHashMismatchError{
+		Location:          location,
+		ImportingLocation: importingLocation,
+		ExpectedHash:      expectedHash.String(),
+		ActualHash:        expectedHash.Algorithm + ":" + hex.EncodeToString(actualDigest),
+		Range:             expectedHash.Range,
+	}
+}
+
 func (r *interpreterRuntime) injectedCompositeFieldsHandler(
 	context Context,
 	storage *Storage,
@@ -1285,7 +1420,8 @@ func (r *interpreterRuntime) injectedCompositeFieldsHandler(
 	) map[string]interpreter.Value {
 
 		switch location {
-		case stdlib.CryptoChecker.Location:
+		case stdlib.CryptoChecker.Location,
+			stdlib.TestContractLocation:
 			return nil
 
 		default:
@@ -1524,6 +1660,8 @@ func (r *interpreterRuntime) newCreateAccountFunction(
 		inter := invocation.Interpreter
 		getLocationRange := invocation.GetLocationRange
 
+		inter.CheckRevertibleOperation("create account", getLocationRange)
+
 		invocation.Interpreter.ExpectType(
 			payer,
 			sema.AuthAccountType,
@@ -1714,6 +1852,8 @@ func (r *interpreterRuntime) newAddPublicKeyFunction(
 	return interpreter.NewHostFunctionValue(
 		gauge,
 		func(invocation interpreter.Invocation) interpreter.Value {
+			invocation.Interpreter.CheckRevertibleOperation("add account key", invocation.GetLocationRange)
+
 			publicKeyValue, ok := invocation.Arguments[0].(*interpreter.ArrayValue)
 			if !ok {
 				panic(runtimeErrors.NewUnreachableError())
@@ -1762,6 +1902,8 @@ func (r *interpreterRuntime) newRemovePublicKeyFunction(
 	return interpreter.NewHostFunctionValue(
 		gauge,
 		func(invocation interpreter.Invocation) interpreter.Value {
+			invocation.Interpreter.CheckRevertibleOperation("revoke account key", invocation.GetLocationRange)
+
 			index, ok := invocation.Arguments[0].(interpreter.IntValue)
 			if !ok {
 				panic(runtimeErrors.NewUnreachableError())
@@ -1818,6 +1960,7 @@ func (r *interpreterRuntime) recordContractValue(
 
 func (r *interpreterRuntime) loadContract(
 	inter *interpreter.Interpreter,
+	context Context,
 	compositeType *sema.CompositeType,
 	constructorGenerator func(common.Address) *interpreter.HostFunctionValue,
 	invocationRange ast.Range,
@@ -1836,6 +1979,18 @@ func (r *interpreterRuntime) loadContract(
 		}
 		return contract
 
+	case stdlib.TestContractLocation:
+		contract, err := stdlib.NewTestContract(
+			inter,
+			context.TestFramework,
+			constructorGenerator(common.Address{}),
+			invocationRange,
+		)
+		if err != nil {
+			panic(err)
+		}
+		return contract
+
 	default:
 
 		var storedValue interpreter.Value
@@ -1966,6 +2121,7 @@ func (r *interpreterRuntime) instantiateContract(
 				// The contract is not the deployed contract, load it from storage
 				return r.loadContract(
 					inter,
+					context,
 					compositeType,
 					constructorGenerator,
 					invocationRange,
@@ -2069,13 +2225,20 @@ func (r *interpreterRuntime) newLogFunction(runtimeInterface Interface) interpre
 	return func(invocation interpreter.Invocation) interpreter.Value {
 		value := invocation.Arguments[0]
 		message := value.MeteredString(invocation.Interpreter, interpreter.SeenReferences{})
-		var err error
-		wrapPanic(func() {
-			err = runtimeInterface.ProgramLog(message)
+
+		// NOTE: messages logged in a try block are only logged
+		// if the effects of the try block are committed
+
+		invocation.Interpreter.RunWhenCommitted(func() {
+			var err error
+			wrapPanic(func() {
+				err = runtimeInterface.ProgramLog(message)
+			})
+			if err != nil {
+				panic(err)
+			}
 		})
-		if err != nil {
-			panic(err)
-		}
+
 		return interpreter.NewVoidValue(invocation.Interpreter)
 	}
 }
@@ -2271,9 +2434,16 @@ func (r *interpreterRuntime) newAuthAccountContractsChangeFunction(
 	checkerOptions []sema.Option,
 	isUpdate bool,
 ) *interpreter.HostFunctionValue {
+
+	operation := "add contract"
+	if isUpdate {
+		operation = "update contract"
+	}
+
 	return interpreter.NewHostFunctionValue(
 		inter,
 		func(invocation interpreter.Invocation) interpreter.Value {
+			invocation.Interpreter.CheckRevertibleOperation(operation, invocation.GetLocationRange)
 
 			const requiredArgumentCount = 2
 
@@ -2401,6 +2571,11 @@ func (r *interpreterRuntime) newAuthAccountContractsChangeFunction(
 			var contractInterfaceTypes []*sema.InterfaceType
 
 			program.Elaboration.GlobalTypes.Foreach(func(_ string, variable *sema.Variable) {
+				// Type aliases refer to existing types, they do not declare new ones
+				if variable.DeclarationKind == common.DeclarationKindTypeAlias {
+					return
+				}
+
 				switch ty := variable.Type.(type) {
 				case *sema.CompositeType:
 					if ty.Kind == common.CompositeKindContract {
@@ -2722,6 +2897,7 @@ func (r *interpreterRuntime) newAuthAccountContractsRemoveFunction(
 	return interpreter.NewHostFunctionValue(
 		inter,
 		func(invocation interpreter.Invocation) interpreter.Value {
+			invocation.Interpreter.CheckRevertibleOperation("remove contract", invocation.GetLocationRange)
 
 			inter := invocation.Interpreter
 			nameValue, ok := invocation.Arguments[0].(*interpreter.StringValue)
@@ -3057,11 +3233,10 @@ func NewBlockValue(
 	)
 
 	// timestamp
-	// TODO: verify
-	timestampValue := interpreter.NewUFix64ValueWithInteger(
+	timestampValue := interpreter.NewTimestampValue(
 		inter,
-		func() uint64 {
-			return uint64(time.Unix(0, block.Timestamp).Unix())
+		func() int64 {
+			return time.Unix(0, block.Timestamp).Unix()
 		},
 	)
 
@@ -3094,6 +3269,8 @@ func (r *interpreterRuntime) newAccountKeysAddFunction(
 			inter := invocation.Interpreter
 			getLocationRange := invocation.GetLocationRange
 
+			inter.CheckRevertibleOperation("add account key", getLocationRange)
+
 			publicKey, err := NewPublicKeyFromValue(inter, getLocationRange, publicKeyValue)
 			if err != nil {
 				panic(err)
@@ -3199,6 +3376,8 @@ func (r *interpreterRuntime) newAccountKeysRevokeFunction(
 	return interpreter.NewHostFunctionValue(
 		inter,
 		func(invocation interpreter.Invocation) interpreter.Value {
+			invocation.Interpreter.CheckRevertibleOperation("revoke account key", invocation.GetLocationRange)
+
 			indexValue, ok := invocation.Arguments[0].(interpreter.IntValue)
 			if !ok {
 				panic(runtimeErrors.NewUnreachableError())
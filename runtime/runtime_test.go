@@ -3566,6 +3566,340 @@ func TestRuntimeContractNestedResource(t *testing.T) {
 	assert.Equal(t, `"Hello World!"`, loggedMessage)
 }
 
+func TestRuntimeContractOverloading(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := newTestInterpreterRuntime()
+
+	addressValue := Address{
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1,
+	}
+
+	contract := []byte(`
+        pub contract Test {
+            pub resource R {
+                pub fun hello(): String {
+                    return "Hello World!"
+                }
+
+                pub fun hello(name: String): String {
+                    return "Hello ".concat(name).concat("!")
+                }
+            }
+
+            pub struct Greeting {
+                pub let message: String
+
+                init() {
+                    self.message = "Hi"
+                }
+
+                init(message: String) {
+                    self.message = message
+                }
+            }
+
+            init() {
+                // store nested resource in account on deployment
+                self.account.save(<-create R(), to: /storage/r)
+            }
+        }
+    `)
+
+	tx := []byte(`
+        import Test from 0x01
+
+        transaction {
+
+            prepare(acct: AuthAccount) {
+                let r = acct.borrow<&Test.R>(from: /storage/r)!
+                log([
+                    r.hello(),
+                    r.hello(name: "Cadence"),
+                    Test.Greeting().message,
+                    Test.Greeting(message: "Hey").message
+                ])
+            }
+        }
+    `)
+
+	deploy := utils.DeploymentTransaction("Test", contract)
+
+	var accountCode []byte
+	var loggedMessage string
+
+	runtimeInterface := &testRuntimeInterface{
+		getCode: func(_ Location) (bytes []byte, err error) {
+			return accountCode, nil
+		},
+		storage: newTestLedger(nil, nil),
+		getSigningAccounts: func() ([]Address, error) {
+			return []Address{addressValue}, nil
+		},
+		resolveLocation: singleIdentifierLocationResolver(t),
+		getAccountContractCode: func(_ Address, _ string) (code []byte, err error) {
+			return accountCode, nil
+		},
+		updateAccountContractCode: func(_ Address, _ string, code []byte) error {
+			accountCode = code
+			return nil
+		},
+		emitEvent: func(event cadence.Event) error {
+			return nil
+		},
+		log: func(message string) {
+			loggedMessage = message
+		},
+	}
+
+	nextTransactionLocation := newTransactionLocationGenerator()
+
+	err := runtime.ExecuteTransaction(
+		Script{
+			Source: deploy,
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	err = runtime.ExecuteTransaction(
+		Script{
+			Source: tx,
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		`["Hello World!", "Hello Cadence!", "Hi", "Hey"]`,
+		loggedMessage,
+	)
+}
+
+func TestRuntimeContractTypeAlias(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := newTestInterpreterRuntime()
+
+	addressValue := Address{
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1,
+	}
+
+	contract := []byte(`
+        pub typealias Alias = Test
+
+        pub contract Test {
+
+            pub typealias Message = String
+
+            pub resource R {
+                pub fun hello(): Message {
+                    return "Hello World!"
+                }
+            }
+
+            init() {
+                self.account.save(<-create R(), to: /storage/r)
+            }
+        }
+    `)
+
+	tx := []byte(`
+        import Test from 0x01
+
+        pub typealias ResourceRef = &Test.R
+
+        transaction {
+
+            prepare(acct: AuthAccount) {
+                let ref: ResourceRef = acct.borrow<&Test.R>(from: /storage/r)!
+                let message: Test.Message = ref.hello()
+                log(message)
+            }
+        }
+    `)
+
+	deploy := utils.DeploymentTransaction("Test", contract)
+
+	var accountCode []byte
+	var loggedMessage string
+
+	runtimeInterface := &testRuntimeInterface{
+		getCode: func(_ Location) (bytes []byte, err error) {
+			return accountCode, nil
+		},
+		storage: newTestLedger(nil, nil),
+		getSigningAccounts: func() ([]Address, error) {
+			return []Address{addressValue}, nil
+		},
+		resolveLocation: singleIdentifierLocationResolver(t),
+		getAccountContractCode: func(_ Address, _ string) (code []byte, err error) {
+			return accountCode, nil
+		},
+		updateAccountContractCode: func(_ Address, _ string, code []byte) error {
+			accountCode = code
+			return nil
+		},
+		emitEvent: func(event cadence.Event) error {
+			return nil
+		},
+		log: func(message string) {
+			loggedMessage = message
+		},
+	}
+
+	nextTransactionLocation := newTransactionLocationGenerator()
+
+	err := runtime.ExecuteTransaction(
+		Script{
+			Source: deploy,
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	assert.NotNil(t, accountCode)
+
+	err = runtime.ExecuteTransaction(
+		Script{
+			Source: tx,
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, `"Hello World!"`, loggedMessage)
+}
+
+func TestRuntimeContractNewtype(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := newTestInterpreterRuntime()
+
+	addressValue := Address{
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1,
+	}
+
+	contract := []byte(`
+        pub contract Test {
+
+            pub newtype ID: UInt64
+
+            pub event Created(id: ID)
+
+            init() {
+                let id = ID(42)
+                self.account.save(id, to: /storage/id)
+                emit Created(id: id)
+            }
+        }
+    `)
+
+	tx := []byte(`
+        import Test from 0x01
+
+        transaction {
+
+            prepare(acct: AuthAccount) {
+                let id = acct.copy<Test.ID>(from: /storage/id)!
+                log(id.value)
+                log(acct.type(at: /storage/id)!.identifier)
+            }
+        }
+    `)
+
+	deploy := utils.DeploymentTransaction("Test", contract)
+
+	var accountCode []byte
+	var events []cadence.Event
+	var loggedMessages []string
+
+	runtimeInterface := &testRuntimeInterface{
+		getCode: func(_ Location) (bytes []byte, err error) {
+			return accountCode, nil
+		},
+		storage: newTestLedger(nil, nil),
+		getSigningAccounts: func() ([]Address, error) {
+			return []Address{addressValue}, nil
+		},
+		resolveLocation: singleIdentifierLocationResolver(t),
+		getAccountContractCode: func(_ Address, _ string) (code []byte, err error) {
+			return accountCode, nil
+		},
+		updateAccountContractCode: func(_ Address, _ string, code []byte) error {
+			accountCode = code
+			return nil
+		},
+		emitEvent: func(event cadence.Event) error {
+			events = append(events, event)
+			return nil
+		},
+		log: func(message string) {
+			loggedMessages = append(loggedMessages, message)
+		},
+	}
+
+	nextTransactionLocation := newTransactionLocationGenerator()
+
+	err := runtime.ExecuteTransaction(
+		Script{
+			Source: deploy,
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	assert.NotNil(t, accountCode)
+
+	require.Len(t, events, 2)
+	createdEvent := events[0]
+	require.Equal(t, "A.0000000000000001.Test.Created", createdEvent.EventType.ID())
+	require.Len(t, createdEvent.Fields, 1)
+	require.IsType(t, cadence.Newtype{}, createdEvent.Fields[0])
+
+	id := createdEvent.Fields[0].(cadence.Newtype)
+	assert.Equal(t, "A.0000000000000001.Test.ID", id.NewtypeType.ID())
+	assert.Equal(t, cadence.UInt64Type{}, id.NewtypeType.UnderlyingType)
+	assert.Equal(t, []cadence.Value{cadence.NewUInt64(42)}, id.Fields)
+
+	err = runtime.ExecuteTransaction(
+		Script{
+			Source: tx,
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		[]string{
+			"42",
+			`"A.0000000000000001.Test.ID"`,
+		},
+		loggedMessages,
+	)
+}
+
 func TestRuntimeStorageLoadedDestructionConcreteType(t *testing.T) {
 
 	t.Parallel()
@@ -4497,16 +4831,16 @@ func TestRuntimeBlock(t *testing.T) {
 
 	assert.Equal(t,
 		[]string{
-			"Block(height: 1, view: 1, id: 0x0000000000000000000000000000000000000000000000000000000000000001, timestamp: 1.00000000)",
+			"Block(height: 1, view: 1, id: 0x0000000000000000000000000000000000000000000000000000000000000001, timestamp: 1970-01-01T00:00:01Z)",
 			"1",
 			"1",
 			"[0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1]",
-			"1.00000000",
-			"Block(height: 2, view: 2, id: 0x0000000000000000000000000000000000000000000000000000000000000002, timestamp: 2.00000000)",
+			"1970-01-01T00:00:01Z",
+			"Block(height: 2, view: 2, id: 0x0000000000000000000000000000000000000000000000000000000000000002, timestamp: 1970-01-01T00:00:02Z)",
 			"2",
 			"2",
 			"[0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2]",
-			"2.00000000",
+			"1970-01-01T00:00:02Z",
 		},
 		loggedMessages,
 	)
@@ -7425,3 +7759,183 @@ func assertRuntimeErrorIsExternalError(t *testing.T, err error) {
 	innerError := runtimeError.Unwrap()
 	require.ErrorAs(t, innerError, &runtimeErrors.ExternalError{})
 }
+
+func TestRuntimeStorageGenericComposite(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := newTestInterpreterRuntime()
+
+	address := Address{
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1,
+	}
+
+	contract := []byte(`
+      pub contract Test {
+
+          pub struct Box<T> {
+              pub let value: T
+
+              init(value: T) {
+                  self.value = value
+              }
+          }
+
+          pub fun makeBox<T>(_ value: T): Box<T> {
+              return Box(value: value)
+          }
+      }
+    `)
+
+	deploy := utils.DeploymentTransaction("Test", contract)
+
+	tx := []byte(`
+      import Test from 0x1
+
+      transaction {
+
+          prepare(signer: AuthAccount) {
+              signer.save(Test.makeBox(42), to: /storage/box)
+          }
+      }
+    `)
+
+	tx2 := []byte(`
+      import Test from 0x1
+
+      transaction {
+
+          prepare(signer: AuthAccount) {
+              let box = signer.load<Test.Box<Int>>(from: /storage/box)!
+              log(box.value)
+              log(box.getType())
+              log(box.isInstance(Type<Test.Box<String>>()))
+          }
+      }
+    `)
+
+	script := []byte(`
+      import Test from 0x1
+
+      pub fun main(): Test.Box<String> {
+          return Test.makeBox("hello")
+      }
+    `)
+
+	accountCodes := map[common.Location][]byte{}
+	var loggedMessages []string
+
+	runtimeInterface := &testRuntimeInterface{
+		getCode: func(location Location) (bytes []byte, err error) {
+			return accountCodes[location], nil
+		},
+		storage: newTestLedger(nil, nil),
+		getSigningAccounts: func() ([]Address, error) {
+			return []Address{address}, nil
+		},
+		resolveLocation: singleIdentifierLocationResolver(t),
+		getAccountContractCode: func(address Address, name string) (code []byte, err error) {
+			location := common.AddressLocation{
+				Address: address,
+				Name:    name,
+			}
+			return accountCodes[location], nil
+		},
+		updateAccountContractCode: func(address Address, name string, code []byte) error {
+			location := common.AddressLocation{
+				Address: address,
+				Name:    name,
+			}
+			accountCodes[location] = code
+			return nil
+		},
+		emitEvent: func(event cadence.Event) error {
+			return nil
+		},
+		log: func(message string) {
+			loggedMessages = append(loggedMessages, message)
+		},
+	}
+
+	nextTransactionLocation := newTransactionLocationGenerator()
+
+	for _, transaction := range [][]byte{deploy, tx, tx2} {
+		err := runtime.ExecuteTransaction(
+			Script{
+				Source: transaction,
+			},
+			Context{
+				Interface: runtimeInterface,
+				Location:  nextTransactionLocation(),
+			},
+		)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t,
+		[]string{
+			"42",
+			"Type<A.0000000000000001.Test.Box<Int>>()",
+			"false",
+		},
+		loggedMessages,
+	)
+
+	value, err := runtime.ExecuteScript(
+		Script{
+			Source: script,
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  common.ScriptLocation{},
+		},
+	)
+	require.NoError(t, err)
+
+	structValue, ok := value.(cadence.Struct)
+	require.True(t, ok)
+
+	assert.Equal(t,
+		"A.0000000000000001.Test.Box<String>",
+		structValue.StructType.ID(),
+	)
+	assert.Equal(t,
+		[]cadence.Type{cadence.StringType{}},
+		structValue.StructType.TypeArguments,
+	)
+	assert.Equal(t,
+		[]cadence.Value{cadence.String("hello")},
+		structValue.Fields,
+	)
+
+	// The exported value can be passed back as an argument
+
+	encodedValue, err := jsoncdc.Encode(value)
+	require.NoError(t, err)
+
+	runtimeInterface.decodeArgument = func(b []byte, t cadence.Type) (cadence.Value, error) {
+		return jsoncdc.Decode(nil, b)
+	}
+
+	argumentScript := []byte(`
+      import Test from 0x1
+
+      pub fun main(box: Test.Box<String>): String {
+          return box.value
+      }
+    `)
+
+	value, err = runtime.ExecuteScript(
+		Script{
+			Source:    argumentScript,
+			Arguments: [][]byte{encodedValue},
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  common.ScriptLocation{},
+		},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, cadence.String("hello"), value)
+}
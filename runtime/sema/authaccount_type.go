@@ -41,6 +41,12 @@ const AuthAccountGetCapabilityField = "getCapability"
 const AuthAccountGetLinkTargetField = "getLinkTarget"
 const AuthAccountContractsField = "contracts"
 const AuthAccountKeysField = "keys"
+const AuthAccountStoragePathsField = "storagePaths"
+const AuthAccountPublicPathsField = "publicPaths"
+const AuthAccountPrivatePathsField = "privatePaths"
+const AuthAccountForEachStoredField = "forEachStored"
+const AuthAccountForEachPublicField = "forEachPublic"
+const AuthAccountForEachPrivateField = "forEachPrivate"
 
 // AuthAccountType represents the authorized access to an account.
 // Access to an AuthAccount means having full access to its storage, public keys, and code.
@@ -170,6 +176,42 @@ var AuthAccountType = func() *CompositeType {
 			AuthAccountKeysType,
 			accountTypeKeysFieldDocString,
 		),
+		NewUnmeteredPublicConstantFieldMember(
+			authAccountType,
+			AuthAccountStoragePathsField,
+			AuthAccountStoragePathsType,
+			authAccountTypeStoragePathsFieldDocString,
+		),
+		NewUnmeteredPublicConstantFieldMember(
+			authAccountType,
+			AuthAccountPublicPathsField,
+			AccountPublicPathsType,
+			accountTypePublicPathsFieldDocString,
+		),
+		NewUnmeteredPublicConstantFieldMember(
+			authAccountType,
+			AuthAccountPrivatePathsField,
+			AuthAccountPrivatePathsType,
+			authAccountTypePrivatePathsFieldDocString,
+		),
+		NewUnmeteredPublicFunctionMember(
+			authAccountType,
+			AuthAccountForEachStoredField,
+			AuthAccountForEachStoredFunctionType,
+			authAccountForEachStoredFunctionDocString,
+		),
+		NewUnmeteredPublicFunctionMember(
+			authAccountType,
+			AuthAccountForEachPublicField,
+			AccountForEachPublicFunctionType,
+			accountForEachPublicFunctionDocString,
+		),
+		NewUnmeteredPublicFunctionMember(
+			authAccountType,
+			AuthAccountForEachPrivateField,
+			AuthAccountForEachPrivateFunctionType,
+			authAccountForEachPrivateFunctionDocString,
+		),
 	}
 
 	authAccountType.Members = GetMembersAsMap(members)
@@ -520,6 +562,116 @@ var AccountTypeGetLinkTargetFunctionType = &FunctionType{
 	),
 }
 
+var AuthAccountStoragePathsType = &VariableSizedType{
+	Type: StoragePathType,
+}
+
+var AccountPublicPathsType = &VariableSizedType{
+	Type: PublicPathType,
+}
+
+var AuthAccountPrivatePathsType = &VariableSizedType{
+	Type: PrivatePathType,
+}
+
+// AccountForEachFunctionType returns the type of a function which iterates
+// over all paths of the given path type, e.g. `forEachStored`.
+//
+// The given iteration function is called with each path and the type of the value
+// stored under it. Iteration stops when the iteration function returns false.
+//
+func AccountForEachFunctionType(pathType Type) *FunctionType {
+	iterationFunctionType := &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "path",
+				TypeAnnotation: NewTypeAnnotation(pathType),
+			},
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "type",
+				TypeAnnotation: NewTypeAnnotation(MetaType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(BoolType),
+	}
+
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "function",
+				TypeAnnotation: NewTypeAnnotation(iterationFunctionType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(VoidType),
+	}
+}
+
+var AuthAccountForEachStoredFunctionType = AccountForEachFunctionType(StoragePathType)
+
+var AccountForEachPublicFunctionType = AccountForEachFunctionType(PublicPathType)
+
+var AuthAccountForEachPrivateFunctionType = AccountForEachFunctionType(PrivatePathType)
+
+const authAccountTypeStoragePathsFieldDocString = `
+All the storage paths of an account
+`
+
+const accountTypePublicPathsFieldDocString = `
+All the public paths of an account
+`
+
+const authAccountTypePrivatePathsFieldDocString = `
+All the private paths of an account
+`
+
+const authAccountForEachStoredFunctionDocString = `
+Iterate over all the stored paths of an account, passing each path and type in turn
+to the provided callback function.
+
+The callback function takes two arguments:
+  1. The path of the stored object
+  2. The runtime type of that object
+
+Iteration is stopped early if the callback function returns ` + "`false`" + `.
+
+The order of iteration is undefined.
+If storage is modified during iteration and the callback does not stop the iteration,
+the program aborts.
+`
+
+const accountForEachPublicFunctionDocString = `
+Iterate over all the public paths of an account, passing each path and type in turn
+to the provided callback function.
+
+The callback function takes two arguments:
+  1. The public path of the link
+  2. The runtime type of the capability for that link
+
+Iteration is stopped early if the callback function returns ` + "`false`" + `.
+
+The order of iteration is undefined.
+If storage is modified during iteration and the callback does not stop the iteration,
+the program aborts.
+`
+
+const authAccountForEachPrivateFunctionDocString = `
+Iterate over all the private paths of an account, passing each path and type in turn
+to the provided callback function.
+
+The callback function takes two arguments:
+  1. The private path of the link
+  2. The runtime type of the capability for that link
+
+Iteration is stopped early if the callback function returns ` + "`false`" + `.
+
+The order of iteration is undefined.
+If storage is modified during iteration and the callback does not stop the iteration,
+the program aborts.
+`
+
 // AuthAccountKeysType represents the keys associated with an auth account.
 var AuthAccountKeysType = func() *CompositeType {
 
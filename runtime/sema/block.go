@@ -69,7 +69,7 @@ var BlockType = &SimpleType{
 						memoryGauge,
 						t,
 						identifier,
-						UFix64Type,
+						TimestampType,
 						blockTypeTimestampFieldDocString,
 					)
 				},
@@ -116,18 +116,18 @@ It is a detail of the consensus algorithm. It is a monotonically increasing inte
 const BlockTypeTimestampFieldName = "timestamp"
 
 const blockTypeTimestampFieldDocString = `
-The ID of the block.
+The timestamp of the block.
 
-It is essentially the hash of the block
+Unix timestamp of when the proposer claims it constructed the block.
+
+NOTE: It is included by the proposer, there are no guarantees on how much the time stamp can deviate from the true time the block was published.
+Consider observing blocks’ status changes off-chain yourself to get a more reliable value
 `
 
 const BlockTypeIDFieldName = "id"
 
 const blockTypeIDFieldDocString = `
-The timestamp of the block.
-
-Unix timestamp of when the proposer claims it constructed the block.
+The ID of the block.
 
-NOTE: It is included by the proposer, there are no guarantees on how much the time stamp can deviate from the true time the block was published.
-Consider observing blocks’ status changes off-chain yourself to get a more reliable value
+It is essentially the hash of the block
 `
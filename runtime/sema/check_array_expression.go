@@ -28,6 +28,7 @@ func (checker *Checker) VisitArrayExpression(expression *ast.ArrayExpression) as
 
 	var elementType Type
 	var resultType ArrayType
+	var setType *SetType
 
 	switch typ := expectedType.(type) {
 
@@ -50,6 +51,11 @@ func (checker *Checker) VisitArrayExpression(expression *ast.ArrayExpression) as
 		elementType = typ.ElementType(false)
 		resultType = typ
 
+	case *SetType:
+		// An array literal is a set literal if a set is expected
+		elementType = typ.ElementType
+		setType = typ
+
 	default:
 		// If the expected type is AnyStruct or AnyResource, and the array is empty,
 		// then expect the elements to also be of the same type.
@@ -75,6 +81,12 @@ func (checker *Checker) VisitArrayExpression(expression *ast.ArrayExpression) as
 
 	checker.Elaboration.ArrayExpressionArgumentTypes[expression] = argumentTypes
 
+	if setType != nil {
+		checker.Elaboration.ArrayExpressionSetType[expression] = setType
+
+		return setType
+	}
+
 	if elementType == nil {
 		// Contextually expected type is not available.
 		// Therefore, find the least common supertype of the elements.
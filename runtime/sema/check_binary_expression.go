@@ -119,6 +119,15 @@ func (checker *Checker) VisitBinaryExpression(expression *ast.BinaryExpression)
 			expectedType = leftType
 		}
 
+		// Durations can only be scaled by an `Int64`,
+		// e.g. `Duration.day * 7`
+
+		if leftType == DurationType &&
+			(operation == ast.OperationMul || operation == ast.OperationDiv) {
+
+			expectedType = Int64Type
+		}
+
 		rightType = checker.VisitExpressionWithForceType(expression.Right, expectedType, false)
 
 		rightIsInvalid := rightType.IsInvalidType()
@@ -202,6 +211,14 @@ func (checker *Checker) checkBinaryExpressionArithmeticOrNonEqualityComparisonOr
 	leftType, rightType Type,
 	leftIsInvalid, rightIsInvalid, anyInvalid bool,
 ) Type {
+	if isTimeType(leftType) || isTimeType(rightType) {
+		return checker.checkBinaryExpressionTime(
+			expression, operation, operationKind,
+			leftType, rightType,
+			anyInvalid,
+		)
+	}
+
 	// check both types are number/integer subtypes
 
 	var expectedSuperType Type
@@ -303,6 +320,91 @@ func (checker *Checker) checkBinaryExpressionArithmeticOrNonEqualityComparisonOr
 	}
 }
 
+func isTimeType(ty Type) bool {
+	return ty == TimestampType || ty == DurationType
+}
+
+// checkBinaryExpressionTime checks arithmetic and comparisons
+// of timestamps and durations.
+//
+// Timestamps are points in time and durations are lengths of time,
+// so only the following combinations are allowed:
+//
+// - Timestamp + Duration, Duration + Timestamp, Timestamp - Duration: Timestamp
+// - Timestamp - Timestamp: Duration
+// - Duration + Duration, Duration - Duration: Duration
+// - Duration * Int64, Duration / Int64: Duration
+// - Comparison of two timestamps or two durations: Bool
+//
+func (checker *Checker) checkBinaryExpressionTime(
+	expression *ast.BinaryExpression,
+	operation ast.Operation,
+	operationKind BinaryOperationKind,
+	leftType, rightType Type,
+	anyInvalid bool,
+) Type {
+
+	var resultType Type
+
+	switch operationKind {
+	case BinaryOperationKindNonEqualityComparison:
+		if leftType == rightType {
+			resultType = BoolType
+		}
+
+	case BinaryOperationKindArithmetic:
+		switch operation {
+		case ast.OperationPlus:
+			switch {
+			case leftType == TimestampType && rightType == DurationType,
+				leftType == DurationType && rightType == TimestampType:
+
+				resultType = TimestampType
+
+			case leftType == DurationType && rightType == DurationType:
+				resultType = DurationType
+			}
+
+		case ast.OperationMinus:
+			switch {
+			case leftType == TimestampType && rightType == DurationType:
+				resultType = TimestampType
+
+			case leftType == TimestampType && rightType == TimestampType,
+				leftType == DurationType && rightType == DurationType:
+
+				resultType = DurationType
+			}
+
+		case ast.OperationMul, ast.OperationDiv:
+			if leftType == DurationType && rightType == Int64Type {
+				resultType = DurationType
+			}
+		}
+	}
+
+	if resultType != nil {
+		return resultType
+	}
+
+	if !anyInvalid {
+		checker.report(
+			&InvalidBinaryOperandsError{
+				Operation: operation,
+				LeftType:  leftType,
+				RightType: rightType,
+				Range:     ast.NewRangeFromPositioned(checker.memoryGauge, expression),
+			},
+		)
+	}
+
+	if operationKind == BinaryOperationKindNonEqualityComparison {
+		return BoolType
+	}
+
+	return InvalidType
+}
+
 func (checker *Checker) checkBinaryExpressionEquality(
 	expression *ast.BinaryExpression,
 	operation ast.Operation,
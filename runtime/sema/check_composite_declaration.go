@@ -75,6 +75,8 @@ func (checker *Checker) visitCompositeDeclaration(declaration *ast.CompositeDecl
 	}
 
 	checker.declareCompositeNestedTypes(declaration, kind, true)
+	checker.declareNestedTypeAliases(declaration.Members)
+	checker.declareTypeParameters(declaration.TypeParameterList, compositeType.typeParameters, false)
 
 	var initializationInfo *InitializationInfo
 
@@ -158,19 +160,47 @@ func (checker *Checker) visitCompositeDeclaration(declaration *ast.CompositeDecl
 
 	checkMissingMembers := kind != ContainerKindInterface
 
-	for i, interfaceType := range compositeType.ExplicitInterfaceConformances {
+	// NOTE: The composite must also conform to the interfaces
+	// that the explicit conformances inherit from.
+	// Each interface is only checked once
+
+	checkedConformances := map[*InterfaceType]struct{}{}
+
+	for i, explicitInterfaceType := range compositeType.ExplicitInterfaceConformances {
 		interfaceNominalType := declaration.Conformances[i]
 
-		checker.checkCompositeConformance(
-			declaration,
-			compositeType,
-			interfaceType,
-			interfaceNominalType.Identifier,
-			compositeConformanceCheckOptions{
-				checkMissingMembers:            checkMissingMembers,
-				interfaceTypeIsTypeRequirement: false,
-			},
+		interfaceTypes := append(
+			[]*InterfaceType{explicitInterfaceType},
+			explicitInterfaceType.EffectiveInterfaceConformances()...,
 		)
+
+		for _, interfaceType := range interfaceTypes {
+			if _, ok := checkedConformances[interfaceType]; ok {
+				continue
+			}
+			checkedConformances[interfaceType] = struct{}{}
+
+			checker.checkCompositeConformance(
+				declaration,
+				compositeType,
+				interfaceType,
+				interfaceNominalType.Identifier,
+				compositeConformanceCheckOptions{
+					checkMissingMembers:            checkMissingMembers,
+					interfaceTypeIsTypeRequirement: false,
+				},
+			)
+		}
+	}
+
+	if kind == ContainerKindComposite {
+		if compositeType.IsHashable() {
+			checker.checkHashableFields(declaration, compositeType)
+		}
+
+		if compositeType.IsUserEquatable() {
+			checker.checkEquatableMembers(declaration, compositeType)
+		}
 	}
 
 	// NOTE: check destructors after initializer and functions
@@ -345,7 +375,8 @@ func (checker *Checker) declareNestedDeclarations(
 			case common.CompositeKindResource,
 				common.CompositeKindStructure,
 				common.CompositeKindEvent,
-				common.CompositeKindEnum:
+				common.CompositeKindEnum,
+				common.CompositeKindNewtype:
 				break
 
 			default:
@@ -421,6 +452,7 @@ func (checker *Checker) declareCompositeType(declaration *ast.CompositeDeclarati
 		Kind:        declaration.CompositeKind,
 		Identifier:  identifier.Identifier,
 		nestedTypes: &StringTypeOrderedMap{},
+		typeAliases: &StringTypeOrderedMap{},
 		Members:     &StringMemberOrderedMap{},
 	}
 
@@ -441,13 +473,45 @@ func (checker *Checker) declareCompositeType(declaration *ast.CompositeDeclarati
 		)
 	}
 
+	// Only structures and resources can be generic.
+	// The type bounds of the type parameters are determined
+	// when the members are declared, see `declareCompositeMembersAndValue`
+
+	if !declaration.TypeParameterList.IsEmpty() {
+		switch declaration.CompositeKind {
+		case common.CompositeKindStructure,
+			common.CompositeKindResource:
+
+			typeParameters := declaration.TypeParameterList.TypeParameters
+			compositeType.typeParameters = make([]*TypeParameter, len(typeParameters))
+			for i, typeParameter := range typeParameters {
+				compositeType.typeParameters[i] = &TypeParameter{
+					Name:      typeParameter.Identifier.Identifier,
+					TypeBound: AnyStructType,
+					declared:  true,
+				}
+			}
+
+		default:
+			checker.report(
+				&InvalidGenericDeclarationError{
+					DeclarationKind: declaration.DeclarationKind(),
+					Range: ast.NewRangeFromPositioned(
+						checker.memoryGauge,
+						declaration.TypeParameterList,
+					),
+				},
+			)
+		}
+	}
+
 	// Resolve conformances
 
 	if declaration.CompositeKind == common.CompositeKindEnum {
 		compositeType.EnumRawType = checker.enumRawType(declaration)
 	} else {
 		compositeType.ExplicitInterfaceConformances =
-			checker.explicitInterfaceConformances(declaration, compositeType)
+			checker.explicitInterfaceConformances(declaration.Conformances, compositeType)
 	}
 
 	// Register in elaboration
@@ -516,12 +580,43 @@ func (checker *Checker) declareCompositeMembersAndValue(
 		defer checker.leaveValueScope(declaration.EndPosition, false)
 
 		checker.declareCompositeNestedTypes(declaration, kind, false)
+		checker.declareNestedTypeAliases(declaration.Members)
+
+		// Determine the type bounds of the type parameters of a generic composite,
+		// and declare the type parameters, so they are in scope in the members
+
+		if len(compositeType.typeParameters) > 0 {
+			for i, typeParameter := range declaration.TypeParameterList.TypeParameters {
+				compositeType.typeParameters[i].TypeBound = checker.typeParameterBound(typeParameter)
+			}
+
+			checker.declareTypeParameters(declaration.TypeParameterList, compositeType.typeParameters, true)
+		}
 
 		// NOTE: determine initializer parameter types while nested types are in scope,
 		// and after declaring nested types as the initializer may use nested type in parameters
 
+		// Only structures and resources may overload initializers:
+		// The initializers of events and newtypes are synthesized,
+		// and the initializer of a contract is invoked without argument labels
+
 		initializers := declaration.Members.Initializers()
-		compositeType.ConstructorParameters = checker.initializerParameters(initializers)
+		allowInitializerOverloading := kind == ContainerKindComposite &&
+			(compositeType.Kind == common.CompositeKindStructure ||
+				compositeType.Kind == common.CompositeKindResource)
+
+		compositeType.ConstructorParameters, compositeType.InitializerOverloads =
+			checker.initializerParameters(initializers, allowInitializerOverloading)
+		compositeType.InitializerHasConditions = initializerHasConditions(initializers)
+		compositeType.HasDestructor = len(declaration.Members.Destructors()) > 0
+
+		// The underlying type of a newtype is the type of the parameter of its synthesized initializer
+
+		if compositeType.Kind == common.CompositeKindNewtype &&
+			len(compositeType.ConstructorParameters) == 1 {
+
+			compositeType.NewtypeUnderlyingType = compositeType.ConstructorParameters[0].TypeAnnotation.Type
+		}
 
 		// Declare nested declarations' members
 
@@ -582,7 +677,7 @@ func (checker *Checker) declareCompositeMembersAndValue(
 				return
 			}
 
-			for _, compositeTypeConformance := range compositeType.ExplicitInterfaceConformances {
+			for _, compositeTypeConformance := range compositeType.EffectiveInterfaceConformances() {
 				conformanceNestedTypes := compositeTypeConformance.GetNestedTypes()
 
 				nestedType, ok := conformanceNestedTypes.Get(nestedTypeIdentifier)
@@ -607,8 +702,9 @@ func (checker *Checker) declareCompositeMembersAndValue(
 		var origins map[string]*Origin
 
 		switch declaration.CompositeKind {
-		case common.CompositeKindEvent:
-			// Event members are derived from the initializer's parameter list
+		case common.CompositeKindEvent,
+			common.CompositeKindNewtype:
+			// Event and newtype members are derived from the initializer's parameter list
 			members, fields, origins = checker.eventMembersAndOrigins(
 				initializers[0],
 				compositeType,
@@ -640,6 +736,13 @@ func (checker *Checker) declareCompositeMembersAndValue(
 		if checker.positionInfoEnabled {
 			checker.memberOrigins[compositeType] = origins
 		}
+
+		// The generic composite type might have been instantiated
+		// before its members were declared
+
+		if len(compositeType.typeParameters) > 0 {
+			compositeType.resolveInstanceMembers()
+		}
 	})()
 
 	// Always determine composite constructor type
@@ -760,6 +863,8 @@ func (checker *Checker) declareEnumConstructor(
 			continue
 		}
 
+		compositeType.EnumCases = append(compositeType.EnumCases, caseName)
+
 		constructorType.Members.Set(
 			caseName,
 			&Member{
@@ -841,38 +946,120 @@ func (checker *Checker) checkMemberStorability(members *StringMemberOrderedMap)
 	})
 }
 
-func (checker *Checker) initializerParameters(initializers []*ast.SpecialFunctionDeclaration) []*Parameter {
-	// TODO: support multiple overloaded initializers
-	var parameters []*Parameter
+// initializerParameters returns the parameters of the first initializer,
+// and if the initializers may be overloaded and multiple initializers are declared,
+// the overloads, i.e. all initializers
+//
+// initializerHasConditions returns true if the first of the given initializers
+// has pre- or post-conditions
+//
+func initializerHasConditions(initializers []*ast.SpecialFunctionDeclaration) bool {
+	if len(initializers) == 0 {
+		return false
+	}
 
+	functionBlock := initializers[0].FunctionDeclaration.FunctionBlock
+	if functionBlock == nil {
+		return false
+	}
+
+	return !functionBlock.PreConditions.IsEmpty() ||
+		!functionBlock.PostConditions.IsEmpty()
+}
+
+func (checker *Checker) initializerParameters(
+	initializers []*ast.SpecialFunctionDeclaration,
+	allowOverloading bool,
+) (
+	parameters []*Parameter,
+	overloads []*InitializerOverload,
+) {
 	initializerCount := len(initializers)
-	if initializerCount > 0 {
-		firstInitializer := initializers[0]
-		parameters = checker.parameters(firstInitializer.FunctionDeclaration.ParameterList)
+	if initializerCount == 0 {
+		return nil, nil
+	}
+
+	firstInitializer := initializers[0]
+	parameters = checker.parameters(firstInitializer.FunctionDeclaration.ParameterList)
+
+	if initializerCount == 1 {
+		return parameters, nil
+	}
 
-		if initializerCount > 1 {
-			secondInitializer := initializers[1]
+	if !allowOverloading {
+		secondInitializer := initializers[1]
 
+		checker.report(
+			&UnsupportedOverloadingError{
+				DeclarationKind: common.DeclarationKindInitializer,
+				Range:           ast.NewRangeFromPositioned(checker.memoryGauge, secondInitializer),
+			},
+		)
+
+		return parameters, nil
+	}
+
+	// Initializers are overloaded based on argument labels,
+	// so each initializer must have different argument labels
+
+	overloadPositions := make(map[string]ast.Position, initializerCount)
+
+	overloads = make([]*InitializerOverload, 0, initializerCount)
+
+	for i, initializer := range initializers {
+		parameterList := initializer.FunctionDeclaration.ParameterList
+		argumentLabels := parameterList.EffectiveArgumentLabels()
+
+		identifier := FunctionOverloadIdentifier(
+			common.DeclarationKindInitializer.Keywords(),
+			argumentLabels,
+		)
+
+		pos := initializer.FunctionDeclaration.StartPos
+
+		if previousPos, ok := overloadPositions[identifier]; ok {
 			checker.report(
-				&UnsupportedOverloadingError{
-					DeclarationKind: common.DeclarationKindInitializer,
-					Range:           ast.NewRangeFromPositioned(checker.memoryGauge, secondInitializer),
+				&RedeclarationError{
+					Name:        common.DeclarationKindInitializer.Keywords(),
+					Pos:         pos,
+					Kind:        common.DeclarationKindInitializer,
+					PreviousPos: &previousPos,
 				},
 			)
+		} else {
+			overloadPositions[identifier] = pos
+		}
+
+		overloadParameters := parameters
+		if i > 0 {
+			overloadParameters = checker.parameters(parameterList)
 		}
+
+		overloads = append(
+			overloads,
+			&InitializerOverload{
+				Identifier:     identifier,
+				ArgumentLabels: argumentLabels,
+				Parameters:     overloadParameters,
+			},
+		)
 	}
-	return parameters
+
+	return parameters, overloads
 }
 
+// explicitInterfaceConformances resolves the given conformances
+// of the given composite or interface type.
+//
 func (checker *Checker) explicitInterfaceConformances(
-	declaration *ast.CompositeDeclaration,
-	compositeType *CompositeType,
+	conformances []*ast.NominalType,
+	compositeKindedType CompositeKindedType,
 ) []*InterfaceType {
 
 	var interfaceTypes []*InterfaceType
 	seenConformances := map[*InterfaceType]bool{}
 
-	for _, conformance := range declaration.Conformances {
+	for _, conformance := range conformances {
 		convertedType := checker.ConvertType(conformance)
 
 		if interfaceType, ok := convertedType.(*InterfaceType); ok {
@@ -881,7 +1068,7 @@ func (checker *Checker) explicitInterfaceConformances(
 			if seenConformances[interfaceType] {
 				checker.report(
 					&DuplicateConformanceError{
-						CompositeType: compositeType,
+						CompositeType: compositeKindedType,
 						InterfaceType: interfaceType,
 						Range:         ast.NewRangeFromPositioned(checker.memoryGauge, conformance.Identifier),
 					},
@@ -990,9 +1177,9 @@ func (checker *Checker) checkCompositeConformance(
 		)
 	}
 
-	// Check initializer requirement
-
-	// TODO: add support for overloaded initializers
+	// Check initializer requirement.
+	// If the initializers of the composite are overloaded,
+	// the first initializer must satisfy the requirement
 
 	if interfaceType.InitializerParameters != nil {
 
@@ -1006,7 +1193,9 @@ func (checker *Checker) checkCompositeConformance(
 		}
 
 		// TODO: subtype?
-		if !initializerType.Equal(interfaceInitializerType) {
+		if !initializerType.Equal(interfaceInitializerType) ||
+			requiredArgumentCount(compositeType.ConstructorParameters) != nil {
+
 			initializerMismatch = &InitializerMismatch{
 				CompositeParameters: compositeType.ConstructorParameters,
 				InterfaceParameters: interfaceType.InitializerParameters,
@@ -1014,6 +1203,24 @@ func (checker *Checker) checkCompositeConformance(
 		}
 	}
 
+	// The conditions of the required initializer are only checked for the first initializer,
+	// as the other initializers have different parameters.
+	// Reject overloaded initializers, so the conditions cannot be bypassed
+
+	if interfaceType.InitializerHasConditions &&
+		len(compositeType.InitializerOverloads) > 1 {
+
+		initializers := compositeDeclaration.Members.Initializers()
+		if len(initializers) > 1 {
+			checker.report(
+				&InitializerConditionsOverloadingError{
+					InterfaceType: interfaceType,
+					Range:         ast.NewRangeFromPositioned(checker.memoryGauge, initializers[1]),
+				},
+			)
+		}
+	}
+
 	// Determine missing members and member conformance
 
 	interfaceType.Members.Foreach(func(name string, interfaceMember *Member) {
@@ -1033,6 +1240,38 @@ func (checker *Checker) checkCompositeConformance(
 			return
 		}
 
+		// If the function is overloaded in the interface or in the composite,
+		// each overload of the interface must be satisfied
+		// by the overload of the composite with the same argument labels
+
+		if len(interfaceMember.Overloads) > 0 || len(compositeMember.Overloads) > 0 {
+			interfaceOverloads := interfaceMember.Overloads
+			if len(interfaceOverloads) == 0 {
+				interfaceOverloads = []*Member{interfaceMember}
+			}
+
+			for _, interfaceOverload := range interfaceOverloads {
+				compositeOverload := compositeMember.Overload(interfaceOverload.ArgumentLabels)
+				if compositeOverload == nil {
+					if options.checkMissingMembers {
+						missingMembers = append(missingMembers, interfaceOverload)
+					}
+					continue
+				}
+
+				if !checker.memberSatisfied(compositeOverload, interfaceOverload) {
+					memberMismatches = append(memberMismatches,
+						MemberMismatch{
+							CompositeMember: compositeOverload,
+							InterfaceMember: interfaceOverload,
+						},
+					)
+				}
+			}
+
+			return
+		}
+
 		if !checker.memberSatisfied(compositeMember, interfaceMember) {
 			memberMismatches = append(memberMismatches,
 				MemberMismatch{
@@ -1130,6 +1369,46 @@ func (checker *Checker) memberSatisfied(compositeMember, interfaceMember *Member
 				return false
 			}
 
+			// The implementation cannot have default arguments,
+			// as the interface's conditions are checked with the arguments of the invocation
+
+			if compositeMemberFunctionType.RequiredArgumentCount != nil {
+				return false
+			}
+
+			// Generic functions must have type parameters with the same type bounds.
+			// Refer to the type parameters of the interface function in the composite function,
+			// so the parameter types and return types can be compared
+
+			interfaceTypeParameters := interfaceMemberFunctionType.TypeParameters
+			compositeTypeParameters := compositeMemberFunctionType.TypeParameters
+
+			if len(compositeTypeParameters) != len(interfaceTypeParameters) {
+				return false
+			}
+
+			if len(interfaceTypeParameters) > 0 {
+				typeArguments := &TypeParameterTypeOrderedMap{}
+
+				for i, interfaceTypeParameter := range interfaceTypeParameters {
+					compositeTypeParameter := compositeTypeParameters[i]
+					if !compositeTypeParameter.TypeBound.Equal(interfaceTypeParameter.TypeBound) {
+						return false
+					}
+
+					typeArguments.Set(
+						compositeTypeParameter,
+						&GenericType{TypeParameter: interfaceTypeParameter},
+					)
+				}
+
+				compositeMemberFunctionType, isCompositeMemberFunctionType =
+					compositeMemberFunctionType.Resolve(typeArguments).(*FunctionType)
+				if !isCompositeMemberFunctionType {
+					return false
+				}
+			}
+
 			// Functions are invariant in their parameter types
 
 			for i, subParameter := range compositeMemberFunctionType.Parameters {
@@ -1270,7 +1549,7 @@ func (checker *Checker) checkTypeRequirement(
 
 	for _, requiredConformance := range requiredCompositeType.ExplicitInterfaceConformances {
 		found := false
-		for _, conformance := range declaredCompositeType.ExplicitInterfaceConformances {
+		for _, conformance := range declaredCompositeType.EffectiveInterfaceConformances() {
 			if conformance == requiredConformance {
 				found = true
 				break
@@ -1312,12 +1591,17 @@ func (checker *Checker) compositeConstructorType(
 	argumentLabels []string,
 ) {
 
+	// The constructor of a generic composite is generic,
+	// e.g. the constructor of `struct Box<T>` returns `Box<T>`
+
 	constructorFunctionType = &FunctionType{
 		IsConstructor:        true,
+		TypeParameters:       compositeType.typeParameters,
 		ReturnTypeAnnotation: NewTypeAnnotation(compositeType),
 	}
 
-	// TODO: support multiple overloaded initializers
+	// The constructor function type is the type of the first initializer.
+	// Invocations of overloaded initializers select the initializer by argument labels
 
 	initializers := compositeDeclaration.Members.Initializers()
 	if len(initializers) > 0 {
@@ -1329,6 +1613,7 @@ func (checker *Checker) compositeConstructorType(
 			EffectiveArgumentLabels()
 
 		constructorFunctionType.Parameters = compositeType.ConstructorParameters
+		constructorFunctionType.RequiredArgumentCount = requiredArgumentCount(compositeType.ConstructorParameters)
 
 		// NOTE: Don't use `constructorFunctionType`, as it has a return type.
 		//   The initializer itself has a `Void` return type.
@@ -1339,6 +1624,19 @@ func (checker *Checker) compositeConstructorType(
 				Parameters:           constructorFunctionType.Parameters,
 				ReturnTypeAnnotation: NewTypeAnnotation(VoidType),
 			}
+
+		for i, overload := range compositeType.InitializerOverloads {
+			if i == 0 {
+				continue
+			}
+
+			checker.Elaboration.ConstructorFunctionTypes[initializers[i]] =
+				&FunctionType{
+					IsConstructor:        true,
+					Parameters:           overload.Parameters,
+					ReturnTypeAnnotation: NewTypeAnnotation(VoidType),
+				}
+		}
 	}
 
 	return constructorFunctionType, argumentLabels
@@ -1472,6 +1770,8 @@ func (checker *Checker) defaultMembersAndOrigins(
 	}
 
 	// declare a member for each function
+	functionMembers := make(map[string]*Member, len(functions))
+
 	for _, function := range functions {
 		if !checkInvalidIdentifier(function) {
 			continue
@@ -1479,7 +1779,14 @@ func (checker *Checker) defaultMembersAndOrigins(
 
 		identifier := function.Identifier.Identifier
 
-		functionType := checker.functionType(function.ParameterList, function.ReturnTypeAnnotation)
+		functionType := checker.functionDeclarationType(function)
+
+		// NOTE: check a generic function with the same function type,
+		// so the type parameters in the member's type and in the function are the same
+
+		if len(functionType.TypeParameters) > 0 {
+			checker.Elaboration.FunctionDeclarationFunctionTypes[function] = functionType
+		}
 
 		argumentLabels := function.ParameterList.EffectiveArgumentLabels()
 
@@ -1502,18 +1809,37 @@ func (checker *Checker) defaultMembersAndOrigins(
 			)
 		}
 
-		members.Set(
-			identifier,
-			&Member{
-				ContainerType:   containerType,
-				Access:          function.Access,
-				Identifier:      function.Identifier,
-				DeclarationKind: declarationKind,
-				TypeAnnotation:  fieldTypeAnnotation,
-				VariableKind:    ast.VariableKindConstant,
-				ArgumentLabels:  argumentLabels,
-				DocString:       function.DocString,
-			})
+		member := &Member{
+			ContainerType:   containerType,
+			Access:          function.Access,
+			Identifier:      function.Identifier,
+			DeclarationKind: declarationKind,
+			TypeAnnotation:  fieldTypeAnnotation,
+			VariableKind:    ast.VariableKindConstant,
+			ArgumentLabels:  argumentLabels,
+			DocString:       function.DocString,
+		}
+
+		// If a function with the same name was already declared,
+		// the function is an overload of it.
+		// Overloads with the same argument labels are reported as redeclarations
+		// in checkNestedIdentifiers
+
+		if firstMember, ok := functionMembers[identifier]; ok {
+			if firstMember.Overload(argumentLabels) != nil {
+				continue
+			}
+
+			if len(firstMember.Overloads) == 0 {
+				firstMember.Overloads = []*Member{firstMember}
+			}
+			firstMember.Overloads = append(firstMember.Overloads, member)
+			continue
+		}
+
+		functionMembers[identifier] = member
+
+		members.Set(identifier, member)
 
 		if checker.positionInfoEnabled && origins != nil {
 			origins[identifier] =
@@ -1521,6 +1847,42 @@ func (checker *Checker) defaultMembersAndOrigins(
 		}
 	}
 
+	// Functions of interfaces cannot have default arguments, see `checkInitializers`,
+	// and neither can overloaded functions
+
+	for _, function := range functions {
+		if containerKind == ContainerKindInterface {
+			checker.reportUnsupportedDefaultArguments(function.ParameterList, containerDeclarationKind, false)
+			continue
+		}
+
+		member, ok := functionMembers[function.Identifier.Identifier]
+		if ok && len(member.Overloads) > 0 {
+			checker.reportUnsupportedDefaultArguments(function.ParameterList, common.DeclarationKindFunction, true)
+		}
+	}
+
+	// Overloaded functions are distinguished by their argument labels.
+	// Functions of interfaces are always distinguished by their argument labels,
+	// as conforming composites may overload them
+
+	for _, member := range functionMembers { //nolint:maprangecheck
+		overloads := member.Overloads
+		if len(overloads) == 0 {
+			if containerKind != ContainerKindInterface {
+				continue
+			}
+			overloads = []*Member{member}
+		}
+
+		for _, overload := range overloads {
+			overload.OverloadIdentifier = FunctionOverloadIdentifier(
+				overload.Identifier.Identifier,
+				overload.ArgumentLabels,
+			)
+		}
+	}
+
 	return members, fieldNames, origins
 }
 
@@ -1666,9 +2028,6 @@ func (checker *Checker) checkInitializers(
 		return
 	}
 
-	// TODO: check all initializers:
-	//  parameter initializerParameterTypeAnnotations needs to be a slice
-
 	initializer := initializers[0]
 	checker.checkSpecialFunction(
 		initializer,
@@ -1680,6 +2039,49 @@ func (checker *Checker) checkInitializers(
 		initializationInfo,
 	)
 
+	// If the initializers are overloaded, check the other initializers.
+	// Each initializer must initialize all fields
+
+	if compositeType, ok := containerType.(*CompositeType); ok &&
+		len(compositeType.InitializerOverloads) == count {
+
+		for i, overload := range compositeType.InitializerOverloads {
+			if i == 0 {
+				continue
+			}
+
+			checker.checkSpecialFunction(
+				initializers[i],
+				containerType,
+				containerDeclarationKind,
+				containerDocString,
+				overload.Parameters,
+				containerKind,
+				NewInitializationInfo(containerType, initializationInfo.FieldMembers),
+			)
+		}
+	}
+
+	// Initializers of interfaces and events cannot have default arguments,
+	// as the conditions of an interface are checked before the implementation is invoked,
+	// and events are constructed without invoking their initializer.
+	// Overloaded initializers cannot have default arguments either,
+	// as an invocation which omits arguments might match multiple overloads
+
+	for _, initializer := range initializers {
+		parameterList := initializer.FunctionDeclaration.ParameterList
+
+		switch {
+		case containerKind == ContainerKindInterface,
+			containerDeclarationKind == common.DeclarationKindEvent:
+
+			checker.reportUnsupportedDefaultArguments(parameterList, containerDeclarationKind, false)
+
+		case count > 1:
+			checker.reportUnsupportedDefaultArguments(parameterList, common.DeclarationKindInitializer, true)
+		}
+	}
+
 	// If the initializer is for an event,
 	// ensure all parameters are valid
 
@@ -1770,7 +2172,7 @@ func (checker *Checker) checkSpecialFunction(
 		}
 
 	case ContainerKindComposite:
-		// Event declarations have an empty initializer as it is synthesized
+		// Event and newtype declarations have an empty initializer as it is synthesized
 
 		compositeType, ok := containerType.(*CompositeType)
 		if !ok {
@@ -1778,6 +2180,7 @@ func (checker *Checker) checkSpecialFunction(
 			panic(errors.NewUnreachableError())
 		}
 		if compositeType.Kind != common.CompositeKindEvent &&
+			compositeType.Kind != common.CompositeKindNewtype &&
 			specialFunction.FunctionDeclaration.FunctionBlock == nil {
 
 			checker.report(
@@ -1849,10 +2252,15 @@ func (checker *Checker) declareSelfValue(selfType Type, selfDocString string) {
 }
 
 // checkNestedIdentifiers checks that nested identifiers, i.e. fields, functions,
-// and nested interfaces and composites, are unique and aren't named `init` or `destroy`
+// and nested interfaces and composites, are unique and aren't named `init` or `destroy`.
+//
+// Functions may be overloaded, i.e. declared multiple times with the same name,
+// as long as the argument labels of the overloads differ
 //
 func (checker *Checker) checkNestedIdentifiers(members *ast.Members) {
 	positions := map[string]ast.Position{}
+	functionNames := map[string]bool{}
+	overloadPositions := map[string]ast.Position{}
 
 	for _, declaration := range members.Declarations() {
 
@@ -1865,6 +2273,35 @@ func (checker *Checker) checkNestedIdentifiers(members *ast.Members) {
 			continue
 		}
 
+		if function, ok := declaration.(*ast.FunctionDeclaration); ok {
+			name := identifier.Identifier
+			overloadIdentifier := FunctionOverloadIdentifier(
+				name,
+				function.ParameterList.EffectiveArgumentLabels(),
+			)
+
+			if functionNames[name] {
+				if previousPos, ok := overloadPositions[overloadIdentifier]; ok {
+					checker.report(
+						&RedeclarationError{
+							Name:        name,
+							Pos:         identifier.Pos,
+							Kind:        declaration.DeclarationKind(),
+							PreviousPos: &previousPos,
+						},
+					)
+				} else {
+					overloadPositions[overloadIdentifier] = identifier.Pos
+				}
+				continue
+			}
+
+			if _, ok := positions[name]; !ok {
+				functionNames[name] = true
+				overloadPositions[overloadIdentifier] = identifier.Pos
+			}
+		}
+
 		checker.checkNestedIdentifier(
 			*identifier,
 			declaration.DeclarationKind(),
@@ -2126,3 +2563,107 @@ func (checker *Checker) checkResourceUseAfterInvalidation(resource Resource, use
 		},
 	)
 }
+
+// checkHashableFields checks that all fields of the given hashable composite type
+// have hashable types, i.e. types which are valid dictionary key types,
+// so the hash of a value of the composite type can be derived from its fields.
+//
+func (checker *Checker) checkHashableFields(
+	declaration *ast.CompositeDeclaration,
+	compositeType *CompositeType,
+) {
+	for _, field := range declaration.Members.Fields() {
+		fieldName := field.Identifier.Identifier
+
+		member, ok := compositeType.Members.Get(fieldName)
+		if !ok {
+			continue
+		}
+
+		fieldType := member.TypeAnnotation.Type
+		if fieldType.IsInvalidType() || IsValidDictionaryKeyType(fieldType) {
+			continue
+		}
+
+		checker.report(
+			&InvalidHashableFieldError{
+				Name:  fieldName,
+				Type:  fieldType,
+				Range: ast.NewRangeFromPositioned(checker.memoryGauge, field.TypeAnnotation),
+			},
+		)
+	}
+}
+
+// checkEquatableMembers checks the members of the given equatable composite type.
+//
+// If the composite type declares an `equals` function, it must have the signature
+// `fun equals(_ other: T): Bool`, where `T` is the composite type.
+// Otherwise, values are compared field-wise, so all fields must have equatable types.
+//
+func (checker *Checker) checkEquatableMembers(
+	declaration *ast.CompositeDeclaration,
+	compositeType *CompositeType,
+) {
+	equalsMember, ok := compositeType.Members.Get(EquatableTypeEqualsFunctionName)
+	if ok {
+		if !isValidEquatableEqualsFunction(equalsMember, compositeType) {
+			checker.report(
+				&InvalidEquatableEqualsFunctionError{
+					Type:  compositeType,
+					Range: ast.NewRangeFromPositioned(checker.memoryGauge, equalsMember.Identifier),
+				},
+			)
+		}
+
+		// The hash of a hashable value is derived from its fields,
+		// so equality must be field-wise, too
+
+		if compositeType.IsHashable() {
+			checker.report(
+				&InvalidHashableEqualsFunctionError{
+					Range: ast.NewRangeFromPositioned(checker.memoryGauge, equalsMember.Identifier),
+				},
+			)
+		}
+
+		return
+	}
+
+	for _, field := range declaration.Members.Fields() {
+		fieldName := field.Identifier.Identifier
+
+		member, ok := compositeType.Members.Get(fieldName)
+		if !ok {
+			continue
+		}
+
+		fieldType := member.TypeAnnotation.Type
+		if fieldType.IsInvalidType() || fieldType.IsEquatable() {
+			continue
+		}
+
+		checker.report(
+			&InvalidEquatableFieldError{
+				Name:  fieldName,
+				Type:  fieldType,
+				Range: ast.NewRangeFromPositioned(checker.memoryGauge, field.TypeAnnotation),
+			},
+		)
+	}
+}
+
+func isValidEquatableEqualsFunction(member *Member, compositeType *CompositeType) bool {
+	if member.DeclarationKind != common.DeclarationKindFunction {
+		return false
+	}
+
+	functionType, ok := member.TypeAnnotation.Type.(*FunctionType)
+	if !ok {
+		return false
+	}
+
+	return len(functionType.Parameters) == 1 &&
+		functionType.Parameters[0].TypeAnnotation.Type.Equal(compositeType) &&
+		functionType.ReturnTypeAnnotation.Type.Equal(BoolType)
+}
@@ -69,16 +69,8 @@ func (checker *Checker) VisitCreateExpression(expression *ast.CreateExpression)
 
 func (checker *Checker) checkResourceCreationOrDestruction(compositeType *CompositeType, positioned ast.HasPosition) {
 
-	contractType := containingContractKindedType(compositeType)
-
-	if contractType == nil {
-		if compositeType.Location == checker.Location {
-			return
-		}
-	} else {
-		if checker.containerTypes[contractType] {
-			return
-		}
+	if checker.isInResourceDeclaringContainer(compositeType) {
+		return
 	}
 
 	checker.report(
@@ -88,3 +80,18 @@ func (checker *Checker) checkResourceCreationOrDestruction(compositeType *Compos
 		},
 	)
 }
+
+// isInResourceDeclaringContainer returns true if the current location of the checker
+// is in the contract that declares the composite, or if not contained in a contract,
+// in the same location
+//
+func (checker *Checker) isInResourceDeclaringContainer(compositeType *CompositeType) bool {
+
+	contractType := containingContractKindedType(compositeType)
+
+	if contractType == nil {
+		return compositeType.Location == checker.Location
+	}
+
+	return checker.containerTypes[contractType]
+}
@@ -108,10 +108,21 @@ func IsValidDictionaryKeyType(keyType Type) bool {
 	case *AddressType:
 		return true
 	case *CompositeType:
-		return keyType.Kind == common.CompositeKindEnum
+		return keyType.Kind == common.CompositeKindEnum ||
+			keyType.IsHashable()
+	case *RestrictedType:
+		return keyType.Type == AnyStructType &&
+			keyType.RestrictionSet().Includes(HashableType)
+	case *GenericType:
+		// A type parameter can be used as a key type,
+		// if all types satisfying its type bound can
+		return keyType.TypeParameter.declared &&
+			IsValidDictionaryKeyType(keyType.TypeParameter.TypeBound)
 	default:
 		switch keyType {
-		case NeverType, BoolType, CharacterType, StringType, MetaType:
+		case NeverType, BoolType, CharacterType, StringType, MetaType,
+			TimestampType, DurationType:
+
 			return true
 		default:
 			return IsSameTypeKind(keyType, NumberType) ||
@@ -76,8 +76,15 @@ func IsValidEventParameterType(t Type, results map[*Member]bool) bool {
 		return IsValidEventParameterType(t.KeyType, results) &&
 			IsValidEventParameterType(t.ValueType, results)
 
+	case *SetType:
+		return IsValidEventParameterType(t.ElementType, results)
+
 	case *CompositeType:
-		if t.Kind != common.CompositeKindStructure {
+		switch t.Kind {
+		case common.CompositeKindStructure,
+			common.CompositeKindNewtype:
+			break
+		default:
 			return false
 		}
 
@@ -93,7 +100,7 @@ func IsValidEventParameterType(t Type, results map[*Member]bool) bool {
 
 	default:
 		switch t {
-		case MetaType, BoolType, CharacterType, StringType:
+		case MetaType, BoolType, CharacterType, StringType, TimestampType, DurationType:
 			return true
 		}
 
@@ -69,7 +69,7 @@ func (checker *Checker) visitFunctionDeclaration(
 
 	functionType := checker.Elaboration.FunctionDeclarationFunctionTypes[declaration]
 	if functionType == nil {
-		functionType = checker.functionType(declaration.ParameterList, declaration.ReturnTypeAnnotation)
+		functionType = checker.functionDeclarationType(declaration)
 
 		if options.declareFunction {
 			checker.declareFunctionDeclaration(declaration, functionType)
@@ -78,6 +78,15 @@ func (checker *Checker) visitFunctionDeclaration(
 
 	checker.Elaboration.FunctionDeclarationFunctionTypes[declaration] = functionType
 
+	// The type parameters of a generic function are in scope in the function
+
+	if len(functionType.TypeParameters) > 0 {
+		checker.typeActivations.Enter()
+		defer checker.typeActivations.Leave(declaration.EndPosition)
+
+		checker.declareTypeParameters(declaration.TypeParameterList, functionType.TypeParameters, false)
+	}
+
 	checker.checkFunction(
 		declaration.ParameterList,
 		declaration.ReturnTypeAnnotation,
@@ -168,10 +177,13 @@ func (checker *Checker) checkFunction(
 				checker.leaveValueScope(endPosGetter, checkResourceLoss)
 			}()
 
-			checker.declareParameters(parameterList, functionType.Parameters)
+			// NOTE: set the initialization info before declaring the parameters,
+			//   so default arguments of initializers cannot access uninitialized fields
 
 			functionActivation.InitializationInfo = initializationInfo
 
+			checker.declareParameters(parameterList, functionType.Parameters)
+
 			if functionBlock != nil {
 				checker.visitFunctionBlock(
 					functionBlock,
@@ -245,6 +257,59 @@ func (checker *Checker) checkParameters(parameterList *ast.ParameterList, parame
 			parameter.TypeAnnotation,
 		)
 	}
+
+	checker.checkDefaultArgumentOrder(parameterList)
+}
+
+// checkDefaultArgumentOrder checks that all parameters following
+// a parameter with a default argument also have a default argument,
+// so invocations can only omit trailing arguments
+//
+func (checker *Checker) checkDefaultArgumentOrder(parameterList *ast.ParameterList) {
+	hasDefaultArgument := false
+
+	for _, parameter := range parameterList.Parameters {
+		if parameter.DefaultArgument != nil {
+			hasDefaultArgument = true
+			continue
+		}
+
+		if hasDefaultArgument {
+			checker.report(
+				&MissingDefaultArgumentError{
+					Name:  parameter.Identifier.Identifier,
+					Range: ast.NewRangeFromPositioned(checker.memoryGauge, parameter),
+				},
+			)
+		}
+	}
+}
+
+// reportUnsupportedDefaultArguments reports the default arguments in the given parameter list
+// of a declaration which does not support them, e.g. an event or a transaction
+//
+func (checker *Checker) reportUnsupportedDefaultArguments(
+	parameterList *ast.ParameterList,
+	declarationKind common.DeclarationKind,
+	overloaded bool,
+) {
+	if parameterList == nil {
+		return
+	}
+
+	for _, parameter := range parameterList.Parameters {
+		if parameter.DefaultArgument == nil {
+			continue
+		}
+
+		checker.report(
+			&UnsupportedDefaultArgumentError{
+				DeclarationKind: declarationKind,
+				Overloaded:      overloaded,
+				Range:           ast.NewRangeFromPositioned(checker.memoryGauge, parameter.DefaultArgument),
+			},
+		)
+	}
 }
 
 // checkArgumentLabels checks that all argument labels (if any) are unique
@@ -288,6 +353,15 @@ func (checker *Checker) declareParameters(
 	for i, parameter := range parameterList.Parameters {
 		identifier := parameter.Identifier
 
+		parameterType := parameters[i].TypeAnnotation.Type
+
+		// The default argument is checked before the parameter is declared,
+		// so it can only refer to the preceding parameters
+
+		if parameter.DefaultArgument != nil {
+			checker.checkDefaultArgument(parameter, parameterType)
+		}
+
 		// check if variable with this identifier is already declared in the current scope
 		existingVariable := checker.valueActivations.Find(identifier.Identifier)
 		if existingVariable != nil && existingVariable.ActivationDepth == depth {
@@ -303,8 +377,6 @@ func (checker *Checker) declareParameters(
 			continue
 		}
 
-		parameterType := parameters[i].TypeAnnotation.Type
-
 		variable := &Variable{
 			Identifier:      identifier.Identifier,
 			Access:          ast.AccessPublic,
@@ -321,6 +393,102 @@ func (checker *Checker) declareParameters(
 	}
 }
 
+// checkDefaultArgument checks the default argument of the given parameter.
+// The default argument is evaluated in the scope of the function when it is invoked,
+// so it must be constant
+//
+func (checker *Checker) checkDefaultArgument(parameter *ast.Parameter, parameterType Type) {
+	defaultArgument := parameter.DefaultArgument
+
+	valueType := checker.VisitExpression(defaultArgument, parameterType)
+
+	checker.Elaboration.DefaultArgumentValueTypes[parameter] = valueType
+	checker.Elaboration.DefaultArgumentParameterTypes[parameter] = parameterType
+
+	if valueType.IsResourceType() ||
+		!checker.isConstantExpression(defaultArgument) {
+
+		checker.report(
+			&NonConstantDefaultArgumentError{
+				Range: ast.NewRangeFromPositioned(checker.memoryGauge, defaultArgument),
+			},
+		)
+	}
+}
+
+// isConstantExpression returns true if the given expression has no side effects
+// and only refers to constants, e.g. literals, constants, parameters, and members of `self`
+//
+func (checker *Checker) isConstantExpression(expression ast.Expression) bool {
+	switch expression := expression.(type) {
+	case *ast.BoolExpression,
+		*ast.NilExpression,
+		*ast.StringExpression,
+		*ast.IntegerExpression,
+		*ast.FixedPointExpression,
+		*ast.PathExpression:
+
+		return true
+
+	case *ast.IdentifierExpression:
+		// NOTE: undeclared variables are already reported
+		variable := checker.valueActivations.Find(expression.Identifier.Identifier)
+		return variable == nil || variable.IsConstant
+
+	case *ast.MemberExpression:
+		return checker.isConstantExpression(expression.Expression)
+
+	case *ast.IndexExpression:
+		return checker.isConstantExpression(expression.TargetExpression) &&
+			checker.isConstantExpression(expression.IndexingExpression)
+
+	case *ast.ArrayExpression:
+		for _, value := range expression.Values {
+			if !checker.isConstantExpression(value) {
+				return false
+			}
+		}
+		return true
+
+	case *ast.DictionaryExpression:
+		for _, entry := range expression.Entries {
+			if !checker.isConstantExpression(entry.Key) ||
+				!checker.isConstantExpression(entry.Value) {
+
+				return false
+			}
+		}
+		return true
+
+	case *ast.UnaryExpression:
+		return expression.Operation != ast.OperationMove &&
+			checker.isConstantExpression(expression.Expression)
+
+	case *ast.BinaryExpression:
+		return checker.isConstantExpression(expression.Left) &&
+			checker.isConstantExpression(expression.Right)
+
+	case *ast.ConditionalExpression:
+		return checker.isConstantExpression(expression.Test) &&
+			checker.isConstantExpression(expression.Then) &&
+			checker.isConstantExpression(expression.Else)
+
+	case *ast.CastingExpression:
+		return checker.isConstantExpression(expression.Expression)
+
+	case *ast.ForceExpression:
+		return checker.isConstantExpression(expression.Expression)
+
+	case *ast.ReferenceExpression:
+		return checker.isConstantExpression(expression.Expression)
+
+	default:
+		// e.g. invocations, function expressions,
+		// and creation and destruction of resources
+		return false
+	}
+}
+
 func (checker *Checker) VisitFunctionBlock(functionBlock *ast.FunctionBlock) ast.Repr {
 	// NOTE: see visitFunctionBlock
 	panic(errors.NewUnreachableError())
@@ -57,6 +57,8 @@ func (checker *Checker) VisitInterfaceDeclaration(declaration *ast.InterfaceDecl
 
 	checker.checkNestedIdentifiers(declaration.Members)
 
+	checker.checkInterfaceConformances(declaration, interfaceType)
+
 	// Activate new scope for nested types
 
 	checker.typeActivations.Enter()
@@ -65,6 +67,7 @@ func (checker *Checker) VisitInterfaceDeclaration(declaration *ast.InterfaceDecl
 	// Declare nested types
 
 	checker.declareInterfaceNestedTypes(declaration)
+	checker.declareNestedTypeAliases(declaration.Members)
 
 	checker.checkInitializers(
 		declaration.Members.Initializers(),
@@ -215,6 +218,7 @@ func (checker *Checker) declareInterfaceType(declaration *ast.InterfaceDeclarati
 		Identifier:    identifier.Identifier,
 		CompositeKind: declaration.CompositeKind,
 		nestedTypes:   &StringTypeOrderedMap{},
+		typeAliases:   &StringTypeOrderedMap{},
 		Members:       &StringMemberOrderedMap{},
 	}
 
@@ -302,6 +306,7 @@ func (checker *Checker) declareInterfaceMembers(declaration *ast.InterfaceDeclar
 	// Declare nested types
 
 	checker.declareInterfaceNestedTypes(declaration)
+	checker.declareNestedTypeAliases(declaration.Members)
 
 	// Declare members
 
@@ -325,8 +330,11 @@ func (checker *Checker) declareInterfaceMembers(declaration *ast.InterfaceDeclar
 	// NOTE: determine initializer parameter types while nested types are in scope,
 	// and after declaring nested types as the initializer may use nested type in parameters
 
-	interfaceType.InitializerParameters =
-		checker.initializerParameters(declaration.Members.Initializers())
+	initializers := declaration.Members.Initializers()
+
+	interfaceType.InitializerParameters, _ =
+		checker.initializerParameters(initializers, false)
+	interfaceType.InitializerHasConditions = initializerHasConditions(initializers)
 
 	// Declare nested declarations' members
 
@@ -339,6 +347,177 @@ func (checker *Checker) declareInterfaceMembers(declaration *ast.InterfaceDeclar
 	}
 }
 
+// declareInterfaceConformances resolves the conformances of the given interface declaration,
+// and recursively for all nested interface declarations.
+//
+// NOTE: This function assumes that the interface type and the nested declarations' types
+// were previously declared using `declareInterfaceType`.
+//
+func (checker *Checker) declareInterfaceConformances(declaration *ast.InterfaceDeclaration) {
+
+	interfaceType := checker.Elaboration.InterfaceDeclarationTypes[declaration]
+	if interfaceType == nil {
+		panic(errors.NewUnreachableError())
+	}
+
+	// NOTE: resolve the conformances in the enclosing scope,
+	// i.e. *before* declaring the nested types
+
+	interfaceType.ExplicitInterfaceConformances =
+		checker.explicitInterfaceConformances(declaration.Conformances, interfaceType)
+
+	// Ensure the composite kinds match, e.g. a structure interface shouldn't be able
+	// to conform to a resource interface
+
+	for i, conformance := range interfaceType.ExplicitInterfaceConformances {
+		if conformance.CompositeKind == interfaceType.CompositeKind {
+			continue
+		}
+
+		checker.report(
+			&CompositeKindMismatchError{
+				ExpectedKind: interfaceType.CompositeKind,
+				ActualKind:   conformance.CompositeKind,
+				Range: ast.NewRangeFromPositioned(
+					checker.memoryGauge,
+					declaration.Conformances[i].Identifier,
+				),
+			},
+		)
+	}
+
+	checker.typeActivations.Enter()
+	defer checker.typeActivations.Leave(declaration.EndPosition)
+
+	checker.declareInterfaceNestedTypes(declaration)
+
+	checker.declareMembersInterfaceConformances(declaration.Members)
+}
+
+// declareCompositeInterfaceConformances resolves the conformances
+// of the interface declarations nested in the given composite declaration, recursively.
+//
+// NOTE: The conformances of the composite declaration itself
+// are resolved in `declareCompositeType`.
+//
+func (checker *Checker) declareCompositeInterfaceConformances(declaration *ast.CompositeDeclaration) {
+
+	checker.typeActivations.Enter()
+	defer checker.typeActivations.Leave(declaration.EndPosition)
+
+	checker.declareCompositeNestedTypes(declaration, ContainerKindComposite, false)
+
+	checker.declareMembersInterfaceConformances(declaration.Members)
+}
+
+func (checker *Checker) declareMembersInterfaceConformances(members *ast.Members) {
+
+	for _, nestedInterface := range members.Interfaces() {
+		checker.declareInterfaceConformances(nestedInterface)
+	}
+
+	for _, nestedComposite := range members.Composites() {
+		checker.declareCompositeInterfaceConformances(nestedComposite)
+	}
+}
+
+// checkInterfaceConformances checks that the given interface type
+// does not conform to itself, directly or indirectly,
+// and that the members it declares and inherits do not conflict.
+//
+func (checker *Checker) checkInterfaceConformances(
+	declaration *ast.InterfaceDeclaration,
+	interfaceType *InterfaceType,
+) {
+	if len(interfaceType.ExplicitInterfaceConformances) == 0 {
+		return
+	}
+
+	// NOTE: check for cycles first,
+	// as the member conflicts of a cyclic hierarchy are meaningless
+
+	effectiveConformances := appendEffectiveInterfaceConformances(
+		nil,
+		map[*InterfaceType]struct{}{},
+		interfaceType.ExplicitInterfaceConformances,
+	)
+
+	for _, conformance := range effectiveConformances {
+		if conformance == interfaceType {
+			checker.report(
+				&CyclicConformanceError{
+					InterfaceType: interfaceType,
+					Range:         ast.NewRangeFromPositioned(checker.memoryGauge, declaration.Identifier),
+				},
+			)
+			return
+		}
+	}
+
+	// Members declared in the interface itself may redeclare inherited members,
+	// e.g. to add conditions, but the declaration kinds and types must match.
+	// Members inherited from different interfaces must match as well
+
+	type inheritedMember struct {
+		interfaceType *InterfaceType
+		member        *Member
+	}
+
+	inheritedMembers := map[string]inheritedMember{}
+
+	for _, conformance := range effectiveConformances {
+		conformance.Members.Foreach(func(name string, member *Member) {
+
+			var previousInterfaceType *InterfaceType
+			var previousMember *Member
+			var errorRange ast.Range
+
+			if declaredMember, ok := interfaceType.Members.Get(name); ok {
+				previousInterfaceType = interfaceType
+				previousMember = declaredMember
+				errorRange = ast.NewRangeFromPositioned(checker.memoryGauge, declaredMember.Identifier)
+			} else if inherited, ok := inheritedMembers[name]; ok {
+				previousInterfaceType = inherited.interfaceType
+				previousMember = inherited.member
+				errorRange = ast.NewRangeFromPositioned(checker.memoryGauge, declaration.Identifier)
+			} else {
+				inheritedMembers[name] = inheritedMember{
+					interfaceType: conformance,
+					member:        member,
+				}
+				return
+			}
+
+			if interfaceMembersMatch(previousMember, member) {
+				return
+			}
+
+			checker.report(
+				&InterfaceMemberConflictError{
+					InterfaceType:            interfaceType,
+					MemberName:               name,
+					DeclaringInterfaceType:   previousInterfaceType,
+					ConflictingInterfaceType: conformance,
+					Range:                    errorRange,
+				},
+			)
+		})
+	}
+}
+
+func interfaceMembersMatch(member, otherMember *Member) bool {
+	if member.DeclarationKind != otherMember.DeclarationKind {
+		return false
+	}
+
+	memberType := member.TypeAnnotation.Type
+	otherMemberType := otherMember.TypeAnnotation.Type
+
+	return memberType.IsInvalidType() ||
+		otherMemberType.IsInvalidType() ||
+		memberType.Equal(otherMemberType)
+}
+
 func (checker *Checker) checkInterfaceSpecialFunctionBlock(
 	functionBlock *ast.FunctionBlock,
 	containerKind common.DeclarationKind,
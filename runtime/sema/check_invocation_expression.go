@@ -58,7 +58,19 @@ func (checker *Checker) checkInvocationExpression(invocationExpression *ast.Invo
 	// check the invoked expression can be invoked
 
 	invokedExpression := invocationExpression.InvokedExpression
-	expressionType := checker.VisitExpression(invokedExpression, nil)
+
+	expressionType := func() Type {
+		// Overloaded functions are selected based on the argument labels of the invocation,
+		// see checkMemberOverload
+
+		previousInvocationExpression := checker.currentInvocationExpression
+		checker.currentInvocationExpression = invocationExpression
+		defer func() {
+			checker.currentInvocationExpression = previousInvocationExpression
+		}()
+
+		return checker.VisitExpression(invokedExpression, nil)
+	}()
 
 	// Get the member from the invoked value
 	// based on the use of optional chaining syntax
@@ -107,6 +119,25 @@ func (checker *Checker) checkInvocationExpression(invocationExpression *ast.Invo
 		return InvalidType
 	}
 
+	// If the invoked function is the constructor of a composite with overloaded initializers,
+	// select the initializer based on the argument labels of the invocation
+
+	isInitializerOverloaded := false
+	if functionType.IsConstructor {
+		var initializerOverload *InitializerOverload
+		initializerOverload, isInitializerOverloaded =
+			checker.checkInitializerOverload(invocationExpression, functionType)
+
+		if initializerOverload != nil {
+			functionType = &FunctionType{
+				IsConstructor:        true,
+				TypeParameters:       functionType.TypeParameters,
+				Parameters:           initializerOverload.Parameters,
+				ReturnTypeAnnotation: functionType.ReturnTypeAnnotation,
+			}
+		}
+	}
+
 	// The invoked expression has a function type,
 	// check the invocation including all arguments.
 	//
@@ -154,10 +185,16 @@ func (checker *Checker) checkInvocationExpression(invocationExpression *ast.Invo
 
 	// If the invocation refers directly to the name of the function as stated in the declaration,
 	// or the invocation refers to a function of a composite (member),
-	// check that the correct argument labels are supplied in the invocation
+	// check that the correct argument labels are supplied in the invocation.
+	//
+	// Overloaded initializers are already selected by the argument labels
 
 	switch typedInvokedExpression := invokedExpression.(type) {
 	case *ast.IdentifierExpression:
+		if isInitializerOverloaded {
+			break
+		}
+
 		checker.checkIdentifierInvocationArgumentLabels(
 			invocationExpression,
 			typedInvokedExpression,
@@ -297,7 +334,13 @@ func (checker *Checker) checkMemberInvocationArgumentLabels(
 ) {
 	_, member, _ := checker.visitMember(memberExpression)
 
-	if member == nil || len(member.ArgumentLabels) == 0 {
+	// NOTE: overloads are selected by the argument labels of the invocation.
+	//   If no overload has the argument labels, the error was already reported
+
+	if member == nil ||
+		len(member.ArgumentLabels) == 0 ||
+		len(member.Overloads) > 0 {
+
 		return
 	}
 
@@ -307,6 +350,108 @@ func (checker *Checker) checkMemberInvocationArgumentLabels(
 	)
 }
 
+// invocationArgumentLabels returns the argument labels of the given arguments.
+// Arguments without a label have the label `_`
+//
+func invocationArgumentLabels(arguments []*ast.Argument) []string {
+	argumentLabels := make([]string, len(arguments))
+
+	for i, argument := range arguments {
+		argumentLabel := argument.Label
+		if argumentLabel == "" {
+			argumentLabel = ArgumentLabelNotRequired
+		}
+		argumentLabels[i] = argumentLabel
+	}
+
+	return argumentLabels
+}
+
+// checkMemberOverload selects the overload of the given overloaded function member
+// which has the argument labels of the invocation of the member expression.
+//
+// Overloaded functions can only be referred to in an invocation
+//
+func (checker *Checker) checkMemberOverload(
+	memberExpression *ast.MemberExpression,
+	member *Member,
+) *Member {
+
+	name := member.Identifier.Identifier
+
+	invocationExpression := checker.currentInvocationExpression
+	if invocationExpression == nil ||
+		invocationExpression.InvokedExpression != memberExpression {
+
+		checker.report(
+			&AmbiguousOverloadError{
+				Name:  name,
+				Range: ast.NewRangeFromPositioned(checker.memoryGauge, memberExpression),
+			},
+		)
+
+		return member
+	}
+
+	argumentLabels := invocationArgumentLabels(invocationExpression.Arguments)
+
+	overload := member.Overload(argumentLabels)
+	if overload == nil {
+		checker.report(
+			&NoMatchingOverloadError{
+				Name:           name,
+				ArgumentLabels: argumentLabels,
+				Range:          ast.NewRangeFromPositioned(checker.memoryGauge, invocationExpression),
+			},
+		)
+
+		return member
+	}
+
+	return overload
+}
+
+// checkInitializerOverload selects the initializer of the composite
+// constructed by the given constructor function type
+// which has the argument labels of the invocation.
+//
+// Returns if the initializers of the composite are overloaded,
+// and the selected initializer, if any
+//
+func (checker *Checker) checkInitializerOverload(
+	invocationExpression *ast.InvocationExpression,
+	constructorFunctionType *FunctionType,
+) (
+	overload *InitializerOverload,
+	overloaded bool,
+) {
+	compositeType, ok := constructorFunctionType.ReturnTypeAnnotation.Type.(*CompositeType)
+	if !ok || len(compositeType.InitializerOverloads) == 0 {
+		return nil, false
+	}
+
+	argumentLabels := invocationArgumentLabels(invocationExpression.Arguments)
+
+	for _, initializerOverload := range compositeType.InitializerOverloads {
+		if argumentLabelsEqual(initializerOverload.ArgumentLabels, argumentLabels) {
+			checker.Elaboration.InvocationExpressionOverloads[invocationExpression] =
+				initializerOverload.Identifier
+
+			return initializerOverload, true
+		}
+	}
+
+	checker.report(
+		&NoMatchingOverloadError{
+			Name:           compositeType.Identifier,
+			ArgumentLabels: argumentLabels,
+			Range:          ast.NewRangeFromPositioned(checker.memoryGauge, invocationExpression),
+		},
+	)
+
+	return nil, true
+}
+
 func (checker *Checker) checkInvocationArgumentLabels(
 	arguments []*ast.Argument,
 	argumentLabels []string,
@@ -537,10 +682,30 @@ func (checker *Checker) checkInvocationRequiredArgument(
 	if len(functionType.TypeParameters) == 0 {
 		// If the function doesn't use generic types, then the
 		// param types can be used to infer the types for arguments.
-		argumentType = checker.VisitExpression(argument.Expression, parameterType)
+
+		expectedType := parameterType
+
+		if _, ok := argument.Expression.(*ast.FixedPointExpression); ok &&
+			functionType.FixedPointLiteralArgumentType != nil {
+
+			expectedType = functionType.FixedPointLiteralArgumentType
+		}
+
+		argumentType = checker.VisitExpression(argument.Expression, expectedType)
 	} else {
-		// TODO: pass the expected type to support for parameters
-		argumentType = checker.VisitExpression(argument.Expression, nil)
+		// If the function is a user-defined generic function,
+		// and all type parameters are already bound, e.g. by explicit type arguments,
+		// the parameter type can be used to infer the type of the argument,
+		// e.g. the type of the empty array literal in `firstOf<String>([])`.
+		// The expected type is only used for inferring,
+		// a mismatch is reported by the compatibility check below
+
+		var expectedType Type
+		if allDeclaredTypeParametersBound(functionType.TypeParameters, typeParameters) {
+			expectedType = parameterType.Resolve(typeParameters)
+		}
+
+		argumentType = checker.VisitExpressionWithForceType(argument.Expression, expectedType, false)
 
 		// Try to unify the parameter type with the argument type.
 		// If unification fails, fall back to the parameter type for now.
@@ -571,6 +736,25 @@ func (checker *Checker) checkInvocationRequiredArgument(
 	return parameterType
 }
 
+// allDeclaredTypeParametersBound returns true if all given type parameters
+// are declared in the program, and have been assigned a type.
+//
+func allDeclaredTypeParametersBound(
+	typeParameters []*TypeParameter,
+	typeArguments *TypeParameterTypeOrderedMap,
+) bool {
+	for _, typeParameter := range typeParameters {
+		if !typeParameter.declared {
+			return false
+		}
+
+		if ty, ok := typeArguments.Get(typeParameter); !ok || ty == nil {
+			return false
+		}
+	}
+	return true
+}
+
 func (checker *Checker) checkInvocationArgumentCount(
 	argumentCount int,
 	parameterCount int,
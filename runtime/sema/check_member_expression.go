@@ -244,6 +244,10 @@ func (checker *Checker) visitMember(expression *ast.MemberExpression) (accessedT
 		}
 	} else {
 
+		if len(member.Overloads) > 0 {
+			member = checker.checkMemberOverload(expression, member)
+		}
+
 		if checker.positionInfoEnabled {
 			origins := checker.memberOrigins[accessedType]
 			origin := origins[identifier]
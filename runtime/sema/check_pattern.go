@@ -0,0 +1,553 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+)
+
+// patternBinding describes how the identifiers bound by a pattern are declared
+//
+type patternBinding struct {
+	declarationKind common.DeclarationKind
+	isConstant      bool
+	// variableDeclaration is the variable declaration which contains the pattern, if any
+	variableDeclaration *ast.VariableDeclaration
+}
+
+// declarePattern checks that the given pattern can destructure a value of the given type,
+// and declares a variable for each identifier bound by the pattern.
+//
+// Resources matched by the pattern are moved into the declared variables,
+// so the resource tracking for the variables applies as for any other variable declaration.
+// The pattern must therefore match every resource contained in the value.
+//
+func (checker *Checker) declarePattern(
+	binding patternBinding,
+	pattern ast.Pattern,
+	valueType Type,
+) {
+	checker.Elaboration.PatternTypes[pattern] = valueType
+
+	switch pattern := pattern.(type) {
+	case *ast.IdentifierPattern:
+		checker.declareIdentifierPattern(binding, pattern, valueType)
+
+	case *ast.ArrayPattern:
+		checker.declareArrayPattern(binding, pattern, valueType)
+
+	case *ast.DictionaryPattern:
+		checker.declareDictionaryPattern(binding, pattern, valueType)
+
+	case *ast.CompositePattern:
+		checker.declareCompositePattern(binding, pattern, valueType)
+
+	case *ast.OptionalPattern:
+		checker.declareOptionalPattern(binding, pattern, valueType)
+
+	case *ast.TypePattern:
+		checker.declareTypePattern(binding, pattern, valueType)
+
+	default:
+		panic(errors.NewUnreachableError())
+	}
+}
+
+func (checker *Checker) declareIdentifierPattern(
+	binding patternBinding,
+	pattern *ast.IdentifierPattern,
+	valueType Type,
+) {
+	if pattern.IsBlank() {
+		// The blank identifier does not bind the value,
+		// so a resource would be lost
+
+		if valueType.IsResourceType() {
+			checker.report(
+				&ResourceLossError{
+					Range: ast.NewRangeFromPositioned(checker.memoryGauge, pattern),
+				},
+			)
+		}
+
+		return
+	}
+
+	identifier := pattern.Identifier.Identifier
+
+	variable, err := checker.valueActivations.Declare(variableDeclaration{
+		identifier:               identifier,
+		ty:                       valueType,
+		access:                   ast.AccessNotSpecified,
+		kind:                     binding.declarationKind,
+		pos:                      pattern.Identifier.Pos,
+		isConstant:               binding.isConstant,
+		argumentLabels:           nil,
+		allowOuterScopeShadowing: true,
+	})
+	checker.report(err)
+
+	if checker.positionInfoEnabled {
+		checker.recordVariableDeclarationOccurrence(identifier, variable)
+		if binding.variableDeclaration != nil {
+			checker.recordVariableDeclarationRange(binding.variableDeclaration, identifier, valueType)
+		}
+	}
+}
+
+func (checker *Checker) declareArrayPattern(
+	binding patternBinding,
+	pattern *ast.ArrayPattern,
+	valueType Type,
+) {
+	var elementType Type = InvalidType
+
+	switch arrayType := valueType.(type) {
+	case *VariableSizedType:
+		// The number of elements can only be checked at run-time
+		elementType = arrayType.Type
+
+	case *ConstantSizedType:
+		elementType = arrayType.Type
+
+		elementCount := len(pattern.Elements)
+		if arrayType.Size != int64(elementCount) {
+			checker.report(
+				&PatternElementCountMismatchError{
+					ExpectedCount: int(arrayType.Size),
+					ActualCount:   elementCount,
+					Range:         ast.NewRangeFromPositioned(checker.memoryGauge, pattern),
+				},
+			)
+		}
+
+	default:
+		checker.reportInvalidPatternType(pattern, "array", valueType)
+	}
+
+	for _, element := range pattern.Elements {
+		checker.declarePattern(binding, element, elementType)
+	}
+}
+
+func (checker *Checker) declareDictionaryPattern(
+	binding patternBinding,
+	pattern *ast.DictionaryPattern,
+	valueType Type,
+) {
+	var keyType Type
+	var elementType Type = InvalidType
+
+	dictionaryType, ok := valueType.(*DictionaryType)
+	if ok {
+		keyType = dictionaryType.KeyType
+
+		// Like for an indexing expression, the dictionary might not contain the key
+		elementType = &OptionalType{
+			Type: dictionaryType.ValueType,
+		}
+
+		// Entries of the dictionary which are not matched by the pattern would be lost
+
+		if dictionaryType.IsResourceType() {
+			checker.report(
+				&InvalidResourceDestructuringError{
+					Type:  dictionaryType,
+					Range: ast.NewRangeFromPositioned(checker.memoryGauge, pattern),
+				},
+			)
+		}
+	} else {
+		checker.reportInvalidPatternType(pattern, "dictionary", valueType)
+	}
+
+	for _, entry := range pattern.Entries {
+		checker.VisitExpression(entry.Key, keyType)
+		checker.declarePattern(binding, entry.Value, elementType)
+	}
+}
+
+func (checker *Checker) declareCompositePattern(
+	binding patternBinding,
+	pattern *ast.CompositePattern,
+	valueType Type,
+) {
+	compositeType, ok := valueType.(*CompositeType)
+	if ok {
+		switch compositeType.Kind {
+		case common.CompositeKindStructure,
+			common.CompositeKindResource:
+
+			break
+
+		default:
+			ok = false
+		}
+	}
+
+	if !ok {
+		checker.reportInvalidPatternType(pattern, "composite", valueType)
+
+		for _, element := range pattern.Elements {
+			checker.declarePattern(binding, element.Pattern, InvalidType)
+		}
+		return
+	}
+
+	isResource := compositeType.Kind == common.CompositeKindResource
+
+	// Destructuring a resource consumes it without calling its destructor,
+	// and gives access to all its fields, just like its destructor.
+	// Only allow it where the resource could also be created.
+
+	if isResource && !checker.isInResourceDeclaringContainer(compositeType) {
+
+		checker.report(
+			&InvalidResourceDestructuringError{
+				Type:  compositeType,
+				Range: ast.NewRangeFromPositioned(checker.memoryGauge, pattern),
+			},
+		)
+	}
+
+	matchedFields := map[string]struct{}{}
+
+	if pattern.IsLabeled() {
+		for _, element := range pattern.Elements {
+			label := element.Label
+			fieldName := label.Identifier
+
+			if _, ok := matchedFields[fieldName]; ok {
+				checker.report(
+					&DuplicatePatternFieldError{
+						Name:  fieldName,
+						Range: ast.NewRangeFromPositioned(checker.memoryGauge, label),
+					},
+				)
+			}
+			matchedFields[fieldName] = struct{}{}
+
+			fieldType := checker.compositePatternFieldType(compositeType, fieldName, label)
+			checker.declarePattern(binding, element.Pattern, fieldType)
+		}
+	} else {
+		// Elements without labels match the fields in the order they are declared
+
+		fieldNames := compositeType.DeclaredFields()
+		fieldCount := len(fieldNames)
+		elementCount := len(pattern.Elements)
+
+		if elementCount != fieldCount {
+			checker.report(
+				&PatternElementCountMismatchError{
+					ExpectedCount: fieldCount,
+					ActualCount:   elementCount,
+					Range:         ast.NewRangeFromPositioned(checker.memoryGauge, pattern),
+				},
+			)
+		}
+
+		for i, element := range pattern.Elements {
+			var fieldType Type = InvalidType
+
+			if i < fieldCount {
+				fieldName := fieldNames[i]
+				matchedFields[fieldName] = struct{}{}
+				fieldType = checker.compositePatternFieldType(compositeType, fieldName, element.Pattern)
+			}
+
+			checker.declarePattern(binding, element.Pattern, fieldType)
+		}
+	}
+
+	// Destructuring a resource does not call its destructor.
+	// If the resource declares a destructor, the pattern must match all fields,
+	// so skipping the destructor is explicit, and all fields are accounted for.
+	// Patterns without labels are already checked to match all fields
+
+	if isResource && compositeType.HasDestructor && pattern.IsLabeled() {
+		var missingFields []string
+
+		for _, fieldName := range compositeType.DeclaredFields() {
+			if _, ok := matchedFields[fieldName]; !ok {
+				missingFields = append(missingFields, fieldName)
+			}
+		}
+
+		if len(missingFields) > 0 {
+			checker.report(
+				&NonExhaustiveResourceDestructuringError{
+					Type:          compositeType,
+					MissingFields: missingFields,
+					Range:         ast.NewRangeFromPositioned(checker.memoryGauge, pattern),
+				},
+			)
+		}
+	}
+
+	// Resource fields which are not matched by the pattern would be lost
+
+	if isResource {
+		for _, fieldName := range compositeType.Fields {
+			if _, ok := matchedFields[fieldName]; ok {
+				continue
+			}
+
+			member, ok := compositeType.Members.Get(fieldName)
+			if !ok || !member.TypeAnnotation.Type.IsResourceType() {
+				continue
+			}
+
+			checker.report(
+				&ResourceLossError{
+					Range: ast.NewRangeFromPositioned(checker.memoryGauge, pattern),
+				},
+			)
+		}
+	}
+}
+
+func (checker *Checker) declareOptionalPattern(
+	binding patternBinding,
+	pattern *ast.OptionalPattern,
+	valueType Type,
+) {
+	var innerType Type = InvalidType
+
+	optionalType, ok := valueType.(*OptionalType)
+	if ok {
+		innerType = optionalType.Type
+	} else {
+		checker.reportInvalidPatternType(pattern, "optional", valueType)
+	}
+
+	checker.declarePattern(binding, pattern.Pattern, innerType)
+}
+
+func (checker *Checker) declareTypePattern(
+	binding patternBinding,
+	pattern *ast.TypePattern,
+	valueType Type,
+) {
+	// The target type was already converted, see checkTypePatternTargetTypes
+
+	targetType, ok := checker.Elaboration.TypePatternTargetTypes[pattern]
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	// Like a failable cast, the pattern must be able to match at run-time
+
+	if !valueType.IsInvalidType() &&
+		!targetType.IsInvalidType() {
+
+		if valueType.IsResourceType() != targetType.IsResourceType() {
+			if valueType.IsResourceType() {
+				checker.report(
+					&AlwaysFailingNonResourceCastingTypeError{
+						ValueType:  valueType,
+						TargetType: targetType,
+						Range:      ast.NewRangeFromPositioned(checker.memoryGauge, pattern.TypeAnnotation),
+					},
+				)
+			} else {
+				checker.report(
+					&AlwaysFailingResourceCastingTypeError{
+						ValueType:  valueType,
+						TargetType: targetType,
+						Range:      ast.NewRangeFromPositioned(checker.memoryGauge, pattern.TypeAnnotation),
+					},
+				)
+			}
+		}
+
+		if !FailableCastCanSucceed(valueType, targetType) {
+			checker.report(
+				&TypeMismatchError{
+					ActualType:   valueType,
+					ExpectedType: targetType,
+					Range:        ast.NewRangeFromPositioned(checker.memoryGauge, pattern),
+				},
+			)
+		}
+	}
+
+	checker.declarePattern(binding, pattern.Pattern, targetType)
+}
+
+// isIrrefutablePattern returns true if the given pattern
+// matches every value of the given type
+//
+func (checker *Checker) isIrrefutablePattern(pattern ast.Pattern, valueType Type) bool {
+	switch pattern := pattern.(type) {
+	case *ast.IdentifierPattern,
+		*ast.DictionaryPattern:
+
+		// Dictionary patterns match optionals,
+		// so they match dictionaries which do not contain the keys
+
+		return true
+
+	case *ast.ArrayPattern:
+		// The number of elements of variable-sized arrays is only known at run-time
+
+		arrayType, ok := valueType.(*ConstantSizedType)
+		if !ok || arrayType.Size != int64(len(pattern.Elements)) {
+			return false
+		}
+
+		for _, element := range pattern.Elements {
+			if !checker.isIrrefutablePattern(element, arrayType.Type) {
+				return false
+			}
+		}
+
+		return true
+
+	case *ast.CompositePattern:
+		compositeType, ok := valueType.(*CompositeType)
+		if !ok {
+			return false
+		}
+
+		var fieldNames []string
+		if !pattern.IsLabeled() {
+			fieldNames = compositeType.DeclaredFields()
+		}
+
+		for i, element := range pattern.Elements {
+			var fieldName string
+			if element.Label != nil {
+				fieldName = element.Label.Identifier
+			} else if i < len(fieldNames) {
+				fieldName = fieldNames[i]
+			} else {
+				return false
+			}
+
+			member, ok := compositeType.Members.Get(fieldName)
+			if !ok || !checker.isIrrefutablePattern(element.Pattern, member.TypeAnnotation.Type) {
+				return false
+			}
+		}
+
+		return true
+
+	case *ast.OptionalPattern:
+		return false
+
+	case *ast.TypePattern:
+		targetType, ok := checker.Elaboration.TypePatternTargetTypes[pattern]
+		return ok &&
+			IsSubType(valueType, targetType) &&
+			checker.isIrrefutablePattern(pattern.Pattern, targetType)
+
+	default:
+		panic(errors.NewUnreachableError())
+	}
+}
+
+// checkTypePatternTargetTypes converts and checks the target types of the type patterns
+// in the given pattern of a switch case.
+//
+// The target types are needed to determine if the case is exhaustive,
+// before the pattern itself is declared in the scope of the case
+//
+func (checker *Checker) checkTypePatternTargetTypes(pattern ast.Pattern) {
+	switch pattern := pattern.(type) {
+	case *ast.OptionalPattern:
+		checker.checkTypePatternTargetTypes(pattern.Pattern)
+
+	case *ast.TypePattern:
+		targetTypeAnnotation := checker.ConvertTypeAnnotation(pattern.TypeAnnotation)
+		checker.checkTypeAnnotation(targetTypeAnnotation, pattern.TypeAnnotation)
+
+		checker.Elaboration.TypePatternTargetTypes[pattern] = targetTypeAnnotation.Type
+
+		checker.checkTypePatternTargetTypes(pattern.Pattern)
+
+	case *ast.ArrayPattern:
+		for _, element := range pattern.Elements {
+			checker.checkTypePatternTargetTypes(element)
+		}
+
+	case *ast.DictionaryPattern:
+		for _, entry := range pattern.Entries {
+			checker.checkTypePatternTargetTypes(entry.Value)
+		}
+
+	case *ast.CompositePattern:
+		for _, element := range pattern.Elements {
+			checker.checkTypePatternTargetTypes(element.Pattern)
+		}
+	}
+}
+
+// compositePatternFieldType returns the type of the field of the given composite type,
+// and reports an error if the field does not exist or is not accessible
+//
+func (checker *Checker) compositePatternFieldType(
+	compositeType *CompositeType,
+	fieldName string,
+	positioned ast.HasPosition,
+) Type {
+	member, ok := compositeType.Members.Get(fieldName)
+	if !ok ||
+		member.DeclarationKind != common.DeclarationKindField ||
+		member.Predeclared {
+
+		checker.report(
+			&NotDeclaredMemberError{
+				Name:  fieldName,
+				Type:  compositeType,
+				Range: ast.NewRangeFromPositioned(checker.memoryGauge, positioned),
+			},
+		)
+		return InvalidType
+	}
+
+	if !checker.isReadableMember(member) {
+		checker.report(
+			&InvalidAccessError{
+				Name:              fieldName,
+				RestrictingAccess: member.Access,
+				DeclarationKind:   member.DeclarationKind,
+				Range:             ast.NewRangeFromPositioned(checker.memoryGauge, positioned),
+			},
+		)
+	}
+
+	return member.TypeAnnotation.Type
+}
+
+func (checker *Checker) reportInvalidPatternType(pattern ast.Pattern, patternKind string, valueType Type) {
+	if valueType.IsInvalidType() {
+		return
+	}
+
+	checker.report(
+		&InvalidPatternTypeError{
+			PatternKind: patternKind,
+			Type:        valueType,
+			Range:       ast.NewRangeFromPositioned(checker.memoryGauge, pattern),
+		},
+	)
+}
@@ -20,6 +20,7 @@ package sema
 
 import (
 	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
 )
 
 func (checker *Checker) VisitSwitchStatement(statement *ast.SwitchStatement) ast.Repr {
@@ -28,9 +29,31 @@ func (checker *Checker) VisitSwitchStatement(statement *ast.SwitchStatement) ast
 
 	testTypeIsValid := !testType.IsInvalidType()
 
-	// The test expression must be equatable
+	// A resource is moved into the case which matches it
+
+	isResourceSwitch := testTypeIsValid && testType.IsResourceType()
+	if isResourceSwitch {
+		checker.checkResourceMoveOperation(statement.Expression, testType)
+	}
+
+	// The test expression must be equatable,
+	// unless all cases match patterns
+
+	hasExpressionCase := false
+	hasPatternCase := false
+	for _, switchCase := range statement.Cases {
+		if switchCase.Expression != nil {
+			hasExpressionCase = true
+		}
+		if switchCase.Pattern != nil {
+			hasPatternCase = true
+		}
+	}
+
+	if testTypeIsValid &&
+		(hasExpressionCase || !hasPatternCase) &&
+		!testType.IsEquatable() {
 
-	if testTypeIsValid && !testType.IsEquatable() {
 		checker.report(
 			&NotEquatableTypeError{
 				Type:  testType,
@@ -46,13 +69,27 @@ func (checker *Checker) VisitSwitchStatement(statement *ast.SwitchStatement) ast
 	for i, switchCase := range statement.Cases {
 		// Only one default case is allowed, as the last case
 		defaultAllowed := i == caseCount-1
-		checker.visitSwitchCase(switchCase, defaultAllowed, testType, testTypeIsValid)
+		checker.visitSwitchCase(switchCase, defaultAllowed, testType, testTypeIsValid, isResourceSwitch)
 	}
 
+	// If the cases are exhaustive, one of them is definitely taken
+
+	exhaustive := checker.isExhaustiveSwitch(statement.Cases, testType)
+
 	checker.functionActivations.WithSwitch(func() {
-		checker.checkSwitchCasesStatements(statement.Cases)
+		checker.checkSwitchCasesStatements(statement.Cases, testType, exhaustive)
 	})
 
+	// If no case matches a resource, it would be lost
+
+	if isResourceSwitch && !exhaustive {
+		checker.report(
+			&ResourceLossError{
+				Range: ast.NewRangeFromPositioned(checker.memoryGauge, statement.Expression),
+			},
+		)
+	}
+
 	return nil
 }
 
@@ -61,12 +98,11 @@ func (checker *Checker) visitSwitchCase(
 	defaultAllowed bool,
 	testType Type,
 	testTypeIsValid bool,
+	isResourceSwitch bool,
 ) {
-	caseExpression := switchCase.Expression
-
-	// If the case has no expression, it is a default case
+	// If the case has no expression and no pattern, it is a default case
 
-	if caseExpression == nil {
+	if switchCase.IsDefault() {
 
 		// Only one default case is allowed, as the last case
 		if !defaultAllowed {
@@ -76,11 +112,122 @@ func (checker *Checker) visitSwitchCase(
 				},
 			)
 		}
+
+		// The default case does not bind the resource
+		if isResourceSwitch {
+			checker.report(
+				&ResourceLossError{
+					Range: switchCase.Range,
+				},
+			)
+		}
+
+		return
+	}
+
+	if switchCase.Pattern != nil {
+		checker.checkTypePatternTargetTypes(switchCase.Pattern)
 	} else {
-		checker.checkSwitchCaseExpression(caseExpression, testType, testTypeIsValid)
+		checker.checkSwitchCaseExpression(switchCase.Expression, testType, testTypeIsValid)
+	}
+
+	// The guard of a case is only evaluated after the pattern matched,
+	// and the resource was moved into the case
+
+	if switchCase.Guard != nil && isResourceSwitch {
+		checker.report(
+			&InvalidResourceSwitchCaseGuardError{
+				Range: ast.NewRangeFromPositioned(checker.memoryGauge, switchCase.Guard),
+			},
+		)
 	}
 }
 
+// isExhaustiveSwitch returns true if one of the given cases definitely matches
+// a value of the given test type, i.e. if there is a default case,
+// a case with an irrefutable pattern and without a guard,
+// or if the test type is an enum and there is a case without a guard for each enum case
+//
+func (checker *Checker) isExhaustiveSwitch(cases []*ast.SwitchCase, testType Type) bool {
+
+	var enumType *CompositeType
+	if compositeType, ok := testType.(*CompositeType); ok &&
+		compositeType.Kind == common.CompositeKindEnum {
+
+		enumType = compositeType
+	}
+
+	coveredEnumCases := map[string]struct{}{}
+
+	for _, switchCase := range cases {
+		if switchCase.IsDefault() {
+			return true
+		}
+
+		if switchCase.Guard != nil {
+			continue
+		}
+
+		if switchCase.Pattern != nil {
+			if checker.isIrrefutablePattern(switchCase.Pattern, testType) {
+				return true
+			}
+			continue
+		}
+
+		if enumType == nil {
+			continue
+		}
+
+		enumCase, ok := checker.switchCaseEnumCase(switchCase.Expression, enumType)
+		if ok {
+			coveredEnumCases[enumCase] = struct{}{}
+		}
+	}
+
+	if enumType == nil || len(enumType.EnumCases) == 0 {
+		return false
+	}
+
+	for _, enumCase := range enumType.EnumCases {
+		if _, ok := coveredEnumCases[enumCase]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// switchCaseEnumCase returns the name of the enum case
+// which the given case expression refers to, if any, e.g. `a` for `E.a`
+//
+func (checker *Checker) switchCaseEnumCase(expression ast.Expression, enumType *CompositeType) (string, bool) {
+	memberExpression, ok := expression.(*ast.MemberExpression)
+	if !ok {
+		return "", false
+	}
+
+	memberInfo, ok := checker.Elaboration.MemberExpressionMemberInfos[memberExpression]
+	if !ok || memberInfo.Member == nil {
+		return "", false
+	}
+
+	// Enum cases are the fields of the enum's constructor function
+
+	member := memberInfo.Member
+
+	constructorType, ok := member.ContainerType.(*FunctionType)
+	if !ok ||
+		!constructorType.IsConstructor ||
+		member.DeclarationKind != common.DeclarationKindField ||
+		member.TypeAnnotation.Type != enumType {
+
+		return "", false
+	}
+
+	return member.Identifier.Identifier, true
+}
+
 func (checker *Checker) checkSwitchCaseExpression(
 	caseExpression ast.Expression,
 	testType Type,
@@ -125,37 +272,42 @@ func (checker *Checker) checkSwitchCaseExpression(
 	}
 }
 
-func (checker *Checker) checkSwitchCasesStatements(cases []*ast.SwitchCase) {
+func (checker *Checker) checkSwitchCasesStatements(
+	cases []*ast.SwitchCase,
+	testType Type,
+	exhaustive bool,
+) {
 	caseCount := len(cases)
 	if caseCount == 0 {
 		return
 	}
 
 	// NOTE: always check blocks as if they're only *potentially* evaluated.
-	// However, the default case's block must be checked directly as the "else",
-	// because if a default case exists, the whole switch statement
+	// However, the last case's block must be checked directly as the "else",
+	// if it is the default case, or if the cases are exhaustive,
+	// because then the whole switch statement
 	// will definitely have one case which will be taken.
 
 	switchCase := cases[0]
 
-	if caseCount == 1 && switchCase.Expression == nil {
-		checker.checkSwitchCaseStatements(switchCase)
+	if caseCount == 1 && (switchCase.IsDefault() || exhaustive) {
+		checker.checkSwitchCaseStatements(switchCase, testType)
 		return
 	}
 
 	_, _ = checker.checkConditionalBranches(
 		func() Type {
-			checker.checkSwitchCaseStatements(switchCase)
+			checker.checkSwitchCaseStatements(switchCase, testType)
 			return nil
 		},
 		func() Type {
-			checker.checkSwitchCasesStatements(cases[1:])
+			checker.checkSwitchCasesStatements(cases[1:], testType, exhaustive)
 			return nil
 		},
 	)
 }
 
-func (checker *Checker) checkSwitchCaseStatements(switchCase *ast.SwitchCase) {
+func (checker *Checker) checkSwitchCaseStatements(switchCase *ast.SwitchCase, testType Type) {
 
 	// Switch-cases must have at least one statement.
 	// This avoids cases that look like implicit fallthrough is assumed.
@@ -169,6 +321,28 @@ func (checker *Checker) checkSwitchCaseStatements(switchCase *ast.SwitchCase) {
 		return
 	}
 
+	// The constants bound by the pattern are only available in the guard and the statements
+
+	if switchCase.Pattern != nil || switchCase.Guard != nil {
+		checker.enterValueScope()
+		defer checker.leaveValueScope(switchCase.EndPosition, true)
+
+		if switchCase.Pattern != nil {
+			checker.declarePattern(
+				patternBinding{
+					declarationKind: common.DeclarationKindConstant,
+					isConstant:      true,
+				},
+				switchCase.Pattern,
+				testType,
+			)
+		}
+
+		if switchCase.Guard != nil {
+			checker.VisitExpression(switchCase.Guard, BoolType)
+		}
+	}
+
 	// NOTE: the block ensures that the statements are checked in a new scope
 
 	block := ast.NewBlock(
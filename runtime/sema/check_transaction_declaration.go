@@ -85,6 +85,7 @@ func (checker *Checker) VisitTransactionDeclaration(declaration *ast.Transaction
 func (checker *Checker) checkTransactionParameters(declaration *ast.TransactionDeclaration, parameters []*Parameter) {
 	checker.checkArgumentLabels(declaration.ParameterList)
 	checker.checkParameters(declaration.ParameterList, parameters)
+	checker.reportUnsupportedDefaultArguments(declaration.ParameterList, common.DeclarationKindTransaction, false)
 	checker.declareParameters(declaration.ParameterList, parameters)
 
 	// Check parameter types
@@ -196,6 +197,12 @@ func (checker *Checker) visitTransactionPrepareFunction(
 		prepareFunction.FunctionDeclaration.ParameterList,
 		prepareFunctionType.Parameters,
 	)
+
+	checker.reportUnsupportedDefaultArguments(
+		prepareFunction.FunctionDeclaration.ParameterList,
+		common.DeclarationKindPrepare,
+		false,
+	)
 }
 
 // checkTransactionPrepareFunctionParameters checks that the parameters are each of type Account.
@@ -0,0 +1,71 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+)
+
+func (checker *Checker) VisitTryStatement(statement *ast.TryStatement) ast.Repr {
+
+	// If the try block fails, all its effects are reverted,
+	// and the catch block is executed instead.
+	// Therefore, the blocks are checked like the branches of a conditional:
+	// Either the try block was fully evaluated, or only the catch block was.
+
+	checker.checkConditionalBranches(
+		func() Type {
+			statement.Block.Accept(checker)
+			return nil
+		},
+		func() Type {
+			checker.enterValueScope()
+			defer checker.leaveValueScope(statement.CatchBlock.EndPosition, true)
+
+			if statement.ErrorIdentifier != nil {
+				checker.declareTryErrorVariable(*statement.ErrorIdentifier)
+			}
+
+			statement.CatchBlock.Accept(checker)
+			return nil
+		},
+	)
+
+	return nil
+}
+
+// declareTryErrorVariable declares the constant which is bound
+// to the error message of the failed try block in the catch block
+//
+func (checker *Checker) declareTryErrorVariable(identifier ast.Identifier) {
+	variable, err := checker.valueActivations.Declare(variableDeclaration{
+		identifier:               identifier.Identifier,
+		ty:                       StringType,
+		kind:                     common.DeclarationKindConstant,
+		pos:                      identifier.Pos,
+		isConstant:               true,
+		argumentLabels:           nil,
+		allowOuterScopeShadowing: false,
+	})
+	checker.report(err)
+	if checker.positionInfoEnabled {
+		checker.recordVariableDeclarationOccurrence(identifier.Identifier, variable)
+	}
+}
@@ -0,0 +1,283 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+)
+
+// VisitTypeAliasDeclaration checks the given type alias declaration.
+//
+// NOTE: Type aliases are fully resolved when they are declared,
+// so there is nothing left to check here.
+// See `declareTypeAliasDeclarations` for the declaration of type aliases.
+//
+func (checker *Checker) VisitTypeAliasDeclaration(_ *ast.TypeAliasDeclaration) ast.Repr {
+	return nil
+}
+
+// declareTypeAliasDeclarations declares the given type alias declarations,
+// which are all declared in the same scope.
+//
+// Type aliases may refer to type aliases which are declared after them in the same scope,
+// so the type aliases are declared in dependency order:
+// A type alias is declared after all type aliases its aliased type refers to.
+// Cyclic type aliases are reported, and declared as the invalid type.
+//
+func (checker *Checker) declareTypeAliasDeclarations(declarations []*ast.TypeAliasDeclaration) {
+
+	declarationsByName := make(map[string]*ast.TypeAliasDeclaration, len(declarations))
+
+	for _, declaration := range declarations {
+		name := declaration.Identifier.Identifier
+
+		// NOTE: redeclarations are reported when the type alias is declared
+		if _, ok := declarationsByName[name]; !ok {
+			declarationsByName[name] = declaration
+		}
+	}
+
+	declared := make(map[*ast.TypeAliasDeclaration]struct{}, len(declarations))
+	declaring := map[*ast.TypeAliasDeclaration]struct{}{}
+
+	var declare func(declaration *ast.TypeAliasDeclaration)
+	declare = func(declaration *ast.TypeAliasDeclaration) {
+
+		if _, ok := declared[declaration]; ok {
+			return
+		}
+
+		if _, ok := declaring[declaration]; ok {
+			checker.report(
+				&CyclicTypeAliasError{
+					Name:  declaration.Identifier.Identifier,
+					Range: ast.NewRangeFromPositioned(checker.memoryGauge, declaration.Identifier),
+				},
+			)
+
+			declared[declaration] = struct{}{}
+			checker.declareTypeAlias(declaration, InvalidType)
+			return
+		}
+
+		declaring[declaration] = struct{}{}
+
+		forEachNominalTypeName(declaration.Type, func(name string) {
+			if dependency, ok := declarationsByName[name]; ok {
+				declare(dependency)
+			}
+		})
+
+		delete(declaring, declaration)
+
+		// The type alias might have been declared already,
+		// if it is part of a cycle
+		if _, ok := declared[declaration]; ok {
+			return
+		}
+
+		declared[declaration] = struct{}{}
+		checker.declareTypeAlias(declaration, checker.ConvertType(declaration.Type))
+	}
+
+	for _, declaration := range declarations {
+		declare(declaration)
+	}
+}
+
+// forEachNominalTypeName calls the given function for the name of each nominal type
+// which occurs in the given type.
+// For qualified nominal types, only the name of the outermost type is passed.
+//
+func forEachNominalTypeName(ty ast.Type, f func(name string)) {
+	switch ty := ty.(type) {
+	case *ast.NominalType:
+		f(ty.Identifier.Identifier)
+
+	case *ast.OptionalType:
+		forEachNominalTypeName(ty.Type, f)
+
+	case *ast.VariableSizedType:
+		forEachNominalTypeName(ty.Type, f)
+
+	case *ast.ConstantSizedType:
+		forEachNominalTypeName(ty.Type, f)
+
+	case *ast.DictionaryType:
+		forEachNominalTypeName(ty.KeyType, f)
+		forEachNominalTypeName(ty.ValueType, f)
+
+	case *ast.SetType:
+		forEachNominalTypeName(ty.ElementType, f)
+
+	case *ast.FunctionType:
+		for _, parameterTypeAnnotation := range ty.ParameterTypeAnnotations {
+			forEachNominalTypeName(parameterTypeAnnotation.Type, f)
+		}
+		if ty.ReturnTypeAnnotation != nil {
+			forEachNominalTypeName(ty.ReturnTypeAnnotation.Type, f)
+		}
+
+	case *ast.ReferenceType:
+		forEachNominalTypeName(ty.Type, f)
+
+	case *ast.RestrictedType:
+		if ty.Type != nil {
+			forEachNominalTypeName(ty.Type, f)
+		}
+		for _, restriction := range ty.Restrictions {
+			forEachNominalTypeName(restriction, f)
+		}
+
+	case *ast.InstantiationType:
+		forEachNominalTypeName(ty.Type, f)
+		for _, typeArgument := range ty.TypeArguments {
+			forEachNominalTypeName(typeArgument.Type, f)
+		}
+	}
+}
+
+// declareTypeAlias records the given aliased type of the given type alias declaration
+// in the elaboration, and declares the type alias in the current scope.
+//
+// Type aliases are transparent: the type alias is declared as the aliased type itself,
+// so any use of the type alias is equivalent to a use of the aliased type.
+//
+func (checker *Checker) declareTypeAlias(declaration *ast.TypeAliasDeclaration, aliasedType Type) {
+
+	checker.checkDeclarationAccessModifier(
+		declaration.Access,
+		declaration.DeclarationKind(),
+		declaration.StartPos,
+		true,
+	)
+
+	checker.Elaboration.TypeAliasDeclarationTypes[declaration] = aliasedType
+
+	variable, err := checker.typeActivations.DeclareType(typeDeclaration{
+		identifier:               declaration.Identifier,
+		ty:                       aliasedType,
+		declarationKind:          declaration.DeclarationKind(),
+		access:                   declaration.Access,
+		docString:                declaration.DocString,
+		allowOuterScopeShadowing: false,
+	})
+	checker.report(err)
+
+	if checker.positionInfoEnabled {
+		checker.recordVariableDeclarationOccurrence(
+			declaration.Identifier.Identifier,
+			variable,
+		)
+	}
+}
+
+// declareCompositeTypeAliases declares the type aliases nested in the given composite declaration,
+// and recursively for all nested declarations.
+//
+// NOTE: This function assumes that the composite type and the nested declarations' types
+// were previously declared using `declareCompositeType`.
+//
+func (checker *Checker) declareCompositeTypeAliases(declaration *ast.CompositeDeclaration) {
+
+	compositeType := checker.Elaboration.CompositeDeclarationTypes[declaration]
+	if compositeType == nil {
+		panic(errors.NewUnreachableError())
+	}
+
+	checker.typeActivations.Enter()
+	defer checker.typeActivations.Leave(declaration.EndPosition)
+
+	checker.declareCompositeNestedTypes(declaration, ContainerKindComposite, false)
+
+	checker.declareMembersTypeAliases(declaration.Members, compositeType.typeAliases)
+}
+
+// declareInterfaceTypeAliases declares the type aliases nested in the given interface declaration,
+// and recursively for all nested declarations.
+//
+// NOTE: This function assumes that the interface type and the nested declarations' types
+// were previously declared using `declareInterfaceType`.
+//
+func (checker *Checker) declareInterfaceTypeAliases(declaration *ast.InterfaceDeclaration) {
+
+	interfaceType := checker.Elaboration.InterfaceDeclarationTypes[declaration]
+	if interfaceType == nil {
+		panic(errors.NewUnreachableError())
+	}
+
+	checker.typeActivations.Enter()
+	defer checker.typeActivations.Leave(declaration.EndPosition)
+
+	checker.declareInterfaceNestedTypes(declaration)
+
+	checker.declareMembersTypeAliases(declaration.Members, interfaceType.typeAliases)
+}
+
+func (checker *Checker) declareMembersTypeAliases(members *ast.Members, typeAliases *StringTypeOrderedMap) {
+
+	typeAliasDeclarations := members.TypeAliases()
+
+	checker.declareTypeAliasDeclarations(typeAliasDeclarations)
+
+	for _, typeAliasDeclaration := range typeAliasDeclarations {
+		aliasedType := checker.Elaboration.TypeAliasDeclarationTypes[typeAliasDeclaration]
+		typeAliases.Set(typeAliasDeclaration.Identifier.Identifier, aliasedType)
+	}
+
+	for _, nestedInterface := range members.Interfaces() {
+		checker.declareInterfaceTypeAliases(nestedInterface)
+	}
+
+	for _, nestedComposite := range members.Composites() {
+		checker.declareCompositeTypeAliases(nestedComposite)
+	}
+}
+
+// declareNestedTypeAliases declares the previously resolved type aliases
+// of the given members in the current scope.
+//
+// It is used when declaring the members of a composite or interface,
+// and when checking the composite or interface declaration.
+//
+func (checker *Checker) declareNestedTypeAliases(members *ast.Members) {
+
+	for _, typeAliasDeclaration := range members.TypeAliases() {
+
+		aliasedType, ok := checker.Elaboration.TypeAliasDeclarationTypes[typeAliasDeclaration]
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		// NOTE: We allow the shadowing of types here, because the type alias was already previously
+		// declared without allowing shadowing before.
+		// Any redeclaration error was already reported at that point, so it is ignored here
+
+		_, _ = checker.typeActivations.DeclareType(typeDeclaration{
+			identifier:               typeAliasDeclaration.Identifier,
+			ty:                       aliasedType,
+			declarationKind:          common.DeclarationKindTypeAlias,
+			access:                   typeAliasDeclaration.Access,
+			docString:                typeAliasDeclaration.DocString,
+			allowOuterScopeShadowing: true,
+		})
+	}
+}
@@ -37,6 +37,18 @@ func (checker *Checker) visitVariableDeclaration(declaration *ast.VariableDeclar
 		declaration.IsConstant,
 	)
 
+	// Destructuring is only supported for local variable declarations
+
+	if declaration.Pattern != nil &&
+		(isOptionalBinding || !checker.functionActivations.IsLocal()) {
+
+		checker.report(
+			&InvalidDestructuringDeclarationError{
+				Range: ast.NewRangeFromPositioned(checker.memoryGauge, declaration.Pattern),
+			},
+		)
+	}
+
 	// Determine the type of the initial value of the variable declaration
 	// and save it in the elaboration
 
@@ -173,7 +185,21 @@ func (checker *Checker) visitVariableDeclaration(declaration *ast.VariableDeclar
 		}
 	}
 
-	// Finally, declare the variable in the current value activation
+	// Finally, declare the variable in the current value activation,
+	// or the variables bound by the pattern
+
+	if declaration.Pattern != nil {
+		checker.declarePattern(
+			patternBinding{
+				declarationKind:     declaration.DeclarationKind(),
+				isConstant:          declaration.IsConstant,
+				variableDeclaration: declaration,
+			},
+			declaration.Pattern,
+			declarationType,
+		)
+		return
+	}
 
 	identifier := declaration.Identifier.Identifier
 
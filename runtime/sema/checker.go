@@ -107,6 +107,7 @@ type Checker struct {
 	allowSelfResourceFieldInvalidation bool
 	Elaboration                        *Elaboration
 	currentMemberExpression            *ast.MemberExpression
+	currentInvocationExpression        *ast.InvocationExpression
 	validTopLevelDeclarationsHandler   ValidTopLevelDeclarationsHandlerFunc
 	beforeExtractor                    *BeforeExtractor
 	locationHandler                    LocationHandlerFunc
@@ -509,6 +510,32 @@ func (checker *Checker) VisitProgram(program *ast.Program) ast.Repr {
 		VisitThisAndNested(compositeType, registerInElaboration)
 	}
 
+	// Declare interfaces' conformances.
+	// NOTE: after all interface and composite types are declared,
+	// so interfaces may conform to interfaces declared after them
+
+	for _, declaration := range program.InterfaceDeclarations() {
+		checker.declareInterfaceConformances(declaration)
+	}
+
+	for _, declaration := range program.CompositeDeclarations() {
+		checker.declareCompositeInterfaceConformances(declaration)
+	}
+
+	// Declare type aliases.
+	// NOTE: after all interface and composite types are declared,
+	// so type aliases may refer to them
+
+	checker.declareTypeAliasDeclarations(program.TypeAliasDeclarations())
+
+	for _, declaration := range program.InterfaceDeclarations() {
+		checker.declareInterfaceTypeAliases(declaration)
+	}
+
+	for _, declaration := range program.CompositeDeclarations() {
+		checker.declareCompositeTypeAliases(declaration)
+	}
+
 	// Declare interfaces' and composites' members
 
 	for _, declaration := range program.InterfaceDeclarations() {
@@ -595,7 +622,7 @@ func (checker *Checker) checkTopLevelDeclarationValidity(declarations []ast.Decl
 }
 
 func (checker *Checker) declareGlobalFunctionDeclaration(declaration *ast.FunctionDeclaration) {
-	functionType := checker.functionType(declaration.ParameterList, declaration.ReturnTypeAnnotation)
+	functionType := checker.functionDeclarationType(declaration)
 	checker.Elaboration.FunctionDeclarationFunctionTypes[declaration] = functionType
 	checker.declareFunctionDeclaration(declaration, functionType)
 }
@@ -964,6 +991,9 @@ func (checker *Checker) ConvertType(t ast.Type) Type {
 	case *ast.RestrictedType:
 		return checker.convertRestrictedType(t)
 
+	case *ast.SetType:
+		return checker.convertSetType(t)
+
 	case *ast.InstantiationType:
 		return checker.convertInstantiationType(t)
 
@@ -983,7 +1013,13 @@ func CheckRestrictedType(
 ) Type {
 	restrictionRanges := make(map[*InterfaceType]func(*ast.RestrictedType) ast.Range, len(restrictions))
 	restrictionsCompositeKind := common.CompositeKindUnknown
-	memberSet := map[string]*InterfaceType{}
+
+	type restrictionMember struct {
+		restriction *InterfaceType
+		member      *Member
+	}
+
+	memberSet := map[string]restrictionMember{}
 
 	for i, restrictionInterfaceType := range restrictions {
 		restrictionCompositeKind := restrictionInterfaceType.CompositeKind
@@ -1020,22 +1056,22 @@ func CheckRestrictedType(
 
 		// The restrictions may not have clashing members
 
-		// TODO: also include interface conformances' members
-		//   once interfaces can have conformances
-
-		restrictionInterfaceType.Members.Foreach(func(name string, member *Member) {
-			if previousDeclaringInterfaceType, ok := memberSet[name]; ok {
+		// NOTE: also include the members inherited from the interface's conformances
 
-				// If there is an overlap in members, ensure the members have the same type
+		restrictionInterfaceType.ForEachMember(func(name string, member *Member) {
+			if previous, ok := memberSet[name]; ok {
 
-				memberType := member.TypeAnnotation.Type
+				// Members of the same restriction were already checked
+				// when the interface was declared
 
-				prevMemberType, ok := previousDeclaringInterfaceType.Members.Get(name)
-				if !ok {
-					panic(errors.NewUnreachableError())
+				if previous.restriction == restrictionInterfaceType {
+					return
 				}
 
-				previousMemberType := prevMemberType.TypeAnnotation.Type
+				// If there is an overlap in members, ensure the members have the same type
+
+				memberType := member.TypeAnnotation.Type
+				previousMemberType := previous.member.TypeAnnotation.Type
 
 				if !memberType.IsInvalidType() &&
 					!previousMemberType.IsInvalidType() &&
@@ -1045,13 +1081,16 @@ func CheckRestrictedType(
 						return &RestrictionMemberClashError{
 							Name:                  name,
 							RedeclaringType:       restrictionInterfaceType,
-							OriginalDeclaringType: previousDeclaringInterfaceType,
+							OriginalDeclaringType: previous.restriction,
 							Range:                 ast.NewRangeFromPositioned(memoryGauge, t.Restrictions[i]),
 						}
 					})
 				}
 			} else {
-				memberSet[name] = restrictionInterfaceType
+				memberSet[name] = restrictionMember{
+					restriction: restrictionInterfaceType,
+					member:      member,
+				}
 			}
 		})
 	}
@@ -1162,10 +1201,28 @@ func (checker *Checker) convertRestrictedType(t *ast.RestrictedType) Type {
 
 	// Convert the restrictions
 
+	restrictionResults := make([]Type, 0, len(t.Restrictions))
+	for _, restriction := range t.Restrictions {
+		restrictionResults = append(restrictionResults, checker.ConvertType(restriction))
+	}
+
+	return checker.checkRestrictedType(t, restrictedType, restrictionResults)
+}
+
+// checkRestrictedType checks the given restricted type and restrictions,
+// which were converted from the given restricted type,
+// and returns the resulting restricted type
+//
+func (checker *Checker) checkRestrictedType(
+	t *ast.RestrictedType,
+	restrictedType Type,
+	restrictionResults []Type,
+) Type {
+
 	var restrictions []*InterfaceType
 
-	for _, restriction := range t.Restrictions {
-		restrictionResult := checker.ConvertType(restriction)
+	for i, restriction := range t.Restrictions {
+		restrictionResult := restrictionResults[i]
 
 		// The restriction must be a resource or structure interface type
 
@@ -1207,6 +1264,50 @@ func (checker *Checker) convertRestrictedType(t *ast.RestrictedType) Type {
 	}
 }
 
+func (checker *Checker) convertSetType(t *ast.SetType) Type {
+	elementType := checker.ConvertType(t.ElementType)
+
+	// A set type with an interface element type, e.g. `{I}`,
+	// has the same syntax as a restricted type with only a restriction,
+	// and no restricted type, so it is a restricted type.
+	// If the nominal element type is invalid, e.g. not declared,
+	// it is also treated as a restricted type
+
+	restriction, isNominal := t.ElementType.(*ast.NominalType)
+	_, isInterface := elementType.(*InterfaceType)
+
+	if isNominal && (isInterface || elementType.IsInvalidType()) {
+		return checker.checkRestrictedType(
+			ast.NewRestrictedType(
+				checker.memoryGauge,
+				nil,
+				[]*ast.NominalType{restriction},
+				t.Range,
+			),
+			nil,
+			[]Type{elementType},
+		)
+	}
+
+	// An interface element type which is not written as a nominal type
+	// can neither be a restriction, nor is it a valid element type
+
+	if isInterface ||
+		(!elementType.IsInvalidType() && !IsValidDictionaryKeyType(elementType)) {
+
+		checker.report(
+			&InvalidSetElementTypeError{
+				Type:  elementType,
+				Range: ast.NewRangeFromPositioned(checker.memoryGauge, t.ElementType),
+			},
+		)
+	}
+
+	return &SetType{
+		ElementType: elementType,
+	}
+}
+
 func (checker *Checker) convertReferenceType(t *ast.ReferenceType) Type {
 	ty := checker.ConvertType(t.Type)
 
@@ -1356,7 +1457,7 @@ func (checker *Checker) convertNominalType(t *ast.NominalType) Type {
 
 	for _, identifier := range t.NestedIdentifiers {
 		if containerType, ok := ty.(ContainerType); ok && containerType.IsContainerType() {
-			ty, _ = containerType.GetNestedTypes().Get(identifier.Identifier)
+			ty, _ = GetNestedTypeOrTypeAlias(containerType, identifier.Identifier)
 		} else {
 			if !ty.IsInvalidType() {
 				checker.report(
@@ -1418,8 +1519,123 @@ func (checker *Checker) functionType(
 		checker.ConvertTypeAnnotation(returnTypeAnnotation)
 
 	return &FunctionType{
-		Parameters:           convertedParameters,
-		ReturnTypeAnnotation: convertedReturnTypeAnnotation,
+		Parameters:            convertedParameters,
+		ReturnTypeAnnotation:  convertedReturnTypeAnnotation,
+		RequiredArgumentCount: requiredArgumentCount(convertedParameters),
+	}
+}
+
+// requiredArgumentCount returns the number of arguments an invocation must provide
+// for the given parameters, or nil if no parameter has a default argument.
+// Only trailing parameters can have default arguments, see `checkDefaultArgumentOrder`
+//
+func requiredArgumentCount(parameters []*Parameter) *int {
+	for i, parameter := range parameters {
+		if parameter.DefaultArgument != nil {
+			return RequiredArgumentCount(i)
+		}
+	}
+
+	return nil
+}
+
+// functionDeclarationType returns the function type of the given function declaration.
+// The type parameters of a generic function are in scope in its parameter list and return type
+//
+func (checker *Checker) functionDeclarationType(declaration *ast.FunctionDeclaration) *FunctionType {
+	typeParameters := checker.typeParameters(declaration.TypeParameterList)
+	if len(typeParameters) == 0 {
+		return checker.functionType(declaration.ParameterList, declaration.ReturnTypeAnnotation)
+	}
+
+	checker.typeActivations.Enter()
+	defer checker.typeActivations.Leave(declaration.EndPosition)
+
+	checker.declareTypeParameters(declaration.TypeParameterList, typeParameters, true)
+
+	functionType := checker.functionType(declaration.ParameterList, declaration.ReturnTypeAnnotation)
+	functionType.TypeParameters = typeParameters
+
+	return functionType
+}
+
+// typeParameters converts the given type parameter list of a generic function or composite.
+// Type parameters without an explicit type bound are bound to `AnyStruct`
+//
+func (checker *Checker) typeParameters(typeParameterList *ast.TypeParameterList) []*TypeParameter {
+	if typeParameterList.IsEmpty() {
+		return nil
+	}
+
+	typeParameters := make([]*TypeParameter, len(typeParameterList.TypeParameters))
+
+	for i, typeParameter := range typeParameterList.TypeParameters {
+		typeParameters[i] = &TypeParameter{
+			Name:      typeParameter.Identifier.Identifier,
+			TypeBound: checker.typeParameterBound(typeParameter),
+			declared:  true,
+		}
+	}
+
+	return typeParameters
+}
+
+func (checker *Checker) typeParameterBound(typeParameter *ast.TypeParameter) Type {
+	if typeParameter.TypeBound == nil {
+		return AnyStructType
+	}
+
+	typeBound := checker.ConvertTypeAnnotation(typeParameter.TypeBound)
+	checker.checkTypeAnnotation(typeBound, typeParameter.TypeBound)
+
+	// Values of generic types are copied, like structures,
+	// so type parameters cannot be bound to resource types
+
+	boundType := typeBound.Type
+	if !boundType.IsInvalidType() &&
+		!IsSubType(boundType, AnyStructType) {
+
+		checker.report(
+			&InvalidTypeParameterBoundError{
+				Type:  boundType,
+				Range: ast.NewRangeFromPositioned(checker.memoryGauge, typeParameter.TypeBound),
+			},
+		)
+
+		return InvalidType
+	}
+
+	return boundType
+}
+
+// declareTypeParameters declares the type parameters of a generic function or composite
+// in the current type activation.
+//
+// Type parameters are declared when the function's or composite's type is determined,
+// and again when the declaration is checked, so redeclarations are only reported once
+//
+func (checker *Checker) declareTypeParameters(
+	typeParameterList *ast.TypeParameterList,
+	typeParameters []*TypeParameter,
+	reportRedeclarations bool,
+) {
+	if len(typeParameters) == 0 {
+		return
+	}
+
+	for i, typeParameter := range typeParameterList.TypeParameters {
+		_, err := checker.typeActivations.DeclareType(typeDeclaration{
+			identifier: typeParameter.Identifier,
+			ty: &GenericType{
+				TypeParameter: typeParameters[i],
+			},
+			declarationKind:          common.DeclarationKindTypeParameter,
+			access:                   ast.AccessNotSpecified,
+			allowOuterScopeShadowing: false,
+		})
+		if reportRedeclarations {
+			checker.report(err)
+		}
 	}
 }
 
@@ -1440,6 +1656,7 @@ func (checker *Checker) parameters(parameterList *ast.ParameterList) []*Paramete
 				IsResource: parameter.TypeAnnotation.IsResource,
 				Type:       convertedParameterType,
 			},
+			DefaultArgument: parameter.DefaultArgument,
 		}
 	}
 
@@ -2344,7 +2561,7 @@ func (checker *Checker) convertInstantiationType(t *ast.InstantiationType) Type
 	}
 
 	parameterizedType, ok := ty.(ParameterizedType)
-	if !ok {
+	if !ok || len(parameterizedType.TypeParameters()) == 0 {
 
 		// The type is not parameterized,
 		// report an error for all type arguments
@@ -0,0 +1,145 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+)
+
+const DurationTypeName = "Duration"
+
+// DurationType represents the duration type, a number of seconds
+//
+var DurationType = &SimpleType{
+	Name:                 DurationTypeName,
+	QualifiedName:        DurationTypeName,
+	TypeID:               DurationTypeName,
+	tag:                  DurationTypeTag,
+	IsInvalid:            false,
+	IsResource:           false,
+	Storable:             true,
+	Equatable:            true,
+	ExternallyReturnable: true,
+	Importable:           true,
+}
+
+const DurationTypeSecondsFieldName = "seconds"
+
+const durationTypeSecondsFieldDocString = `
+The number of seconds of the duration
+`
+
+func init() {
+	DurationType.Members = func(t *SimpleType) map[string]MemberResolver {
+		return map[string]MemberResolver{
+			DurationTypeSecondsFieldName: {
+				Kind: common.DeclarationKindField,
+				Resolve: func(memoryGauge common.MemoryGauge, identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicConstantFieldMember(
+						memoryGauge,
+						t,
+						identifier,
+						Int64Type,
+						durationTypeSecondsFieldDocString,
+					)
+				},
+			},
+			ToStringFunctionName: {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(memoryGauge common.MemoryGauge, identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						memoryGauge,
+						t,
+						identifier,
+						ToStringFunctionType,
+						toStringFunctionDocString,
+					)
+				},
+			},
+		}
+	}
+}
+
+// DurationConstants are the built-in duration constants,
+// and their number of seconds.
+// They are declared as members of the `Duration` function, e.g. `Duration.day`
+//
+var DurationConstants = []struct {
+	Name    string
+	Seconds int64
+}{
+	{Name: "second", Seconds: 1},
+	{Name: "minute", Seconds: 60},
+	{Name: "hour", Seconds: 60 * 60},
+	{Name: "day", Seconds: 24 * 60 * 60},
+}
+
+// DurationConversionFunctionType is the type of the `Duration` function,
+// which creates a duration from a number of seconds
+//
+var DurationConversionFunctionType = func() *FunctionType {
+	functionType := &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "seconds",
+				TypeAnnotation: NewTypeAnnotation(Int64Type),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(DurationType),
+		Members:              &StringMemberOrderedMap{},
+	}
+
+	for _, constant := range DurationConstants {
+		functionType.Members.Set(
+			constant.Name,
+			NewUnmeteredPublicConstantFieldMember(
+				functionType,
+				constant.Name,
+				DurationType,
+				"The duration of one "+constant.Name,
+			),
+		)
+	}
+
+	return functionType
+}()
+
+func init() {
+	// Declare a conversion function for the duration type
+
+	// Check that the function is not accidentally redeclared
+
+	typeName := DurationTypeName
+
+	if BaseValueActivation.Find(typeName) != nil {
+		panic(errors.NewUnreachableError())
+	}
+
+	BaseValueActivation.Set(
+		typeName,
+		baseFunctionVariable(
+			typeName,
+			DurationConversionFunctionType,
+			"Creates a duration of the given number of seconds",
+		),
+	)
+}
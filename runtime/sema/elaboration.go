@@ -43,6 +43,8 @@ type Elaboration struct {
 	VariableDeclarationValueTypes       map[*ast.VariableDeclaration]Type
 	VariableDeclarationSecondValueTypes map[*ast.VariableDeclaration]Type
 	VariableDeclarationTargetTypes      map[*ast.VariableDeclaration]Type
+	PatternTypes                        map[ast.Pattern]Type
+	TypePatternTargetTypes              map[*ast.TypePattern]Type
 	AssignmentStatementValueTypes       map[*ast.AssignmentStatement]Type
 	AssignmentStatementTargetTypes      map[*ast.AssignmentStatement]Type
 	CompositeDeclarationTypes           map[*ast.CompositeDeclaration]*CompositeType
@@ -51,10 +53,13 @@ type Elaboration struct {
 	InterfaceTypeDeclarations           map[*InterfaceType]*ast.InterfaceDeclaration
 	ConstructorFunctionTypes            map[*ast.SpecialFunctionDeclaration]*FunctionType
 	FunctionExpressionFunctionType      map[*ast.FunctionExpression]*FunctionType
+	DefaultArgumentValueTypes           map[*ast.Parameter]Type
+	DefaultArgumentParameterTypes       map[*ast.Parameter]Type
 	InvocationExpressionArgumentTypes   map[*ast.InvocationExpression][]Type
 	InvocationExpressionParameterTypes  map[*ast.InvocationExpression][]Type
 	InvocationExpressionReturnTypes     map[*ast.InvocationExpression]Type
 	InvocationExpressionTypeArguments   map[*ast.InvocationExpression]*TypeParameterTypeOrderedMap
+	InvocationExpressionOverloads       map[*ast.InvocationExpression]string
 	CastingStaticValueTypes             map[*ast.CastingExpression]Type
 	CastingTargetTypes                  map[*ast.CastingExpression]Type
 	ReturnStatementValueTypes           map[*ast.ReturnStatement]Type
@@ -66,6 +71,7 @@ type Elaboration struct {
 	MemberExpressionExpectedTypes       map[*ast.MemberExpression]Type
 	ArrayExpressionArgumentTypes        map[*ast.ArrayExpression][]Type
 	ArrayExpressionArrayType            map[*ast.ArrayExpression]ArrayType
+	ArrayExpressionSetType              map[*ast.ArrayExpression]*SetType
 	DictionaryExpressionType            map[*ast.DictionaryExpression]*DictionaryType
 	DictionaryExpressionEntryTypes      map[*ast.DictionaryExpression][]DictionaryEntryType
 	IntegerExpressionType               map[*ast.IntegerExpression]Type
@@ -79,6 +85,7 @@ type Elaboration struct {
 	IsNestedResourceMoveExpression      map[ast.Expression]struct{}
 	CompositeNestedDeclarations         map[*ast.CompositeDeclaration]map[string]ast.Declaration
 	InterfaceNestedDeclarations         map[*ast.InterfaceDeclaration]map[string]ast.Declaration
+	TypeAliasDeclarationTypes           map[*ast.TypeAliasDeclaration]Type
 	PostConditionsRewrite               map[*ast.Conditions]PostConditionsRewrite
 	EmitStatementEventTypes             map[*ast.EmitStatement]*CompositeType
 	CompositeTypes                      map[TypeID]*CompositeType
@@ -114,6 +121,8 @@ func NewElaboration(gauge common.MemoryGauge, extendedElaboration bool) *Elabora
 		VariableDeclarationValueTypes:       map[*ast.VariableDeclaration]Type{},
 		VariableDeclarationSecondValueTypes: map[*ast.VariableDeclaration]Type{},
 		VariableDeclarationTargetTypes:      map[*ast.VariableDeclaration]Type{},
+		PatternTypes:                        map[ast.Pattern]Type{},
+		TypePatternTargetTypes:              map[*ast.TypePattern]Type{},
 		AssignmentStatementValueTypes:       map[*ast.AssignmentStatement]Type{},
 		AssignmentStatementTargetTypes:      map[*ast.AssignmentStatement]Type{},
 		CompositeDeclarationTypes:           map[*ast.CompositeDeclaration]*CompositeType{},
@@ -122,10 +131,13 @@ func NewElaboration(gauge common.MemoryGauge, extendedElaboration bool) *Elabora
 		InterfaceTypeDeclarations:           map[*InterfaceType]*ast.InterfaceDeclaration{},
 		ConstructorFunctionTypes:            map[*ast.SpecialFunctionDeclaration]*FunctionType{},
 		FunctionExpressionFunctionType:      map[*ast.FunctionExpression]*FunctionType{},
+		DefaultArgumentValueTypes:           map[*ast.Parameter]Type{},
+		DefaultArgumentParameterTypes:       map[*ast.Parameter]Type{},
 		InvocationExpressionArgumentTypes:   map[*ast.InvocationExpression][]Type{},
 		InvocationExpressionParameterTypes:  map[*ast.InvocationExpression][]Type{},
 		InvocationExpressionReturnTypes:     map[*ast.InvocationExpression]Type{},
 		InvocationExpressionTypeArguments:   map[*ast.InvocationExpression]*TypeParameterTypeOrderedMap{},
+		InvocationExpressionOverloads:       map[*ast.InvocationExpression]string{},
 		CastingStaticValueTypes:             map[*ast.CastingExpression]Type{},
 		CastingTargetTypes:                  map[*ast.CastingExpression]Type{},
 		ReturnStatementValueTypes:           map[*ast.ReturnStatement]Type{},
@@ -137,6 +149,7 @@ func NewElaboration(gauge common.MemoryGauge, extendedElaboration bool) *Elabora
 		MemberExpressionExpectedTypes:       map[*ast.MemberExpression]Type{},
 		ArrayExpressionArgumentTypes:        map[*ast.ArrayExpression][]Type{},
 		ArrayExpressionArrayType:            map[*ast.ArrayExpression]ArrayType{},
+		ArrayExpressionSetType:              map[*ast.ArrayExpression]*SetType{},
 		DictionaryExpressionType:            map[*ast.DictionaryExpression]*DictionaryType{},
 		DictionaryExpressionEntryTypes:      map[*ast.DictionaryExpression][]DictionaryEntryType{},
 		IntegerExpressionType:               map[*ast.IntegerExpression]Type{},
@@ -148,6 +161,7 @@ func NewElaboration(gauge common.MemoryGauge, extendedElaboration bool) *Elabora
 		IsNestedResourceMoveExpression:      map[ast.Expression]struct{}{},
 		CompositeNestedDeclarations:         map[*ast.CompositeDeclaration]map[string]ast.Declaration{},
 		InterfaceNestedDeclarations:         map[*ast.InterfaceDeclaration]map[string]ast.Declaration{},
+		TypeAliasDeclarationTypes:           map[*ast.TypeAliasDeclaration]Type{},
 		PostConditionsRewrite:               map[*ast.Conditions]PostConditionsRewrite{},
 		EmitStatementEventTypes:             map[*ast.EmitStatement]*CompositeType{},
 		CompositeTypes:                      map[TypeID]*CompositeType{},
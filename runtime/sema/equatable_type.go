@@ -0,0 +1,42 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/common"
+)
+
+const EquatableTypeName = "Equatable"
+
+const EquatableTypeEqualsFunctionName = "equals"
+
+// EquatableType represents the built-in structure interface `Equatable`.
+//
+// Values of structures which conform to it can be compared using `==` and `!=`.
+// The structure may declare a function `equals(_ other: T): Bool`,
+// where `T` is the structure itself, which is used to compare values.
+// Otherwise, values are compared field-wise, and all fields must be equatable.
+//
+var EquatableType = &InterfaceType{
+	Identifier:    EquatableTypeName,
+	CompositeKind: common.CompositeKindStructure,
+	nestedTypes:   &StringTypeOrderedMap{},
+	typeAliases:   &StringTypeOrderedMap{},
+	Members:       &StringMemberOrderedMap{},
+}
@@ -1224,7 +1224,7 @@ func (n MemberMismatchNote) Message() string {
 // TODO: just make this a warning?
 
 type DuplicateConformanceError struct {
-	CompositeType *CompositeType
+	CompositeType CompositeKindedType
 	InterfaceType *InterfaceType
 	ast.Range
 }
@@ -1239,13 +1239,78 @@ func (*DuplicateConformanceError) IsUserError() {}
 func (e *DuplicateConformanceError) Error() string {
 	return fmt.Sprintf(
 		"%s `%s` repeats conformance to %s `%s`",
-		e.CompositeType.Kind.Name(),
+		compositeKindedTypeKindName(e.CompositeType),
 		e.CompositeType.QualifiedString(),
 		e.InterfaceType.CompositeKind.DeclarationKind(true).Name(),
 		e.InterfaceType.QualifiedString(),
 	)
 }
 
+func compositeKindedTypeKindName(ty CompositeKindedType) string {
+	compositeKind := ty.GetCompositeKind()
+	if _, ok := ty.(*InterfaceType); ok {
+		return compositeKind.DeclarationKind(true).Name()
+	}
+	return compositeKind.Name()
+}
+
+// CyclicConformanceError
+
+type CyclicConformanceError struct {
+	InterfaceType *InterfaceType
+	ast.Range
+}
+
+var _ SemanticError = &CyclicConformanceError{}
+var _ errors.UserError = &CyclicConformanceError{}
+
+func (*CyclicConformanceError) isSemanticError() {}
+
+func (*CyclicConformanceError) IsUserError() {}
+
+func (e *CyclicConformanceError) Error() string {
+	return fmt.Sprintf(
+		"%s `%s` conforms to itself",
+		e.InterfaceType.CompositeKind.DeclarationKind(true).Name(),
+		e.InterfaceType.QualifiedString(),
+	)
+}
+
+// InterfaceMemberConflictError
+
+type InterfaceMemberConflictError struct {
+	InterfaceType            *InterfaceType
+	MemberName               string
+	DeclaringInterfaceType   *InterfaceType
+	ConflictingInterfaceType *InterfaceType
+	ast.Range
+}
+
+var _ SemanticError = &InterfaceMemberConflictError{}
+var _ errors.UserError = &InterfaceMemberConflictError{}
+var _ errors.SecondaryError = &InterfaceMemberConflictError{}
+
+func (*InterfaceMemberConflictError) isSemanticError() {}
+
+func (*InterfaceMemberConflictError) IsUserError() {}
+
+func (e *InterfaceMemberConflictError) Error() string {
+	return fmt.Sprintf(
+		"%s `%s` has conflicting declarations of member `%s`",
+		e.InterfaceType.CompositeKind.DeclarationKind(true).Name(),
+		e.InterfaceType.QualifiedString(),
+		e.MemberName,
+	)
+}
+
+func (e *InterfaceMemberConflictError) SecondaryError() string {
+	return fmt.Sprintf(
+		"declaration in `%s` does not match declaration in `%s`",
+		e.DeclaringInterfaceType.QualifiedString(),
+		e.ConflictingInterfaceType.QualifiedString(),
+	)
+}
+
 // MissingConformanceError
 
 type MissingConformanceError struct {
@@ -1432,6 +1497,157 @@ func (e *UnsupportedOverloadingError) Error() string {
 	)
 }
 
+// InitializerConditionsOverloadingError
+
+type InitializerConditionsOverloadingError struct {
+	InterfaceType *InterfaceType
+	ast.Range
+}
+
+var _ SemanticError = &InitializerConditionsOverloadingError{}
+var _ errors.UserError = &InitializerConditionsOverloadingError{}
+var _ errors.SecondaryError = &InitializerConditionsOverloadingError{}
+
+func (*InitializerConditionsOverloadingError) isSemanticError() {}
+
+func (*InitializerConditionsOverloadingError) IsUserError() {}
+
+func (e *InitializerConditionsOverloadingError) Error() string {
+	return fmt.Sprintf(
+		"cannot overload initializers of a type which conforms to %s `%s`",
+		e.InterfaceType.CompositeKind.DeclarationKind(true).Name(),
+		e.InterfaceType.QualifiedString(),
+	)
+}
+
+func (e *InitializerConditionsOverloadingError) SecondaryError() string {
+	return "the required initializer has conditions, which must apply to all initializers"
+}
+
+// UnsupportedDefaultArgumentError
+
+type UnsupportedDefaultArgumentError struct {
+	DeclarationKind common.DeclarationKind
+	Overloaded      bool
+	ast.Range
+}
+
+var _ SemanticError = &UnsupportedDefaultArgumentError{}
+var _ errors.UserError = &UnsupportedDefaultArgumentError{}
+
+func (*UnsupportedDefaultArgumentError) isSemanticError() {}
+
+func (*UnsupportedDefaultArgumentError) IsUserError() {}
+
+func (e *UnsupportedDefaultArgumentError) Error() string {
+	kind := e.DeclarationKind.Name()
+	if e.Overloaded {
+		kind = "overloaded " + kind
+	}
+
+	return fmt.Sprintf(
+		"default arguments are not supported in %s declarations",
+		kind,
+	)
+}
+
+// MissingDefaultArgumentError
+
+type MissingDefaultArgumentError struct {
+	Name string
+	ast.Range
+}
+
+var _ SemanticError = &MissingDefaultArgumentError{}
+var _ errors.UserError = &MissingDefaultArgumentError{}
+var _ errors.SecondaryError = &MissingDefaultArgumentError{}
+
+func (*MissingDefaultArgumentError) isSemanticError() {}
+
+func (*MissingDefaultArgumentError) IsUserError() {}
+
+func (e *MissingDefaultArgumentError) Error() string {
+	return fmt.Sprintf(
+		"missing default argument for parameter `%s`",
+		e.Name,
+	)
+}
+
+func (e *MissingDefaultArgumentError) SecondaryError() string {
+	return "parameters following a parameter with a default argument must also have a default argument"
+}
+
+// NonConstantDefaultArgumentError
+
+type NonConstantDefaultArgumentError struct {
+	ast.Range
+}
+
+var _ SemanticError = &NonConstantDefaultArgumentError{}
+var _ errors.UserError = &NonConstantDefaultArgumentError{}
+var _ errors.SecondaryError = &NonConstantDefaultArgumentError{}
+
+func (*NonConstantDefaultArgumentError) isSemanticError() {}
+
+func (*NonConstantDefaultArgumentError) IsUserError() {}
+
+func (e *NonConstantDefaultArgumentError) Error() string {
+	return "default argument must be a constant expression"
+}
+
+func (e *NonConstantDefaultArgumentError) SecondaryError() string {
+	return "default arguments cannot call functions, refer to variables, or create, destroy, or move resources"
+}
+
+// AmbiguousOverloadError
+
+type AmbiguousOverloadError struct {
+	Name string
+	ast.Range
+}
+
+var _ SemanticError = &AmbiguousOverloadError{}
+var _ errors.UserError = &AmbiguousOverloadError{}
+var _ errors.SecondaryError = &AmbiguousOverloadError{}
+
+func (*AmbiguousOverloadError) isSemanticError() {}
+
+func (*AmbiguousOverloadError) IsUserError() {}
+
+func (e *AmbiguousOverloadError) Error() string {
+	return fmt.Sprintf(
+		"cannot refer to overloaded function `%s` without calling it",
+		e.Name,
+	)
+}
+
+func (e *AmbiguousOverloadError) SecondaryError() string {
+	return "the overload is selected by the argument labels of the call"
+}
+
+// NoMatchingOverloadError
+
+type NoMatchingOverloadError struct {
+	Name           string
+	ArgumentLabels []string
+	ast.Range
+}
+
+var _ SemanticError = &NoMatchingOverloadError{}
+var _ errors.UserError = &NoMatchingOverloadError{}
+
+func (*NoMatchingOverloadError) isSemanticError() {}
+
+func (*NoMatchingOverloadError) IsUserError() {}
+
+func (e *NoMatchingOverloadError) Error() string {
+	return fmt.Sprintf(
+		"no overload of `%s` has the argument labels of the call: `%s`",
+		e.Name,
+		FunctionOverloadIdentifier(e.Name, e.ArgumentLabels),
+	)
+}
+
 // CompositeKindMismatchError
 
 type CompositeKindMismatchError struct {
@@ -2603,6 +2819,144 @@ func (e *InvalidDictionaryKeyTypeError) Error() string {
 	)
 }
 
+// InvalidSetElementTypeError
+
+type InvalidSetElementTypeError struct {
+	Type Type
+	ast.Range
+}
+
+var _ SemanticError = &InvalidSetElementTypeError{}
+var _ errors.UserError = &InvalidSetElementTypeError{}
+
+func (*InvalidSetElementTypeError) isSemanticError() {}
+
+func (*InvalidSetElementTypeError) IsUserError() {}
+
+func (e *InvalidSetElementTypeError) Error() string {
+	return fmt.Sprintf(
+		"cannot use type as set element type: `%s`",
+		e.Type.QualifiedString(),
+	)
+}
+
+// InvalidHashableFieldError
+
+type InvalidHashableFieldError struct {
+	Name string
+	Type Type
+	ast.Range
+}
+
+var _ SemanticError = &InvalidHashableFieldError{}
+var _ errors.UserError = &InvalidHashableFieldError{}
+var _ errors.SecondaryError = &InvalidHashableFieldError{}
+
+func (*InvalidHashableFieldError) isSemanticError() {}
+
+func (*InvalidHashableFieldError) IsUserError() {}
+
+func (e *InvalidHashableFieldError) Error() string {
+	return fmt.Sprintf(
+		"field `%s` of hashable structure has non-hashable type: `%s`",
+		e.Name,
+		e.Type.QualifiedString(),
+	)
+}
+
+func (e *InvalidHashableFieldError) SecondaryError() string {
+	return fmt.Sprintf(
+		"all fields of a structure conforming to `%s` must be usable as dictionary keys",
+		HashableTypeName,
+	)
+}
+
+// InvalidEquatableFieldError
+
+type InvalidEquatableFieldError struct {
+	Name string
+	Type Type
+	ast.Range
+}
+
+var _ SemanticError = &InvalidEquatableFieldError{}
+var _ errors.UserError = &InvalidEquatableFieldError{}
+var _ errors.SecondaryError = &InvalidEquatableFieldError{}
+
+func (*InvalidEquatableFieldError) isSemanticError() {}
+
+func (*InvalidEquatableFieldError) IsUserError() {}
+
+func (e *InvalidEquatableFieldError) Error() string {
+	return fmt.Sprintf(
+		"field `%s` of equatable structure has non-equatable type: `%s`",
+		e.Name,
+		e.Type.QualifiedString(),
+	)
+}
+
+func (e *InvalidEquatableFieldError) SecondaryError() string {
+	return fmt.Sprintf(
+		"declare an `%s` function, or only use fields with equatable types",
+		EquatableTypeEqualsFunctionName,
+	)
+}
+
+// InvalidEquatableEqualsFunctionError
+
+type InvalidEquatableEqualsFunctionError struct {
+	Type *CompositeType
+	ast.Range
+}
+
+var _ SemanticError = &InvalidEquatableEqualsFunctionError{}
+var _ errors.UserError = &InvalidEquatableEqualsFunctionError{}
+var _ errors.SecondaryError = &InvalidEquatableEqualsFunctionError{}
+
+func (*InvalidEquatableEqualsFunctionError) isSemanticError() {}
+
+func (*InvalidEquatableEqualsFunctionError) IsUserError() {}
+
+func (e *InvalidEquatableEqualsFunctionError) Error() string {
+	return fmt.Sprintf(
+		"invalid `%s` function of equatable structure",
+		EquatableTypeEqualsFunctionName,
+	)
+}
+
+func (e *InvalidEquatableEqualsFunctionError) SecondaryError() string {
+	return fmt.Sprintf(
+		"expected `fun %s(_ other: %s): Bool`",
+		EquatableTypeEqualsFunctionName,
+		e.Type.QualifiedString(),
+	)
+}
+
+// InvalidHashableEqualsFunctionError
+
+type InvalidHashableEqualsFunctionError struct {
+	ast.Range
+}
+
+var _ SemanticError = &InvalidHashableEqualsFunctionError{}
+var _ errors.UserError = &InvalidHashableEqualsFunctionError{}
+var _ errors.SecondaryError = &InvalidHashableEqualsFunctionError{}
+
+func (*InvalidHashableEqualsFunctionError) isSemanticError() {}
+
+func (*InvalidHashableEqualsFunctionError) IsUserError() {}
+
+func (e *InvalidHashableEqualsFunctionError) Error() string {
+	return fmt.Sprintf(
+		"hashable structure cannot declare an `%s` function",
+		EquatableTypeEqualsFunctionName,
+	)
+}
+
+func (e *InvalidHashableEqualsFunctionError) SecondaryError() string {
+	return "values of hashable structures are always compared field-wise"
+}
+
 // MissingFunctionBodyError
 
 type MissingFunctionBodyError struct {
@@ -2732,6 +3086,29 @@ func (e *InvalidCharacterLiteralError) SecondaryError() string {
 	)
 }
 
+// InvalidTimestampLiteralError
+
+type InvalidTimestampLiteralError struct {
+	Literal string
+	ast.Range
+}
+
+var _ SemanticError = &InvalidTimestampLiteralError{}
+var _ errors.UserError = &InvalidTimestampLiteralError{}
+var _ errors.SecondaryError = &InvalidTimestampLiteralError{}
+
+func (*InvalidTimestampLiteralError) isSemanticError() {}
+
+func (*InvalidTimestampLiteralError) IsUserError() {}
+
+func (e *InvalidTimestampLiteralError) Error() string {
+	return fmt.Sprintf("invalid timestamp literal: `%s`", e.Literal)
+}
+
+func (e *InvalidTimestampLiteralError) SecondaryError() string {
+	return "expected an ISO-8601 date and time in UTC, e.g. `2020-03-13T19:52:43Z`"
+}
+
 // InvalidFailableResourceDowncastOutsideOptionalBindingError
 
 type InvalidFailableResourceDowncastOutsideOptionalBindingError struct {
@@ -3628,6 +4005,24 @@ func (e *CyclicImportsError) Error() string {
 	return fmt.Sprintf("cyclic import of `%s`", e.Location)
 }
 
+// CyclicTypeAliasError
+
+type CyclicTypeAliasError struct {
+	Name string
+	ast.Range
+}
+
+var _ SemanticError = &CyclicTypeAliasError{}
+var _ errors.UserError = &CyclicTypeAliasError{}
+
+func (*CyclicTypeAliasError) isSemanticError() {}
+
+func (*CyclicTypeAliasError) IsUserError() {}
+
+func (e *CyclicTypeAliasError) Error() string {
+	return fmt.Sprintf("type alias `%s` refers to itself", e.Name)
+}
+
 // SwitchDefaultPositionError
 
 type SwitchDefaultPositionError struct {
@@ -3733,3 +4128,231 @@ func (e *ExternalMutationError) SecondaryError() string {
 		e.ContainerType.QualifiedString(),
 	)
 }
+
+// InvalidDestructuringDeclarationError
+
+type InvalidDestructuringDeclarationError struct {
+	ast.Range
+}
+
+var _ SemanticError = &InvalidDestructuringDeclarationError{}
+var _ errors.UserError = &InvalidDestructuringDeclarationError{}
+var _ errors.SecondaryError = &InvalidDestructuringDeclarationError{}
+
+func (*InvalidDestructuringDeclarationError) isSemanticError() {}
+
+func (*InvalidDestructuringDeclarationError) IsUserError() {}
+
+func (e *InvalidDestructuringDeclarationError) Error() string {
+	return "invalid destructuring declaration"
+}
+
+func (e *InvalidDestructuringDeclarationError) SecondaryError() string {
+	return "patterns are only allowed in local variable declarations"
+}
+
+// InvalidPatternTypeError
+
+type InvalidPatternTypeError struct {
+	PatternKind string
+	Type        Type
+	ast.Range
+}
+
+var _ SemanticError = &InvalidPatternTypeError{}
+var _ errors.UserError = &InvalidPatternTypeError{}
+
+func (*InvalidPatternTypeError) isSemanticError() {}
+
+func (*InvalidPatternTypeError) IsUserError() {}
+
+func (e *InvalidPatternTypeError) Error() string {
+	return fmt.Sprintf(
+		"cannot destructure value of type `%s` using %s pattern",
+		e.Type.QualifiedString(),
+		e.PatternKind,
+	)
+}
+
+// PatternElementCountMismatchError
+
+type PatternElementCountMismatchError struct {
+	ExpectedCount int
+	ActualCount   int
+	ast.Range
+}
+
+var _ SemanticError = &PatternElementCountMismatchError{}
+var _ errors.UserError = &PatternElementCountMismatchError{}
+
+func (*PatternElementCountMismatchError) isSemanticError() {}
+
+func (*PatternElementCountMismatchError) IsUserError() {}
+
+func (e *PatternElementCountMismatchError) Error() string {
+	return fmt.Sprintf(
+		"incorrect number of elements in pattern: expected %d, got %d",
+		e.ExpectedCount,
+		e.ActualCount,
+	)
+}
+
+// DuplicatePatternFieldError
+
+type DuplicatePatternFieldError struct {
+	Name string
+	ast.Range
+}
+
+var _ SemanticError = &DuplicatePatternFieldError{}
+var _ errors.UserError = &DuplicatePatternFieldError{}
+
+func (*DuplicatePatternFieldError) isSemanticError() {}
+
+func (*DuplicatePatternFieldError) IsUserError() {}
+
+func (e *DuplicatePatternFieldError) Error() string {
+	return fmt.Sprintf(
+		"field `%s` is already matched in pattern",
+		e.Name,
+	)
+}
+
+// InvalidResourceDestructuringError
+
+type InvalidResourceDestructuringError struct {
+	Type Type
+	ast.Range
+}
+
+var _ SemanticError = &InvalidResourceDestructuringError{}
+var _ errors.UserError = &InvalidResourceDestructuringError{}
+var _ errors.SecondaryError = &InvalidResourceDestructuringError{}
+
+func (*InvalidResourceDestructuringError) isSemanticError() {}
+
+func (*InvalidResourceDestructuringError) IsUserError() {}
+
+func (e *InvalidResourceDestructuringError) Error() string {
+	return fmt.Sprintf(
+		"cannot destructure resource type `%s`",
+		e.Type.QualifiedString(),
+	)
+}
+
+func (e *InvalidResourceDestructuringError) SecondaryError() string {
+	switch e.Type.(type) {
+	case *DictionaryType:
+		return "dictionaries of resources cannot be destructured, as unmatched entries would be lost"
+	case *CompositeType:
+		return "resources can only be destructured in their declaring contract"
+	}
+	return ""
+}
+
+// NonExhaustiveResourceDestructuringError
+
+type NonExhaustiveResourceDestructuringError struct {
+	Type          Type
+	MissingFields []string
+	ast.Range
+}
+
+var _ SemanticError = &NonExhaustiveResourceDestructuringError{}
+var _ errors.UserError = &NonExhaustiveResourceDestructuringError{}
+var _ errors.SecondaryError = &NonExhaustiveResourceDestructuringError{}
+
+func (*NonExhaustiveResourceDestructuringError) isSemanticError() {}
+
+func (*NonExhaustiveResourceDestructuringError) IsUserError() {}
+
+func (e *NonExhaustiveResourceDestructuringError) Error() string {
+	quotedFields := make([]string, len(e.MissingFields))
+	for i, field := range e.MissingFields {
+		quotedFields[i] = fmt.Sprintf("`%s`", field)
+	}
+
+	return fmt.Sprintf(
+		"resource type `%s` declares a destructor, but the pattern does not match field(s) %s",
+		e.Type.QualifiedString(),
+		common.EnumerateWords(quotedFields, "and"),
+	)
+}
+
+func (*NonExhaustiveResourceDestructuringError) SecondaryError() string {
+	return "destructuring a resource does not call its destructor, so all fields must be matched"
+}
+
+// InvalidResourceSwitchCaseGuardError
+
+type InvalidResourceSwitchCaseGuardError struct {
+	ast.Range
+}
+
+var _ SemanticError = &InvalidResourceSwitchCaseGuardError{}
+var _ errors.UserError = &InvalidResourceSwitchCaseGuardError{}
+var _ errors.SecondaryError = &InvalidResourceSwitchCaseGuardError{}
+
+func (*InvalidResourceSwitchCaseGuardError) isSemanticError() {}
+
+func (*InvalidResourceSwitchCaseGuardError) IsUserError() {}
+
+func (e *InvalidResourceSwitchCaseGuardError) Error() string {
+	return "cannot use guard in switch over resource"
+}
+
+func (e *InvalidResourceSwitchCaseGuardError) SecondaryError() string {
+	return "the resource is moved into the case when the pattern matches, so the case must be taken"
+}
+
+// InvalidTypeParameterBoundError
+
+type InvalidTypeParameterBoundError struct {
+	Type Type
+	ast.Range
+}
+
+var _ SemanticError = &InvalidTypeParameterBoundError{}
+var _ errors.UserError = &InvalidTypeParameterBoundError{}
+var _ errors.SecondaryError = &InvalidTypeParameterBoundError{}
+
+func (*InvalidTypeParameterBoundError) isSemanticError() {}
+
+func (*InvalidTypeParameterBoundError) IsUserError() {}
+
+func (e *InvalidTypeParameterBoundError) Error() string {
+	return fmt.Sprintf(
+		"invalid type parameter bound `%s`",
+		e.Type.QualifiedString(),
+	)
+}
+
+func (e *InvalidTypeParameterBoundError) SecondaryError() string {
+	return "type parameters must be bound to a subtype of `AnyStruct`"
+}
+
+// InvalidGenericDeclarationError
+
+type InvalidGenericDeclarationError struct {
+	DeclarationKind common.DeclarationKind
+	ast.Range
+}
+
+var _ SemanticError = &InvalidGenericDeclarationError{}
+var _ errors.UserError = &InvalidGenericDeclarationError{}
+var _ errors.SecondaryError = &InvalidGenericDeclarationError{}
+
+func (*InvalidGenericDeclarationError) isSemanticError() {}
+
+func (*InvalidGenericDeclarationError) IsUserError() {}
+
+func (e *InvalidGenericDeclarationError) Error() string {
+	return fmt.Sprintf(
+		"%s declarations cannot have type parameters",
+		e.DeclarationKind.Name(),
+	)
+}
+
+func (e *InvalidGenericDeclarationError) SecondaryError() string {
+	return "only functions, structures, and resources can be generic"
+}
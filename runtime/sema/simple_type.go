@@ -137,6 +137,10 @@ func (t *SimpleType) GetNestedTypes() *StringTypeOrderedMap {
 	return t.NestedTypes
 }
 
+func (t *SimpleType) GetTypeAliases() *StringTypeOrderedMap {
+	return nil
+}
+
 func (t *SimpleType) isValueIndexableType() bool {
 	return t.ValueIndexingInfo.IsValueIndexableType
 }
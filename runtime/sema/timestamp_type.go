@@ -0,0 +1,163 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"time"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+)
+
+const TimestampTypeName = "Timestamp"
+
+// TimestampType represents the timestamp type,
+// the number of seconds since the Unix epoch (00:00:00 UTC on 1 January 1970)
+//
+var TimestampType = &SimpleType{
+	Name:                 TimestampTypeName,
+	QualifiedName:        TimestampTypeName,
+	TypeID:               TimestampTypeName,
+	tag:                  TimestampTypeTag,
+	IsInvalid:            false,
+	IsResource:           false,
+	Storable:             true,
+	Equatable:            true,
+	ExternallyReturnable: true,
+	Importable:           true,
+}
+
+// TimestampTypeMinSeconds and TimestampTypeMaxSeconds are the bounds of timestamps,
+// 0000-01-01T00:00:00Z and 9999-12-31T23:59:59Z.
+//
+// Timestamps outside of this range have no ISO-8601 representation with a four-digit year,
+// so they could not be converted to a string and parsed again.
+//
+const TimestampTypeMinSeconds int64 = -62167219200
+const TimestampTypeMaxSeconds int64 = 253402300799
+
+const TimestampTypeSecondsFieldName = "seconds"
+
+const timestampTypeSecondsFieldDocString = `
+The number of seconds since the Unix epoch (00:00:00 UTC on 1 January 1970)
+`
+
+func init() {
+	TimestampType.Members = func(t *SimpleType) map[string]MemberResolver {
+		return map[string]MemberResolver{
+			TimestampTypeSecondsFieldName: {
+				Kind: common.DeclarationKindField,
+				Resolve: func(memoryGauge common.MemoryGauge, identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicConstantFieldMember(
+						memoryGauge,
+						t,
+						identifier,
+						Int64Type,
+						timestampTypeSecondsFieldDocString,
+					)
+				},
+			},
+			ToStringFunctionName: {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(memoryGauge common.MemoryGauge, identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						memoryGauge,
+						t,
+						identifier,
+						ToStringFunctionType,
+						toStringFunctionDocString,
+					)
+				},
+			},
+		}
+	}
+}
+
+// timestampLayouts are the accepted ISO-8601 layouts of timestamps.
+// Only the UTC timezone is allowed, indicated with the optional trailing `Z`
+//
+var timestampLayouts = []string{
+	"2006-01-02T15:04:05Z",
+	"2006-01-02T15:04:05",
+}
+
+// ParseTimestamp parses the given ISO-8601 string, e.g. `2020-03-13T19:52:43Z`,
+// and returns the number of seconds since the Unix epoch.
+//
+func ParseTimestamp(s string) (int64, bool) {
+	for _, layout := range timestampLayouts {
+		t, err := time.Parse(layout, s)
+		if err == nil {
+			return t.Unix(), true
+		}
+	}
+	return 0, false
+}
+
+var TimestampConversionFunctionType = &FunctionType{
+	Parameters: []*Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "value",
+			TypeAnnotation: NewTypeAnnotation(StringType),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(TimestampType),
+	ArgumentExpressionsCheck: func(checker *Checker, argumentExpressions []ast.Expression, _ ast.Range) {
+		if len(argumentExpressions) < 1 {
+			return
+		}
+
+		stringExpression, ok := argumentExpressions[0].(*ast.StringExpression)
+		if !ok {
+			return
+		}
+
+		if _, ok := ParseTimestamp(stringExpression.Value); !ok {
+			checker.report(
+				&InvalidTimestampLiteralError{
+					Literal: stringExpression.Value,
+					Range:   ast.NewRangeFromPositioned(checker.memoryGauge, stringExpression),
+				},
+			)
+		}
+	},
+}
+
+func init() {
+	// Declare a conversion function for the timestamp type
+
+	// Check that the function is not accidentally redeclared
+
+	typeName := TimestampTypeName
+
+	if BaseValueActivation.Find(typeName) != nil {
+		panic(errors.NewUnreachableError())
+	}
+
+	BaseValueActivation.Set(
+		typeName,
+		baseFunctionVariable(
+			typeName,
+			TimestampConversionFunctionType,
+			"Converts the given ISO-8601 string in the UTC timezone, e.g. `2020-03-13T19:52:43Z`, into a timestamp",
+		),
+	)
+}
@@ -200,12 +200,32 @@ type ContainedType interface {
 	SetContainerType(containerType Type)
 }
 
-// ContainerType is a type which might have nested types
+// ContainerType is a type which might have nested types and type aliases
 //
 type ContainerType interface {
 	Type
 	IsContainerType() bool
 	GetNestedTypes() *StringTypeOrderedMap
+	GetTypeAliases() *StringTypeOrderedMap
+}
+
+// GetNestedTypeOrTypeAlias returns the type nested in the given container type,
+// or the type the type alias with the given name refers to, if any
+//
+func GetNestedTypeOrTypeAlias(containerType ContainerType, name string) (Type, bool) {
+	nestedTypes := containerType.GetNestedTypes()
+	if nestedTypes != nil {
+		if ty, ok := nestedTypes.Get(name); ok {
+			return ty, true
+		}
+	}
+
+	typeAliases := containerType.GetTypeAliases()
+	if typeAliases != nil {
+		return typeAliases.Get(name)
+	}
+
+	return nil, false
 }
 
 func VisitThisAndNested(t Type, visit func(ty Type)) {
@@ -756,12 +776,26 @@ func (t *GenericType) Unify(
 func (t *GenericType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type {
 	ty, ok := typeArguments.Get(t.TypeParameter)
 	if !ok {
+		// Type parameters declared in a program are in scope
+		// in the generic function or composite declaring them,
+		// so they resolve to themselves when they are not bound
+
+		if t.TypeParameter.declared {
+			return t
+		}
+
 		return nil
 	}
 	return ty
 }
 
 func (t *GenericType) GetMembers() map[string]MemberResolver {
+	// The members of a value of a generic type are the members of the type bound
+
+	if t.TypeParameter.declared {
+		return t.TypeParameter.TypeBound.GetMembers()
+	}
+
 	return withBuiltinMembers(t, nil)
 }
 
@@ -1401,6 +1435,18 @@ var (
 			WithTag(Word64TypeTag).
 			WithIntRange(Word64TypeMinInt, Word64TypeMaxInt)
 
+	// Word128Type represents the 128-bit unsigned integer type `Word128`
+	// which does NOT check for overflow and underflow
+	Word128Type = NewNumericType(Word128TypeName).
+			WithTag(Word128TypeTag).
+			WithIntRange(Word128TypeMinIntBig, Word128TypeMaxIntBig)
+
+	// Word256Type represents the 256-bit unsigned integer type `Word256`
+	// which does NOT check for overflow and underflow
+	Word256Type = NewNumericType(Word256TypeName).
+			WithTag(Word256TypeTag).
+			WithIntRange(Word256TypeMinIntBig, Word256TypeMaxIntBig)
+
 	// FixedPointType represents the super-type of all fixed-point types
 	FixedPointType = NewNumericType(FixedPointTypeName).
 			WithTag(FixedPointTypeTag).
@@ -1433,6 +1479,29 @@ var (
 			WithSaturatingAdd().
 			WithSaturatingSubtract().
 			WithSaturatingMultiply()
+
+	// Fix128Type represents the 128-bit signed decimal fixed-point type `Fix128`
+	// which has a scale of Fix128Scale, and checks for overflow and underflow
+	Fix128Type = NewFixedPointNumericType(Fix128TypeName).
+			WithTag(Fix128TypeTag).
+			WithIntRange(Fix128TypeMinIntBig, Fix128TypeMaxIntBig).
+			WithFractionalRange(Fix128TypeMinFractionalBig, Fix128TypeMaxFractionalBig).
+			WithScale(Fix128Scale).
+			WithSaturatingAdd().
+			WithSaturatingSubtract().
+			WithSaturatingMultiply().
+			WithSaturatingDivide()
+
+	// UFix128Type represents the 128-bit unsigned decimal fixed-point type `UFix128`
+	// which has a scale of Fix128Scale, and checks for overflow and underflow
+	UFix128Type = NewFixedPointNumericType(UFix128TypeName).
+			WithTag(UFix128TypeTag).
+			WithIntRange(UFix128TypeMinIntBig, UFix128TypeMaxIntBig).
+			WithFractionalRange(UFix128TypeMinFractionalBig, UFix128TypeMaxFractionalBig).
+			WithScale(Fix128Scale).
+			WithSaturatingAdd().
+			WithSaturatingSubtract().
+			WithSaturatingMultiply()
 )
 
 // Numeric type ranges
@@ -1520,6 +1589,12 @@ var (
 	Word64TypeMinInt = new(big.Int)
 	Word64TypeMaxInt = new(big.Int).SetUint64(math.MaxUint64)
 
+	Word128TypeMinIntBig = new(big.Int)
+	Word128TypeMaxIntBig = UInt128TypeMaxIntBig
+
+	Word256TypeMinIntBig = new(big.Int)
+	Word256TypeMaxIntBig = UInt256TypeMaxIntBig
+
 	Fix64FactorBig = new(big.Int).SetUint64(uint64(Fix64Factor))
 
 	Fix64TypeMinIntBig = fixedpoint.Fix64TypeMinIntBig
@@ -1533,6 +1608,26 @@ var (
 
 	UFix64TypeMinFractionalBig = fixedpoint.UFix64TypeMinFractionalBig
 	UFix64TypeMaxFractionalBig = fixedpoint.UFix64TypeMaxFractionalBig
+
+	Fix128FactorBig = fixedpoint.Fix128FactorBig
+
+	Fix128TypeMinBig = fixedpoint.Fix128TypeMinBig
+	Fix128TypeMaxBig = fixedpoint.Fix128TypeMaxBig
+
+	UFix128TypeMinBig = fixedpoint.UFix128TypeMinBig
+	UFix128TypeMaxBig = fixedpoint.UFix128TypeMaxBig
+
+	Fix128TypeMinIntBig = fixedpoint.Fix128TypeMinIntBig
+	Fix128TypeMaxIntBig = fixedpoint.Fix128TypeMaxIntBig
+
+	Fix128TypeMinFractionalBig = fixedpoint.Fix128TypeMinFractionalBig
+	Fix128TypeMaxFractionalBig = fixedpoint.Fix128TypeMaxFractionalBig
+
+	UFix128TypeMinIntBig = fixedpoint.UFix128TypeMinIntBig
+	UFix128TypeMaxIntBig = fixedpoint.UFix128TypeMaxIntBig
+
+	UFix128TypeMinFractionalBig = fixedpoint.UFix128TypeMinFractionalBig
+	UFix128TypeMaxFractionalBig = fixedpoint.UFix128TypeMaxFractionalBig
 )
 
 const Fix64Scale = fixedpoint.Fix64Scale
@@ -1550,6 +1645,8 @@ const UFix64TypeMaxInt = fixedpoint.UFix64TypeMaxInt
 const UFix64TypeMinFractional = fixedpoint.UFix64TypeMinFractional
 const UFix64TypeMaxFractional = fixedpoint.UFix64TypeMaxFractional
 
+const Fix128Scale = fixedpoint.Fix128Scale
+
 // ArrayType
 
 type ArrayType interface {
@@ -1620,6 +1717,86 @@ It does not modify the original array.
 If either of the parameters are out of the bounds of the array, or the indices are invalid (` + "`from > upTo`" + `), then the function will fail.
 `
 
+const arrayTypeForEachFunctionDocString = `
+Calls the given function for each element of the array, in order.
+
+The array must not be mutated while it is iterated over.
+If the array is mutated, the program aborts
+`
+
+const arrayTypeMapFunctionDocString = `
+Returns a new array containing the results of calling the given function for each element of the array, in order.
+
+The result has the same kind of array type as the original array.
+It does not modify the original array
+`
+
+const arrayTypeFilterFunctionDocString = `
+Returns a new variable-sized array containing the elements of the array for which the given function returns true, in order.
+
+It does not modify the original array
+`
+
+const arrayTypeReduceFunctionDocString = `
+Combines the elements of the array using the given function.
+
+The function is called with the initial value and the first element of the array,
+then with the result of the previous call and the next element of the array, and so on.
+Returns the result of the last call, or the initial value if the array is empty
+`
+
+const arrayTypeReverseFunctionDocString = `
+Returns a new array containing the elements of the array in reverse order.
+
+It does not modify the original array
+`
+
+const arrayTypeSortFunctionDocString = `
+Sorts the array in place, using the given function to compare elements.
+
+The function must return true if its first argument should be ordered before its second argument.
+The sort is stable, i.e. elements which are not ordered before each other retain their original order
+`
+
+// newArrayHigherOrderFunctionMemberResolver returns a member resolver
+// for a function of the given array type which is not available for arrays of resources:
+// the elements of an array of resources cannot be passed to the function argument,
+// as that would move them out of the array
+//
+func newArrayHigherOrderFunctionMemberResolver(
+	arrayType ArrayType,
+	mutating bool,
+	docString string,
+	functionType func(elementType Type) *FunctionType,
+) MemberResolver {
+	return MemberResolver{
+		Kind:     common.DeclarationKindFunction,
+		Mutating: mutating,
+		Resolve: func(memoryGauge common.MemoryGauge, identifier string, targetRange ast.Range, report func(error)) *Member {
+
+			elementType := arrayType.ElementType(false)
+
+			if elementType.IsResourceType() {
+				report(
+					&InvalidResourceArrayMemberError{
+						Name:            identifier,
+						DeclarationKind: common.DeclarationKindFunction,
+						Range:           targetRange,
+					},
+				)
+			}
+
+			return NewPublicFunctionMember(
+				memoryGauge,
+				arrayType,
+				identifier,
+				functionType(elementType),
+				docString,
+			)
+		},
+	}
+}
+
 func getArrayMembers(arrayType ArrayType) map[string]MemberResolver {
 
 	members := map[string]MemberResolver{
@@ -1713,6 +1890,54 @@ func getArrayMembers(arrayType ArrayType) map[string]MemberResolver {
 				)
 			},
 		},
+		"forEach": newArrayHigherOrderFunctionMemberResolver(
+			arrayType,
+			false,
+			arrayTypeForEachFunctionDocString,
+			func(elementType Type) *FunctionType {
+				return ArrayForEachFunctionType(elementType)
+			},
+		),
+		"map": newArrayHigherOrderFunctionMemberResolver(
+			arrayType,
+			false,
+			arrayTypeMapFunctionDocString,
+			func(_ Type) *FunctionType {
+				return ArrayMapFunctionType(arrayType)
+			},
+		),
+		"filter": newArrayHigherOrderFunctionMemberResolver(
+			arrayType,
+			false,
+			arrayTypeFilterFunctionDocString,
+			func(elementType Type) *FunctionType {
+				return ArrayFilterFunctionType(elementType)
+			},
+		),
+		"reduce": newArrayHigherOrderFunctionMemberResolver(
+			arrayType,
+			false,
+			arrayTypeReduceFunctionDocString,
+			func(elementType Type) *FunctionType {
+				return ArrayReduceFunctionType(elementType)
+			},
+		),
+		"reverse": newArrayHigherOrderFunctionMemberResolver(
+			arrayType,
+			false,
+			arrayTypeReverseFunctionDocString,
+			func(_ Type) *FunctionType {
+				return ArrayReverseFunctionType(arrayType)
+			},
+		),
+		"sort": newArrayHigherOrderFunctionMemberResolver(
+			arrayType,
+			true,
+			arrayTypeSortFunctionDocString,
+			func(elementType Type) *FunctionType {
+				return ArraySortFunctionType(elementType)
+			},
+		),
 	}
 
 	// TODO: maybe still return members but report a helpful error?
@@ -2025,6 +2250,186 @@ func ArraySliceFunctionType(elementType Type) *FunctionType {
 	}
 }
 
+func ArrayForEachFunctionType(elementType Type) *FunctionType {
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:      ArgumentLabelNotRequired,
+				Identifier: "function",
+				TypeAnnotation: NewTypeAnnotation(
+					&FunctionType{
+						Parameters: []*Parameter{
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "element",
+								TypeAnnotation: NewTypeAnnotation(elementType),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(VoidType),
+					},
+				),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(VoidType),
+	}
+}
+
+func ArrayMapFunctionType(arrayType ArrayType) *FunctionType {
+	typeParameter := &TypeParameter{
+		Name:      "T",
+		TypeBound: AnyStructType,
+	}
+
+	resultElementType := &GenericType{
+		TypeParameter: typeParameter,
+	}
+
+	var resultType ArrayType
+	if constantSizedType, ok := arrayType.(*ConstantSizedType); ok {
+		resultType = &ConstantSizedType{
+			Type: resultElementType,
+			Size: constantSizedType.Size,
+		}
+	} else {
+		resultType = &VariableSizedType{
+			Type: resultElementType,
+		}
+	}
+
+	return &FunctionType{
+		TypeParameters: []*TypeParameter{
+			typeParameter,
+		},
+		Parameters: []*Parameter{
+			{
+				Label:      ArgumentLabelNotRequired,
+				Identifier: "transform",
+				TypeAnnotation: NewTypeAnnotation(
+					&FunctionType{
+						Parameters: []*Parameter{
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "element",
+								TypeAnnotation: NewTypeAnnotation(arrayType.ElementType(false)),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(resultElementType),
+					},
+				),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(resultType),
+	}
+}
+
+func ArrayFilterFunctionType(elementType Type) *FunctionType {
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:      ArgumentLabelNotRequired,
+				Identifier: "predicate",
+				TypeAnnotation: NewTypeAnnotation(
+					&FunctionType{
+						Parameters: []*Parameter{
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "element",
+								TypeAnnotation: NewTypeAnnotation(elementType),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(BoolType),
+					},
+				),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			&VariableSizedType{
+				Type: elementType,
+			},
+		),
+	}
+}
+
+func ArrayReduceFunctionType(elementType Type) *FunctionType {
+	typeParameter := &TypeParameter{
+		Name:      "T",
+		TypeBound: AnyStructType,
+	}
+
+	resultType := &GenericType{
+		TypeParameter: typeParameter,
+	}
+
+	return &FunctionType{
+		TypeParameters: []*TypeParameter{
+			typeParameter,
+		},
+		Parameters: []*Parameter{
+			{
+				Identifier:     "initial",
+				TypeAnnotation: NewTypeAnnotation(resultType),
+			},
+			{
+				Label:      ArgumentLabelNotRequired,
+				Identifier: "combine",
+				TypeAnnotation: NewTypeAnnotation(
+					&FunctionType{
+						Parameters: []*Parameter{
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "result",
+								TypeAnnotation: NewTypeAnnotation(resultType),
+							},
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "element",
+								TypeAnnotation: NewTypeAnnotation(elementType),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(resultType),
+					},
+				),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(resultType),
+	}
+}
+
+func ArrayReverseFunctionType(arrayType ArrayType) *FunctionType {
+	return &FunctionType{
+		ReturnTypeAnnotation: NewTypeAnnotation(arrayType),
+	}
+}
+
+func ArraySortFunctionType(elementType Type) *FunctionType {
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:      "by",
+				Identifier: "isOrderedBefore",
+				TypeAnnotation: NewTypeAnnotation(
+					&FunctionType{
+						Parameters: []*Parameter{
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "a",
+								TypeAnnotation: NewTypeAnnotation(elementType),
+							},
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "b",
+								TypeAnnotation: NewTypeAnnotation(elementType),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(BoolType),
+					},
+				),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(VoidType),
+	}
+}
+
 // VariableSizedType is a variable sized array type
 type VariableSizedType struct {
 	Type                Type
@@ -2335,6 +2740,9 @@ type Parameter struct {
 	Label          string
 	Identifier     string
 	TypeAnnotation *TypeAnnotation
+	// DefaultArgument is the expression which is evaluated
+	// if an invocation provides no argument for the parameter, if any
+	DefaultArgument ast.Expression
 }
 
 func (p *Parameter) String() string {
@@ -2355,6 +2763,17 @@ func (p *Parameter) QualifiedString() string {
 	)
 }
 
+// DefaultArgumentString returns the source representation of the parameter's default argument,
+// e.g. `self.owner` for the parameter `recipient: Address = self.owner`,
+// or the empty string if the parameter has no default argument
+//
+func (p *Parameter) DefaultArgumentString() string {
+	if p.DefaultArgument == nil {
+		return ""
+	}
+	return p.DefaultArgument.String()
+}
+
 // EffectiveArgumentLabel returns the effective argument label that
 // an argument in a call must use:
 // If no argument label is declared for parameter,
@@ -2373,6 +2792,10 @@ type TypeParameter struct {
 	Name      string
 	TypeBound Type
 	Optional  bool
+	// declared is true if the type parameter is declared in a program,
+	// e.g. `T` in `fun firstOf<T>(_ xs: [T]): T?`,
+	// instead of being a type parameter of a built-in function
+	declared bool
 }
 
 func (p TypeParameter) string(typeFormatter func(Type) string) string {
@@ -2489,7 +2912,10 @@ type FunctionType struct {
 	ReturnTypeAnnotation     *TypeAnnotation
 	RequiredArgumentCount    *int
 	ArgumentExpressionsCheck ArgumentExpressionsCheck
-	Members                  *StringMemberOrderedMap
+	// FixedPointLiteralArgumentType is the expected type of fixed-point literal arguments, if any,
+	// instead of the parameter type, e.g. the target type of a fixed-point conversion function
+	FixedPointLiteralArgumentType Type
+	Members                       *StringMemberOrderedMap
 }
 
 func RequiredArgumentCount(count int) *int {
@@ -2608,6 +3034,24 @@ func (t *FunctionType) Equal(other Type) bool {
 		}
 	}
 
+	// The type parameters of the other function type are equal,
+	// refer to the type parameters of this function type instead
+
+	if len(t.TypeParameters) > 0 {
+		typeArguments := &TypeParameterTypeOrderedMap{}
+		for i, typeParameter := range t.TypeParameters {
+			typeArguments.Set(
+				otherFunction.TypeParameters[i],
+				&GenericType{TypeParameter: typeParameter},
+			)
+		}
+
+		otherFunction, ok = otherFunction.Resolve(typeArguments).(*FunctionType)
+		if !ok {
+			return false
+		}
+	}
+
 	// parameters
 
 	if len(t.Parameters) != len(otherFunction.Parameters) {
@@ -2782,9 +3226,10 @@ func (t *FunctionType) RewriteWithRestrictedTypes() (Type, bool) {
 				rewrittenParameterType, ok := rewrittenParameterTypes[parameter]
 				if ok {
 					rewrittenParameters[i] = &Parameter{
-						Label:          parameter.Label,
-						Identifier:     parameter.Identifier,
-						TypeAnnotation: NewTypeAnnotation(rewrittenParameterType),
+						Label:           parameter.Label,
+						Identifier:      parameter.Identifier,
+						TypeAnnotation:  NewTypeAnnotation(rewrittenParameterType),
+						DefaultArgument: parameter.DefaultArgument,
 					}
 				} else {
 					rewrittenParameters[i] = parameter
@@ -2875,7 +3320,8 @@ func (t *FunctionType) Unify(
 
 func (t *FunctionType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type {
 
-	// TODO: type parameters ?
+	// NOTE: the type parameters of the function type itself are not resolved,
+	// the resolved function type is still generic
 
 	// parameters
 
@@ -2889,9 +3335,10 @@ func (t *FunctionType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type
 
 		newParameters = append(newParameters,
 			&Parameter{
-				Label:          parameter.Label,
-				Identifier:     parameter.Identifier,
-				TypeAnnotation: NewTypeAnnotation(newParameterType),
+				Label:           parameter.Label,
+				Identifier:      parameter.Identifier,
+				TypeAnnotation:  NewTypeAnnotation(newParameterType),
+				DefaultArgument: parameter.DefaultArgument,
 			},
 		)
 	}
@@ -2904,6 +3351,7 @@ func (t *FunctionType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type
 	}
 
 	return &FunctionType{
+		TypeParameters:        t.TypeParameters,
 		Parameters:            newParameters,
 		ReturnTypeAnnotation:  NewTypeAnnotation(newReturnType),
 		RequiredArgumentCount: t.RequiredArgumentCount,
@@ -2966,10 +3414,14 @@ func init() {
 		&CapabilityType{},
 		DeployedContractType,
 		BlockType,
+		TimestampType,
+		DurationType,
 		AccountKeyType,
 		PublicKeyType,
 		SignatureAlgorithmType,
 		HashAlgorithmType,
+		HashableType,
+		EquatableType,
 	)
 
 	for _, ty := range types {
@@ -3013,10 +3465,12 @@ var BaseValueActivation = NewVariableActivation(nil)
 
 var AllSignedFixedPointTypes = []Type{
 	Fix64Type,
+	Fix128Type,
 }
 
 var AllUnsignedFixedPointTypes = []Type{
 	UFix64Type,
+	UFix128Type,
 }
 
 var AllFixedPointTypes = append(
@@ -3052,6 +3506,8 @@ var AllUnsignedIntegerTypes = []Type{
 	Word16Type,
 	Word32Type,
 	Word64Type,
+	Word128Type,
+	Word256Type,
 }
 
 var AllIntegerTypes = append(
@@ -3081,6 +3537,14 @@ const numberTypeMaxFieldDocString = `The maximum integer of this type`
 const fixedPointNumberTypeMinFieldDocString = `The minimum fixed-point value of this type`
 const fixedPointNumberTypeMaxFieldDocString = `The maximum fixed-point value of this type`
 
+const NumberTypeFromStringFunctionName = "fromString"
+
+const numberTypeFromStringFunctionDocString = `
+Parses the given string as a number of this type, using the same syntax as number literals.
+
+Returns nil if the string is not a valid literal, or if the number is outside of the bounds of this type
+`
+
 const numberConversionFunctionDocStringSuffix = `
 The value must be within the bounds of this type.
 If a value is passed that is outside the bounds, the program aborts.`
@@ -3170,6 +3634,13 @@ func init() {
 				}
 			}
 
+			addMember(NewUnmeteredPublicFunctionMember(
+				functionType,
+				NumberTypeFromStringFunctionName,
+				NumberTypeFromStringFunctionType(numberType),
+				numberTypeFromStringFunctionDocString,
+			))
+
 			BaseValueActivation.Set(
 				typeName,
 				baseFunctionVariable(
@@ -3185,6 +3656,15 @@ func init() {
 }
 
 func NumberConversionFunctionType(numberType Type) *FunctionType {
+
+	// Fixed-point literals are inferred to have the target type of the conversion,
+	// so they are not limited to the range and scale of the default fixed-point types
+
+	var fixedPointLiteralArgumentType Type
+	if IsSubType(numberType, FixedPointType) {
+		fixedPointLiteralArgumentType = numberType
+	}
+
 	return &FunctionType{
 		Parameters: []*Parameter{
 			{
@@ -3193,23 +3673,41 @@ func NumberConversionFunctionType(numberType Type) *FunctionType {
 				TypeAnnotation: NewTypeAnnotation(NumberType),
 			},
 		},
-		ReturnTypeAnnotation:     NewTypeAnnotation(numberType),
-		ArgumentExpressionsCheck: numberFunctionArgumentExpressionsChecker(numberType),
+		ReturnTypeAnnotation:          NewTypeAnnotation(numberType),
+		ArgumentExpressionsCheck:      numberFunctionArgumentExpressionsChecker(numberType),
+		FixedPointLiteralArgumentType: fixedPointLiteralArgumentType,
 	}
 }
 
-func numberConversionDocString(targetDescription string) string {
-	return fmt.Sprintf(
-		"Converts the given number to %s. %s",
-		targetDescription,
-		numberConversionFunctionDocStringSuffix,
-	)
-}
-
-func baseFunctionVariable(name string, ty *FunctionType, docString string) *Variable {
-	return &Variable{
-		Identifier:      name,
-		DeclarationKind: common.DeclarationKindFunction,
+func NumberTypeFromStringFunctionType(numberType Type) *FunctionType {
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "input",
+				TypeAnnotation: NewTypeAnnotation(StringType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			&OptionalType{
+				Type: numberType,
+			},
+		),
+	}
+}
+
+func numberConversionDocString(targetDescription string) string {
+	return fmt.Sprintf(
+		"Converts the given number to %s. %s",
+		targetDescription,
+		numberConversionFunctionDocStringSuffix,
+	)
+}
+
+func baseFunctionVariable(name string, ty *FunctionType, docString string) *Variable {
+	return &Variable{
+		Identifier:      name,
+		DeclarationKind: common.DeclarationKindFunction,
 		ArgumentLabels:  ty.ArgumentLabels(),
 		IsConstant:      true,
 		IsBaseValue:     true,
@@ -3284,7 +3782,10 @@ func numberFunctionArgumentExpressionsChecker(targetType Type) ArgumentExpressio
 			}
 
 		case *ast.FixedPointExpression:
-			if CheckFixedPointLiteral(nil, argument, targetType, checker.report) {
+			// The literal was already checked if it was inferred to have the target type
+			if checker.Elaboration.FixedPointExpression[argument] == targetType ||
+				CheckFixedPointLiteral(nil, argument, targetType, checker.report) {
+
 				if checker.extendedElaboration {
 					checker.Elaboration.NumberConversionArgumentTypes[argument] = struct {
 						Type  Type
@@ -3332,6 +3833,27 @@ func init() {
 		StringTypeEncodeHexFunctionDocString,
 	))
 
+	addMember(NewUnmeteredPublicFunctionMember(
+		functionType,
+		StringTypeJoinFunctionName,
+		StringTypeJoinFunctionType,
+		StringTypeJoinFunctionDocString,
+	))
+
+	addMember(NewUnmeteredPublicFunctionMember(
+		functionType,
+		StringTypeFromUTF8FunctionName,
+		StringTypeFromUTF8FunctionType,
+		StringTypeFromUTF8FunctionDocString,
+	))
+
+	addMember(NewUnmeteredPublicFunctionMember(
+		functionType,
+		StringTypeFromCharactersFunctionName,
+		StringTypeFromCharactersFunctionType,
+		StringTypeFromCharactersFunctionDocString,
+	))
+
 	BaseValueActivation.Set(
 		typeName,
 		baseFunctionVariable(
@@ -3459,16 +3981,39 @@ type CompositeType struct {
 	memberResolvers                     map[string]MemberResolver
 	memberResolversOnce                 sync.Once
 	Fields                              []string
-	// TODO: add support for overloaded initializers
+	// ConstructorParameters are the parameters of the first initializer
 	ConstructorParameters []*Parameter
-	nestedTypes           *StringTypeOrderedMap
-	containerType         Type
-	EnumRawType           Type
+	// InitializerOverloads are all initializers, in declaration order,
+	// if more than one initializer is declared
+	InitializerOverloads []*InitializerOverload
+	// InitializerHasConditions is true if the first initializer has pre- or post-conditions,
+	// which are conditions of conforming types if this type is a type requirement
+	InitializerHasConditions bool
+	// HasDestructor is true if a destructor is declared
+	HasDestructor bool
+	nestedTypes   *StringTypeOrderedMap
+	typeAliases   *StringTypeOrderedMap
+	containerType Type
+	EnumRawType   Type
+	// EnumCases are the names of the cases of an enum, in declaration order
+	EnumCases             []string
+	NewtypeUnderlyingType Type
 	hasComputedMembers    bool
 
 	// Only applicable for native composite types.
 	importable bool
 
+	// typeParameters are the type parameters of a generic composite type
+	typeParameters []*TypeParameter
+	// genericType is the generic composite type that was instantiated,
+	// and typeArguments are the type arguments it was instantiated with.
+	// Only set for instantiated generic composite types, e.g. `Box<Int>`
+	genericType   *CompositeType
+	typeArguments []Type
+	// instances are the instantiations of a generic composite type, by type ID
+	instances     map[TypeID]*CompositeType
+	instancesLock sync.Mutex
+
 	cachedIdentifiers *struct {
 		TypeID              TypeID
 		QualifiedIdentifier string
@@ -3487,16 +4032,50 @@ func (t *CompositeType) ExplicitInterfaceConformanceSet() *InterfaceSet {
 
 func (t *CompositeType) initializeExplicitInterfaceConformanceSet() {
 	t.explicitInterfaceConformanceSetOnce.Do(func() {
-		// TODO: also include conformances' conformances recursively
-		//   once interface can have conformances
+		// NOTE: also include the conformances' conformances, recursively
 
 		t.explicitInterfaceConformanceSet = NewInterfaceSet()
-		for _, conformance := range t.ExplicitInterfaceConformances {
+		for _, conformance := range t.EffectiveInterfaceConformances() {
 			t.explicitInterfaceConformanceSet.Add(conformance)
 		}
 	})
 }
 
+// EffectiveInterfaceConformances returns the explicit interface conformances of the composite type,
+// and recursively, the interfaces these interfaces conform to.
+// Each interface is only included once, in depth-first order.
+//
+func (t *CompositeType) EffectiveInterfaceConformances() []*InterfaceType {
+	return appendEffectiveInterfaceConformances(
+		nil,
+		map[*InterfaceType]struct{}{},
+		t.ExplicitInterfaceConformances,
+	)
+}
+
+func appendEffectiveInterfaceConformances(
+	result []*InterfaceType,
+	seen map[*InterfaceType]struct{},
+	conformances []*InterfaceType,
+) []*InterfaceType {
+	for _, conformance := range conformances {
+		if _, ok := seen[conformance]; ok {
+			continue
+		}
+		seen[conformance] = struct{}{}
+
+		result = append(result, conformance)
+
+		result = appendEffectiveInterfaceConformances(
+			result,
+			seen,
+			conformance.ExplicitInterfaceConformances,
+		)
+	}
+
+	return result
+}
+
 func (t *CompositeType) addImplicitTypeRequirementConformance(typeRequirement *CompositeType) {
 	t.ImplicitTypeRequirementConformances =
 		append(t.ImplicitTypeRequirementConformances, typeRequirement)
@@ -3505,13 +4084,33 @@ func (t *CompositeType) addImplicitTypeRequirementConformance(typeRequirement *C
 func (*CompositeType) IsType() {}
 
 func (t *CompositeType) String() string {
+	if t.genericType != nil {
+		return formatInstantiatedType(t.Identifier, t.typeArguments, Type.String)
+	}
 	return t.Identifier
 }
 
 func (t *CompositeType) QualifiedString() string {
+	if t.genericType != nil {
+		return formatInstantiatedType(t.QualifiedIdentifier(), t.typeArguments, Type.QualifiedString)
+	}
 	return t.QualifiedIdentifier()
 }
 
+func formatInstantiatedType(identifier string, typeArguments []Type, typeFormatter func(Type) string) string {
+	var builder strings.Builder
+	builder.WriteString(identifier)
+	builder.WriteRune('<')
+	for i, typeArgument := range typeArguments {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(typeFormatter(typeArgument))
+	}
+	builder.WriteRune('>')
+	return builder.String()
+}
+
 func (t *CompositeType) GetContainerType() Type {
 	return t.containerType
 }
@@ -3578,6 +4177,19 @@ func (t *CompositeType) initializeIdentifiers() {
 		typeID = t.Location.TypeID(nil, identifier)
 	}
 
+	// The type ID of an instantiated generic composite type
+	// includes the type IDs of the type arguments, e.g. `S.test.Box<Int>`
+
+	if t.genericType != nil {
+		typeID = TypeID(formatInstantiatedType(
+			string(typeID),
+			t.typeArguments,
+			func(typeArgument Type) string {
+				return string(typeArgument.ID())
+			},
+		))
+	}
+
 	t.cachedIdentifiers = &struct {
 		TypeID              TypeID
 		QualifiedIdentifier string
@@ -3615,12 +4227,13 @@ func (t *CompositeType) IsStorable(results map[*Member]bool) bool {
 		return false
 	}
 
-	// Only structures, resources, and enums can be stored
+	// Only structures, resources, enums, and newtypes can be stored
 
 	switch t.Kind {
 	case common.CompositeKindStructure,
 		common.CompositeKindResource,
-		common.CompositeKindEnum:
+		common.CompositeKindEnum,
+		common.CompositeKindNewtype:
 		break
 	default:
 		return false
@@ -3649,11 +4262,12 @@ func (t *CompositeType) IsImportable(results map[*Member]bool) bool {
 		return t.importable
 	}
 
-	// Only structures and enums can be imported
+	// Only structures, enums, and newtypes can be imported
 
 	switch t.Kind {
 	case common.CompositeKindStructure,
-		common.CompositeKindEnum:
+		common.CompositeKindEnum,
+		common.CompositeKindNewtype:
 		break
 	default:
 		return false
@@ -3672,12 +4286,13 @@ func (t *CompositeType) IsImportable(results map[*Member]bool) bool {
 }
 
 func (t *CompositeType) IsExternallyReturnable(results map[*Member]bool) bool {
-	// Only structures, resources, and enums can be stored
+	// Only structures, resources, enums, and newtypes can be stored
 
 	switch t.Kind {
 	case common.CompositeKindStructure,
 		common.CompositeKindResource,
-		common.CompositeKindEnum:
+		common.CompositeKindEnum,
+		common.CompositeKindNewtype:
 		break
 	default:
 		return false
@@ -3695,9 +4310,39 @@ func (t *CompositeType) IsExternallyReturnable(results map[*Member]bool) bool {
 	return true
 }
 
+// IsHashable returns true if the composite type is a structure
+// which conforms to the built-in Hashable interface
+//
+func (t *CompositeType) IsHashable() bool {
+	return t.Kind == common.CompositeKindStructure &&
+		t.ExplicitInterfaceConformanceSet().Includes(HashableType)
+}
+
+// IsUserEquatable returns true if the composite type is a structure
+// which conforms to the built-in Equatable interface
+//
+func (t *CompositeType) IsUserEquatable() bool {
+	return t.Kind == common.CompositeKindStructure &&
+		t.ExplicitInterfaceConformanceSet().Includes(EquatableType)
+}
+
 func (t *CompositeType) IsEquatable() bool {
 	// TODO: add support for more composite kinds
-	return t.Kind == common.CompositeKindEnum
+	switch t.Kind {
+	case common.CompositeKindEnum:
+		return true
+
+	case common.CompositeKindStructure:
+		return t.IsUserEquatable()
+
+	case common.CompositeKindNewtype:
+		// Newtypes are equatable if their underlying type is
+		return t.NewtypeUnderlyingType != nil &&
+			t.NewtypeUnderlyingType.IsEquatable()
+
+	default:
+		return false
+	}
 }
 
 func (*CompositeType) TypeAnnotationState() TypeAnnotationState {
@@ -3710,14 +4355,16 @@ func (t *CompositeType) RewriteWithRestrictedTypes() (result Type, rewritten boo
 
 func (t *CompositeType) InterfaceType() *InterfaceType {
 	return &InterfaceType{
-		Location:              t.Location,
-		Identifier:            t.Identifier,
-		CompositeKind:         t.Kind,
-		Members:               t.Members,
-		Fields:                t.Fields,
-		InitializerParameters: t.ConstructorParameters,
-		containerType:         t.containerType,
-		nestedTypes:           t.nestedTypes,
+		Location:                 t.Location,
+		Identifier:               t.Identifier,
+		CompositeKind:            t.Kind,
+		Members:                  t.Members,
+		Fields:                   t.Fields,
+		InitializerParameters:    t.ConstructorParameters,
+		InitializerHasConditions: t.InitializerHasConditions,
+		containerType:            t.containerType,
+		nestedTypes:              t.nestedTypes,
+		typeAliases:              t.typeAliases,
 	}
 }
 
@@ -3726,7 +4373,7 @@ func (t *CompositeType) TypeRequirements() []*CompositeType {
 	var typeRequirements []*CompositeType
 
 	if containerComposite, ok := t.containerType.(*CompositeType); ok {
-		for _, conformance := range containerComposite.ExplicitInterfaceConformances {
+		for _, conformance := range containerComposite.EffectiveInterfaceConformances() {
 			ty, ok := conformance.nestedTypes.Get(t.Identifier)
 			if !ok {
 				continue
@@ -3744,15 +4391,283 @@ func (t *CompositeType) TypeRequirements() []*CompositeType {
 	return typeRequirements
 }
 
-func (*CompositeType) Unify(_ Type, _ *TypeParameterTypeOrderedMap, _ func(err error), _ ast.Range) bool {
-	// TODO:
-	return false
+func (t *CompositeType) Unify(
+	other Type,
+	typeParameters *TypeParameterTypeOrderedMap,
+	report func(err error),
+	outerRange ast.Range,
+) bool {
+
+	// Only instantiations of generic composite types can be unified,
+	// by unifying their type arguments
+
+	typeArguments := t.instanceTypeArguments()
+	if len(typeArguments) == 0 {
+		return false
+	}
+
+	otherComposite, ok := other.(*CompositeType)
+	if !ok || otherComposite.generic() != t.generic() {
+		return false
+	}
+
+	otherTypeArguments := otherComposite.instanceTypeArguments()
+
+	result := false
+
+	for i, typeArgument := range typeArguments {
+		unified := typeArgument.Unify(
+			otherTypeArguments[i],
+			typeParameters,
+			report,
+			outerRange,
+		)
+		result = result || unified
+	}
+
+	return result
+}
+
+func (t *CompositeType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type {
+	instanceTypeArguments := t.instanceTypeArguments()
+	if len(instanceTypeArguments) == 0 {
+		return t
+	}
+
+	resolvedTypeArguments := make([]Type, len(instanceTypeArguments))
+	for i, typeArgument := range instanceTypeArguments {
+		resolvedTypeArgument := typeArgument.Resolve(typeArguments)
+		if resolvedTypeArgument == nil {
+			return nil
+		}
+		resolvedTypeArguments[i] = resolvedTypeArgument
+	}
+
+	return t.generic().instantiate(resolvedTypeArguments)
 }
 
-func (t *CompositeType) Resolve(_ *TypeParameterTypeOrderedMap) Type {
+// generic returns the generic composite type
+// if this composite type is an instantiated generic composite type,
+// or the composite type itself otherwise
+//
+func (t *CompositeType) generic() *CompositeType {
+	if t.genericType != nil {
+		return t.genericType
+	}
 	return t
 }
 
+// instanceTypeArguments returns the type arguments of an instantiated generic composite type.
+// The type arguments of the generic composite type itself are its type parameters,
+// i.e. inside the declaration `struct Box<T>`, the type `Box` is `Box<T>`
+//
+func (t *CompositeType) instanceTypeArguments() []Type {
+	if t.genericType != nil {
+		return t.typeArguments
+	}
+
+	if len(t.typeParameters) == 0 {
+		return nil
+	}
+
+	typeArguments := make([]Type, len(t.typeParameters))
+	for i, typeParameter := range t.typeParameters {
+		typeArguments[i] = &GenericType{TypeParameter: typeParameter}
+	}
+	return typeArguments
+}
+
+// TypeParameters returns the type parameters of a generic composite type
+//
+func (t *CompositeType) TypeParameters() []*TypeParameter {
+	return t.generic().typeParameters
+}
+
+// TypeArguments returns the type arguments of an instantiated generic composite type
+//
+func (t *CompositeType) TypeArguments() []Type {
+	return t.typeArguments
+}
+
+// BaseType returns the generic composite type of an instantiated generic composite type
+//
+func (t *CompositeType) BaseType() Type {
+	if t.genericType == nil {
+		return nil
+	}
+	return t.genericType
+}
+
+func (t *CompositeType) Instantiate(typeArguments []Type, _ func(err error)) Type {
+	return t.generic().instantiate(typeArguments)
+}
+
+// instantiate instantiates the generic composite type with the given type arguments.
+// Instantiations are cached, so the members of an instantiation are only resolved once
+//
+func (t *CompositeType) instantiate(typeArguments []Type) *CompositeType {
+
+	if len(typeArguments) != len(t.typeParameters) {
+		return t
+	}
+
+	// Instantiating a generic composite type with its own type parameters,
+	// e.g. `Box<T>` inside of the declaration `struct Box<T>`,
+	// results in the generic composite type itself
+
+	isGenericType := true
+	for i, typeArgument := range typeArguments {
+		genericType, ok := typeArgument.(*GenericType)
+		if !ok || genericType.TypeParameter != t.typeParameters[i] {
+			isGenericType = false
+			break
+		}
+	}
+	if isGenericType {
+		return t
+	}
+
+	instance := &CompositeType{
+		Location:                            t.Location,
+		Identifier:                          t.Identifier,
+		Kind:                                t.Kind,
+		ExplicitInterfaceConformances:       t.ExplicitInterfaceConformances,
+		ImplicitTypeRequirementConformances: t.ImplicitTypeRequirementConformances,
+		Fields:                              t.Fields,
+		containerType:                       t.containerType,
+		HasDestructor:                       t.HasDestructor,
+		hasComputedMembers:                  t.hasComputedMembers,
+		importable:                          t.importable,
+		genericType:                         t,
+		typeArguments:                       typeArguments,
+	}
+
+	typeID := instance.ID()
+
+	t.instancesLock.Lock()
+
+	if existingInstance, ok := t.instances[typeID]; ok {
+		t.instancesLock.Unlock()
+		return existingInstance
+	}
+
+	if t.instances == nil {
+		t.instances = map[TypeID]*CompositeType{}
+	}
+	t.instances[typeID] = instance
+
+	t.instancesLock.Unlock()
+
+	// NOTE: resolve the members after caching the instance,
+	// as the members might refer to the instance itself
+
+	instance.resolveMembers()
+
+	return instance
+}
+
+// resolveInstanceMembers resolves the members of all instantiations of the generic composite type.
+// Composite types might be instantiated before the members of the generic composite type are declared
+//
+func (t *CompositeType) resolveInstanceMembers() {
+	t.instancesLock.Lock()
+	instances := make([]*CompositeType, 0, len(t.instances))
+	for _, instance := range t.instances { //nolint:maprangecheck
+		instances = append(instances, instance)
+	}
+	t.instancesLock.Unlock()
+
+	for _, instance := range instances {
+		instance.ExplicitInterfaceConformances = t.ExplicitInterfaceConformances
+		instance.ImplicitTypeRequirementConformances = t.ImplicitTypeRequirementConformances
+		instance.Fields = t.Fields
+		instance.resolveMembers()
+	}
+}
+
+// resolveMembers resolves the members of an instantiated generic composite type
+// by substituting the type parameters of the generic composite type
+// with the type arguments of the instantiation
+//
+func (t *CompositeType) resolveMembers() {
+	genericType := t.genericType
+
+	typeArguments := &TypeParameterTypeOrderedMap{}
+	for i, typeParameter := range genericType.typeParameters {
+		typeArguments.Set(typeParameter, t.typeArguments[i])
+	}
+
+	resolveType := func(ty Type) Type {
+		resolvedType := ty.Resolve(typeArguments)
+		if resolvedType == nil {
+			return ty
+		}
+		return resolvedType
+	}
+
+	resolveParameters := func(parameters []*Parameter) []*Parameter {
+		resolvedParameters := make([]*Parameter, len(parameters))
+		for i, parameter := range parameters {
+			resolvedParameters[i] = &Parameter{
+				Label:           parameter.Label,
+				Identifier:      parameter.Identifier,
+				TypeAnnotation:  NewTypeAnnotation(resolveType(parameter.TypeAnnotation.Type)),
+				DefaultArgument: parameter.DefaultArgument,
+			}
+		}
+		return resolvedParameters
+	}
+
+	resolvedMembers := map[*Member]*Member{}
+
+	resolveMember := func(member *Member) *Member {
+		if resolvedMember, ok := resolvedMembers[member]; ok {
+			return resolvedMember
+		}
+
+		resolvedMember := *member
+		resolvedMember.ContainerType = t
+		resolvedMember.TypeAnnotation = &TypeAnnotation{
+			IsResource: member.TypeAnnotation.IsResource,
+			Type:       resolveType(member.TypeAnnotation.Type),
+		}
+		resolvedMembers[member] = &resolvedMember
+		return &resolvedMember
+	}
+
+	members := &StringMemberOrderedMap{}
+
+	genericType.Members.Foreach(func(name string, member *Member) {
+		resolvedMember := resolveMember(member)
+
+		if len(member.Overloads) > 0 {
+			resolvedOverloads := make([]*Member, len(member.Overloads))
+			for i, overload := range member.Overloads {
+				resolvedOverloads[i] = resolveMember(overload)
+			}
+			resolvedMember.Overloads = resolvedOverloads
+		}
+
+		members.Set(name, resolvedMember)
+	})
+
+	t.Members = members
+
+	t.ConstructorParameters = resolveParameters(genericType.ConstructorParameters)
+
+	if len(genericType.InitializerOverloads) > 0 {
+		initializerOverloads := make([]*InitializerOverload, len(genericType.InitializerOverloads))
+		for i, overload := range genericType.InitializerOverloads {
+			initializerOverloads[i] = &InitializerOverload{
+				Identifier:     overload.Identifier,
+				ArgumentLabels: overload.ArgumentLabels,
+				Parameters:     resolveParameters(overload.Parameters),
+			}
+		}
+		t.InitializerOverloads = initializerOverloads
+	}
+}
+
 func (t *CompositeType) IsContainerType() bool {
 	return t.nestedTypes != nil
 }
@@ -3761,6 +4676,10 @@ func (t *CompositeType) GetNestedTypes() *StringTypeOrderedMap {
 	return t.nestedTypes
 }
 
+func (t *CompositeType) GetTypeAliases() *StringTypeOrderedMap {
+	return t.typeAliases
+}
+
 func (t *CompositeType) initializeMemberResolvers() {
 	t.memberResolversOnce.Do(func() {
 		members := make(map[string]MemberResolver, t.Members.Len())
@@ -3809,6 +4728,21 @@ func (t *CompositeType) FieldPosition(name string, declaration *ast.CompositeDec
 	return pos
 }
 
+// DeclaredFields returns the names of the fields declared in the composite declaration,
+// in declaration order, i.e. without the predeclared fields, like `uuid` and `owner`
+//
+func (t *CompositeType) DeclaredFields() []string {
+	fields := make([]string, 0, len(t.Fields))
+	for _, name := range t.Fields {
+		member, ok := t.Members.Get(name)
+		if ok && member.Predeclared {
+			continue
+		}
+		fields = append(fields, name)
+	}
+	return fields
+}
+
 // Member
 
 type Member struct {
@@ -3825,6 +4759,70 @@ type Member struct {
 	// IgnoreInSerialization fields are ignored in serialization
 	IgnoreInSerialization bool
 	DocString             string
+	// Overloads are all declarations of an overloaded function, in declaration order,
+	// including the member itself. Only set for the first declaration
+	Overloads []*Member
+	// OverloadIdentifier distinguishes the function from other functions with the same name,
+	// e.g. `transfer(from:amount:)`. Only set for overloaded functions and interface functions
+	OverloadIdentifier string
+}
+
+// Overload returns the declaration of the member
+// which has the given argument labels, if any
+//
+func (m *Member) Overload(argumentLabels []string) *Member {
+	overloads := m.Overloads
+	if len(overloads) == 0 {
+		overloads = []*Member{m}
+	}
+
+	for _, overload := range overloads {
+		if argumentLabelsEqual(overload.ArgumentLabels, argumentLabels) {
+			return overload
+		}
+	}
+
+	return nil
+}
+
+// InitializerOverload is one of multiple initializers of a composite
+//
+type InitializerOverload struct {
+	// Identifier distinguishes the initializer from the other initializers,
+	// e.g. `init(name:symbol:)`
+	Identifier     string
+	ArgumentLabels []string
+	Parameters     []*Parameter
+}
+
+// FunctionOverloadIdentifier returns the identifier of the function with the given name
+// and the given argument labels, which distinguishes it from its overloads,
+// e.g. `transfer(from:amount:)`
+//
+func FunctionOverloadIdentifier(name string, argumentLabels []string) string {
+	var builder strings.Builder
+	builder.WriteString(name)
+	builder.WriteByte('(')
+	for _, argumentLabel := range argumentLabels {
+		builder.WriteString(argumentLabel)
+		builder.WriteByte(':')
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+func argumentLabelsEqual(argumentLabels, otherArgumentLabels []string) bool {
+	if len(argumentLabels) != len(otherArgumentLabels) {
+		return false
+	}
+
+	for i, argumentLabel := range argumentLabels {
+		if argumentLabel != otherArgumentLabels[i] {
+			return false
+		}
+	}
+
+	return true
 }
 
 func NewUnmeteredPublicFunctionMember(
@@ -3988,9 +4986,13 @@ type InterfaceType struct {
 	Fields              []string
 	// TODO: add support for overloaded initializers
 	InitializerParameters []*Parameter
-	containerType         Type
-	nestedTypes           *StringTypeOrderedMap
-	cachedIdentifiers     *struct {
+	// InitializerHasConditions is true if the required initializer has pre- or post-conditions
+	InitializerHasConditions      bool
+	ExplicitInterfaceConformances []*InterfaceType
+	containerType                 Type
+	nestedTypes                   *StringTypeOrderedMap
+	typeAliases                   *StringTypeOrderedMap
+	cachedIdentifiers             *struct {
 		TypeID              TypeID
 		QualifiedIdentifier string
 	}
@@ -4095,7 +5097,15 @@ func (t *InterfaceType) GetMembers() map[string]MemberResolver {
 func (t *InterfaceType) initializeMemberResolvers() {
 	t.memberResolversOnce.Do(func() {
 		members := make(map[string]MemberResolver, t.Members.Len())
-		t.Members.Foreach(func(name string, loopMember *Member) {
+
+		// NOTE: also include the members inherited from the conformances.
+		// Members declared in the interface itself take precedence
+
+		t.ForEachMember(func(name string, loopMember *Member) {
+			if _, ok := members[name]; ok {
+				return
+			}
+
 			// NOTE: don't capture loop variable
 			member := loopMember
 			members[name] = MemberResolver{
@@ -4110,6 +5120,30 @@ func (t *InterfaceType) initializeMemberResolvers() {
 	})
 }
 
+// EffectiveInterfaceConformances returns the explicit interface conformances of the interface type,
+// and recursively, the interfaces these interfaces conform to.
+// Each interface is only included once, in depth-first order.
+//
+func (t *InterfaceType) EffectiveInterfaceConformances() []*InterfaceType {
+	return appendEffectiveInterfaceConformances(
+		nil,
+		map[*InterfaceType]struct{}{t: {}},
+		t.ExplicitInterfaceConformances,
+	)
+}
+
+// ForEachMember calls the given function for each member declared in the interface type,
+// and then for each member of the interfaces it effectively conforms to.
+// Members may be visited multiple times, if they are redeclared.
+//
+func (t *InterfaceType) ForEachMember(f func(name string, member *Member)) {
+	t.Members.Foreach(f)
+
+	for _, conformance := range t.EffectiveInterfaceConformances() {
+		conformance.Members.Foreach(f)
+	}
+}
+
 func (t *InterfaceType) IsResourceType() bool {
 	return t.CompositeKind == common.CompositeKindResource
 }
@@ -4212,6 +5246,10 @@ func (t *InterfaceType) GetNestedTypes() *StringTypeOrderedMap {
 	return t.nestedTypes
 }
 
+func (t *InterfaceType) GetTypeAliases() *StringTypeOrderedMap {
+	return t.typeAliases
+}
+
 func (t *InterfaceType) FieldPosition(name string, declaration *ast.InterfaceDeclaration) ast.Position {
 	return declaration.Members.FieldPosition(name, declaration.CompositeKind)
 }
@@ -4281,109 +5319,542 @@ func (t *DictionaryType) IsResourceType() bool {
 		t.ValueType.IsResourceType()
 }
 
-func (t *DictionaryType) IsInvalidType() bool {
-	return t.KeyType.IsInvalidType() ||
-		t.ValueType.IsInvalidType()
+func (t *DictionaryType) IsInvalidType() bool {
+	return t.KeyType.IsInvalidType() ||
+		t.ValueType.IsInvalidType()
+}
+
+func (t *DictionaryType) IsStorable(results map[*Member]bool) bool {
+	return t.KeyType.IsStorable(results) &&
+		t.ValueType.IsStorable(results)
+}
+
+func (t *DictionaryType) IsExternallyReturnable(results map[*Member]bool) bool {
+	return t.KeyType.IsExternallyReturnable(results) &&
+		t.ValueType.IsExternallyReturnable(results)
+}
+
+func (t *DictionaryType) IsImportable(results map[*Member]bool) bool {
+	return t.KeyType.IsImportable(results) &&
+		t.ValueType.IsImportable(results)
+}
+
+func (*DictionaryType) IsEquatable() bool {
+	// TODO:
+	return false
+}
+
+func (t *DictionaryType) TypeAnnotationState() TypeAnnotationState {
+	keyTypeAnnotationState := t.KeyType.TypeAnnotationState()
+	if keyTypeAnnotationState != TypeAnnotationStateValid {
+		return keyTypeAnnotationState
+	}
+
+	valueTypeAnnotationState := t.ValueType.TypeAnnotationState()
+	if valueTypeAnnotationState != TypeAnnotationStateValid {
+		return valueTypeAnnotationState
+	}
+
+	return TypeAnnotationStateValid
+}
+
+func (t *DictionaryType) RewriteWithRestrictedTypes() (Type, bool) {
+	rewrittenKeyType, keyTypeRewritten := t.KeyType.RewriteWithRestrictedTypes()
+	rewrittenValueType, valueTypeRewritten := t.ValueType.RewriteWithRestrictedTypes()
+	rewritten := keyTypeRewritten || valueTypeRewritten
+	if rewritten {
+		return &DictionaryType{
+			KeyType:   rewrittenKeyType,
+			ValueType: rewrittenValueType,
+		}, true
+	} else {
+		return t, false
+	}
+}
+
+const dictionaryTypeContainsKeyFunctionDocString = `
+Returns true if the given key is in the dictionary
+`
+
+const dictionaryTypeLengthFieldDocString = `
+The number of entries in the dictionary
+`
+
+const dictionaryTypeKeysFieldDocString = `
+An array containing all keys of the dictionary
+`
+
+const dictionaryTypeValuesFieldDocString = `
+An array containing all values of the dictionary
+`
+
+const dictionaryTypeInsertFunctionDocString = `
+Inserts the given value into the dictionary under the given key.
+
+Returns the previous value as an optional if the dictionary contained the key, or nil if the dictionary did not contain the key
+`
+
+const dictionaryTypeRemoveFunctionDocString = `
+Removes the value for the given key from the dictionary.
+
+Returns the value as an optional if the dictionary contained the key, or nil if the dictionary did not contain the key
+`
+
+const dictionaryTypeForEachKeyFunctionDocString = `
+Calls the given function for each key of the dictionary.
+
+The iteration stops when the function returns false.
+The dictionary must not be mutated while it is iterated over.
+If the dictionary is mutated, the program aborts
+`
+
+const dictionaryTypeForEachFunctionDocString = `
+Calls the given function for each key and value of the dictionary.
+
+The iteration stops when the function returns false.
+The dictionary must not be mutated while it is iterated over.
+If the dictionary is mutated, the program aborts
+`
+
+func (t *DictionaryType) GetMembers() map[string]MemberResolver {
+	t.initializeMemberResolvers()
+	return t.memberResolvers
+}
+
+func (t *DictionaryType) initializeMemberResolvers() {
+	t.memberResolversOnce.Do(func() {
+
+		t.memberResolvers = withBuiltinMembers(t, map[string]MemberResolver{
+			"containsKey": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(memoryGauge common.MemoryGauge, identifier string, targetRange ast.Range, report func(error)) *Member {
+
+					return NewPublicFunctionMember(
+						memoryGauge,
+						t,
+						identifier,
+						DictionaryContainsKeyFunctionType(t),
+						dictionaryTypeContainsKeyFunctionDocString,
+					)
+				},
+			},
+			"length": {
+				Kind: common.DeclarationKindField,
+				Resolve: func(memoryGauge common.MemoryGauge, identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicConstantFieldMember(
+						memoryGauge,
+						t,
+						identifier,
+						IntType,
+						dictionaryTypeLengthFieldDocString,
+					)
+				},
+			},
+			"keys": {
+				Kind: common.DeclarationKindField,
+				Resolve: func(memoryGauge common.MemoryGauge, identifier string, targetRange ast.Range, report func(error)) *Member {
+					// TODO: maybe allow for resource key type
+
+					if t.KeyType.IsResourceType() {
+						report(
+							&InvalidResourceDictionaryMemberError{
+								Name:            identifier,
+								DeclarationKind: common.DeclarationKindField,
+								Range:           targetRange,
+							},
+						)
+					}
+
+					return NewPublicConstantFieldMember(
+						memoryGauge,
+						t,
+						identifier,
+						&VariableSizedType{Type: t.KeyType},
+						dictionaryTypeKeysFieldDocString,
+					)
+				},
+			},
+			"values": {
+				Kind: common.DeclarationKindField,
+				Resolve: func(memoryGauge common.MemoryGauge, identifier string, targetRange ast.Range, report func(error)) *Member {
+					// TODO: maybe allow for resource value type
+
+					if t.ValueType.IsResourceType() {
+						report(
+							&InvalidResourceDictionaryMemberError{
+								Name:            identifier,
+								DeclarationKind: common.DeclarationKindField,
+								Range:           targetRange,
+							},
+						)
+					}
+
+					return NewPublicConstantFieldMember(
+						memoryGauge,
+						t,
+						identifier,
+						&VariableSizedType{Type: t.ValueType},
+						dictionaryTypeValuesFieldDocString,
+					)
+				},
+			},
+			"forEachKey": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(memoryGauge common.MemoryGauge, identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						memoryGauge,
+						t,
+						identifier,
+						DictionaryForEachKeyFunctionType(t),
+						dictionaryTypeForEachKeyFunctionDocString,
+					)
+				},
+			},
+			"forEach": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(memoryGauge common.MemoryGauge, identifier string, targetRange ast.Range, report func(error)) *Member {
+					// The values of a dictionary of resources cannot be passed to the function,
+					// as that would move them out of the dictionary
+
+					if t.ValueType.IsResourceType() {
+						report(
+							&InvalidResourceDictionaryMemberError{
+								Name:            identifier,
+								DeclarationKind: common.DeclarationKindFunction,
+								Range:           targetRange,
+							},
+						)
+					}
+
+					return NewPublicFunctionMember(
+						memoryGauge,
+						t,
+						identifier,
+						DictionaryForEachFunctionType(t),
+						dictionaryTypeForEachFunctionDocString,
+					)
+				},
+			},
+			"insert": {
+				Kind:     common.DeclarationKindFunction,
+				Mutating: true,
+				Resolve: func(memoryGauge common.MemoryGauge, identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						memoryGauge,
+						t,
+						identifier,
+						DictionaryInsertFunctionType(t),
+						dictionaryTypeInsertFunctionDocString,
+					)
+				},
+			},
+			"remove": {
+				Kind:     common.DeclarationKindFunction,
+				Mutating: true,
+				Resolve: func(memoryGauge common.MemoryGauge, identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						memoryGauge,
+						t,
+						identifier,
+						DictionaryRemoveFunctionType(t),
+						dictionaryTypeRemoveFunctionDocString,
+					)
+				},
+			},
+		})
+	})
+}
+
+func DictionaryContainsKeyFunctionType(t *DictionaryType) *FunctionType {
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "key",
+				TypeAnnotation: NewTypeAnnotation(t.KeyType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			BoolType,
+		),
+	}
+}
+
+func DictionaryInsertFunctionType(t *DictionaryType) *FunctionType {
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Identifier:     "key",
+				TypeAnnotation: NewTypeAnnotation(t.KeyType),
+			},
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "value",
+				TypeAnnotation: NewTypeAnnotation(t.ValueType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			&OptionalType{
+				Type: t.ValueType,
+			},
+		),
+	}
+}
+
+func DictionaryRemoveFunctionType(t *DictionaryType) *FunctionType {
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Identifier:     "key",
+				TypeAnnotation: NewTypeAnnotation(t.KeyType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			&OptionalType{
+				Type: t.ValueType,
+			},
+		),
+	}
+}
+
+func DictionaryForEachKeyFunctionType(t *DictionaryType) *FunctionType {
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:      ArgumentLabelNotRequired,
+				Identifier: "function",
+				TypeAnnotation: NewTypeAnnotation(
+					&FunctionType{
+						Parameters: []*Parameter{
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "key",
+								TypeAnnotation: NewTypeAnnotation(t.KeyType),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(BoolType),
+					},
+				),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(VoidType),
+	}
+}
+
+func DictionaryForEachFunctionType(t *DictionaryType) *FunctionType {
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:      ArgumentLabelNotRequired,
+				Identifier: "function",
+				TypeAnnotation: NewTypeAnnotation(
+					&FunctionType{
+						Parameters: []*Parameter{
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "key",
+								TypeAnnotation: NewTypeAnnotation(t.KeyType),
+							},
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "value",
+								TypeAnnotation: NewTypeAnnotation(t.ValueType),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(BoolType),
+					},
+				),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(VoidType),
+	}
+}
+
+func (*DictionaryType) isValueIndexableType() bool {
+	return true
+}
+
+func (t *DictionaryType) ElementType(_ bool) Type {
+	return &OptionalType{Type: t.ValueType}
+}
+
+func (*DictionaryType) AllowsValueIndexingAssignment() bool {
+	return true
+}
+
+func (t *DictionaryType) IndexingType() Type {
+	return t.KeyType
+}
+
+type DictionaryEntryType struct {
+	KeyType   Type
+	ValueType Type
+}
+
+func (t *DictionaryType) Unify(
+	other Type,
+	typeParameters *TypeParameterTypeOrderedMap,
+	report func(err error),
+	outerRange ast.Range,
+) bool {
+
+	otherDictionary, ok := other.(*DictionaryType)
+	if !ok {
+		return false
+	}
+
+	keyUnified := t.KeyType.Unify(otherDictionary.KeyType, typeParameters, report, outerRange)
+	valueUnified := t.ValueType.Unify(otherDictionary.ValueType, typeParameters, report, outerRange)
+	return keyUnified || valueUnified
+}
+
+func (t *DictionaryType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type {
+	newKeyType := t.KeyType.Resolve(typeArguments)
+	if newKeyType == nil {
+		return nil
+	}
+
+	newValueType := t.ValueType.Resolve(typeArguments)
+	if newValueType == nil {
+		return nil
+	}
+
+	return &DictionaryType{
+		KeyType:   newKeyType,
+		ValueType: newValueType,
+	}
+}
+
+// SetType consists of the element type
+// for all elements in the set:
+// All elements have to be a subtype of the element type,
+// and the element type has to be a hashable value type.
+
+type SetType struct {
+	ElementType         Type
+	memberResolvers     map[string]MemberResolver
+	memberResolversOnce sync.Once
+}
+
+func NewSetType(memoryGauge common.MemoryGauge, elementType Type) *SetType {
+	common.UseMemory(memoryGauge, common.SetSemaTypeMemoryUsage)
+	return &SetType{
+		ElementType: elementType,
+	}
+}
+
+func (*SetType) IsType() {}
+
+func (t *SetType) Tag() TypeTag {
+	return SetTypeTag
+}
+
+func (t *SetType) String() string {
+	return fmt.Sprintf(
+		"{%s}",
+		t.ElementType,
+	)
+}
+
+func (t *SetType) QualifiedString() string {
+	return fmt.Sprintf(
+		"{%s}",
+		t.ElementType.QualifiedString(),
+	)
+}
+
+func (t *SetType) ID() TypeID {
+	return TypeID(fmt.Sprintf(
+		"{%s}",
+		t.ElementType.ID(),
+	))
+}
+
+func (t *SetType) Equal(other Type) bool {
+	otherSet, ok := other.(*SetType)
+	if !ok {
+		return false
+	}
+
+	return otherSet.ElementType.Equal(t.ElementType)
 }
 
-func (t *DictionaryType) IsStorable(results map[*Member]bool) bool {
-	return t.KeyType.IsStorable(results) &&
-		t.ValueType.IsStorable(results)
+func (t *SetType) IsResourceType() bool {
+	return t.ElementType.IsResourceType()
 }
 
-func (t *DictionaryType) IsExternallyReturnable(results map[*Member]bool) bool {
-	return t.KeyType.IsExternallyReturnable(results) &&
-		t.ValueType.IsExternallyReturnable(results)
+func (t *SetType) IsInvalidType() bool {
+	return t.ElementType.IsInvalidType()
 }
 
-func (t *DictionaryType) IsImportable(results map[*Member]bool) bool {
-	return t.KeyType.IsImportable(results) &&
-		t.ValueType.IsImportable(results)
+func (t *SetType) IsStorable(results map[*Member]bool) bool {
+	return t.ElementType.IsStorable(results)
 }
 
-func (*DictionaryType) IsEquatable() bool {
-	// TODO:
-	return false
+func (t *SetType) IsExternallyReturnable(results map[*Member]bool) bool {
+	return t.ElementType.IsExternallyReturnable(results)
 }
 
-func (t *DictionaryType) TypeAnnotationState() TypeAnnotationState {
-	keyTypeAnnotationState := t.KeyType.TypeAnnotationState()
-	if keyTypeAnnotationState != TypeAnnotationStateValid {
-		return keyTypeAnnotationState
-	}
+func (t *SetType) IsImportable(results map[*Member]bool) bool {
+	return t.ElementType.IsImportable(results)
+}
 
-	valueTypeAnnotationState := t.ValueType.TypeAnnotationState()
-	if valueTypeAnnotationState != TypeAnnotationStateValid {
-		return valueTypeAnnotationState
-	}
+func (t *SetType) IsEquatable() bool {
+	return t.ElementType.IsEquatable()
+}
 
-	return TypeAnnotationStateValid
+func (t *SetType) TypeAnnotationState() TypeAnnotationState {
+	return t.ElementType.TypeAnnotationState()
 }
 
-func (t *DictionaryType) RewriteWithRestrictedTypes() (Type, bool) {
-	rewrittenKeyType, keyTypeRewritten := t.KeyType.RewriteWithRestrictedTypes()
-	rewrittenValueType, valueTypeRewritten := t.ValueType.RewriteWithRestrictedTypes()
-	rewritten := keyTypeRewritten || valueTypeRewritten
+func (t *SetType) RewriteWithRestrictedTypes() (Type, bool) {
+	rewrittenElementType, rewritten := t.ElementType.RewriteWithRestrictedTypes()
 	if rewritten {
-		return &DictionaryType{
-			KeyType:   rewrittenKeyType,
-			ValueType: rewrittenValueType,
+		return &SetType{
+			ElementType: rewrittenElementType,
 		}, true
 	} else {
 		return t, false
 	}
 }
 
-const dictionaryTypeContainsKeyFunctionDocString = `
-Returns true if the given key is in the dictionary
+const setTypeLengthFieldDocString = `
+The number of elements in the set
 `
 
-const dictionaryTypeLengthFieldDocString = `
-The number of entries in the dictionary
+const setTypeContainsFunctionDocString = `
+Returns true if the given element is in the set
 `
 
-const dictionaryTypeKeysFieldDocString = `
-An array containing all keys of the dictionary
-`
+const setTypeInsertFunctionDocString = `
+Inserts the given element into the set.
 
-const dictionaryTypeValuesFieldDocString = `
-An array containing all values of the dictionary
+Returns true if the element was inserted, or false if the set already contained the element
 `
 
-const dictionaryTypeInsertFunctionDocString = `
-Inserts the given value into the dictionary under the given key.
+const setTypeRemoveFunctionDocString = `
+Removes the given element from the set.
 
-Returns the previous value as an optional if the dictionary contained the key, or nil if the dictionary did not contain the key
+Returns true if the element was removed, or false if the set did not contain the element
 `
 
-const dictionaryTypeRemoveFunctionDocString = `
-Removes the value for the given key from the dictionary.
+const setTypeUnionFunctionDocString = `
+Returns a new set containing all elements of this set and the given set
+`
 
-Returns the value as an optional if the dictionary contained the key, or nil if the dictionary did not contain the key
+const setTypeIntersectionFunctionDocString = `
+Returns a new set containing the elements that are both in this set and the given set
 `
 
-func (t *DictionaryType) GetMembers() map[string]MemberResolver {
+func (t *SetType) GetMembers() map[string]MemberResolver {
 	t.initializeMemberResolvers()
 	return t.memberResolvers
 }
 
-func (t *DictionaryType) initializeMemberResolvers() {
+func (t *SetType) initializeMemberResolvers() {
 	t.memberResolversOnce.Do(func() {
 
 		t.memberResolvers = withBuiltinMembers(t, map[string]MemberResolver{
-			"containsKey": {
-				Kind: common.DeclarationKindFunction,
-				Resolve: func(memoryGauge common.MemoryGauge, identifier string, targetRange ast.Range, report func(error)) *Member {
-
-					return NewPublicFunctionMember(
-						memoryGauge,
-						t,
-						identifier,
-						DictionaryContainsKeyFunctionType(t),
-						dictionaryTypeContainsKeyFunctionDocString,
-					)
-				},
-			},
 			"length": {
 				Kind: common.DeclarationKindField,
 				Resolve: func(memoryGauge common.MemoryGauge, identifier string, _ ast.Range, _ func(error)) *Member {
@@ -4392,59 +5863,36 @@ func (t *DictionaryType) initializeMemberResolvers() {
 						t,
 						identifier,
 						IntType,
-						dictionaryTypeLengthFieldDocString,
+						setTypeLengthFieldDocString,
 					)
 				},
 			},
-			"keys": {
-				Kind: common.DeclarationKindField,
-				Resolve: func(memoryGauge common.MemoryGauge, identifier string, targetRange ast.Range, report func(error)) *Member {
-					// TODO: maybe allow for resource key type
-
-					if t.KeyType.IsResourceType() {
-						report(
-							&InvalidResourceDictionaryMemberError{
-								Name:            identifier,
-								DeclarationKind: common.DeclarationKindField,
-								Range:           targetRange,
-							},
-						)
-					}
-
-					return NewPublicConstantFieldMember(
+			"contains": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(memoryGauge common.MemoryGauge, identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
 						memoryGauge,
 						t,
 						identifier,
-						&VariableSizedType{Type: t.KeyType},
-						dictionaryTypeKeysFieldDocString,
+						SetElementFunctionType(t),
+						setTypeContainsFunctionDocString,
 					)
 				},
 			},
-			"values": {
-				Kind: common.DeclarationKindField,
-				Resolve: func(memoryGauge common.MemoryGauge, identifier string, targetRange ast.Range, report func(error)) *Member {
-					// TODO: maybe allow for resource value type
-
-					if t.ValueType.IsResourceType() {
-						report(
-							&InvalidResourceDictionaryMemberError{
-								Name:            identifier,
-								DeclarationKind: common.DeclarationKindField,
-								Range:           targetRange,
-							},
-						)
-					}
-
-					return NewPublicConstantFieldMember(
+			"insert": {
+				Kind:     common.DeclarationKindFunction,
+				Mutating: true,
+				Resolve: func(memoryGauge common.MemoryGauge, identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
 						memoryGauge,
 						t,
 						identifier,
-						&VariableSizedType{Type: t.ValueType},
-						dictionaryTypeValuesFieldDocString,
+						SetElementFunctionType(t),
+						setTypeInsertFunctionDocString,
 					)
 				},
 			},
-			"insert": {
+			"remove": {
 				Kind:     common.DeclarationKindFunction,
 				Mutating: true,
 				Resolve: func(memoryGauge common.MemoryGauge, identifier string, _ ast.Range, _ func(error)) *Member {
@@ -4452,21 +5900,32 @@ func (t *DictionaryType) initializeMemberResolvers() {
 						memoryGauge,
 						t,
 						identifier,
-						DictionaryInsertFunctionType(t),
-						dictionaryTypeInsertFunctionDocString,
+						SetElementFunctionType(t),
+						setTypeRemoveFunctionDocString,
 					)
 				},
 			},
-			"remove": {
-				Kind:     common.DeclarationKindFunction,
-				Mutating: true,
+			"union": {
+				Kind: common.DeclarationKindFunction,
 				Resolve: func(memoryGauge common.MemoryGauge, identifier string, _ ast.Range, _ func(error)) *Member {
 					return NewPublicFunctionMember(
 						memoryGauge,
 						t,
 						identifier,
-						DictionaryRemoveFunctionType(t),
-						dictionaryTypeRemoveFunctionDocString,
+						SetCombineFunctionType(t),
+						setTypeUnionFunctionDocString,
+					)
+				},
+			},
+			"intersection": {
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(memoryGauge common.MemoryGauge, identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						memoryGauge,
+						t,
+						identifier,
+						SetCombineFunctionType(t),
+						setTypeIntersectionFunctionDocString,
 					)
 				},
 			},
@@ -4474,13 +5933,17 @@ func (t *DictionaryType) initializeMemberResolvers() {
 	})
 }
 
-func DictionaryContainsKeyFunctionType(t *DictionaryType) *FunctionType {
+// SetElementFunctionType returns the type of the set functions
+// which take a single element and return whether the set contained it,
+// i.e. `contains`, `insert`, and `remove`.
+//
+func SetElementFunctionType(t *SetType) *FunctionType {
 	return &FunctionType{
 		Parameters: []*Parameter{
 			{
 				Label:          ArgumentLabelNotRequired,
-				Identifier:     "key",
-				TypeAnnotation: NewTypeAnnotation(t.KeyType),
+				Identifier:     "element",
+				TypeAnnotation: NewTypeAnnotation(t.ElementType),
 			},
 		},
 		ReturnTypeAnnotation: NewTypeAnnotation(
@@ -4489,95 +5952,50 @@ func DictionaryContainsKeyFunctionType(t *DictionaryType) *FunctionType {
 	}
 }
 
-func DictionaryInsertFunctionType(t *DictionaryType) *FunctionType {
+// SetCombineFunctionType returns the type of the set functions
+// which combine the set with another set into a new set,
+// i.e. `union` and `intersection`.
+//
+func SetCombineFunctionType(t *SetType) *FunctionType {
 	return &FunctionType{
 		Parameters: []*Parameter{
-			{
-				Identifier:     "key",
-				TypeAnnotation: NewTypeAnnotation(t.KeyType),
-			},
 			{
 				Label:          ArgumentLabelNotRequired,
-				Identifier:     "value",
-				TypeAnnotation: NewTypeAnnotation(t.ValueType),
-			},
-		},
-		ReturnTypeAnnotation: NewTypeAnnotation(
-			&OptionalType{
-				Type: t.ValueType,
-			},
-		),
-	}
-}
-
-func DictionaryRemoveFunctionType(t *DictionaryType) *FunctionType {
-	return &FunctionType{
-		Parameters: []*Parameter{
-			{
-				Identifier:     "key",
-				TypeAnnotation: NewTypeAnnotation(t.KeyType),
+				Identifier:     "other",
+				TypeAnnotation: NewTypeAnnotation(t),
 			},
 		},
 		ReturnTypeAnnotation: NewTypeAnnotation(
-			&OptionalType{
-				Type: t.ValueType,
+			&SetType{
+				ElementType: t.ElementType,
 			},
 		),
 	}
 }
 
-func (*DictionaryType) isValueIndexableType() bool {
-	return true
-}
-
-func (t *DictionaryType) ElementType(_ bool) Type {
-	return &OptionalType{Type: t.ValueType}
-}
-
-func (*DictionaryType) AllowsValueIndexingAssignment() bool {
-	return true
-}
-
-func (t *DictionaryType) IndexingType() Type {
-	return t.KeyType
-}
-
-type DictionaryEntryType struct {
-	KeyType   Type
-	ValueType Type
-}
-
-func (t *DictionaryType) Unify(
+func (t *SetType) Unify(
 	other Type,
 	typeParameters *TypeParameterTypeOrderedMap,
 	report func(err error),
 	outerRange ast.Range,
 ) bool {
 
-	otherDictionary, ok := other.(*DictionaryType)
+	otherSet, ok := other.(*SetType)
 	if !ok {
 		return false
 	}
 
-	keyUnified := t.KeyType.Unify(otherDictionary.KeyType, typeParameters, report, outerRange)
-	valueUnified := t.ValueType.Unify(otherDictionary.ValueType, typeParameters, report, outerRange)
-	return keyUnified || valueUnified
+	return t.ElementType.Unify(otherSet.ElementType, typeParameters, report, outerRange)
 }
 
-func (t *DictionaryType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type {
-	newKeyType := t.KeyType.Resolve(typeArguments)
-	if newKeyType == nil {
-		return nil
-	}
-
-	newValueType := t.ValueType.Resolve(typeArguments)
-	if newValueType == nil {
+func (t *SetType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type {
+	newElementType := t.ElementType.Resolve(typeArguments)
+	if newElementType == nil {
 		return nil
 	}
 
-	return &DictionaryType{
-		KeyType:   newKeyType,
-		ValueType: newValueType,
+	return &SetType{
+		ElementType: newElementType,
 	}
 }
 
@@ -4728,9 +6146,16 @@ func (*ReferenceType) Unify(_ Type, _ *TypeParameterTypeOrderedMap, _ func(err e
 	return false
 }
 
-func (t *ReferenceType) Resolve(_ *TypeParameterTypeOrderedMap) Type {
-	// TODO:
-	return t
+func (t *ReferenceType) Resolve(typeArguments *TypeParameterTypeOrderedMap) Type {
+	newInnerType := t.Type.Resolve(typeArguments)
+	if newInnerType == nil {
+		return nil
+	}
+
+	return &ReferenceType{
+		Authorized: t.Authorized,
+		Type:       newInnerType,
+	}
 }
 
 const AddressTypeName = "Address"
@@ -4890,6 +6315,14 @@ func IsSameTypeKind(subType Type, superType Type) bool {
 		return false
 	}
 
+	// A type parameter is a subtype of its bound,
+	// but its type argument might be any subtype of the bound,
+	// so e.g. literals and arithmetic cannot assume a particular kind
+
+	if _, ok := subType.(*GenericType); ok {
+		return false
+	}
+
 	return IsSubType(subType, superType)
 }
 
@@ -4920,6 +6353,20 @@ func checkSubTypeWithoutEquality(subType Type, superType Type) bool {
 		return true
 	}
 
+	// A declared generic type `T: U` is a subtype of `V` if `U` is a subtype of `V`,
+	// and it is a subtype of `T?`.
+	// NOTE: `T` is only a supertype of itself, which is handled by `IsSubType`
+
+	if typedSubType, ok := subType.(*GenericType); ok &&
+		typedSubType.TypeParameter.declared {
+
+		if optionalSuperType, ok := superType.(*OptionalType); ok {
+			return IsSubType(typedSubType, optionalSuperType.Type)
+		}
+
+		return IsSubType(typedSubType.TypeParameter.TypeBound, superType)
+	}
+
 	switch superType {
 	case AnyType:
 		return true
@@ -4955,7 +6402,7 @@ func checkSubTypeWithoutEquality(subType Type, superType Type) bool {
 		case IntegerType, SignedIntegerType,
 			UIntType,
 			UInt8Type, UInt16Type, UInt32Type, UInt64Type, UInt128Type, UInt256Type,
-			Word8Type, Word16Type, Word32Type, Word64Type:
+			Word8Type, Word16Type, Word32Type, Word64Type, Word128Type, Word256Type:
 
 			return true
 
@@ -4978,7 +6425,7 @@ func checkSubTypeWithoutEquality(subType Type, superType Type) bool {
 	case FixedPointType:
 		switch subType {
 		case FixedPointType, SignedFixedPointType,
-			UFix64Type:
+			UFix64Type, UFix128Type:
 
 			return true
 
@@ -4988,7 +6435,7 @@ func checkSubTypeWithoutEquality(subType Type, superType Type) bool {
 
 	case SignedFixedPointType:
 		switch subType {
-		case SignedFixedPointType, Fix64Type:
+		case SignedFixedPointType, Fix64Type, Fix128Type:
 			return true
 
 		default:
@@ -5015,6 +6462,14 @@ func checkSubTypeWithoutEquality(subType Type, superType Type) bool {
 		return IsSubType(typedSubType.KeyType, typedSuperType.KeyType) &&
 			IsSubType(typedSubType.ValueType, typedSuperType.ValueType)
 
+	case *SetType:
+		typedSubType, ok := subType.(*SetType)
+		if !ok {
+			return false
+		}
+
+		return IsSubType(typedSubType.ElementType, typedSuperType.ElementType)
+
 	case *VariableSizedType:
 		typedSubType, ok := subType.(*VariableSizedType)
 		if !ok {
@@ -5087,7 +6542,7 @@ func checkSubTypeWithoutEquality(subType Type, superType Type) bool {
 
 					return IsSubType(typedInnerSubType.Type, restrictedSuperType) &&
 						typedInnerSuperType.RestrictionSet().
-							IsSubsetOf(typedInnerSubType.RestrictionSet())
+							IsSubsetOf(typedInnerSubType.EffectiveRestrictionSet())
 
 				case *CompositeType:
 					// An unauthorized reference to an unrestricted type `&T`
@@ -5098,7 +6553,6 @@ func checkSubTypeWithoutEquality(subType Type, superType Type) bool {
 					//
 					// The holder of the reference may only restrict the reference.
 
-					// TODO: once interfaces can conform to interfaces, include
 					return IsSubType(typedInnerSubType, restrictedSuperType) &&
 						typedInnerSuperType.RestrictionSet().
 							IsSubsetOf(typedInnerSubType.ExplicitInterfaceConformanceSet())
@@ -5133,7 +6587,7 @@ func checkSubTypeWithoutEquality(subType Type, superType Type) bool {
 
 						return typedInnerSubType.Type == typedInnerSuperType.Type &&
 							typedInnerSuperType.RestrictionSet().
-								IsSubsetOf(typedInnerSubType.RestrictionSet())
+								IsSubsetOf(typedInnerSubType.EffectiveRestrictionSet())
 					}
 
 					switch typedInnerSubType.Type {
@@ -5321,7 +6775,7 @@ func checkSubTypeWithoutEquality(subType Type, superType Type) bool {
 
 					return IsSubType(restrictedSubtype, restrictedSuperType) &&
 						typedSuperType.RestrictionSet().
-							IsSubsetOf(typedSubType.RestrictionSet())
+							IsSubsetOf(typedSubType.EffectiveRestrictionSet())
 				}
 
 				if restrictedSubtype, ok := restrictedSubtype.(*CompositeType); ok {
@@ -5331,7 +6785,6 @@ func checkSubTypeWithoutEquality(subType Type, superType Type) bool {
 					// and `T` conforms to `Vs`.
 					// `Us` and `Vs` do *not* have to be subsets.
 
-					// TODO: once interfaces can conform to interfaces, include
 					return IsSubType(restrictedSubtype, restrictedSuperType) &&
 						typedSuperType.RestrictionSet().
 							IsSubsetOf(restrictedSubtype.ExplicitInterfaceConformanceSet())
@@ -5439,12 +6892,23 @@ func checkSubTypeWithoutEquality(subType Type, superType Type) bool {
 				return false
 			}
 
-			// TODO: once interfaces can conform to interfaces, include
 			return typedSubType.ExplicitInterfaceConformanceSet().
 				Includes(typedSuperType)
 
 		case *InterfaceType:
-			// TODO: Once interfaces can conform to interfaces, check conformances here
+			// An interface type `T` is a subtype of a interface type `V`:
+			// if `T` conforms to `V`, and `V` and `T` are of the same kind
+
+			if typedSubType.CompositeKind != typedSuperType.CompositeKind {
+				return false
+			}
+
+			for _, conformance := range typedSubType.EffectiveInterfaceConformances() {
+				if conformance == typedSuperType {
+					return true
+				}
+			}
+
 			return false
 		}
 
@@ -5675,6 +7139,10 @@ type RestrictedType struct {
 	// an internal set of field `Restrictions`
 	restrictionSet     *InterfaceSet
 	restrictionSetOnce sync.Once
+	// an internal set of field `Restrictions`,
+	// and recursively, their conformances
+	effectiveRestrictionSet     *InterfaceSet
+	effectiveRestrictionSetOnce sync.Once
 }
 
 func NewRestrictedType(memoryGauge common.MemoryGauge, typ Type, restrictions []*InterfaceType) *RestrictedType {
@@ -5697,6 +7165,29 @@ func (t *RestrictedType) RestrictionSet() *InterfaceSet {
 	return t.restrictionSet
 }
 
+// EffectiveRestrictionSet returns the restrictions of the restricted type,
+// and recursively, the interfaces the restrictions conform to.
+//
+func (t *RestrictedType) EffectiveRestrictionSet() *InterfaceSet {
+	t.initializeEffectiveRestrictionSet()
+	return t.effectiveRestrictionSet
+}
+
+func (t *RestrictedType) initializeEffectiveRestrictionSet() {
+	t.effectiveRestrictionSetOnce.Do(func() {
+		effectiveRestrictions := appendEffectiveInterfaceConformances(
+			nil,
+			map[*InterfaceType]struct{}{},
+			t.Restrictions,
+		)
+
+		t.effectiveRestrictionSet = NewInterfaceSet()
+		for _, restriction := range effectiveRestrictions {
+			t.effectiveRestrictionSet.Add(restriction)
+		}
+	})
+}
+
 func (t *RestrictedType) initializeRestrictionSet() {
 	t.restrictionSetOnce.Do(func() {
 		t.restrictionSet = NewInterfaceSet()
@@ -5832,9 +7323,10 @@ func (t *RestrictedType) IsImportable(results map[*Member]bool) bool {
 	return true
 }
 
-func (*RestrictedType) IsEquatable() bool {
-	// TODO:
-	return false
+func (t *RestrictedType) IsEquatable() bool {
+	// TODO: support more restricted types
+	return t.Type == AnyStructType &&
+		t.RestrictionSet().Includes(EquatableType)
 }
 
 func (*RestrictedType) TypeAnnotationState() TypeAnnotationState {
@@ -6213,6 +7705,19 @@ func init() {
 	}
 }
 
+var NativeInterfaceTypes = map[string]*InterfaceType{}
+
+func init() {
+	types := []*InterfaceType{
+		HashableType,
+		EquatableType,
+	}
+
+	for _, semaType := range types {
+		NativeInterfaceTypes[semaType.QualifiedIdentifier()] = semaType
+	}
+}
+
 const AccountKeyTypeName = "AccountKey"
 const AccountKeyKeyIndexField = "keyIndex"
 const AccountKeyPublicKeyField = "publicKey"
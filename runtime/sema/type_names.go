@@ -42,11 +42,15 @@ const (
 	UInt128TypeName = "UInt128"
 	UInt256TypeName = "UInt256"
 
-	Word8TypeName  = "Word8"
-	Word16TypeName = "Word16"
-	Word32TypeName = "Word32"
-	Word64TypeName = "Word64"
+	Word8TypeName   = "Word8"
+	Word16TypeName  = "Word16"
+	Word32TypeName  = "Word32"
+	Word64TypeName  = "Word64"
+	Word128TypeName = "Word128"
+	Word256TypeName = "Word256"
 
-	Fix64TypeName  = "Fix64"
-	UFix64TypeName = "UFix64"
+	Fix64TypeName   = "Fix64"
+	Fix128TypeName  = "Fix128"
+	UFix64TypeName  = "UFix64"
+	UFix128TypeName = "UFix128"
 )
@@ -170,14 +170,14 @@ const (
 	_ // future: Fix16
 	_ // future: Fix32
 	fix64TypeMask
-	_ // future: Fix128
+	fix128TypeMask
 	_ // future: Fix256
 
 	_ // future: UFix8
 	_ // future: UFix16
 	_ // future: UFix32
 	ufix64TypeMask
-	_ // future: UFix128
+	ufix128TypeMask
 	_ // future: UFix256
 
 	stringTypeMask
@@ -217,6 +217,11 @@ const (
 	capabilityTypeMask uint64 = 1 << iota
 	restrictedTypeMask
 	transactionTypeMask
+	setTypeMask
+	word128TypeMask
+	word256TypeMask
+	timestampTypeMask
+	durationTypeMask
 
 	invalidTypeMask
 )
@@ -245,17 +250,21 @@ var (
 				Or(Word8TypeTag).
 				Or(Word16TypeTag).
 				Or(Word32TypeTag).
-				Or(Word64TypeTag)
+				Or(Word64TypeTag).
+				Or(Word128TypeTag).
+				Or(Word256TypeTag)
 
 	IntegerTypeTag = newTypeTagFromLowerMask(integerTypeMask).
 			Or(SignedIntegerTypeTag).
 			Or(UnsignedIntegerTypeTag)
 
 	SignedFixedPointTypeTag = newTypeTagFromLowerMask(signedFixedPointTypeMask).
-				Or(Fix64TypeTag)
+				Or(Fix64TypeTag).
+				Or(Fix128TypeTag)
 
 	UnsignedFixedPointTypeTag = newTypeTagFromLowerMask(unsignedFixedPointTypeMask).
-					Or(UFix64TypeTag)
+					Or(UFix64TypeTag).
+					Or(UFix128TypeTag)
 
 	FixedPointTypeTag = newTypeTagFromLowerMask(fixedPointTypeMask).
 				Or(SignedFixedPointTypeTag).
@@ -286,13 +295,17 @@ var (
 	Int128TypeTag = newTypeTagFromLowerMask(int128TypeMask)
 	Int256TypeTag = newTypeTagFromLowerMask(int256TypeMask)
 
-	Word8TypeTag  = newTypeTagFromLowerMask(word8TypeMask)
-	Word16TypeTag = newTypeTagFromLowerMask(word16TypeMask)
-	Word32TypeTag = newTypeTagFromLowerMask(word32TypeMask)
-	Word64TypeTag = newTypeTagFromLowerMask(word64TypeMask)
+	Word8TypeTag   = newTypeTagFromLowerMask(word8TypeMask)
+	Word16TypeTag  = newTypeTagFromLowerMask(word16TypeMask)
+	Word32TypeTag  = newTypeTagFromLowerMask(word32TypeMask)
+	Word64TypeTag  = newTypeTagFromLowerMask(word64TypeMask)
+	Word128TypeTag = newTypeTagFromUpperMask(word128TypeMask)
+	Word256TypeTag = newTypeTagFromUpperMask(word256TypeMask)
 
-	Fix64TypeTag  = newTypeTagFromLowerMask(fix64TypeMask)
-	UFix64TypeTag = newTypeTagFromLowerMask(ufix64TypeMask)
+	Fix64TypeTag   = newTypeTagFromLowerMask(fix64TypeMask)
+	Fix128TypeTag  = newTypeTagFromLowerMask(fix128TypeMask)
+	UFix64TypeTag  = newTypeTagFromLowerMask(ufix64TypeMask)
+	UFix128TypeTag = newTypeTagFromLowerMask(ufix128TypeMask)
 
 	StringTypeTag           = newTypeTagFromLowerMask(stringTypeMask)
 	CharacterTypeTag        = newTypeTagFromLowerMask(characterTypeMask)
@@ -330,6 +343,9 @@ var (
 	CapabilityTypeTag  = newTypeTagFromUpperMask(capabilityTypeMask)
 	InvalidTypeTag     = newTypeTagFromUpperMask(invalidTypeMask)
 	TransactionTypeTag = newTypeTagFromUpperMask(transactionTypeMask)
+	SetTypeTag         = newTypeTagFromUpperMask(setTypeMask)
+	TimestampTypeTag   = newTypeTagFromUpperMask(timestampTypeMask)
+	DurationTypeTag    = newTypeTagFromUpperMask(durationTypeMask)
 
 	// AnyStructTypeTag only includes the types that are pre-known
 	// to belong to AnyStruct type. This is more of an optimization.
@@ -349,6 +365,8 @@ var (
 				Or(PathTypeTag).
 				Or(AddressTypeTag).
 				Or(BlockTypeTag).
+				Or(TimestampTypeTag).
+				Or(DurationTypeTag).
 				Or(DeployedContractTypeTag).
 				Or(CapabilityTypeTag).
 				Or(FunctionTypeTag)
@@ -361,6 +379,7 @@ var (
 			Or(ConstantSizedTypeTag).
 			Or(VariableSizedTypeTag).
 			Or(DictionaryTypeTag).
+			Or(SetTypeTag).
 			Or(GenericTypeTag).
 			Or(InterfaceTypeTag).
 			Or(TransactionTypeTag).
@@ -528,8 +547,12 @@ func findSuperTypeFromLowerMask(joinedTypeTag TypeTag, types []Type) Type {
 
 	case fix64TypeMask:
 		return Fix64Type
+	case fix128TypeMask:
+		return Fix128Type
 	case ufix64TypeMask:
 		return UFix64Type
+	case ufix128TypeMask:
+		return UFix128Type
 
 	case stringTypeMask:
 		return StringType
@@ -625,6 +648,20 @@ func findSuperTypeFromUpperMask(joinedTypeTag TypeTag, types []Type) Type {
 		restrictedTypeMask,
 		transactionTypeMask:
 		return getSuperTypeOfDerivedTypes(types)
+
+	case setTypeMask:
+		return commonSuperTypeOfSets(types)
+
+	case word128TypeMask:
+		return Word128Type
+	case word256TypeMask:
+		return Word256Type
+
+	case timestampTypeMask:
+		return TimestampType
+	case durationTypeMask:
+		return DurationType
+
 	default:
 		return nil
 	}
@@ -777,6 +814,42 @@ func commonSuperTypeOfDictionaries(types []Type) Type {
 	}
 }
 
+func commonSuperTypeOfSets(types []Type) Type {
+	// We reach here if all types are set types.
+	// Therefore, decide the common supertype based on the element types.
+
+	elementTypes := make([]Type, 0)
+
+	for _, typ := range types {
+		// 'Never' type doesn't affect the supertype.
+		// Hence, ignore them
+		if typ == NeverType {
+			continue
+		}
+
+		setType, ok := typ.(*SetType)
+		if !ok {
+			panic(errors.NewUnexpectedError("expected set type, found %s", typ))
+		}
+
+		elementTypes = append(elementTypes, setType.ElementType)
+	}
+
+	elementSuperType := LeastCommonSuperType(elementTypes...)
+
+	if elementSuperType == InvalidType {
+		return InvalidType
+	}
+
+	if !IsValidDictionaryKeyType(elementSuperType) {
+		return commonSuperTypeOfHeterogeneousTypes(types)
+	}
+
+	return &SetType{
+		ElementType: elementSuperType,
+	}
+}
+
 func commonSuperTypeOfHeterogeneousTypes(types []Type) Type {
 	var hasStructs, hasResources bool
 	for _, typ := range types {
@@ -835,7 +908,7 @@ func commonSuperTypeOfComposites(types []Type) Type {
 
 		// NOTE: index 0 may not always be the first type, since there can be 'Never' types.
 		if firstType {
-			for _, interfaceType := range compositeType.ExplicitInterfaceConformances {
+			for _, interfaceType := range compositeType.EffectiveInterfaceConformances() {
 				commonInterfaces[interfaceType.QualifiedIdentifier()] = true
 				commonInterfacesList = append(commonInterfacesList, interfaceType)
 			}
@@ -844,7 +917,7 @@ func commonSuperTypeOfComposites(types []Type) Type {
 			intersection := map[string]bool{}
 			commonInterfacesList = make([]*InterfaceType, 0)
 
-			for _, interfaceType := range compositeType.ExplicitInterfaceConformances {
+			for _, interfaceType := range compositeType.EffectiveInterfaceConformances() {
 				if _, ok := commonInterfaces[interfaceType.QualifiedIdentifier()]; ok {
 					intersection[interfaceType.QualifiedIdentifier()] = true
 					commonInterfacesList = append(commonInterfacesList, interfaceType)
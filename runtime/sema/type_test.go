@@ -1761,7 +1761,8 @@ func TestTypeInclusions(t *testing.T) {
 
 				typ := variable.Type
 
-				if _, ok := typ.(*CompositeType); ok {
+				switch typ.(type) {
+				case *CompositeType, *InterfaceType:
 					return
 				}
 
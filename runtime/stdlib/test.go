@@ -0,0 +1,666 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stdlib
+
+import (
+	"github.com/onflow/atree"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/parser"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/stdlib/contracts"
+)
+
+// TestFramework is the host environment of the Test contract,
+// which is only available when running tests.
+type TestFramework interface {
+	// NewEmulatorBlockchain returns a new, empty blockchain.
+	NewEmulatorBlockchain() TestBlockchain
+
+	// ReadFile returns the content of the file at the given path.
+	ReadFile(path string) (string, error)
+}
+
+// TestBlockchain is a blockchain the tests can run scripts and transactions on.
+//
+// Values are passed to and returned from the blockchain
+// as values of the interpreter which runs the test.
+type TestBlockchain interface {
+	ExecuteScript(
+		inter *interpreter.Interpreter,
+		getLocationRange func() interpreter.LocationRange,
+		script string,
+		arguments []interpreter.Value,
+	) (interpreter.Value, error)
+
+	ExecuteTransaction(
+		inter *interpreter.Interpreter,
+		getLocationRange func() interpreter.LocationRange,
+		code string,
+		signers []common.Address,
+		arguments []interpreter.Value,
+	) error
+
+	CreateAccount() (common.Address, error)
+
+	DeployContract(name string, code string, address common.Address) error
+
+	CommitBlock()
+
+	Events(
+		inter *interpreter.Interpreter,
+		getLocationRange func() interpreter.LocationRange,
+	) ([]interpreter.Value, error)
+}
+
+const testContractTypeName = "Test"
+
+var TestContractLocation = common.IdentifierLocation(testContractTypeName)
+
+var TestContractChecker = func() *sema.Checker {
+
+	program, err := parser.ParseProgram(contracts.Test, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	var checker *sema.Checker
+	checker, err = sema.NewChecker(
+		program,
+		TestContractLocation,
+		nil,
+		false,
+		sema.WithPredeclaredValues(BuiltinFunctions.ToSemaValueDeclarations()),
+		sema.WithPredeclaredTypes(BuiltinTypes.ToTypeDeclarations()),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	err = checker.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	return checker
+}()
+
+var testContractType = func() *sema.CompositeType {
+	variable, ok := TestContractChecker.Elaboration.GlobalTypes.Get(testContractTypeName)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+	return variable.Type.(*sema.CompositeType)
+}()
+
+func testNestedCompositeType(name string) *sema.CompositeType {
+	ty, ok := testContractType.GetNestedTypes().Get(name)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+	return ty.(*sema.CompositeType)
+}
+
+// testFunctionFieldType returns the type of the function-typed field of the given composite type
+func testFunctionFieldType(compositeType *sema.CompositeType, fieldName string) *sema.FunctionType {
+	member, ok := compositeType.Members.Get(fieldName)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+	return member.TypeAnnotation.Type.(*sema.FunctionType)
+}
+
+const testMatcherTypeName = "Matcher"
+const testMatcherTestFieldName = "test"
+
+var testMatcherType = testNestedCompositeType(testMatcherTypeName)
+
+var testMatcherTestFunctionType = testFunctionFieldType(testMatcherType, testMatcherTestFieldName)
+
+const testResultStatusTypeName = "ResultStatus"
+const testResultStatusSucceededCaseName = "succeeded"
+const testResultStatusFailedCaseName = "failed"
+
+const testScriptResultTypeName = "ScriptResult"
+const testTransactionResultTypeName = "TransactionResult"
+const testResultStatusFieldName = "status"
+const testResultErrorFieldName = "error"
+const testScriptResultReturnValueFieldName = "returnValue"
+
+const testErrorTypeName = "Error"
+const testErrorMessageFieldName = "message"
+
+const testAccountTypeName = "Account"
+const testAccountAddressFieldName = "address"
+
+const testTransactionCodeFieldName = "code"
+const testTransactionSignersFieldName = "signers"
+const testTransactionArgumentsFieldName = "arguments"
+
+const testBlockchainTypeName = "Blockchain"
+const testBlockchainExecuteScriptFieldName = "executeScriptFunction"
+const testBlockchainExecuteTransactionFieldName = "executeTransactionFunction"
+const testBlockchainCreateAccountFieldName = "createAccountFunction"
+const testBlockchainDeployContractFieldName = "deployContractFunction"
+const testBlockchainCommitBlockFieldName = "commitBlockFunction"
+const testBlockchainEventsFieldName = "eventsFunction"
+
+var testBlockchainType = testNestedCompositeType(testBlockchainTypeName)
+
+// Natively implemented functions of the Test contract
+
+const testAssertFunctionName = "assert"
+
+const testEqualFunctionName = "equal"
+
+const testEqualFunctionDocString = `
+Returns a matcher which succeeds if the tested value is equal to the given value
+`
+
+var testEqualFunctionType = &sema.FunctionType{
+	Parameters: []*sema.Parameter{
+		{
+			Label:          sema.ArgumentLabelNotRequired,
+			Identifier:     "value",
+			TypeAnnotation: sema.NewTypeAnnotation(sema.AnyStructType),
+		},
+	},
+	ReturnTypeAnnotation: sema.NewTypeAnnotation(testMatcherType),
+}
+
+const testNewEmulatorBlockchainFunctionName = "newEmulatorBlockchain"
+
+const testNewEmulatorBlockchainFunctionDocString = `
+Returns a new, empty emulator blockchain
+`
+
+var testNewEmulatorBlockchainFunctionType = &sema.FunctionType{
+	ReturnTypeAnnotation: sema.NewTypeAnnotation(testBlockchainType),
+}
+
+const testReadFileFunctionName = "readFile"
+
+const testReadFileFunctionDocString = `
+Returns the content of the file at the given path
+`
+
+var testReadFileFunctionType = &sema.FunctionType{
+	Parameters: []*sema.Parameter{
+		{
+			Label:          sema.ArgumentLabelNotRequired,
+			Identifier:     "path",
+			TypeAnnotation: sema.NewTypeAnnotation(sema.StringType),
+		},
+	},
+	ReturnTypeAnnotation: sema.NewTypeAnnotation(sema.StringType),
+}
+
+func init() {
+	// Enrich the Test contract type with the natively implemented functions.
+	// The values are added to the contract value when it is loaded, see NewTestContract
+
+	addMember := func(name string, functionType *sema.FunctionType, docString string) {
+		testContractType.Members.Set(
+			name,
+			sema.NewUnmeteredPublicFunctionMember(
+				testContractType,
+				name,
+				functionType,
+				docString,
+			),
+		)
+	}
+
+	addMember(testAssertFunctionName, assertFunctionType, assertFunctionDocString)
+	addMember(testEqualFunctionName, testEqualFunctionType, testEqualFunctionDocString)
+	addMember(testNewEmulatorBlockchainFunctionName, testNewEmulatorBlockchainFunctionType, testNewEmulatorBlockchainFunctionDocString)
+	addMember(testReadFileFunctionName, testReadFileFunctionType, testReadFileFunctionDocString)
+}
+
+func NewTestContract(
+	inter *interpreter.Interpreter,
+	testFramework TestFramework,
+	constructor interpreter.FunctionValue,
+	invocationRange ast.Range,
+) (
+	*interpreter.CompositeValue,
+	error,
+) {
+	value, err := inter.InvokeFunctionValue(
+		constructor,
+		nil,
+		nil,
+		nil,
+		invocationRange,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	compositeValue := value.(*interpreter.CompositeValue)
+
+	// The functions of the contract value are shared with other values of the type,
+	// so copy them before adding the natively implemented functions
+
+	functions := make(map[string]interpreter.FunctionValue, len(compositeValue.Functions)+4)
+	for name, function := range compositeValue.Functions { //nolint:maprangecheck
+		functions[name] = function
+	}
+
+	functions[testAssertFunctionName] = AssertFunction.Function
+	functions[testEqualFunctionName] = testEqualFunction
+	functions[testNewEmulatorBlockchainFunctionName] =
+		newTestNewEmulatorBlockchainFunction(testFramework, compositeValue)
+	functions[testReadFileFunctionName] = newTestReadFileFunction(testFramework)
+
+	compositeValue.Functions = functions
+
+	return compositeValue, nil
+}
+
+var testEqualFunction = interpreter.NewUnmeteredHostFunctionValue(
+	func(invocation interpreter.Invocation) interpreter.Value {
+		expected := invocation.Arguments[0]
+
+		test := interpreter.NewHostFunctionValue(
+			invocation.Interpreter,
+			func(invocation interpreter.Invocation) interpreter.Value {
+				inter := invocation.Interpreter
+
+				equatableValue, ok := expected.(interpreter.EquatableValue)
+				if !ok {
+					return interpreter.NewBoolValue(inter, false)
+				}
+
+				return interpreter.NewBoolValue(
+					inter,
+					equatableValue.Equal(
+						inter,
+						invocation.GetLocationRange,
+						invocation.Arguments[0],
+					),
+				)
+			},
+			testMatcherTestFunctionType,
+		)
+
+		return newTestCompositeValue(
+			invocation.Interpreter,
+			invocation.GetLocationRange,
+			testMatcherTypeName,
+			interpreter.CompositeField{
+				Name:  testMatcherTestFieldName,
+				Value: test,
+			},
+		)
+	},
+	testEqualFunctionType,
+)
+
+func newTestReadFileFunction(testFramework TestFramework) *interpreter.HostFunctionValue {
+	return interpreter.NewUnmeteredHostFunctionValue(
+		func(invocation interpreter.Invocation) interpreter.Value {
+			pathValue, ok := invocation.Arguments[0].(*interpreter.StringValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			path := pathValue.Str
+
+			content, err := testFramework.ReadFile(path)
+			if err != nil {
+				panic(errors.NewDefaultUserError("cannot read file `%s`: %s", path, err))
+			}
+
+			return newTestStringValue(invocation.Interpreter, content)
+		},
+		testReadFileFunctionType,
+	)
+}
+
+func newTestNewEmulatorBlockchainFunction(
+	testFramework TestFramework,
+	testContract *interpreter.CompositeValue,
+) *interpreter.HostFunctionValue {
+	return interpreter.NewUnmeteredHostFunctionValue(
+		func(invocation interpreter.Invocation) interpreter.Value {
+			blockchain := testFramework.NewEmulatorBlockchain()
+
+			return newTestBlockchainValue(
+				invocation.Interpreter,
+				invocation.GetLocationRange,
+				testContract,
+				blockchain,
+			)
+		},
+		testNewEmulatorBlockchainFunctionType,
+	)
+}
+
+// newTestBlockchainValue returns a new Test.Blockchain value.
+// The operations of the blockchain are implemented by host functions,
+// which are stored in function-typed fields
+func newTestBlockchainValue(
+	inter *interpreter.Interpreter,
+	getLocationRange func() interpreter.LocationRange,
+	testContract *interpreter.CompositeValue,
+	blockchain TestBlockchain,
+) *interpreter.CompositeValue {
+
+	newField := func(name string, function interpreter.HostFunction) interpreter.CompositeField {
+		return interpreter.CompositeField{
+			Name: name,
+			Value: interpreter.NewHostFunctionValue(
+				inter,
+				function,
+				testFunctionFieldType(testBlockchainType, name),
+			),
+		}
+	}
+
+	executeScript := func(invocation interpreter.Invocation) interpreter.Value {
+		inter := invocation.Interpreter
+		getLocationRange := invocation.GetLocationRange
+
+		script, ok := invocation.Arguments[0].(*interpreter.StringValue)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		arguments := testArrayElements(inter, invocation.Arguments[1])
+
+		value, err := blockchain.ExecuteScript(
+			inter,
+			getLocationRange,
+			script.Str,
+			arguments,
+		)
+
+		var returnValue interpreter.Value = interpreter.NilValue{}
+		if err == nil {
+			returnValue = interpreter.NewSomeValueNonCopying(inter, value)
+		}
+
+		return newTestCompositeValue(
+			inter,
+			getLocationRange,
+			testScriptResultTypeName,
+			interpreter.CompositeField{
+				Name:  testResultStatusFieldName,
+				Value: newTestResultStatusValue(inter, getLocationRange, testContract, err),
+			},
+			interpreter.CompositeField{
+				Name:  testScriptResultReturnValueFieldName,
+				Value: returnValue,
+			},
+			interpreter.CompositeField{
+				Name:  testResultErrorFieldName,
+				Value: newTestErrorValue(inter, getLocationRange, err),
+			},
+		)
+	}
+
+	executeTransaction := func(invocation interpreter.Invocation) interpreter.Value {
+		inter := invocation.Interpreter
+		getLocationRange := invocation.GetLocationRange
+
+		transaction, ok := invocation.Arguments[0].(*interpreter.CompositeValue)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		code, ok := transaction.GetMember(inter, getLocationRange, testTransactionCodeFieldName).(*interpreter.StringValue)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		signerValues := testArrayElements(
+			inter,
+			transaction.GetMember(inter, getLocationRange, testTransactionSignersFieldName),
+		)
+		signers := make([]common.Address, 0, len(signerValues))
+		for _, signerValue := range signerValues {
+			signers = append(signers, testAccountAddress(inter, getLocationRange, signerValue))
+		}
+
+		arguments := testArrayElements(
+			inter,
+			transaction.GetMember(inter, getLocationRange, testTransactionArgumentsFieldName),
+		)
+
+		err := blockchain.ExecuteTransaction(
+			inter,
+			getLocationRange,
+			code.Str,
+			signers,
+			arguments,
+		)
+
+		return newTestCompositeValue(
+			inter,
+			getLocationRange,
+			testTransactionResultTypeName,
+			interpreter.CompositeField{
+				Name:  testResultStatusFieldName,
+				Value: newTestResultStatusValue(inter, getLocationRange, testContract, err),
+			},
+			interpreter.CompositeField{
+				Name:  testResultErrorFieldName,
+				Value: newTestErrorValue(inter, getLocationRange, err),
+			},
+		)
+	}
+
+	createAccount := func(invocation interpreter.Invocation) interpreter.Value {
+		address, err := blockchain.CreateAccount()
+		if err != nil {
+			panic(err)
+		}
+
+		return newTestAccountValue(
+			invocation.Interpreter,
+			invocation.GetLocationRange,
+			address,
+		)
+	}
+
+	deployContract := func(invocation interpreter.Invocation) interpreter.Value {
+		inter := invocation.Interpreter
+		getLocationRange := invocation.GetLocationRange
+
+		name, ok := invocation.Arguments[0].(*interpreter.StringValue)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		code, ok := invocation.Arguments[1].(*interpreter.StringValue)
+		if !ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		address := testAccountAddress(inter, getLocationRange, invocation.Arguments[2])
+
+		err := blockchain.DeployContract(name.Str, code.Str, address)
+
+		return newTestErrorValue(inter, getLocationRange, err)
+	}
+
+	commitBlock := func(invocation interpreter.Invocation) interpreter.Value {
+		blockchain.CommitBlock()
+		return interpreter.NewVoidValue(invocation.Interpreter)
+	}
+
+	events := func(invocation interpreter.Invocation) interpreter.Value {
+		inter := invocation.Interpreter
+		getLocationRange := invocation.GetLocationRange
+
+		events, err := blockchain.Events(inter, getLocationRange)
+		if err != nil {
+			panic(err)
+		}
+
+		return interpreter.NewArrayValue(
+			inter,
+			getLocationRange,
+			interpreter.NewVariableSizedStaticType(
+				inter,
+				interpreter.NewPrimitiveStaticType(inter, interpreter.PrimitiveStaticTypeAnyStruct),
+			),
+			common.Address{},
+			events...,
+		)
+	}
+
+	return newTestCompositeValue(
+		inter,
+		getLocationRange,
+		testBlockchainTypeName,
+		newField(testBlockchainExecuteScriptFieldName, executeScript),
+		newField(testBlockchainExecuteTransactionFieldName, executeTransaction),
+		newField(testBlockchainCreateAccountFieldName, createAccount),
+		newField(testBlockchainDeployContractFieldName, deployContract),
+		newField(testBlockchainCommitBlockFieldName, commitBlock),
+		newField(testBlockchainEventsFieldName, events),
+	)
+}
+
+func newTestCompositeValue(
+	inter *interpreter.Interpreter,
+	getLocationRange func() interpreter.LocationRange,
+	typeName string,
+	fields ...interpreter.CompositeField,
+) *interpreter.CompositeValue {
+	return interpreter.NewCompositeValue(
+		inter,
+		getLocationRange,
+		TestContractLocation,
+		testNestedCompositeType(typeName).QualifiedIdentifier(),
+		common.CompositeKindStructure,
+		fields,
+		common.Address{},
+	)
+}
+
+func newTestStringValue(inter *interpreter.Interpreter, str string) *interpreter.StringValue {
+	return interpreter.NewStringValue(
+		inter,
+		common.NewStringMemoryUsage(len(str)),
+		func() string {
+			return str
+		},
+	)
+}
+
+func newTestResultStatusValue(
+	inter *interpreter.Interpreter,
+	getLocationRange func() interpreter.LocationRange,
+	testContract *interpreter.CompositeValue,
+	err error,
+) interpreter.Value {
+	constructor, ok := testContract.GetMember(inter, getLocationRange, testResultStatusTypeName).(*interpreter.HostFunctionValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	caseName := testResultStatusSucceededCaseName
+	if err != nil {
+		caseName = testResultStatusFailedCaseName
+	}
+
+	// The enum case is shared, so it must be copied before it is added to a new value
+
+	return constructor.GetMember(inter, getLocationRange, caseName).
+		Transfer(inter, getLocationRange, atree.Address{}, false, nil)
+}
+
+// newTestErrorValue returns an optional Test.Error for the given error
+func newTestErrorValue(
+	inter *interpreter.Interpreter,
+	getLocationRange func() interpreter.LocationRange,
+	err error,
+) interpreter.OptionalValue {
+	if err == nil {
+		return interpreter.NilValue{}
+	}
+
+	return interpreter.NewSomeValueNonCopying(
+		inter,
+		newTestCompositeValue(
+			inter,
+			getLocationRange,
+			testErrorTypeName,
+			interpreter.CompositeField{
+				Name:  testErrorMessageFieldName,
+				Value: newTestStringValue(inter, err.Error()),
+			},
+		),
+	)
+}
+
+func newTestAccountValue(
+	inter *interpreter.Interpreter,
+	getLocationRange func() interpreter.LocationRange,
+	address common.Address,
+) *interpreter.CompositeValue {
+	return newTestCompositeValue(
+		inter,
+		getLocationRange,
+		testAccountTypeName,
+		interpreter.CompositeField{
+			Name:  testAccountAddressFieldName,
+			Value: interpreter.NewAddressValue(inter, address),
+		},
+	)
+}
+
+func testAccountAddress(
+	inter *interpreter.Interpreter,
+	getLocationRange func() interpreter.LocationRange,
+	value interpreter.Value,
+) common.Address {
+	account, ok := value.(*interpreter.CompositeValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	address, ok := account.GetMember(inter, getLocationRange, testAccountAddressFieldName).(interpreter.AddressValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	return address.ToAddress()
+}
+
+func testArrayElements(inter *interpreter.Interpreter, value interpreter.Value) []interpreter.Value {
+	array, ok := value.(*interpreter.ArrayValue)
+	if !ok {
+		panic(errors.NewUnreachableError())
+	}
+
+	elements := make([]interpreter.Value, 0, array.Count())
+	array.Iterate(inter, func(element interpreter.Value) (resume bool) {
+		elements = append(elements, element)
+		return true
+	})
+
+	return elements
+}
@@ -39,6 +39,15 @@ type Storage struct {
 	contractUpdates map[interpreter.StorageKey]*interpreter.CompositeValue
 	Ledger          atree.Ledger
 	memoryGauge     common.MemoryGauge
+	slabCheckpoints *interpreter.SlabCheckpoints
+	checkpoints     []storageCheckpoint
+}
+
+// storageCheckpoint is the state of the storage when a checkpoint was started
+//
+type storageCheckpoint struct {
+	writes      map[interpreter.StorageKey]atree.StorageIndex
+	storageMaps map[interpreter.StorageKey]*interpreter.StorageMap
 }
 
 var _ atree.SlabStorage = &Storage{}
@@ -78,6 +87,11 @@ func NewStorage(ledger atree.Ledger, memoryGauge common.MemoryGauge) *Storage {
 		storageMaps:           map[interpreter.StorageKey]*interpreter.StorageMap{},
 		contractUpdates:       map[interpreter.StorageKey]*interpreter.CompositeValue{},
 		memoryGauge:           memoryGauge,
+		slabCheckpoints: interpreter.NewSlabCheckpoints(
+			persistentSlabStorage,
+			decodeStorable,
+			decodeTypeInfo,
+		),
 	}
 }
 
@@ -320,6 +334,93 @@ func (s *Storage) Commit(inter *interpreter.Interpreter, commitContractUpdates b
 	return s.PersistentSlabStorage.FastCommit(runtime.NumCPU())
 }
 
+func (s *Storage) GenerateStorageID(address atree.Address) (atree.StorageID, error) {
+	id, err := s.PersistentSlabStorage.GenerateStorageID(address)
+	if err != nil {
+		return atree.StorageID{}, err
+	}
+	s.slabCheckpoints.RecordNew(id)
+	return id, nil
+}
+
+func (s *Storage) Retrieve(id atree.StorageID) (atree.Slab, bool, error) {
+	err := s.slabCheckpoints.Record(id)
+	if err != nil {
+		return nil, false, err
+	}
+	return s.PersistentSlabStorage.Retrieve(id)
+}
+
+func (s *Storage) Store(id atree.StorageID, slab atree.Slab) error {
+	err := s.slabCheckpoints.Record(id)
+	if err != nil {
+		return err
+	}
+	return s.PersistentSlabStorage.Store(id, slab)
+}
+
+func (s *Storage) Remove(id atree.StorageID) error {
+	err := s.slabCheckpoints.Record(id)
+	if err != nil {
+		return err
+	}
+	return s.PersistentSlabStorage.Remove(id)
+}
+
+// Checkpoint starts a new, nested checkpoint.
+// All changes to the storage made after the checkpoint was started,
+// i.e. changes to slabs and to storage maps,
+// can be reverted using RevertCheckpoint.
+//
+func (s *Storage) Checkpoint() {
+	checkpoint := storageCheckpoint{
+		writes:      make(map[interpreter.StorageKey]atree.StorageIndex, len(s.writes)),
+		storageMaps: make(map[interpreter.StorageKey]*interpreter.StorageMap, len(s.storageMaps)),
+	}
+
+	// NOTE: ranging over maps is safe (deterministic),
+	// as the loops only copy the maps
+
+	for key, index := range s.writes { //nolint:maprangecheck
+		checkpoint.writes[key] = index
+	}
+	for key, storageMap := range s.storageMaps { //nolint:maprangecheck
+		checkpoint.storageMaps[key] = storageMap
+	}
+
+	s.checkpoints = append(s.checkpoints, checkpoint)
+	s.slabCheckpoints.Push()
+}
+
+// CommitCheckpoint ends the current checkpoint and keeps all changes made since it was started.
+//
+func (s *Storage) CommitCheckpoint() {
+	s.slabCheckpoints.Commit()
+	s.checkpoints = s.checkpoints[:len(s.checkpoints)-1]
+}
+
+// RevertCheckpoint ends the current checkpoint and reverts all changes made since it was started.
+//
+func (s *Storage) RevertCheckpoint() error {
+	err := s.slabCheckpoints.Revert()
+	if err != nil {
+		return err
+	}
+
+	count := len(s.checkpoints)
+	checkpoint := s.checkpoints[count-1]
+	s.checkpoints = s.checkpoints[:count-1]
+
+	s.writes = checkpoint.writes
+	s.storageMaps = checkpoint.storageMaps
+
+	return nil
+}
+
+func (s *Storage) RecordSlab(id atree.StorageID) error {
+	return s.slabCheckpoints.Record(id)
+}
+
 func (s *Storage) CheckHealth() error {
 	// Check slab storage health
 	rootSlabIDs, err := atree.CheckStorageHealth(s, -1)
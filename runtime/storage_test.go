@@ -3179,6 +3179,139 @@ func TestRuntimeStorageEnumCase(t *testing.T) {
 	)
 }
 
+func TestRuntimeStorageHashableKey(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := newTestInterpreterRuntime()
+
+	address := common.MustBytesToAddress([]byte{0x1})
+
+	accountCodes := map[common.Location][]byte{}
+	var loggedMessages []string
+
+	runtimeInterface := &testRuntimeInterface{
+		storage: newTestLedger(nil, nil),
+		getSigningAccounts: func() ([]Address, error) {
+			return []Address{address}, nil
+		},
+		resolveLocation: singleIdentifierLocationResolver(t),
+		updateAccountContractCode: func(address Address, name string, code []byte) error {
+			location := common.AddressLocation{
+				Address: address,
+				Name:    name,
+			}
+			accountCodes[location] = code
+			return nil
+		},
+		getAccountContractCode: func(address Address, name string) (code []byte, err error) {
+			location := common.AddressLocation{
+				Address: address,
+				Name:    name,
+			}
+			code = accountCodes[location]
+			return code, nil
+		},
+		emitEvent: func(event cadence.Event) error {
+			return nil
+		},
+		log: func(message string) {
+			loggedMessages = append(loggedMessages, message)
+		},
+	}
+
+	nextTransactionLocation := newTransactionLocationGenerator()
+
+	// Deploy contract
+
+	err := runtime.ExecuteTransaction(
+		Script{
+			Source: utils.DeploymentTransaction(
+				"C",
+				[]byte(`
+                  pub contract C {
+
+                    pub struct Point: Hashable {
+                        pub let x: Int
+                        pub let y: Int
+                        pub let label: String
+
+                        init(x: Int, y: Int, label: String) {
+                            self.x = x
+                            self.y = y
+                            self.label = label
+                        }
+                    }
+                  }
+                `),
+			),
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	// Store dictionary with hashable keys
+
+	err = runtime.ExecuteTransaction(
+		Script{
+			Source: []byte(`
+              import C from 0x1
+
+              transaction {
+                  prepare(signer: AuthAccount) {
+                      let names: {C.Point: String} = {
+                          C.Point(x: 1, y: 2, label: "a"): "first",
+                          C.Point(x: 2, y: 1, label: "a"): "second"
+                      }
+                      signer.save(names, to: /storage/names)
+                  }
+               }
+            `),
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	// Look up stored dictionary with new keys
+
+	err = runtime.ExecuteTransaction(
+		Script{
+			Source: []byte(`
+              import C from 0x1
+
+              transaction {
+                  prepare(signer: AuthAccount) {
+                      let names = signer.borrow<&{C.Point: String}>(from: /storage/names)!
+                      log(names[C.Point(x: 1, y: 2, label: "a")])
+                      log(names[C.Point(x: 2, y: 1, label: "a")])
+                      log(names[C.Point(x: 1, y: 2, label: "b")])
+                  }
+               }
+            `),
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	require.Equal(t,
+		[]string{
+			`"first"`,
+			`"second"`,
+			"nil",
+		},
+		loggedMessages,
+	)
+}
+
 func TestStorageReadNoImplicitWrite(t *testing.T) {
 
 	t.Parallel()
@@ -1508,3 +1508,183 @@ func TestPublicAccountContracts(t *testing.T) {
 	})
 
 }
+
+func TestCheckAccount_StoragePaths(t *testing.T) {
+
+	t.Parallel()
+
+	type testCase struct {
+		accountVariable string
+		fieldName       string
+		pathType        sema.Type
+	}
+
+	for _, test := range []testCase{
+		{"authAccount", "storagePaths", sema.StoragePathType},
+		{"authAccount", "publicPaths", sema.PublicPathType},
+		{"authAccount", "privatePaths", sema.PrivatePathType},
+		{"publicAccount", "publicPaths", sema.PublicPathType},
+	} {
+
+		test := test
+
+		t.Run(fmt.Sprintf("%s.%s", test.accountVariable, test.fieldName), func(t *testing.T) {
+
+			t.Parallel()
+
+			checker, err := ParseAndCheckAccount(t,
+				fmt.Sprintf(
+					`
+                      let paths = %s.%s
+                    `,
+					test.accountVariable,
+					test.fieldName,
+				),
+			)
+			require.NoError(t, err)
+
+			pathsType := RequireGlobalValue(t, checker.Elaboration, "paths")
+
+			assert.Equal(t,
+				&sema.VariableSizedType{
+					Type: test.pathType,
+				},
+				pathsType,
+			)
+		})
+	}
+
+	t.Run("publicAccount.storagePaths", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckAccount(t, `
+            let paths = publicAccount.storagePaths
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+	})
+
+	t.Run("assignment", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckAccount(t, `
+            fun test() {
+                authAccount.storagePaths = []
+            }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.InvalidAssignmentAccessError{}, errs[0])
+		assert.IsType(t, &sema.AssignmentToConstantMemberError{}, errs[1])
+	})
+}
+
+func TestCheckAccount_ForEach(t *testing.T) {
+
+	t.Parallel()
+
+	type testCase struct {
+		accountVariable string
+		functionName    string
+		pathType        string
+	}
+
+	for _, test := range []testCase{
+		{"authAccount", "forEachStored", "StoragePath"},
+		{"authAccount", "forEachPublic", "PublicPath"},
+		{"authAccount", "forEachPrivate", "PrivatePath"},
+		{"publicAccount", "forEachPublic", "PublicPath"},
+	} {
+
+		test := test
+
+		t.Run(fmt.Sprintf("%s.%s", test.accountVariable, test.functionName), func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := ParseAndCheckAccount(t,
+				fmt.Sprintf(
+					`
+                      fun test() {
+                          %s.%s(fun (path: %s, type: Type): Bool {
+                              return true
+                          })
+                      }
+                    `,
+					test.accountVariable,
+					test.functionName,
+					test.pathType,
+				),
+			)
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("supertype path parameter", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckAccount(t, `
+            fun test() {
+                authAccount.forEachStored(fun (path: Path, type: Type): Bool {
+                    return true
+                })
+            }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("wrong path type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckAccount(t, `
+            fun test() {
+                authAccount.forEachStored(fun (path: PublicPath, type: Type): Bool {
+                    return true
+                })
+            }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("missing return", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckAccount(t, `
+            fun test() {
+                authAccount.forEachStored(fun (path: StoragePath, type: Type) {})
+            }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("forEachStored on public account", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckAccount(t, `
+            fun test() {
+                publicAccount.forEachStored(fun (path: StoragePath, type: Type): Bool {
+                    return true
+                })
+            }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+	})
+}
@@ -864,6 +864,197 @@ func TestCheckInvalidArrayContainsNotEquatable(t *testing.T) {
 	assert.IsType(t, &sema.NotEquatableTypeError{}, errs[0])
 }
 
+func TestCheckArrayHigherOrderFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      let xs = [1, 2, 3]
+      let fixed: [Int; 3] = [1, 2, 3]
+
+      let strings = xs.map(fun (x: Int): String {
+          return x.toString()
+      })
+      let fixedStrings = fixed.map(fun (x: Int): String {
+          return x.toString()
+      })
+      let evens = fixed.filter(fun (x: Int): Bool {
+          return x % 2 == 0
+      })
+      let sum = xs.reduce(initial: 0, fun (sum: Int, x: Int): Int {
+          return sum + x
+      })
+      let reversed = xs.reverse()
+      let fixedReversed = fixed.reverse()
+
+      fun test() {
+          xs.forEach(fun (x: Int) {})
+          xs.sort(by: fun (a: Int, b: Int): Bool {
+              return a < b
+          })
+      }
+    `)
+
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		&sema.VariableSizedType{
+			Type: sema.StringType,
+		},
+		RequireGlobalValue(t, checker.Elaboration, "strings"),
+	)
+
+	assert.Equal(t,
+		&sema.ConstantSizedType{
+			Type: sema.StringType,
+			Size: 3,
+		},
+		RequireGlobalValue(t, checker.Elaboration, "fixedStrings"),
+	)
+
+	assert.Equal(t,
+		&sema.VariableSizedType{
+			Type: sema.IntType,
+		},
+		RequireGlobalValue(t, checker.Elaboration, "evens"),
+	)
+
+	assert.Equal(t,
+		sema.IntType,
+		RequireGlobalValue(t, checker.Elaboration, "sum"),
+	)
+
+	assert.Equal(t,
+		&sema.VariableSizedType{
+			Type: sema.IntType,
+		},
+		RequireGlobalValue(t, checker.Elaboration, "reversed"),
+	)
+
+	assert.Equal(t,
+		&sema.ConstantSizedType{
+			Type: sema.IntType,
+			Size: 3,
+		},
+		RequireGlobalValue(t, checker.Elaboration, "fixedReversed"),
+	)
+}
+
+func TestCheckInvalidArrayHigherOrderFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("map, wrong parameter type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let xs = [1, 2, 3].map(fun (x: String): String {
+              return x
+          })
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("map, resource result", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test() {
+              let rs <- [1, 2, 3].map(fun (x: Int): @R {
+                  return <-create R()
+              })
+              destroy rs
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("filter, non-boolean result", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let xs = [1, 2, 3].filter(fun (x: Int): Int {
+              return x
+          })
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("reduce, wrong element type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let x = [1, 2, 3].reduce(initial: 0, fun (sum: Int, x: String): Int {
+              return sum
+          })
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("sort, missing label", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              [1, 2, 3].sort(fun (a: Int, b: Int): Bool {
+                  return a < b
+              })
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingArgumentLabelError{}, errs[0])
+	})
+
+	t.Run("sort, external mutation", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          pub contract C {
+              pub struct S {
+                  pub let xs: [Int]
+
+                  init() {
+                      self.xs = [3, 2, 1]
+                  }
+              }
+
+              pub fun test() {
+                  let s = S()
+                  s.xs.sort(by: fun (a: Int, b: Int): Bool {
+                      return a < b
+                  })
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ExternalMutationError{}, errs[0])
+	})
+}
+
 func TestCheckEmptyArray(t *testing.T) {
 
 	t.Parallel()
@@ -918,6 +1109,64 @@ func TestCheckInvalidDictionaryContainsKey(t *testing.T) {
 	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
 }
 
+func TestCheckDictionaryForEach(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      fun test() {
+          let x = {"one": 1, "two": 2}
+          x.forEachKey(fun (key: String): Bool {
+              return true
+          })
+          x.forEach(fun (key: String, value: Int): Bool {
+              return key == "one" && value == 1
+          })
+      }
+    `)
+
+	require.NoError(t, err)
+}
+
+func TestCheckInvalidDictionaryForEach(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("forEachKey, wrong key type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let x = {"one": 1}
+              x.forEachKey(fun (key: Int): Bool {
+                  return true
+              })
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("forEach, missing result", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let x = {"one": 1}
+              x.forEach(fun (key: String, value: Int) {})
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
+
 func TestCheckEmptyDictionary(t *testing.T) {
 
 	t.Parallel()
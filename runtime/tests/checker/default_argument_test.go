@@ -0,0 +1,369 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func TestCheckDefaultArgument(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("function", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let base = 10
+
+          fun add(_ a: Int, _ b: Int = base, c: Int = a + b): Int {
+              return a + b + c
+          }
+
+          let x = add(1)
+          let y = add(1, 2)
+          let z = add(1, 2, c: 3)
+        `)
+		require.NoError(t, err)
+
+		addType := RequireGlobalValue(t, checker.Elaboration, "add")
+		require.IsType(t, &sema.FunctionType{}, addType)
+
+		assert.Equal(t,
+			sema.RequiredArgumentCount(1),
+			addType.(*sema.FunctionType).RequiredArgumentCount,
+		)
+
+		parameters := addType.(*sema.FunctionType).Parameters
+		require.Len(t, parameters, 3)
+
+		assert.Equal(t, "", parameters[0].DefaultArgumentString())
+		assert.Equal(t, "base", parameters[1].DefaultArgumentString())
+		assert.Equal(t, "a + b", parameters[2].DefaultArgumentString())
+	})
+
+	t.Run("composite function and initializer", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource Minter {
+              let amount: UFix64
+
+              init(amount: UFix64 = 1.0) {
+                  self.amount = amount
+              }
+
+              fun mint(amount: UFix64 = self.amount, recipient: Address = 0x1): UFix64 {
+                  return amount
+              }
+          }
+
+          fun test() {
+              let minter <- create Minter()
+              minter.mint()
+              minter.mint(amount: 2.0)
+              minter.mint(amount: 2.0, recipient: 0x2)
+              destroy minter
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("function expression", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let f = fun (_ a: Int = 1): Int {
+              return a
+          }
+
+          let x = f()
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("constant expressions", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(
+              a: [Int] = [1, 2],
+              b: {String: Int} = {"one": 1},
+              c: Int = true ? 1 : 2,
+              d: Int? = nil,
+              e: Int = -a[0],
+              f: Int = a.length,
+              g: StoragePath = /storage/test
+          ) {}
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("optional resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(_ r: @R? = nil) {
+              destroy r
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("missing required argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(a: Int, b: Int = 1) {}
+
+          let x = test()
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ArgumentCountError{}, errs[0])
+	})
+
+	t.Run("incorrect argument label", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(a: Int, b: Int = 1) {}
+
+          let x = test(a: 1, c: 2)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.IncorrectArgumentLabelError{}, errs[0])
+	})
+
+	t.Run("type mismatch", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(a: Int = "one") {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("missing default argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(a: Int = 1, b: Int) {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingDefaultArgumentError{}, errs[0])
+	})
+
+	t.Run("following parameter", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(a: Int = b, b: Int = 1) {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+
+	t.Run("uninitialized field", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              let x: Int
+
+              init(x: Int = self.x) {
+                  self.x = x
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.UninitializedFieldAccessError{}, errs[0])
+	})
+}
+
+func TestCheckInvalidNonConstantDefaultArgument(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(name string, code string) {
+		t.Run(name, func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := ParseAndCheck(t, code)
+
+			errs := ExpectCheckerErrors(t, err, 1)
+
+			assert.IsType(t, &sema.NonConstantDefaultArgumentError{}, errs[0])
+		})
+	}
+
+	test("variable", `
+      var x = 1
+
+      fun test(a: Int = x) {}
+    `)
+
+	test("invocation", `
+      fun one(): Int {
+          return 1
+      }
+
+      fun test(a: Int = one()) {}
+    `)
+
+	test("function expression", `
+      fun test(f: ((): Int) = fun (): Int { return 1 }) {}
+    `)
+
+	test("resource", `
+      resource R {}
+
+      fun test(r: @R = create R()) {
+          destroy r
+      }
+    `)
+}
+
+func TestCheckInvalidUnsupportedDefaultArgument(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(name string, code string) {
+		t.Run(name, func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := ParseAndCheck(t, code)
+
+			errs := ExpectCheckerErrors(t, err, 1)
+
+			assert.IsType(t, &sema.UnsupportedDefaultArgumentError{}, errs[0])
+		})
+	}
+
+	test("event", `
+      event Test(a: Int = 1)
+    `)
+
+	test("transaction", `
+      transaction(a: Int = 1) {}
+    `)
+
+	test("interface function", `
+      struct interface I {
+          fun test(a: Int = 1)
+      }
+    `)
+
+	test("interface initializer", `
+      struct interface I {
+          init(a: Int = 1)
+      }
+    `)
+
+	test("overloaded function", `
+      struct S {
+          fun test(a: Int = 1) {}
+
+          fun test(b: Int) {}
+      }
+    `)
+
+	test("overloaded initializer", `
+      struct S {
+          init(a: Int = 1) {}
+
+          init(b: Int) {}
+      }
+    `)
+}
+
+func TestCheckInvalidDefaultArgumentConformance(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I {
+              fun test(a: Int)
+          }
+
+          struct S: I {
+              fun test(a: Int = 1) {}
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ConformanceError{}, errs[0])
+	})
+
+	t.Run("initializer", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I {
+              init(a: Int)
+          }
+
+          struct S: I {
+              init(a: Int = 1) {}
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ConformanceError{}, errs[0])
+	})
+}
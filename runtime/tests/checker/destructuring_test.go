@@ -0,0 +1,687 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func TestCheckArrayDestructuring(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("variable-sized", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          fun test(): Int {
+              let [a, b, _] = [1, 2, 3]
+              return a + b
+          }
+        `)
+		require.NoError(t, err)
+
+		assert.Len(t, checker.Elaboration.PatternTypes, 4)
+	})
+
+	t.Run("constant-sized", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(): Int {
+              let xs: [Int; 2] = [1, 2]
+              let [a, b] = xs
+              return a + b
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("constant-sized, count mismatch", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let xs: [Int; 2] = [1, 2]
+              let [a, b, c] = xs
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		var countErr *sema.PatternElementCountMismatchError
+		require.ErrorAs(t, errs[0], &countErr)
+		assert.Equal(t, 2, countErr.ExpectedCount)
+		assert.Equal(t, 3, countErr.ActualCount)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          fun test(): String {
+              let [[a], [b, c]] = [["a"], ["b", "c"]]
+              return a.concat(b).concat(c)
+          }
+        `)
+		require.NoError(t, err)
+
+		assert.Len(t, checker.Elaboration.PatternTypes, 6)
+	})
+
+	t.Run("var", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              var [a, b] = [1, 2]
+              a = b
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("let", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let [a, b] = [1, 2]
+              a = b
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.AssignmentToConstantError{}, errs[0])
+	})
+
+	t.Run("duplicate binding", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let [a, a] = [1, 2]
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+	})
+
+	t.Run("invalid type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let [a, b] = {1: 2}
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidPatternTypeError{}, errs[0])
+	})
+}
+
+func TestCheckDictionaryDestructuring(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          fun test(): Int? {
+              let {"a": a, "b": b} = {"a": 1, "b": 2}
+              return a
+          }
+        `)
+		require.NoError(t, err)
+
+		for pattern, ty := range checker.Elaboration.PatternTypes {
+			if pattern.String() == "a" {
+				assert.Equal(t,
+					&sema.OptionalType{Type: sema.IntType},
+					ty,
+				)
+			}
+		}
+	})
+
+	t.Run("key type mismatch", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let {1: a} = {"a": 1}
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test() {
+              let {"a": a} <- {"a": <-create R()}
+              destroy a
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidResourceDestructuringError{}, errs[0])
+	})
+}
+
+func TestCheckCompositeDestructuring(t *testing.T) {
+
+	t.Parallel()
+
+	const pairDeclaration = `
+      struct Pair {
+          let first: Int
+          let second: String
+
+          init(first: Int, second: String) {
+              self.first = first
+              self.second = second
+          }
+      }
+    `
+
+	t.Run("positional", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, pairDeclaration+`
+          fun test(): String {
+              let (a, b) = Pair(first: 1, second: "2")
+              let x: Int = a
+              return b
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("positional, count mismatch", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, pairDeclaration+`
+          fun test() {
+              let (a) = Pair(first: 1, second: "2")
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.PatternElementCountMismatchError{}, errs[0])
+	})
+
+	t.Run("labeled", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, pairDeclaration+`
+          fun test(): String {
+              let (second: b) = Pair(first: 1, second: "2")
+              return b
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("labeled, unknown field", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, pairDeclaration+`
+          fun test() {
+              let (third: c) = Pair(first: 1, second: "2")
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+	})
+
+	t.Run("labeled, duplicate field", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, pairDeclaration+`
+          fun test() {
+              let (first: a, first: b) = Pair(first: 1, second: "2")
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.DuplicatePatternFieldError{}, errs[0])
+	})
+
+	t.Run("inaccessible field", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          pub contract C {
+
+              pub struct S {
+                  priv let secret: Int
+
+                  init() {
+                      self.secret = 42
+                  }
+              }
+          }
+
+          fun test() {
+              let (secret: s) = C.S()
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidAccessError{}, errs[0])
+	})
+
+	t.Run("invalid type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let (a, b) = [1, 2]
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidPatternTypeError{}, errs[0])
+	})
+}
+
+func TestCheckResourceDestructuring(t *testing.T) {
+
+	t.Parallel()
+
+	const declarations = `
+      resource R {}
+
+      resource Pair {
+          let first: @R
+          let second: @R
+          let count: Int
+
+          init() {
+              self.first <- create R()
+              self.second <- create R()
+              self.count = 2
+          }
+
+          destroy() {
+              destroy self.first
+              destroy self.second
+          }
+      }
+    `
+
+	t.Run("composite", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, declarations+`
+          fun test() {
+              let (a, b, _) <- create Pair()
+              destroy a
+              destroy b
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("composite, labeled", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, declarations+`
+          fun test() {
+              let (second: b, count: _, first: a) <- create Pair()
+              destroy a
+              destroy b
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("composite, labeled, unmatched field, destructor", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, declarations+`
+          fun test() {
+              let (second: b, first: a) <- create Pair()
+              destroy a
+              destroy b
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.NonExhaustiveResourceDestructuringError{}, errs[0])
+		assert.Equal(t,
+			[]string{"count"},
+			errs[0].(*sema.NonExhaustiveResourceDestructuringError).MissingFields,
+		)
+	})
+
+	t.Run("composite, labeled, unmatched field, no destructor", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource Ticket {
+              let id: UInt64
+              let seat: String
+
+              init() {
+                  self.id = 1
+                  self.seat = "A1"
+              }
+          }
+
+          fun test() {
+              let (seat: seat) <- create Ticket()
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("composite, unmatched resource field", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, declarations+`
+          fun test() {
+              let (first: a, count: _) <- create Pair()
+              destroy a
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.NonExhaustiveResourceDestructuringError{}, errs[0])
+		assert.IsType(t, &sema.ResourceLossError{}, errs[1])
+	})
+
+	t.Run("composite, blank resource field", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, declarations+`
+          fun test() {
+              let (a, _, c) <- create Pair()
+              destroy a
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ResourceLossError{}, errs[0])
+	})
+
+	t.Run("composite, bound resource not moved", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, declarations+`
+          fun test() {
+              let (a, b, c) <- create Pair()
+              destroy a
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ResourceLossError{}, errs[0])
+	})
+
+	t.Run("composite, use after move", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, declarations+`
+          fun test() {
+              let pair <- create Pair()
+              let (a, b, c) <- pair
+              destroy a
+              destroy b
+              destroy pair
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ResourceUseAfterInvalidationError{}, errs[0])
+	})
+
+	t.Run("composite, missing move operation", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, declarations+`
+          fun test() {
+              let (a, b, c) = create Pair()
+              destroy a
+              destroy b
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.IncorrectTransferOperationError{}, errs[0])
+	})
+
+	t.Run("composite outside of declaring contract", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          pub contract C {
+
+              pub resource R {
+                  pub let id: Int
+
+                  init() {
+                      self.id = 1
+                  }
+              }
+
+              pub fun createR(): @R {
+                  return <-create R()
+              }
+          }
+
+          fun test() {
+              let (id) <- C.createR()
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidResourceDestructuringError{}, errs[0])
+	})
+
+	t.Run("array", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test() {
+              let [a, b] <- [<-create R(), <-create R()]
+              destroy a
+              destroy b
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("composite, nested resources", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, declarations+`
+          resource Outer {
+              let pair: @Pair
+              let r: @R
+
+              init() {
+                  self.pair <- create Pair()
+                  self.r <- create R()
+              }
+
+              destroy() {
+                  destroy self.pair
+                  destroy self.r
+              }
+          }
+
+          fun test(): @[R] {
+              let (pair: (a, b, _), r: c) <- create Outer()
+              return <-[<-a, <-b, <-c]
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("array, blank element", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test() {
+              let [a, _] <- [<-create R(), <-create R()]
+              destroy a
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ResourceLossError{}, errs[0])
+	})
+
+	t.Run("array, bound resource not moved", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test() {
+              let [a, b] <- [<-create R(), <-create R()]
+              destroy a
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ResourceLossError{}, errs[0])
+	})
+
+	t.Run("nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, declarations+`
+          fun test() {
+              let [(a, b, _), (c, d, _)] <- [<-create Pair(), <-create Pair()]
+              destroy a
+              destroy b
+              destroy c
+              destroy d
+          }
+        `)
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckInvalidDestructuringDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("global", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let [a, b] = [1, 2]
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidDestructuringDeclarationError{}, errs[0])
+	})
+
+	t.Run("optional binding", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let xs: [Int]? = [1, 2]
+              if let [a, b] = xs {}
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidDestructuringDeclarationError{}, errs[0])
+	})
+}
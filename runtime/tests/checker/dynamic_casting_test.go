@@ -1292,3 +1292,134 @@ func TestCheckDynamicCastingCapability(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckFailableCastConversion(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("allowed", func(t *testing.T) {
+
+		t.Parallel()
+
+		type testCase struct {
+			valueType  string
+			value      string
+			targetType string
+		}
+
+		testCases := []testCase{
+			{"Int", "1", "UInt8"},
+			{"UInt8", "1", "Int"},
+			{"Integer", "1", "UInt8"},
+			{"Fix64", "1.0", "Int"},
+			{"Int", "1", "UFix64"},
+			{"Int?", "1", "UInt8"},
+			{"[Int]", "[1]", "[UInt8]"},
+			{"[Int; 2]", "[1, 2]", "[UInt8; 2]"},
+			{"[Int]", "[1, 2]", "[UInt8; 2]"},
+			{"{String: Int}", `{"a": 1}`, "{String: UInt8}"},
+			{"{Int: String}", `{1: "a"}`, "{UInt8: String}"},
+			// Values which are not statically numbers are not converted,
+			// but the cast may still succeed at run-time
+			{"AnyStruct", "1", "UInt8"},
+		}
+
+		for _, testCase := range testCases {
+
+			testCase := testCase
+
+			name := fmt.Sprintf("%s as? %s", testCase.valueType, testCase.targetType)
+
+			t.Run(name, func(t *testing.T) {
+
+				t.Parallel()
+
+				checker, err := ParseAndCheck(t,
+					fmt.Sprintf(
+						`
+                          let x: %[1]s = %[2]s
+                          let y = x as? %[3]s
+                        `,
+						testCase.valueType,
+						testCase.value,
+						testCase.targetType,
+					),
+				)
+				require.NoError(t, err)
+
+				yType := RequireGlobalValue(t, checker.Elaboration, "y")
+				require.IsType(t, &sema.OptionalType{}, yType)
+				assert.Equal(t,
+					testCase.targetType,
+					yType.(*sema.OptionalType).Type.String(),
+				)
+			})
+		}
+	})
+
+	t.Run("references are not converted", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let x: Int = 1
+          let ref = &x as &Int
+          let y = ref as? &UInt8
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("resource to number", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test() {
+              let r <- create R()
+              if let x = r as? Int {}
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.AlwaysFailingNonResourceCastingTypeError{}, errs[0])
+		assert.IsType(t, &sema.ResourceLossError{}, errs[1])
+	})
+
+	t.Run("number to resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          let x: Int = 1
+          let y = x as? @R
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.AlwaysFailingResourceCastingTypeError{}, errs[0])
+		assert.IsType(t, &sema.IncorrectTransferOperationError{}, errs[1])
+	})
+
+	t.Run("container of resources", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let xs: [Int] = [1]
+          let ys = xs as? @[AnyResource]
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.AlwaysFailingResourceCastingTypeError{}, errs[0])
+		assert.IsType(t, &sema.IncorrectTransferOperationError{}, errs[1])
+	})
+}
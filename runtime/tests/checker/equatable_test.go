@@ -0,0 +1,232 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func TestCheckEquatable(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("derived", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Point: Equatable {
+              let x: Int
+              let name: String?
+
+              init(x: Int, name: String?) {
+                  self.x = x
+                  self.name = name
+              }
+          }
+
+          let a = Point(x: 1, name: nil)
+          let b = Point(x: 1, name: "b")
+          let equal = a == b
+          let notEqual = a != b
+          let optional = (a as Point?) == nil
+          let contains = [a].contains(b)
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("equals function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Version: Equatable {
+              let major: Int
+              let tags: [String]
+
+              init(major: Int) {
+                  self.major = major
+                  self.tags = []
+              }
+
+              fun equals(_ other: Version): Bool {
+                  return self.major == other.major
+              }
+          }
+
+          let equal = Version(major: 1) == Version(major: 1)
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("restricted type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Point: Equatable {
+              let x: Int
+
+              init(x: Int) {
+                  self.x = x
+              }
+          }
+
+          let a: {Equatable} = Point(x: 1)
+          let equal = a == a
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("not conforming", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Point {}
+
+          let equal = Point() == Point()
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidBinaryOperandsError{}, errs[0])
+	})
+
+	t.Run("different types", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct A: Equatable {}
+          struct B: Equatable {}
+
+          let equal = A() == B()
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidBinaryOperandsError{}, errs[0])
+	})
+
+	t.Run("non-equatable field", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Point: Equatable {
+              let tags: [String]
+
+              init() {
+                  self.tags = []
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.InvalidEquatableFieldError{}, errs[0])
+		assert.Equal(t, "tags", errs[0].(*sema.InvalidEquatableFieldError).Name)
+	})
+
+	t.Run("invalid equals function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Point: Equatable {
+              fun equals(_ other: Int): Bool {
+                  return true
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidEquatableEqualsFunctionError{}, errs[0])
+	})
+
+	t.Run("equals field", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Point: Equatable {
+              let equals: Bool
+
+              init() {
+                  self.equals = true
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidEquatableEqualsFunctionError{}, errs[0])
+	})
+
+	t.Run("hashable with equals function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Point: Hashable, Equatable {
+              let x: Int
+
+              init(x: Int) {
+                  self.x = x
+              }
+
+              fun equals(_ other: Point): Bool {
+                  return true
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidHashableEqualsFunctionError{}, errs[0])
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R: Equatable {}
+
+          fun test(a: @R, b: @R): Bool {
+              let equal = a == b
+              destroy a
+              destroy b
+              return equal
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.CompositeKindMismatchError{}, errs[0])
+		assert.IsType(t, &sema.InvalidBinaryOperandsError{}, errs[1])
+	})
+}
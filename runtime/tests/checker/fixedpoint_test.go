@@ -550,6 +550,75 @@ func TestCheckFixedPointLiteralTypeConversionInReturn(t *testing.T) {
 	}
 }
 
+func TestCheckFixedPointLiteralTypeConversionInConversionFunctionArgument(t *testing.T) {
+
+	t.Parallel()
+
+	for _, ty := range sema.AllFixedPointTypes {
+		// Only test leaf types
+		switch ty {
+		case sema.FixedPointType, sema.SignedFixedPointType:
+			continue
+		}
+
+		ranged := ty.(sema.FractionalRangedType)
+
+		formatLiteral := func(integer, fractional *big.Int) string {
+			var builder strings.Builder
+			builder.WriteString(integer.String())
+			builder.WriteRune('.')
+			builder.WriteString(format.PadLeft(fractional.String(), '0', ranged.Scale()))
+			return builder.String()
+		}
+
+		t.Run(ty.String(), func(t *testing.T) {
+
+			for _, literal := range []string{
+				formatLiteral(ranged.MinInt(), ranged.MinFractional()),
+				formatLiteral(ranged.MaxInt(), ranged.MaxFractional()),
+			} {
+				_, err := ParseAndCheck(t,
+					fmt.Sprintf(
+						`
+                          let x = %s(%s)
+                        `,
+						ty,
+						literal,
+					),
+				)
+
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("invalid scale", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let x = UFix128(0.0000000000000000000000001)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.InvalidFixedPointLiteralScaleError{}, errs[0])
+	})
+
+	t.Run("invalid range", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let x = UFix128(-1.0)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.InvalidFixedPointLiteralRangeError{}, errs[0])
+	})
+}
+
 func TestCheckSignedFixedPointNegate(t *testing.T) {
 
 	t.Parallel()
@@ -658,13 +727,23 @@ func TestCheckFixedPointLiteralScales(t *testing.T) {
 					),
 				)
 
-				if i <= scale {
+				expectedErrorCount := 0
+				if i > scale {
+					expectedErrorCount++
+				}
+				// The literal without a type annotation is inferred to be an UFix64
+				if i > sema.Fix64Scale {
+					expectedErrorCount++
+				}
+
+				if expectedErrorCount == 0 {
 					assert.NoError(t, err)
 				} else {
-					errs := ExpectCheckerErrors(t, err, 2)
+					errs := ExpectCheckerErrors(t, err, expectedErrorCount)
 
-					assert.IsType(t, &sema.InvalidFixedPointLiteralScaleError{}, errs[0])
-					assert.IsType(t, &sema.InvalidFixedPointLiteralScaleError{}, errs[1])
+					for _, err := range errs {
+						assert.IsType(t, &sema.InvalidFixedPointLiteralScaleError{}, err)
+					}
 				}
 			}
 		})
@@ -710,3 +789,36 @@ func TestCheckFixedPointMinMax(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckFixedPointFromString(t *testing.T) {
+
+	t.Parallel()
+
+	for _, ty := range sema.AllFixedPointTypes {
+		// Only test leaf types
+		switch ty {
+		case sema.FixedPointType, sema.SignedFixedPointType:
+			continue
+		}
+
+		t.Run(ty.String(), func(t *testing.T) {
+
+			checker, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      let x = %s.fromString("1.0")
+                    `,
+					ty,
+				),
+			)
+			require.NoError(t, err)
+
+			require.Equal(t,
+				&sema.OptionalType{
+					Type: ty,
+				},
+				RequireGlobalValue(t, checker.Elaboration, "x"),
+			)
+		})
+	}
+}
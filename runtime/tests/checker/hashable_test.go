@@ -0,0 +1,201 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func TestCheckHashable(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("dictionary key", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Point: Hashable {
+              let x: Int
+              let y: Int
+
+              init(x: Int, y: Int) {
+                  self.x = x
+                  self.y = y
+              }
+          }
+
+          let names: {Point: String} = {Point(x: 1, y: 2): "a"}
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("set element", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Point: Hashable {
+              let x: Int
+
+              init(x: Int) {
+                  self.x = x
+              }
+          }
+
+          let points: {Point} = [Point(x: 1)]
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("nested hashable and enum fields", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          enum Color: UInt8 {
+              case red
+              case green
+          }
+
+          struct Inner: Hashable {
+              let name: String
+
+              init(name: String) {
+                  self.name = name
+              }
+          }
+
+          struct Outer: Hashable {
+              let inner: Inner
+              let color: Color
+              let address: Address
+
+              init(inner: Inner, color: Color, address: Address) {
+                  self.inner = inner
+                  self.color = color
+                  self.address = address
+              }
+          }
+
+          let xs: {Outer: Int} = {}
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("restricted type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Point: Hashable {
+              let x: Int
+
+              init(x: Int) {
+                  self.x = x
+              }
+          }
+
+          let xs: {{Hashable}: Int} = {Point(x: 1): 1}
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("not conforming", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Point {
+              let x: Int
+
+              init(x: Int) {
+                  self.x = x
+              }
+          }
+
+          fun test(xs: {Point: String}) {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidDictionaryKeyTypeError{}, errs[0])
+	})
+
+	t.Run("non-hashable field", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Point: Hashable {
+              let x: Int
+              let tags: [String]
+
+              init(x: Int, tags: [String]) {
+                  self.x = x
+                  self.tags = tags
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.InvalidHashableFieldError{}, errs[0])
+		assert.Equal(t, "tags", errs[0].(*sema.InvalidHashableFieldError).Name)
+	})
+
+	t.Run("non-hashable structure field", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Inner {}
+
+          struct Outer: Hashable {
+              let inner: Inner
+
+              init(inner: Inner) {
+                  self.inner = inner
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidHashableFieldError{}, errs[0])
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R: Hashable {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.CompositeKindMismatchError{}, errs[0])
+	})
+}
@@ -581,3 +581,36 @@ func TestCheckIntegerMinMax(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckIntegerFromString(t *testing.T) {
+
+	t.Parallel()
+
+	for _, ty := range sema.AllIntegerTypes {
+		// Only test leaf types
+		switch ty {
+		case sema.IntegerType, sema.SignedIntegerType:
+			continue
+		}
+
+		t.Run(ty.String(), func(t *testing.T) {
+
+			checker, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      let x = %s.fromString("1")
+                    `,
+					ty,
+				),
+			)
+			require.NoError(t, err)
+
+			require.Equal(t,
+				&sema.OptionalType{
+					Type: ty,
+				},
+				RequireGlobalValue(t, checker.Elaboration, "x"),
+			)
+		})
+	}
+}
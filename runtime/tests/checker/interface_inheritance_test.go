@@ -0,0 +1,404 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func TestCheckInterfaceInheritance(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("inherited members", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct interface I1 {
+              fun foo(): Int
+          }
+
+          struct interface I2 {
+              fun bar(): Int
+          }
+
+          struct interface I3: I1, I2 {}
+
+          struct S: I3 {
+              fun foo(): Int { return 1 }
+              fun bar(): Int { return 2 }
+          }
+
+          let s: {I3} = S()
+          let x = s.foo() + s.bar()
+        `)
+		require.NoError(t, err)
+
+		i1Type := checker.Elaboration.InterfaceTypes["S.test.I1"]
+		i2Type := checker.Elaboration.InterfaceTypes["S.test.I2"]
+		i3Type := checker.Elaboration.InterfaceTypes["S.test.I3"]
+
+		assert.Equal(t,
+			[]*sema.InterfaceType{i1Type, i2Type},
+			i3Type.ExplicitInterfaceConformances,
+		)
+
+		assert.Equal(t,
+			sema.IntType,
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+	})
+
+	t.Run("interface declared later", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource interface I2: I1 {}
+
+          resource interface I1 {}
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract C {
+              resource interface Receiver {
+                  fun deposit()
+              }
+
+              resource interface Vault: Receiver {}
+
+              resource R: Vault {
+                  fun deposit() {}
+              }
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("missing inherited member", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I1 {
+              fun foo(): Int
+          }
+
+          struct interface I2: I1 {}
+
+          struct S: I2 {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		var conformanceErr *sema.ConformanceError
+		require.ErrorAs(t, errs[0], &conformanceErr)
+
+		assert.Equal(t, "I1", conformanceErr.InterfaceType.Identifier)
+	})
+
+	t.Run("transitive", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I1 {
+              let x: Int
+          }
+
+          struct interface I2: I1 {}
+
+          struct interface I3: I2 {}
+
+          struct S: I3 {
+              let x: Int
+
+              init() {
+                  self.x = 1
+              }
+          }
+
+          let s: {I1} = S()
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("redeclared member with same type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I1 {
+              fun foo(x: Int): Int
+          }
+
+          struct interface I2: I1 {
+              fun foo(x: Int): Int {
+                  pre { x > 0 }
+              }
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("redeclared member with different type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I1 {
+              fun foo(): Int
+          }
+
+          struct interface I2: I1 {
+              fun foo(): String
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InterfaceMemberConflictError{}, errs[0])
+	})
+
+	t.Run("conflicting inherited members", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I1 {
+              fun foo(): Int
+          }
+
+          struct interface I2 {
+              let foo: Int
+          }
+
+          struct interface I3: I1, I2 {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InterfaceMemberConflictError{}, errs[0])
+	})
+
+	t.Run("diamond", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I1 {
+              fun foo(): Int
+          }
+
+          struct interface I2: I1 {}
+
+          struct interface I3: I1 {}
+
+          struct interface I4: I2, I3 {}
+
+          struct S: I4 {
+              fun foo(): Int { return 1 }
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("cyclic", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I1: I2 {}
+
+          struct interface I2: I1 {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.CyclicConformanceError{}, errs[0])
+		assert.IsType(t, &sema.CyclicConformanceError{}, errs[1])
+	})
+
+	t.Run("self", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I: I {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.CyclicConformanceError{}, errs[0])
+	})
+
+	t.Run("duplicate", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I1 {}
+
+          struct interface I2: I1, I1 {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.DuplicateConformanceError{}, errs[0])
+	})
+
+	t.Run("composite kind mismatch", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource interface I1 {}
+
+          struct interface I2: I1 {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.CompositeKindMismatchError{}, errs[0])
+	})
+
+	t.Run("non-interface conformance", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {}
+
+          struct interface I: S {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidConformanceError{}, errs[0])
+	})
+
+	t.Run("inherited type requirement", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract interface CI1 {
+              resource R {}
+          }
+
+          contract interface CI2: CI1 {}
+
+          contract C: CI2 {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		var conformanceErr *sema.ConformanceError
+		require.ErrorAs(t, errs[0], &conformanceErr)
+
+		require.Len(t, conformanceErr.MissingNestedCompositeTypes, 1)
+	})
+}
+
+func TestCheckRestrictedTypeInterfaceInheritance(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("restricted type is subtype of inherited restriction", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource interface I1 {}
+
+          resource interface I2: I1 {}
+
+          resource R: I2 {}
+
+          fun test(r: @R{I2}): @R{I1} {
+              return <-r
+          }
+
+          fun test2(r: @AnyResource{I2}): @AnyResource{I1} {
+              return <-r
+          }
+
+          fun test3(r: &R{I2}): &R{I1} {
+              return r
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("restricted type is not subtype of descendant restriction", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource interface I1 {}
+
+          resource interface I2: I1 {}
+
+          fun test(r: @AnyResource{I1}): @AnyResource{I2} {
+              return <-r
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("member clash with inherited member", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource interface I1 {
+              fun foo(): Int
+          }
+
+          resource interface I2: I1 {}
+
+          resource interface I3 {
+              fun foo(): String
+          }
+
+          fun test(r: @AnyResource{I2, I3}) {
+              destroy r
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.RestrictionMemberClashError{}, errs[0])
+	})
+}
@@ -23,12 +23,13 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/sema"
 )
 
-func TestCheckInvalidCompositeInitializerOverloading(t *testing.T) {
+func TestCheckCompositeInitializerOverloading(t *testing.T) {
 
 	t.Parallel()
 
@@ -66,6 +67,13 @@ func TestCheckInvalidCompositeInitializerOverloading(t *testing.T) {
 					),
 				)
 
+				// Only structures and resources support initializer overloading
+
+				if !isInterface && kind != common.CompositeKindContract {
+					require.NoError(t, err)
+					return
+				}
+
 				errs := ExpectCheckerErrors(t, err, 1)
 
 				assert.IsType(t, &sema.UnsupportedOverloadingError{}, errs[0])
@@ -111,3 +119,554 @@ func TestCheckInvalidResourceDestructorOverloading(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckCompositeInitializerOverloadingInvocation(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Token {
+              let name: String
+              let symbol: String
+
+              init(name: String) {
+                  self.name = name
+                  self.symbol = ""
+              }
+
+              init(name: String, symbol: String) {
+                  self.name = name
+                  self.symbol = symbol
+              }
+
+              init(symbol: String) {
+                  self.name = ""
+                  self.symbol = symbol
+              }
+          }
+
+          let a = Token(name: "Flow")
+          let b = Token(name: "Flow", symbol: "FLOW")
+          let c = Token(symbol: "FLOW")
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {
+              let id: Int
+
+              init() {
+                  self.id = 0
+              }
+
+              init(id: Int) {
+                  self.id = id
+              }
+          }
+
+          fun test(): @[R] {
+              return <-[<-create R(), <-create R(id: 1)]
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("no matching overload", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              init(a: Int) {}
+              init(b: Int) {}
+          }
+
+          let s = S(c: 1)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NoMatchingOverloadError{}, errs[0])
+	})
+
+	t.Run("invalid argument type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              init(a: Int) {}
+              init(b: String) {}
+          }
+
+          let s = S(b: 1)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("same argument labels", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              init(a: Int) {}
+              init(a: String) {}
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+	})
+
+	t.Run("uninitialized field", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              let x: Int
+
+              init() {
+                  self.x = 1
+              }
+
+              init(y: Int) {}
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.FieldUninitializedError{}, errs[0])
+	})
+}
+
+func TestCheckCompositeFunctionOverloading(t *testing.T) {
+
+	t.Parallel()
+
+	for _, kind := range common.CompositeKindsWithFieldsAndFunctions {
+
+		kind := kind
+
+		t.Run(kind.Keyword(), func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      %[1]s X {
+                          fun transfer(amount: Int): Int {
+                              return amount
+                          }
+
+                          fun transfer(from: Address, amount: Int): String {
+                              return from.toString()
+                          }
+
+                          fun transfer(_ amount: Int): Bool {
+                              return self.transfer(amount: amount) > 0
+                          }
+                      }
+                    `,
+					kind.Keyword(),
+				),
+			)
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCheckCompositeFunctionOverloadingInvocation(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct S {
+              fun add(_ value: Int): Int {
+                  return value + 1
+              }
+
+              fun add(value: Int, times: Int): Int {
+                  return value * times
+              }
+
+              fun add(string: String): String {
+                  return string.concat("!")
+              }
+          }
+
+          let s = S()
+          let a = s.add(1)
+          let b = s.add(value: 2, times: 3)
+          let c = s.add(string: "x")
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t, sema.IntType, RequireGlobalValue(t, checker.Elaboration, "a"))
+		assert.Equal(t, sema.IntType, RequireGlobalValue(t, checker.Elaboration, "b"))
+		assert.Equal(t, sema.StringType, RequireGlobalValue(t, checker.Elaboration, "c"))
+	})
+
+	t.Run("optional chaining", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct S {
+              fun get(): Int {
+                  return 1
+              }
+
+              fun get(default: String): String {
+                  return default
+              }
+          }
+
+          let s: S? = S()
+          let a = s?.get()
+          let b = s?.get(default: "x")
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.OptionalType{Type: sema.IntType},
+			RequireGlobalValue(t, checker.Elaboration, "a"),
+		)
+		assert.Equal(t,
+			&sema.OptionalType{Type: sema.StringType},
+			RequireGlobalValue(t, checker.Elaboration, "b"),
+		)
+	})
+
+	t.Run("no matching overload", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              fun test(a: Int) {}
+              fun test(b: Int) {}
+          }
+
+          fun test() {
+              S().test(c: 1)
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NoMatchingOverloadError{}, errs[0])
+	})
+
+	t.Run("reference without invocation", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              fun test(a: Int) {}
+              fun test(b: Int) {}
+          }
+
+          let f = S().test
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.AmbiguousOverloadError{}, errs[0])
+	})
+
+	t.Run("overload as argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              fun test(a: Int) {}
+              fun test(b: Int) {}
+          }
+
+          fun apply(_ f: ((Int): Void)) {}
+
+          fun test() {
+              apply(S().test)
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.AmbiguousOverloadError{}, errs[0])
+	})
+
+	t.Run("access", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              pub fun test(a: Int) {}
+              priv fun test(b: Int) {}
+          }
+
+          fun test() {
+              S().test(a: 1)
+              S().test(b: 1)
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidAccessError{}, errs[0])
+	})
+
+	t.Run("same argument labels", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              fun test(a: Int) {}
+              fun test(a: String) {}
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+	})
+
+	t.Run("field and function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              let test: Int
+
+              init() {
+                  self.test = 1
+              }
+
+              fun test(a: Int) {}
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[1])
+	})
+}
+
+func TestCheckCompositeFunctionOverloadingConformance(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("overloads in composite only", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I {
+              fun test(a: Int): Int
+          }
+
+          struct S: I {
+              fun test(b: Int): Int {
+                  return b
+              }
+
+              fun test(a: Int): Int {
+                  return a
+              }
+          }
+
+          let s: {I} = S()
+          let x = s.test(a: 1)
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("overloads in interface", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I {
+              fun test(a: Int): Int
+              fun test(b: Int): Int
+          }
+
+          struct S: I {
+              fun test(b: Int): Int {
+                  return b
+              }
+
+              fun test(a: Int): Int {
+                  return a
+              }
+          }
+
+          let s: {I} = S()
+          let x = s.test(b: 1)
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("missing overload", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I {
+              fun test(a: Int): Int
+              fun test(b: Int): Int
+          }
+
+          struct S: I {
+              fun test(a: Int): Int {
+                  return a
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.ConformanceError{}, errs[0])
+
+		conformanceErr := errs[0].(*sema.ConformanceError)
+		require.Len(t, conformanceErr.MissingMembers, 1)
+		assert.Equal(t,
+			[]string{"b"},
+			conformanceErr.MissingMembers[0].ArgumentLabels,
+		)
+	})
+
+	t.Run("mismatching overload", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I {
+              fun test(a: Int): Int
+          }
+
+          struct S: I {
+              fun test(a: Int): String {
+                  return ""
+              }
+
+              fun test(b: Int): Int {
+                  return b
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.ConformanceError{}, errs[0])
+
+		conformanceErr := errs[0].(*sema.ConformanceError)
+		require.Len(t, conformanceErr.MemberMismatches, 1)
+	})
+}
+
+func TestCheckCompositeInitializerOverloadingConformance(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("interface without conditions", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I {
+              init(a: Int)
+          }
+
+          struct S: I {
+              init(a: Int) {}
+
+              init(b: Int) {}
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("interface with conditions", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I {
+              init(a: Int) {
+                  pre { a > 0 }
+              }
+          }
+
+          struct S: I {
+              init(a: Int) {}
+
+              init(b: Int) {}
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.InitializerConditionsOverloadingError{}, errs[0])
+	})
+
+	t.Run("type requirement with conditions", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract interface CI {
+              struct S {
+                  init(a: Int) {
+                      post { a > 0 }
+                  }
+              }
+          }
+
+          contract C: CI {
+              struct S {
+                  init(a: Int) {}
+
+                  init(b: Int) {}
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.InitializerConditionsOverloadingError{}, errs[0])
+	})
+}
@@ -3624,6 +3624,63 @@ func TestCheckInvalidResourceArrayConcat(t *testing.T) {
 	assert.IsType(t, &sema.InvalidResourceArrayMemberError{}, errs[0])
 }
 
+func TestCheckInvalidResourceArrayHigherOrderFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(name string, code string) {
+		t.Run(name, func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := ParseAndCheck(t, fmt.Sprintf(
+				`
+                  resource X {}
+
+                  fun one(_ x: @X): Int {
+                      destroy x
+                      return 1
+                  }
+
+                  fun isIncluded(_ x: @X): Bool {
+                      destroy x
+                      return true
+                  }
+
+                  fun combine(_ sum: Int, _ x: @X): Int {
+                      destroy x
+                      return sum
+                  }
+
+                  fun isOrderedBefore(_ a: @X, _ b: @X): Bool {
+                      destroy a
+                      destroy b
+                      return true
+                  }
+
+                  fun test() {
+                      let xs: @[X] <- [<-create X()]
+                      %s
+                      destroy xs
+                  }
+                `,
+				code,
+			))
+
+			errs := ExpectCheckerErrors(t, err, 1)
+
+			assert.IsType(t, &sema.InvalidResourceArrayMemberError{}, errs[0])
+		})
+	}
+
+	test("forEach", `xs.forEach(fun (x: @X) { destroy x })`)
+	test("map", `let ys = xs.map(one)`)
+	test("filter", `let ys <- xs.filter(isIncluded); destroy ys`)
+	test("reduce", `let n = xs.reduce(initial: 0, combine)`)
+	test("reverse", `let ys <- xs.reverse(); destroy ys`)
+	test("sort", `xs.sort(by: isOrderedBefore)`)
+}
+
 func TestCheckResourceDictionaryRemove(t *testing.T) {
 
 	t.Parallel()
@@ -3759,6 +3816,51 @@ func TestCheckInvalidResourceDictionaryValues(t *testing.T) {
 	assert.IsType(t, &sema.InvalidNestedResourceMoveError{}, errs[1])
 }
 
+func TestCheckResourceDictionaryForEachKey(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      resource X {}
+
+      fun isIncluded(_ key: String): Bool {
+          return true
+      }
+
+      fun test() {
+          let xs <- {"x1": <-create X()}
+          xs.forEachKey(isIncluded)
+          destroy xs
+      }
+    `)
+
+	require.NoError(t, err)
+}
+
+func TestCheckInvalidResourceDictionaryForEach(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      resource X {}
+
+      fun isIncluded(_ key: String, _ x: @X): Bool {
+          destroy x
+          return true
+      }
+
+      fun test() {
+          let xs <- {"x1": <-create X()}
+          xs.forEach(isIncluded)
+          destroy xs
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.InvalidResourceDictionaryMemberError{}, errs[0])
+}
+
 func TestCheckInvalidResourceLossAfterMoveThroughDictionaryIndexing(t *testing.T) {
 
 	t.Parallel()
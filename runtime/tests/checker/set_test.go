@@ -0,0 +1,260 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func TestCheckSetType(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("literal", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let s: {Int} = [1, 2, 3]
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.SetType{
+				ElementType: sema.IntType,
+			},
+			RequireGlobalValue(t, checker.Elaboration, "s"),
+		)
+	})
+
+	t.Run("empty literal", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let s: {String} = []
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid literal element", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let s: {Int} = ["a"]
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("array is not a set", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let xs: [Int] = [1]
+          let s: {Int} = xs
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("interface restriction", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct interface I {}
+
+          struct S: I {}
+
+          let s: {I} = S()
+        `)
+		require.NoError(t, err)
+
+		require.IsType(t,
+			&sema.RestrictedType{},
+			RequireGlobalValue(t, checker.Elaboration, "s"),
+		)
+	})
+
+	t.Run("subtype", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let s: {Int} = [1]
+          let t: {Integer} = s
+        `)
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckInvalidSetElementType(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(s: @{R}) {
+              destroy s
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.InvalidSetElementTypeError{}, errs[0])
+	})
+
+	t.Run("struct", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {}
+
+          fun test(s: {S}) {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.InvalidSetElementTypeError{}, errs[0])
+	})
+
+	t.Run("enum", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          enum E: UInt8 {
+              case a
+          }
+
+          fun test(s: {E}) {}
+        `)
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckSetMembers(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      let s: {Int} = [1, 2]
+      let other: {Int} = [2, 3]
+
+      let inserted = s.insert(3)
+      let removed = s.remove(1)
+      let contained = s.contains(2)
+      let union = s.union(other)
+      let intersection = s.intersection(other)
+      let length = s.length
+    `)
+	require.NoError(t, err)
+
+	setType := &sema.SetType{
+		ElementType: sema.IntType,
+	}
+
+	assert.Equal(t, sema.BoolType, RequireGlobalValue(t, checker.Elaboration, "inserted"))
+	assert.Equal(t, sema.BoolType, RequireGlobalValue(t, checker.Elaboration, "removed"))
+	assert.Equal(t, sema.BoolType, RequireGlobalValue(t, checker.Elaboration, "contained"))
+	assert.Equal(t, setType, RequireGlobalValue(t, checker.Elaboration, "union"))
+	assert.Equal(t, setType, RequireGlobalValue(t, checker.Elaboration, "intersection"))
+	assert.Equal(t, sema.IntType, RequireGlobalValue(t, checker.Elaboration, "length"))
+}
+
+func TestCheckInvalidSetMemberArgument(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      let s: {Int} = [1, 2]
+      let inserted = s.insert("a")
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+}
+
+func TestCheckSetEquality(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("same element type", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let a: {Int} = [1, 2]
+          let b: {Int} = [2, 1]
+          let equal = a == b
+          let notEqual = a != b
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t, sema.BoolType, RequireGlobalValue(t, checker.Elaboration, "equal"))
+		assert.Equal(t, sema.BoolType, RequireGlobalValue(t, checker.Elaboration, "notEqual"))
+	})
+
+	t.Run("optional", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let a: {Int}? = [1, 2]
+          let b: {Int} = [2, 1]
+          let equal = a == b
+          let isNil = a == nil
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("different element types", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let a: {Int} = [1, 2]
+          let b: {String} = ["1", "2"]
+          let equal = a == b
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.InvalidBinaryOperandsError{}, errs[0])
+	})
+}
@@ -401,3 +401,556 @@ func TestCheckSwitchStatementWithUnreachableReturn(t *testing.T) {
 	assert.IsType(t, &sema.UnreachableStatementError{}, errs[0])
 	assert.IsType(t, &sema.MissingReturnStatementError{}, errs[1])
 }
+
+func TestCheckSwitchStatementOptionalPattern(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(_ x: Int?): Int {
+              switch x {
+              case let y?:
+                  return y
+              default:
+                  return 0
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("non-optional", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(_ x: Int) {
+              switch x {
+              case let y?:
+                  return
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidPatternTypeError{}, errs[0])
+	})
+}
+
+func TestCheckSwitchStatementTypePattern(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              let id: Int
+
+              init(id: Int) {
+                  self.id = id
+              }
+          }
+
+          fun test(_ x: AnyStruct): Int {
+              switch x {
+              case let s as S:
+                  return s.id
+              case let i as Int:
+                  return i
+              default:
+                  return 0
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("non-equatable test type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {}
+
+          fun test() {
+              switch S() {
+              case let s as S:
+                  return
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("resource type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(_ x: AnyStruct) {
+              switch x {
+              case let r as @R:
+                  destroy r
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.AlwaysFailingResourceCastingTypeError{}, errs[0])
+	})
+
+	t.Run("impossible", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(_ x: &Int) {
+              switch x {
+              case let y as auth &Int:
+                  return
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("unknown type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(_ x: AnyStruct) {
+              switch x {
+              case let y as T:
+                  return
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+}
+
+func TestCheckSwitchStatementPatternScope(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("other case", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(_ x: Int?) {
+              switch x {
+              case let y?:
+                  return
+              default:
+                  y
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+
+	t.Run("after switch", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(_ x: Int?) {
+              switch x {
+              case let y?:
+                  break
+              }
+              y
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+
+	t.Run("constant", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(_ x: Int?) {
+              switch x {
+              case let y?:
+                  y = 1
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.AssignmentToConstantError{}, errs[0])
+	})
+
+	t.Run("destructuring", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(_ xs: [Int]): Int {
+              switch xs {
+              case let [a, b]:
+                  return a + b
+              case let [a]:
+                  return a
+              default:
+                  return 0
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckSwitchStatementGuard(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(_ x: Int?, _ limit: Int) {
+              switch x {
+              case let y? where y > limit:
+                  return
+              case 1 where limit > 0:
+                  return
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("non-boolean", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(_ x: Int) {
+              switch x {
+              case let y where y:
+                  return
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
+
+func TestCheckSwitchStatementExhaustiveness(t *testing.T) {
+
+	t.Parallel()
+
+	const enumDeclaration = `
+      enum E: UInt8 {
+          case a
+          case b
+          case c
+      }
+    `
+
+	t.Run("all enum cases", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, enumDeclaration+`
+          fun test(_ e: E): String {
+              switch e {
+              case E.a:
+                  return "a"
+              case E.c:
+                  return "c"
+              case E.b:
+                  return "b"
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("missing enum case", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, enumDeclaration+`
+          fun test(_ e: E): String {
+              switch e {
+              case E.a:
+                  return "a"
+              case E.b:
+                  return "b"
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingReturnStatementError{}, errs[0])
+	})
+
+	t.Run("guarded enum case", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, enumDeclaration+`
+          fun test(_ e: E, _ flag: Bool): String {
+              switch e {
+              case E.a:
+                  return "a"
+              case E.b where flag:
+                  return "b"
+              case E.c:
+                  return "c"
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingReturnStatementError{}, errs[0])
+	})
+
+	t.Run("irrefutable pattern", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(_ x: Int?): Int {
+              switch x {
+              case let y?:
+                  return y
+              case let none:
+                  return 0
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("definite initialization", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, enumDeclaration+`
+          struct S {
+              let x: Int
+
+              init(_ e: E) {
+                  switch e {
+                  case E.a:
+                      self.x = 1
+                  case E.b:
+                      self.x = 2
+                  case E.c:
+                      self.x = 3
+                  }
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckResourceSwitchStatement(t *testing.T) {
+
+	t.Parallel()
+
+	const declarations = `
+      resource interface NFT {}
+
+      resource Kitty: NFT {}
+
+      resource Dog: NFT {}
+    `
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, declarations+`
+          fun test(_ nft: @AnyResource) {
+              switch <-nft {
+              case let kitty as @Kitty:
+                  destroy kitty
+              case let dog as @Dog:
+                  destroy dog
+              case let other:
+                  destroy other
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("irrefutable type pattern", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, declarations+`
+          fun test(_ kitty: @Kitty) {
+              switch <-kitty {
+              case let nft as @AnyResource{NFT}:
+                  destroy nft
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("not exhaustive", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, declarations+`
+          fun test(_ nft: @AnyResource) {
+              switch <-nft {
+              case let kitty as @Kitty:
+                  destroy kitty
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ResourceLossError{}, errs[0])
+	})
+
+	t.Run("default", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, declarations+`
+          fun test(_ nft: @AnyResource) {
+              switch <-nft {
+              case let kitty as @Kitty:
+                  destroy kitty
+              default:
+                  return
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ResourceLossError{}, errs[0])
+	})
+
+	t.Run("guard", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, declarations+`
+          fun test(_ nft: @AnyResource, _ flag: Bool) {
+              switch <-nft {
+              case let kitty as @Kitty where flag:
+                  destroy kitty
+              case let other:
+                  destroy other
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidResourceSwitchCaseGuardError{}, errs[0])
+	})
+
+	t.Run("missing move", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, declarations+`
+          fun test(_ nft: @AnyResource) {
+              switch nft {
+              case let other:
+                  destroy other
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.MissingMoveOperationError{}, errs[0])
+		assert.IsType(t, &sema.ResourceLossError{}, errs[1])
+	})
+
+	t.Run("use after move", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, declarations+`
+          fun test(_ nft: @AnyResource) {
+              switch <-nft {
+              case let other:
+                  destroy other
+              }
+              destroy nft
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ResourceUseAfterInvalidationError{}, errs[0])
+	})
+
+	t.Run("loss in case", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, declarations+`
+          fun test(_ nft: @AnyResource) {
+              switch <-nft {
+              case let kitty as @Kitty:
+                  destroy kitty
+              case let other:
+                  break
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ResourceLossError{}, errs[0])
+	})
+}
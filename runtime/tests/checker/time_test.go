@@ -0,0 +1,321 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func TestCheckTimestampConstructor(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let a = Timestamp("2020-03-13T19:52:43Z")
+          let b = Timestamp("2020-03-13T19:52:43")
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.TimestampType,
+			RequireGlobalValue(t, checker.Elaboration, "a"),
+		)
+		assert.Equal(t,
+			sema.TimestampType,
+			RequireGlobalValue(t, checker.Elaboration, "b"),
+		)
+	})
+
+	t.Run("non-literal", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(_ s: String): Timestamp {
+              return Timestamp(s)
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	for _, literal := range []string{
+		"2020-03-13",
+		"2020-03-13T19:52:43+01:00",
+		"2020-13-13T19:52:43Z",
+		"yesterday",
+	} {
+
+		literal := literal
+
+		t.Run(fmt.Sprintf("invalid %s", literal), func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`let a = Timestamp("%s")`,
+					literal,
+				),
+			)
+
+			errs := ExpectCheckerErrors(t, err, 1)
+
+			assert.IsType(t, &sema.InvalidTimestampLiteralError{}, errs[0])
+		})
+	}
+}
+
+func TestCheckDurationConstants(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      let a = Duration.second
+      let b = Duration.minute
+      let c = Duration.hour
+      let d = Duration.day
+      let e = Duration(90)
+    `)
+
+	require.NoError(t, err)
+
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		assert.Equal(t,
+			sema.DurationType,
+			RequireGlobalValue(t, checker.Elaboration, name),
+		)
+	}
+
+	t.Run("not global", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let d = day
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+
+	t.Run("constant", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              Duration.day = Duration.hour
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.InvalidAssignmentAccessError{}, errs[0])
+		assert.IsType(t, &sema.AssignmentToConstantMemberError{}, errs[1])
+	})
+
+	t.Run("common names", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let day = 5
+
+          fun test() {
+              let second = "first"
+              let hour = second
+          }
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.IntType,
+			RequireGlobalValue(t, checker.Elaboration, "day"),
+		)
+	})
+
+	t.Run("invalid argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let d = Duration(1.5)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
+
+func TestCheckTimeMembers(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      let t = Timestamp("2020-03-13T19:52:43Z")
+      let a = t.seconds
+      let b = t.toString()
+      let c = Duration.day.seconds
+      let d = Duration.day.toString()
+    `)
+
+	require.NoError(t, err)
+
+	assert.Equal(t, sema.Int64Type, RequireGlobalValue(t, checker.Elaboration, "a"))
+	assert.Equal(t, sema.StringType, RequireGlobalValue(t, checker.Elaboration, "b"))
+	assert.Equal(t, sema.Int64Type, RequireGlobalValue(t, checker.Elaboration, "c"))
+	assert.Equal(t, sema.StringType, RequireGlobalValue(t, checker.Elaboration, "d"))
+}
+
+func TestCheckTimeArithmetic(t *testing.T) {
+
+	t.Parallel()
+
+	type testCase struct {
+		expression string
+		resultType sema.Type
+	}
+
+	validTestCases := []testCase{
+		{"t + day", sema.TimestampType},
+		{"day + t", sema.TimestampType},
+		{"t - day", sema.TimestampType},
+		{"t - t", sema.DurationType},
+		{"day + hour", sema.DurationType},
+		{"day - hour", sema.DurationType},
+		{"day * 7", sema.DurationType},
+		{"day * n", sema.DurationType},
+		{"day / 2", sema.DurationType},
+		{"t < t", sema.BoolType},
+		{"t >= t", sema.BoolType},
+		{"day > hour", sema.BoolType},
+		{"t == t", sema.BoolType},
+		{"day != hour", sema.BoolType},
+	}
+
+	for _, testCase := range validTestCases {
+
+		testCase := testCase
+
+		t.Run(testCase.expression, func(t *testing.T) {
+
+			t.Parallel()
+
+			checker, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      let t = Timestamp("2020-03-13T19:52:43Z")
+                      let day = Duration.day
+                      let hour = Duration.hour
+                      let n: Int64 = 3
+                      let x = %s
+                    `,
+					testCase.expression,
+				),
+			)
+
+			require.NoError(t, err)
+
+			assert.Equal(t,
+				testCase.resultType,
+				RequireGlobalValue(t, checker.Elaboration, "x"),
+			)
+		})
+	}
+
+	invalidExpressions := []string{
+		"t + t",
+		"t * 2",
+		"t / 2",
+		"t % t",
+		"day - t",
+		"day * day",
+		"day * i",
+		"2 * day",
+		"day % 2",
+		"t < day",
+		"day + 1",
+		"t + 1",
+		"day & 1",
+	}
+
+	for _, expression := range invalidExpressions {
+
+		expression := expression
+
+		t.Run(expression, func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      let t = Timestamp("2020-03-13T19:52:43Z")
+                      let day = Duration.day
+                      let i: Int = 3
+                      let x = %s
+                    `,
+					expression,
+				),
+			)
+
+			errs := ExpectCheckerErrors(t, err, 1)
+
+			assert.IsType(t, &sema.InvalidBinaryOperandsError{}, errs[0])
+		})
+	}
+}
+
+func TestCheckTimeStorable(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      struct Vesting {
+          let start: Timestamp
+          let period: Duration
+          let schedule: {Timestamp: UFix64}
+
+          init(start: Timestamp, period: Duration) {
+              self.start = start
+              self.period = period
+              self.schedule = {start + period: 1.0}
+          }
+      }
+
+      event Vested(at: Timestamp, period: Duration)
+    `)
+
+	require.NoError(t, err)
+}
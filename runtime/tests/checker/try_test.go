@@ -0,0 +1,202 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func TestCheckTryStatement(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      fun test(): Int {
+          var x = 1
+          try {
+              x = 2
+          } catch {
+              x = 3
+          }
+          return x
+      }
+    `)
+
+	require.NoError(t, err)
+}
+
+func TestCheckTryStatementErrorIdentifier(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      fun test(): String {
+          var message = ""
+          try {} catch (err) {
+              message = err
+          }
+          return message
+      }
+    `)
+
+	require.NoError(t, err)
+}
+
+func TestCheckInvalidTryStatementErrorIdentifierScope(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      fun test() {
+          try {
+              err
+          } catch (err) {}
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
+}
+
+func TestCheckInvalidTryStatementErrorIdentifierAssignment(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      fun test() {
+          try {} catch (err) {
+              err = "other"
+          }
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.AssignmentToConstantError{}, errs[0])
+}
+
+func TestCheckTryStatementResource(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("moved in both blocks", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(r: @R) {
+              try {
+                  destroy r
+              } catch {
+                  destroy r
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("moved in try block only", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(r: @R) {
+              try {
+                  destroy r
+              } catch {}
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ResourceLossError{}, errs[0])
+	})
+
+	t.Run("use after move in try block", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(r: @R) {
+              try {
+                  destroy r
+              } catch {
+                  destroy r
+              }
+              destroy r
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ResourceUseAfterInvalidationError{}, errs[0])
+	})
+}
+
+func TestCheckTryStatementReturn(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("return in both blocks", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(): Int {
+              try {
+                  return 1
+              } catch {
+                  return 2
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("return in try block only", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(): Int {
+              try {
+                  return 1
+              } catch {}
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingReturnStatementError{}, errs[0])
+	})
+}
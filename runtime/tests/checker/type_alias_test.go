@@ -0,0 +1,452 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func TestCheckTypeAlias(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("global", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          pub typealias NFTID = UInt64
+
+          let id: NFTID = 1
+          let id2: UInt64 = id
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.UInt64Type,
+			RequireGlobalValue(t, checker.Elaboration, "id"),
+		)
+	})
+
+	t.Run("alias of alias", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          typealias A = Int
+          typealias B = [A]
+
+          let xs: B = [1]
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.VariableSizedType{
+				Type: sema.IntType,
+			},
+			RequireGlobalValue(t, checker.Elaboration, "xs"),
+		)
+	})
+
+	t.Run("forward reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          typealias A = B
+          typealias B = Int
+
+          let x: A = 1
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.IntType,
+			RequireGlobalValue(t, checker.Elaboration, "x"),
+		)
+	})
+
+	t.Run("cyclic", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          typealias A = [B]
+          typealias B = A?
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.CyclicTypeAliasError{}, errs[0])
+	})
+
+	t.Run("self-reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          typealias A = {String: A}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.CyclicTypeAliasError{}, errs[0])
+	})
+
+	t.Run("composite", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          typealias Thing = S
+
+          struct S {}
+
+          let s: Thing = S()
+        `)
+		require.NoError(t, err)
+
+		assert.IsType(t,
+			&sema.CompositeType{},
+			RequireGlobalValue(t, checker.Elaboration, "s"),
+		)
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          typealias Token = R
+
+          resource R {}
+
+          fun test() {
+              let r: @Token <- create R()
+              destroy r
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("reference to restricted type", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          resource interface I {}
+
+          resource R: I {}
+
+          typealias Ref = &R{I}
+
+          fun test(r: Ref) {}
+        `)
+		require.NoError(t, err)
+
+		functionType := RequireGlobalValue(t, checker.Elaboration, "test").(*sema.FunctionType)
+		parameterType := functionType.Parameters[0].TypeAnnotation.Type
+
+		require.IsType(t, &sema.ReferenceType{}, parameterType)
+		assert.IsType(t,
+			&sema.RestrictedType{},
+			parameterType.(*sema.ReferenceType).Type,
+		)
+	})
+
+	t.Run("type mismatch", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          typealias NFTID = UInt64
+
+          let id: NFTID = "1"
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("undeclared aliased type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          typealias X = Y
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+
+	t.Run("redeclaration", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {}
+
+          typealias S = Int
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.RedeclarationError{}, errs[0])
+	})
+
+	t.Run("local", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              typealias X = Int
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.InvalidDeclarationError{}, errs[0])
+	})
+}
+
+func TestCheckNestedTypeAlias(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("contract", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          contract C {
+
+              pub typealias ID = UInt64
+
+              pub typealias Token = NFT
+
+              pub resource NFT {
+                  pub let id: ID
+
+                  init(id: ID) {
+                      self.id = id
+                  }
+              }
+
+              pub fun mint(id: ID): @Token {
+                  return <-create NFT(id: id)
+              }
+
+              init() {}
+          }
+
+          let id: C.ID = 1
+
+          fun test(): C.ID {
+              let nft: @C.Token <- C.mint(id: id)
+              let nftID = nft.id
+              destroy nft
+              return nftID
+          }
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			sema.UInt64Type,
+			RequireGlobalValue(t, checker.Elaboration, "id"),
+		)
+	})
+
+	t.Run("forward reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          contract C {
+
+              pub typealias IDs = [ID]
+
+              pub typealias ID = UInt64
+
+              init() {}
+          }
+
+          let ids: C.IDs = [1]
+        `)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.VariableSizedType{
+				Type: sema.UInt64Type,
+			},
+			RequireGlobalValue(t, checker.Elaboration, "ids"),
+		)
+	})
+
+	t.Run("contract interface", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract interface CI {
+
+              pub typealias ID = UInt64
+
+              pub fun get(): ID
+          }
+
+          contract C: CI {
+
+              pub fun get(): CI.ID {
+                  return 1
+              }
+          }
+        `)
+		require.NoError(t, err)
+	})
+
+	t.Run("undeclared nested type alias", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract C {}
+
+          let id: C.ID = 1
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.NotDeclaredError{}, errs[0])
+	})
+
+	t.Run("duplicate member", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract C {
+              pub typealias ID = UInt64
+              pub typealias ID = String
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		require.IsType(t, &sema.RedeclarationError{}, errs[0])
+		require.IsType(t, &sema.RedeclarationError{}, errs[1])
+	})
+
+	t.Run("clash with nested type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract C {
+              pub struct S {}
+              pub typealias S = String
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		require.IsType(t, &sema.RedeclarationError{}, errs[0])
+		require.IsType(t, &sema.RedeclarationError{}, errs[1])
+	})
+}
+
+func TestCheckImportedTypeAlias(t *testing.T) {
+
+	t.Parallel()
+
+	importedChecker, err := ParseAndCheckWithOptions(t,
+		`
+          pub typealias NFTID = UInt64
+
+          pub contract C {
+              pub typealias ID = NFTID
+          }
+        `,
+		ParseAndCheckOptions{
+			Location: utils.ImportedLocation,
+		},
+	)
+	require.NoError(t, err)
+
+	_, err = ParseAndCheckWithOptions(t,
+		`
+          import NFTID, C from "imported"
+
+          let a: NFTID = 1
+          let b: C.ID = a
+        `,
+		ParseAndCheckOptions{
+			Options: []sema.Option{
+				sema.WithImportHandler(
+					func(_ *sema.Checker, _ common.Location, _ ast.Range) (sema.Import, error) {
+						return sema.ElaborationImport{
+							Elaboration: importedChecker.Elaboration,
+						}, nil
+					},
+				),
+			},
+		},
+	)
+	require.NoError(t, err)
+}
+
+func TestCheckTypeAliasOccurrences(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheckWithOptions(t,
+		`
+          /// The ID of an NFT
+          pub typealias NFTID = UInt64
+
+          let id: NFTID = 1
+        `,
+		ParseAndCheckOptions{
+			Options: []sema.Option{
+				sema.WithPositionInfoEnabled(true),
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	occurrence := checker.Occurrences.Find(sema.Position{Line: 5, Column: 19})
+	require.NotNil(t, occurrence)
+
+	origin := occurrence.Origin
+	require.NotNil(t, origin)
+	assert.Equal(t, sema.UInt64Type, origin.Type)
+	assert.Equal(t, common.DeclarationKindTypeAlias, origin.DeclarationKind)
+	assert.Equal(t, " The ID of an NFT", origin.DocString)
+}
@@ -1902,3 +1902,359 @@ func TestInterpretAccount_StorageFields(t *testing.T) {
 		}
 	}
 }
+
+func TestInterpretAccount_StoragePaths(t *testing.T) {
+
+	t.Parallel()
+
+	address := interpreter.NewUnmeteredAddressValueFromBytes([]byte{42})
+
+	inter, _ := testAccount(
+		t,
+		address,
+		true,
+		`
+          resource R {}
+
+          fun setup() {
+              account.save(<-create R(), to: /storage/r)
+              account.save(1, to: /storage/one)
+              account.link<&R>(/public/r, target: /storage/r)
+              account.link<&R>(/private/r, target: /storage/r)
+          }
+
+          fun storagePaths(): [StoragePath] {
+              return account.storagePaths
+          }
+
+          fun publicPaths(): [PublicPath] {
+              return account.publicPaths
+          }
+
+          fun privatePaths(): [PrivatePath] {
+              return account.privatePaths
+          }
+
+          fun publicAccountPublicPaths(): [PublicPath] {
+              return pubAccount.publicPaths
+          }
+        `,
+	)
+
+	_, err := inter.Invoke("setup")
+	require.NoError(t, err)
+
+	pathStrings := func(value interpreter.Value) []string {
+		array, ok := value.(*interpreter.ArrayValue)
+		require.True(t, ok)
+
+		var result []string
+		array.Iterate(inter, func(element interpreter.Value) (resume bool) {
+			result = append(result, element.String())
+			return true
+		})
+		return result
+	}
+
+	value, err := inter.Invoke("storagePaths")
+	require.NoError(t, err)
+	assert.ElementsMatch(t,
+		[]string{"/storage/r", "/storage/one"},
+		pathStrings(value),
+	)
+
+	value, err = inter.Invoke("publicPaths")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/public/r"}, pathStrings(value))
+
+	value, err = inter.Invoke("privatePaths")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/private/r"}, pathStrings(value))
+
+	value, err = inter.Invoke("publicAccountPublicPaths")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/public/r"}, pathStrings(value))
+}
+
+func TestInterpretAccount_ForEachStored(t *testing.T) {
+
+	t.Parallel()
+
+	address := interpreter.NewUnmeteredAddressValueFromBytes([]byte{42})
+
+	t.Run("empty", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter, _ := testAccount(
+			t,
+			address,
+			true,
+			`
+              fun test(): Int {
+                  var count = 0
+                  account.forEachStored(fun (path: StoragePath, type: Type): Bool {
+                      count = count + 1
+                      return true
+                  })
+                  return count
+              }
+            `,
+		)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(0),
+			value,
+		)
+	})
+
+	t.Run("paths and types", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter, _ := testAccount(
+			t,
+			address,
+			true,
+			`
+              resource R {}
+
+              struct S {}
+
+              fun test(): {StoragePath: Type} {
+                  account.save(<-create R(), to: /storage/r)
+                  account.save(S(), to: /storage/s)
+                  account.save("hello", to: /storage/string)
+
+                  let types: {StoragePath: Type} = {}
+                  account.forEachStored(fun (path: StoragePath, type: Type): Bool {
+                      types[path] = type
+                      return true
+                  })
+                  return types
+              }
+
+              fun check(): Bool {
+                  let types = test()
+                  return types.length == 3
+                      && types[/storage/r] == Type<@R>()
+                      && types[/storage/s] == Type<S>()
+                      && types[/storage/string] == Type<String>()
+              }
+            `,
+		)
+
+		value, err := inter.Invoke("check")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.BoolValue(true),
+			value,
+		)
+	})
+
+	t.Run("early termination", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter, _ := testAccount(
+			t,
+			address,
+			true,
+			`
+              fun test(): Int {
+                  account.save(1, to: /storage/a)
+                  account.save(2, to: /storage/b)
+                  account.save(3, to: /storage/c)
+
+                  var count = 0
+                  account.forEachStored(fun (path: StoragePath, type: Type): Bool {
+                      count = count + 1
+                      return false
+                  })
+                  return count
+              }
+            `,
+		)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(1),
+			value,
+		)
+	})
+
+	t.Run("links", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter, _ := testAccount(
+			t,
+			address,
+			true,
+			`
+              resource R {}
+
+              fun test(): Bool {
+                  account.save(<-create R(), to: /storage/r)
+                  account.link<&R>(/public/r, target: /storage/r)
+
+                  var publicType: Type? = nil
+                  pubAccount.forEachPublic(fun (path: PublicPath, type: Type): Bool {
+                      publicType = type
+                      return true
+                  })
+
+                  var privateCount = 0
+                  account.forEachPrivate(fun (path: PrivatePath, type: Type): Bool {
+                      privateCount = privateCount + 1
+                      return true
+                  })
+
+                  return publicType == Type<Capability<&R>>()
+                      && privateCount == 0
+              }
+            `,
+		)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.BoolValue(true),
+			value,
+		)
+	})
+
+	t.Run("mutation, continue", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter, _ := testAccount(
+			t,
+			address,
+			true,
+			`
+              fun test() {
+                  account.save(1, to: /storage/a)
+                  account.save(2, to: /storage/b)
+
+                  account.forEachStored(fun (path: StoragePath, type: Type): Bool {
+                      account.save(3, to: /storage/c)
+                      return true
+                  })
+              }
+            `,
+		)
+
+		_, err := inter.Invoke("test")
+		require.ErrorAs(t, err, &interpreter.StorageMutatedDuringIterationError{})
+	})
+
+	t.Run("removal, continue", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter, _ := testAccount(
+			t,
+			address,
+			true,
+			`
+              fun test() {
+                  account.save(1, to: /storage/a)
+                  account.save(2, to: /storage/b)
+
+                  account.forEachStored(fun (path: StoragePath, type: Type): Bool {
+                      account.load<Int>(from: path)
+                      return true
+                  })
+              }
+            `,
+		)
+
+		_, err := inter.Invoke("test")
+		require.ErrorAs(t, err, &interpreter.StorageMutatedDuringIterationError{})
+	})
+
+	t.Run("mutation, stop", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter, _ := testAccount(
+			t,
+			address,
+			true,
+			`
+              fun test(): Int {
+                  account.save(1, to: /storage/a)
+                  account.save(2, to: /storage/b)
+
+                  account.forEachStored(fun (path: StoragePath, type: Type): Bool {
+                      account.save(3, to: /storage/c)
+                      return false
+                  })
+
+                  // Iterating again after stopping is allowed
+
+                  var count = 0
+                  account.forEachStored(fun (path: StoragePath, type: Type): Bool {
+                      count = count + 1
+                      return true
+                  })
+                  return count
+              }
+            `,
+		)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(3),
+			value,
+		)
+	})
+
+	t.Run("nested iteration mutation", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter, _ := testAccount(
+			t,
+			address,
+			true,
+			`
+              fun test() {
+                  account.save(1, to: /storage/a)
+                  account.save(2, to: /storage/b)
+
+                  account.forEachStored(fun (path: StoragePath, type: Type): Bool {
+                      account.forEachStored(fun (path: StoragePath, type: Type): Bool {
+                          account.save(3, to: /storage/c)
+                          return false
+                      })
+                      return true
+                  })
+              }
+            `,
+		)
+
+		_, err := inter.Invoke("test")
+		require.ErrorAs(t, err, &interpreter.StorageMutatedDuringIterationError{})
+	})
+}
@@ -21,6 +21,7 @@ package interpreter_test
 import (
 	"fmt"
 	"math"
+	"math/big"
 	"strings"
 	"testing"
 
@@ -49,10 +50,12 @@ var integerTestValues = map[string]interpreter.NumberValue{
 	"UInt128": interpreter.NewUnmeteredUInt128ValueFromUint64(60),
 	"UInt256": interpreter.NewUnmeteredUInt256ValueFromUint64(60),
 	// Word*
-	"Word8":  interpreter.NewUnmeteredWord8Value(60),
-	"Word16": interpreter.NewUnmeteredWord16Value(60),
-	"Word32": interpreter.NewUnmeteredWord32Value(60),
-	"Word64": interpreter.NewUnmeteredWord64Value(60),
+	"Word8":   interpreter.NewUnmeteredWord8Value(60),
+	"Word16":  interpreter.NewUnmeteredWord16Value(60),
+	"Word32":  interpreter.NewUnmeteredWord32Value(60),
+	"Word64":  interpreter.NewUnmeteredWord64Value(60),
+	"Word128": interpreter.NewUnmeteredWord128ValueFromUint64(60),
+	"Word256": interpreter.NewUnmeteredWord256ValueFromUint64(60),
 }
 
 func init() {
@@ -547,6 +550,51 @@ func TestInterpretSaturatedArithmeticFunctions(t *testing.T) {
 				},
 			},
 		},
+		sema.Fix128Type: {
+			add: testCalls{
+				overflow: testCall{
+					interpreter.NewUnmeteredFix128Value(sema.Fix128TypeMaxBig),
+					interpreter.NewUnmeteredFix128ValueWithInteger(big.NewInt(2)),
+					interpreter.NewUnmeteredFix128Value(sema.Fix128TypeMaxBig),
+				},
+				underflow: testCall{
+					interpreter.NewUnmeteredFix128Value(sema.Fix128TypeMinBig),
+					interpreter.NewUnmeteredFix128ValueWithInteger(big.NewInt(-2)),
+					interpreter.NewUnmeteredFix128Value(sema.Fix128TypeMinBig),
+				},
+			},
+			subtract: testCalls{
+				overflow: testCall{
+					interpreter.NewUnmeteredFix128Value(sema.Fix128TypeMaxBig),
+					interpreter.NewUnmeteredFix128ValueWithInteger(big.NewInt(-2)),
+					interpreter.NewUnmeteredFix128Value(sema.Fix128TypeMaxBig),
+				},
+				underflow: testCall{
+					interpreter.NewUnmeteredFix128Value(sema.Fix128TypeMinBig),
+					interpreter.NewUnmeteredFix128ValueWithInteger(big.NewInt(2)),
+					interpreter.NewUnmeteredFix128Value(sema.Fix128TypeMinBig),
+				},
+			},
+			multiply: testCalls{
+				overflow: testCall{
+					interpreter.NewUnmeteredFix128Value(sema.Fix128TypeMaxBig),
+					interpreter.NewUnmeteredFix128ValueWithInteger(big.NewInt(2)),
+					interpreter.NewUnmeteredFix128Value(sema.Fix128TypeMaxBig),
+				},
+				underflow: testCall{
+					interpreter.NewUnmeteredFix128Value(sema.Fix128TypeMinBig),
+					interpreter.NewUnmeteredFix128ValueWithInteger(big.NewInt(2)),
+					interpreter.NewUnmeteredFix128Value(sema.Fix128TypeMinBig),
+				},
+			},
+			divide: testCalls{
+				overflow: testCall{
+					interpreter.NewUnmeteredFix128Value(sema.Fix128TypeMinBig),
+					interpreter.NewUnmeteredFix128ValueWithInteger(big.NewInt(-1)),
+					interpreter.NewUnmeteredFix128Value(sema.Fix128TypeMaxBig),
+				},
+			},
+		},
 		sema.UIntType: {
 			subtract: testCalls{
 				underflow: testCall{
@@ -717,6 +765,29 @@ func TestInterpretSaturatedArithmeticFunctions(t *testing.T) {
 				},
 			},
 		},
+		sema.UFix128Type: {
+			add: testCalls{
+				overflow: testCall{
+					interpreter.NewUnmeteredUFix128Value(sema.UFix128TypeMaxBig),
+					interpreter.NewUnmeteredUFix128ValueWithInteger(big.NewInt(2)),
+					interpreter.NewUnmeteredUFix128Value(sema.UFix128TypeMaxBig),
+				},
+			},
+			subtract: testCalls{
+				underflow: testCall{
+					interpreter.NewUnmeteredUFix128Value(sema.UFix128TypeMinBig),
+					interpreter.NewUnmeteredUFix128ValueWithInteger(big.NewInt(2)),
+					interpreter.NewUnmeteredUFix128Value(sema.UFix128TypeMinBig),
+				},
+			},
+			multiply: testCalls{
+				overflow: testCall{
+					interpreter.NewUnmeteredUFix128Value(sema.UFix128TypeMaxBig),
+					interpreter.NewUnmeteredUFix128ValueWithInteger(big.NewInt(2)),
+					interpreter.NewUnmeteredUFix128Value(sema.UFix128TypeMaxBig),
+				},
+			},
+		},
 	}
 
 	// Verify all test cases exist
@@ -835,3 +906,50 @@ func TestInterpretSaturatedArithmeticFunctions(t *testing.T) {
 		test(ty, "Divide", testCase.divide)
 	}
 }
+
+func TestInterpretWordWrappingArithmetic(t *testing.T) {
+
+	t.Parallel()
+
+	words := map[string]*big.Int{
+		"Word128": sema.Word128TypeMaxIntBig,
+		"Word256": sema.Word256TypeMaxIntBig,
+	}
+
+	for ty, max := range words {
+
+		t.Run(ty, func(t *testing.T) {
+
+			inter := parseCheckAndInterpret(t,
+				fmt.Sprintf(
+					`
+                      let max = %[1]s.max
+                      let overflow = max + 1
+                      let underflow = %[1]s(0) - 1
+                      let product = max * 2
+                      let shifted = max << 1
+                      let shiftedOut = %[1]s(1) << %[2]d
+                    `,
+					ty,
+					max.BitLen(),
+				),
+			)
+
+			maxMinusOne := new(big.Int).Sub(max, big.NewInt(1))
+
+			for name, expected := range map[string]string{
+				"overflow":   "0",
+				"underflow":  max.String(),
+				"product":    maxMinusOne.String(),
+				"shifted":    maxMinusOne.String(),
+				"shiftedOut": "0",
+			} {
+				require.Equal(t,
+					expected,
+					inter.Globals[name].GetValue().String(),
+					name,
+				)
+			}
+		})
+	}
+}
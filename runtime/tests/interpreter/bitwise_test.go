@@ -85,6 +85,12 @@ var bitwiseTestValueFunctions = map[string]func(int) interpreter.NumberValue{
 	"Word64": func(v int) interpreter.NumberValue {
 		return interpreter.NewUnmeteredWord64Value(uint64(v))
 	},
+	"Word128": func(v int) interpreter.NumberValue {
+		return interpreter.NewUnmeteredWord128ValueFromUint64(uint64(v))
+	},
+	"Word256": func(v int) interpreter.NumberValue {
+		return interpreter.NewUnmeteredWord256ValueFromUint64(uint64(v))
+	},
 }
 
 func init() {
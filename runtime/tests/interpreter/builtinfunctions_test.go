@@ -81,12 +81,18 @@ func TestInterpretToString(t *testing.T) {
 
 			isSigned := sema.IsSubType(ty, sema.SignedFixedPointType)
 
+			var scale uint = sema.Fix64Scale
+			if fractionalType, ok := ty.(sema.FractionalRangedType); ok {
+				scale = fractionalType.Scale()
+			}
+			fractional := fmt.Sprintf("34%0*d", scale-2, 0)
+
 			if isSigned {
 				literal = "-12.34"
-				expected = interpreter.NewUnmeteredStringValue("-12.34000000")
+				expected = interpreter.NewUnmeteredStringValue("-12." + fractional)
 			} else {
 				literal = "12.34"
-				expected = interpreter.NewUnmeteredStringValue("12.34000000")
+				expected = interpreter.NewUnmeteredStringValue("12." + fractional)
 			}
 
 			inter := parseCheckAndInterpret(t,
@@ -294,6 +300,20 @@ func TestInterpretToBigEndianBytes(t *testing.T) {
 			"9223372036854775808":  {128, 0, 0, 0, 0, 0, 0, 0},
 			"18446744073709551615": {255, 255, 255, 255, 255, 255, 255, 255},
 		},
+		"Word128": {
+			"0":   {0},
+			"42":  {42},
+			"127": {127},
+			"128": {128},
+			"200": {200},
+		},
+		"Word256": {
+			"0":   {0},
+			"42":  {42},
+			"127": {127},
+			"128": {128},
+			"200": {200},
+		},
 		// Fix*
 		"Fix64": {
 			"0.0":   {0, 0, 0, 0, 0, 0, 0, 0},
@@ -301,12 +321,23 @@ func TestInterpretToBigEndianBytes(t *testing.T) {
 			"42.24": {0, 0, 0, 0, 251, 197, 32, 0},
 			"-1.0":  {255, 255, 255, 255, 250, 10, 31, 0},
 		},
+		"Fix128": {
+			"0.0":   {0},
+			"42.0":  {34, 189, 216, 143, 237, 158, 252, 106, 0, 0, 0},
+			"42.24": {34, 240, 170, 253, 0, 136, 125, 32, 0, 0, 0},
+			"-1.0":  {255, 44, 61, 228, 49, 51, 18, 95, 0, 0, 0},
+		},
 		// UFix*
 		"UFix64": {
 			"0.0":   {0, 0, 0, 0, 0, 0, 0, 0},
 			"42.0":  {0, 0, 0, 0, 250, 86, 234, 0},
 			"42.24": {0, 0, 0, 0, 251, 197, 32, 0},
 		},
+		"UFix128": {
+			"0.0":   {0},
+			"42.0":  {34, 189, 216, 143, 237, 158, 252, 106, 0, 0, 0},
+			"42.24": {34, 240, 170, 253, 0, 136, 125, 32, 0, 0, 0},
+		},
 	}
 
 	// Ensure the test cases are complete
@@ -1165,3 +1165,54 @@ func TestInterpretFunctionWithPostConditionAndResourceResult(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, checkCalled)
 }
+
+func TestInterpretInheritedInterfaceFunctionConditions(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      struct interface I1 {
+          fun test(x: Int): Int {
+              pre {
+                  x > 0
+              }
+          }
+      }
+
+      struct interface I2: I1 {
+          fun test(x: Int): Int {
+              pre {
+                  x < 10
+              }
+          }
+      }
+
+      struct interface I3: I1 {}
+
+      struct S: I2, I3 {
+          fun test(x: Int): Int {
+              return x
+          }
+      }
+
+      fun test(x: Int): Int {
+          return S().test(x: x)
+      }
+    `)
+
+	for _, x := range []int64{0, 10} {
+
+		_, err := inter.Invoke(
+			"test",
+			interpreter.NewUnmeteredIntValueFromInt64(x),
+		)
+		var conditionErr interpreter.ConditionError
+		require.ErrorAs(t, err, &conditionErr)
+	}
+
+	five := interpreter.NewUnmeteredIntValueFromInt64(5)
+	value, err := inter.Invoke("test", five)
+	require.NoError(t, err)
+
+	AssertValuesEqual(t, inter, five, value)
+}
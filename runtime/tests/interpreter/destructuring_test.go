@@ -0,0 +1,331 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+	. "github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func TestInterpretArrayDestructuring(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("variable-sized", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Int {
+              let [a, _, c] = [1, 2, 3]
+              return a + c
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(4),
+			result,
+		)
+	})
+
+	t.Run("constant-sized, nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): String {
+              let xs: [[String]; 2] = [["a"], ["b", "c"]]
+              let [[a], [b, c]] = xs
+              return a.concat(b).concat(c)
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredStringValue("abc"),
+			result,
+		)
+	})
+
+	t.Run("copy", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): [Int] {
+              let xs = [[1], [2]]
+              var [a, b] = xs
+              a.append(3)
+              return xs[0]
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.ReturnEmptyLocationRange,
+				interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+				},
+				common.Address{},
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+			),
+			result,
+		)
+	})
+
+	t.Run("length mismatch", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test() {
+              let [a, b] = [1, 2, 3]
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.Error(t, err)
+
+		var lengthErr interpreter.ArrayPatternLengthMismatchError
+		require.ErrorAs(t, err, &lengthErr)
+		require.Equal(t, 2, lengthErr.ExpectedLength)
+		require.Equal(t, 3, lengthErr.ActualLength)
+	})
+
+	t.Run("resources", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          resource R {
+              let id: Int
+
+              init(id: Int) {
+                  self.id = id
+              }
+          }
+
+          fun test(): Int {
+              let rs <- [<-create R(id: 1), <-create R(id: 2)]
+              let [a, b] <- rs
+              let sum = a.id + b.id
+              destroy a
+              destroy b
+              return sum
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(3),
+			result,
+		)
+	})
+}
+
+func TestInterpretDictionaryDestructuring(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun test(): [Int?] {
+          let {"a": a, "c": c} = {"a": 1, "b": 2}
+          return [a, c]
+      }
+    `)
+
+	result, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewArrayValue(
+			inter,
+			interpreter.ReturnEmptyLocationRange,
+			interpreter.VariableSizedStaticType{
+				Type: interpreter.OptionalStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+				},
+			},
+			common.Address{},
+			interpreter.NewUnmeteredSomeValueNonCopying(
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+			),
+			interpreter.NilValue{},
+		),
+		result,
+	)
+}
+
+func TestInterpretCompositeDestructuring(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("struct", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct Pair {
+              let first: Int
+              let second: String
+
+              init(first: Int, second: String) {
+                  self.first = first
+                  self.second = second
+              }
+          }
+
+          fun test(): [String] {
+              let pair = Pair(first: 1, second: "2")
+              let (a, b) = pair
+              let (second: c) = pair
+              return [a.toString(), b, c]
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.ReturnEmptyLocationRange,
+				interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeString,
+				},
+				common.Address{},
+				interpreter.NewUnmeteredStringValue("1"),
+				interpreter.NewUnmeteredStringValue("2"),
+				interpreter.NewUnmeteredStringValue("2"),
+			),
+			result,
+		)
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          var destroyedPairs = 0
+          var destroyedRs = 0
+
+          resource R {
+              let id: Int
+
+              init(id: Int) {
+                  self.id = id
+              }
+
+              destroy() {
+                  destroyedRs = destroyedRs + 1
+              }
+          }
+
+          resource Pair {
+              let first: @R
+              let second: @R
+
+              init() {
+                  self.first <- create R(id: 1)
+                  self.second <- create R(id: 2)
+              }
+
+              destroy() {
+                  destroyedPairs = destroyedPairs + 1
+                  destroy self.first
+                  destroy self.second
+              }
+          }
+
+          fun test(): [Int] {
+              let pair <- create Pair()
+              let (second: b, first: a) <- pair
+              let ids = [a.id, b.id]
+              destroy a
+              destroy b
+              return ids
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.ReturnEmptyLocationRange,
+				interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+				},
+				common.Address{},
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+				interpreter.NewUnmeteredIntValueFromInt64(2),
+			),
+			result,
+		)
+
+		// The destructor of the destructured resource is not called,
+		// but the destructors of the moved fields are
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(0),
+			inter.Globals["destroyedPairs"].GetValue(),
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(2),
+			inter.Globals["destroyedRs"].GetValue(),
+		)
+	})
+}
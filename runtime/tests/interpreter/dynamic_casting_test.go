@@ -3798,3 +3798,348 @@ func TestInterpretReferenceCasting(t *testing.T) {
 		assert.ErrorAs(t, err, &interpreter.ForceCastTypeMismatchError{})
 	})
 }
+
+func TestInterpretFailableCastConversion(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("numbers", func(t *testing.T) {
+
+		t.Parallel()
+
+		type testCase struct {
+			valueType string
+			value     string
+			target    string
+			expected  interpreter.Value
+		}
+
+		testCases := []testCase{
+			{"Int", "255", "UInt8", interpreter.NewUnmeteredUInt8Value(255)},
+			{"Int", "256", "UInt8", nil},
+			{"Int", "-1", "UInt8", nil},
+			{"Int", "-128", "Int8", interpreter.NewUnmeteredInt8Value(-128)},
+			{"Int", "-129", "Int8", nil},
+			{"Int", "256", "Word8", nil},
+			{"UInt64", "18446744073709551615", "Int64", nil},
+			{"UInt64", "18446744073709551615", "UInt128", interpreter.NewUnmeteredUInt128ValueFromUint64(18446744073709551615)},
+			{"Integer", "42", "UInt8", interpreter.NewUnmeteredUInt8Value(42)},
+			{"Number", "42", "UInt8", interpreter.NewUnmeteredUInt8Value(42)},
+			{"AnyStruct", "42", "UInt8", nil},
+			{"Int", "1", "Fix64", interpreter.NewUnmeteredFix64ValueWithInteger(1)},
+			{"Int", "200000000000", "UFix64", nil},
+			{"Fix64", "1.0", "Int", interpreter.NewUnmeteredIntValueFromInt64(1)},
+			{"Fix64", "1.5", "Int", nil},
+			{"UFix64", "255.0", "UInt8", interpreter.NewUnmeteredUInt8Value(255)},
+			{"Fix64", "2.5", "UFix64", interpreter.NewUnmeteredUFix64Value(250_000_000)},
+			{"Fix64", "-1.0", "UFix64", nil},
+		}
+
+		for _, testCase := range testCases {
+
+			testCase := testCase
+
+			t.Run(fmt.Sprintf("%s %s to %s", testCase.valueType, testCase.value, testCase.target), func(t *testing.T) {
+
+				t.Parallel()
+
+				inter := parseCheckAndInterpret(t,
+					fmt.Sprintf(
+						`
+                          fun test(): %[3]s? {
+                              let x: %[1]s = %[2]s
+                              return x as? %[3]s
+                          }
+                        `,
+						testCase.valueType,
+						testCase.value,
+						testCase.target,
+					),
+				)
+
+				result, err := inter.Invoke("test")
+				require.NoError(t, err)
+
+				var expected interpreter.Value = interpreter.NilValue{}
+				if testCase.expected != nil {
+					expected = interpreter.NewUnmeteredSomeValueNonCopying(testCase.expected)
+				}
+
+				AssertValuesEqual(t, inter, expected, result)
+			})
+		}
+	})
+
+	t.Run("optional", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): UInt8?? {
+              let x: Int? = 1
+              return x as? UInt8?
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredSomeValueNonCopying(
+				interpreter.NewUnmeteredSomeValueNonCopying(
+					interpreter.NewUnmeteredUInt8Value(1),
+				),
+			),
+			result,
+		)
+	})
+
+	t.Run("array", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): [UInt8]? {
+              let x: [Integer] = [1, 2, 3]
+              return x as? [UInt8]
+          }
+
+          fun testOverflow(): [UInt8]? {
+              let x: [Int] = [1, 256]
+              return x as? [UInt8]
+          }
+
+          fun testNested(): [[UInt8]]? {
+              let x: [[Int]] = [[1], [2, 3]]
+              return x as? [[UInt8]]
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredSomeValueNonCopying(
+				interpreter.NewArrayValue(
+					inter,
+					interpreter.ReturnEmptyLocationRange,
+					interpreter.ByteArrayStaticType,
+					common.Address{},
+					interpreter.NewUnmeteredUInt8Value(1),
+					interpreter.NewUnmeteredUInt8Value(2),
+					interpreter.NewUnmeteredUInt8Value(3),
+				),
+			),
+			result,
+		)
+
+		result, err = inter.Invoke("testOverflow")
+		require.NoError(t, err)
+
+		AssertValuesEqual(t, inter, interpreter.NilValue{}, result)
+
+		result, err = inter.Invoke("testNested")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredSomeValueNonCopying(
+				interpreter.NewArrayValue(
+					inter,
+					interpreter.ReturnEmptyLocationRange,
+					interpreter.VariableSizedStaticType{
+						Type: interpreter.ByteArrayStaticType,
+					},
+					common.Address{},
+					interpreter.NewArrayValue(
+						inter,
+						interpreter.ReturnEmptyLocationRange,
+						interpreter.ByteArrayStaticType,
+						common.Address{},
+						interpreter.NewUnmeteredUInt8Value(1),
+					),
+					interpreter.NewArrayValue(
+						inter,
+						interpreter.ReturnEmptyLocationRange,
+						interpreter.ByteArrayStaticType,
+						common.Address{},
+						interpreter.NewUnmeteredUInt8Value(2),
+						interpreter.NewUnmeteredUInt8Value(3),
+					),
+				),
+			),
+			result,
+		)
+	})
+
+	t.Run("constant-sized array", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): [UInt8; 2]? {
+              let x: [Int; 2] = [1, 2]
+              return x as? [UInt8; 2]
+          }
+
+          fun testSizeMismatch(): [UInt8; 3]? {
+              let x: [Int] = [1, 2]
+              return x as? [UInt8; 3]
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredSomeValueNonCopying(
+				interpreter.NewArrayValue(
+					inter,
+					interpreter.ReturnEmptyLocationRange,
+					interpreter.ConstantSizedStaticType{
+						Type: interpreter.PrimitiveStaticTypeUInt8,
+						Size: 2,
+					},
+					common.Address{},
+					interpreter.NewUnmeteredUInt8Value(1),
+					interpreter.NewUnmeteredUInt8Value(2),
+				),
+			),
+			result,
+		)
+
+		result, err = inter.Invoke("testSizeMismatch")
+		require.NoError(t, err)
+
+		AssertValuesEqual(t, inter, interpreter.NilValue{}, result)
+	})
+
+	t.Run("dictionary", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): {UInt8: UInt8}? {
+              let x: {Int: Int} = {1: 2}
+              return x as? {UInt8: UInt8}
+          }
+
+          fun testOverflow(): {UInt8: UInt8}? {
+              let x: {Int: Int} = {256: 2}
+              return x as? {UInt8: UInt8}
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredSomeValueNonCopying(
+				interpreter.NewDictionaryValue(
+					inter,
+					interpreter.ReturnEmptyLocationRange,
+					interpreter.DictionaryStaticType{
+						KeyType:   interpreter.PrimitiveStaticTypeUInt8,
+						ValueType: interpreter.PrimitiveStaticTypeUInt8,
+					},
+					interpreter.NewUnmeteredUInt8Value(1),
+					interpreter.NewUnmeteredUInt8Value(2),
+				),
+			),
+			result,
+		)
+
+		result, err = inter.Invoke("testOverflow")
+		require.NoError(t, err)
+
+		AssertValuesEqual(t, inter, interpreter.NilValue{}, result)
+	})
+
+	t.Run("not statically a number", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): [UInt8]? {
+              let x: [AnyStruct] = [1, 2]
+              return x as? [UInt8]
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(t, inter, interpreter.NilValue{}, result)
+	})
+
+	t.Run("force cast does not convert", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(_ x: Int): UInt8 {
+              return x as! UInt8
+          }
+        `)
+
+		_, err := inter.Invoke("test", interpreter.NewUnmeteredIntValueFromInt64(1))
+		require.ErrorAs(t, err, &interpreter.ForceCastTypeMismatchError{})
+	})
+
+	t.Run("switch type pattern does not convert", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(_ x: Int): String {
+              switch x {
+              case let u as UInt8:
+                  return "UInt8"
+              default:
+                  return "other"
+              }
+          }
+        `)
+
+		result, err := inter.Invoke("test", interpreter.NewUnmeteredIntValueFromInt64(1))
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredStringValue("other"),
+			result,
+		)
+	})
+
+	t.Run("isInstance does not convert", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Bool {
+              let x: Int = 1
+              return x.isInstance(Type<UInt8>())
+          }
+        `)
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.BoolValue(false),
+			result,
+		)
+	})
+}
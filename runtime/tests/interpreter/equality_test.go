@@ -319,3 +319,199 @@ func TestInterpretEqualityOnNumericSuperTypes(t *testing.T) {
 		}
 	})
 }
+
+func TestInterpretEquatable(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("derived", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct Point: Equatable {
+              let x: Int
+              let name: String?
+
+              init(x: Int, name: String?) {
+                  self.x = x
+                  self.name = name
+              }
+          }
+
+          fun test(): [Bool] {
+              let a = Point(x: 1, name: "a")
+              return [
+                  a == Point(x: 1, name: "a"),
+                  a == Point(x: 1, name: nil),
+                  a != Point(x: 2, name: "a"),
+                  [Point(x: 2, name: nil), a].contains(Point(x: 1, name: "a"))
+              ]
+          }
+        `)
+
+		actual, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.ReturnEmptyLocationRange,
+				interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeBool,
+				},
+				common.Address{},
+				interpreter.BoolValue(true),
+				interpreter.BoolValue(false),
+				interpreter.BoolValue(true),
+				interpreter.BoolValue(true),
+			),
+			actual,
+		)
+	})
+
+	t.Run("equals function", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct Version: Equatable {
+              let major: Int
+              let tags: [String]
+
+              init(major: Int, tags: [String]) {
+                  self.major = major
+                  self.tags = tags
+              }
+
+              fun equals(_ other: Version): Bool {
+                  return self.major == other.major
+              }
+          }
+
+          fun test(): [Bool] {
+              let a = Version(major: 1, tags: ["a"])
+              return [
+                  a == Version(major: 1, tags: ["b"]),
+                  a != Version(major: 2, tags: ["a"])
+              ]
+          }
+        `)
+
+		actual, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.ReturnEmptyLocationRange,
+				interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeBool,
+				},
+				common.Address{},
+				interpreter.BoolValue(true),
+				interpreter.BoolValue(true),
+			),
+			actual,
+		)
+	})
+
+	t.Run("equals function, argument is copied", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct Counter: Equatable {
+              var count: Int
+
+              init(count: Int) {
+                  self.count = count
+              }
+
+              fun equals(_ other: Counter): Bool {
+                  other.count = other.count + 1
+                  return self.count == other.count
+              }
+          }
+
+          fun test(): [Bool] {
+              let a = Counter(count: 2)
+              let b = Counter(count: 1)
+              return [a == b, b.count == 1]
+          }
+        `)
+
+		actual, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.ReturnEmptyLocationRange,
+				interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeBool,
+				},
+				common.Address{},
+				interpreter.BoolValue(true),
+				interpreter.BoolValue(true),
+			),
+			actual,
+		)
+	})
+
+	t.Run("restricted type, different types", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct A: Equatable {}
+          struct B: Equatable {}
+
+          fun test(): Bool {
+              let a: {Equatable} = A()
+              let b: {Equatable} = B()
+              return a == b
+          }
+        `)
+
+		actual, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(t, inter, interpreter.BoolValue(false), actual)
+	})
+
+	t.Run("switch", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct Point: Equatable {
+              let x: Int
+
+              init(x: Int) {
+                  self.x = x
+              }
+          }
+
+          fun test(_ x: Int): String {
+              switch Point(x: x) {
+              case Point(x: 1):
+                  return "one"
+              default:
+                  return "other"
+              }
+          }
+        `)
+
+		actual, err := inter.Invoke("test", interpreter.NewUnmeteredIntValueFromInt64(1))
+		require.NoError(t, err)
+
+		AssertValuesEqual(t, inter, interpreter.NewUnmeteredStringValue("one"), actual)
+	})
+}
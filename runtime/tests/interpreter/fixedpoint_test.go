@@ -55,8 +55,20 @@ func TestInterpretFixedPointConversionAndAddition(t *testing.T) {
 	tests := map[string]interpreter.Value{
 		// Fix*
 		"Fix64": interpreter.NewUnmeteredFix64Value(123000000),
+		"Fix128": interpreter.NewUnmeteredFix128Value(
+			new(big.Int).Div(
+				new(big.Int).Mul(big.NewInt(123), sema.Fix128FactorBig),
+				big.NewInt(100),
+			),
+		),
 		// UFix*
 		"UFix64": interpreter.NewUnmeteredUFix64Value(123000000),
+		"UFix128": interpreter.NewUnmeteredUFix128Value(
+			new(big.Int).Div(
+				new(big.Int).Mul(big.NewInt(123), sema.Fix128FactorBig),
+				big.NewInt(100),
+			),
+		),
 	}
 
 	for _, fixedPointType := range sema.AllFixedPointTypes {
@@ -112,8 +124,10 @@ func TestInterpretFixedPointConversionAndAddition(t *testing.T) {
 }
 
 var testFixedPointValues = map[string]interpreter.Value{
-	"Fix64":  interpreter.NewUnmeteredFix64Value(50 * sema.Fix64Factor),
-	"UFix64": interpreter.NewUnmeteredUFix64Value(50 * sema.Fix64Factor),
+	"Fix64":   interpreter.NewUnmeteredFix64Value(50 * sema.Fix64Factor),
+	"UFix64":  interpreter.NewUnmeteredUFix64Value(50 * sema.Fix64Factor),
+	"Fix128":  interpreter.NewUnmeteredFix128ValueWithInteger(big.NewInt(50)),
+	"UFix128": interpreter.NewUnmeteredUFix128ValueWithInteger(big.NewInt(50)),
 }
 
 func init() {
@@ -571,6 +585,14 @@ func TestInterpretFixedPointMinMax(t *testing.T) {
 			min: interpreter.NewUnmeteredUFix64Value(0),
 			max: interpreter.NewUnmeteredUFix64Value(math.MaxUint64),
 		},
+		sema.Fix128Type: {
+			min: interpreter.NewUnmeteredFix128Value(sema.Fix128TypeMinBig),
+			max: interpreter.NewUnmeteredFix128Value(sema.Fix128TypeMaxBig),
+		},
+		sema.UFix128Type: {
+			min: interpreter.NewUnmeteredUFix128Value(sema.UFix128TypeMinBig),
+			max: interpreter.NewUnmeteredUFix128Value(sema.UFix128TypeMaxBig),
+		},
 	}
 
 	for _, ty := range sema.AllFixedPointTypes {
@@ -592,3 +614,183 @@ func TestInterpretFixedPointMinMax(t *testing.T) {
 		})
 	}
 }
+
+func TestInterpretFixedPointFromString(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(t *testing.T, ty sema.Type, input string, expected interpreter.Value) {
+
+		inter := parseCheckAndInterpret(t,
+			fmt.Sprintf(
+				`
+                  let x = %s.fromString("%s")
+                `,
+				ty,
+				input,
+			),
+		)
+
+		if expected != nil {
+			expected = interpreter.NewUnmeteredSomeValueNonCopying(expected)
+		} else {
+			expected = interpreter.NilValue{}
+		}
+
+		AssertValuesEqual(
+			t,
+			inter,
+			expected,
+			inter.Globals["x"].GetValue(),
+		)
+	}
+
+	t.Run("Fix64", func(t *testing.T) {
+
+		test(t, sema.Fix64Type, "12.34", interpreter.NewUnmeteredFix64Value(12_34000000))
+		test(t, sema.Fix64Type, "-0.5", interpreter.NewUnmeteredFix64Value(-50000000))
+		test(t, sema.Fix64Type, "1_000.000_1", interpreter.NewUnmeteredFix64Value(1000_00010000))
+		test(t, sema.Fix64Type, "-92233720368.54775808", interpreter.NewUnmeteredFix64Value(math.MinInt64))
+		test(t, sema.Fix64Type, "92233720368.54775808", nil)
+	})
+
+	t.Run("UFix64", func(t *testing.T) {
+
+		test(t, sema.UFix64Type, "12.34", interpreter.NewUnmeteredUFix64Value(12_34000000))
+		test(t, sema.UFix64Type, "184467440737.09551615", interpreter.NewUnmeteredUFix64Value(math.MaxUint64))
+		test(t, sema.UFix64Type, "184467440737.09551616", nil)
+		test(t, sema.UFix64Type, "-1.0", nil)
+	})
+
+	t.Run("Fix128", func(t *testing.T) {
+
+		test(t, sema.Fix128Type, "-0.5", interpreter.NewUnmeteredFix128Value(
+			new(big.Int).Div(sema.Fix128FactorBig, big.NewInt(-2)),
+		))
+		test(t, sema.Fix128Type, "0.000000000000000000000001", interpreter.NewUnmeteredFix128Value(big.NewInt(1)))
+		test(t, sema.Fix128Type, "0.0000000000000000000000001", nil)
+	})
+
+	t.Run("UFix128", func(t *testing.T) {
+
+		test(t, sema.UFix128Type, "12.0", interpreter.NewUnmeteredUFix128ValueWithInteger(big.NewInt(12)))
+		test(t, sema.UFix128Type, "-1.0", nil)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+
+		for _, input := range []string{
+			"",
+			"1",
+			"1.",
+			".1",
+			"1._",
+			"_1.0",
+			"+1.0",
+			" 1.0",
+			"1.0.0",
+			"1.-1",
+			"0x1.0",
+			// more fractional digits than the scale of the type
+			"1.000000001",
+		} {
+			test(t, sema.Fix64Type, input, nil)
+			test(t, sema.UFix64Type, input, nil)
+		}
+	})
+}
+
+func TestInterpretFix128Precision(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("division", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let x: UFix128 = 1.0 / 3.0
+        `)
+
+		expected, ok := new(big.Int).SetString("333333333333333333333333", 10)
+		require.True(t, ok)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredUFix128Value(expected),
+			inter.Globals["x"].GetValue(),
+		)
+	})
+
+	t.Run("from Fix64", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let x: Fix64 = -1.5
+          let y = Fix128(x)
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredFix128Value(
+				new(big.Int).Div(
+					new(big.Int).Mul(big.NewInt(-3), sema.Fix128FactorBig),
+					big.NewInt(2),
+				),
+			),
+			inter.Globals["y"].GetValue(),
+		)
+	})
+
+	t.Run("conversion of literal", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let x = UFix128(0.000000000000000000000001)
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredUFix128Value(big.NewInt(1)),
+			inter.Globals["x"].GetValue(),
+		)
+	})
+
+	t.Run("to UFix64, truncated", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let x: UFix128 = 0.123456789123456789
+          let y = UFix64(x)
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredUFix64Value(12345678),
+			inter.Globals["y"].GetValue(),
+		)
+	})
+
+	t.Run("to UFix64, overflow", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): UFix64 {
+              let x: UFix128 = 184467440738.0
+              return UFix64(x)
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+
+		require.ErrorAs(t, err, &interpreter.OverflowError{})
+	})
+}
@@ -0,0 +1,344 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/onflow/cadence/runtime/tests/utils"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+func TestInterpretGenericFunction(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("inferred type argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun firstOf<T>(_ xs: [T]): T? {
+              if xs.length == 0 {
+                  return nil
+              }
+              return xs[0]
+          }
+
+          fun test(): Int? {
+              return firstOf([1, 2, 3])
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredSomeValueNonCopying(
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+			),
+			value,
+		)
+	})
+
+	t.Run("explicit type argument, inferred argument type", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun firstOf<T>(_ xs: [T]): T? {
+              if xs.length == 0 {
+                  return nil
+              }
+              return xs[0]
+          }
+
+          fun test(): UInt8? {
+              return firstOf<UInt8>([1, 2, 3])
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredSomeValueNonCopying(
+				interpreter.NewUnmeteredUInt8Value(1),
+			),
+			value,
+		)
+	})
+
+	t.Run("type parameter in local types", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun twice<T>(_ x: T): [T] {
+              let f = fun (y: T): T {
+                  return y
+              }
+              return [f(x), f(x)]
+          }
+
+          fun test(): Type {
+              return twice("a").getType()
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.TypeValue{
+				Type: interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeString,
+				},
+			},
+			value,
+		)
+	})
+
+	t.Run("cast to type parameter", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun cast<T>(_ x: AnyStruct): T? {
+              return x as? T
+          }
+
+          fun test(): [AnyStruct] {
+              return [cast<Int>(1), cast<String>(1)]
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		require.IsType(t, &interpreter.ArrayValue{}, value)
+		array := value.(*interpreter.ArrayValue)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredSomeValueNonCopying(
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+			),
+			array.Get(inter, interpreter.ReturnEmptyLocationRange, 0),
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NilValue{},
+			array.Get(inter, interpreter.ReturnEmptyLocationRange, 1),
+		)
+	})
+}
+
+func TestInterpretGenericComposite(t *testing.T) {
+
+	t.Parallel()
+
+	const code = `
+      struct Box<T> {
+          let value: T
+
+          init(value: T) {
+              self.value = value
+          }
+
+          fun get(): T {
+              return self.value
+          }
+
+          fun map<U>(_ f: ((T): U)): Box<U> {
+              return Box(value: f(self.value))
+          }
+
+          fun getter(): ((): T) {
+              return fun (): T {
+                  return self.value
+              }
+          }
+      }
+
+      fun nest<T>(_ x: T): Box<Box<T>> {
+          return Box(value: Box(value: x))
+      }
+    `
+
+	boxType := func(typeArguments ...interpreter.StaticType) interpreter.StaticType {
+		return interpreter.CompositeStaticType{
+			Location:            TestLocation,
+			QualifiedIdentifier: "Box",
+			TypeID:              common.TypeID("S.test.Box"),
+			TypeArguments:       typeArguments,
+		}
+	}
+
+	t.Run("methods", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, code+`
+          fun test(): String {
+              let box = Box(value: 1)
+              let mapped = box.map(fun (x: Int): String {
+                  return x.toString()
+              })
+              return mapped.getter()()
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredStringValue("1"),
+			value,
+		)
+	})
+
+	t.Run("static type", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, code+`
+          fun test(): Type {
+              return nest(1).getType()
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		require.IsType(t, interpreter.TypeValue{}, value)
+		staticType := value.(interpreter.TypeValue).Type
+
+		assert.True(t,
+			boxType(boxType(interpreter.PrimitiveStaticTypeInt)).Equal(staticType),
+		)
+		assert.Equal(t,
+			"S.test.Box<S.test.Box<Int>>",
+			staticType.String(),
+		)
+	})
+
+	t.Run("dynamic casting", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, code+`
+          fun test(): [Bool] {
+              let box: AnyStruct = Box(value: 1)
+              return [
+                  (box as? Box<Int>) != nil,
+                  (box as? Box<String>) != nil,
+                  box.isInstance(Type<Box<Int>>()),
+                  box.isInstance(Type<Box<Box<Int>>>()),
+                  box.getType() == Type<Box<Int>>()
+              ]
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.ReturnEmptyLocationRange,
+				interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeBool,
+				},
+				common.Address{},
+				interpreter.BoolValue(true),
+				interpreter.BoolValue(false),
+				interpreter.BoolValue(true),
+				interpreter.BoolValue(false),
+				interpreter.BoolValue(true),
+			),
+			value,
+		)
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          resource Vault<T> {
+              var items: [T]
+
+              init() {
+                  self.items = []
+              }
+
+              fun add(_ item: T) {
+                  self.items.append(item)
+              }
+          }
+
+          fun test(): [String] {
+              let vault <- create Vault<String>()
+              vault.add("a")
+              vault.add("b")
+              let items = vault.items
+              destroy vault
+              return items
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.ReturnEmptyLocationRange,
+				interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeString,
+				},
+				common.Address{},
+				interpreter.NewUnmeteredStringValue("a"),
+				interpreter.NewUnmeteredStringValue("b"),
+			),
+			value,
+		)
+	})
+}
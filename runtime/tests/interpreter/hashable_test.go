@@ -0,0 +1,192 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+	. "github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func TestInterpretHashable(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("dictionary key", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct Point: Hashable {
+              let x: Int
+              let y: Int
+
+              init(x: Int, y: Int) {
+                  self.x = x
+                  self.y = y
+              }
+          }
+
+          fun test(): [AnyStruct] {
+              let names: {Point: String} = {
+                  Point(x: 1, y: 2): "a",
+                  Point(x: 2, y: 1): "b"
+              }
+              names[Point(x: 1, y: 2)] = "c"
+
+              return [
+                  names.length,
+                  names[Point(x: 1, y: 2)],
+                  names[Point(x: 2, y: 1)],
+                  names.containsKey(Point(x: 3, y: 3)),
+                  names.remove(key: Point(x: 2, y: 1)),
+                  names.length
+              ]
+          }
+        `)
+
+		actual, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.ReturnEmptyLocationRange,
+				interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeAnyStruct,
+				},
+				common.Address{},
+				interpreter.NewUnmeteredIntValueFromInt64(2),
+				interpreter.NewUnmeteredSomeValueNonCopying(
+					interpreter.NewUnmeteredStringValue("c"),
+				),
+				interpreter.NewUnmeteredSomeValueNonCopying(
+					interpreter.NewUnmeteredStringValue("b"),
+				),
+				interpreter.BoolValue(false),
+				interpreter.NewUnmeteredSomeValueNonCopying(
+					interpreter.NewUnmeteredStringValue("b"),
+				),
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+			),
+			actual,
+		)
+	})
+
+	t.Run("nested, different types", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          enum Color: UInt8 {
+              case red
+              case green
+          }
+
+          struct Inner: Hashable {
+              let color: Color
+
+              init(color: Color) {
+                  self.color = color
+              }
+          }
+
+          struct Outer: Hashable {
+              let inner: Inner
+
+              init(inner: Inner) {
+                  self.inner = inner
+              }
+          }
+
+          fun test(): [AnyStruct] {
+              let values: {{Hashable}: Int} = {
+                  Inner(color: Color.red): 1,
+                  Outer(inner: Inner(color: Color.red)): 2,
+                  Outer(inner: Inner(color: Color.green)): 3
+              }
+
+              return [
+                  values.length,
+                  values[Outer(inner: Inner(color: Color.red))],
+                  values[Inner(color: Color.red)],
+                  values[Inner(color: Color.green)]
+              ]
+          }
+        `)
+
+		actual, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.ReturnEmptyLocationRange,
+				interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeAnyStruct,
+				},
+				common.Address{},
+				interpreter.NewUnmeteredIntValueFromInt64(3),
+				interpreter.NewUnmeteredSomeValueNonCopying(
+					interpreter.NewUnmeteredIntValueFromInt64(2),
+				),
+				interpreter.NewUnmeteredSomeValueNonCopying(
+					interpreter.NewUnmeteredIntValueFromInt64(1),
+				),
+				interpreter.NilValue{},
+			),
+			actual,
+		)
+	})
+
+	t.Run("key is copied", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct Counter: Hashable {
+              var count: Int
+
+              init(count: Int) {
+                  self.count = count
+              }
+          }
+
+          fun test(): Bool {
+              let counter = Counter(count: 1)
+              let values: {Counter: Bool} = {counter: true}
+              counter.count = 2
+              return values[Counter(count: 1)] ?? false
+          }
+        `)
+
+		actual, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(t, inter, interpreter.BoolValue(true), actual)
+	})
+}
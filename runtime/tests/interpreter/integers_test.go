@@ -27,9 +27,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/onflow/cadence/runtime/tests/checker"
 	. "github.com/onflow/cadence/runtime/tests/utils"
 
 	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/parser"
 	"github.com/onflow/cadence/runtime/sema"
 )
 
@@ -51,10 +53,12 @@ var testIntegerTypesAndValues = map[string]interpreter.Value{
 	"UInt128": interpreter.NewUnmeteredUInt128ValueFromUint64(50),
 	"UInt256": interpreter.NewUnmeteredUInt256ValueFromUint64(50),
 	// Word*
-	"Word8":  interpreter.NewUnmeteredWord8Value(50),
-	"Word16": interpreter.NewUnmeteredWord16Value(50),
-	"Word32": interpreter.NewUnmeteredWord32Value(50),
-	"Word64": interpreter.NewUnmeteredWord64Value(50),
+	"Word8":   interpreter.NewUnmeteredWord8Value(50),
+	"Word16":  interpreter.NewUnmeteredWord16Value(50),
+	"Word32":  interpreter.NewUnmeteredWord32Value(50),
+	"Word64":  interpreter.NewUnmeteredWord64Value(50),
+	"Word128": interpreter.NewUnmeteredWord128ValueFromUint64(50),
+	"Word256": interpreter.NewUnmeteredWord256ValueFromUint64(50),
 }
 
 func init() {
@@ -120,10 +124,12 @@ func TestInterpretWordOverflowConversions(t *testing.T) {
 	t.Parallel()
 
 	words := map[string]*big.Int{
-		"Word8":  sema.UInt8TypeMaxInt,
-		"Word16": sema.UInt16TypeMaxInt,
-		"Word32": sema.UInt32TypeMaxInt,
-		"Word64": sema.UInt64TypeMaxInt,
+		"Word8":   sema.UInt8TypeMaxInt,
+		"Word16":  sema.UInt16TypeMaxInt,
+		"Word32":  sema.UInt32TypeMaxInt,
+		"Word64":  sema.UInt64TypeMaxInt,
+		"Word128": sema.Word128TypeMaxIntBig,
+		"Word256": sema.Word256TypeMaxIntBig,
 	}
 
 	for typeName, value := range words {
@@ -155,10 +161,12 @@ func TestInterpretWordUnderflowConversions(t *testing.T) {
 	t.Parallel()
 
 	words := map[string]*big.Int{
-		"Word8":  sema.UInt8TypeMaxInt,
-		"Word16": sema.UInt16TypeMaxInt,
-		"Word32": sema.UInt32TypeMaxInt,
-		"Word64": sema.UInt64TypeMaxInt,
+		"Word8":   sema.UInt8TypeMaxInt,
+		"Word16":  sema.UInt16TypeMaxInt,
+		"Word32":  sema.UInt32TypeMaxInt,
+		"Word64":  sema.UInt64TypeMaxInt,
+		"Word128": sema.Word128TypeMaxIntBig,
+		"Word256": sema.Word256TypeMaxIntBig,
 	}
 
 	for typeName, value := range words {
@@ -652,6 +660,16 @@ func TestInterpretIntegerConversion(t *testing.T) {
 			min:      interpreter.NewUnmeteredWord64Value(0),
 			max:      interpreter.NewUnmeteredWord64Value(math.MaxUint64),
 		},
+		sema.Word128Type: {
+			fortyTwo: interpreter.NewUnmeteredWord128ValueFromUint64(42),
+			min:      interpreter.NewUnmeteredWord128ValueFromUint64(0),
+			max:      interpreter.NewUnmeteredWord128ValueFromBigInt(sema.Word128TypeMaxIntBig),
+		},
+		sema.Word256Type: {
+			fortyTwo: interpreter.NewUnmeteredWord256ValueFromUint64(42),
+			min:      interpreter.NewUnmeteredWord256ValueFromUint64(0),
+			max:      interpreter.NewUnmeteredWord256ValueFromBigInt(sema.Word256TypeMaxIntBig),
+		},
 		sema.Int8Type: {
 			fortyTwo: interpreter.NewUnmeteredInt8Value(42),
 			min:      interpreter.NewUnmeteredInt8Value(math.MinInt8),
@@ -711,7 +729,9 @@ func TestInterpretIntegerConversion(t *testing.T) {
 					case sema.Word8Type,
 						sema.Word16Type,
 						sema.Word32Type,
-						sema.Word64Type:
+						sema.Word64Type,
+						sema.Word128Type,
+						sema.Word256Type:
 					default:
 						t.Run("underflow", func(t *testing.T) {
 							test(t, sourceType, targetType, sourceValues.min, nil, interpreter.UnderflowError{})
@@ -736,7 +756,9 @@ func TestInterpretIntegerConversion(t *testing.T) {
 					case sema.Word8Type,
 						sema.Word16Type,
 						sema.Word32Type,
-						sema.Word64Type:
+						sema.Word64Type,
+						sema.Word128Type,
+						sema.Word256Type:
 					default:
 						t.Run("overflow", func(t *testing.T) {
 							test(t, sourceType, targetType, sourceValues.max, nil, interpreter.OverflowError{})
@@ -832,6 +854,14 @@ func TestInterpretIntegerMinMax(t *testing.T) {
 			min: interpreter.NewUnmeteredWord64Value(0),
 			max: interpreter.NewUnmeteredWord64Value(math.MaxUint64),
 		},
+		sema.Word128Type: {
+			min: interpreter.NewUnmeteredWord128ValueFromUint64(0),
+			max: interpreter.NewUnmeteredWord128ValueFromBigInt(sema.Word128TypeMaxIntBig),
+		},
+		sema.Word256Type: {
+			min: interpreter.NewUnmeteredWord256ValueFromUint64(0),
+			max: interpreter.NewUnmeteredWord256ValueFromBigInt(sema.Word256TypeMaxIntBig),
+		},
 		sema.Int8Type: {
 			min: interpreter.NewUnmeteredInt8Value(math.MinInt8),
 			max: interpreter.NewUnmeteredInt8Value(math.MaxInt8),
@@ -882,3 +912,200 @@ func TestInterpretIntegerMinMax(t *testing.T) {
 		})
 	}
 }
+
+func TestInterpretIntegerFromString(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(t *testing.T, ty string, input string, expected interpreter.Value) {
+
+		inter := parseCheckAndInterpret(t,
+			fmt.Sprintf(
+				`
+                  let x = %s.fromString("%s")
+                `,
+				ty,
+				input,
+			),
+		)
+
+		if expected != nil {
+			expected = interpreter.NewUnmeteredSomeValueNonCopying(expected)
+		} else {
+			expected = interpreter.NilValue{}
+		}
+
+		AssertValuesEqual(
+			t,
+			inter,
+			expected,
+			inter.Globals["x"].GetValue(),
+		)
+	}
+
+	for integerType, value := range testIntegerTypesAndValues {
+
+		t.Run(integerType, func(t *testing.T) {
+
+			test(t, integerType, "50", value)
+			test(t, integerType, "5_0", value)
+			test(t, integerType, "0x32", value)
+			test(t, integerType, "0b110010", value)
+			test(t, integerType, "0o62", value)
+		})
+	}
+
+	t.Run("negative", func(t *testing.T) {
+
+		test(t, "Int", "-0xF_F", interpreter.NewUnmeteredIntValueFromInt64(-255))
+		test(t, "Int8", "-128", interpreter.NewUnmeteredInt8Value(math.MinInt8))
+		test(t, "UInt", "-1", nil)
+		test(t, "Word8", "-1", nil)
+	})
+
+	t.Run("out of range", func(t *testing.T) {
+
+		test(t, "Int8", "128", nil)
+		test(t, "Int8", "-129", nil)
+		test(t, "UInt8", "256", nil)
+		test(t, "Word8", "256", nil)
+		test(t, "UInt64", "18446744073709551616", nil)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+
+		for _, input := range []string{
+			"",
+			"-",
+			"+1",
+			" 1",
+			"1 ",
+			"--1",
+			"_1",
+			"1_",
+			"0x",
+			"0x_",
+			"0x_F",
+			"0z1",
+			"0b2",
+			"1.0",
+			"abc",
+		} {
+			test(t, "Int", input, nil)
+		}
+	})
+}
+
+func TestInterpretNumberFromStringLiteralConsistency(t *testing.T) {
+
+	t.Parallel()
+
+	// fromString must accept exactly the inputs which are valid literals of the type,
+	// and must result in the same value
+
+	inputs := []string{
+		"0",
+		"1",
+		"-1",
+		"00001",
+		"0_1",
+		"1__0",
+		"1_000",
+		"1_",
+		"0b101",
+		"0o17",
+		"0xFF",
+		"0xF_F",
+		"0x_F",
+		"0x",
+		"0K1",
+		"0b2",
+		"127",
+		"128",
+		"-128",
+		"-129",
+		"255",
+		"256",
+		"1.0",
+		"-1.5",
+		"1_000.000_1",
+		"1._1",
+		"0.00000001",
+		"0.000000001",
+		"92233720368.54775807",
+		"92233720368.54775808",
+		"184467440737.09551615",
+		"184467440737.09551616",
+	}
+
+	types := []sema.Type{
+		sema.IntType,
+		sema.Int8Type,
+		sema.UInt8Type,
+		sema.Word8Type,
+		sema.Fix64Type,
+		sema.UFix64Type,
+	}
+
+	for _, ty := range types {
+		for _, input := range inputs {
+
+			t.Run(fmt.Sprintf("%s %s", ty, input), func(t *testing.T) {
+
+				literalCode := fmt.Sprintf(
+					`
+                      let literal: %s = %s
+                    `,
+					ty,
+					input,
+				)
+
+				_, err := parser.ParseProgram(literalCode, nil)
+				if err == nil {
+					_, err = checker.ParseAndCheck(t, literalCode)
+				}
+
+				if err != nil {
+					inter := parseCheckAndInterpret(t,
+						fmt.Sprintf(
+							`
+                              let parsed = %s.fromString("%s")
+                            `,
+							ty,
+							input,
+						),
+					)
+
+					AssertValuesEqual(
+						t,
+						inter,
+						interpreter.NilValue{},
+						inter.Globals["parsed"].GetValue(),
+					)
+
+					return
+				}
+
+				inter := parseCheckAndInterpret(t,
+					fmt.Sprintf(
+						`
+                          let literal: %[1]s = %[2]s
+                          let parsed = %[1]s.fromString("%[2]s")
+                        `,
+						ty,
+						input,
+					),
+				)
+
+				AssertValuesEqual(
+					t,
+					inter,
+					interpreter.NewUnmeteredSomeValueNonCopying(
+						inter.Globals["literal"].GetValue(),
+					),
+					inter.Globals["parsed"].GetValue(),
+				)
+			})
+		}
+	}
+}
@@ -5580,6 +5580,428 @@ func TestInterpretArrayContains(t *testing.T) {
 	)
 }
 
+func TestInterpretArrayHigherOrderFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	intArray := func(inter *interpreter.Interpreter, arrayType interpreter.ArrayStaticType, values ...int64) *interpreter.ArrayValue {
+		elements := make([]interpreter.Value, len(values))
+		for i, value := range values {
+			elements[i] = interpreter.NewUnmeteredIntValueFromInt64(value)
+		}
+		return interpreter.NewArrayValue(
+			inter,
+			interpreter.ReturnEmptyLocationRange,
+			arrayType,
+			common.Address{},
+			elements...,
+		)
+	}
+
+	variableSizedIntArrayType := interpreter.VariableSizedStaticType{
+		Type: interpreter.PrimitiveStaticTypeInt,
+	}
+
+	t.Run("forEach", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Int {
+              var sum = 0
+              [1, 2, 3].forEach(fun (x: Int) {
+                  sum = sum * 10 + x
+              })
+              return sum
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(123),
+			value,
+		)
+	})
+
+	t.Run("map", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): [Int] {
+              return [1, 2, 3].map(fun (x: Int): Int {
+                  return x * 2
+              })
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			intArray(inter, variableSizedIntArrayType, 2, 4, 6),
+			value,
+		)
+	})
+
+	t.Run("map, constant-sized", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): [String; 2] {
+              let xs: [Int; 2] = [1, 2]
+              return xs.map(fun (x: Int): String {
+                  return x.toString()
+              })
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.ReturnEmptyLocationRange,
+				interpreter.ConstantSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeString,
+					Size: 2,
+				},
+				common.Address{},
+				interpreter.NewUnmeteredStringValue("1"),
+				interpreter.NewUnmeteredStringValue("2"),
+			),
+			value,
+		)
+	})
+
+	t.Run("filter", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): [Int] {
+              return [1, 2, 3, 4].filter(fun (x: Int): Bool {
+                  return x % 2 == 0
+              })
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			intArray(inter, variableSizedIntArrayType, 2, 4),
+			value,
+		)
+	})
+
+	t.Run("filter, mutating predicate", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct S {
+              pub(set) var x: Int
+
+              init(x: Int) {
+                  self.x = x
+              }
+          }
+
+          fun test(): [Int] {
+              let ss = [S(x: 1), S(x: 2)]
+              let filtered = ss.filter(fun (s: S): Bool {
+                  s.x = 0
+                  return true
+              })
+              return [ss[0].x, ss[1].x, filtered[0].x, filtered[1].x]
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			intArray(inter, variableSizedIntArrayType, 1, 2, 1, 2),
+			value,
+		)
+	})
+
+	t.Run("reduce", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): [String] {
+              let xs = [1, 2, 3]
+              let empty: [Int] = []
+              let combine = fun (partial: String, x: Int): String {
+                  return partial.concat(x.toString())
+              }
+              return [
+                  xs.reduce(initial: ">", combine),
+                  empty.reduce(initial: ">", combine)
+              ]
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.ReturnEmptyLocationRange,
+				interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeString,
+				},
+				common.Address{},
+				interpreter.NewUnmeteredStringValue(">123"),
+				interpreter.NewUnmeteredStringValue(">"),
+			),
+			value,
+		)
+	})
+
+	t.Run("reverse", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let xs = [1, 2, 3]
+
+          fun test(): [Int] {
+              return xs.reverse()
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			intArray(inter, variableSizedIntArrayType, 3, 2, 1),
+			value,
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			intArray(inter, variableSizedIntArrayType, 1, 2, 3),
+			inter.Globals["xs"].GetValue(),
+		)
+	})
+
+	t.Run("sort", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): [Int] {
+              let xs = [3, 1, 2]
+              xs.sort(by: fun (a: Int, b: Int): Bool {
+                  return a < b
+              })
+              return xs
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			intArray(inter, variableSizedIntArrayType, 1, 2, 3),
+			value,
+		)
+	})
+
+	t.Run("sort, stable", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): [[Int]] {
+              let xs = [[2, 1], [1, 1], [2, 2], [1, 2]]
+              xs.sort(by: fun (a: [Int], b: [Int]): Bool {
+                  return a[0] < b[0]
+              })
+              return xs
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.ReturnEmptyLocationRange,
+				interpreter.VariableSizedStaticType{
+					Type: variableSizedIntArrayType,
+				},
+				common.Address{},
+				intArray(inter, variableSizedIntArrayType, 1, 1),
+				intArray(inter, variableSizedIntArrayType, 1, 2),
+				intArray(inter, variableSizedIntArrayType, 2, 1),
+				intArray(inter, variableSizedIntArrayType, 2, 2),
+			),
+			value,
+		)
+	})
+
+	t.Run("mutation during iteration", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun forEach() {
+              let xs = [1, 2]
+              xs.forEach(fun (x: Int) {
+                  xs.append(x)
+              })
+          }
+
+          fun sort() {
+              let xs = [1, 2]
+              xs.sort(by: fun (a: Int, b: Int): Bool {
+                  xs.removeLast()
+                  return a < b
+              })
+          }
+
+          fun sortDuringForEach() {
+              let xs = [2, 1]
+              xs.forEach(fun (x: Int) {
+                  xs.sort(by: fun (a: Int, b: Int): Bool {
+                      return a < b
+                  })
+              })
+          }
+
+          fun reverseDuringForEach(): [Int] {
+              let xs = [1, 2]
+              xs.forEach(fun (x: Int) {
+                  xs.reverse()
+              })
+              xs.append(3)
+              return xs.reverse()
+          }
+        `)
+
+		_, err := inter.Invoke("forEach")
+		require.ErrorAs(t, err, &interpreter.ContainerMutatedDuringIterationError{})
+
+		_, err = inter.Invoke("sort")
+		require.ErrorAs(t, err, &interpreter.ContainerMutatedDuringIterationError{})
+
+		_, err = inter.Invoke("sortDuringForEach")
+		require.ErrorAs(t, err, &interpreter.ContainerMutatedDuringIterationError{})
+
+		// Reversing only reads the array, so it is allowed during an iteration,
+		// and the array may be mutated again afterwards
+
+		value, err := inter.Invoke("reverseDuringForEach")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			intArray(inter, variableSizedIntArrayType, 3, 2, 1),
+			value,
+		)
+	})
+
+	t.Run("sort, stored", func(t *testing.T) {
+
+		t.Parallel()
+
+		address := interpreter.NewUnmeteredAddressValueFromBytes([]byte{42})
+
+		inter, _ := testAccount(
+			t,
+			address,
+			true,
+			`
+              fun test(): [[Int]] {
+                  account.save([[3, 3], [1, 1], [2, 2]], to: /storage/xs)
+                  let xs = account.borrow<&[[Int]]>(from: /storage/xs)!
+                  xs.sort(by: fun (a: [Int], b: [Int]): Bool {
+                      return a[0] < b[0]
+                  })
+                  xs[0].append(1)
+                  return account.load<[[Int]]>(from: /storage/xs)!
+              }
+            `,
+		)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.ReturnEmptyLocationRange,
+				interpreter.VariableSizedStaticType{
+					Type: variableSizedIntArrayType,
+				},
+				common.Address{},
+				intArray(inter, variableSizedIntArrayType, 1, 1, 1),
+				intArray(inter, variableSizedIntArrayType, 2, 2),
+				intArray(inter, variableSizedIntArrayType, 3, 3),
+			),
+			value,
+		)
+	})
+
+	t.Run("removal from storage during iteration", func(t *testing.T) {
+
+		t.Parallel()
+
+		address := interpreter.NewUnmeteredAddressValueFromBytes([]byte{42})
+
+		inter, _ := testAccount(
+			t,
+			address,
+			true,
+			`
+              fun test() {
+                  account.save([1, 2], to: /storage/xs)
+                  let xs = account.borrow<&[Int]>(from: /storage/xs)!
+                  xs.forEach(fun (x: Int) {
+                      account.load<[Int]>(from: /storage/xs)
+                  })
+              }
+            `,
+		)
+
+		_, err := inter.Invoke("test")
+		require.ErrorAs(t, err, &interpreter.ContainerMutatedDuringIterationError{})
+	})
+}
+
 func TestInterpretDictionaryContainsKey(t *testing.T) {
 
 	t.Parallel()
@@ -5798,6 +6220,228 @@ func TestInterpretDictionaryValues(t *testing.T) {
 	)
 }
 
+func TestInterpretDictionaryForEachKey(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let dict = {"def": 2, "abc": 1, "a": 3}
+
+      fun all(): [String] {
+          let keys: [String] = []
+          dict.forEachKey(fun (key: String): Bool {
+              keys.append(key)
+              return true
+          })
+          return keys
+      }
+
+      fun first(): [String] {
+          let keys: [String] = []
+          dict.forEachKey(fun (key: String): Bool {
+              keys.append(key)
+              return false
+          })
+          return keys
+      }
+    `)
+
+	value, err := inter.Invoke("all")
+	require.NoError(t, err)
+
+	AssertValueSlicesEqual(
+		t,
+		inter,
+		[]interpreter.Value{
+			interpreter.NewUnmeteredStringValue("abc"),
+			interpreter.NewUnmeteredStringValue("def"),
+			interpreter.NewUnmeteredStringValue("a"),
+		},
+		arrayElements(inter, value.(*interpreter.ArrayValue)),
+	)
+
+	value, err = inter.Invoke("first")
+	require.NoError(t, err)
+
+	AssertValueSlicesEqual(
+		t,
+		inter,
+		[]interpreter.Value{
+			interpreter.NewUnmeteredStringValue("abc"),
+		},
+		arrayElements(inter, value.(*interpreter.ArrayValue)),
+	)
+}
+
+func TestInterpretDictionaryForEach(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("all", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): [String] {
+              let dict = {"def": 2, "abc": 1, "a": 3}
+              let entries: [String] = []
+              dict.forEach(fun (key: String, value: Int): Bool {
+                  entries.append(key.concat(value.toString()))
+                  return true
+              })
+              return entries
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValueSlicesEqual(
+			t,
+			inter,
+			[]interpreter.Value{
+				interpreter.NewUnmeteredStringValue("abc1"),
+				interpreter.NewUnmeteredStringValue("def2"),
+				interpreter.NewUnmeteredStringValue("a3"),
+			},
+			arrayElements(inter, value.(*interpreter.ArrayValue)),
+		)
+	})
+
+	t.Run("early termination", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Int {
+              let dict = {"def": 2, "abc": 1, "a": 3}
+              var count = 0
+              dict.forEach(fun (key: String, value: Int): Bool {
+                  count = count + 1
+                  return value != 2
+              })
+
+              // The dictionary may be mutated after the iteration
+              dict.remove(key: "a")
+
+              return count
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(2),
+			value,
+		)
+	})
+
+	t.Run("mutated value", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): [Int] {
+              let dict = {"a": [1]}
+              dict.forEach(fun (key: String, value: [Int]): Bool {
+                  value.append(2)
+                  return true
+              })
+              return dict["a"]!
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValueSlicesEqual(
+			t,
+			inter,
+			[]interpreter.Value{
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+			},
+			arrayElements(inter, value.(*interpreter.ArrayValue)),
+		)
+	})
+}
+
+func TestInterpretDictionaryMutationDuringIteration(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun insert() {
+          let dict = {"a": 1}
+          dict.forEach(fun (key: String, value: Int): Bool {
+              dict.insert(key: "b", 2)
+              return true
+          })
+      }
+
+      fun remove() {
+          let dict = {"a": 1}
+          dict.forEachKey(fun (key: String): Bool {
+              dict.remove(key: key)
+              return true
+          })
+      }
+
+      fun assign() {
+          let dict = {"a": 1}
+          let ref = &dict as &{String: Int}
+          dict.forEachKey(fun (key: String): Bool {
+              ref[key] = nil
+              return true
+          })
+      }
+    `)
+
+	for _, name := range []string{"insert", "remove", "assign"} {
+		_, err := inter.Invoke(name)
+		require.ErrorAs(t, err, &interpreter.ContainerMutatedDuringIterationError{})
+	}
+}
+
+func TestInterpretDictionaryRemovalFromStorageDuringIteration(t *testing.T) {
+
+	t.Parallel()
+
+	address := interpreter.NewUnmeteredAddressValueFromBytes([]byte{42})
+
+	inter, _ := testAccount(
+		t,
+		address,
+		true,
+		`
+          fun forEachKey() {
+              account.save({"a": 1, "b": 2}, to: /storage/dict)
+              let dict = account.borrow<&{String: Int}>(from: /storage/dict)!
+              dict.forEachKey(fun (key: String): Bool {
+                  account.load<{String: Int}>(from: /storage/dict)
+                  return true
+              })
+          }
+
+          fun forEach() {
+              account.save({"a": 1, "b": 2}, to: /storage/dict)
+              let dict = account.borrow<&{String: Int}>(from: /storage/dict)!
+              dict.forEach(fun (key: String, value: Int): Bool {
+                  account.load<{String: Int}>(from: /storage/dict)
+                  return true
+              })
+          }
+        `,
+	)
+
+	for _, name := range []string{"forEachKey", "forEach"} {
+		_, err := inter.Invoke(name)
+		require.ErrorAs(t, err, &interpreter.ContainerMutatedDuringIterationError{})
+	}
+}
+
 func TestInterpretDictionaryKeyTypes(t *testing.T) {
 
 	t.Parallel()
@@ -6849,16 +7493,32 @@ func TestInterpretEmitEventParameterTypes(t *testing.T) {
 			value: interpreter.NewUnmeteredWord64Value(42),
 			ty:    sema.Word64Type,
 		},
+		"Word128": {
+			value: interpreter.NewUnmeteredWord128ValueFromUint64(42),
+			ty:    sema.Word128Type,
+		},
+		"Word256": {
+			value: interpreter.NewUnmeteredWord256ValueFromUint64(42),
+			ty:    sema.Word256Type,
+		},
 		// Fix*
 		"Fix64": {
 			value: interpreter.NewUnmeteredFix64Value(123000000),
 			ty:    sema.Fix64Type,
 		},
+		"Fix128": {
+			value: interpreter.NewUnmeteredFix128Value(new(big.Int).Mul(big.NewInt(123), sema.Fix128FactorBig)),
+			ty:    sema.Fix128Type,
+		},
 		// UFix*
 		"UFix64": {
 			value: interpreter.NewUnmeteredUFix64Value(123000000),
 			ty:    sema.UFix64Type,
 		},
+		"UFix128": {
+			value: interpreter.NewUnmeteredUFix128Value(new(big.Int).Mul(big.NewInt(123), sema.Fix128FactorBig)),
+			ty:    sema.UFix128Type,
+		},
 		// TODO:
 		//// Struct
 		//"S": {
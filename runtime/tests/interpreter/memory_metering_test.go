@@ -703,8 +703,8 @@ func TestInterpretSimpleCompositeMetering(t *testing.T) {
 		require.NoError(t, err)
 
 		assert.Equal(t, uint64(1), meter.getMemory(common.MemoryKindSimpleCompositeValueBase))
-		// AuthAccount has 18 fields
-		assert.Equal(t, uint64(18), meter.getMemory(common.MemoryKindSimpleCompositeValue))
+		// AuthAccount has 24 fields
+		assert.Equal(t, uint64(24), meter.getMemory(common.MemoryKindSimpleCompositeValue))
 	})
 
 	t.Run("public account", func(t *testing.T) {
@@ -723,8 +723,8 @@ func TestInterpretSimpleCompositeMetering(t *testing.T) {
 		require.NoError(t, err)
 
 		assert.Equal(t, uint64(1), meter.getMemory(common.MemoryKindSimpleCompositeValueBase))
-		// PublicAccount has 9 fields
-		assert.Equal(t, uint64(9), meter.getMemory(common.MemoryKindSimpleCompositeValue))
+		// PublicAccount has 11 fields
+		assert.Equal(t, uint64(11), meter.getMemory(common.MemoryKindSimpleCompositeValue))
 	})
 }
 
@@ -7404,6 +7404,91 @@ func TestInterpretStringMetering(t *testing.T) {
 		// + result: 1 + 4 (max UTF8 encoding)
 		assert.Equal(t, uint64(10), meter.getMemory(common.MemoryKindStringValue))
 	})
+
+	t.Run("toUpper, ASCII", func(t *testing.T) {
+
+		t.Parallel()
+
+		script := `
+            pub fun main() {
+                let x = "abc".toUpper()
+            }
+        `
+		meter := newTestMemoryGauge()
+		inter := parseCheckAndInterpretWithMemoryMetering(t, script, meter)
+
+		_, err := inter.Invoke("main")
+		require.NoError(t, err)
+
+		// creation: 1 + 2 * " + 3 (abc)
+		// + result: 1 + 3 (ABC)
+		assert.Equal(t, uint64(10), meter.getMemory(common.MemoryKindStringValue))
+	})
+
+	t.Run("split", func(t *testing.T) {
+
+		t.Parallel()
+
+		script := `
+            pub fun main() {
+                let x = "a,bc".split(separator: ",")
+            }
+        `
+		meter := newTestMemoryGauge()
+		inter := parseCheckAndInterpretWithMemoryMetering(t, script, meter)
+
+		_, err := inter.Invoke("main")
+		require.NoError(t, err)
+
+		// creation: 1 + 2 * " + 4 (a,bc)
+		// + separator: 1 + 2 * " + 1 (,)
+		// + results: 1 + 1 (a) + 1 + 2 (bc)
+		assert.Equal(t, uint64(16), meter.getMemory(common.MemoryKindStringValue))
+	})
+
+	t.Run("replaceAll", func(t *testing.T) {
+
+		t.Parallel()
+
+		script := `
+            pub fun main() {
+                let x = "abab".replaceAll(of: "b", with: "cde")
+            }
+        `
+		meter := newTestMemoryGauge()
+		inter := parseCheckAndInterpretWithMemoryMetering(t, script, meter)
+
+		_, err := inter.Invoke("main")
+		require.NoError(t, err)
+
+		// creation: 1 + 2 * " + 4 (abab)
+		// + original: 1 + 2 * " + 1 (b)
+		// + replacement: 1 + 2 * " + 3 (cde)
+		// + result: 1 + 8 (acdeacde)
+		assert.Equal(t, uint64(26), meter.getMemory(common.MemoryKindStringValue))
+	})
+
+	t.Run("join", func(t *testing.T) {
+
+		t.Parallel()
+
+		script := `
+            pub fun main() {
+                let x = String.join(["a", "bc"], separator: ", ")
+            }
+        `
+		meter := newTestMemoryGauge()
+		inter := parseCheckAndInterpretWithMemoryMetering(t, script, meter)
+
+		_, err := inter.Invoke("main")
+		require.NoError(t, err)
+
+		// creation: 1 + 2 * " + 1 (a)
+		// + 1 + 2 * " + 2 (bc)
+		// + separator: 1 + 2 * " + 2 (, )
+		// + result: 1 + 5 (a, bc)
+		assert.Equal(t, uint64(20), meter.getMemory(common.MemoryKindStringValue))
+	})
 }
 
 func TestInterpretCharacterMetering(t *testing.T) {
@@ -422,3 +422,160 @@ func TestInterpretFunctionInvocationHandler(t *testing.T) {
 		occurrences,
 	)
 }
+
+func TestInterpretArrayIterationComputationMetering(t *testing.T) {
+
+	t.Parallel()
+
+	var iterations uint
+
+	inter, err := parseCheckAndInterpretWithOptions(t,
+		`
+          fun test() {
+              let xs = [1, 2, 3]
+              xs.forEach(fun (x: Int) {})
+              let ys = xs.map(fun (x: Int): Int {
+                  return x
+              })
+              let zs = xs.filter(fun (x: Int): Bool {
+                  return true
+              })
+          }
+        `,
+		ParseCheckAndInterpretOptions{
+			Options: []interpreter.Option{
+				interpreter.WithOnMeterComputationFuncHandler(
+					func(compKind common.ComputationKind, intensity uint) {
+						if compKind == common.ComputationKindIterateArrayValue {
+							iterations += intensity
+						}
+					},
+				),
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	_, err = inter.Invoke("test")
+	require.NoError(t, err)
+
+	assert.Equal(t, uint(9), iterations)
+}
+
+func TestInterpretArraySortComputationMetering(t *testing.T) {
+
+	t.Parallel()
+
+	var comparisons uint
+	var iterations uint
+
+	inter, err := parseCheckAndInterpretWithOptions(t,
+		`
+          fun test() {
+              let xs = [3, 1, 2]
+              xs.sort(by: fun (a: Int, b: Int): Bool {
+                  return a < b
+              })
+          }
+        `,
+		ParseCheckAndInterpretOptions{
+			Options: []interpreter.Option{
+				interpreter.WithOnMeterComputationFuncHandler(
+					func(compKind common.ComputationKind, intensity uint) {
+						switch compKind {
+						case common.ComputationKindSortArrayValue:
+							comparisons += intensity
+						case common.ComputationKindIterateArrayValue:
+							iterations += intensity
+						}
+					},
+				),
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	_, err = inter.Invoke("test")
+	require.NoError(t, err)
+
+	assert.Equal(t, uint(3), comparisons)
+	assert.Equal(t, uint(0), iterations)
+}
+
+func TestInterpretDictionaryIterationComputationMetering(t *testing.T) {
+
+	t.Parallel()
+
+	var iterations uint
+
+	inter, err := parseCheckAndInterpretWithOptions(t,
+		`
+          fun test() {
+              let dict = {"a": 1, "b": 2, "c": 3}
+              dict.forEachKey(fun (key: String): Bool {
+                  return true
+              })
+              dict.forEach(fun (key: String, value: Int): Bool {
+                  return false
+              })
+          }
+        `,
+		ParseCheckAndInterpretOptions{
+			Options: []interpreter.Option{
+				interpreter.WithOnMeterComputationFuncHandler(
+					func(compKind common.ComputationKind, intensity uint) {
+						if compKind == common.ComputationKindIterateDictionaryValue {
+							iterations += intensity
+						}
+					},
+				),
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	_, err = inter.Invoke("test")
+	require.NoError(t, err)
+
+	assert.Equal(t, uint(4), iterations)
+}
+
+func TestInterpretSetComputationMetering(t *testing.T) {
+
+	t.Parallel()
+
+	computations := map[common.ComputationKind]uint{}
+
+	inter, err := parseCheckAndInterpretWithOptions(t,
+		`
+          fun test() {
+              let a: {Int} = [1, 2, 3]
+              let b: {Int} = [2, 3, 4, 5]
+              let union = a.union(b)
+              let intersection = a.intersection(b)
+          }
+        `,
+		ParseCheckAndInterpretOptions{
+			Options: []interpreter.Option{
+				interpreter.WithOnMeterComputationFuncHandler(
+					func(compKind common.ComputationKind, intensity uint) {
+						computations[compKind] += intensity
+					},
+				),
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	_, err = inter.Invoke("test")
+	require.NoError(t, err)
+
+	// two literals, one for the intersection
+	assert.Equal(t, uint(3), computations[common.ComputationKindCreateSetValue])
+	assert.NotZero(t, computations[common.ComputationKindTransferSetValue])
+	// the union iterates the second set, the intersection the first set
+	assert.Equal(t, uint(7), computations[common.ComputationKindIterateSetValue])
+
+	assert.Zero(t, computations[common.ComputationKindCreateDictionaryValue])
+	assert.Zero(t, computations[common.ComputationKindTransferDictionaryValue])
+}
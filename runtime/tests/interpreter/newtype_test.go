@@ -0,0 +1,139 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+	. "github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func TestInterpretNewtype(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      newtype PersonID: String
+
+      let id = PersonID("alice")
+      let value = id.value
+    `)
+
+	idValue := inter.Globals["id"].GetValue()
+	require.IsType(t, &interpreter.CompositeValue{}, idValue)
+
+	composite := idValue.(*interpreter.CompositeValue)
+	assert.Equal(t, common.CompositeKindNewtype, composite.Kind)
+	assert.Equal(t,
+		common.TypeID("S.test.PersonID"),
+		composite.TypeID(),
+	)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredStringValue("alice"),
+		inter.Globals["value"].GetValue(),
+	)
+}
+
+func TestInterpretNewtypeEquality(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      newtype PersonID: String
+
+      fun test(): [Bool] {
+          return [
+              PersonID("alice") == PersonID("alice"),
+              PersonID("alice") == PersonID("bob"),
+              PersonID("alice") != PersonID("bob")
+          ]
+      }
+    `)
+
+	result, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewArrayValue(
+			inter,
+			interpreter.ReturnEmptyLocationRange,
+			interpreter.VariableSizedStaticType{
+				Type: interpreter.PrimitiveStaticTypeBool,
+			},
+			common.Address{},
+			interpreter.BoolValue(true),
+			interpreter.BoolValue(false),
+			interpreter.BoolValue(true),
+		),
+		result,
+	)
+}
+
+func TestInterpretNewtypeRuntimeType(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      newtype PersonID: String
+
+      fun test(): [Bool] {
+          let id = PersonID("alice")
+          let any: AnyStruct = id
+          return [
+              id.getType() == Type<PersonID>(),
+              id.getType() != Type<String>(),
+              any.isInstance(Type<PersonID>()),
+              !any.isInstance(Type<String>()),
+              (any as? String) == nil
+          ]
+      }
+    `)
+
+	result, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewArrayValue(
+			inter,
+			interpreter.ReturnEmptyLocationRange,
+			interpreter.VariableSizedStaticType{
+				Type: interpreter.PrimitiveStaticTypeBool,
+			},
+			common.Address{},
+			interpreter.BoolValue(true),
+			interpreter.BoolValue(true),
+			interpreter.BoolValue(true),
+			interpreter.BoolValue(true),
+			interpreter.BoolValue(true),
+		),
+		result,
+	)
+}
@@ -0,0 +1,306 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	. "github.com/onflow/cadence/runtime/tests/utils"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+func TestInterpretCompositeFunctionOverloading(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      struct Counter {
+          var count: Int
+
+          init() {
+              self.count = 0
+          }
+
+          fun increment() {
+              self.increment(by: 1)
+          }
+
+          fun increment(by amount: Int) {
+              self.count = self.count + amount
+          }
+
+          fun increment(_ amount: Int, times: Int) {
+              self.increment(by: amount * times)
+          }
+      }
+
+      fun test(): Int {
+          let counter = Counter()
+          counter.increment()
+          counter.increment(by: 10)
+          counter.increment(100, times: 2)
+          return counter.count
+      }
+    `)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredIntValueFromInt64(211),
+		value,
+	)
+}
+
+func TestInterpretCompositeFunctionOverloadingReference(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      resource R {
+          fun describe(): String {
+              return "none"
+          }
+
+          fun describe(prefix: String): String {
+              return prefix.concat("R")
+          }
+      }
+
+      fun test(): [String] {
+          let r <- create R()
+          let ref = &r as &R
+          let descriptions = [ref.describe(), ref.describe(prefix: "#")]
+          destroy r
+          return descriptions
+      }
+    `)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewArrayValue(
+			inter,
+			interpreter.ReturnEmptyLocationRange,
+			interpreter.VariableSizedStaticType{
+				Type: interpreter.PrimitiveStaticTypeString,
+			},
+			common.Address{},
+			interpreter.NewUnmeteredStringValue("none"),
+			interpreter.NewUnmeteredStringValue("#R"),
+		),
+		value,
+	)
+}
+
+func TestInterpretCompositeFunctionOverloadingInterface(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      struct interface Describable {
+          fun describe(name: String): String {
+              pre { name != "" }
+          }
+      }
+
+      struct S: Describable {
+          fun describe(): String {
+              return "S"
+          }
+
+          fun describe(name: String): String {
+              return name
+          }
+      }
+
+      fun test(_ name: String): String {
+          let describable: {Describable} = S()
+          return describable.describe(name: name)
+      }
+
+      fun testWithoutName(): String {
+          return S().describe()
+      }
+    `)
+
+	value, err := inter.Invoke("test", interpreter.NewUnmeteredStringValue("x"))
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredStringValue("x"),
+		value,
+	)
+
+	value, err = inter.Invoke("testWithoutName")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredStringValue("S"),
+		value,
+	)
+
+	_, err = inter.Invoke("test", interpreter.NewUnmeteredStringValue(""))
+	require.ErrorAs(t, err, &interpreter.ConditionError{})
+}
+
+func TestInterpretCompositeInitializerOverloading(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("structure", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct Token {
+              let name: String
+              let symbol: String
+
+              init(name: String) {
+                  self.name = name
+                  self.symbol = "?"
+              }
+
+              init(name: String, symbol: String) {
+                  self.name = name
+                  self.symbol = symbol
+              }
+          }
+
+          let a = Token(name: "Flow")
+          let b = Token(name: "Flow", symbol: "FLOW")
+          let c = [a.symbol, b.symbol]
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.ReturnEmptyLocationRange,
+				interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeString,
+				},
+				common.Address{},
+				interpreter.NewUnmeteredStringValue("?"),
+				interpreter.NewUnmeteredStringValue("FLOW"),
+			),
+			inter.Globals["c"].GetValue(),
+		)
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          resource R {
+              let id: Int
+
+              init() {
+                  self.id = 1
+              }
+
+              init(id: Int) {
+                  self.id = id
+              }
+          }
+
+          fun test(): Int {
+              let r1 <- create R()
+              let r2 <- create R(id: 41)
+              let sum = r1.id + r2.id
+              destroy r1
+              destroy r2
+              return sum
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(42),
+			value,
+		)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter, err := parseCheckAndInterpretWithOptions(t,
+			`
+              contract C {
+                  struct S {
+                      let value: Int
+
+                      init() {
+                          self.value = 1
+                      }
+
+                      init(value: Int) {
+                          self.value = value
+                      }
+                  }
+
+                  fun make(): S {
+                      return S(value: 2)
+                  }
+              }
+
+              fun test(): Int {
+                  return C.S().value + C.S(value: 3).value + C.make().value
+              }
+            `,
+			ParseCheckAndInterpretOptions{
+				Options: []interpreter.Option{
+					makeContractValueHandler(nil, nil, nil),
+				},
+			},
+		)
+		require.NoError(t, err)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredIntValueFromInt64(6),
+			value,
+		)
+	})
+}
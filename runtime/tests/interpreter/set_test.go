@@ -0,0 +1,245 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/onflow/atree"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+	. "github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func TestInterpretSetLiteral(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let s: {Int} = [1, 2, 2, 3, 1]
+      let length = s.length
+    `)
+
+	require.IsType(t, &interpreter.SetValue{}, inter.Globals["s"].GetValue())
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredIntValueFromInt64(3),
+		inter.Globals["length"].GetValue(),
+	)
+}
+
+func TestInterpretSetInsertRemoveContains(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun test(): [AnyStruct] {
+          let s: {String} = ["a"]
+          return [
+              s.insert("b"),
+              s.insert("b"),
+              s.contains("a"),
+              s.remove("a"),
+              s.remove("a"),
+              s.contains("a"),
+              s.length
+          ]
+      }
+    `)
+
+	result, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewArrayValue(
+			inter,
+			interpreter.ReturnEmptyLocationRange,
+			interpreter.VariableSizedStaticType{
+				Type: interpreter.PrimitiveStaticTypeAnyStruct,
+			},
+			common.Address{},
+			interpreter.BoolValue(true),
+			interpreter.BoolValue(false),
+			interpreter.BoolValue(true),
+			interpreter.BoolValue(true),
+			interpreter.BoolValue(false),
+			interpreter.BoolValue(false),
+			interpreter.NewUnmeteredIntValueFromInt64(1),
+		),
+		result,
+	)
+}
+
+func TestInterpretSetInsertDuplicateIntoStorage(t *testing.T) {
+
+	t.Parallel()
+
+	address := interpreter.NewUnmeteredAddressValueFromBytes([]byte{42})
+
+	inter, _ := testAccount(
+		t,
+		address,
+		true,
+		`
+          pub enum E: UInt8 {
+              pub case a
+              pub case b
+          }
+
+          fun setup() {
+              let s: {E} = [E.a]
+              account.save(s, to: /storage/s)
+          }
+
+          fun insert(): Bool {
+              let s = account.borrow<&{E}>(from: /storage/s)!
+              return s.insert(E.a)
+          }
+        `,
+	)
+
+	_, err := inter.Invoke("setup")
+	require.NoError(t, err)
+
+	countPermanentSlabs := func() int {
+		count := 0
+		for _, slab := range inter.Storage.(interpreter.InMemoryStorage).Slabs {
+			if slab.ID().Address == (atree.Address{}) {
+				continue
+			}
+			count++
+		}
+		return count
+	}
+
+	permanentSlabCount := countPermanentSlabs()
+
+	result, err := inter.Invoke("insert")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.BoolValue(false),
+		result,
+	)
+
+	// The duplicate element must not leave an orphaned slab behind
+	require.Equal(t, permanentSlabCount, countPermanentSlabs())
+}
+
+func TestInterpretSetUnionIntersection(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let a: {Int} = [1, 2, 3]
+      let b: {Int} = [2, 3, 4]
+
+      let union = a.union(b)
+      let intersection = a.intersection(b)
+
+      let unionLength = union.length
+      let intersectionLength = intersection.length
+      let unionContainsAll = union.contains(1) && union.contains(4)
+      let intersectionContains = intersection.contains(2) && intersection.contains(3)
+      let intersectionExcludes = !intersection.contains(1) && !intersection.contains(4)
+      let unchanged = a.length == 3 && b.length == 3
+    `)
+
+	for name, expected := range map[string]interpreter.Value{
+		"unionLength":          interpreter.NewUnmeteredIntValueFromInt64(4),
+		"intersectionLength":   interpreter.NewUnmeteredIntValueFromInt64(2),
+		"unionContainsAll":     interpreter.BoolValue(true),
+		"intersectionContains": interpreter.BoolValue(true),
+		"intersectionExcludes": interpreter.BoolValue(true),
+		"unchanged":            interpreter.BoolValue(true),
+	} {
+		AssertValuesEqual(
+			t,
+			inter,
+			expected,
+			inter.Globals[name].GetValue(),
+		)
+	}
+}
+
+func TestInterpretSetEquality(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let a: {Int} = [1, 2, 3]
+      let b: {Int} = [3, 2, 1, 2]
+      let c: {Int} = [1, 2, 4]
+      let d: {Int} = [1, 2]
+      let e: {Int}? = [2, 1, 3]
+
+      let equal = a == b
+      let differentElements = a == c
+      let differentLength = a == d
+      let notEqual = a != c
+      let optional = e == a
+    `)
+
+	for name, expected := range map[string]interpreter.Value{
+		"equal":             interpreter.BoolValue(true),
+		"differentElements": interpreter.BoolValue(false),
+		"differentLength":   interpreter.BoolValue(false),
+		"notEqual":          interpreter.BoolValue(true),
+		"optional":          interpreter.BoolValue(true),
+	} {
+		AssertValuesEqual(
+			t,
+			inter,
+			expected,
+			inter.Globals[name].GetValue(),
+		)
+	}
+}
+
+func TestInterpretSetCopySemantics(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun test(): Bool {
+          let a: {Int} = [1]
+          let b = a
+          b.insert(2)
+          return a.contains(2)
+      }
+    `)
+
+	result, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.BoolValue(false),
+		result,
+	)
+}
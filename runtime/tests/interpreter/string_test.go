@@ -19,6 +19,7 @@
 package interpreter_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -343,3 +344,299 @@ func TestInterpretCompareCharacters(t *testing.T) {
 		inter.Globals["z"].GetValue(),
 	)
 }
+
+func TestInterpretStringToUpper(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun test(): String {
+          return "Flowers".toUpper()
+      }
+    `)
+
+	result, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	require.Equal(t,
+		interpreter.NewUnmeteredStringValue("FLOWERS"),
+		result,
+	)
+}
+
+func TestInterpretStringSplit(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(t *testing.T, str string, separator string, expected ...string) {
+
+		inter := parseCheckAndInterpret(t, fmt.Sprintf(
+			`
+              fun test(): [String] {
+                  return "%s".split(separator: "%s")
+              }
+            `,
+			str,
+			separator,
+		))
+
+		result, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		expectedValues := make([]interpreter.Value, len(expected))
+		for i, part := range expected {
+			expectedValues[i] = interpreter.NewUnmeteredStringValue(part)
+		}
+
+		AssertValueSlicesEqual(
+			t,
+			inter,
+			expectedValues,
+			arrayElements(inter, result.(*interpreter.ArrayValue)),
+		)
+	}
+
+	t.Run("separator", func(t *testing.T) {
+		t.Parallel()
+
+		test(t, "a, b, c", ", ", "a", "b", "c")
+	})
+
+	t.Run("leading and trailing separators", func(t *testing.T) {
+		t.Parallel()
+
+		test(t, "-a--b-", "-", "", "a", "", "b", "")
+	})
+
+	t.Run("no occurrence", func(t *testing.T) {
+		t.Parallel()
+
+		test(t, "abc", ",", "abc")
+	})
+
+	t.Run("empty separator", func(t *testing.T) {
+		t.Parallel()
+
+		test(t, "ae\\u{301}b", "", "a", "e\u0301", "b")
+	})
+
+	t.Run("separator inside of character", func(t *testing.T) {
+		t.Parallel()
+
+		// The separator "e" is only a part of the character "e\u0301",
+		// the second "e" is a separate character
+		test(t, "ae\\u{301}be", "e", "ae\u0301b", "")
+	})
+}
+
+func TestInterpretStringContains(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let a = "abc".contains("bc")
+      let b = "abc".contains("d")
+      let c = "abc".contains("")
+      let d = "e\u{301}".contains("e")
+      let e = "e\u{301}".contains("\u{E9}")
+    `)
+
+	for name, expected := range map[string]bool{
+		"a": true,
+		"b": false,
+		"c": true,
+		"d": false,
+		// NOTE: matching is performed on the encoded string,
+		// not the canonical equivalent
+		"e": false,
+	} {
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.BoolValue(expected),
+			inter.Globals[name].GetValue(),
+		)
+	}
+}
+
+func TestInterpretStringIndexOf(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let a = "abcbc".index(of: "bc")
+      let b = "abc".index(of: "d")
+      let c = "abc".index(of: "")
+      let d = "e\u{301}e".index(of: "e")
+      let e = "\u{1F1E9}\u{1F1EA}x".index(of: "x")
+    `)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredSomeValueNonCopying(interpreter.NewUnmeteredIntValueFromInt64(1)),
+		inter.Globals["a"].GetValue(),
+	)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NilValue{},
+		inter.Globals["b"].GetValue(),
+	)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredSomeValueNonCopying(interpreter.NewUnmeteredIntValueFromInt64(0)),
+		inter.Globals["c"].GetValue(),
+	)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredSomeValueNonCopying(interpreter.NewUnmeteredIntValueFromInt64(1)),
+		inter.Globals["d"].GetValue(),
+	)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredSomeValueNonCopying(interpreter.NewUnmeteredIntValueFromInt64(1)),
+		inter.Globals["e"].GetValue(),
+	)
+}
+
+func TestInterpretStringReplaceAll(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let a = "abcbc".replaceAll(of: "bc", with: "x")
+      let b = "abc".replaceAll(of: "d", with: "x")
+      let c = "ab".replaceAll(of: "", with: "-")
+      let d = "e\u{301}e".replaceAll(of: "e", with: "x")
+    `)
+
+	for name, expected := range map[string]string{
+		"a": "axx",
+		"b": "abc",
+		"c": "-a-b-",
+		"d": "e\u0301x",
+	} {
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredStringValue(expected),
+			inter.Globals[name].GetValue(),
+		)
+	}
+}
+
+func TestInterpretStringTrim(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let a = "  a b \t\n".trim()
+      let b = "   ".trim()
+      let c = "abc".trim()
+      let d = " \u{301}a ".trim()
+    `)
+
+	for name, expected := range map[string]string{
+		"a": "a b",
+		"b": "",
+		"c": "abc",
+		// The space and the combining mark form a single character,
+		// which is not only whitespace
+		"d": " \u0301a",
+	} {
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredStringValue(expected),
+			inter.Globals[name].GetValue(),
+		)
+	}
+}
+
+func TestInterpretStringJoin(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let a = String.join(["a", "b", "c"], separator: ", ")
+      let b = String.join([], separator: ", ")
+      let c = String.join(["a"], separator: ", ")
+    `)
+
+	for name, expected := range map[string]string{
+		"a": "a, b, c",
+		"b": "",
+		"c": "a",
+	} {
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredStringValue(expected),
+			inter.Globals[name].GetValue(),
+		)
+	}
+}
+
+func TestInterpretStringFromUTF8(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let a = String.fromUTF8([0x46, 0xF0, 0x9F, 0x92, 0x90])
+      let b = String.fromUTF8([0xF0, 0x9F])
+      let c = String.fromUTF8([])
+    `)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredSomeValueNonCopying(interpreter.NewUnmeteredStringValue("F\U0001F490")),
+		inter.Globals["a"].GetValue(),
+	)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NilValue{},
+		inter.Globals["b"].GetValue(),
+	)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredSomeValueNonCopying(interpreter.NewUnmeteredStringValue("")),
+		inter.Globals["c"].GetValue(),
+	)
+}
+
+func TestInterpretStringFromCharacters(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let a = String.fromCharacters(["a", "e\u{301}", "\u{1F490}"])
+      let b = String.fromCharacters([])
+    `)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredStringValue("ae\u0301\U0001F490"),
+		inter.Globals["a"].GetValue(),
+	)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredStringValue(""),
+		inter.Globals["b"].GetValue(),
+	)
+}
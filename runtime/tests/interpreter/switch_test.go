@@ -26,6 +26,7 @@ import (
 
 	. "github.com/onflow/cadence/runtime/tests/utils"
 
+	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/interpreter"
 	"github.com/onflow/cadence/runtime/sema"
 	"github.com/onflow/cadence/runtime/tests/checker"
@@ -272,3 +273,324 @@ func TestInterpretSwitchStatement(t *testing.T) {
 		}
 	})
 }
+
+func TestInterpretSwitchStatementPatterns(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("optional pattern, guard", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(_ x: Int?): String {
+              switch x {
+              case let y? where y > 5:
+                  return "large"
+              case let y?:
+                  return "small"
+              default:
+                  return "nil"
+              }
+          }
+        `)
+
+		for _, testCase := range []struct {
+			argument interpreter.Value
+			expected string
+		}{
+			{
+				argument: interpreter.NewUnmeteredSomeValueNonCopying(
+					interpreter.NewUnmeteredIntValueFromInt64(10),
+				),
+				expected: "large",
+			},
+			{
+				argument: interpreter.NewUnmeteredSomeValueNonCopying(
+					interpreter.NewUnmeteredIntValueFromInt64(1),
+				),
+				expected: "small",
+			},
+			{
+				argument: interpreter.NilValue{},
+				expected: "nil",
+			},
+		} {
+			actual, err := inter.Invoke("test", testCase.argument)
+			require.NoError(t, err)
+
+			AssertValuesEqual(
+				t,
+				inter,
+				interpreter.NewUnmeteredStringValue(testCase.expected),
+				actual,
+			)
+		}
+	})
+
+	t.Run("identifier, guard", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(_ x: Int): String {
+              switch x {
+              case y where y > 5:
+                  return "large: ".concat(y.toString())
+              default:
+                  return "small"
+              }
+          }
+        `)
+
+		actual, err := inter.Invoke("test", interpreter.NewUnmeteredIntValueFromInt64(10))
+		require.NoError(t, err)
+
+		AssertValuesEqual(t, inter, interpreter.NewUnmeteredStringValue("large: 10"), actual)
+
+		actual, err = inter.Invoke("test", interpreter.NewUnmeteredIntValueFromInt64(1))
+		require.NoError(t, err)
+
+		AssertValuesEqual(t, inter, interpreter.NewUnmeteredStringValue("small"), actual)
+	})
+
+	t.Run("type pattern", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct Deposit {
+              let amount: Int
+
+              init(amount: Int) {
+                  self.amount = amount
+              }
+          }
+
+          struct Withdraw {
+              let amount: Int
+
+              init(amount: Int) {
+                  self.amount = amount
+              }
+          }
+
+          fun describe(_ x: AnyStruct): String {
+              switch x {
+              case let deposit as Deposit:
+                  return "deposit ".concat(deposit.amount.toString())
+              case let withdraw as Withdraw:
+                  return "withdraw ".concat(withdraw.amount.toString())
+              case let optional as Int?:
+                  return "optional"
+              default:
+                  return "other"
+              }
+          }
+
+          fun test(): [String] {
+              return [
+                  describe(Deposit(amount: 1)),
+                  describe(Withdraw(amount: 2)),
+                  describe(3),
+                  describe("4")
+              ]
+          }
+        `)
+
+		actual, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.ReturnEmptyLocationRange,
+				interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeString,
+				},
+				common.Address{},
+				interpreter.NewUnmeteredStringValue("deposit 1"),
+				interpreter.NewUnmeteredStringValue("withdraw 2"),
+				interpreter.NewUnmeteredStringValue("optional"),
+				interpreter.NewUnmeteredStringValue("other"),
+			),
+			actual,
+		)
+	})
+
+	t.Run("array pattern", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun sum(_ xs: [Int]): Int {
+              switch xs {
+              case let [a, b]:
+                  return a + b
+              case let [a]:
+                  return a
+              default:
+                  return 0
+              }
+          }
+
+          fun test(): [Int] {
+              return [sum([1, 2]), sum([3]), sum([4, 5, 6])]
+          }
+        `)
+
+		actual, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.ReturnEmptyLocationRange,
+				interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+				},
+				common.Address{},
+				interpreter.NewUnmeteredIntValueFromInt64(3),
+				interpreter.NewUnmeteredIntValueFromInt64(3),
+				interpreter.NewUnmeteredIntValueFromInt64(0),
+			),
+			actual,
+		)
+	})
+
+	t.Run("enum, guard", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          enum E: UInt8 {
+              case a
+              case b
+          }
+
+          fun test(_ flag: Bool): String {
+              switch E.a {
+              case E.a where flag:
+                  return "a, flag"
+              case E.a:
+                  return "a"
+              case E.b:
+                  return "b"
+              }
+          }
+        `)
+
+		actual, err := inter.Invoke("test", interpreter.BoolValue(true))
+		require.NoError(t, err)
+
+		AssertValuesEqual(t, inter, interpreter.NewUnmeteredStringValue("a, flag"), actual)
+
+		actual, err = inter.Invoke("test", interpreter.BoolValue(false))
+		require.NoError(t, err)
+
+		AssertValuesEqual(t, inter, interpreter.NewUnmeteredStringValue("a"), actual)
+	})
+
+	t.Run("copy", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): [Int] {
+              let xs = [1]
+              switch xs {
+              case let ys:
+                  ys.append(2)
+              }
+              return xs
+          }
+        `)
+
+		actual, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.ReturnEmptyLocationRange,
+				interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+				},
+				common.Address{},
+				interpreter.NewUnmeteredIntValueFromInt64(1),
+			),
+			actual,
+		)
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          resource interface NFT {
+              pub let id: UInt64
+          }
+
+          resource Kitty: NFT {
+              pub let id: UInt64
+
+              init(id: UInt64) {
+                  self.id = id
+              }
+          }
+
+          resource Dog: NFT {
+              pub let id: UInt64
+
+              init(id: UInt64) {
+                  self.id = id
+              }
+          }
+
+          fun describe(_ nft: @AnyResource{NFT}): String {
+              switch <-nft {
+              case let kitty as @Kitty:
+                  let id = kitty.id
+                  destroy kitty
+                  return "kitty ".concat(id.toString())
+              case let other:
+                  destroy other
+                  return "other"
+              }
+          }
+
+          fun test(): [String] {
+              return [
+                  describe(<-create Kitty(id: 1)),
+                  describe(<-create Dog(id: 2))
+              ]
+          }
+        `)
+
+		actual, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.ReturnEmptyLocationRange,
+				interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeString,
+				},
+				common.Address{},
+				interpreter.NewUnmeteredStringValue("kitty 1"),
+				interpreter.NewUnmeteredStringValue("other"),
+			),
+			actual,
+		)
+	})
+}
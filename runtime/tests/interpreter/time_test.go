@@ -0,0 +1,327 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/onflow/cadence/runtime/tests/utils"
+
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func TestInterpretTimestampConstructor(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("literal", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let a = Timestamp("2020-03-13T19:52:43Z")
+          let b = Timestamp("1970-01-01T00:00:00")
+        `)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredTimestampValue(1584129163),
+			inter.Globals["a"].GetValue(),
+		)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewUnmeteredTimestampValue(0),
+			inter.Globals["b"].GetValue(),
+		)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Timestamp {
+              let s = "2020-03-13"
+              return Timestamp(s)
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.Error(t, err)
+
+		var timestampErr interpreter.InvalidTimestampError
+		require.ErrorAs(t, err, &timestampErr)
+
+		assert.Equal(t, 4, timestampErr.StartPos.Line)
+		assert.Equal(t, 4, timestampErr.EndPos.Line)
+	})
+}
+
+func TestInterpretTimestampStringRoundTrip(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun roundTrip(_ t: Timestamp): Bool {
+          return Timestamp(t.toString()) == t
+      }
+
+      fun afterMax(): Timestamp {
+          return Timestamp("9999-12-31T23:59:59Z") + Duration.second
+      }
+
+      fun beforeMin(): Timestamp {
+          return Timestamp("0000-01-01T00:00:00Z") - Duration.second
+      }
+    `)
+
+	for _, seconds := range []int64{
+		sema.TimestampTypeMinSeconds,
+		-1,
+		0,
+		1584129163,
+		sema.TimestampTypeMaxSeconds,
+	} {
+		result, err := inter.Invoke(
+			"roundTrip",
+			interpreter.NewUnmeteredTimestampValue(seconds),
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, interpreter.BoolValue(true), result)
+	}
+
+	_, err := inter.Invoke("afterMax")
+	require.Error(t, err)
+
+	require.ErrorAs(t, err, &interpreter.OverflowError{})
+
+	_, err = inter.Invoke("beforeMin")
+	require.Error(t, err)
+
+	require.ErrorAs(t, err, &interpreter.UnderflowError{})
+}
+
+func TestInterpretTimeMembers(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let t = Timestamp("2020-03-13T19:52:43Z")
+      let a = t.seconds
+      let b = t.toString()
+      let c = Duration.day.seconds
+      let d = (Duration.hour * 2).toString()
+    `)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredInt64Value(1584129163),
+		inter.Globals["a"].GetValue(),
+	)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredStringValue("2020-03-13T19:52:43Z"),
+		inter.Globals["b"].GetValue(),
+	)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredInt64Value(86400),
+		inter.Globals["c"].GetValue(),
+	)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredStringValue("7200"),
+		inter.Globals["d"].GetValue(),
+	)
+}
+
+func TestInterpretTimeArithmetic(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let start = Timestamp("2020-03-13T00:00:00Z")
+      let end = start + Duration.day * 7 + Duration.hour
+      let length = end - start
+      let halfway = start + length / 2
+      let earlier = end - Duration.minute - Duration.second
+      let later = Duration.day + start
+      let isBefore = start < end
+      let isEqual = start + Duration.day == later
+    `)
+
+	start := int64(1584057600)
+
+	for name, expected := range map[string]interpreter.Value{
+		"end":      interpreter.NewUnmeteredTimestampValue(start + 7*86400 + 3600),
+		"length":   interpreter.NewUnmeteredDurationValue(7*86400 + 3600),
+		"halfway":  interpreter.NewUnmeteredTimestampValue(start + (7*86400+3600)/2),
+		"earlier":  interpreter.NewUnmeteredTimestampValue(start + 7*86400 + 3600 - 61),
+		"later":    interpreter.NewUnmeteredTimestampValue(start + 86400),
+		"isBefore": interpreter.BoolValue(true),
+		"isEqual":  interpreter.BoolValue(true),
+	} {
+		AssertValuesEqual(
+			t,
+			inter,
+			expected,
+			inter.Globals[name].GetValue(),
+		)
+	}
+}
+
+func TestInterpretTimeOverflow(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("multiplication", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Duration {
+              return Duration.day * 9223372036854775807
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.Error(t, err)
+
+		require.ErrorAs(t, err, &interpreter.OverflowError{})
+	})
+
+	t.Run("division by zero", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Duration {
+              return Duration.day / 0
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.Error(t, err)
+
+		require.ErrorAs(t, err, &interpreter.DivisionByZeroError{})
+	})
+
+	t.Run("subtraction", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(t: Timestamp): Timestamp {
+              return t - Duration.second
+          }
+        `)
+
+		_, err := inter.Invoke(
+			"test",
+			interpreter.NewUnmeteredTimestampValue(sema.TimestampTypeMinSeconds),
+		)
+		require.Error(t, err)
+
+		require.ErrorAs(t, err, &interpreter.UnderflowError{})
+	})
+}
+
+func TestInterpretTimeDictionaryKey(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let t = Timestamp("2020-03-13T19:52:43Z")
+      let unlocks: {Timestamp: UFix64} = {t: 1.0, t + Duration.day: 2.0}
+      let periods: {Duration: String} = {Duration.day: "daily", Duration.day * 7: "weekly"}
+
+      let a = unlocks[t + Duration.day]!
+      let b = periods[Duration.hour * 24]!
+    `)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredUFix64Value(200000000),
+		inter.Globals["a"].GetValue(),
+	)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredStringValue("daily"),
+		inter.Globals["b"].GetValue(),
+	)
+}
+
+func TestInterpretDurationFunction(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let day = 5
+
+      let a = Duration(90)
+      let b = Duration.minute + Duration(30)
+      let c = Duration.day
+    `)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredIntValueFromInt64(5),
+		inter.Globals["day"].GetValue(),
+	)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredDurationValue(90),
+		inter.Globals["a"].GetValue(),
+	)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredDurationValue(90),
+		inter.Globals["b"].GetValue(),
+	)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewUnmeteredDurationValue(24*60*60),
+		inter.Globals["c"].GetValue(),
+	)
+}
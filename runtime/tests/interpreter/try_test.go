@@ -0,0 +1,683 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/stdlib"
+	. "github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func parseCheckAndInterpretWithPanic(t *testing.T, code string) *interpreter.Interpreter {
+
+	standardLibraryFunctions :=
+		stdlib.StandardLibraryFunctions{
+			stdlib.PanicFunction,
+		}
+
+	inter, err := parseCheckAndInterpretWithOptions(t,
+		code,
+		ParseCheckAndInterpretOptions{
+			CheckerOptions: []sema.Option{
+				sema.WithPredeclaredValues(standardLibraryFunctions.ToSemaValueDeclarations()),
+			},
+			Options: []interpreter.Option{
+				interpreter.WithPredeclaredValues(standardLibraryFunctions.ToInterpreterValueDeclarations()),
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	return inter
+}
+
+func TestInterpretTryStatement(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithPanic(t, `
+          fun test(): Int {
+              var x = 0
+              try {
+                  x = 1
+              } catch {
+                  x = 2
+              }
+              return x
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(t, inter, interpreter.NewUnmeteredIntValueFromInt64(1), value)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithPanic(t, `
+          fun test(): [Int] {
+              var x = 0
+              var y = 0
+              try {
+                  x = 1
+                  panic("failed")
+              } catch {
+                  y = 2
+              }
+              return [x, y]
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(
+			t,
+			inter,
+			interpreter.NewArrayValue(
+				inter,
+				interpreter.ReturnEmptyLocationRange,
+				interpreter.VariableSizedStaticType{
+					Type: interpreter.PrimitiveStaticTypeInt,
+				},
+				common.Address{},
+				interpreter.NewUnmeteredIntValueFromInt64(0),
+				interpreter.NewUnmeteredIntValueFromInt64(2),
+			),
+			value,
+		)
+	})
+
+	t.Run("error identifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithPanic(t, `
+          fun test(): String {
+              try {
+                  panic("failed")
+              } catch (err) {
+                  return err
+              }
+              return ""
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		require.IsType(t, &interpreter.StringValue{}, value)
+		assert.Contains(t, value.(*interpreter.StringValue).Str, "failed")
+	})
+
+	t.Run("failure in invoked function", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithPanic(t, `
+          fun fail(_ n: Int) {
+              pre { n < 3: "too large" }
+              fail(n + 1)
+          }
+
+          fun test(): String {
+              try {
+                  fail(0)
+              } catch (err) {
+                  return err
+              }
+              return ""
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		require.IsType(t, &interpreter.StringValue{}, value)
+		assert.Contains(t, value.(*interpreter.StringValue).Str, "too large")
+
+		assert.Empty(t, inter.CallStack.Invocations)
+	})
+
+	t.Run("return", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithPanic(t, `
+          let xs: [Int] = []
+
+          fun test(): Int {
+              try {
+                  xs.append(1)
+                  return 1
+              } catch {
+                  return 2
+              }
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(t, inter, interpreter.NewUnmeteredIntValueFromInt64(1), value)
+		assert.Equal(t, 1, inter.Globals["xs"].GetValue().(*interpreter.ArrayValue).Count())
+	})
+
+	t.Run("break", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithPanic(t, `
+          fun test(): Int {
+              var i = 0
+              while true {
+                  try {
+                      i = i + 1
+                      if i == 3 {
+                          break
+                      }
+                  } catch {}
+              }
+              return i
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(t, inter, interpreter.NewUnmeteredIntValueFromInt64(3), value)
+	})
+
+	t.Run("failure in catch block", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithPanic(t, `
+          fun test() {
+              try {
+                  panic("first")
+              } catch {
+                  panic("second")
+              }
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.Error(t, err)
+
+		require.ErrorAs(t, err, &stdlib.PanicError{})
+		assert.Contains(t, err.Error(), "second")
+	})
+}
+
+func TestInterpretTryStatementRevertsMutations(t *testing.T) {
+
+	t.Parallel()
+
+	// test invokes the function "test" and compares the result
+	// to the result of the function "expected"
+
+	test := func(t *testing.T, code string) {
+		inter := parseCheckAndInterpretWithPanic(t, code)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		expected, err := inter.Invoke("expected")
+		require.NoError(t, err)
+
+		AssertValuesEqual(t, inter, expected, value)
+	}
+
+	t.Run("array", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(t, `
+          fun test(): [Int] {
+              let xs = [1, 2, 3]
+              try {
+                  xs[0] = 10
+                  xs.append(4)
+                  xs.insert(at: 1, 5)
+                  xs.remove(at: 2)
+                  panic("failed")
+              } catch {}
+              return xs
+          }
+
+          fun expected(): [Int] {
+              return [1, 2, 3]
+          }
+        `)
+	})
+
+	t.Run("large array", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(t, `
+          fun test(): Bool {
+              let xs: [Int] = []
+              var i = 0
+              while i < 1000 {
+                  xs.append(i)
+                  i = i + 1
+              }
+
+              try {
+                  i = 0
+                  while i < 1000 {
+                      xs[i] = 0
+                      xs.append(i)
+                      i = i + 1
+                  }
+                  xs.removeFirst()
+                  panic("failed")
+              } catch {}
+
+              if xs.length != 1000 {
+                  return false
+              }
+              i = 0
+              while i < 1000 {
+                  if xs[i] != i {
+                      return false
+                  }
+                  i = i + 1
+              }
+              return true
+          }
+
+          fun expected(): Bool {
+              return true
+          }
+        `)
+	})
+
+	t.Run("dictionary", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(t, `
+          fun test(): {String: Int} {
+              let xs = {"a": 1, "b": 2}
+              try {
+                  xs["a"] = 10
+                  xs["c"] = 3
+                  xs.remove(key: "b")
+                  panic("failed")
+              } catch {}
+              return xs
+          }
+
+          fun expected(): {String: Int} {
+              return {"a": 1, "b": 2}
+          }
+        `)
+	})
+
+	t.Run("composite", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(t, `
+          struct S {
+              var x: Int
+              let ys: [Int]
+
+              init() {
+                  self.x = 1
+                  self.ys = [1]
+              }
+          }
+
+          fun test(): [Int] {
+              let s = S()
+              try {
+                  s.x = 2
+                  s.ys.append(2)
+                  panic("failed")
+              } catch {}
+              return [s.x].concat(s.ys)
+          }
+
+          fun expected(): [Int] {
+              return [1, 1]
+          }
+        `)
+	})
+
+	t.Run("optional", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(t, `
+          fun test(): [Int] {
+              var x: [Int]? = [1]
+              try {
+                  x!.append(2)
+                  x = nil
+                  panic("failed")
+              } catch {}
+              return x!
+          }
+
+          fun expected(): [Int] {
+              return [1]
+          }
+        `)
+	})
+
+	t.Run("nested, inner failure", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(t, `
+          fun test(): [Int] {
+              let xs: [Int] = []
+              try {
+                  xs.append(1)
+                  try {
+                      xs.append(2)
+                      panic("failed")
+                  } catch {
+                      xs.append(3)
+                  }
+              } catch {}
+              return xs
+          }
+
+          fun expected(): [Int] {
+              return [1, 3]
+          }
+        `)
+	})
+
+	t.Run("nested, outer failure", func(t *testing.T) {
+
+		t.Parallel()
+
+		test(t, `
+          fun test(): [Int] {
+              let xs: [Int] = []
+              try {
+                  xs.append(1)
+                  try {
+                      xs.append(2)
+                  } catch {}
+                  panic("failed")
+              } catch {
+                  xs.append(3)
+              }
+              return xs
+          }
+
+          fun expected(): [Int] {
+              return [3]
+          }
+        `)
+	})
+}
+
+func TestInterpretTryStatementResources(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("mutation", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithPanic(t, `
+          resource R {
+              var value: Int
+
+              init() {
+                  self.value = 1
+              }
+
+              fun setValue(_ value: Int) {
+                  self.value = value
+              }
+          }
+
+          fun test(): Int {
+              let r <- create R()
+              try {
+                  r.setValue(2)
+                  panic("failed")
+              } catch {}
+              let value = r.value
+              destroy r
+              return value
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(t, inter, interpreter.NewUnmeteredIntValueFromInt64(1), value)
+	})
+
+	t.Run("move", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithPanic(t, `
+          resource R {}
+
+          fun test(): Int {
+              let rs: @[R] <- []
+              let r <- create R()
+              try {
+                  rs.append(<-r)
+                  panic("failed")
+              } catch {
+                  rs.append(<-r)
+                  rs.append(<-create R())
+              }
+              let count = rs.length
+              destroy rs
+              return count
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(t, inter, interpreter.NewUnmeteredIntValueFromInt64(2), value)
+	})
+
+	t.Run("destroy", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpretWithPanic(t, `
+          resource R {
+              let value: Int
+
+              init(_ value: Int) {
+                  self.value = value
+              }
+          }
+
+          fun test(): Int {
+              let rs: @[R] <- [<-create R(1), <-create R(2)]
+              try {
+                  destroy rs
+                  panic("failed")
+              } catch {
+                  let r <- rs.removeFirst()
+                  let value = r.value
+                  destroy r
+                  destroy rs
+                  return value
+              }
+              return 0
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		AssertValuesEqual(t, inter, interpreter.NewUnmeteredIntValueFromInt64(1), value)
+	})
+}
+
+func TestInterpretTryStatementEvents(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpretWithPanic(t, `
+      event E(value: Int)
+
+      fun test() {
+          emit E(value: 1)
+          try {
+              emit E(value: 2)
+              try {
+                  emit E(value: 3)
+              } catch {}
+              panic("failed")
+          } catch {
+              emit E(value: 4)
+          }
+          try {
+              emit E(value: 5)
+              try {
+                  emit E(value: 6)
+                  panic("failed")
+              } catch {}
+          } catch {}
+      }
+    `)
+
+	var values []interpreter.Value
+
+	inter.SetOnEventEmittedHandler(
+		func(
+			inter *interpreter.Interpreter,
+			getLocationRange func() interpreter.LocationRange,
+			event *interpreter.CompositeValue,
+			_ *sema.CompositeType,
+		) error {
+			values = append(values, event.GetField(inter, getLocationRange, "value"))
+			return nil
+		},
+	)
+
+	_, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		[]interpreter.Value{
+			interpreter.NewUnmeteredIntValueFromInt64(1),
+			interpreter.NewUnmeteredIntValueFromInt64(4),
+			interpreter.NewUnmeteredIntValueFromInt64(5),
+		},
+		values,
+	)
+}
+
+func TestInterpretTryStatementStorage(t *testing.T) {
+
+	t.Parallel()
+
+	address := interpreter.NewUnmeteredAddressValueFromBytes([]byte{42})
+
+	inter, getAccountValues := testAccount(
+		t,
+		address,
+		true,
+		`
+          resource R {
+              let values: [Int]
+
+              init() {
+                  self.values = [1]
+              }
+          }
+
+          fun fail() {
+              pre { false: "failed" }
+          }
+
+          fun test() {
+              account.save(<-create R(), to: /storage/r)
+              try {
+                  account.save(1, to: /storage/a)
+                  let r = account.borrow<&R>(from: /storage/r)!
+                  r.values.append(2)
+                  fail()
+              } catch {}
+              try {
+                  account.save(2, to: /storage/b)
+              } catch {}
+          }
+
+          fun values(): [Int] {
+              return account.borrow<&R>(from: /storage/r)!.values
+          }
+        `,
+	)
+
+	_, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	accountValues := getAccountValues()
+	require.Len(t, accountValues, 2)
+
+	for key := range accountValues { //nolint:maprangecheck
+		assert.NotEqual(t, "a", key.key)
+	}
+
+	value, err := inter.Invoke("values")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewArrayValue(
+			inter,
+			interpreter.ReturnEmptyLocationRange,
+			interpreter.VariableSizedStaticType{
+				Type: interpreter.PrimitiveStaticTypeInt,
+			},
+			common.Address{},
+			interpreter.NewUnmeteredIntValueFromInt64(1),
+		),
+		value,
+	)
+
+	require.NoError(t, inter.Storage.CheckHealth())
+}
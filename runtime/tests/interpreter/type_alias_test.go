@@ -0,0 +1,82 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+	. "github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func TestInterpretTypeAlias(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      typealias ID = UInt64
+
+      struct S {
+          typealias Count = Int
+
+          let id: ID
+          let count: Count
+
+          init(id: ID, count: Count) {
+              self.id = id
+              self.count = count
+          }
+      }
+
+      fun test(): [AnyStruct] {
+          let s = S(id: 42, count: 2)
+          let x: S.Count = s.count
+          return [
+              s.id,
+              x,
+              s.id.getType() == Type<ID>(),
+              Type<S.Count>() == Type<Int>()
+          ]
+      }
+    `)
+
+	result, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	AssertValuesEqual(
+		t,
+		inter,
+		interpreter.NewArrayValue(
+			inter,
+			interpreter.ReturnEmptyLocationRange,
+			interpreter.VariableSizedStaticType{
+				Type: interpreter.PrimitiveStaticTypeAnyStruct,
+			},
+			common.Address{},
+			interpreter.NewUnmeteredUInt64Value(42),
+			interpreter.NewUnmeteredIntValueFromInt64(2),
+			interpreter.BoolValue(true),
+			interpreter.BoolValue(true),
+		),
+		result,
+	)
+}
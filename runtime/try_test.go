@@ -0,0 +1,280 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2022 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/tests/utils"
+)
+
+func TestRuntimeTryStatement(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := newTestInterpreterRuntime()
+
+	address := common.MustBytesToAddress([]byte{0x42})
+
+	deploy := utils.DeploymentTransaction("Test", []byte(`
+      pub contract Test {
+
+          pub event Purchased(id: Int)
+
+          pub let sold: [Int]
+
+          init() {
+              self.sold = []
+          }
+
+          pub fun purchase(id: Int) {
+              pre {
+                  id != 2: "listing is not available"
+              }
+              self.sold.append(id)
+              emit Purchased(id: id)
+          }
+      }
+    `))
+
+	purchase := []byte(`
+      import Test from 0x42
+
+      transaction {
+          prepare(signer: AuthAccount) {
+              signer.save(0, to: /storage/failures)
+
+              for id in [1, 2, 3] {
+                  try {
+                      signer.save(id, to: /storage/last)
+                      log(id)
+                      Test.purchase(id: id)
+                      signer.load<Int>(from: /storage/last)
+                  } catch (err) {
+                      log(err)
+                      let failures = signer.load<Int>(from: /storage/failures)!
+                      signer.save(failures + 1, to: /storage/failures)
+                  }
+              }
+          }
+      }
+    `)
+
+	script := []byte(`
+      import Test from 0x42
+
+      pub fun main(): [Int] {
+          let account = getAuthAccount(0x42)
+          assert(account.load<Int>(from: /storage/last) == nil)
+          let failures = account.load<Int>(from: /storage/failures)!
+          return Test.sold.concat([failures])
+      }
+    `)
+
+	accountCodes := map[common.LocationID][]byte{}
+	var events []cadence.Event
+	var loggedMessages []string
+
+	runtimeInterface := &testRuntimeInterface{
+		getCode: func(location Location) (bytes []byte, err error) {
+			return accountCodes[location.ID()], nil
+		},
+		storage: newTestLedger(nil, nil),
+		getSigningAccounts: func() ([]Address, error) {
+			return []Address{address}, nil
+		},
+		resolveLocation: singleIdentifierLocationResolver(t),
+		getAccountContractCode: func(address Address, name string) (code []byte, err error) {
+			location := common.AddressLocation{
+				Address: address,
+				Name:    name,
+			}
+			return accountCodes[location.ID()], nil
+		},
+		updateAccountContractCode: func(address Address, name string, code []byte) error {
+			location := common.AddressLocation{
+				Address: address,
+				Name:    name,
+			}
+			accountCodes[location.ID()] = code
+			return nil
+		},
+		emitEvent: func(event cadence.Event) error {
+			events = append(events, event)
+			return nil
+		},
+		log: func(message string) {
+			loggedMessages = append(loggedMessages, message)
+		},
+	}
+
+	nextTransactionLocation := newTransactionLocationGenerator()
+
+	err := runtime.ExecuteTransaction(
+		Script{
+			Source: deploy,
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	events = nil
+
+	err = runtime.ExecuteTransaction(
+		Script{
+			Source: purchase,
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  nextTransactionLocation(),
+		},
+	)
+	require.NoError(t, err)
+
+	require.Len(t, loggedMessages, 3)
+	assert.Equal(t, "1", loggedMessages[0])
+	assert.Contains(t, loggedMessages[1], "listing is not available")
+	assert.Equal(t, "3", loggedMessages[2])
+
+	require.Len(t, events, 2)
+	assert.Equal(t, "A.0000000000000042.Test.Purchased(id: 1)", events[0].String())
+	assert.Equal(t, "A.0000000000000042.Test.Purchased(id: 3)", events[1].String())
+
+	result, err := runtime.ExecuteScript(
+		Script{
+			Source: script,
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  common.ScriptLocation{},
+		},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		cadence.NewArray([]cadence.Value{
+			cadence.NewInt(1),
+			cadence.NewInt(3),
+			cadence.NewInt(1),
+		}).WithType(cadence.VariableSizedArrayType{
+			ElementType: cadence.IntType{},
+		}),
+		result,
+	)
+}
+
+func TestRuntimeTryStatementNonRevertibleOperation(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := newTestInterpreterRuntime()
+
+	script := []byte(`
+      transaction {
+          prepare(signer: AuthAccount) {
+              try {
+                  AuthAccount(payer: signer)
+              } catch (err) {
+                  log(err)
+              }
+          }
+      }
+    `)
+
+	var loggedMessages []string
+	var createdAccount bool
+
+	runtimeInterface := &testRuntimeInterface{
+		storage: newTestLedger(nil, nil),
+		getSigningAccounts: func() ([]Address, error) {
+			return []Address{{42}}, nil
+		},
+		createAccount: func(payer Address) (address Address, err error) {
+			createdAccount = true
+			return Address{42}, nil
+		},
+		log: func(message string) {
+			loggedMessages = append(loggedMessages, message)
+		},
+	}
+
+	err := runtime.ExecuteTransaction(
+		Script{
+			Source: script,
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  common.TransactionLocation{},
+		},
+	)
+	require.NoError(t, err)
+
+	assert.False(t, createdAccount)
+
+	require.Len(t, loggedMessages, 1)
+	assert.Contains(t,
+		loggedMessages[0],
+		interpreter.NonRevertibleOperationError{Operation: "create account"}.Error(),
+	)
+}
+
+func TestRuntimeTryStatementCallStackLimit(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := newTestInterpreterRuntime()
+
+	script := []byte(`
+      pub fun recurse() {
+          recurse()
+      }
+
+      pub fun main() {
+          try {
+              recurse()
+          } catch {}
+      }
+    `)
+
+	runtimeInterface := &testRuntimeInterface{
+		storage: newTestLedger(nil, nil),
+	}
+
+	_, err := runtime.ExecuteScript(
+		Script{
+			Source: script,
+		},
+		Context{
+			Interface: runtimeInterface,
+			Location:  common.ScriptLocation{},
+		},
+	)
+	require.Error(t, err)
+
+	require.ErrorAs(t, err, &CallStackLimitExceededError{})
+}
@@ -27,9 +27,22 @@ var _ ast.TypeEqualityChecker = &TypeComparator{}
 
 type TypeComparator struct {
 	RootDeclIdentifier *Identifier
+
+	// expectedTypeAliases and foundTypeAliases are the type aliases in scope
+	// for the expected and the found types, keyed by their (qualified) names.
+	// Type aliases are transparent, so they are expanded before comparing types.
+	expectedTypeAliases map[string]ast.Type
+	foundTypeAliases    map[string]ast.Type
 }
 
 func (c *TypeComparator) CheckNominalTypeEquality(expected *ast.NominalType, found ast.Type) error {
+	expectedType := expandTypeAlias(expected, c.expectedTypeAliases)
+	if expectedType != ast.Type(expected) {
+		return expectedType.CheckEqual(found, c)
+	}
+
+	found = expandTypeAlias(found, c.foundTypeAliases)
+
 	foundNominalType, ok := found.(*ast.NominalType)
 	if !ok {
 		return getTypeMismatchError(expected, found)
@@ -45,6 +58,8 @@ func (c *TypeComparator) CheckNominalTypeEquality(expected *ast.NominalType, fou
 }
 
 func (c *TypeComparator) CheckOptionalTypeEquality(expected *ast.OptionalType, found ast.Type) error {
+	found = expandTypeAlias(found, c.foundTypeAliases)
+
 	foundOptionalType, ok := found.(*ast.OptionalType)
 	if !ok {
 		return getTypeMismatchError(expected, found)
@@ -54,6 +69,8 @@ func (c *TypeComparator) CheckOptionalTypeEquality(expected *ast.OptionalType, f
 }
 
 func (c *TypeComparator) CheckVariableSizedTypeEquality(expected *ast.VariableSizedType, found ast.Type) error {
+	found = expandTypeAlias(found, c.foundTypeAliases)
+
 	foundVarSizedType, ok := found.(*ast.VariableSizedType)
 	if !ok {
 		return getTypeMismatchError(expected, found)
@@ -63,6 +80,8 @@ func (c *TypeComparator) CheckVariableSizedTypeEquality(expected *ast.VariableSi
 }
 
 func (c *TypeComparator) CheckConstantSizedTypeEquality(expected *ast.ConstantSizedType, found ast.Type) error {
+	found = expandTypeAlias(found, c.foundTypeAliases)
+
 	foundConstSizedType, ok := found.(*ast.ConstantSizedType)
 	if !ok {
 		return getTypeMismatchError(expected, found)
@@ -79,6 +98,8 @@ func (c *TypeComparator) CheckConstantSizedTypeEquality(expected *ast.ConstantSi
 }
 
 func (c *TypeComparator) CheckDictionaryTypeEquality(expected *ast.DictionaryType, found ast.Type) error {
+	found = expandTypeAlias(found, c.foundTypeAliases)
+
 	foundDictionaryType, ok := found.(*ast.DictionaryType)
 	if !ok {
 		return getTypeMismatchError(expected, found)
@@ -92,7 +113,42 @@ func (c *TypeComparator) CheckDictionaryTypeEquality(expected *ast.DictionaryTyp
 	return expected.ValueType.CheckEqual(foundDictionaryType.ValueType, c)
 }
 
+func (c *TypeComparator) CheckSetTypeEquality(expected *ast.SetType, found ast.Type) error {
+	found = expandTypeAlias(found, c.foundTypeAliases)
+
+	// A set type with a nominal element type, e.g. `{I}`,
+	// may denote a restricted type
+
+	if foundRestrictedType, ok := found.(*ast.RestrictedType); ok {
+		expectedRestrictedType, ok := restrictedTypeOfSetType(expected)
+		if !ok {
+			return getTypeMismatchError(expected, found)
+		}
+
+		return expectedRestrictedType.CheckEqual(foundRestrictedType, c)
+	}
+
+	foundSetType, ok := found.(*ast.SetType)
+	if !ok {
+		return getTypeMismatchError(expected, found)
+	}
+
+	return expected.ElementType.CheckEqual(foundSetType.ElementType, c)
+}
+
 func (c *TypeComparator) CheckRestrictedTypeEquality(expected *ast.RestrictedType, found ast.Type) error {
+	found = expandTypeAlias(found, c.foundTypeAliases)
+
+	// A set type with a nominal element type, e.g. `{I}`,
+	// may denote a restricted type
+
+	if foundSetType, ok := found.(*ast.SetType); ok {
+		found, ok = restrictedTypeOfSetType(foundSetType)
+		if !ok {
+			return getTypeMismatchError(expected, foundSetType)
+		}
+	}
+
 	foundRestrictedType, ok := found.(*ast.RestrictedType)
 	if !ok {
 		return getTypeMismatchError(expected, found)
@@ -132,6 +188,8 @@ func (c *TypeComparator) CheckRestrictedTypeEquality(expected *ast.RestrictedTyp
 }
 
 func (c *TypeComparator) CheckInstantiationTypeEquality(expected *ast.InstantiationType, found ast.Type) error {
+	found = expandTypeAlias(found, c.foundTypeAliases)
+
 	foundInstType, ok := found.(*ast.InstantiationType)
 	if !ok {
 		return getTypeMismatchError(expected, found)
@@ -154,6 +212,8 @@ func (c *TypeComparator) CheckInstantiationTypeEquality(expected *ast.Instantiat
 }
 
 func (c *TypeComparator) CheckFunctionTypeEquality(expected *ast.FunctionType, found ast.Type) error {
+	found = expandTypeAlias(found, c.foundTypeAliases)
+
 	foundFuncType, ok := found.(*ast.FunctionType)
 	if !ok || len(expected.ParameterTypeAnnotations) != len(foundFuncType.ParameterTypeAnnotations) {
 		return getTypeMismatchError(expected, found)
@@ -171,6 +231,8 @@ func (c *TypeComparator) CheckFunctionTypeEquality(expected *ast.FunctionType, f
 }
 
 func (c *TypeComparator) CheckReferenceTypeEquality(expected *ast.ReferenceType, found ast.Type) error {
+	found = expandTypeAlias(found, c.foundTypeAliases)
+
 	refType, ok := found.(*ast.ReferenceType)
 	if !ok {
 		return getTypeMismatchError(expected, found)
@@ -240,6 +302,41 @@ func identifiersEqual(expected []ast.Identifier, found []ast.Identifier) bool {
 	return true
 }
 
+// expandTypeAlias returns the type the given type refers to,
+// if the given type is a nominal type referring to a type alias.
+func expandTypeAlias(ty ast.Type, typeAliases map[string]ast.Type) ast.Type {
+	// NOTE: limit the number of expansions, to guard against cyclic type aliases
+	for i := 0; i <= len(typeAliases); i++ {
+		nominalType, ok := ty.(*ast.NominalType)
+		if !ok {
+			return ty
+		}
+
+		aliasedType, ok := typeAliases[nominalType.String()]
+		if !ok {
+			return ty
+		}
+
+		ty = aliasedType
+	}
+
+	return ty
+}
+
+// restrictedTypeOfSetType returns the restricted type
+// which the given set type denotes, if its element type is nominal.
+func restrictedTypeOfSetType(setType *ast.SetType) (*ast.RestrictedType, bool) {
+	restriction, ok := setType.ElementType.(*ast.NominalType)
+	if !ok {
+		return nil, false
+	}
+
+	return &ast.RestrictedType{
+		Restrictions: []*ast.NominalType{restriction},
+		Range:        setType.Range,
+	}, true
+}
+
 func isAnyStructOrAnyResourceType(astType ast.Type) bool {
 	// If the restricted type is not stated, then it is either AnyStruct or AnyResource
 	if astType == nil {
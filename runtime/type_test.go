@@ -109,13 +109,11 @@ func TestRuntimeBlockFieldTypes(t *testing.T) {
                     let id = block.id
                     log(id.isInstance(Type<[UInt8; 32]>()))
 
-                    var ts: UFix64 = block.timestamp
-                    log(ts.isInstance(Type<UFix64>()))
-
-                    var div: UFix64 = 4.0
+                    var ts: Timestamp = block.timestamp
+                    log(ts.isInstance(Type<Timestamp>()))
 
                     // Shouldn't panic
-                    var res = ts/div
+                    var res = ts + Duration.day
                 }
             }
         `)
@@ -163,19 +161,17 @@ func TestRuntimeBlockFieldTypes(t *testing.T) {
 		runtime := newTestInterpreterRuntime()
 
 		script := []byte(`
-            pub fun main(): [UFix64] {
+            pub fun main(): [Timestamp] {
                 let block = getCurrentBlock()
 
                 let id = block.id
                 log(id.isInstance(Type<[UInt8; 32]>()))
 
-                var ts: UFix64 = block.timestamp
-                log(ts.isInstance(Type<UFix64>()))
-
-                var div: UFix64 = 4.0
+                var ts: Timestamp = block.timestamp
+                log(ts.isInstance(Type<Timestamp>()))
 
                 // Shouldn't panic
-                var res = ts/div
+                var res = ts + Duration.day
 
                 return [ts, res]
             }
@@ -209,8 +205,8 @@ func TestRuntimeBlockFieldTypes(t *testing.T) {
 		values := value.(cadence.Array).Values
 
 		require.Equal(t, 2, len(values))
-		assert.IsType(t, cadence.UFix64Type{}, values[0].Type())
-		assert.IsType(t, cadence.UFix64Type{}, values[1].Type())
+		assert.IsType(t, cadence.TimestampType{}, values[0].Type())
+		assert.IsType(t, cadence.TimestampType{}, values[1].Type())
 
 		assert.Equal(
 			t,
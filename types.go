@@ -20,6 +20,7 @@ package cadence
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/onflow/cadence/runtime/common"
 )
@@ -728,6 +729,44 @@ func (Word64Type) ID() string {
 	return "Word64"
 }
 
+// Word128Type
+
+type Word128Type struct{}
+
+func NewWord128Type() Word128Type {
+	return Word128Type{}
+}
+
+func NewMeteredWord128Type(gauge common.MemoryGauge) Word128Type {
+	common.UseMemory(gauge, common.CadenceSimpleTypeMemoryUsage)
+	return NewWord128Type()
+}
+
+func (Word128Type) isType() {}
+
+func (Word128Type) ID() string {
+	return "Word128"
+}
+
+// Word256Type
+
+type Word256Type struct{}
+
+func NewWord256Type() Word256Type {
+	return Word256Type{}
+}
+
+func NewMeteredWord256Type(gauge common.MemoryGauge) Word256Type {
+	common.UseMemory(gauge, common.CadenceSimpleTypeMemoryUsage)
+	return NewWord256Type()
+}
+
+func (Word256Type) isType() {}
+
+func (Word256Type) ID() string {
+	return "Word256"
+}
+
 // Fix64Type
 
 type Fix64Type struct{}
@@ -766,6 +805,82 @@ func (UFix64Type) ID() string {
 	return "UFix64"
 }
 
+// Fix128Type
+
+type Fix128Type struct{}
+
+func NewFix128Type() Fix128Type {
+	return Fix128Type{}
+}
+
+func NewMeteredFix128Type(gauge common.MemoryGauge) Fix128Type {
+	common.UseMemory(gauge, common.CadenceSimpleTypeMemoryUsage)
+	return NewFix128Type()
+}
+
+func (Fix128Type) isType() {}
+
+func (Fix128Type) ID() string {
+	return "Fix128"
+}
+
+// UFix128Type
+
+type UFix128Type struct{}
+
+func NewUFix128Type() UFix128Type {
+	return UFix128Type{}
+}
+
+func NewMeteredUFix128Type(gauge common.MemoryGauge) UFix128Type {
+	common.UseMemory(gauge, common.CadenceSimpleTypeMemoryUsage)
+	return NewUFix128Type()
+}
+
+func (UFix128Type) isType() {}
+
+func (UFix128Type) ID() string {
+	return "UFix128"
+}
+
+// TimestampType
+
+type TimestampType struct{}
+
+func NewTimestampType() TimestampType {
+	return TimestampType{}
+}
+
+func NewMeteredTimestampType(gauge common.MemoryGauge) TimestampType {
+	common.UseMemory(gauge, common.CadenceSimpleTypeMemoryUsage)
+	return NewTimestampType()
+}
+
+func (TimestampType) isType() {}
+
+func (TimestampType) ID() string {
+	return "Timestamp"
+}
+
+// DurationType
+
+type DurationType struct{}
+
+func NewDurationType() DurationType {
+	return DurationType{}
+}
+
+func NewMeteredDurationType(gauge common.MemoryGauge) DurationType {
+	common.UseMemory(gauge, common.CadenceSimpleTypeMemoryUsage)
+	return NewDurationType()
+}
+
+func (DurationType) isType() {}
+
+func (DurationType) ID() string {
+	return "Duration"
+}
+
 type ArrayType interface {
 	Type
 	Element() Type
@@ -873,6 +988,32 @@ func (t DictionaryType) ID() string {
 	)
 }
 
+// SetType
+
+type SetType struct {
+	ElementType Type
+}
+
+func NewSetType(
+	elementType Type,
+) SetType {
+	return SetType{ElementType: elementType}
+}
+
+func NewMeteredSetType(
+	gauge common.MemoryGauge,
+	elementType Type,
+) SetType {
+	common.UseMemory(gauge, common.CadenceSetTypeMemoryUsage)
+	return NewSetType(elementType)
+}
+
+func (SetType) isType() {}
+
+func (t SetType) ID() string {
+	return fmt.Sprintf("{%s}", t.ElementType.ID())
+}
+
 // Field
 
 type Field struct {
@@ -926,6 +1067,7 @@ type CompositeType interface {
 type StructType struct {
 	Location            common.Location
 	QualifiedIdentifier string
+	TypeArguments       []Type
 	Fields              []Field
 	Initializers        [][]Parameter
 }
@@ -958,11 +1100,14 @@ func NewMeteredStructType(
 func (*StructType) isType() {}
 
 func (t *StructType) ID() string {
+	var id string
 	if t.Location == nil {
-		return t.QualifiedIdentifier
+		id = t.QualifiedIdentifier
+	} else {
+		id = string(t.Location.TypeID(nil, t.QualifiedIdentifier))
 	}
 
-	return string(t.Location.TypeID(nil, t.QualifiedIdentifier))
+	return instantiatedTypeID(id, t.TypeArguments)
 }
 
 func (*StructType) isCompositeType() {}
@@ -987,11 +1132,34 @@ func (t *StructType) CompositeInitializers() [][]Parameter {
 	return t.Initializers
 }
 
+// instantiatedTypeID returns the ID of an instantiated generic composite type,
+// e.g. `S.test.Box<Int>`
+//
+func instantiatedTypeID(id string, typeArguments []Type) string {
+	if len(typeArguments) == 0 {
+		return id
+	}
+
+	var builder strings.Builder
+	builder.WriteString(id)
+	builder.WriteByte('<')
+	for i, typeArgument := range typeArguments {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(typeArgument.ID())
+	}
+	builder.WriteByte('>')
+
+	return builder.String()
+}
+
 // ResourceType
 
 type ResourceType struct {
 	Location            common.Location
 	QualifiedIdentifier string
+	TypeArguments       []Type
 	Fields              []Field
 	Initializers        [][]Parameter
 }
@@ -1024,11 +1192,14 @@ func NewMeteredResourceType(
 func (*ResourceType) isType() {}
 
 func (t *ResourceType) ID() string {
+	var id string
 	if t.Location == nil {
-		return t.QualifiedIdentifier
+		id = t.QualifiedIdentifier
+	} else {
+		id = string(t.Location.TypeID(nil, t.QualifiedIdentifier))
 	}
 
-	return string(t.Location.TypeID(nil, t.QualifiedIdentifier))
+	return instantiatedTypeID(id, t.TypeArguments)
 }
 
 func (*ResourceType) isCompositeType() {}
@@ -1735,6 +1906,75 @@ func (t *EnumType) CompositeInitializers() [][]Parameter {
 	return t.Initializers
 }
 
+// NewtypeType
+type NewtypeType struct {
+	Location            common.Location
+	QualifiedIdentifier string
+	UnderlyingType      Type
+	Fields              []Field
+	Initializers        [][]Parameter
+}
+
+func NewNewtypeType(
+	location common.Location,
+	qualifiedIdentifier string,
+	underlyingType Type,
+	fields []Field,
+	initializers [][]Parameter,
+) *NewtypeType {
+	return &NewtypeType{
+		Location:            location,
+		QualifiedIdentifier: qualifiedIdentifier,
+		UnderlyingType:      underlyingType,
+		Fields:              fields,
+		Initializers:        initializers,
+	}
+}
+
+func NewMeteredNewtypeType(
+	gauge common.MemoryGauge,
+	location common.Location,
+	qualifiedIdentifier string,
+	underlyingType Type,
+	fields []Field,
+	initializers [][]Parameter,
+) *NewtypeType {
+	common.UseMemory(gauge, common.CadenceNewtypeTypeMemoryUsage)
+	return NewNewtypeType(location, qualifiedIdentifier, underlyingType, fields, initializers)
+}
+
+func (*NewtypeType) isType() {}
+
+func (t *NewtypeType) ID() string {
+	if t.Location == nil {
+		return t.QualifiedIdentifier
+	}
+
+	return string(t.Location.TypeID(nil, t.QualifiedIdentifier))
+}
+
+func (*NewtypeType) isCompositeType() {}
+
+func (t *NewtypeType) CompositeTypeLocation() common.Location {
+	return t.Location
+}
+
+func (t *NewtypeType) CompositeTypeQualifiedIdentifier() string {
+	return t.QualifiedIdentifier
+}
+
+func (t *NewtypeType) CompositeFields() []Field {
+	return t.Fields
+}
+
+func (t *NewtypeType) SetCompositeFields(fields []Field) {
+	t.Fields = fields
+}
+
+func (t *NewtypeType) CompositeInitializers() [][]Parameter {
+	return t.Initializers
+}
+
 // AuthAccountType
 type AuthAccountType struct{}
 
@@ -65,6 +65,8 @@ func TestType_ID(t *testing.T) {
 		{Word64Type{}, "Word64"},
 		{UFix64Type{}, "UFix64"},
 		{Fix64Type{}, "Fix64"},
+		{UFix128Type{}, "UFix128"},
+		{Fix128Type{}, "Fix128"},
 		{VoidType{}, "Void"},
 		{BoolType{}, "Bool"},
 		{CharacterType{}, "Character"},
@@ -115,6 +117,12 @@ func TestType_ID(t *testing.T) {
 			},
 			"{String:Int}",
 		},
+		{
+			SetType{
+				ElementType: StringType{},
+			},
+			"{String}",
+		},
 		{
 			&StructType{
 				Location:            utils.TestLocation,
@@ -22,6 +22,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"math/big"
+	"time"
 	"unicode/utf8"
 	"unsafe"
 
@@ -1202,6 +1203,132 @@ func (v Word64) String() string {
 	return format.Uint(uint64(v))
 }
 
+// Word128
+
+type Word128 struct {
+	Value *big.Int
+}
+
+var _ Value = Word128{}
+
+var Word128MemoryUsage = common.NewCadenceBigIntMemoryUsage(16)
+
+func NewWord128(i uint) Word128 {
+	return Word128{new(big.Int).SetUint64(uint64(i))}
+}
+
+func NewWord128FromBig(i *big.Int) (Word128, error) {
+	if i.Sign() < 0 {
+		return Word128{}, errors.NewDefaultUserError("invalid negative value for Word128: %s", i.String())
+	}
+	if i.Cmp(sema.Word128TypeMaxIntBig) > 0 {
+		return Word128{}, errors.NewDefaultUserError("value exceeds max of Word128: %s", i.String())
+	}
+	return Word128{i}, nil
+}
+
+func NewMeteredWord128FromBig(
+	memoryGauge common.MemoryGauge,
+	bigIntConstructor func() *big.Int,
+) (Word128, error) {
+	common.UseMemory(memoryGauge, Word128MemoryUsage)
+	value := bigIntConstructor()
+	return NewWord128FromBig(value)
+}
+
+func (Word128) isValue() {}
+
+func (Word128) Type() Type {
+	return NewWord128Type()
+}
+
+func (Word128) MeteredType(gauge common.MemoryGauge) Type {
+	return NewMeteredWord128Type(gauge)
+}
+
+func (v Word128) ToGoValue() any {
+	return v.Big()
+}
+
+func (v Word128) Int() int {
+	return int(v.Value.Uint64())
+}
+
+func (v Word128) Big() *big.Int {
+	return v.Value
+}
+
+func (v Word128) ToBigEndianBytes() []byte {
+	return interpreter.UnsignedBigIntToBigEndianBytes(v.Value)
+}
+
+func (v Word128) String() string {
+	return format.BigInt(v.Value)
+}
+
+// Word256
+
+type Word256 struct {
+	Value *big.Int
+}
+
+var _ Value = Word256{}
+
+var Word256MemoryUsage = common.NewCadenceBigIntMemoryUsage(32)
+
+func NewWord256(i uint) Word256 {
+	return Word256{new(big.Int).SetUint64(uint64(i))}
+}
+
+func NewWord256FromBig(i *big.Int) (Word256, error) {
+	if i.Sign() < 0 {
+		return Word256{}, errors.NewDefaultUserError("invalid negative value for Word256: %s", i.String())
+	}
+	if i.Cmp(sema.Word256TypeMaxIntBig) > 0 {
+		return Word256{}, errors.NewDefaultUserError("value exceeds max of Word256: %s", i.String())
+	}
+	return Word256{i}, nil
+}
+
+func NewMeteredWord256FromBig(
+	memoryGauge common.MemoryGauge,
+	bigIntConstructor func() *big.Int,
+) (Word256, error) {
+	common.UseMemory(memoryGauge, Word256MemoryUsage)
+	value := bigIntConstructor()
+	return NewWord256FromBig(value)
+}
+
+func (Word256) isValue() {}
+
+func (Word256) Type() Type {
+	return NewWord256Type()
+}
+
+func (Word256) MeteredType(gauge common.MemoryGauge) Type {
+	return NewMeteredWord256Type(gauge)
+}
+
+func (v Word256) ToGoValue() any {
+	return v.Big()
+}
+
+func (v Word256) Int() int {
+	return int(v.Value.Uint64())
+}
+
+func (v Word256) Big() *big.Int {
+	return v.Value
+}
+
+func (v Word256) ToBigEndianBytes() []byte {
+	return interpreter.UnsignedBigIntToBigEndianBytes(v.Value)
+}
+
+func (v Word256) String() string {
+	return format.BigInt(v.Value)
+}
+
 // Fix64
 
 type Fix64 int64
@@ -1333,6 +1460,230 @@ func (v UFix64) String() string {
 	return format.UFix64(uint64(v))
 }
 
+// Fix128
+
+// Fix128 is a signed fixed-point number with a scale of 24,
+// represented by the underlying integer, i.e. the number multiplied by 10^24
+//
+type Fix128 struct {
+	Value *big.Int
+}
+
+var _ Value = Fix128{}
+
+var Fix128MemoryUsage = common.NewCadenceBigIntMemoryUsage(16)
+
+func NewFix128(s string) (Fix128, error) {
+	v, err := fixedpoint.ParseFix128(s)
+	if err != nil {
+		return Fix128{}, err
+	}
+	return Fix128{v}, nil
+}
+
+func NewFix128FromBig(i *big.Int) (Fix128, error) {
+	if i.Cmp(sema.Fix128TypeMinBig) < 0 {
+		return Fix128{}, errors.NewDefaultUserError("value exceeds min of Fix128: %s", i.String())
+	}
+	if i.Cmp(sema.Fix128TypeMaxBig) > 0 {
+		return Fix128{}, errors.NewDefaultUserError("value exceeds max of Fix128: %s", i.String())
+	}
+	return Fix128{i}, nil
+}
+
+func NewMeteredFix128(gauge common.MemoryGauge, constructor func() (string, error)) (Fix128, error) {
+	common.UseMemory(gauge, Fix128MemoryUsage)
+	value, err := constructor()
+	if err != nil {
+		return Fix128{}, err
+	}
+	return NewFix128(value)
+}
+
+func NewMeteredFix128FromBig(
+	memoryGauge common.MemoryGauge,
+	bigIntConstructor func() *big.Int,
+) (Fix128, error) {
+	common.UseMemory(memoryGauge, Fix128MemoryUsage)
+	value := bigIntConstructor()
+	return NewFix128FromBig(value)
+}
+
+func (Fix128) isValue() {}
+
+func (Fix128) Type() Type {
+	return NewFix128Type()
+}
+
+func (Fix128) MeteredType(gauge common.MemoryGauge) Type {
+	return NewMeteredFix128Type(gauge)
+}
+
+func (v Fix128) ToGoValue() any {
+	return v.Big()
+}
+
+func (v Fix128) Big() *big.Int {
+	return v.Value
+}
+
+func (v Fix128) ToBigEndianBytes() []byte {
+	return interpreter.SignedBigIntToBigEndianBytes(v.Value)
+}
+
+func (v Fix128) String() string {
+	return format.Fix128(v.Value)
+}
+
+// UFix128
+
+// UFix128 is an unsigned fixed-point number with a scale of 24,
+// represented by the underlying integer, i.e. the number multiplied by 10^24
+//
+type UFix128 struct {
+	Value *big.Int
+}
+
+var _ Value = UFix128{}
+
+var UFix128MemoryUsage = common.NewCadenceBigIntMemoryUsage(16)
+
+func NewUFix128(s string) (UFix128, error) {
+	v, err := fixedpoint.ParseUFix128(s)
+	if err != nil {
+		return UFix128{}, err
+	}
+	return UFix128{v}, nil
+}
+
+func NewUFix128FromBig(i *big.Int) (UFix128, error) {
+	if i.Sign() < 0 {
+		return UFix128{}, errors.NewDefaultUserError("invalid negative value for UFix128: %s", i.String())
+	}
+	if i.Cmp(sema.UFix128TypeMaxBig) > 0 {
+		return UFix128{}, errors.NewDefaultUserError("value exceeds max of UFix128: %s", i.String())
+	}
+	return UFix128{i}, nil
+}
+
+func NewMeteredUFix128(gauge common.MemoryGauge, constructor func() (string, error)) (UFix128, error) {
+	common.UseMemory(gauge, UFix128MemoryUsage)
+	value, err := constructor()
+	if err != nil {
+		return UFix128{}, err
+	}
+	return NewUFix128(value)
+}
+
+func NewMeteredUFix128FromBig(
+	memoryGauge common.MemoryGauge,
+	bigIntConstructor func() *big.Int,
+) (UFix128, error) {
+	common.UseMemory(memoryGauge, UFix128MemoryUsage)
+	value := bigIntConstructor()
+	return NewUFix128FromBig(value)
+}
+
+func (UFix128) isValue() {}
+
+func (UFix128) Type() Type {
+	return NewUFix128Type()
+}
+
+func (UFix128) MeteredType(gauge common.MemoryGauge) Type {
+	return NewMeteredUFix128Type(gauge)
+}
+
+func (v UFix128) ToGoValue() any {
+	return v.Big()
+}
+
+func (v UFix128) Big() *big.Int {
+	return v.Value
+}
+
+func (v UFix128) ToBigEndianBytes() []byte {
+	return interpreter.UnsignedBigIntToBigEndianBytes(v.Value)
+}
+
+func (v UFix128) String() string {
+	return format.UFix128(v.Value)
+}
+
+// Timestamp
+
+// Timestamp is the number of seconds since the Unix epoch
+//
+type Timestamp int64
+
+var _ Value = Timestamp(0)
+
+var TimestampMemoryUsage = common.NewCadenceNumberMemoryUsage(int(unsafe.Sizeof(Timestamp(0))))
+
+func NewTimestamp(seconds int64) Timestamp {
+	return Timestamp(seconds)
+}
+
+func NewMeteredTimestamp(memoryGauge common.MemoryGauge, seconds int64) Timestamp {
+	common.UseMemory(memoryGauge, TimestampMemoryUsage)
+	return Timestamp(seconds)
+}
+
+func (Timestamp) isValue() {}
+
+func (Timestamp) Type() Type {
+	return NewTimestampType()
+}
+
+func (Timestamp) MeteredType(gauge common.MemoryGauge) Type {
+	return NewMeteredTimestampType(gauge)
+}
+
+func (v Timestamp) ToGoValue() any {
+	return time.Unix(int64(v), 0).UTC()
+}
+
+func (v Timestamp) String() string {
+	return format.Timestamp(int64(v))
+}
+
+// Duration
+
+// Duration is a number of seconds
+//
+type Duration int64
+
+var _ Value = Duration(0)
+
+var DurationMemoryUsage = common.NewCadenceNumberMemoryUsage(int(unsafe.Sizeof(Duration(0))))
+
+func NewDuration(seconds int64) Duration {
+	return Duration(seconds)
+}
+
+func NewMeteredDuration(memoryGauge common.MemoryGauge, seconds int64) Duration {
+	common.UseMemory(memoryGauge, DurationMemoryUsage)
+	return Duration(seconds)
+}
+
+func (Duration) isValue() {}
+
+func (Duration) Type() Type {
+	return NewDurationType()
+}
+
+func (Duration) MeteredType(gauge common.MemoryGauge) Type {
+	return NewMeteredDurationType(gauge)
+}
+
+func (v Duration) ToGoValue() any {
+	return time.Duration(v) * time.Second
+}
+
+func (v Duration) String() string {
+	return format.Int(int64(v))
+}
+
 // Array
 
 type Array struct {
@@ -1467,6 +1818,65 @@ func (v Dictionary) String() string {
 	return format.Dictionary(pairs)
 }
 
+// Set
+
+type Set struct {
+	SetType  Type
+	Elements []Value
+}
+
+var _ Value = Set{}
+
+func NewSet(elements []Value) Set {
+	return Set{Elements: elements}
+}
+
+func NewMeteredSet(
+	gauge common.MemoryGauge,
+	constructor func() ([]Value, error),
+) (Set, error) {
+	common.UseMemory(gauge, common.CadenceSetValueMemoryUsage)
+
+	elements, err := constructor()
+	if err != nil {
+		return Set{}, err
+	}
+	return NewSet(elements), nil
+}
+
+func (Set) isValue() {}
+
+func (v Set) Type() Type {
+	return v.SetType
+}
+
+func (v Set) MeteredType(_ common.MemoryGauge) Type {
+	return v.Type()
+}
+
+func (v Set) WithType(setType SetType) Set {
+	v.SetType = setType
+	return v
+}
+
+func (v Set) ToGoValue() any {
+	ret := make([]any, len(v.Elements))
+
+	for i, e := range v.Elements {
+		ret[i] = e.ToGoValue()
+	}
+
+	return ret
+}
+
+func (v Set) String() string {
+	elements := make([]string, len(v.Elements))
+	for i, element := range v.Elements {
+		elements[i] = element.String()
+	}
+	return format.Set(elements)
+}
+
 // KeyValuePair
 
 type KeyValuePair struct {
@@ -1953,3 +2363,59 @@ func (v Enum) ToGoValue() any {
 func (v Enum) String() string {
 	return formatComposite(v.EnumType.ID(), v.EnumType.Fields, v.Fields)
 }
+
+// Newtype
+type Newtype struct {
+	NewtypeType *NewtypeType
+	Fields      []Value
+}
+
+var _ Value = Newtype{}
+
+func NewNewtype(fields []Value) Newtype {
+	return Newtype{Fields: fields}
+}
+
+func NewMeteredNewtype(
+	gauge common.MemoryGauge,
+	numberOfFields int,
+	constructor func() ([]Value, error),
+) (Newtype, error) {
+	baseUsage, sizeUsage := common.NewCadenceNewtypeMemoryUsages(numberOfFields)
+	common.UseMemory(gauge, baseUsage)
+	common.UseMemory(gauge, sizeUsage)
+	fields, err := constructor()
+	if err != nil {
+		return Newtype{}, err
+	}
+	return NewNewtype(fields), nil
+}
+
+func (Newtype) isValue() {}
+
+func (v Newtype) Type() Type {
+	return v.NewtypeType
+}
+
+func (v Newtype) MeteredType(_ common.MemoryGauge) Type {
+	return v.Type()
+}
+
+func (v Newtype) WithType(typ *NewtypeType) Newtype {
+	v.NewtypeType = typ
+	return v
+}
+
+func (v Newtype) ToGoValue() any {
+	ret := make([]any, len(v.Fields))
+
+	for i, field := range v.Fields {
+		ret[i] = field.ToGoValue()
+	}
+
+	return ret
+}
+
+func (v Newtype) String() string {
+	return formatComposite(v.NewtypeType.ID(), v.NewtypeType.Fields, v.Fields)
+}
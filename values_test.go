@@ -42,6 +42,8 @@ func TestStringer(t *testing.T) {
 
 	ufix64, _ := NewUFix64("64.01")
 	fix64, _ := NewFix64("-32.11")
+	ufix128, _ := NewUFix128("64.000000000000000000000001")
+	fix128, _ := NewFix128("-0.5")
 
 	stringerTests := map[string]testCase{
 		"UInt": {
@@ -116,6 +118,22 @@ func TestStringer(t *testing.T) {
 			value:    NewWord64(64),
 			expected: "64",
 		},
+		"Word128": {
+			value:    NewWord128(128),
+			expected: "128",
+		},
+		"Word256": {
+			value:    NewWord256(256),
+			expected: "256",
+		},
+		"Timestamp": {
+			value:    NewTimestamp(1584129163),
+			expected: "2020-03-13T19:52:43Z",
+		},
+		"Duration": {
+			value:    NewDuration(86400),
+			expected: "86400",
+		},
 		"UFix64": {
 			value:    ufix64,
 			expected: "64.01000000",
@@ -124,6 +142,14 @@ func TestStringer(t *testing.T) {
 			value:    fix64,
 			expected: "-32.11000000",
 		},
+		"UFix128": {
+			value:    ufix128,
+			expected: "64.000000000000000000000001",
+		},
+		"Fix128": {
+			value:    fix128,
+			expected: "-0.500000000000000000000000",
+		},
 		"Void": {
 			value:    NewVoid(),
 			expected: "()",
@@ -164,6 +190,13 @@ func TestStringer(t *testing.T) {
 			}),
 			expected: "{\"key\": \"value\"}",
 		},
+		"Set": {
+			value: NewSet([]Value{
+				String("a"),
+				String("b"),
+			}),
+			expected: "{\"a\", \"b\"}",
+		},
 		"Bytes": {
 			value:    NewBytes([]byte{0x1, 0x2}),
 			expected: "[0x1, 0x2]",
@@ -427,6 +460,20 @@ func TestToBigEndianBytes(t *testing.T) {
 			NewWord64(9223372036854775808):  {128, 0, 0, 0, 0, 0, 0, 0},
 			NewWord64(18446744073709551615): {255, 255, 255, 255, 255, 255, 255, 255},
 		},
+		"Word128": {
+			NewWord128(0):   {0},
+			NewWord128(42):  {42},
+			NewWord128(127): {127},
+			NewWord128(128): {128},
+			NewWord128(200): {200},
+		},
+		"Word256": {
+			NewWord256(0):   {0},
+			NewWord256(42):  {42},
+			NewWord256(127): {127},
+			NewWord256(128): {128},
+			NewWord256(200): {200},
+		},
 		// Fix*
 		"Fix64": {
 			Fix64(0):           {0, 0, 0, 0, 0, 0, 0, 0},
@@ -434,12 +481,25 @@ func TestToBigEndianBytes(t *testing.T) {
 			Fix64(42_24000000): {0, 0, 0, 0, 251, 197, 32, 0},
 			Fix64(-1_00000000): {255, 255, 255, 255, 250, 10, 31, 0},
 		},
+		"Fix128": {
+			Fix128{big.NewInt(0)}:   {0},
+			Fix128{big.NewInt(42)}:  {42},
+			Fix128{big.NewInt(127)}: {127},
+			Fix128{big.NewInt(128)}: {0, 128},
+			Fix128{big.NewInt(-1)}:  {255},
+		},
 		// UFix*
 		"UFix64": {
 			Fix64(0):           {0, 0, 0, 0, 0, 0, 0, 0},
 			Fix64(42_00000000): {0, 0, 0, 0, 250, 86, 234, 0},
 			Fix64(42_24000000): {0, 0, 0, 0, 251, 197, 32, 0},
 		},
+		"UFix128": {
+			UFix128{big.NewInt(0)}:   {0},
+			UFix128{big.NewInt(42)}:  {42},
+			UFix128{big.NewInt(128)}: {128},
+			UFix128{big.NewInt(200)}: {200},
+		},
 	}
 
 	// Ensure the test cases are complete
@@ -583,6 +643,43 @@ func TestNewUInt128FromBig(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestNewFix128FromBig(t *testing.T) {
+
+	_, err := NewFix128FromBig(big.NewInt(1))
+	require.NoError(t, err)
+
+	belowMin := new(big.Int).Sub(
+		sema.Fix128TypeMinBig,
+		big.NewInt(1),
+	)
+	_, err = NewFix128FromBig(belowMin)
+	require.Error(t, err)
+
+	aboveMax := new(big.Int).Add(
+		sema.Fix128TypeMaxBig,
+		big.NewInt(1),
+	)
+	_, err = NewFix128FromBig(aboveMax)
+	require.Error(t, err)
+}
+
+func TestNewUFix128FromBig(t *testing.T) {
+
+	_, err := NewUFix128FromBig(big.NewInt(1))
+	require.NoError(t, err)
+
+	belowMin := big.NewInt(-1)
+	_, err = NewUFix128FromBig(belowMin)
+	require.Error(t, err)
+
+	aboveMax := new(big.Int).Add(
+		sema.UFix128TypeMaxBig,
+		big.NewInt(1),
+	)
+	_, err = NewUFix128FromBig(aboveMax)
+	require.Error(t, err)
+}
+
 func TestNewUInt256FromBig(t *testing.T) {
 
 	_, err := NewUInt256FromBig(big.NewInt(1))